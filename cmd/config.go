@@ -9,14 +9,22 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/buildpack"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/dbmanager"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/log/dockerlogs"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/log/loki"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/log/victorialogs"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/metrics/dockerstats"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/metrics/prometheus"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/notification"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/oidc"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/staticserver/builtin"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/staticserver/caddy"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/webhook"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/alert"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/customdomain"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/healthcheck"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/tlscert"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/uptime"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/domain/builder"
@@ -26,11 +34,16 @@ import (
 type Config struct {
 	PrivateKeyFile  string                   `mapstructure:"privateKeyFile" yaml:"privateKeyFile"`
 	AdditionalLinks []*domain.AdditionalLink `mapstructure:"additionalLinks" yaml:"additionalLinks"`
+	// TLSCertificateKeyFile is the file holding the secret to encrypt uploaded TLS certificates with.
+	// Uploading certificates is disabled if empty.
+	TLSCertificateKeyFile string `mapstructure:"tlsCertificateKeyFile" yaml:"tlsCertificateKeyFile"`
 
 	DB      repository.Config    `mapstructure:"db" yaml:"db"`
 	Storage domain.StorageConfig `mapstructure:"storage" yaml:"storage"`
 	Image   builder.ImageConfig  `mapstructure:"image" yaml:"image"`
 
+	Tracing observability.TracingConfig `mapstructure:"tracing" yaml:"tracing"`
+
 	Components ComponentsConfig `mapstructure:"components" yaml:"components"`
 }
 
@@ -71,23 +84,36 @@ type ControllerConfig struct {
 	Webhook          webhook.ReceiverConfig            `mapstructure:"webhook" yaml:"webhook"`
 	GiteaIntegration ControllerGiteaIntegrationConfig  `mapstructure:"giteaIntegration" yaml:"giteaIntegration"`
 	Metrics          observability.MetricsServerConfig `mapstructure:"metrics" yaml:"metrics"`
+	CrashLoop        domain.CrashLoopConfig            `mapstructure:"crashLoop" yaml:"crashLoop"`
+	Uptime           uptime.Config                     `mapstructure:"uptime" yaml:"uptime"`
+	Alert            alert.Config                      `mapstructure:"alert" yaml:"alert"`
+	Notification     notification.Config               `mapstructure:"notification" yaml:"notification"`
+	CustomDomain     customdomain.Config               `mapstructure:"customDomain" yaml:"customDomain"`
+	TLSCertificate   tlscert.Config                    `mapstructure:"tlsCertificate" yaml:"tlsCertificate"`
 }
 
+// GatewayConfig is the config of the gateway.
+// Quota and RateLimit are also read by the controller, to bound the changes by the config files in repositories.
 type GatewayConfig struct {
 	Port          int                                `mapstructure:"port" yaml:"port"`
 	AvatarBaseURL domain.AvatarBaseURL               `mapstructure:"avatarBaseURL" yaml:"avatarBaseURL"`
 	AuthHeader    grpc.AuthHeader                    `mapstructure:"authHeader" yaml:"authHeader"`
+	OIDC          oidc.Config                        `mapstructure:"oidc" yaml:"oidc"`
 	Controller    grpc.ControllerServiceClientConfig `mapstructure:"controller" yaml:"controller"`
 	MariaDB       dbmanager.MariaDBConfig            `mapstructure:"mariadb" yaml:"mariadb"`
 	MongoDB       dbmanager.MongoDBConfig            `mapstructure:"mongodb" yaml:"mongodb"`
+	Quota         domain.Quota                       `mapstructure:"quota" yaml:"quota"`
+	RateLimit     domain.WebsiteRateLimitConfig      `mapstructure:"rateLimit" yaml:"rateLimit"`
 	Log           struct {
 		Type         string              `mapstructure:"type" yaml:"type"`
 		Loki         loki.Config         `mapstructure:"loki" yaml:"loki"`
 		VictoriaLogs victorialogs.Config `mapstructure:"victorialogs" yaml:"victorialogs"`
+		Docker       dockerlogs.Config   `mapstructure:"docker" yaml:"docker"`
 	} `mapstructure:"log" yaml:"log"`
 	Metrics struct {
-		Type       string            `mapstructure:"type" yaml:"type"`
-		Prometheus prometheus.Config `mapstructure:"prometheus" yaml:"prometheus"`
+		Type       string             `mapstructure:"type" yaml:"type"`
+		Prometheus prometheus.Config  `mapstructure:"prometheus" yaml:"prometheus"`
+		Docker     dockerstats.Config `mapstructure:"docker" yaml:"docker"`
 	}
 }
 
@@ -150,6 +176,11 @@ func init() {
 	viper.SetDefault("image.namePrefix", "ns-apps/")
 	viper.SetDefault("image.tmpNamePrefix", "ns-apps-tmp/")
 
+	viper.SetDefault("tracing.exporter", "")
+	viper.SetDefault("tracing.endpoint", "")
+	viper.SetDefault("tracing.insecure", false)
+	viper.SetDefault("tracing.sampleRatio", 1.0)
+
 	viper.SetDefault("components.authDev.header", "X-Showcase-User")
 	viper.SetDefault("components.authDev.port", 4181)
 	viper.SetDefault("components.authDev.user", "toki")
@@ -247,10 +278,41 @@ func init() {
 
 	viper.SetDefault("components.controller.metrics.port", 9100)
 
+	viper.SetDefault("components.controller.crashLoop.threshold", 3)
+	viper.SetDefault("components.controller.crashLoop.window", "10m")
+	viper.SetDefault("components.controller.crashLoop.autoStop", false)
+	viper.SetDefault("components.controller.uptime.interval", "1m")
+	viper.SetDefault("components.controller.uptime.timeout", "10s")
+	viper.SetDefault("components.controller.uptime.retention", "720h")
+	viper.SetDefault("components.controller.alert.interval", "1m")
+	viper.SetDefault("components.controller.notification.timeout", "10s")
+	viper.SetDefault("components.controller.notification.smtp.port", 587)
+	viper.SetDefault("components.controller.customDomain.interval", "1h")
+	viper.SetDefault("components.controller.customDomain.gracePeriod", "72h")
+	viper.SetDefault("components.controller.tlsCertificate.interval", "24h")
+
 	viper.SetDefault("components.gateway.port", 8080)
 	viper.SetDefault("components.gateway.avatarBaseURL", "https://q.trap.jp/api/v3/public/icon/")
 	viper.SetDefault("components.gateway.authHeader", "X-Showcase-User")
 
+	viper.SetDefault("components.gateway.oidc.enabled", false)
+	viper.SetDefault("components.gateway.oidc.issuer", "")
+	viper.SetDefault("components.gateway.oidc.clientID", "")
+	viper.SetDefault("components.gateway.oidc.clientSecret", "")
+	viper.SetDefault("components.gateway.oidc.redirectURL", "")
+	viper.SetDefault("components.gateway.oidc.scopes", []string{"openid", "profile"})
+	viper.SetDefault("components.gateway.oidc.basePath", "/auth")
+	viper.SetDefault("components.gateway.oidc.postLoginRedirect", "/")
+	viper.SetDefault("components.gateway.oidc.claims.username", "preferred_username")
+	viper.SetDefault("components.gateway.oidc.claims.groups", "groups")
+	viper.SetDefault("components.gateway.oidc.claims.admin", "")
+	viper.SetDefault("components.gateway.oidc.allowedGroups", nil)
+	viper.SetDefault("components.gateway.oidc.adminGroups", nil)
+	viper.SetDefault("components.gateway.oidc.session.cookieName", "ns_session")
+	viper.SetDefault("components.gateway.oidc.session.secret", "")
+	viper.SetDefault("components.gateway.oidc.session.maxAge", "168h")
+	viper.SetDefault("components.gateway.oidc.session.secure", true)
+
 	viper.SetDefault("components.gateway.controller.url", "http://ns-controller:10000")
 
 	viper.SetDefault("components.gateway.mariadb.host", "mariadb")
@@ -263,17 +325,32 @@ func init() {
 	viper.SetDefault("components.gateway.mongodb.adminUser", "root")
 	viper.SetDefault("components.gateway.mongodb.adminPassword", "password")
 
+	viper.SetDefault("components.gateway.quota.maxApplications", -1)
+	viper.SetDefault("components.gateway.quota.maxRunningApplications", -1)
+	viper.SetDefault("components.gateway.quota.maxPortPublications", -1)
+	viper.SetDefault("components.gateway.quota.maxDatabases", -1)
+
+	viper.SetDefault("components.gateway.rateLimit.defaultAverage", 100)
+	viper.SetDefault("components.gateway.rateLimit.defaultBurst", 200)
+	viper.SetDefault("components.gateway.rateLimit.maxAverage", -1)
+	viper.SetDefault("components.gateway.rateLimit.maxBurst", -1)
+
 	viper.SetDefault("components.gateway.log.type", "loki")
 	viper.SetDefault("components.gateway.log.loki.endpoint", "http://loki:3100")
 	viper.SetDefault("components.gateway.log.loki.queryTemplate", loki.DefaultQueryTemplate())
 	viper.SetDefault("components.gateway.log.loki.logLimit", 5000)
+	viper.SetDefault("components.gateway.log.loki.streamLabel", "")
 	viper.SetDefault("components.gateway.log.victorialogs.endpoint", "http://victorialogs:9428")
 	viper.SetDefault("components.gateway.log.victorialogs.queryTemplate", victorialogs.DefaultQueryTemplate())
 	viper.SetDefault("components.gateway.log.victorialogs.logLimit", 5000)
+	viper.SetDefault("components.gateway.log.victorialogs.streamField", "")
+	viper.SetDefault("components.gateway.log.docker.logLimit", 5000)
 
 	viper.SetDefault("components.gateway.metrics.type", "prometheus")
 	viper.SetDefault("components.gateway.metrics.endpoint", "http://prometheus:9090")
 	viper.SetDefault("components.gateway.metric.queries", prometheus.DefaultQueriesConfig())
+	viper.SetDefault("components.gateway.metrics.docker.interval", "30s")
+	viper.SetDefault("components.gateway.metrics.docker.retention", "6h")
 
 	viper.SetDefault("components.giteaIntegration.port", 10001)
 	viper.SetDefault("components.giteaIntegration.url", "https://git.trap.jp")
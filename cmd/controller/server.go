@@ -11,10 +11,14 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/domain/web"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/webhook"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/alert"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/cleaner"
 	commitfetcher "github.com/traPtitech/neoshowcase/pkg/usecase/commit-fetcher"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/customdomain"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/repofetcher"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/sshserver"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/tlscert"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/uptime"
 	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
 )
 
@@ -35,6 +39,10 @@ type Server struct {
 	CommitFetcher  commitfetcher.Service
 	FetcherService repofetcher.Service
 	CleanerService cleaner.Service
+	UptimeService  uptime.Service
+	AlertService   alert.Service
+	DomainService  customdomain.Service
+	TLSCertService tlscert.Service
 }
 
 func (s *Server) Start(ctx context.Context) error {
@@ -70,6 +78,18 @@ func (s *Server) Start(ctx context.Context) error {
 	eg.Go(func() error {
 		return s.CleanerService.Start(ctx)
 	})
+	eg.Go(func() error {
+		return s.UptimeService.Start(ctx)
+	})
+	eg.Go(func() error {
+		return s.AlertService.Start(ctx)
+	})
+	eg.Go(func() error {
+		return s.DomainService.Start(ctx)
+	})
+	eg.Go(func() error {
+		return s.TLSCertService.Start(ctx)
+	})
 	eg.Go(func() error {
 		return s.APIServer.Start(ctx)
 	})
@@ -107,6 +127,18 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	eg.Go(func() error {
 		return s.CleanerService.Shutdown(ctx)
 	})
+	eg.Go(func() error {
+		return s.UptimeService.Shutdown(ctx)
+	})
+	eg.Go(func() error {
+		return s.AlertService.Shutdown(ctx)
+	})
+	eg.Go(func() error {
+		return s.DomainService.Shutdown(ctx)
+	})
+	eg.Go(func() error {
+		return s.TLSCertService.Shutdown(ctx)
+	})
 	eg.Go(func() error {
 		return s.APIServer.Shutdown(ctx)
 	})
@@ -54,6 +54,18 @@ func componentCommand(name string, gen componentGenF, longDesc string) *cobra.Co
 		Short: fmt.Sprintf("NeoShowcase %s component", name),
 		Long:  longDesc,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			shutdownTracer, err := observability.InitTracerProvider("ns-"+name, config.Tracing)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracer(ctx); err != nil {
+					slog.Error("failed to shutdown tracer provider", "error", err)
+				}
+			}()
+
 			service, err := gen(config)
 			if err != nil {
 				return err
@@ -81,12 +93,6 @@ func componentCommand(name string, gen componentGenF, longDesc string) *cobra.Co
 }
 
 func main() {
-	// Initialize OpenTelemetry tracer provider for trace ID generation
-	if err := observability.InitTracerProvider("neoshowcase"); err != nil {
-		slog.Error("failed to initialize tracer provider", "error", err)
-		os.Exit(1)
-	}
-
 	cobra.OnInitialize(cli.CobraOnInitializeFunc(&configFilePath, &config))
 
 	rootCommand.AddCommand(
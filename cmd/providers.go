@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -19,11 +20,15 @@ import (
 	cmdgiteaintegration "github.com/traPtitech/neoshowcase/cmd/gitea-integration"
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/backend/dockerimpl"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb/pbconnect"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/log/dockerlogs"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/log/loki"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/log/victorialogs"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/metrics/dockerstats"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/metrics/prometheus"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/oidc"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/staticserver/builtin"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/staticserver/caddy"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/storage"
@@ -43,6 +48,17 @@ func provideRepositoryPrivateKey(c Config) (domain.PrivateKey, error) {
 	return bytes, nil
 }
 
+func provideTLSCertificateEncryptionKey(c Config) (domain.TLSCertificateEncryptionKey, error) {
+	if c.TLSCertificateKeyFile == "" {
+		return nil, nil
+	}
+	bytes, err := os.ReadFile(c.TLSCertificateKeyFile)
+	if err != nil {
+		return nil, oops.Wrapf(err, "opening tls certificate key file")
+	}
+	return bytes, nil
+}
+
 func provideStorage(c domain.StorageConfig) (domain.Storage, error) {
 	switch strings.ToLower(c.Type) {
 	case "local":
@@ -68,7 +84,7 @@ func provideTokenAuthInterceptor(c Config) (*grpc.TokenAuthInterceptor, error) {
 	)
 }
 
-func provideControllerBuilderServiceClient(c Config, auth *grpc.TokenAuthInterceptor) domain.ControllerBuilderServiceClient {
+func provideControllerBuilderServiceClient(c Config, auth *grpc.TokenAuthInterceptor) (domain.ControllerBuilderServiceClient, error) {
 	return grpc.NewControllerBuilderServiceClient(
 		c.Components.Builder.Controller,
 		c.Components.Builder.Priority,
@@ -93,6 +109,10 @@ func provideBuildpackHelperServer(
 	return &buildpackhelper.APIServer{H2CServer: web.NewH2CServer(wc)}
 }
 
+func provideDNSResolver() domain.DNSResolver {
+	return net.DefaultResolver
+}
+
 func provideDiscoverer(c Config) (discovery.Discoverer, error) {
 	switch c.Components.Controller.Mode {
 	case "docker":
@@ -152,8 +172,9 @@ func provideControllerServer(
 			mux.Handle(pbconnect.NewControllerServiceHandler(controllerHandler,
 				connect.WithInterceptors(otelInterceptor, logInterceptor)))
 			mux.Handle(pbconnect.NewControllerBuilderServiceHandler(builderHandler,
-				connect.WithInterceptors(tokenAuth)))
-			mux.Handle(pbconnect.NewControllerSSGenServiceHandler(ssgenHandler))
+				connect.WithInterceptors(tokenAuth, otelInterceptor)))
+			mux.Handle(pbconnect.NewControllerSSGenServiceHandler(ssgenHandler,
+				connect.WithInterceptors(otelInterceptor)))
 		},
 	}
 	return &controller.APIServer{H2CServer: web.NewH2CServer(wc)}, nil
@@ -166,8 +187,14 @@ func provideContainerLogger(c Config) (domain.ContainerLogger, error) {
 		return loki.NewLokiStreamer(cc.Log.Loki)
 	case "victorialogs":
 		return victorialogs.NewVictoriaLogsStreamer(cc.Log.VictoriaLogs)
+	case "docker":
+		c, err := dockerimpl.NewClientFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		return dockerlogs.NewDockerLogger(cc.Log.Docker, c)
 	default:
-		return nil, oops.Errorf("invalid log type: %v (supported values: loki, victorialogs)", cc.Log.Type)
+		return nil, oops.Errorf("invalid log type: %v (supported values: loki, victorialogs, docker)", cc.Log.Type)
 	}
 }
 
@@ -176,14 +203,31 @@ func provideMetricsService(c Config) (domain.MetricsService, error) {
 	switch cc.Metrics.Type {
 	case "prometheus":
 		return prometheus.NewPromClient(cc.Metrics.Prometheus)
+	case "docker":
+		c, err := dockerimpl.NewClientFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		return dockerstats.NewDockerStats(cc.Metrics.Docker, c)
 	default:
-		return nil, oops.Errorf("invalid metrics type: %v (supported values: prometheus)", cc.Metrics.Type)
+		return nil, oops.Errorf("invalid metrics type: %v (supported values: prometheus, docker)", cc.Metrics.Type)
+	}
+}
+
+func provideOIDCProvider(c Config) (*oidc.Provider, error) {
+	cc := c.Components.Gateway
+	if !cc.OIDC.Enabled {
+		return nil, nil
 	}
+	return oidc.NewProvider(cc.OIDC)
 }
 
 func provideGatewayServer(
 	c Config,
 	appService pbconnect.APIServiceHandler,
+	logExportHandler *grpc.LogExportHandler,
+	statusHandler *grpc.StatusHandler,
+	oidcProvider *oidc.Provider,
 	authInterceptor *grpc.AuthInterceptor,
 	logInterceptor *grpc.LogInterceptor,
 	cacheInterceptor *grpc.CacheInterceptor,
@@ -204,6 +248,12 @@ func provideGatewayServer(
 					cacheInterceptor,
 				),
 			))
+			mux.Handle(grpc.LogExportBasePath, authInterceptor.Middleware(logExportHandler))
+			mux.Handle(grpc.StatusBasePath, authInterceptor.Middleware(statusHandler))
+			mux.Handle(grpc.StatusBasePath+"/", authInterceptor.Middleware(statusHandler))
+			if oidcProvider != nil {
+				mux.Handle(strings.TrimSuffix(c.Components.Gateway.OIDC.BasePath, "/")+"/", oidcProvider.Handler())
+			}
 		},
 	}
 	return &gateway.APIServer{H2CServer: web.NewH2CServer(wc)}, nil
@@ -219,24 +269,29 @@ func provideGiteaIntegrationConfig(c Config) giteaintegration.Config {
 	}
 }
 
-func provideGiteaIntegrationServiceClient(c Config) domain.GiteaIntegrationServiceClient {
+func provideGiteaIntegrationServiceClient(c Config) (domain.GiteaIntegrationServiceClient, error) {
 	if c.Components.Controller.GiteaIntegration.Enable {
 		return grpc.NewGiteaIntegrationServiceClient(c.Components.Controller.GiteaIntegration.URL)
 	}
-	return grpc.NewGiteaIntegrationServiceClientNop()
+	return grpc.NewGiteaIntegrationServiceClientNop(), nil
 }
 
 func provideGiteaIntegrationAPIServer(
 	c Config,
 	giteaIntegrationHandler domain.GiteaIntegrationService,
-) *cmdgiteaintegration.APIServer {
+) (*cmdgiteaintegration.APIServer, error) {
+	otelInterceptor, err := otelconnect.NewInterceptor(otelconnect.WithTrustRemote())
+	if err != nil {
+		return nil, err
+	}
 	wc := web.H2CConfig{
 		Port: c.Components.GiteaIntegration.Port,
 		SetupRoute: func(mux *http.ServeMux) {
-			mux.Handle(pbconnect.NewGiteaIntegrationServiceHandler(giteaIntegrationHandler))
+			mux.Handle(pbconnect.NewGiteaIntegrationServiceHandler(giteaIntegrationHandler,
+				connect.WithInterceptors(otelInterceptor)))
 		},
 	}
-	return &cmdgiteaintegration.APIServer{H2CServer: web.NewH2CServer(wc)}
+	return &cmdgiteaintegration.APIServer{H2CServer: web.NewH2CServer(wc)}, nil
 }
 
 func provideHealthCheckFunc(gen ssgen.GeneratorService) healthcheck.Func {
@@ -24,16 +24,20 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/dbmanager"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/git"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/notification"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/registry"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/webhook"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/alert"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/apiserver"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/applimit"
 	ubuilder "github.com/traPtitech/neoshowcase/pkg/usecase/builder"
 	buildermock "github.com/traPtitech/neoshowcase/pkg/usecase/builder/mock"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/cdservice"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/cleaner"
 	commitfetcher "github.com/traPtitech/neoshowcase/pkg/usecase/commit-fetcher"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/customdomain"
 	ugiteaintegration "github.com/traPtitech/neoshowcase/pkg/usecase/gitea-integration"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/healthcheck"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/logstream"
@@ -41,6 +45,8 @@ import (
 	ussgen "github.com/traPtitech/neoshowcase/pkg/usecase/ssgen"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/sshserver"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/systeminfo"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/tlscert"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/uptime"
 	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
 )
 
@@ -51,6 +57,12 @@ var providers = wire.NewSet(
 	cdservice.NewService,
 	certmanagerv1.NewForConfig,
 	cleaner.NewService,
+	uptime.NewService,
+	alert.NewService,
+	customdomain.NewService,
+	customdomain.NewVerifier,
+	tlscert.NewService,
+	provideDNSResolver,
 	commitfetcher.NewService,
 	dbmanager.NewMariaDBManager,
 	dbmanager.NewMongoDBManager,
@@ -60,6 +72,8 @@ var providers = wire.NewSet(
 	grpc.NewAPIServiceServer,
 	grpc.NewAuthInterceptor,
 	grpc.NewLogInterceptor,
+	grpc.NewLogExportHandler,
+	grpc.NewStatusHandler,
 	grpc.NewBuildpackHelperService,
 	provideBuildpackHelperClient,
 	grpc.NewCacheInterceptor,
@@ -75,9 +89,17 @@ var providers = wire.NewSet(
 	k8simpl.NewK8SBackend,
 	kubernetes.NewForConfig,
 	logstream.NewService,
+	notification.NewDispatcher,
 	repofetcher.NewService,
+	applimit.NewLimiter,
 	repository.New,
 	repository.NewApplicationRepository,
+	repository.NewApplicationEventRepository,
+	repository.NewWebsiteProbeRepository,
+	repository.NewAlertRepository,
+	repository.NewNotificationSubscriptionRepository,
+	repository.NewCustomDomainRepository,
+	repository.NewTLSCertificateRepository,
 	repository.NewArtifactRepository,
 	repository.NewRuntimeImageRepository,
 	repository.NewBuildRepository,
@@ -94,6 +116,7 @@ var providers = wire.NewSet(
 	ubuilder.NewService,
 	webhook.NewReceiver,
 	provideRepositoryPrivateKey,
+	provideTLSCertificateEncryptionKey,
 	domain.IntoPublicKey,
 	git.NewService,
 	registry.NewClient,
@@ -111,6 +134,7 @@ var providers = wire.NewSet(
 	provideControllerServer,
 	provideContainerLogger,
 	provideMetricsService,
+	provideOIDCProvider,
 	provideGatewayServer,
 	provideGiteaIntegrationConfig,
 	provideGiteaIntegrationServiceClient,
@@ -182,7 +206,8 @@ func NewController(c Config) (component, error) {
 func NewControllerDocker(c Config) (component, error) {
 	wire.Build(
 		providers,
-		wire.FieldsOf(new(ControllerConfig), "Port", "Docker", "SSH", "Webhook", "Metrics"),
+		wire.FieldsOf(new(ControllerConfig), "Port", "Docker", "SSH", "Webhook", "Metrics", "CrashLoop", "Uptime", "Alert", "Notification", "CustomDomain", "TLSCertificate"),
+		wire.FieldsOf(new(GatewayConfig), "Quota", "RateLimit"),
 		wire.Bind(new(domain.Backend), new(*dockerimpl.Backend)),
 		wire.Bind(new(component), new(*controller.Server)),
 		wire.Struct(new(controller.Server), "*"),
@@ -193,7 +218,8 @@ func NewControllerDocker(c Config) (component, error) {
 func NewControllerK8s(c Config) (component, error) {
 	wire.Build(
 		providers,
-		wire.FieldsOf(new(ControllerConfig), "Port", "K8s", "SSH", "Webhook", "Metrics"),
+		wire.FieldsOf(new(ControllerConfig), "Port", "K8s", "SSH", "Webhook", "Metrics", "CrashLoop", "Uptime", "Alert", "Notification", "CustomDomain", "TLSCertificate"),
+		wire.FieldsOf(new(GatewayConfig), "Quota", "RateLimit"),
 		wire.Bind(new(domain.Backend), new(*k8simpl.Backend)),
 		wire.Bind(new(component), new(*controller.Server)),
 		wire.Struct(new(controller.Server), "*"),
@@ -204,7 +230,7 @@ func NewControllerK8s(c Config) (component, error) {
 func NewGateway(c Config) (component, error) {
 	wire.Build(
 		providers,
-		wire.FieldsOf(new(GatewayConfig), "AvatarBaseURL", "AuthHeader", "Controller", "MariaDB", "MongoDB"),
+		wire.FieldsOf(new(GatewayConfig), "AvatarBaseURL", "AuthHeader", "Controller", "MariaDB", "MongoDB", "Quota", "RateLimit"),
 		wire.Bind(new(component), new(*gateway.Server)),
 		wire.Struct(new(gateway.Server), "*"),
 	)
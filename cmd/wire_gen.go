@@ -23,16 +23,20 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/dbmanager"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/git"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/notification"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/registry"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/webhook"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/alert"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/apiserver"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/applimit"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/builder"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/builder/mock"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/cdservice"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/cleaner"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/commit-fetcher"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/customdomain"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/gitea-integration"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/healthcheck"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/logstream"
@@ -40,6 +44,8 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/usecase/ssgen"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/sshserver"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/systeminfo"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/tlscert"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/uptime"
 	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
 	"github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/generated/clientset/versioned/typed/traefikio/v1alpha1"
 	"k8s.io/client-go/kubernetes"
@@ -70,7 +76,10 @@ func newBuilder(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
-	controllerBuilderServiceClient := provideControllerBuilderServiceClient(c, tokenAuthInterceptor)
+	controllerBuilderServiceClient, err := provideControllerBuilderServiceClient(c, tokenAuthInterceptor)
+	if err != nil {
+		return nil, err
+	}
 	componentsConfig := c.Components
 	mainBuilderConfig := componentsConfig.Builder
 	buildpackConfig := mainBuilderConfig.Buildpack
@@ -96,7 +105,10 @@ func newMockBuilder(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
-	controllerBuilderServiceClient := provideControllerBuilderServiceClient(c, tokenAuthInterceptor)
+	controllerBuilderServiceClient, err := provideControllerBuilderServiceClient(c, tokenAuthInterceptor)
+	if err != nil {
+		return nil, err
+	}
 	builderServiceMock := mock.NewBuilderServiceMock(controllerBuilderServiceClient)
 	server := &builder2.Server{
 		Buildkit: client,
@@ -151,8 +163,15 @@ func NewControllerDocker(c Config) (component, error) {
 	}
 	service := systeminfo.NewService(serviceConfig, backend, applicationRepository, sshConfig, publicKeys)
 	gitRepositoryRepository := repository.NewGitRepositoryRepository(db)
-	buildRepository := repository.NewBuildRepository(db)
 	environmentRepository := repository.NewEnvironmentRepository(db)
+	applicationEventRepository := repository.NewApplicationEventRepository(db)
+	customDomainRepository := repository.NewCustomDomainRepository(db)
+	userRepository := repository.NewUserRepository(db)
+	gatewayConfig := componentsConfig.Gateway
+	quota := gatewayConfig.Quota
+	websiteRateLimitConfig := gatewayConfig.RateLimit
+	limiter := applimit.NewLimiter(applicationRepository, userRepository, quota, websiteRateLimitConfig)
+	buildRepository := repository.NewBuildRepository(db)
 	logstreamService := logstream.NewService()
 	storageConfig := c.Storage
 	storage, err := provideStorage(storageConfig)
@@ -161,13 +180,32 @@ func NewControllerDocker(c Config) (component, error) {
 	}
 	artifactRepository := repository.NewArtifactRepository(db)
 	runtimeImageRepository := repository.NewRuntimeImageRepository(db)
+	notificationSubscriptionRepository := repository.NewNotificationSubscriptionRepository(db)
+	dnsResolver := provideDNSResolver()
+	notificationConfig := controllerConfig.Notification
+	notifier, err := notification.NewDispatcher(notificationSubscriptionRepository, dnsResolver, notificationConfig)
+	if err != nil {
+		return nil, err
+	}
 	controllerMetrics := observability.NewControllerMetrics()
-	controllerBuilderService := grpc.NewControllerBuilderService(logstreamService, privateKey, imageConfig, storage, applicationRepository, artifactRepository, runtimeImageRepository, buildRepository, environmentRepository, gitRepositoryRepository, controllerMetrics)
+	controllerBuilderService := grpc.NewControllerBuilderService(logstreamService, privateKey, imageConfig, storage, applicationRepository, artifactRepository, runtimeImageRepository, buildRepository, environmentRepository, gitRepositoryRepository, applicationEventRepository, notifier, controllerMetrics)
 	websiteRepository := repository.NewWebsiteRepository(db)
+	tlsCertificateEncryptionKey, err := provideTLSCertificateEncryptionKey(c)
+	if err != nil {
+		return nil, err
+	}
+	tlsCertificateRepository, err := repository.NewTLSCertificateRepository(db, tlsCertificateEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
 	controllerSSGenService := grpc.NewControllerSSGenService()
-	appDeployHelper := cdservice.NewAppDeployHelper(cluster, backend, applicationRepository, buildRepository, environmentRepository, websiteRepository, controllerSSGenService, imageConfig)
-	containerStateMutator := cdservice.NewContainerStateMutator(cluster, applicationRepository, backend)
-	cdService, err := cdservice.NewService(cluster, controllerPort, applicationRepository, buildRepository, environmentRepository, backend, controllerBuilderService, appDeployHelper, containerStateMutator, controllerMetrics)
+	appDeployHelper := cdservice.NewAppDeployHelper(cluster, backend, applicationRepository, buildRepository, environmentRepository, websiteRepository, customDomainRepository, tlsCertificateRepository, controllerSSGenService, imageConfig)
+	crashLoopConfig := controllerConfig.CrashLoop
+	containerStateMutator, err := cdservice.NewContainerStateMutator(cluster, applicationRepository, applicationEventRepository, backend, notifier, crashLoopConfig)
+	if err != nil {
+		return nil, err
+	}
+	cdService, err := cdservice.NewService(cluster, controllerPort, applicationRepository, buildRepository, environmentRepository, applicationEventRepository, backend, controllerBuilderService, appDeployHelper, containerStateMutator, controllerMetrics)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +215,7 @@ func NewControllerDocker(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
-	repofetcherService, err := repofetcher.NewService(cluster, applicationRepository, gitRepositoryRepository, cdService, commitfetcherService, gitService)
+	repofetcherService, err := repofetcher.NewService(cluster, applicationRepository, gitRepositoryRepository, environmentRepository, applicationEventRepository, customDomainRepository, limiter, backend, cdService, commitfetcherService, gitService, controllerMetrics)
 	if err != nil {
 		return nil, err
 	}
@@ -191,11 +229,13 @@ func NewControllerDocker(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
-	userRepository := repository.NewUserRepository(db)
 	sshServer := sshserver.NewSSHServer(sshConfig, publicKeys, backend, applicationRepository, userRepository)
 	receiverConfig := controllerConfig.Webhook
-	giteaIntegrationServiceClient := provideGiteaIntegrationServiceClient(c)
-	receiver := webhook.NewReceiver(receiverConfig, gitRepositoryRepository, repofetcherService, giteaIntegrationServiceClient)
+	giteaIntegrationServiceClient, err := provideGiteaIntegrationServiceClient(c)
+	if err != nil {
+		return nil, err
+	}
+	receiver := webhook.NewReceiver(receiverConfig, gitRepositoryRepository, repofetcherService, giteaIntegrationServiceClient, controllerMetrics)
 	metricsServerConfig := controllerConfig.Metrics
 	metricsServer := observability.NewMetricsServer(metricsServerConfig)
 	registryClient := registry.NewClient(imageConfig)
@@ -203,6 +243,37 @@ func NewControllerDocker(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
+	websiteProbeRepository := repository.NewWebsiteProbeRepository(db)
+	uptimeConfig := controllerConfig.Uptime
+	uptimeService, err := uptime.NewService(cluster, applicationRepository, websiteProbeRepository, notifier, uptimeConfig)
+	if err != nil {
+		return nil, err
+	}
+	alertRepository := repository.NewAlertRepository(db)
+	metricsService, err := provideMetricsService(c)
+	if err != nil {
+		return nil, err
+	}
+	containerLogger, err := provideContainerLogger(c)
+	if err != nil {
+		return nil, err
+	}
+	alertConfig := controllerConfig.Alert
+	alertService, err := alert.NewService(cluster, applicationRepository, alertRepository, metricsService, containerLogger, notifier, alertConfig)
+	if err != nil {
+		return nil, err
+	}
+	customDomainVerifier := customdomain.NewVerifier(dnsResolver)
+	customdomainConfig := controllerConfig.CustomDomain
+	customdomainService, err := customdomain.NewService(cluster, customDomainRepository, customDomainVerifier, customdomainConfig)
+	if err != nil {
+		return nil, err
+	}
+	tlscertConfig := controllerConfig.TLSCertificate
+	tlscertService, err := tlscert.NewService(cluster, applicationRepository, tlsCertificateRepository, notifier, tlscertConfig)
+	if err != nil {
+		return nil, err
+	}
 	server := &controller.Server{
 		APIServer:      apiServer,
 		DB:             db,
@@ -215,6 +286,10 @@ func NewControllerDocker(c Config) (component, error) {
 		CommitFetcher:  commitfetcherService,
 		FetcherService: repofetcherService,
 		CleanerService: cleanerService,
+		UptimeService:  uptimeService,
+		AlertService:   alertService,
+		DomainService:  customdomainService,
+		TLSCertService: tlscertService,
 	}
 	return server, nil
 }
@@ -267,8 +342,15 @@ func NewControllerK8s(c Config) (component, error) {
 	}
 	service := systeminfo.NewService(serviceConfig, backend, applicationRepository, sshConfig, publicKeys)
 	gitRepositoryRepository := repository.NewGitRepositoryRepository(db)
-	buildRepository := repository.NewBuildRepository(db)
 	environmentRepository := repository.NewEnvironmentRepository(db)
+	applicationEventRepository := repository.NewApplicationEventRepository(db)
+	customDomainRepository := repository.NewCustomDomainRepository(db)
+	userRepository := repository.NewUserRepository(db)
+	gatewayConfig := componentsConfig.Gateway
+	quota := gatewayConfig.Quota
+	websiteRateLimitConfig := gatewayConfig.RateLimit
+	limiter := applimit.NewLimiter(applicationRepository, userRepository, quota, websiteRateLimitConfig)
+	buildRepository := repository.NewBuildRepository(db)
 	logstreamService := logstream.NewService()
 	imageConfig := c.Image
 	storageConfig := c.Storage
@@ -278,13 +360,32 @@ func NewControllerK8s(c Config) (component, error) {
 	}
 	artifactRepository := repository.NewArtifactRepository(db)
 	runtimeImageRepository := repository.NewRuntimeImageRepository(db)
+	notificationSubscriptionRepository := repository.NewNotificationSubscriptionRepository(db)
+	dnsResolver := provideDNSResolver()
+	notificationConfig := controllerConfig.Notification
+	notifier, err := notification.NewDispatcher(notificationSubscriptionRepository, dnsResolver, notificationConfig)
+	if err != nil {
+		return nil, err
+	}
 	controllerMetrics := observability.NewControllerMetrics()
-	controllerBuilderService := grpc.NewControllerBuilderService(logstreamService, privateKey, imageConfig, storage, applicationRepository, artifactRepository, runtimeImageRepository, buildRepository, environmentRepository, gitRepositoryRepository, controllerMetrics)
+	controllerBuilderService := grpc.NewControllerBuilderService(logstreamService, privateKey, imageConfig, storage, applicationRepository, artifactRepository, runtimeImageRepository, buildRepository, environmentRepository, gitRepositoryRepository, applicationEventRepository, notifier, controllerMetrics)
 	websiteRepository := repository.NewWebsiteRepository(db)
+	tlsCertificateEncryptionKey, err := provideTLSCertificateEncryptionKey(c)
+	if err != nil {
+		return nil, err
+	}
+	tlsCertificateRepository, err := repository.NewTLSCertificateRepository(db, tlsCertificateEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
 	controllerSSGenService := grpc.NewControllerSSGenService()
-	appDeployHelper := cdservice.NewAppDeployHelper(cluster, backend, applicationRepository, buildRepository, environmentRepository, websiteRepository, controllerSSGenService, imageConfig)
-	containerStateMutator := cdservice.NewContainerStateMutator(cluster, applicationRepository, backend)
-	cdService, err := cdservice.NewService(cluster, controllerPort, applicationRepository, buildRepository, environmentRepository, backend, controllerBuilderService, appDeployHelper, containerStateMutator, controllerMetrics)
+	appDeployHelper := cdservice.NewAppDeployHelper(cluster, backend, applicationRepository, buildRepository, environmentRepository, websiteRepository, customDomainRepository, tlsCertificateRepository, controllerSSGenService, imageConfig)
+	crashLoopConfig := controllerConfig.CrashLoop
+	containerStateMutator, err := cdservice.NewContainerStateMutator(cluster, applicationRepository, applicationEventRepository, backend, notifier, crashLoopConfig)
+	if err != nil {
+		return nil, err
+	}
+	cdService, err := cdservice.NewService(cluster, controllerPort, applicationRepository, buildRepository, environmentRepository, applicationEventRepository, backend, controllerBuilderService, appDeployHelper, containerStateMutator, controllerMetrics)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +395,7 @@ func NewControllerK8s(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
-	repofetcherService, err := repofetcher.NewService(cluster, applicationRepository, gitRepositoryRepository, cdService, commitfetcherService, gitService)
+	repofetcherService, err := repofetcher.NewService(cluster, applicationRepository, gitRepositoryRepository, environmentRepository, applicationEventRepository, customDomainRepository, limiter, backend, cdService, commitfetcherService, gitService, controllerMetrics)
 	if err != nil {
 		return nil, err
 	}
@@ -308,11 +409,13 @@ func NewControllerK8s(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
-	userRepository := repository.NewUserRepository(db)
 	sshServer := sshserver.NewSSHServer(sshConfig, publicKeys, backend, applicationRepository, userRepository)
 	receiverConfig := controllerConfig.Webhook
-	giteaIntegrationServiceClient := provideGiteaIntegrationServiceClient(c)
-	receiver := webhook.NewReceiver(receiverConfig, gitRepositoryRepository, repofetcherService, giteaIntegrationServiceClient)
+	giteaIntegrationServiceClient, err := provideGiteaIntegrationServiceClient(c)
+	if err != nil {
+		return nil, err
+	}
+	receiver := webhook.NewReceiver(receiverConfig, gitRepositoryRepository, repofetcherService, giteaIntegrationServiceClient, controllerMetrics)
 	metricsServerConfig := controllerConfig.Metrics
 	metricsServer := observability.NewMetricsServer(metricsServerConfig)
 	registryClient := registry.NewClient(imageConfig)
@@ -320,6 +423,37 @@ func NewControllerK8s(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
+	websiteProbeRepository := repository.NewWebsiteProbeRepository(db)
+	uptimeConfig := controllerConfig.Uptime
+	uptimeService, err := uptime.NewService(cluster, applicationRepository, websiteProbeRepository, notifier, uptimeConfig)
+	if err != nil {
+		return nil, err
+	}
+	alertRepository := repository.NewAlertRepository(db)
+	metricsService, err := provideMetricsService(c)
+	if err != nil {
+		return nil, err
+	}
+	containerLogger, err := provideContainerLogger(c)
+	if err != nil {
+		return nil, err
+	}
+	alertConfig := controllerConfig.Alert
+	alertService, err := alert.NewService(cluster, applicationRepository, alertRepository, metricsService, containerLogger, notifier, alertConfig)
+	if err != nil {
+		return nil, err
+	}
+	customDomainVerifier := customdomain.NewVerifier(dnsResolver)
+	customdomainConfig := controllerConfig.CustomDomain
+	customdomainService, err := customdomain.NewService(cluster, customDomainRepository, customDomainVerifier, customdomainConfig)
+	if err != nil {
+		return nil, err
+	}
+	tlscertConfig := controllerConfig.TLSCertificate
+	tlscertService, err := tlscert.NewService(cluster, applicationRepository, tlsCertificateRepository, notifier, tlscertConfig)
+	if err != nil {
+		return nil, err
+	}
 	server := &controller.Server{
 		APIServer:      apiServer,
 		DB:             db,
@@ -332,6 +466,10 @@ func NewControllerK8s(c Config) (component, error) {
 		CommitFetcher:  commitfetcherService,
 		FetcherService: repofetcherService,
 		CleanerService: cleanerService,
+		UptimeService:  uptimeService,
+		AlertService:   alertService,
+		DomainService:  customdomainService,
+		TLSCertService: tlscertService,
 	}
 	return server, nil
 }
@@ -345,6 +483,19 @@ func NewGateway(c Config) (component, error) {
 	artifactRepository := repository.NewArtifactRepository(db)
 	runtimeImageRepository := repository.NewRuntimeImageRepository(db)
 	applicationRepository := repository.NewApplicationRepository(db)
+	applicationEventRepository := repository.NewApplicationEventRepository(db)
+	websiteProbeRepository := repository.NewWebsiteProbeRepository(db)
+	alertRepository := repository.NewAlertRepository(db)
+	notificationSubscriptionRepository := repository.NewNotificationSubscriptionRepository(db)
+	customDomainRepository := repository.NewCustomDomainRepository(db)
+	tlsCertificateEncryptionKey, err := provideTLSCertificateEncryptionKey(c)
+	if err != nil {
+		return nil, err
+	}
+	tlsCertificateRepository, err := repository.NewTLSCertificateRepository(db, tlsCertificateEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
 	buildRepository := repository.NewBuildRepository(db)
 	environmentRepository := repository.NewEnvironmentRepository(db)
 	gitRepositoryRepository := repository.NewGitRepositoryRepository(db)
@@ -375,6 +526,8 @@ func NewGateway(c Config) (component, error) {
 	if err != nil {
 		return nil, err
 	}
+	dnsResolver := provideDNSResolver()
+	customDomainVerifier := customdomain.NewVerifier(dnsResolver)
 	controllerServiceClientConfig := gatewayConfig.Controller
 	controllerServiceClient, err := grpc.NewControllerServiceClient(controllerServiceClientConfig)
 	if err != nil {
@@ -391,17 +544,26 @@ func NewGateway(c Config) (component, error) {
 		return nil, err
 	}
 	gitService := git.NewService(publicKeys)
-	service, err := apiserver.NewService(artifactRepository, runtimeImageRepository, applicationRepository, buildRepository, environmentRepository, gitRepositoryRepository, repositoryCommitRepository, userRepository, storage, mariaDBManager, mongoDBManager, metricsService, containerLogger, controllerServiceClient, registryClient, imageConfig, gitService)
+	quota := gatewayConfig.Quota
+	websiteRateLimitConfig := gatewayConfig.RateLimit
+	limiter := applimit.NewLimiter(applicationRepository, userRepository, quota, websiteRateLimitConfig)
+	service, err := apiserver.NewService(artifactRepository, runtimeImageRepository, applicationRepository, applicationEventRepository, websiteProbeRepository, alertRepository, notificationSubscriptionRepository, customDomainRepository, tlsCertificateRepository, buildRepository, environmentRepository, gitRepositoryRepository, repositoryCommitRepository, userRepository, storage, mariaDBManager, mongoDBManager, metricsService, containerLogger, customDomainVerifier, controllerServiceClient, registryClient, imageConfig, gitService, limiter)
 	if err != nil {
 		return nil, err
 	}
 	avatarBaseURL := gatewayConfig.AvatarBaseURL
 	apiServiceHandler := grpc.NewAPIServiceServer(service, avatarBaseURL)
+	logExportHandler := grpc.NewLogExportHandler(service)
+	statusHandler := grpc.NewStatusHandler(service)
+	provider, err := provideOIDCProvider(c)
+	if err != nil {
+		return nil, err
+	}
 	authHeader := gatewayConfig.AuthHeader
-	authInterceptor := grpc.NewAuthInterceptor(userRepository, authHeader)
+	authInterceptor := grpc.NewAuthInterceptor(userRepository, authHeader, provider)
 	logInterceptor := grpc.NewLogInterceptor()
 	cacheInterceptor := grpc.NewCacheInterceptor()
-	apiServer, err := provideGatewayServer(c, apiServiceHandler, authInterceptor, logInterceptor, cacheInterceptor)
+	apiServer, err := provideGatewayServer(c, apiServiceHandler, logExportHandler, statusHandler, provider, authInterceptor, logInterceptor, cacheInterceptor)
 	if err != nil {
 		return nil, err
 	}
@@ -427,7 +589,10 @@ func NewGiteaIntegration(c Config) (component, error) {
 		return nil, err
 	}
 	giteaIntegrationService := grpc.NewGiteaIntegrationService(integration)
-	apiServer := provideGiteaIntegrationAPIServer(c, giteaIntegrationService)
+	apiServer, err := provideGiteaIntegrationAPIServer(c, giteaIntegrationService)
+	if err != nil {
+		return nil, err
+	}
 	server := &giteaintegration2.Server{
 		Integration: integration,
 		DB:          db,
@@ -445,7 +610,10 @@ func NewSSGen(c Config) (component, error) {
 	componentsConfig := c.Components
 	ssGenConfig := componentsConfig.SSGen
 	controllerServiceClientConfig := ssGenConfig.Controller
-	controllerSSGenServiceClient := grpc.NewControllerSSGenServiceClient(controllerServiceClientConfig)
+	controllerSSGenServiceClient, err := grpc.NewControllerSSGenServiceClient(controllerServiceClientConfig)
+	if err != nil {
+		return nil, err
+	}
 	applicationRepository := repository.NewApplicationRepository(db)
 	buildRepository := repository.NewBuildRepository(db)
 	storageConfig := c.Storage
@@ -473,8 +641,9 @@ func NewSSGen(c Config) (component, error) {
 
 // wire.go:
 
-var providers = wire.NewSet(apiserver.NewService, cdservice.NewAppDeployHelper, cdservice.NewContainerStateMutator, cdservice.NewService, versioned.NewForConfig, cleaner.NewService, commitfetcher.NewService, dbmanager.NewMariaDBManager, dbmanager.NewMongoDBManager, dockerimpl.NewClientFromEnv, dockerimpl.NewDockerBackend, giteaintegration.NewIntegration, grpc.NewAPIServiceServer, grpc.NewAuthInterceptor, grpc.NewLogInterceptor, grpc.NewBuildpackHelperService, provideBuildpackHelperClient, grpc.NewCacheInterceptor, grpc.NewControllerService, grpc.NewControllerServiceClient, grpc.NewControllerBuilderService, grpc.NewGiteaIntegrationService, provideTokenAuthInterceptor,
-	provideControllerBuilderServiceClient, grpc.NewControllerSSGenService, grpc.NewControllerSSGenServiceClient, healthcheck.NewServer, k8simpl.NewK8SBackend, kubernetes.NewForConfig, logstream.NewService, repofetcher.NewService, repository.New, repository.NewApplicationRepository, repository.NewArtifactRepository, repository.NewRuntimeImageRepository, repository.NewBuildRepository, repository.NewEnvironmentRepository, repository.NewGitRepositoryRepository, repository.NewRepositoryCommitRepository, repository.NewUserRepository, repository.NewWebsiteRepository, rest.InClusterConfig, v1alpha1.NewForConfig, ssgen.NewGeneratorService, sshserver.NewSSHServer, systeminfo.NewService, builder.NewService, webhook.NewReceiver, provideRepositoryPrivateKey, domain.IntoPublicKey, git.NewService, registry.NewClient, observability.NewMetricsServer, observability.NewControllerMetrics, provideStorage,
+var providers = wire.NewSet(apiserver.NewService, cdservice.NewAppDeployHelper, cdservice.NewContainerStateMutator, cdservice.NewService, versioned.NewForConfig, cleaner.NewService, uptime.NewService, alert.NewService, customdomain.NewService, customdomain.NewVerifier, tlscert.NewService, provideDNSResolver, commitfetcher.NewService, dbmanager.NewMariaDBManager, dbmanager.NewMongoDBManager, dockerimpl.NewClientFromEnv, dockerimpl.NewDockerBackend, giteaintegration.NewIntegration, grpc.NewAPIServiceServer, grpc.NewAuthInterceptor, grpc.NewLogInterceptor, grpc.NewLogExportHandler, grpc.NewStatusHandler, grpc.NewBuildpackHelperService, provideBuildpackHelperClient, grpc.NewCacheInterceptor, grpc.NewControllerService, grpc.NewControllerServiceClient, grpc.NewControllerBuilderService, grpc.NewGiteaIntegrationService, provideTokenAuthInterceptor,
+	provideControllerBuilderServiceClient, grpc.NewControllerSSGenService, grpc.NewControllerSSGenServiceClient, healthcheck.NewServer, k8simpl.NewK8SBackend, kubernetes.NewForConfig, logstream.NewService, notification.NewDispatcher, repofetcher.NewService, applimit.NewLimiter, repository.New, repository.NewApplicationRepository, repository.NewApplicationEventRepository, repository.NewWebsiteProbeRepository, repository.NewAlertRepository, repository.NewNotificationSubscriptionRepository, repository.NewCustomDomainRepository, repository.NewTLSCertificateRepository, repository.NewArtifactRepository, repository.NewRuntimeImageRepository, repository.NewBuildRepository, repository.NewEnvironmentRepository, repository.NewGitRepositoryRepository, repository.NewRepositoryCommitRepository, repository.NewUserRepository, repository.NewWebsiteRepository, rest.InClusterConfig, v1alpha1.NewForConfig, ssgen.NewGeneratorService, sshserver.NewSSHServer, systeminfo.NewService, builder.NewService, webhook.NewReceiver, provideRepositoryPrivateKey,
+	provideTLSCertificateEncryptionKey, domain.IntoPublicKey, git.NewService, registry.NewClient, observability.NewMetricsServer, observability.NewControllerMetrics, provideStorage,
 	provideAuthDevServer,
 	provideBuildpackHelperServer, buildpack.NewBuildpackBackend, provideDiscoverer, discovery.NewCluster, provideBuilderConfig,
 	provideBuildkitClient,
@@ -482,6 +651,7 @@ var providers = wire.NewSet(apiserver.NewService, cdservice.NewAppDeployHelper,
 	provideControllerServer,
 	provideContainerLogger,
 	provideMetricsService,
+	provideOIDCProvider,
 	provideGatewayServer,
 	provideGiteaIntegrationConfig,
 	provideGiteaIntegrationServiceClient,
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file neoshowcase/protobuf/gateway.proto.
  */
 export const file_neoshowcase_protobuf_gateway: GenFile = /*@__PURE__*/
-  fileDesc("CiJuZW9zaG93Y2FzZS9wcm90b2J1Zi9nYXRld2F5LnByb3RvEhRuZW9zaG93Y2FzZS5wcm90b2J1ZiIlCgdTU0hJbmZvEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBSJpCg9BdmFpbGFibGVEb21haW4SDgoGZG9tYWluGAEgASgJEhcKD2V4Y2x1ZGVfZG9tYWlucxgCIAMoCRIWCg5hdXRoX2F2YWlsYWJsZRgDIAEoCBIVCg1hbHJlYWR5X2JvdW5kGAQgASgIInYKDUF2YWlsYWJsZVBvcnQSEgoKc3RhcnRfcG9ydBgBIAEoBRIQCghlbmRfcG9ydBgCIAEoBRI/Cghwcm90b2NvbBgDIAEoDjItLm5lb3Nob3djYXNlLnByb3RvYnVmLlBvcnRQdWJsaWNhdGlvblByb3RvY29sIisKDkFkZGl0aW9uYWxMaW5rEgwKBG5hbWUYASABKAkSCwoDdXJsGAIgASgJIpsCCgpTeXN0ZW1JbmZvEhIKCnB1YmxpY19rZXkYASABKAkSKgoDc3NoGAIgASgLMh0ubmVvc2hvd2Nhc2UucHJvdG9idWYuU1NISW5mbxI2Cgdkb21haW5zGAMgAygLMiUubmVvc2hvd2Nhc2UucHJvdG9idWYuQXZhaWxhYmxlRG9tYWluEjIKBXBvcnRzGAQgAygLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuQXZhaWxhYmxlUG9ydBI+ChBhZGRpdGlvbmFsX2xpbmtzGAUgAygLMiQubmVvc2hvd2Nhc2UucHJvdG9idWYuQWRkaXRpb25hbExpbmsSDwoHdmVyc2lvbhgGIAEoCRIQCghyZXZpc2lvbhgHIAEoCSJDCgRVc2VyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDQoFYWRtaW4YAyABKAgSEgoKYXZhdGFyX3VybBgEIAEoCSJ4CgdVc2VyS2V5EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRIMCgRuYW1lGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIsYBCgpSZXBvc2l0b3J5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSCwoDdXJsGAMgASgJEhAKCGh0bWxfdXJsGAQgASgJEkAKC2F1dGhfbWV0aG9kGAUgASgOMisubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeS5BdXRoTWV0aG9kEhEKCW93bmVyX2lkcxgGIAMoCSIqCgpBdXRoTWV0aG9kEggKBE5PTkUQABIJCgVCQVNJQxABEgcKA1NTSBACInMKDFNpbXBsZUNvbW1pdBIMCgRoYXNoGAEgASgJEhMKC2F1dGhvcl9uYW1lGAIgASgJEi8KC2NvbW1pdF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdtZXNzYWdlGAQgASgJIrIBChJBdXRvU2h1dGRvd25Db25maWcSDwoHZW5hYmxlZBgBIAEoCBJJCgdzdGFydHVwGAIgASgOMjgubmVvc2hvd2Nhc2UucHJvdG9idWYuQXV0b1NodXRkb3duQ29uZmlnLlN0YXJ0dXBCZWhhdmlvciJACg9TdGFydHVwQmVoYXZpb3ISDQoJVU5ERUZJTkVEEAASEAoMTE9BRElOR19QQUdFEAESDAoIQkxPQ0tJTkcQAiKfAQoNUnVudGltZUNvbmZpZxITCgt1c2VfbWFyaWFkYhgBIAEoCBITCgt1c2VfbW9uZ29kYhgCIAEoCBISCgplbnRyeXBvaW50GAMgASgJEg8KB2NvbW1hbmQYBCABKAkSPwoNYXV0b19zaHV0ZG93bhgFIAEoCzIoLm5lb3Nob3djYXNlLnByb3RvYnVmLkF1dG9TaHV0ZG93bkNvbmZpZyJrChtCdWlsZENvbmZpZ1J1bnRpbWVCdWlsZHBhY2sSOwoOcnVudGltZV9jb25maWcYASABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5SdW50aW1lQ29uZmlnEg8KB2NvbnRleHQYAiABKAkiewoVQnVpbGRDb25maWdSdW50aW1lQ21kEjsKDnJ1bnRpbWVfY29uZmlnGAEgASgLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuUnVudGltZUNvbmZpZxISCgpiYXNlX2ltYWdlGAIgASgJEhEKCWJ1aWxkX2NtZBgDIAEoCSKFAQocQnVpbGRDb25maWdSdW50aW1lRG9ja2VyZmlsZRI7Cg5ydW50aW1lX2NvbmZpZxgBIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLlJ1bnRpbWVDb25maWcSFwoPZG9ja2VyZmlsZV9uYW1lGAIgASgJEg8KB2NvbnRleHQYAyABKAkiMgoMU3RhdGljQ29uZmlnEhUKDWFydGlmYWN0X3BhdGgYASABKAkSCwoDc3BhGAIgASgIImgKGkJ1aWxkQ29uZmlnU3RhdGljQnVpbGRwYWNrEjkKDXN0YXRpY19jb25maWcYASABKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5TdGF0aWNDb25maWcSDwoHY29udGV4dBgCIAEoCSJ4ChRCdWlsZENvbmZpZ1N0YXRpY0NtZBI5Cg1zdGF0aWNfY29uZmlnGAEgASgLMiIubmVvc2hvd2Nhc2UucHJvdG9idWYuU3RhdGljQ29uZmlnEhIKCmJhc2VfaW1hZ2UYAiABKAkSEQoJYnVpbGRfY21kGAMgASgJIoIBChtCdWlsZENvbmZpZ1N0YXRpY0RvY2tlcmZpbGUSOQoNc3RhdGljX2NvbmZpZxgBIAEoCzIiLm5lb3Nob3djYXNlLnByb3RvYnVmLlN0YXRpY0NvbmZpZxIXCg9kb2NrZXJmaWxlX25hbWUYAiABKAkSDwoHY29udGV4dBgDIAEoCSLpAwoRQXBwbGljYXRpb25Db25maWcSTgoRcnVudGltZV9idWlsZHBhY2sYASABKAsyMS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZENvbmZpZ1J1bnRpbWVCdWlsZHBhY2tIABJCCgtydW50aW1lX2NtZBgCIAEoCzIrLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkQ29uZmlnUnVudGltZUNtZEgAElAKEnJ1bnRpbWVfZG9ja2VyZmlsZRgDIAEoCzIyLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkQ29uZmlnUnVudGltZURvY2tlcmZpbGVIABJMChBzdGF0aWNfYnVpbGRwYWNrGAQgASgLMjAubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRDb25maWdTdGF0aWNCdWlsZHBhY2tIABJACgpzdGF0aWNfY21kGAUgASgLMioubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRDb25maWdTdGF0aWNDbWRIABJOChFzdGF0aWNfZG9ja2VyZmlsZRgGIAEoCzIxLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkQ29uZmlnU3RhdGljRG9ja2VyZmlsZUgAQg4KDGJ1aWxkX2NvbmZpZyK/AQoHV2Vic2l0ZRIKCgJpZBgBIAEoCRIMCgRmcWRuGAIgASgJEhMKC3BhdGhfcHJlZml4GAMgASgJEhQKDHN0cmlwX3ByZWZpeBgEIAEoCBINCgVodHRwcxgFIAEoCBILCgNoMmMYBiABKAgSEQoJaHR0cF9wb3J0GAcgASgFEkAKDmF1dGhlbnRpY2F0aW9uGAggASgOMigubmVvc2hvd2Nhc2UucHJvdG9idWYuQXV0aGVudGljYXRpb25UeXBlIoMBCg9Qb3J0UHVibGljYXRpb24SFQoNaW50ZXJuZXRfcG9ydBgBIAEoBRIYChBhcHBsaWNhdGlvbl9wb3J0GAIgASgFEj8KCHByb3RvY29sGAMgASgOMi0ubmVvc2hvd2Nhc2UucHJvdG9idWYuUG9ydFB1YmxpY2F0aW9uUHJvdG9jb2wiiwYKC0FwcGxpY2F0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFQoNcmVwb3NpdG9yeV9pZBgDIAEoCRIQCghyZWZfbmFtZRgEIAEoCRIOCgZjb21taXQYBSABKAkSNQoLZGVwbG95X3R5cGUYBiABKA4yIC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5EZXBsb3lUeXBlEg8KB3J1bm5pbmcYByABKAgSQwoJY29udGFpbmVyGAggASgOMjAubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb24uQ29udGFpbmVyU3RhdGUSGQoRY29udGFpbmVyX21lc3NhZ2UYCSABKAkSFQoNY3VycmVudF9idWlsZBgKIAEoCRIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI3CgZjb25maWcYDSABKAsyJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbkNvbmZpZxIvCgh3ZWJzaXRlcxgOIAMoCzIdLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGUSQAoRcG9ydF9wdWJsaWNhdGlvbnMYDyADKAsyJS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Qb3J0UHVibGljYXRpb24SEQoJb3duZXJfaWRzGBAgAygJEkMKE2xhdGVzdF9idWlsZF9zdGF0dXMYESABKA4yIS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZFN0YXR1c0gAiAEBIm4KDkNvbnRhaW5lclN0YXRlEgsKB01JU1NJTkcQABIMCghTVEFSVElORxABEg4KClJFU1RBUlRJTkcQAhILCgdSVU5OSU5HEAMSCgoGRVhJVEVEEAQSCwoHRVJST1JFRBAFEgsKB1VOS05PV04QBkIWChRfbGF0ZXN0X2J1aWxkX3N0YXR1cyJXChFBcHBsaWNhdGlvbkVudlZhchIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRILCgNrZXkYAiABKAkSDQoFdmFsdWUYAyABKAkSDgoGc3lzdGVtGAQgASgIIlAKEkFwcGxpY2F0aW9uRW52VmFycxI6Cgl2YXJpYWJsZXMYASADKAsyJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbkVudlZhciKtAQoIQXJ0aWZhY3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIQCghidWlsZF9pZBgDIAEoCRIMCgRzaXplGAQgASgDEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjcKCmRlbGV0ZWRfYXQYBiABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5OdWxsVGltZXN0YW1wIjQKD0FydGlmYWN0Q29udGVudBIQCghmaWxlbmFtZRgBIAEoCRIPCgdjb250ZW50GAIgASgMImoKDFJ1bnRpbWVJbWFnZRIKCgJpZBgBIAEoCRIQCghidWlsZF9pZBgCIAEoCRIMCgRzaXplGAMgASgDEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIikKEEF2YWlsYWJsZU1ldHJpY3MSFQoNbWV0cmljc19uYW1lcxgBIAMoCSJMChFBcHBsaWNhdGlvbk1ldHJpYxIoCgR0aW1lGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV2YWx1ZRgCIAEoASJOChJBcHBsaWNhdGlvbk1ldHJpY3MSOAoHbWV0cmljcxgBIAMoCzInLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uTWV0cmljIkoKEUFwcGxpY2F0aW9uT3V0cHV0EigKBHRpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgsKA2xvZxgCIAEoCSJOChJBcHBsaWNhdGlvbk91dHB1dHMSOAoHb3V0cHV0cxgBIAMoCzInLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uT3V0cHV0IuEDCgVCdWlsZBIKCgJpZBgBIAEoCRIWCg5hcHBsaWNhdGlvbl9pZBgCIAEoCRIOCgZjb21taXQYAyABKAkSMQoGc3RhdHVzGAQgASgOMiEubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRTdGF0dXMSLQoJcXVldWVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI3CgpzdGFydGVkX2F0GAYgASgLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuTnVsbFRpbWVzdGFtcBI3Cgp1cGRhdGVkX2F0GAcgASgLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuTnVsbFRpbWVzdGFtcBI4CgtmaW5pc2hlZF9hdBgIIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLk51bGxUaW1lc3RhbXASEQoJcmV0cmlhYmxlGAkgASgIEjEKCWFydGlmYWN0cxgKIAMoCzIeLm5lb3Nob3djYXNlLnByb3RvYnVmLkFydGlmYWN0Ej4KDXJ1bnRpbWVfaW1hZ2UYCyABKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5SdW50aW1lSW1hZ2VIAIgBAUIQCg5fcnVudGltZV9pbWFnZSIXCghCdWlsZExvZxILCgNsb2cYASABKAwiKgoGR2l0UmVmEhAKCHJlZl9uYW1lGAEgASgJEg4KBmNvbW1pdBgCIAEoCSI9ChdHZW5lcmF0ZUtleVBhaXJSZXNwb25zZRIOCgZrZXlfaWQYASABKAkSEgoKcHVibGljX2tleRgCIAEoCSI9ChBHZXRVc2Vyc1Jlc3BvbnNlEikKBXVzZXJzGAEgAygLMhoubmVvc2hvd2Nhc2UucHJvdG9idWYuVXNlciJCChNHZXRVc2VyS2V5c1Jlc3BvbnNlEisKBGtleXMYASADKAsyHS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Vc2VyS2V5IjgKFENyZWF0ZVVzZXJLZXlSZXF1ZXN0EhIKCnB1YmxpY19rZXkYASABKAkSDAoEbmFtZRgCIAEoCSImChREZWxldGVVc2VyS2V5UmVxdWVzdBIOCgZrZXlfaWQYASABKAkiPwoZQ3JlYXRlUmVwb3NpdG9yeUF1dGhCYXNpYxIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIpChdDcmVhdGVSZXBvc2l0b3J5QXV0aFNTSBIOCgZrZXlfaWQYASABKAkixgEKFENyZWF0ZVJlcG9zaXRvcnlBdXRoEiYKBG5vbmUYASABKAsyFi5nb29nbGUucHJvdG9idWYuRW1wdHlIABJACgViYXNpYxgCIAEoCzIvLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZVJlcG9zaXRvcnlBdXRoQmFzaWNIABI8CgNzc2gYAyABKAsyLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DcmVhdGVSZXBvc2l0b3J5QXV0aFNTSEgAQgYKBGF1dGgibgoXQ3JlYXRlUmVwb3NpdG9yeVJlcXVlc3QSDAoEbmFtZRgBIAEoCRILCgN1cmwYAiABKAkSOAoEYXV0aBgDIAEoCzIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZVJlcG9zaXRvcnlBdXRoIpIBChZHZXRSZXBvc2l0b3JpZXNSZXF1ZXN0EkEKBXNjb3BlGAEgASgOMjIubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0UmVwb3NpdG9yaWVzUmVxdWVzdC5TY29wZSI1CgVTY29wZRIICgRNSU5FEAASDQoJQ1JFQVRBQkxFEAESCgoGUFVCTElDEAISBwoDQUxMEAMiqAIKF1VwZGF0ZVJlcG9zaXRvcnlSZXF1ZXN0EgoKAmlkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIQCgN1cmwYAyABKAlIAYgBARI9CgRhdXRoGAQgASgLMioubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlUmVwb3NpdG9yeUF1dGhIAogBARJSCglvd25lcl9pZHMYBSABKAsyOi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5VcGRhdGVSZXBvc2l0b3J5UmVxdWVzdC5VcGRhdGVPd25lcnNIA4gBARohCgxVcGRhdGVPd25lcnMSEQoJb3duZXJfaWRzGAEgAygJQgcKBV9uYW1lQgYKBF91cmxCBwoFX2F1dGhCDAoKX293bmVyX2lkcyIsChNSZXBvc2l0b3J5SWRSZXF1ZXN0EhUKDXJlcG9zaXRvcnlfaWQYASABKAkiLQobR2V0UmVwb3NpdG9yeUNvbW1pdHNSZXF1ZXN0Eg4KBmhhc2hlcxgBIAMoCSJTChxHZXRSZXBvc2l0b3J5Q29tbWl0c1Jlc3BvbnNlEjMKB2NvbW1pdHMYASADKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5TaW1wbGVDb21taXQiwAEKFENyZWF0ZVdlYnNpdGVSZXF1ZXN0EgwKBGZxZG4YASABKAkSEwoLcGF0aF9wcmVmaXgYAiABKAkSFAoMc3RyaXBfcHJlZml4GAMgASgIEg0KBWh0dHBzGAQgASgIEgsKA2gyYxgFIAEoCBIRCglodHRwX3BvcnQYBiABKAUSQAoOYXV0aGVudGljYXRpb24YByABKA4yKC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BdXRoZW50aWNhdGlvblR5cGUiIgoURGVsZXRlV2Vic2l0ZVJlcXVlc3QSCgoCaWQYASABKAkiowIKGENyZWF0ZUFwcGxpY2F0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJEhUKDXJlcG9zaXRvcnlfaWQYAiABKAkSEAoIcmVmX25hbWUYAyABKAkSNwoGY29uZmlnGAQgASgLMicubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25Db25maWcSPAoId2Vic2l0ZXMYBSADKAsyKi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DcmVhdGVXZWJzaXRlUmVxdWVzdBJAChFwb3J0X3B1YmxpY2F0aW9ucxgGIAMoCzIlLm5lb3Nob3djYXNlLnByb3RvYnVmLlBvcnRQdWJsaWNhdGlvbhIXCg9zdGFydF9vbl9jcmVhdGUYByABKAgitQEKFkdldEFwcGxpY2F0aW9uc1JlcXVlc3QSQQoFc2NvcGUYASABKA4yMi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRBcHBsaWNhdGlvbnNSZXF1ZXN0LlNjb3BlEhoKDXJlcG9zaXRvcnlfaWQYAiABKAlIAIgBASIqCgVTY29wZRIICgRNSU5FEAASBwoDQUxMEAESDgoKUkVQT1NJVE9SWRACQhAKDl9yZXBvc2l0b3J5X2lkIrEFChhVcGRhdGVBcHBsaWNhdGlvblJlcXVlc3QSCgoCaWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEhUKCHJlZl9uYW1lGAQgASgJSAGIAQESPAoGY29uZmlnGAUgASgLMicubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25Db25maWdIAogBARJUCgh3ZWJzaXRlcxgGIAEoCzI9Lm5lb3Nob3djYXNlLnByb3RvYnVmLlVwZGF0ZUFwcGxpY2F0aW9uUmVxdWVzdC5VcGRhdGVXZWJzaXRlc0gDiAEBEloKEXBvcnRfcHVibGljYXRpb25zGAcgASgLMjoubmVvc2hvd2Nhc2UucHJvdG9idWYuVXBkYXRlQXBwbGljYXRpb25SZXF1ZXN0LlVwZGF0ZVBvcnRzSASIAQESUwoJb3duZXJfaWRzGAggASgLMjsubmVvc2hvd2Nhc2UucHJvdG9idWYuVXBkYXRlQXBwbGljYXRpb25SZXF1ZXN0LlVwZGF0ZU93bmVyc0gFiAEBGk4KDlVwZGF0ZVdlYnNpdGVzEjwKCHdlYnNpdGVzGAEgAygLMioubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlV2Vic2l0ZVJlcXVlc3QaTwoLVXBkYXRlUG9ydHMSQAoRcG9ydF9wdWJsaWNhdGlvbnMYASADKAsyJS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Qb3J0UHVibGljYXRpb24aIQoMVXBkYXRlT3duZXJzEhEKCW93bmVyX2lkcxgBIAMoCUIHCgVfbmFtZUILCglfcmVmX25hbWVCCQoHX2NvbmZpZ0ILCglfd2Vic2l0ZXNCFAoSX3BvcnRfcHVibGljYXRpb25zQgwKCl9vd25lcl9pZHNKBAgDEAQiUQoXR2V0UmVwb3NpdG9yaWVzUmVzcG9uc2USNgoMcmVwb3NpdG9yaWVzGAEgAygLMiAubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeSJSChdHZXRBcHBsaWNhdGlvbnNSZXNwb25zZRI3CgxhcHBsaWNhdGlvbnMYASADKAsyIS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbiIiChRBcHBsaWNhdGlvbklkUmVxdWVzdBIKCgJpZBgBIAEoCSIyChNHZXRBbGxCdWlsZHNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUiIgoOQnVpbGRJZFJlcXVlc3QSEAoIYnVpbGRfaWQYASABKAkiKAoRQXJ0aWZhY3RJZFJlcXVlc3QSEwoLYXJ0aWZhY3RfaWQYASABKAkiQAoRR2V0QnVpbGRzUmVzcG9uc2USKwoGYnVpbGRzGAEgAygLMhsubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGQiUQobU2V0QXBwbGljYXRpb25FbnZWYXJSZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEgsKA2tleRgCIAEoCRINCgV2YWx1ZRgDIAEoCSJFCh5EZWxldGVBcHBsaWNhdGlvbkVudlZhclJlcXVlc3QSFgoOYXBwbGljYXRpb25faWQYASABKAkSCwoDa2V5GAIgASgJIo8BChxHZXRBcHBsaWNhdGlvbk1ldHJpY3NSZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEhQKDG1ldHJpY3NfbmFtZRgCIAEoCRIqCgZiZWZvcmUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWxpbWl0X3NlY29uZHMYBCABKAMiZQoQR2V0T3V0cHV0UmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIqCgZiZWZvcmUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbWl0GAMgASgFIlsKFkdldE91dHB1dFN0cmVhbVJlcXVlc3QSFgoOYXBwbGljYXRpb25faWQYASABKAkSKQoFYmVnaW4YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkEKF1JldHJ5Q29tbWl0QnVpbGRSZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEg4KBmNvbW1pdBgCIAEoCSJHChlHZXRSZXBvc2l0b3J5UmVmc1Jlc3BvbnNlEioKBHJlZnMYASADKAsyHC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HaXRSZWYqJQoKRGVwbG95VHlwZRILCgdSVU5USU1FEAASCgoGU1RBVElDEAEqMQoSQXV0aGVudGljYXRpb25UeXBlEgcKA09GRhAAEggKBFNPRlQQARIICgRIQVJEEAIqKwoXUG9ydFB1YmxpY2F0aW9uUHJvdG9jb2wSBwoDVENQEAASBwoDVURQEAEqXgoLQnVpbGRTdGF0dXMSCgoGUVVFVUVEEAASDAoIQlVJTERJTkcQARINCglTVUNDRUVERUQQAhIKCgZGQUlMRUQQAxINCglDQU5DRUxMRUQQBBILCgdTS0lQUEVEEAUy7hsKCkFQSVNlcnZpY2USTgoNR2V0U3lzdGVtSW5mbxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRogLm5lb3Nob3djYXNlLnByb3RvYnVmLlN5c3RlbUluZm8iA5ACARJYCg9HZW5lcmF0ZUtleVBhaXISFi5nb29nbGUucHJvdG9idWYuRW1wdHkaLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZW5lcmF0ZUtleVBhaXJSZXNwb25zZRJACgVHZXRNZRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoaLm5lb3Nob3djYXNlLnByb3RvYnVmLlVzZXIiA5ACARJPCghHZXRVc2VycxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRomLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFVzZXJzUmVzcG9uc2UiA5ACARJaCg1DcmVhdGVVc2VyS2V5EioubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlVXNlcktleVJlcXVlc3QaHS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Vc2VyS2V5ElUKC0dldFVzZXJLZXlzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GikubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0VXNlcktleXNSZXNwb25zZSIDkAIBElMKDURlbGV0ZVVzZXJLZXkSKi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5EZWxldGVVc2VyS2V5UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJjChBDcmVhdGVSZXBvc2l0b3J5Ei0ubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlUmVwb3NpdG9yeVJlcXVlc3QaIC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5SZXBvc2l0b3J5EnMKD0dldFJlcG9zaXRvcmllcxIsLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFJlcG9zaXRvcmllc1JlcXVlc3QaLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRSZXBvc2l0b3JpZXNSZXNwb25zZSIDkAIBEoIBChRHZXRSZXBvc2l0b3J5Q29tbWl0cxIxLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFJlcG9zaXRvcnlDb21taXRzUmVxdWVzdBoyLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFJlcG9zaXRvcnlDb21taXRzUmVzcG9uc2UiA5ACARJhCg1HZXRSZXBvc2l0b3J5EikubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeUlkUmVxdWVzdBogLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlcG9zaXRvcnkiA5ACARJ0ChFHZXRSZXBvc2l0b3J5UmVmcxIpLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlcG9zaXRvcnlJZFJlcXVlc3QaLy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRSZXBvc2l0b3J5UmVmc1Jlc3BvbnNlIgOQAgESWQoQVXBkYXRlUmVwb3NpdG9yeRItLm5lb3Nob3djYXNlLnByb3RvYnVmLlVwZGF0ZVJlcG9zaXRvcnlSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElYKEVJlZnJlc2hSZXBvc2l0b3J5EikubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeUlkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJVChBEZWxldGVSZXBvc2l0b3J5EikubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeUlkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJmChFDcmVhdGVBcHBsaWNhdGlvbhIuLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZUFwcGxpY2F0aW9uUmVxdWVzdBohLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uEnMKD0dldEFwcGxpY2F0aW9ucxIsLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEFwcGxpY2F0aW9uc1JlcXVlc3QaLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRBcHBsaWNhdGlvbnNSZXNwb25zZSIDkAIBEmQKDkdldEFwcGxpY2F0aW9uEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaIS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbiIDkAIBElsKEVVwZGF0ZUFwcGxpY2F0aW9uEi4ubmVvc2hvd2Nhc2UucHJvdG9idWYuVXBkYXRlQXBwbGljYXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElcKEURlbGV0ZUFwcGxpY2F0aW9uEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSWgoTR2V0QXZhaWxhYmxlTWV0cmljcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRomLm5lb3Nob3djYXNlLnByb3RvYnVmLkF2YWlsYWJsZU1ldHJpY3MiA5ACARJ6ChVHZXRBcHBsaWNhdGlvbk1ldHJpY3MSMi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRBcHBsaWNhdGlvbk1ldHJpY3NSZXF1ZXN0GigubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25NZXRyaWNzIgOQAgESYgoJR2V0T3V0cHV0EiYubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0T3V0cHV0UmVxdWVzdBooLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uT3V0cHV0cyIDkAIBEmoKD0dldE91dHB1dFN0cmVhbRIsLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldE91dHB1dFN0cmVhbVJlcXVlc3QaJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbk91dHB1dDABEmcKCkdldEVudlZhcnMSKi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbklkUmVxdWVzdBooLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uRW52VmFycyIDkAIBElYKCVNldEVudlZhchIxLm5lb3Nob3djYXNlLnByb3RvYnVmLlNldEFwcGxpY2F0aW9uRW52VmFyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJcCgxEZWxldGVFbnZWYXISNC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5EZWxldGVBcHBsaWNhdGlvbkVudlZhclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVgoQU3RhcnRBcHBsaWNhdGlvbhIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uSWRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElUKD1N0b3BBcHBsaWNhdGlvbhIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uSWRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EmcKDEdldEFsbEJ1aWxkcxIpLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEFsbEJ1aWxkc1JlcXVlc3QaJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRCdWlsZHNSZXNwb25zZSIDkAIBEmUKCUdldEJ1aWxkcxIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uSWRSZXF1ZXN0GicubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0QnVpbGRzUmVzcG9uc2UiA5ACARJSCghHZXRCdWlsZBIkLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkSWRSZXF1ZXN0GhsubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGQiA5ACARJZChBSZXRyeUNvbW1pdEJ1aWxkEi0ubmVvc2hvd2Nhc2UucHJvdG9idWYuUmV0cnlDb21taXRCdWlsZFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSSwoLQ2FuY2VsQnVpbGQSJC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZElkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJYCgtHZXRCdWlsZExvZxIkLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkSWRSZXF1ZXN0Gh4ubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRMb2ciA5ACARJbChFHZXRCdWlsZExvZ1N0cmVhbRIkLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkSWRSZXF1ZXN0Gh4ubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRMb2cwARJnChBHZXRCdWlsZEFydGlmYWN0EicubmVvc2hvd2Nhc2UucHJvdG9idWYuQXJ0aWZhY3RJZFJlcXVlc3QaJS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcnRpZmFjdENvbnRlbnQiA5ACAWIGcHJvdG8z", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_neoshowcase_protobuf_null]);
+  fileDesc("CiJuZW9zaG93Y2FzZS9wcm90b2J1Zi9nYXRld2F5LnByb3RvEhRuZW9zaG93Y2FzZS5wcm90b2J1ZiIlCgdTU0hJbmZvEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBSJpCg9BdmFpbGFibGVEb21haW4SDgoGZG9tYWluGAEgASgJEhcKD2V4Y2x1ZGVfZG9tYWlucxgCIAMoCRIWCg5hdXRoX2F2YWlsYWJsZRgDIAEoCBIVCg1hbHJlYWR5X2JvdW5kGAQgASgIInYKDUF2YWlsYWJsZVBvcnQSEgoKc3RhcnRfcG9ydBgBIAEoBRIQCghlbmRfcG9ydBgCIAEoBRI/Cghwcm90b2NvbBgDIAEoDjItLm5lb3Nob3djYXNlLnByb3RvYnVmLlBvcnRQdWJsaWNhdGlvblByb3RvY29sIisKDkFkZGl0aW9uYWxMaW5rEgwKBG5hbWUYASABKAkSCwoDdXJsGAIgASgJIpsCCgpTeXN0ZW1JbmZvEhIKCnB1YmxpY19rZXkYASABKAkSKgoDc3NoGAIgASgLMh0ubmVvc2hvd2Nhc2UucHJvdG9idWYuU1NISW5mbxI2Cgdkb21haW5zGAMgAygLMiUubmVvc2hvd2Nhc2UucHJvdG9idWYuQXZhaWxhYmxlRG9tYWluEjIKBXBvcnRzGAQgAygLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuQXZhaWxhYmxlUG9ydBI+ChBhZGRpdGlvbmFsX2xpbmtzGAUgAygLMiQubmVvc2hvd2Nhc2UucHJvdG9idWYuQWRkaXRpb25hbExpbmsSDwoHdmVyc2lvbhgGIAEoCRIQCghyZXZpc2lvbhgHIAEoCSJDCgRVc2VyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDQoFYWRtaW4YAyABKAgSEgoKYXZhdGFyX3VybBgEIAEoCSJ4CgdVc2VyS2V5EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRIMCgRuYW1lGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIrIDCgxDdXN0b21Eb21haW4SCgoCaWQYASABKAkSDgoGZG9tYWluGAIgASgJEkUKBm1ldGhvZBgDIAEoDjI1Lm5lb3Nob3djYXNlLnByb3RvYnVmLkN1c3RvbURvbWFpbi5WZXJpZmljYXRpb25NZXRob2QSDQoFdG9rZW4YBCABKAkSFwoPdHh0X3JlY29yZF9uYW1lGAUgASgJEhgKEHR4dF9yZWNvcmRfdmFsdWUYBiABKAkSEAoIaHR0cF91cmwYByABKAkSEAoIdmVyaWZpZWQYCCABKAgSOAoLdmVyaWZpZWRfYXQYCSABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5OdWxsVGltZXN0YW1wEjcKCmNoZWNrZWRfYXQYCiABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5OdWxsVGltZXN0YW1wEg0KBWVycm9yGAsgASgJEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIicKElZlcmlmaWNhdGlvbk1ldGhvZBIHCgNETlMQABIICgRIVFRQEAEiTwoYR2V0Q3VzdG9tRG9tYWluc1Jlc3BvbnNlEjMKB2RvbWFpbnMYASADKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DdXN0b21Eb21haW4i3AEKDlRMU0NlcnRpZmljYXRlEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDAoEZnFkbhgDIAEoCRIuCgpub3RfYmVmb3JlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCglub3RfYWZ0ZXIYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGV4cGlyaW5nGAYgASgIEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlgKGkdldFRMU0NlcnRpZmljYXRlc1Jlc3BvbnNlEjoKDGNlcnRpZmljYXRlcxgBIAMoCzIkLm5lb3Nob3djYXNlLnByb3RvYnVmLlRMU0NlcnRpZmljYXRlIoEBCg1SZXNvdXJjZVF1b3RhEhgKEG1heF9hcHBsaWNhdGlvbnMYASABKAUSIAoYbWF4X3J1bm5pbmdfYXBwbGljYXRpb25zGAIgASgFEh0KFW1heF9wb3J0X3B1YmxpY2F0aW9ucxgDIAEoBRIVCg1tYXhfZGF0YWJhc2VzGAQgASgFInEKDVJlc291cmNlVXNhZ2USFAoMYXBwbGljYXRpb25zGAEgASgFEhwKFHJ1bm5pbmdfYXBwbGljYXRpb25zGAIgASgFEhkKEXBvcnRfcHVibGljYXRpb25zGAMgASgFEhEKCWRhdGFiYXNlcxgEIAEoBSLGAQoKUmVwb3NpdG9yeRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgsKA3VybBgDIAEoCRIQCghodG1sX3VybBgEIAEoCRJACgthdXRoX21ldGhvZBgFIAEoDjIrLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlcG9zaXRvcnkuQXV0aE1ldGhvZBIRCglvd25lcl9pZHMYBiADKAkiKgoKQXV0aE1ldGhvZBIICgROT05FEAASCQoFQkFTSUMQARIHCgNTU0gQAiJzCgxTaW1wbGVDb21taXQSDAoEaGFzaBgBIAEoCRITCgthdXRob3JfbmFtZRgCIAEoCRIvCgtjb21taXRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHbWVzc2FnZRgEIAEoCSKyAQoSQXV0b1NodXRkb3duQ29uZmlnEg8KB2VuYWJsZWQYASABKAgSSQoHc3RhcnR1cBgCIAEoDjI4Lm5lb3Nob3djYXNlLnByb3RvYnVmLkF1dG9TaHV0ZG93bkNvbmZpZy5TdGFydHVwQmVoYXZpb3IiQAoPU3RhcnR1cEJlaGF2aW9yEg0KCVVOREVGSU5FRBAAEhAKDExPQURJTkdfUEFHRRABEgwKCEJMT0NLSU5HEAIinwEKDVJ1bnRpbWVDb25maWcSEwoLdXNlX21hcmlhZGIYASABKAgSEwoLdXNlX21vbmdvZGIYAiABKAgSEgoKZW50cnlwb2ludBgDIAEoCRIPCgdjb21tYW5kGAQgASgJEj8KDWF1dG9fc2h1dGRvd24YBSABKAsyKC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BdXRvU2h1dGRvd25Db25maWciawobQnVpbGRDb25maWdSdW50aW1lQnVpbGRwYWNrEjsKDnJ1bnRpbWVfY29uZmlnGAEgASgLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuUnVudGltZUNvbmZpZxIPCgdjb250ZXh0GAIgASgJInsKFUJ1aWxkQ29uZmlnUnVudGltZUNtZBI7Cg5ydW50aW1lX2NvbmZpZxgBIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLlJ1bnRpbWVDb25maWcSEgoKYmFzZV9pbWFnZRgCIAEoCRIRCglidWlsZF9jbWQYAyABKAkihQEKHEJ1aWxkQ29uZmlnUnVudGltZURvY2tlcmZpbGUSOwoOcnVudGltZV9jb25maWcYASABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5SdW50aW1lQ29uZmlnEhcKD2RvY2tlcmZpbGVfbmFtZRgCIAEoCRIPCgdjb250ZXh0GAMgASgJIpoBCgxTdGF0aWNDb25maWcSFQoNYXJ0aWZhY3RfcGF0aBgBIAEoCRILCgNzcGEYAiABKAgSFgoObm90X2ZvdW5kX3BhdGgYAyABKAkSGwoTYXNzZXRfY2FjaGVfY29udHJvbBgEIAEoCRIaChJodG1sX2NhY2hlX2NvbnRyb2wYBSABKAkSFQoNcHJlY29tcHJlc3NlZBgGIAEoCCJoChpCdWlsZENvbmZpZ1N0YXRpY0J1aWxkcGFjaxI5Cg1zdGF0aWNfY29uZmlnGAEgASgLMiIubmVvc2hvd2Nhc2UucHJvdG9idWYuU3RhdGljQ29uZmlnEg8KB2NvbnRleHQYAiABKAkieAoUQnVpbGRDb25maWdTdGF0aWNDbWQSOQoNc3RhdGljX2NvbmZpZxgBIAEoCzIiLm5lb3Nob3djYXNlLnByb3RvYnVmLlN0YXRpY0NvbmZpZxISCgpiYXNlX2ltYWdlGAIgASgJEhEKCWJ1aWxkX2NtZBgDIAEoCSKCAQobQnVpbGRDb25maWdTdGF0aWNEb2NrZXJmaWxlEjkKDXN0YXRpY19jb25maWcYASABKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5TdGF0aWNDb25maWcSFwoPZG9ja2VyZmlsZV9uYW1lGAIgASgJEg8KB2NvbnRleHQYAyABKAki6QMKEUFwcGxpY2F0aW9uQ29uZmlnEk4KEXJ1bnRpbWVfYnVpbGRwYWNrGAEgASgLMjEubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRDb25maWdSdW50aW1lQnVpbGRwYWNrSAASQgoLcnVudGltZV9jbWQYAiABKAsyKy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZENvbmZpZ1J1bnRpbWVDbWRIABJQChJydW50aW1lX2RvY2tlcmZpbGUYAyABKAsyMi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZENvbmZpZ1J1bnRpbWVEb2NrZXJmaWxlSAASTAoQc3RhdGljX2J1aWxkcGFjaxgEIAEoCzIwLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkQ29uZmlnU3RhdGljQnVpbGRwYWNrSAASQAoKc3RhdGljX2NtZBgFIAEoCzIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkQ29uZmlnU3RhdGljQ21kSAASTgoRc3RhdGljX2RvY2tlcmZpbGUYBiABKAsyMS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZENvbmZpZ1N0YXRpY0RvY2tlcmZpbGVIAEIOCgxidWlsZF9jb25maWci6wMKB1dlYnNpdGUSCgoCaWQYASABKAkSDAoEZnFkbhgCIAEoCRITCgtwYXRoX3ByZWZpeBgDIAEoCRIUCgxzdHJpcF9wcmVmaXgYBCABKAgSDQoFaHR0cHMYBSABKAgSCwoDaDJjGAYgASgIEhEKCWh0dHBfcG9ydBgHIAEoBRJACg5hdXRoZW50aWNhdGlvbhgIIAEoDjIoLm5lb3Nob3djYXNlLnByb3RvYnVmLkF1dGhlbnRpY2F0aW9uVHlwZRIwCgVydWxlcxgJIAMoCzIhLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGVSdWxlEkAKDWhlYWRlcl9wb2xpY3kYCiABKAsyKS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlSGVhZGVyUG9saWN5EkIKDmFjY2Vzc19jb250cm9sGAsgASgLMioubmVvc2hvd2Nhc2UucHJvdG9idWYuV2Vic2l0ZUFjY2Vzc0NvbnRyb2wSOgoKcmF0ZV9saW1pdBgMIAEoCzImLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGVSYXRlTGltaXQSNgoIYmFja2VuZHMYDSADKAsyJC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlQmFja2VuZCI4Cg5XZWJzaXRlQmFja2VuZBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIOCgZ3ZWlnaHQYAiABKAUitwEKC1dlYnNpdGVSdWxlEjQKBHR5cGUYASABKA4yJi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlUnVsZS5UeXBlEhIKCnBhdGhfcmVnZXgYAiABKAkSDgoGdGFyZ2V0GAMgASgJEhMKC3N0YXR1c19jb2RlGAQgASgFEhYKDnByZXNlcnZlX3F1ZXJ5GAUgASgIIiEKBFR5cGUSDAoIUkVESVJFQ1QQABILCgdSRVdSSVRFEAEi5AIKE1dlYnNpdGVIZWFkZXJQb2xpY3kSSgoQcmVzcG9uc2VfaGVhZGVycxgBIAMoCzIwLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGVIZWFkZXJQb2xpY3kuSGVhZGVyEkIKBGNvcnMYAiABKAsyNC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlSGVhZGVyUG9saWN5LkNPUlNQb2xpY3kaJQoGSGVhZGVyEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkalQEKCkNPUlNQb2xpY3kSFQoNYWxsb3dfb3JpZ2lucxgBIAMoCRIVCg1hbGxvd19tZXRob2RzGAIgAygJEhUKDWFsbG93X2hlYWRlcnMYAyADKAkSFgoOZXhwb3NlX2hlYWRlcnMYBCADKAkSGQoRYWxsb3dfY3JlZGVudGlhbHMYBSABKAgSDwoHbWF4X2FnZRgGIAEoBSK2AQoUV2Vic2l0ZUFjY2Vzc0NvbnRyb2wSFQoNaXBfYWxsb3dfbGlzdBgBIAMoCRJSChBiYXNpY19hdXRoX3VzZXJzGAIgAygLMjgubmVvc2hvd2Nhc2UucHJvdG9idWYuV2Vic2l0ZUFjY2Vzc0NvbnRyb2wuQmFzaWNBdXRoVXNlchozCg1CYXNpY0F1dGhVc2VyEhAKCHVzZXJuYW1lGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIloKEFdlYnNpdGVSYXRlTGltaXQSDwoHZW5hYmxlZBgBIAEoCBIPCgdhdmVyYWdlGAIgASgFEg0KBWJ1cnN0GAMgASgFEhUKDXNvdXJjZV9oZWFkZXIYBCABKAkigwEKD1BvcnRQdWJsaWNhdGlvbhIVCg1pbnRlcm5ldF9wb3J0GAEgASgFEhgKEGFwcGxpY2F0aW9uX3BvcnQYAiABKAUSPwoIcHJvdG9jb2wYAyABKA4yLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Qb3J0UHVibGljYXRpb25Qcm90b2NvbCI4Cg9JbnRlcm5hbFNlcnZpY2USDAoEcG9ydBgBIAEoBRIXCg9hbGxvd2VkX2FwcF9pZHMYAiADKAkigQcKC0FwcGxpY2F0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFQoNcmVwb3NpdG9yeV9pZBgDIAEoCRIQCghyZWZfbmFtZRgEIAEoCRIOCgZjb21taXQYBSABKAkSNQoLZGVwbG95X3R5cGUYBiABKA4yIC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5EZXBsb3lUeXBlEg8KB3J1bm5pbmcYByABKAgSQwoJY29udGFpbmVyGAggASgOMjAubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb24uQ29udGFpbmVyU3RhdGUSGQoRY29udGFpbmVyX21lc3NhZ2UYCSABKAkSFQoNY3VycmVudF9idWlsZBgKIAEoCRIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI3CgZjb25maWcYDSABKAsyJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbkNvbmZpZxIvCgh3ZWJzaXRlcxgOIAMoCzIdLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGUSQAoRcG9ydF9wdWJsaWNhdGlvbnMYDyADKAsyJS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Qb3J0UHVibGljYXRpb24SEQoJb3duZXJfaWRzGBAgAygJEkMKE2xhdGVzdF9idWlsZF9zdGF0dXMYESABKA4yIS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZFN0YXR1c0gAiAEBEhgKEGNvbmZpZ19maWxlX3BhdGgYEiABKAkSGQoRY29uZmlnX2ZpbGVfZXJyb3IYEyABKAkSPwoQaW50ZXJuYWxfc2VydmljZRgUIAEoCzIlLm5lb3Nob3djYXNlLnByb3RvYnVmLkludGVybmFsU2VydmljZSJuCg5Db250YWluZXJTdGF0ZRILCgdNSVNTSU5HEAASDAoIU1RBUlRJTkcQARIOCgpSRVNUQVJUSU5HEAISCwoHUlVOTklORxADEgoKBkVYSVRFRBAEEgsKB0VSUk9SRUQQBRILCgdVTktOT1dOEAZCFgoUX2xhdGVzdF9idWlsZF9zdGF0dXMiVwoRQXBwbGljYXRpb25FbnZWYXISFgoOYXBwbGljYXRpb25faWQYASABKAkSCwoDa2V5GAIgASgJEg0KBXZhbHVlGAMgASgJEg4KBnN5c3RlbRgEIAEoCCJQChJBcHBsaWNhdGlvbkVudlZhcnMSOgoJdmFyaWFibGVzGAEgAygLMicubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25FbnZWYXIirQEKCEFydGlmYWN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEAoIYnVpbGRfaWQYAyABKAkSDAoEc2l6ZRgEIAEoAxIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI3CgpkZWxldGVkX2F0GAYgASgLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuTnVsbFRpbWVzdGFtcCI0Cg9BcnRpZmFjdENvbnRlbnQSEAoIZmlsZW5hbWUYASABKAkSDwoHY29udGVudBgCIAEoDCJqCgxSdW50aW1lSW1hZ2USCgoCaWQYASABKAkSEAoIYnVpbGRfaWQYAiABKAkSDAoEc2l6ZRgDIAEoAxIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIpChBBdmFpbGFibGVNZXRyaWNzEhUKDW1ldHJpY3NfbmFtZXMYASADKAkiTAoRQXBwbGljYXRpb25NZXRyaWMSKAoEdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFdmFsdWUYAiABKAEiTgoSQXBwbGljYXRpb25NZXRyaWNzEjgKB21ldHJpY3MYASADKAsyJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbk1ldHJpYyJKChFBcHBsaWNhdGlvbk91dHB1dBIoCgR0aW1lGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBILCgNsb2cYAiABKAkiTgoSQXBwbGljYXRpb25PdXRwdXRzEjgKB291dHB1dHMYASADKAsyJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbk91dHB1dCLWAgoQQXBwbGljYXRpb25FdmVudBIKCgJpZBgBIAEoCRIWCg5hcHBsaWNhdGlvbl9pZBgCIAEoCRI5CgR0eXBlGAMgASgOMisubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25FdmVudC5UeXBlEhEKCXJlZmVyZW5jZRgEIAEoCRIPCgdtZXNzYWdlGAUgASgJEg8KB3VzZXJfaWQYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAifgoEVHlwZRITCg9DT05UQUlORVJfU1RBVEUQABIRCg1CVUlMRF9TVEFSVEVEEAESEgoOQlVJTERfRklOSVNIRUQQAhIMCghERVBMT1lFRBADEhIKDkNPTkZJR19DSEFOR0VEEAQSCwoHU1RBUlRFRBAFEgsKB1NUT1BQRUQQBiJLChFBcHBsaWNhdGlvbkV2ZW50cxI2CgZldmVudHMYASADKAsyJi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbkV2ZW50Ir8BCgxXZWJzaXRlUHJvYmUSLgoKY2hlY2tlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCgoCdXAYAiABKAgSEwoLc3RhdHVzX2NvZGUYAyABKAUSEgoKbGF0ZW5jeV9tcxgEIAEoAxI7Cg50bHNfZXhwaXJlc19hdBgFIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLk51bGxUaW1lc3RhbXASDQoFZXJyb3IYBiABKAkiYAoNV2Vic2l0ZVVwdGltZRIWCg53aW5kb3dfc2Vjb25kcxgBIAEoAxINCgV0b3RhbBgCIAEoBRIKCgJ1cBgDIAEoBRISCgVyYXRpbxgEIAEoAUgAiAEBQggKBl9yYXRpbyLRAQoNV2Vic2l0ZVN0YXR1cxIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIuCgd3ZWJzaXRlGAIgASgLMh0ubmVvc2hvd2Nhc2UucHJvdG9idWYuV2Vic2l0ZRI3CgZsYXRlc3QYAyABKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlUHJvYmVIAIgBARI0Cgd1cHRpbWVzGAQgAygLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuV2Vic2l0ZVVwdGltZUIJCgdfbGF0ZXN0IkgKD1dlYnNpdGVTdGF0dXNlcxI1CghzdGF0dXNlcxgBIAMoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGVTdGF0dXMi2AMKCUFsZXJ0UnVsZRIKCgJpZBgBIAEoCRIWCg5hcHBsaWNhdGlvbl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEjIKBGtpbmQYBCABKA4yJC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BbGVydFJ1bGUuS2luZBIOCgZtZXRyaWMYBSABKAkSPgoKY29tcGFyaXNvbhgGIAEoDjIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFsZXJ0UnVsZS5Db21wYXJpc29uEhEKCXRocmVzaG9sZBgHIAEoARIYChBkdXJhdGlvbl9zZWNvbmRzGAggASgDEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEkUKDnRocmVzaG9sZF91bml0GAogASgOMi0ubmVvc2hvd2Nhc2UucHJvdG9idWYuQWxlcnRSdWxlLlRocmVzaG9sZFVuaXQiHgoES2luZBIKCgZNRVRSSUMQABIKCgZOT19MT0cQASIiCgpDb21wYXJpc29uEgkKBUFCT1ZFEAASCQoFQkVMT1cQASItCg1UaHJlc2hvbGRVbml0EgkKBVZBTFVFEAASEQoNTElNSVRfUEVSQ0VOVBABIjwKCkFsZXJ0UnVsZXMSLgoFcnVsZXMYASADKAsyHy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BbGVydFJ1bGUitQEKBUFsZXJ0EgoKAmlkGAEgASgJEg8KB3J1bGVfaWQYAiABKAkSFgoOYXBwbGljYXRpb25faWQYAyABKAkSDwoHbWVzc2FnZRgEIAEoCRIsCghmaXJlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoLcmVzb2x2ZWRfYXQYBiABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5OdWxsVGltZXN0YW1wIjUKBkFsZXJ0cxIrCgZhbGVydHMYASADKAsyGy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BbGVydCLHAwoYTm90aWZpY2F0aW9uU3Vic2NyaXB0aW9uEgoKAmlkGAEgASgJEhYKDmFwcGxpY2F0aW9uX2lkGAIgASgJEkEKBHNpbmsYAyABKA4yMy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Ob3RpZmljYXRpb25TdWJzY3JpcHRpb24uU2luaxIOCgZ0YXJnZXQYBCABKAkSEgoKaGFzX3NlY3JldBgFIAEoCBJECgZldmVudHMYBiADKA4yNC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Ob3RpZmljYXRpb25TdWJzY3JpcHRpb24uRXZlbnQSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiMwoEU2luaxILCgdXRUJIT09LEAASCQoFU0xBQ0sQARIICgRUUkFREAISCQoFRU1BSUwQAyJ1CgVFdmVudBIQCgxCVUlMRF9GQUlMRUQQABITCg9CVUlMRF9TVUNDRUVERUQQARIMCghERVBMT1lFRBACEhUKEUNPTlRBSU5FUl9FUlJPUkVEEAMSFQoRQ0VSVElGSUNBVEVfRVJST1IQBBIJCgVBTEVSVBAFImIKGU5vdGlmaWNhdGlvblN1YnNjcmlwdGlvbnMSRQoNc3Vic2NyaXB0aW9ucxgBIAMoCzIuLm5lb3Nob3djYXNlLnByb3RvYnVmLk5vdGlmaWNhdGlvblN1YnNjcmlwdGlvbiLhAwoFQnVpbGQSCgoCaWQYASABKAkSFgoOYXBwbGljYXRpb25faWQYAiABKAkSDgoGY29tbWl0GAMgASgJEjEKBnN0YXR1cxgEIAEoDjIhLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkU3RhdHVzEi0KCXF1ZXVlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNwoKc3RhcnRlZF9hdBgGIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLk51bGxUaW1lc3RhbXASNwoKdXBkYXRlZF9hdBgHIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLk51bGxUaW1lc3RhbXASOAoLZmluaXNoZWRfYXQYCCABKAsyIy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5OdWxsVGltZXN0YW1wEhEKCXJldHJpYWJsZRgJIAEoCBIxCglhcnRpZmFjdHMYCiADKAsyHi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcnRpZmFjdBI+Cg1ydW50aW1lX2ltYWdlGAsgASgLMiIubmVvc2hvd2Nhc2UucHJvdG9idWYuUnVudGltZUltYWdlSACIAQFCEAoOX3J1bnRpbWVfaW1hZ2UiFwoIQnVpbGRMb2cSCwoDbG9nGAEgASgMIioKBkdpdFJlZhIQCghyZWZfbmFtZRgBIAEoCRIOCgZjb21taXQYAiABKAkiPQoXR2VuZXJhdGVLZXlQYWlyUmVzcG9uc2USDgoGa2V5X2lkGAEgASgJEhIKCnB1YmxpY19rZXkYAiABKAkiPQoQR2V0VXNlcnNSZXNwb25zZRIpCgV1c2VycxgBIAMoCzIaLm5lb3Nob3djYXNlLnByb3RvYnVmLlVzZXIiQgoTR2V0VXNlcktleXNSZXNwb25zZRIrCgRrZXlzGAEgAygLMh0ubmVvc2hvd2Nhc2UucHJvdG9idWYuVXNlcktleSI4ChRDcmVhdGVVc2VyS2V5UmVxdWVzdBISCgpwdWJsaWNfa2V5GAEgASgJEgwKBG5hbWUYAiABKAkiJgoURGVsZXRlVXNlcktleVJlcXVlc3QSDgoGa2V5X2lkGAEgASgJInIKGUNyZWF0ZUN1c3RvbURvbWFpblJlcXVlc3QSDgoGZG9tYWluGAEgASgJEkUKBm1ldGhvZBgCIAEoDjI1Lm5lb3Nob3djYXNlLnByb3RvYnVmLkN1c3RvbURvbWFpbi5WZXJpZmljYXRpb25NZXRob2QiKgoVQ3VzdG9tRG9tYWluSWRSZXF1ZXN0EhEKCWRvbWFpbl9pZBgBIAEoCSJVChtVcGxvYWRUTFNDZXJ0aWZpY2F0ZVJlcXVlc3QSDAoEZnFkbhgBIAEoCRITCgtjZXJ0aWZpY2F0ZRgCIAEoCRITCgtwcml2YXRlX2tleRgDIAEoCSIxChdUTFNDZXJ0aWZpY2F0ZUlkUmVxdWVzdBIWCg5jZXJ0aWZpY2F0ZV9pZBgBIAEoCSKSAQoSR2V0TXlVc2FnZVJlc3BvbnNlEjIKBXF1b3RhGAEgASgLMiMubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVzb3VyY2VRdW90YRIyCgV1c2FnZRgCIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlc291cmNlVXNhZ2USFAoMY3VzdG9tX3F1b3RhGAMgASgIImkKE1NldFVzZXJRdW90YVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRI3CgVxdW90YRgCIAEoCzIjLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlc291cmNlUXVvdGFIAIgBAUIICgZfcXVvdGEiPwoZQ3JlYXRlUmVwb3NpdG9yeUF1dGhCYXNpYxIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIpChdDcmVhdGVSZXBvc2l0b3J5QXV0aFNTSBIOCgZrZXlfaWQYASABKAkixgEKFENyZWF0ZVJlcG9zaXRvcnlBdXRoEiYKBG5vbmUYASABKAsyFi5nb29nbGUucHJvdG9idWYuRW1wdHlIABJACgViYXNpYxgCIAEoCzIvLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZVJlcG9zaXRvcnlBdXRoQmFzaWNIABI8CgNzc2gYAyABKAsyLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DcmVhdGVSZXBvc2l0b3J5QXV0aFNTSEgAQgYKBGF1dGgibgoXQ3JlYXRlUmVwb3NpdG9yeVJlcXVlc3QSDAoEbmFtZRgBIAEoCRILCgN1cmwYAiABKAkSOAoEYXV0aBgDIAEoCzIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZVJlcG9zaXRvcnlBdXRoIpIBChZHZXRSZXBvc2l0b3JpZXNSZXF1ZXN0EkEKBXNjb3BlGAEgASgOMjIubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0UmVwb3NpdG9yaWVzUmVxdWVzdC5TY29wZSI1CgVTY29wZRIICgRNSU5FEAASDQoJQ1JFQVRBQkxFEAESCgoGUFVCTElDEAISBwoDQUxMEAMiqAIKF1VwZGF0ZVJlcG9zaXRvcnlSZXF1ZXN0EgoKAmlkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIQCgN1cmwYAyABKAlIAYgBARI9CgRhdXRoGAQgASgLMioubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlUmVwb3NpdG9yeUF1dGhIAogBARJSCglvd25lcl9pZHMYBSABKAsyOi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5VcGRhdGVSZXBvc2l0b3J5UmVxdWVzdC5VcGRhdGVPd25lcnNIA4gBARohCgxVcGRhdGVPd25lcnMSEQoJb3duZXJfaWRzGAEgAygJQgcKBV9uYW1lQgYKBF91cmxCBwoFX2F1dGhCDAoKX293bmVyX2lkcyIsChNSZXBvc2l0b3J5SWRSZXF1ZXN0EhUKDXJlcG9zaXRvcnlfaWQYASABKAkiLQobR2V0UmVwb3NpdG9yeUNvbW1pdHNSZXF1ZXN0Eg4KBmhhc2hlcxgBIAMoCSJTChxHZXRSZXBvc2l0b3J5Q29tbWl0c1Jlc3BvbnNlEjMKB2NvbW1pdHMYASADKAsyIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5TaW1wbGVDb21taXQi7AMKFENyZWF0ZVdlYnNpdGVSZXF1ZXN0EgwKBGZxZG4YASABKAkSEwoLcGF0aF9wcmVmaXgYAiABKAkSFAoMc3RyaXBfcHJlZml4GAMgASgIEg0KBWh0dHBzGAQgASgIEgsKA2gyYxgFIAEoCBIRCglodHRwX3BvcnQYBiABKAUSQAoOYXV0aGVudGljYXRpb24YByABKA4yKC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BdXRoZW50aWNhdGlvblR5cGUSMAoFcnVsZXMYCCADKAsyIS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlUnVsZRJACg1oZWFkZXJfcG9saWN5GAkgASgLMikubmVvc2hvd2Nhc2UucHJvdG9idWYuV2Vic2l0ZUhlYWRlclBvbGljeRJCCg5hY2Nlc3NfY29udHJvbBgKIAEoCzIqLm5lb3Nob3djYXNlLnByb3RvYnVmLldlYnNpdGVBY2Nlc3NDb250cm9sEjoKCnJhdGVfbGltaXQYCyABKAsyJi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlUmF0ZUxpbWl0EjYKCGJhY2tlbmRzGAwgAygLMiQubmVvc2hvd2Nhc2UucHJvdG9idWYuV2Vic2l0ZUJhY2tlbmQiIgoURGVsZXRlV2Vic2l0ZVJlcXVlc3QSCgoCaWQYASABKAki/gIKGENyZWF0ZUFwcGxpY2F0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJEhUKDXJlcG9zaXRvcnlfaWQYAiABKAkSEAoIcmVmX25hbWUYAyABKAkSNwoGY29uZmlnGAQgASgLMicubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25Db25maWcSPAoId2Vic2l0ZXMYBSADKAsyKi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DcmVhdGVXZWJzaXRlUmVxdWVzdBJAChFwb3J0X3B1YmxpY2F0aW9ucxgGIAMoCzIlLm5lb3Nob3djYXNlLnByb3RvYnVmLlBvcnRQdWJsaWNhdGlvbhIXCg9zdGFydF9vbl9jcmVhdGUYByABKAgSGAoQY29uZmlnX2ZpbGVfcGF0aBgIIAEoCRI/ChBpbnRlcm5hbF9zZXJ2aWNlGAkgASgLMiUubmVvc2hvd2Nhc2UucHJvdG9idWYuSW50ZXJuYWxTZXJ2aWNlIskBChtEdXBsaWNhdGVBcHBsaWNhdGlvblJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIQCghyZWZfbmFtZRgDIAEoCRIVCg13ZWJzaXRlX2ZxZG5zGAQgAygJEhYKDmludGVybmV0X3BvcnRzGAUgAygFEhcKD3N0YXJ0X29uX2NyZWF0ZRgGIAEoCBIfChJleGNsdWRlX3N5c3RlbV9lbnYYByABKAhIAIgBAUIVChNfZXhjbHVkZV9zeXN0ZW1fZW52IrUBChZHZXRBcHBsaWNhdGlvbnNSZXF1ZXN0EkEKBXNjb3BlGAEgASgOMjIubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0QXBwbGljYXRpb25zUmVxdWVzdC5TY29wZRIaCg1yZXBvc2l0b3J5X2lkGAIgASgJSACIAQEiKgoFU2NvcGUSCAoETUlORRAAEgcKA0FMTBABEg4KClJFUE9TSVRPUlkQAkIQCg5fcmVwb3NpdG9yeV9pZCLABgoYVXBkYXRlQXBwbGljYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIVCghyZWZfbmFtZRgEIAEoCUgBiAEBEjwKBmNvbmZpZxgFIAEoCzInLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uQ29uZmlnSAKIAQESVAoId2Vic2l0ZXMYBiABKAsyPS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5VcGRhdGVBcHBsaWNhdGlvblJlcXVlc3QuVXBkYXRlV2Vic2l0ZXNIA4gBARJaChFwb3J0X3B1YmxpY2F0aW9ucxgHIAEoCzI6Lm5lb3Nob3djYXNlLnByb3RvYnVmLlVwZGF0ZUFwcGxpY2F0aW9uUmVxdWVzdC5VcGRhdGVQb3J0c0gEiAEBElMKCW93bmVyX2lkcxgIIAEoCzI7Lm5lb3Nob3djYXNlLnByb3RvYnVmLlVwZGF0ZUFwcGxpY2F0aW9uUmVxdWVzdC5VcGRhdGVPd25lcnNIBYgBARIdChBjb25maWdfZmlsZV9wYXRoGAkgASgJSAaIAQESRAoQaW50ZXJuYWxfc2VydmljZRgKIAEoCzIlLm5lb3Nob3djYXNlLnByb3RvYnVmLkludGVybmFsU2VydmljZUgHiAEBGk4KDlVwZGF0ZVdlYnNpdGVzEjwKCHdlYnNpdGVzGAEgAygLMioubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlV2Vic2l0ZVJlcXVlc3QaTwoLVXBkYXRlUG9ydHMSQAoRcG9ydF9wdWJsaWNhdGlvbnMYASADKAsyJS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Qb3J0UHVibGljYXRpb24aIQoMVXBkYXRlT3duZXJzEhEKCW93bmVyX2lkcxgBIAMoCUIHCgVfbmFtZUILCglfcmVmX25hbWVCCQoHX2NvbmZpZ0ILCglfd2Vic2l0ZXNCFAoSX3BvcnRfcHVibGljYXRpb25zQgwKCl9vd25lcl9pZHNCEwoRX2NvbmZpZ19maWxlX3BhdGhCEwoRX2ludGVybmFsX3NlcnZpY2VKBAgDEAQiagoZRXhwb3J0QXBwbGljYXRpb25zUmVxdWVzdBIXCg9hcHBsaWNhdGlvbl9pZHMYASADKAkSNAoGZm9ybWF0GAIgASgOMiQubmVvc2hvd2Nhc2UucHJvdG9idWYuTWFuaWZlc3RGb3JtYXQiLgoaRXhwb3J0QXBwbGljYXRpb25zUmVzcG9uc2USEAoIbWFuaWZlc3QYASABKAkiOQoUQXBwbHlNYW5pZmVzdFJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAkSDwoHZHJ5X3J1bhgCIAEoCCJBChFNYW5pZmVzdEZpZWxkRGlmZhINCgVmaWVsZBgBIAEoCRIOCgZiZWZvcmUYAiABKAkSDQoFYWZ0ZXIYAyABKAkimQEKGU1hbmlmZXN0QXBwbGljYXRpb25SZXN1bHQSFgoOYXBwbGljYXRpb25faWQYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZjcmVhdGUYAyABKAgSNgoFZGlmZnMYBCADKAsyJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5NYW5pZmVzdEZpZWxkRGlmZhIOCgZlcnJvcnMYBSADKAkiagoVQXBwbHlNYW5pZmVzdFJlc3BvbnNlEkAKB3Jlc3VsdHMYASADKAsyLy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5NYW5pZmVzdEFwcGxpY2F0aW9uUmVzdWx0Eg8KB2FwcGxpZWQYAiABKAgiUQoXR2V0UmVwb3NpdG9yaWVzUmVzcG9uc2USNgoMcmVwb3NpdG9yaWVzGAEgAygLMiAubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeSJSChdHZXRBcHBsaWNhdGlvbnNSZXNwb25zZRI3CgxhcHBsaWNhdGlvbnMYASADKAsyIS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbiIiChRBcHBsaWNhdGlvbklkUmVxdWVzdBIKCgJpZBgBIAEoCSIyChNHZXRBbGxCdWlsZHNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUiIgoOQnVpbGRJZFJlcXVlc3QSEAoIYnVpbGRfaWQYASABKAkiKAoRQXJ0aWZhY3RJZFJlcXVlc3QSEwoLYXJ0aWZhY3RfaWQYASABKAkiQAoRR2V0QnVpbGRzUmVzcG9uc2USKwoGYnVpbGRzGAEgAygLMhsubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGQiUQobU2V0QXBwbGljYXRpb25FbnZWYXJSZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEgsKA2tleRgCIAEoCRINCgV2YWx1ZRgDIAEoCSJFCh5EZWxldGVBcHBsaWNhdGlvbkVudlZhclJlcXVlc3QSFgoOYXBwbGljYXRpb25faWQYASABKAkSCwoDa2V5GAIgASgJIo8BChxHZXRBcHBsaWNhdGlvbk1ldHJpY3NSZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEhQKDG1ldHJpY3NfbmFtZRgCIAEoCRIqCgZiZWZvcmUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWxpbWl0X3NlY29uZHMYBCABKAMikAEKCUxvZ0ZpbHRlchIQCghjb250YWlucxgBIAEoCRIOCgZyZWdleHAYAiABKAkSNgoGc3RyZWFtGAMgASgOMiYubmVvc2hvd2Nhc2UucHJvdG9idWYuTG9nRmlsdGVyLlN0cmVhbSIpCgZTdHJlYW0SBwoDQUxMEAASCgoGU1RET1VUEAESCgoGU1RERVJSEAIilgEKEEdldE91dHB1dFJlcXVlc3QSFgoOYXBwbGljYXRpb25faWQYASABKAkSKgoGYmVmb3JlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVsaW1pdBgDIAEoBRIvCgZmaWx0ZXIYBCABKAsyHy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Mb2dGaWx0ZXIijAEKFkdldE91dHB1dFN0cmVhbVJlcXVlc3QSFgoOYXBwbGljYXRpb25faWQYASABKAkSKQoFYmVnaW4YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KBmZpbHRlchgDIAEoCzIfLm5lb3Nob3djYXNlLnByb3RvYnVmLkxvZ0ZpbHRlciK2AgoWQ3JlYXRlQWxlcnRSdWxlUmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEjIKBGtpbmQYAyABKA4yJC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BbGVydFJ1bGUuS2luZBIOCgZtZXRyaWMYBCABKAkSPgoKY29tcGFyaXNvbhgFIAEoDjIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFsZXJ0UnVsZS5Db21wYXJpc29uEhEKCXRocmVzaG9sZBgGIAEoARIYChBkdXJhdGlvbl9zZWNvbmRzGAcgASgDEkUKDnRocmVzaG9sZF91bml0GAggASgOMi0ubmVvc2hvd2Nhc2UucHJvdG9idWYuQWxlcnRSdWxlLlRocmVzaG9sZFVuaXQiQQoWRGVsZXRlQWxlcnRSdWxlUmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIPCgdydWxlX2lkGAIgASgJIugBCiVDcmVhdGVOb3RpZmljYXRpb25TdWJzY3JpcHRpb25SZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEkEKBHNpbmsYAiABKA4yMy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Ob3RpZmljYXRpb25TdWJzY3JpcHRpb24uU2luaxIOCgZ0YXJnZXQYAyABKAkSDgoGc2VjcmV0GAQgASgJEkQKBmV2ZW50cxgFIAMoDjI0Lm5lb3Nob3djYXNlLnByb3RvYnVmLk5vdGlmaWNhdGlvblN1YnNjcmlwdGlvbi5FdmVudCJYCiVEZWxldGVOb3RpZmljYXRpb25TdWJzY3JpcHRpb25SZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEhcKD3N1YnNjcmlwdGlvbl9pZBgCIAEoCSI5ChBHZXRBbGVydHNSZXF1ZXN0EhYKDmFwcGxpY2F0aW9uX2lkGAEgASgJEg0KBWxpbWl0GAIgASgFInAKG0dldEFwcGxpY2F0aW9uRXZlbnRzUmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIqCgZiZWZvcmUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbWl0GAMgASgFImYKIUdldEFwcGxpY2F0aW9uRXZlbnRzU3RyZWFtUmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoCRIpCgViZWdpbhgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQQoXUmV0cnlDb21taXRCdWlsZFJlcXVlc3QSFgoOYXBwbGljYXRpb25faWQYASABKAkSDgoGY29tbWl0GAIgASgJIkcKGUdldFJlcG9zaXRvcnlSZWZzUmVzcG9uc2USKgoEcmVmcxgBIAMoCzIcLm5lb3Nob3djYXNlLnByb3RvYnVmLkdpdFJlZiolCgpEZXBsb3lUeXBlEgsKB1JVTlRJTUUQABIKCgZTVEFUSUMQASoxChJBdXRoZW50aWNhdGlvblR5cGUSBwoDT0ZGEAASCAoEU09GVBABEggKBEhBUkQQAiorChdQb3J0UHVibGljYXRpb25Qcm90b2NvbBIHCgNUQ1AQABIHCgNVRFAQASpeCgtCdWlsZFN0YXR1cxIKCgZRVUVVRUQQABIMCghCVUlMRElORxABEg0KCVNVQ0NFRURFRBACEgoKBkZBSUxFRBADEg0KCUNBTkNFTExFRBAEEgsKB1NLSVBQRUQQBSokCg5NYW5pZmVzdEZvcm1hdBIICgRZQU1MEAASCAoESlNPThABMpYuCgpBUElTZXJ2aWNlEk4KDUdldFN5c3RlbUluZm8SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5TeXN0ZW1JbmZvIgOQAgESWAoPR2VuZXJhdGVLZXlQYWlyEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gi0ubmVvc2hvd2Nhc2UucHJvdG9idWYuR2VuZXJhdGVLZXlQYWlyUmVzcG9uc2USQAoFR2V0TWUSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5Vc2VyIgOQAgESTwoIR2V0VXNlcnMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaJi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRVc2Vyc1Jlc3BvbnNlIgOQAgESWgoNQ3JlYXRlVXNlcktleRIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZVVzZXJLZXlSZXF1ZXN0Gh0ubmVvc2hvd2Nhc2UucHJvdG9idWYuVXNlcktleRJVCgtHZXRVc2VyS2V5cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRopLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFVzZXJLZXlzUmVzcG9uc2UiA5ACARJTCg1EZWxldGVVc2VyS2V5EioubmVvc2hvd2Nhc2UucHJvdG9idWYuRGVsZXRlVXNlcktleVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSUwoKR2V0TXlVc2FnZRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRooLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldE15VXNhZ2VSZXNwb25zZSIDkAIBElEKDFNldFVzZXJRdW90YRIpLm5lb3Nob3djYXNlLnByb3RvYnVmLlNldFVzZXJRdW90YVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSXwoQR2V0Q3VzdG9tRG9tYWlucxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRouLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEN1c3RvbURvbWFpbnNSZXNwb25zZSIDkAIBEmkKEkNyZWF0ZUN1c3RvbURvbWFpbhIvLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZUN1c3RvbURvbWFpblJlcXVlc3QaIi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DdXN0b21Eb21haW4SZQoSVmVyaWZ5Q3VzdG9tRG9tYWluEisubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3VzdG9tRG9tYWluSWRSZXF1ZXN0GiIubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3VzdG9tRG9tYWluElkKEkRlbGV0ZUN1c3RvbURvbWFpbhIrLm5lb3Nob3djYXNlLnByb3RvYnVmLkN1c3RvbURvbWFpbklkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJjChJHZXRUTFNDZXJ0aWZpY2F0ZXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaMC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRUTFNDZXJ0aWZpY2F0ZXNSZXNwb25zZSIDkAIBEm8KFFVwbG9hZFRMU0NlcnRpZmljYXRlEjEubmVvc2hvd2Nhc2UucHJvdG9idWYuVXBsb2FkVExTQ2VydGlmaWNhdGVSZXF1ZXN0GiQubmVvc2hvd2Nhc2UucHJvdG9idWYuVExTQ2VydGlmaWNhdGUSXQoURGVsZXRlVExTQ2VydGlmaWNhdGUSLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5UTFNDZXJ0aWZpY2F0ZUlkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJjChBDcmVhdGVSZXBvc2l0b3J5Ei0ubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlUmVwb3NpdG9yeVJlcXVlc3QaIC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5SZXBvc2l0b3J5EnMKD0dldFJlcG9zaXRvcmllcxIsLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFJlcG9zaXRvcmllc1JlcXVlc3QaLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRSZXBvc2l0b3JpZXNSZXNwb25zZSIDkAIBEoIBChRHZXRSZXBvc2l0b3J5Q29tbWl0cxIxLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFJlcG9zaXRvcnlDb21taXRzUmVxdWVzdBoyLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldFJlcG9zaXRvcnlDb21taXRzUmVzcG9uc2UiA5ACARJhCg1HZXRSZXBvc2l0b3J5EikubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeUlkUmVxdWVzdBogLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlcG9zaXRvcnkiA5ACARJ0ChFHZXRSZXBvc2l0b3J5UmVmcxIpLm5lb3Nob3djYXNlLnByb3RvYnVmLlJlcG9zaXRvcnlJZFJlcXVlc3QaLy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRSZXBvc2l0b3J5UmVmc1Jlc3BvbnNlIgOQAgESWQoQVXBkYXRlUmVwb3NpdG9yeRItLm5lb3Nob3djYXNlLnByb3RvYnVmLlVwZGF0ZVJlcG9zaXRvcnlSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElYKEVJlZnJlc2hSZXBvc2l0b3J5EikubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeUlkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJVChBEZWxldGVSZXBvc2l0b3J5EikubmVvc2hvd2Nhc2UucHJvdG9idWYuUmVwb3NpdG9yeUlkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJmChFDcmVhdGVBcHBsaWNhdGlvbhIuLm5lb3Nob3djYXNlLnByb3RvYnVmLkNyZWF0ZUFwcGxpY2F0aW9uUmVxdWVzdBohLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uEmwKFER1cGxpY2F0ZUFwcGxpY2F0aW9uEjEubmVvc2hvd2Nhc2UucHJvdG9idWYuRHVwbGljYXRlQXBwbGljYXRpb25SZXF1ZXN0GiEubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb24ScwoPR2V0QXBwbGljYXRpb25zEiwubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0QXBwbGljYXRpb25zUmVxdWVzdBotLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEFwcGxpY2F0aW9uc1Jlc3BvbnNlIgOQAgESZAoOR2V0QXBwbGljYXRpb24SKi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbklkUmVxdWVzdBohLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uIgOQAgESWwoRVXBkYXRlQXBwbGljYXRpb24SLi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5VcGRhdGVBcHBsaWNhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVwoRRGVsZXRlQXBwbGljYXRpb24SKi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbklkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJ8ChJFeHBvcnRBcHBsaWNhdGlvbnMSLy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5FeHBvcnRBcHBsaWNhdGlvbnNSZXF1ZXN0GjAubmVvc2hvd2Nhc2UucHJvdG9idWYuRXhwb3J0QXBwbGljYXRpb25zUmVzcG9uc2UiA5ACARJoCg1BcHBseU1hbmlmZXN0EioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbHlNYW5pZmVzdFJlcXVlc3QaKy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBseU1hbmlmZXN0UmVzcG9uc2USWgoTR2V0QXZhaWxhYmxlTWV0cmljcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRomLm5lb3Nob3djYXNlLnByb3RvYnVmLkF2YWlsYWJsZU1ldHJpY3MiA5ACARJ6ChVHZXRBcHBsaWNhdGlvbk1ldHJpY3MSMi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRBcHBsaWNhdGlvbk1ldHJpY3NSZXF1ZXN0GigubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25NZXRyaWNzIgOQAgESYgoJR2V0T3V0cHV0EiYubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0T3V0cHV0UmVxdWVzdBooLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uT3V0cHV0cyIDkAIBEmoKD0dldE91dHB1dFN0cmVhbRIsLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldE91dHB1dFN0cmVhbVJlcXVlc3QaJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcHBsaWNhdGlvbk91dHB1dDABEncKFEdldEFwcGxpY2F0aW9uRXZlbnRzEjEubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0QXBwbGljYXRpb25FdmVudHNSZXF1ZXN0GicubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25FdmVudHMiA5ACARJ/ChpHZXRBcHBsaWNhdGlvbkV2ZW50c1N0cmVhbRI3Lm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEFwcGxpY2F0aW9uRXZlbnRzU3RyZWFtUmVxdWVzdBomLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uRXZlbnQwARJqChBHZXRXZWJzaXRlU3RhdHVzEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaJS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5XZWJzaXRlU3RhdHVzZXMiA5ACARJiCg1HZXRBbGVydFJ1bGVzEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaIC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BbGVydFJ1bGVzIgOQAgESYAoPQ3JlYXRlQWxlcnRSdWxlEiwubmVvc2hvd2Nhc2UucHJvdG9idWYuQ3JlYXRlQWxlcnRSdWxlUmVxdWVzdBofLm5lb3Nob3djYXNlLnByb3RvYnVmLkFsZXJ0UnVsZRJXCg9EZWxldGVBbGVydFJ1bGUSLC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5EZWxldGVBbGVydFJ1bGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElYKCUdldEFsZXJ0cxImLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEFsZXJ0c1JlcXVlc3QaHC5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BbGVydHMiA5ACARKAAQocR2V0Tm90aWZpY2F0aW9uU3Vic2NyaXB0aW9ucxIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uSWRSZXF1ZXN0Gi8ubmVvc2hvd2Nhc2UucHJvdG9idWYuTm90aWZpY2F0aW9uU3Vic2NyaXB0aW9ucyIDkAIBEo0BCh5DcmVhdGVOb3RpZmljYXRpb25TdWJzY3JpcHRpb24SOy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5DcmVhdGVOb3RpZmljYXRpb25TdWJzY3JpcHRpb25SZXF1ZXN0Gi4ubmVvc2hvd2Nhc2UucHJvdG9idWYuTm90aWZpY2F0aW9uU3Vic2NyaXB0aW9uEnUKHkRlbGV0ZU5vdGlmaWNhdGlvblN1YnNjcmlwdGlvbhI7Lm5lb3Nob3djYXNlLnByb3RvYnVmLkRlbGV0ZU5vdGlmaWNhdGlvblN1YnNjcmlwdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSZwoKR2V0RW52VmFycxIqLm5lb3Nob3djYXNlLnByb3RvYnVmLkFwcGxpY2F0aW9uSWRSZXF1ZXN0GigubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25FbnZWYXJzIgOQAgESVgoJU2V0RW52VmFyEjEubmVvc2hvd2Nhc2UucHJvdG9idWYuU2V0QXBwbGljYXRpb25FbnZWYXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElwKDERlbGV0ZUVudlZhchI0Lm5lb3Nob3djYXNlLnByb3RvYnVmLkRlbGV0ZUFwcGxpY2F0aW9uRW52VmFyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJWChBTdGFydEFwcGxpY2F0aW9uEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVQoPU3RvcEFwcGxpY2F0aW9uEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSZwoMR2V0QWxsQnVpbGRzEikubmVvc2hvd2Nhc2UucHJvdG9idWYuR2V0QWxsQnVpbGRzUmVxdWVzdBonLm5lb3Nob3djYXNlLnByb3RvYnVmLkdldEJ1aWxkc1Jlc3BvbnNlIgOQAgESZQoJR2V0QnVpbGRzEioubmVvc2hvd2Nhc2UucHJvdG9idWYuQXBwbGljYXRpb25JZFJlcXVlc3QaJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5HZXRCdWlsZHNSZXNwb25zZSIDkAIBElIKCEdldEJ1aWxkEiQubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRJZFJlcXVlc3QaGy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZCIDkAIBElkKEFJldHJ5Q29tbWl0QnVpbGQSLS5uZW9zaG93Y2FzZS5wcm90b2J1Zi5SZXRyeUNvbW1pdEJ1aWxkUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJLCgtDYW5jZWxCdWlsZBIkLm5lb3Nob3djYXNlLnByb3RvYnVmLkJ1aWxkSWRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElgKC0dldEJ1aWxkTG9nEiQubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRJZFJlcXVlc3QaHi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZExvZyIDkAIBElsKEUdldEJ1aWxkTG9nU3RyZWFtEiQubmVvc2hvd2Nhc2UucHJvdG9idWYuQnVpbGRJZFJlcXVlc3QaHi5uZW9zaG93Y2FzZS5wcm90b2J1Zi5CdWlsZExvZzABEmcKEEdldEJ1aWxkQXJ0aWZhY3QSJy5uZW9zaG93Y2FzZS5wcm90b2J1Zi5BcnRpZmFjdElkUmVxdWVzdBolLm5lb3Nob3djYXNlLnByb3RvYnVmLkFydGlmYWN0Q29udGVudCIDkAIBYgZwcm90bzM", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_neoshowcase_protobuf_null]);
 
 /**
  * @generated from message neoshowcase.protobuf.SSHInfo
@@ -251,6 +251,262 @@ export type UserKey = Message<"neoshowcase.protobuf.UserKey"> & {
 export const UserKeySchema: GenMessage<UserKey> = /*@__PURE__*/
   messageDesc(file_neoshowcase_protobuf_gateway, 6);
 
+/**
+ * CustomDomain ユーザーが登録した独自ドメイン 所有権が確認されると自身のアプリで使用できます
+ *
+ * @generated from message neoshowcase.protobuf.CustomDomain
+ */
+export type CustomDomain = Message<"neoshowcase.protobuf.CustomDomain"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.CustomDomain.VerificationMethod method = 3;
+   */
+  method: CustomDomain_VerificationMethod;
+
+  /**
+   * @generated from field: string token = 4;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string txt_record_name = 5;
+   */
+  txtRecordName: string;
+
+  /**
+   * @generated from field: string txt_record_value = 6;
+   */
+  txtRecordValue: string;
+
+  /**
+   * @generated from field: string http_url = 7;
+   */
+  httpUrl: string;
+
+  /**
+   * @generated from field: bool verified = 8;
+   */
+  verified: boolean;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.NullTimestamp verified_at = 9;
+   */
+  verifiedAt?: NullTimestamp;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.NullTimestamp checked_at = 10;
+   */
+  checkedAt?: NullTimestamp;
+
+  /**
+   * error 最後に失敗した確認の理由
+   *
+   * @generated from field: string error = 11;
+   */
+  error: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 12;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CustomDomain.
+ * Use `create(CustomDomainSchema)` to create a new message.
+ */
+export const CustomDomainSchema: GenMessage<CustomDomain> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 7);
+
+/**
+ * @generated from enum neoshowcase.protobuf.CustomDomain.VerificationMethod
+ */
+export enum CustomDomain_VerificationMethod {
+  /**
+   * DNS txt_record_nameのTXTレコードにtxt_record_valueを設定して確認します
+   *
+   * @generated from enum value: DNS = 0;
+   */
+  DNS = 0,
+
+  /**
+   * HTTP http_urlでtokenを返すことで確認します
+   *
+   * @generated from enum value: HTTP = 1;
+   */
+  HTTP = 1,
+}
+
+/**
+ * Describes the enum neoshowcase.protobuf.CustomDomain.VerificationMethod.
+ */
+export const CustomDomain_VerificationMethodSchema: GenEnum<CustomDomain_VerificationMethod> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 7, 0);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetCustomDomainsResponse
+ */
+export type GetCustomDomainsResponse = Message<"neoshowcase.protobuf.GetCustomDomainsResponse"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.CustomDomain domains = 1;
+   */
+  domains: CustomDomain[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetCustomDomainsResponse.
+ * Use `create(GetCustomDomainsResponseSchema)` to create a new message.
+ */
+export const GetCustomDomainsResponseSchema: GenMessage<GetCustomDomainsResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 8);
+
+/**
+ * TLSCertificate アップロードされたTLS証明書 ACMEで発行された証明書の代わりにfqdnのWebサイトで使用されます
+ *
+ * @generated from message neoshowcase.protobuf.TLSCertificate
+ */
+export type TLSCertificate = Message<"neoshowcase.protobuf.TLSCertificate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * user_id アップロードしたユーザーのID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * fqdn "*.example.com"のようなワイルドカードドメインの場合 直下のサブドメインで使用されます
+   *
+   * @generated from field: string fqdn = 3;
+   */
+  fqdn: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp not_before = 4;
+   */
+  notBefore?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp not_after = 5;
+   */
+  notAfter?: Timestamp;
+
+  /**
+   * expiring 有効期限が近いか 既に切れているか 自動では更新されないため再アップロードが必要です
+   *
+   * @generated from field: bool expiring = 6;
+   */
+  expiring: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.TLSCertificate.
+ * Use `create(TLSCertificateSchema)` to create a new message.
+ */
+export const TLSCertificateSchema: GenMessage<TLSCertificate> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 9);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetTLSCertificatesResponse
+ */
+export type GetTLSCertificatesResponse = Message<"neoshowcase.protobuf.GetTLSCertificatesResponse"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.TLSCertificate certificates = 1;
+   */
+  certificates: TLSCertificate[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetTLSCertificatesResponse.
+ * Use `create(GetTLSCertificatesResponseSchema)` to create a new message.
+ */
+export const GetTLSCertificatesResponseSchema: GenMessage<GetTLSCertificatesResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 10);
+
+/**
+ * ResourceQuota ユーザーが所有するアプリケーションで使えるリソースの上限 負の値は無制限を表します
+ *
+ * @generated from message neoshowcase.protobuf.ResourceQuota
+ */
+export type ResourceQuota = Message<"neoshowcase.protobuf.ResourceQuota"> & {
+  /**
+   * @generated from field: int32 max_applications = 1;
+   */
+  maxApplications: number;
+
+  /**
+   * @generated from field: int32 max_running_applications = 2;
+   */
+  maxRunningApplications: number;
+
+  /**
+   * @generated from field: int32 max_port_publications = 3;
+   */
+  maxPortPublications: number;
+
+  /**
+   * @generated from field: int32 max_databases = 4;
+   */
+  maxDatabases: number;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ResourceQuota.
+ * Use `create(ResourceQuotaSchema)` to create a new message.
+ */
+export const ResourceQuotaSchema: GenMessage<ResourceQuota> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 11);
+
+/**
+ * @generated from message neoshowcase.protobuf.ResourceUsage
+ */
+export type ResourceUsage = Message<"neoshowcase.protobuf.ResourceUsage"> & {
+  /**
+   * @generated from field: int32 applications = 1;
+   */
+  applications: number;
+
+  /**
+   * @generated from field: int32 running_applications = 2;
+   */
+  runningApplications: number;
+
+  /**
+   * @generated from field: int32 port_publications = 3;
+   */
+  portPublications: number;
+
+  /**
+   * @generated from field: int32 databases = 4;
+   */
+  databases: number;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ResourceUsage.
+ * Use `create(ResourceUsageSchema)` to create a new message.
+ */
+export const ResourceUsageSchema: GenMessage<ResourceUsage> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 12);
+
 /**
  * @generated from message neoshowcase.protobuf.Repository
  */
@@ -291,7 +547,7 @@ export type Repository = Message<"neoshowcase.protobuf.Repository"> & {
  * Use `create(RepositorySchema)` to create a new message.
  */
 export const RepositorySchema: GenMessage<Repository> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 7);
+  messageDesc(file_neoshowcase_protobuf_gateway, 13);
 
 /**
  * @generated from enum neoshowcase.protobuf.Repository.AuthMethod
@@ -317,7 +573,7 @@ export enum Repository_AuthMethod {
  * Describes the enum neoshowcase.protobuf.Repository.AuthMethod.
  */
 export const Repository_AuthMethodSchema: GenEnum<Repository_AuthMethod> = /*@__PURE__*/
-  enumDesc(file_neoshowcase_protobuf_gateway, 7, 0);
+  enumDesc(file_neoshowcase_protobuf_gateway, 13, 0);
 
 /**
  * @generated from message neoshowcase.protobuf.SimpleCommit
@@ -349,7 +605,7 @@ export type SimpleCommit = Message<"neoshowcase.protobuf.SimpleCommit"> & {
  * Use `create(SimpleCommitSchema)` to create a new message.
  */
 export const SimpleCommitSchema: GenMessage<SimpleCommit> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 8);
+  messageDesc(file_neoshowcase_protobuf_gateway, 14);
 
 /**
  * @generated from message neoshowcase.protobuf.AutoShutdownConfig
@@ -371,7 +627,7 @@ export type AutoShutdownConfig = Message<"neoshowcase.protobuf.AutoShutdownConfi
  * Use `create(AutoShutdownConfigSchema)` to create a new message.
  */
 export const AutoShutdownConfigSchema: GenMessage<AutoShutdownConfig> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 9);
+  messageDesc(file_neoshowcase_protobuf_gateway, 15);
 
 /**
  * @generated from enum neoshowcase.protobuf.AutoShutdownConfig.StartupBehavior
@@ -397,7 +653,7 @@ export enum AutoShutdownConfig_StartupBehavior {
  * Describes the enum neoshowcase.protobuf.AutoShutdownConfig.StartupBehavior.
  */
 export const AutoShutdownConfig_StartupBehaviorSchema: GenEnum<AutoShutdownConfig_StartupBehavior> = /*@__PURE__*/
-  enumDesc(file_neoshowcase_protobuf_gateway, 9, 0);
+  enumDesc(file_neoshowcase_protobuf_gateway, 15, 0);
 
 /**
  * @generated from message neoshowcase.protobuf.RuntimeConfig
@@ -434,7 +690,7 @@ export type RuntimeConfig = Message<"neoshowcase.protobuf.RuntimeConfig"> & {
  * Use `create(RuntimeConfigSchema)` to create a new message.
  */
 export const RuntimeConfigSchema: GenMessage<RuntimeConfig> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 10);
+  messageDesc(file_neoshowcase_protobuf_gateway, 16);
 
 /**
  * @generated from message neoshowcase.protobuf.BuildConfigRuntimeBuildpack
@@ -456,7 +712,7 @@ export type BuildConfigRuntimeBuildpack = Message<"neoshowcase.protobuf.BuildCon
  * Use `create(BuildConfigRuntimeBuildpackSchema)` to create a new message.
  */
 export const BuildConfigRuntimeBuildpackSchema: GenMessage<BuildConfigRuntimeBuildpack> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 11);
+  messageDesc(file_neoshowcase_protobuf_gateway, 17);
 
 /**
  * @generated from message neoshowcase.protobuf.BuildConfigRuntimeCmd
@@ -483,7 +739,7 @@ export type BuildConfigRuntimeCmd = Message<"neoshowcase.protobuf.BuildConfigRun
  * Use `create(BuildConfigRuntimeCmdSchema)` to create a new message.
  */
 export const BuildConfigRuntimeCmdSchema: GenMessage<BuildConfigRuntimeCmd> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 12);
+  messageDesc(file_neoshowcase_protobuf_gateway, 18);
 
 /**
  * @generated from message neoshowcase.protobuf.BuildConfigRuntimeDockerfile
@@ -510,7 +766,7 @@ export type BuildConfigRuntimeDockerfile = Message<"neoshowcase.protobuf.BuildCo
  * Use `create(BuildConfigRuntimeDockerfileSchema)` to create a new message.
  */
 export const BuildConfigRuntimeDockerfileSchema: GenMessage<BuildConfigRuntimeDockerfile> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 13);
+  messageDesc(file_neoshowcase_protobuf_gateway, 19);
 
 /**
  * @generated from message neoshowcase.protobuf.StaticConfig
@@ -525,6 +781,34 @@ export type StaticConfig = Message<"neoshowcase.protobuf.StaticConfig"> & {
    * @generated from field: bool spa = 2;
    */
   spa: boolean;
+
+  /**
+   * 404ページとして配信する静的成果物内のパス (例: "/404.html") 空の場合はデフォルトのページ
+   *
+   * @generated from field: string not_found_path = 3;
+   */
+  notFoundPath: string;
+
+  /**
+   * ハッシュ付きファイル名のアセット (例: "/assets/index-BxK3a9_d.js") のCache-Control 空の場合は付与しない
+   *
+   * @generated from field: string asset_cache_control = 4;
+   */
+  assetCacheControl: string;
+
+  /**
+   * HTMLのCache-Control 空の場合は付与しない
+   *
+   * @generated from field: string html_cache_control = 5;
+   */
+  htmlCacheControl: string;
+
+  /**
+   * クライアントが対応していれば、圧縮済みファイル (.br, .gz) を配信するか
+   *
+   * @generated from field: bool precompressed = 6;
+   */
+  precompressed: boolean;
 };
 
 /**
@@ -532,7 +816,7 @@ export type StaticConfig = Message<"neoshowcase.protobuf.StaticConfig"> & {
  * Use `create(StaticConfigSchema)` to create a new message.
  */
 export const StaticConfigSchema: GenMessage<StaticConfig> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 14);
+  messageDesc(file_neoshowcase_protobuf_gateway, 20);
 
 /**
  * @generated from message neoshowcase.protobuf.BuildConfigStaticBuildpack
@@ -554,7 +838,7 @@ export type BuildConfigStaticBuildpack = Message<"neoshowcase.protobuf.BuildConf
  * Use `create(BuildConfigStaticBuildpackSchema)` to create a new message.
  */
 export const BuildConfigStaticBuildpackSchema: GenMessage<BuildConfigStaticBuildpack> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 15);
+  messageDesc(file_neoshowcase_protobuf_gateway, 21);
 
 /**
  * @generated from message neoshowcase.protobuf.BuildConfigStaticCmd
@@ -581,7 +865,7 @@ export type BuildConfigStaticCmd = Message<"neoshowcase.protobuf.BuildConfigStat
  * Use `create(BuildConfigStaticCmdSchema)` to create a new message.
  */
 export const BuildConfigStaticCmdSchema: GenMessage<BuildConfigStaticCmd> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 16);
+  messageDesc(file_neoshowcase_protobuf_gateway, 22);
 
 /**
  * @generated from message neoshowcase.protobuf.BuildConfigStaticDockerfile
@@ -608,7 +892,7 @@ export type BuildConfigStaticDockerfile = Message<"neoshowcase.protobuf.BuildCon
  * Use `create(BuildConfigStaticDockerfileSchema)` to create a new message.
  */
 export const BuildConfigStaticDockerfileSchema: GenMessage<BuildConfigStaticDockerfile> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 17);
+  messageDesc(file_neoshowcase_protobuf_gateway, 23);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationConfig
@@ -661,7 +945,7 @@ export type ApplicationConfig = Message<"neoshowcase.protobuf.ApplicationConfig"
  * Use `create(ApplicationConfigSchema)` to create a new message.
  */
 export const ApplicationConfigSchema: GenMessage<ApplicationConfig> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 18);
+  messageDesc(file_neoshowcase_protobuf_gateway, 24);
 
 /**
  * @generated from message neoshowcase.protobuf.Website
@@ -706,6 +990,33 @@ export type Website = Message<"neoshowcase.protobuf.Website"> & {
    * @generated from field: neoshowcase.protobuf.AuthenticationType authentication = 8;
    */
   authentication: AuthenticationType;
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteRule rules = 9;
+   */
+  rules: WebsiteRule[];
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteHeaderPolicy header_policy = 10;
+   */
+  headerPolicy?: WebsiteHeaderPolicy;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteAccessControl access_control = 11;
+   */
+  accessControl?: WebsiteAccessControl;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteRateLimit rate_limit = 12;
+   */
+  rateLimit?: WebsiteRateLimit;
+
+  /**
+   * backends 重み付けしてトラフィックを分配するアプリ (このアプリ自身を含む) 空の場合はこのアプリが全てのトラフィックを受けます
+   *
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteBackend backends = 13;
+   */
+  backends: WebsiteBackend[];
 };
 
 /**
@@ -713,61 +1024,378 @@ export type Website = Message<"neoshowcase.protobuf.Website"> & {
  * Use `create(WebsiteSchema)` to create a new message.
  */
 export const WebsiteSchema: GenMessage<Website> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 19);
+  messageDesc(file_neoshowcase_protobuf_gateway, 25);
 
 /**
- * @generated from message neoshowcase.protobuf.PortPublication
+ * WebsiteBackend トラフィックの一部を受けるアプリ
+ *
+ * @generated from message neoshowcase.protobuf.WebsiteBackend
  */
-export type PortPublication = Message<"neoshowcase.protobuf.PortPublication"> & {
-  /**
-   * @generated from field: int32 internet_port = 1;
-   */
-  internetPort: number;
-
+export type WebsiteBackend = Message<"neoshowcase.protobuf.WebsiteBackend"> & {
   /**
-   * @generated from field: int32 application_port = 2;
+   * application_id 同じオーナーのランタイムアプリ 分配元のWebサイトと同じポート・プロトコルのWebサイトを持つ必要があります
+   *
+   * @generated from field: string application_id = 1;
    */
-  applicationPort: number;
+  applicationId: string;
 
   /**
-   * @generated from field: neoshowcase.protobuf.PortPublicationProtocol protocol = 3;
+   * weight 他のbackendに対する相対的なトラフィックの割合 0の場合はトラフィックを送りません
+   *
+   * @generated from field: int32 weight = 2;
    */
-  protocol: PortPublicationProtocol;
+  weight: number;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.PortPublication.
- * Use `create(PortPublicationSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.WebsiteBackend.
+ * Use `create(WebsiteBackendSchema)` to create a new message.
  */
-export const PortPublicationSchema: GenMessage<PortPublication> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 20);
+export const WebsiteBackendSchema: GenMessage<WebsiteBackend> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 26);
 
 /**
- * @generated from message neoshowcase.protobuf.Application
+ * WebsiteRule Webサイトのリダイレクト・書き換えルール 宣言順に評価されます
+ *
+ * @generated from message neoshowcase.protobuf.WebsiteRule
  */
-export type Application = Message<"neoshowcase.protobuf.Application"> & {
+export type WebsiteRule = Message<"neoshowcase.protobuf.WebsiteRule"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: neoshowcase.protobuf.WebsiteRule.Type type = 1;
    */
-  id: string;
+  type: WebsiteRule_Type;
 
   /**
-   * @generated from field: string name = 2;
+   * path_regex リクエストパス全体にマッチする正規表現 (RE2)
+   *
+   * @generated from field: string path_regex = 2;
    */
-  name: string;
+  pathRegex: string;
 
   /**
-   * @generated from field: string repository_id = 3;
+   * target リダイレクト先のパスまたはURL、または書き換え後のパス ${1} でキャプチャグループを参照できます
+   *
+   * @generated from field: string target = 3;
    */
-  repositoryId: string;
+  target: string;
 
   /**
-   * @generated from field: string ref_name = 4;
+   * status_code (REDIRECT only) 301, 302, 307, 308 のいずれか
+   *
+   * @generated from field: int32 status_code = 4;
    */
-  refName: string;
+  statusCode: number;
 
   /**
-   * @generated from field: string commit = 5;
+   * preserve_query (REDIRECT only) クエリ文字列をリダイレクト先に引き継ぎます
+   *
+   * @generated from field: bool preserve_query = 5;
+   */
+  preserveQuery: boolean;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteRule.
+ * Use `create(WebsiteRuleSchema)` to create a new message.
+ */
+export const WebsiteRuleSchema: GenMessage<WebsiteRule> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 27);
+
+/**
+ * @generated from enum neoshowcase.protobuf.WebsiteRule.Type
+ */
+export enum WebsiteRule_Type {
+  /**
+   * REDIRECT targetへリダイレクトします
+   *
+   * @generated from enum value: REDIRECT = 0;
+   */
+  REDIRECT = 0,
+
+  /**
+   * REWRITE リクエストパスをtargetに書き換えます
+   *
+   * @generated from enum value: REWRITE = 1;
+   */
+  REWRITE = 1,
+}
+
+/**
+ * Describes the enum neoshowcase.protobuf.WebsiteRule.Type.
+ */
+export const WebsiteRule_TypeSchema: GenEnum<WebsiteRule_Type> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 27, 0);
+
+/**
+ * WebsiteHeaderPolicy Webサイトのカスタムレスポンスヘッダーとオリジン間リソース共有 (CORS) の設定
+ *
+ * @generated from message neoshowcase.protobuf.WebsiteHeaderPolicy
+ */
+export type WebsiteHeaderPolicy = Message<"neoshowcase.protobuf.WebsiteHeaderPolicy"> & {
+  /**
+   * response_headers 全てのレスポンスに付与するヘッダー hop-by-hopヘッダーや内部で使用するヘッダーは設定できません
+   *
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteHeaderPolicy.Header response_headers = 1;
+   */
+  responseHeaders: WebsiteHeaderPolicy_Header[];
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicy cors = 2;
+   */
+  cors?: WebsiteHeaderPolicy_CORSPolicy;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteHeaderPolicy.
+ * Use `create(WebsiteHeaderPolicySchema)` to create a new message.
+ */
+export const WebsiteHeaderPolicySchema: GenMessage<WebsiteHeaderPolicy> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 28);
+
+/**
+ * @generated from message neoshowcase.protobuf.WebsiteHeaderPolicy.Header
+ */
+export type WebsiteHeaderPolicy_Header = Message<"neoshowcase.protobuf.WebsiteHeaderPolicy.Header"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteHeaderPolicy.Header.
+ * Use `create(WebsiteHeaderPolicy_HeaderSchema)` to create a new message.
+ */
+export const WebsiteHeaderPolicy_HeaderSchema: GenMessage<WebsiteHeaderPolicy_Header> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 28, 0);
+
+/**
+ * CORSPolicy allow_originsが空の場合はCORSを無効にします
+ *
+ * @generated from message neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicy
+ */
+export type WebsiteHeaderPolicy_CORSPolicy = Message<"neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicy"> & {
+  /**
+   * allow_origins 許可するオリジン "*" で全てのオリジンを許可します
+   *
+   * @generated from field: repeated string allow_origins = 1;
+   */
+  allowOrigins: string[];
+
+  /**
+   * @generated from field: repeated string allow_methods = 2;
+   */
+  allowMethods: string[];
+
+  /**
+   * @generated from field: repeated string allow_headers = 3;
+   */
+  allowHeaders: string[];
+
+  /**
+   * @generated from field: repeated string expose_headers = 4;
+   */
+  exposeHeaders: string[];
+
+  /**
+   * @generated from field: bool allow_credentials = 5;
+   */
+  allowCredentials: boolean;
+
+  /**
+   * max_age プリフライトレスポンスのキャッシュ秒数
+   *
+   * @generated from field: int32 max_age = 6;
+   */
+  maxAge: number;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicy.
+ * Use `create(WebsiteHeaderPolicy_CORSPolicySchema)` to create a new message.
+ */
+export const WebsiteHeaderPolicy_CORSPolicySchema: GenMessage<WebsiteHeaderPolicy_CORSPolicy> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 28, 1);
+
+/**
+ * WebsiteAccessControl Webサイトへのアクセス制限 authenticationとは別に、IPアドレス制限、Basic認証の順に適用されます
+ *
+ * @generated from message neoshowcase.protobuf.WebsiteAccessControl
+ */
+export type WebsiteAccessControl = Message<"neoshowcase.protobuf.WebsiteAccessControl"> & {
+  /**
+   * ip_allow_list アクセスを許可するIPアドレスまたはアドレス範囲 (CIDR) 空の場合は制限しません
+   *
+   * @generated from field: repeated string ip_allow_list = 1;
+   */
+  ipAllowList: string[];
+
+  /**
+   * basic_auth_users 空でない場合、いずれかのユーザーでのBasic認証を要求します
+   *
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUser basic_auth_users = 2;
+   */
+  basicAuthUsers: WebsiteAccessControl_BasicAuthUser[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteAccessControl.
+ * Use `create(WebsiteAccessControlSchema)` to create a new message.
+ */
+export const WebsiteAccessControlSchema: GenMessage<WebsiteAccessControl> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 29);
+
+/**
+ * @generated from message neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUser
+ */
+export type WebsiteAccessControl_BasicAuthUser = Message<"neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUser"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * password (input only) 新しいパスワード 空の場合は同じユーザーの既存のパスワードを引き継ぎます
+   *
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUser.
+ * Use `create(WebsiteAccessControl_BasicAuthUserSchema)` to create a new message.
+ */
+export const WebsiteAccessControl_BasicAuthUserSchema: GenMessage<WebsiteAccessControl_BasicAuthUser> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 29, 0);
+
+/**
+ * WebsiteRateLimit Webサイトへのリクエストのレート制限 クライアントごとにトークンバケットで制限します
+ *
+ * @generated from message neoshowcase.protobuf.WebsiteRateLimit
+ */
+export type WebsiteRateLimit = Message<"neoshowcase.protobuf.WebsiteRateLimit"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * average 1秒あたりの平均リクエスト数 0の場合は管理者の設定したデフォルト値を使用します
+   *
+   * @generated from field: int32 average = 2;
+   */
+  average: number;
+
+  /**
+   * burst 同時に許可する最大リクエスト数 0の場合は管理者の設定したデフォルト値を使用します
+   *
+   * @generated from field: int32 burst = 3;
+   */
+  burst: number;
+
+  /**
+   * source_header クライアントを区別するリクエストヘッダー名 空の場合はIPアドレスで区別します
+   *
+   * @generated from field: string source_header = 4;
+   */
+  sourceHeader: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.WebsiteRateLimit.
+ * Use `create(WebsiteRateLimitSchema)` to create a new message.
+ */
+export const WebsiteRateLimitSchema: GenMessage<WebsiteRateLimit> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 30);
+
+/**
+ * @generated from message neoshowcase.protobuf.PortPublication
+ */
+export type PortPublication = Message<"neoshowcase.protobuf.PortPublication"> & {
+  /**
+   * @generated from field: int32 internet_port = 1;
+   */
+  internetPort: number;
+
+  /**
+   * @generated from field: int32 application_port = 2;
+   */
+  applicationPort: number;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.PortPublicationProtocol protocol = 3;
+   */
+  protocol: PortPublicationProtocol;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.PortPublication.
+ * Use `create(PortPublicationSchema)` to create a new message.
+ */
+export const PortPublicationSchema: GenMessage<PortPublication> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 31);
+
+/**
+ * InternalService 公開ingressを経由せず他のアプリからアクセスできる内部ポート
+ * 他のアプリからは "<アプリ名>.apps.internal" でアクセスできます
+ *
+ * @generated from message neoshowcase.protobuf.InternalService
+ */
+export type InternalService = Message<"neoshowcase.protobuf.InternalService"> & {
+  /**
+   * port 他のアプリに公開するアプリのポート 0の場合は無効
+   *
+   * @generated from field: int32 port = 1;
+   */
+  port: number;
+
+  /**
+   * allowed_app_ids 同じオーナーのアプリに加えてアクセスを許可するアプリのID
+   *
+   * @generated from field: repeated string allowed_app_ids = 2;
+   */
+  allowedAppIds: string[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.InternalService.
+ * Use `create(InternalServiceSchema)` to create a new message.
+ */
+export const InternalServiceSchema: GenMessage<InternalService> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 32);
+
+/**
+ * @generated from message neoshowcase.protobuf.Application
+ */
+export type Application = Message<"neoshowcase.protobuf.Application"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string repository_id = 3;
+   */
+  repositoryId: string;
+
+  /**
+   * @generated from field: string ref_name = 4;
+   */
+  refName: string;
+
+  /**
+   * @generated from field: string commit = 5;
    */
   commit: string;
 
@@ -830,6 +1458,21 @@ export type Application = Message<"neoshowcase.protobuf.Application"> & {
    * @generated from field: optional neoshowcase.protobuf.BuildStatus latest_build_status = 17;
    */
   latestBuildStatus?: BuildStatus;
+
+  /**
+   * @generated from field: string config_file_path = 18;
+   */
+  configFilePath: string;
+
+  /**
+   * @generated from field: string config_file_error = 19;
+   */
+  configFileError: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.InternalService internal_service = 20;
+   */
+  internalService?: InternalService;
 };
 
 /**
@@ -837,7 +1480,7 @@ export type Application = Message<"neoshowcase.protobuf.Application"> & {
  * Use `create(ApplicationSchema)` to create a new message.
  */
 export const ApplicationSchema: GenMessage<Application> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 21);
+  messageDesc(file_neoshowcase_protobuf_gateway, 33);
 
 /**
  * @generated from enum neoshowcase.protobuf.Application.ContainerState
@@ -883,7 +1526,7 @@ export enum Application_ContainerState {
  * Describes the enum neoshowcase.protobuf.Application.ContainerState.
  */
 export const Application_ContainerStateSchema: GenEnum<Application_ContainerState> = /*@__PURE__*/
-  enumDesc(file_neoshowcase_protobuf_gateway, 21, 0);
+  enumDesc(file_neoshowcase_protobuf_gateway, 33, 0);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationEnvVar
@@ -915,7 +1558,7 @@ export type ApplicationEnvVar = Message<"neoshowcase.protobuf.ApplicationEnvVar"
  * Use `create(ApplicationEnvVarSchema)` to create a new message.
  */
 export const ApplicationEnvVarSchema: GenMessage<ApplicationEnvVar> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 22);
+  messageDesc(file_neoshowcase_protobuf_gateway, 34);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationEnvVars
@@ -932,7 +1575,7 @@ export type ApplicationEnvVars = Message<"neoshowcase.protobuf.ApplicationEnvVar
  * Use `create(ApplicationEnvVarsSchema)` to create a new message.
  */
 export const ApplicationEnvVarsSchema: GenMessage<ApplicationEnvVars> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 23);
+  messageDesc(file_neoshowcase_protobuf_gateway, 35);
 
 /**
  * @generated from message neoshowcase.protobuf.Artifact
@@ -974,7 +1617,7 @@ export type Artifact = Message<"neoshowcase.protobuf.Artifact"> & {
  * Use `create(ArtifactSchema)` to create a new message.
  */
 export const ArtifactSchema: GenMessage<Artifact> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 24);
+  messageDesc(file_neoshowcase_protobuf_gateway, 36);
 
 /**
  * @generated from message neoshowcase.protobuf.ArtifactContent
@@ -996,7 +1639,7 @@ export type ArtifactContent = Message<"neoshowcase.protobuf.ArtifactContent"> &
  * Use `create(ArtifactContentSchema)` to create a new message.
  */
 export const ArtifactContentSchema: GenMessage<ArtifactContent> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 25);
+  messageDesc(file_neoshowcase_protobuf_gateway, 37);
 
 /**
  * @generated from message neoshowcase.protobuf.RuntimeImage
@@ -1028,7 +1671,7 @@ export type RuntimeImage = Message<"neoshowcase.protobuf.RuntimeImage"> & {
  * Use `create(RuntimeImageSchema)` to create a new message.
  */
 export const RuntimeImageSchema: GenMessage<RuntimeImage> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 26);
+  messageDesc(file_neoshowcase_protobuf_gateway, 38);
 
 /**
  * @generated from message neoshowcase.protobuf.AvailableMetrics
@@ -1045,7 +1688,7 @@ export type AvailableMetrics = Message<"neoshowcase.protobuf.AvailableMetrics">
  * Use `create(AvailableMetricsSchema)` to create a new message.
  */
 export const AvailableMetricsSchema: GenMessage<AvailableMetrics> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 27);
+  messageDesc(file_neoshowcase_protobuf_gateway, 39);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationMetric
@@ -1067,7 +1710,7 @@ export type ApplicationMetric = Message<"neoshowcase.protobuf.ApplicationMetric"
  * Use `create(ApplicationMetricSchema)` to create a new message.
  */
 export const ApplicationMetricSchema: GenMessage<ApplicationMetric> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 28);
+  messageDesc(file_neoshowcase_protobuf_gateway, 40);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationMetrics
@@ -1084,7 +1727,7 @@ export type ApplicationMetrics = Message<"neoshowcase.protobuf.ApplicationMetric
  * Use `create(ApplicationMetricsSchema)` to create a new message.
  */
 export const ApplicationMetricsSchema: GenMessage<ApplicationMetrics> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 29);
+  messageDesc(file_neoshowcase_protobuf_gateway, 41);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationOutput
@@ -1106,7 +1749,7 @@ export type ApplicationOutput = Message<"neoshowcase.protobuf.ApplicationOutput"
  * Use `create(ApplicationOutputSchema)` to create a new message.
  */
 export const ApplicationOutputSchema: GenMessage<ApplicationOutput> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 30);
+  messageDesc(file_neoshowcase_protobuf_gateway, 42);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationOutputs
@@ -1123,12 +1766,12 @@ export type ApplicationOutputs = Message<"neoshowcase.protobuf.ApplicationOutput
  * Use `create(ApplicationOutputsSchema)` to create a new message.
  */
 export const ApplicationOutputsSchema: GenMessage<ApplicationOutputs> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 31);
+  messageDesc(file_neoshowcase_protobuf_gateway, 43);
 
 /**
- * @generated from message neoshowcase.protobuf.Build
+ * @generated from message neoshowcase.protobuf.ApplicationEvent
  */
-export type Build = Message<"neoshowcase.protobuf.Build"> & {
+export type ApplicationEvent = Message<"neoshowcase.protobuf.ApplicationEvent"> & {
   /**
    * @generated from field: string id = 1;
    */
@@ -1140,704 +1783,1704 @@ export type Build = Message<"neoshowcase.protobuf.Build"> & {
   applicationId: string;
 
   /**
-   * @generated from field: string commit = 3;
+   * @generated from field: neoshowcase.protobuf.ApplicationEvent.Type type = 3;
    */
-  commit: string;
+  type: ApplicationEvent_Type;
 
   /**
-   * @generated from field: neoshowcase.protobuf.BuildStatus status = 4;
+   * reference イベントに関連するエンティティのID (ビルドIDなど)
+   *
+   * @generated from field: string reference = 4;
    */
-  status: BuildStatus;
+  reference: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp queued_at = 5;
+   * @generated from field: string message = 5;
    */
-  queuedAt?: Timestamp;
+  message: string;
 
   /**
-   * @generated from field: neoshowcase.protobuf.NullTimestamp started_at = 6;
+   * user_id イベントを発生させたユーザーのID システムによるイベントの場合は空です
+   *
+   * @generated from field: string user_id = 6;
    */
-  startedAt?: NullTimestamp;
+  userId: string;
 
   /**
-   * @generated from field: neoshowcase.protobuf.NullTimestamp updated_at = 7;
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
    */
-  updatedAt?: NullTimestamp;
+  createdAt?: Timestamp;
+};
 
+/**
+ * Describes the message neoshowcase.protobuf.ApplicationEvent.
+ * Use `create(ApplicationEventSchema)` to create a new message.
+ */
+export const ApplicationEventSchema: GenMessage<ApplicationEvent> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 44);
+
+/**
+ * @generated from enum neoshowcase.protobuf.ApplicationEvent.Type
+ */
+export enum ApplicationEvent_Type {
   /**
-   * @generated from field: neoshowcase.protobuf.NullTimestamp finished_at = 8;
+   * @generated from enum value: CONTAINER_STATE = 0;
    */
-  finishedAt?: NullTimestamp;
+  CONTAINER_STATE = 0,
 
   /**
-   * @generated from field: bool retriable = 9;
+   * @generated from enum value: BUILD_STARTED = 1;
    */
-  retriable: boolean;
+  BUILD_STARTED = 1,
 
   /**
-   * @generated from field: repeated neoshowcase.protobuf.Artifact artifacts = 10;
+   * @generated from enum value: BUILD_FINISHED = 2;
    */
-  artifacts: Artifact[];
+  BUILD_FINISHED = 2,
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.RuntimeImage runtime_image = 11;
+   * @generated from enum value: DEPLOYED = 3;
    */
-  runtimeImage?: RuntimeImage;
-};
+  DEPLOYED = 3,
+
+  /**
+   * @generated from enum value: CONFIG_CHANGED = 4;
+   */
+  CONFIG_CHANGED = 4,
+
+  /**
+   * @generated from enum value: STARTED = 5;
+   */
+  STARTED = 5,
+
+  /**
+   * @generated from enum value: STOPPED = 6;
+   */
+  STOPPED = 6,
+}
 
 /**
- * Describes the message neoshowcase.protobuf.Build.
- * Use `create(BuildSchema)` to create a new message.
+ * Describes the enum neoshowcase.protobuf.ApplicationEvent.Type.
  */
-export const BuildSchema: GenMessage<Build> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 32);
+export const ApplicationEvent_TypeSchema: GenEnum<ApplicationEvent_Type> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 44, 0);
 
 /**
- * @generated from message neoshowcase.protobuf.BuildLog
+ * @generated from message neoshowcase.protobuf.ApplicationEvents
  */
-export type BuildLog = Message<"neoshowcase.protobuf.BuildLog"> & {
+export type ApplicationEvents = Message<"neoshowcase.protobuf.ApplicationEvents"> & {
   /**
-   * @generated from field: bytes log = 1;
+   * @generated from field: repeated neoshowcase.protobuf.ApplicationEvent events = 1;
    */
-  log: Uint8Array;
+  events: ApplicationEvent[];
 };
 
 /**
- * Describes the message neoshowcase.protobuf.BuildLog.
- * Use `create(BuildLogSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.ApplicationEvents.
+ * Use `create(ApplicationEventsSchema)` to create a new message.
  */
-export const BuildLogSchema: GenMessage<BuildLog> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 33);
+export const ApplicationEventsSchema: GenMessage<ApplicationEvents> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 45);
 
 /**
- * @generated from message neoshowcase.protobuf.GitRef
+ * @generated from message neoshowcase.protobuf.WebsiteProbe
  */
-export type GitRef = Message<"neoshowcase.protobuf.GitRef"> & {
+export type WebsiteProbe = Message<"neoshowcase.protobuf.WebsiteProbe"> & {
   /**
-   * @generated from field: string ref_name = 1;
+   * @generated from field: google.protobuf.Timestamp checked_at = 1;
    */
-  refName: string;
+  checkedAt?: Timestamp;
 
   /**
-   * @generated from field: string commit = 2;
+   * @generated from field: bool up = 2;
    */
-  commit: string;
-};
-
-/**
- * Describes the message neoshowcase.protobuf.GitRef.
- * Use `create(GitRefSchema)` to create a new message.
- */
-export const GitRefSchema: GenMessage<GitRef> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 34);
+  up: boolean;
 
-/**
- * @generated from message neoshowcase.protobuf.GenerateKeyPairResponse
- */
-export type GenerateKeyPairResponse = Message<"neoshowcase.protobuf.GenerateKeyPairResponse"> & {
   /**
-   * @generated from field: string key_id = 1;
+   * status_code 応答がなかった場合は0です
+   *
+   * @generated from field: int32 status_code = 3;
    */
-  keyId: string;
+  statusCode: number;
 
   /**
-   * @generated from field: string public_key = 2;
+   * @generated from field: int64 latency_ms = 4;
    */
-  publicKey: string;
-};
+  latencyMs: bigint;
 
-/**
- * Describes the message neoshowcase.protobuf.GenerateKeyPairResponse.
- * Use `create(GenerateKeyPairResponseSchema)` to create a new message.
- */
-export const GenerateKeyPairResponseSchema: GenMessage<GenerateKeyPairResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 35);
+  /**
+   * tls_expires_at HTTPSの場合のみ有効です
+   *
+   * @generated from field: neoshowcase.protobuf.NullTimestamp tls_expires_at = 5;
+   */
+  tlsExpiresAt?: NullTimestamp;
 
-/**
- * @generated from message neoshowcase.protobuf.GetUsersResponse
- */
-export type GetUsersResponse = Message<"neoshowcase.protobuf.GetUsersResponse"> & {
   /**
-   * @generated from field: repeated neoshowcase.protobuf.User users = 1;
+   * @generated from field: string error = 6;
    */
-  users: User[];
+  error: string;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetUsersResponse.
- * Use `create(GetUsersResponseSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.WebsiteProbe.
+ * Use `create(WebsiteProbeSchema)` to create a new message.
  */
-export const GetUsersResponseSchema: GenMessage<GetUsersResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 36);
+export const WebsiteProbeSchema: GenMessage<WebsiteProbe> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 46);
 
 /**
- * @generated from message neoshowcase.protobuf.GetUserKeysResponse
+ * @generated from message neoshowcase.protobuf.WebsiteUptime
  */
-export type GetUserKeysResponse = Message<"neoshowcase.protobuf.GetUserKeysResponse"> & {
+export type WebsiteUptime = Message<"neoshowcase.protobuf.WebsiteUptime"> & {
   /**
-   * @generated from field: repeated neoshowcase.protobuf.UserKey keys = 1;
+   * @generated from field: int64 window_seconds = 1;
    */
-  keys: UserKey[];
-};
+  windowSeconds: bigint;
 
-/**
- * Describes the message neoshowcase.protobuf.GetUserKeysResponse.
- * Use `create(GetUserKeysResponseSchema)` to create a new message.
- */
-export const GetUserKeysResponseSchema: GenMessage<GetUserKeysResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 37);
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
 
-/**
- * @generated from message neoshowcase.protobuf.CreateUserKeyRequest
- */
-export type CreateUserKeyRequest = Message<"neoshowcase.protobuf.CreateUserKeyRequest"> & {
   /**
-   * @generated from field: string public_key = 1;
+   * @generated from field: int32 up = 3;
    */
-  publicKey: string;
+  up: number;
 
   /**
-   * @generated from field: string name = 2;
+   * ratio 確認が一度もない場合は設定されません
+   *
+   * @generated from field: optional double ratio = 4;
    */
-  name: string;
+  ratio?: number;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.CreateUserKeyRequest.
- * Use `create(CreateUserKeyRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.WebsiteUptime.
+ * Use `create(WebsiteUptimeSchema)` to create a new message.
  */
-export const CreateUserKeyRequestSchema: GenMessage<CreateUserKeyRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 38);
+export const WebsiteUptimeSchema: GenMessage<WebsiteUptime> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 47);
 
 /**
- * @generated from message neoshowcase.protobuf.DeleteUserKeyRequest
+ * @generated from message neoshowcase.protobuf.WebsiteStatus
  */
-export type DeleteUserKeyRequest = Message<"neoshowcase.protobuf.DeleteUserKeyRequest"> & {
+export type WebsiteStatus = Message<"neoshowcase.protobuf.WebsiteStatus"> & {
   /**
-   * @generated from field: string key_id = 1;
+   * @generated from field: string application_id = 1;
    */
-  keyId: string;
-};
+  applicationId: string;
 
-/**
- * Describes the message neoshowcase.protobuf.DeleteUserKeyRequest.
- * Use `create(DeleteUserKeyRequestSchema)` to create a new message.
- */
-export const DeleteUserKeyRequestSchema: GenMessage<DeleteUserKeyRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 39);
+  /**
+   * @generated from field: neoshowcase.protobuf.Website website = 2;
+   */
+  website?: Website;
 
-/**
- * @generated from message neoshowcase.protobuf.CreateRepositoryAuthBasic
- */
-export type CreateRepositoryAuthBasic = Message<"neoshowcase.protobuf.CreateRepositoryAuthBasic"> & {
   /**
-   * @generated from field: string username = 1;
+   * latest まだ一度も確認されていない場合は設定されません
+   *
+   * @generated from field: optional neoshowcase.protobuf.WebsiteProbe latest = 3;
    */
-  username: string;
+  latest?: WebsiteProbe;
 
   /**
-   * @generated from field: string password = 2;
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteUptime uptimes = 4;
    */
-  password: string;
+  uptimes: WebsiteUptime[];
 };
 
 /**
- * Describes the message neoshowcase.protobuf.CreateRepositoryAuthBasic.
- * Use `create(CreateRepositoryAuthBasicSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.WebsiteStatus.
+ * Use `create(WebsiteStatusSchema)` to create a new message.
  */
-export const CreateRepositoryAuthBasicSchema: GenMessage<CreateRepositoryAuthBasic> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 40);
+export const WebsiteStatusSchema: GenMessage<WebsiteStatus> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 48);
 
 /**
- * @generated from message neoshowcase.protobuf.CreateRepositoryAuthSSH
+ * @generated from message neoshowcase.protobuf.WebsiteStatuses
  */
-export type CreateRepositoryAuthSSH = Message<"neoshowcase.protobuf.CreateRepositoryAuthSSH"> & {
+export type WebsiteStatuses = Message<"neoshowcase.protobuf.WebsiteStatuses"> & {
   /**
-   * @generated from field: string key_id = 1;
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteStatus statuses = 1;
    */
-  keyId: string;
+  statuses: WebsiteStatus[];
 };
 
 /**
- * Describes the message neoshowcase.protobuf.CreateRepositoryAuthSSH.
- * Use `create(CreateRepositoryAuthSSHSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.WebsiteStatuses.
+ * Use `create(WebsiteStatusesSchema)` to create a new message.
  */
-export const CreateRepositoryAuthSSHSchema: GenMessage<CreateRepositoryAuthSSH> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 41);
+export const WebsiteStatusesSchema: GenMessage<WebsiteStatuses> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 49);
 
 /**
- * @generated from message neoshowcase.protobuf.CreateRepositoryAuth
+ * @generated from message neoshowcase.protobuf.AlertRule
  */
-export type CreateRepositoryAuth = Message<"neoshowcase.protobuf.CreateRepositoryAuth"> & {
+export type AlertRule = Message<"neoshowcase.protobuf.AlertRule"> & {
   /**
-   * @generated from oneof neoshowcase.protobuf.CreateRepositoryAuth.auth
+   * @generated from field: string id = 1;
    */
-  auth: {
-    /**
-     * @generated from field: google.protobuf.Empty none = 1;
-     */
-    value: Empty;
-    case: "none";
-  } | {
-    /**
-     * @generated from field: neoshowcase.protobuf.CreateRepositoryAuthBasic basic = 2;
-     */
-    value: CreateRepositoryAuthBasic;
-    case: "basic";
-  } | {
-    /**
-     * @generated from field: neoshowcase.protobuf.CreateRepositoryAuthSSH ssh = 3;
-     */
-    value: CreateRepositoryAuthSSH;
-    case: "ssh";
-  } | { case: undefined; value?: undefined };
-};
+  id: string;
 
-/**
- * Describes the message neoshowcase.protobuf.CreateRepositoryAuth.
- * Use `create(CreateRepositoryAuthSchema)` to create a new message.
- */
-export const CreateRepositoryAuthSchema: GenMessage<CreateRepositoryAuth> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 42);
+  /**
+   * @generated from field: string application_id = 2;
+   */
+  applicationId: string;
 
-/**
- * @generated from message neoshowcase.protobuf.CreateRepositoryRequest
- */
-export type CreateRepositoryRequest = Message<"neoshowcase.protobuf.CreateRepositoryRequest"> & {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: string name = 3;
    */
   name: string;
 
   /**
-   * @generated from field: string url = 2;
+   * @generated from field: neoshowcase.protobuf.AlertRule.Kind kind = 4;
    */
-  url: string;
+  kind: AlertRule_Kind;
 
   /**
-   * @generated from field: neoshowcase.protobuf.CreateRepositoryAuth auth = 3;
+   * metric kindがMETRICの場合のメトリクス名 GetAvailableMetricsで取得できるものです
+   *
+   * @generated from field: string metric = 5;
    */
-  auth?: CreateRepositoryAuth;
+  metric: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.AlertRule.Comparison comparison = 6;
+   */
+  comparison: AlertRule_Comparison;
+
+  /**
+   * @generated from field: double threshold = 7;
+   */
+  threshold: number;
+
+  /**
+   * @generated from field: int64 duration_seconds = 8;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.AlertRule.ThresholdUnit threshold_unit = 10;
+   */
+  thresholdUnit: AlertRule_ThresholdUnit;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.CreateRepositoryRequest.
- * Use `create(CreateRepositoryRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.AlertRule.
+ * Use `create(AlertRuleSchema)` to create a new message.
  */
-export const CreateRepositoryRequestSchema: GenMessage<CreateRepositoryRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 43);
+export const AlertRuleSchema: GenMessage<AlertRule> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 50);
 
 /**
- * @generated from message neoshowcase.protobuf.GetRepositoriesRequest
+ * @generated from enum neoshowcase.protobuf.AlertRule.Kind
  */
-export type GetRepositoriesRequest = Message<"neoshowcase.protobuf.GetRepositoriesRequest"> & {
+export enum AlertRule_Kind {
   /**
-   * @generated from field: neoshowcase.protobuf.GetRepositoriesRequest.Scope scope = 1;
+   * METRIC メトリクスの値が閾値を超えた状態が継続した場合に発火します
+   *
+   * @generated from enum value: METRIC = 0;
    */
-  scope: GetRepositoriesRequest_Scope;
-};
+  METRIC = 0,
+
+  /**
+   * NO_LOG 起動中のアプリのログ出力が継続してない場合に発火します
+   *
+   * @generated from enum value: NO_LOG = 1;
+   */
+  NO_LOG = 1,
+}
 
 /**
- * Describes the message neoshowcase.protobuf.GetRepositoriesRequest.
- * Use `create(GetRepositoriesRequestSchema)` to create a new message.
+ * Describes the enum neoshowcase.protobuf.AlertRule.Kind.
  */
-export const GetRepositoriesRequestSchema: GenMessage<GetRepositoriesRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 44);
+export const AlertRule_KindSchema: GenEnum<AlertRule_Kind> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 50, 0);
 
 /**
- * @generated from enum neoshowcase.protobuf.GetRepositoriesRequest.Scope
+ * @generated from enum neoshowcase.protobuf.AlertRule.Comparison
  */
-export enum GetRepositoriesRequest_Scope {
+export enum AlertRule_Comparison {
   /**
-   * @generated from enum value: MINE = 0;
+   * @generated from enum value: ABOVE = 0;
    */
-  MINE = 0,
+  ABOVE = 0,
 
   /**
-   * @generated from enum value: CREATABLE = 1;
+   * @generated from enum value: BELOW = 1;
    */
-  CREATABLE = 1,
+  BELOW = 1,
+}
 
+/**
+ * Describes the enum neoshowcase.protobuf.AlertRule.Comparison.
+ */
+export const AlertRule_ComparisonSchema: GenEnum<AlertRule_Comparison> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 50, 1);
+
+/**
+ * @generated from enum neoshowcase.protobuf.AlertRule.ThresholdUnit
+ */
+export enum AlertRule_ThresholdUnit {
   /**
-   * @generated from enum value: PUBLIC = 2;
+   * VALUE メトリクスの値そのものと比較します
+   *
+   * @generated from enum value: VALUE = 0;
    */
-  PUBLIC = 2,
+  VALUE = 0,
 
   /**
-   * admin only
+   * LIMIT_PERCENT メトリクスの上限 (メモリ上限など) に対する割合 (%) と比較します
    *
-   * @generated from enum value: ALL = 3;
+   * @generated from enum value: LIMIT_PERCENT = 1;
    */
-  ALL = 3,
+  LIMIT_PERCENT = 1,
 }
 
 /**
- * Describes the enum neoshowcase.protobuf.GetRepositoriesRequest.Scope.
+ * Describes the enum neoshowcase.protobuf.AlertRule.ThresholdUnit.
  */
-export const GetRepositoriesRequest_ScopeSchema: GenEnum<GetRepositoriesRequest_Scope> = /*@__PURE__*/
-  enumDesc(file_neoshowcase_protobuf_gateway, 44, 0);
+export const AlertRule_ThresholdUnitSchema: GenEnum<AlertRule_ThresholdUnit> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 50, 2);
 
 /**
- * @generated from message neoshowcase.protobuf.UpdateRepositoryRequest
+ * @generated from message neoshowcase.protobuf.AlertRules
  */
-export type UpdateRepositoryRequest = Message<"neoshowcase.protobuf.UpdateRepositoryRequest"> & {
+export type AlertRules = Message<"neoshowcase.protobuf.AlertRules"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: repeated neoshowcase.protobuf.AlertRule rules = 1;
    */
-  id: string;
+  rules: AlertRule[];
+};
 
-  /**
-   * @generated from field: optional string name = 2;
-   */
-  name?: string;
+/**
+ * Describes the message neoshowcase.protobuf.AlertRules.
+ * Use `create(AlertRulesSchema)` to create a new message.
+ */
+export const AlertRulesSchema: GenMessage<AlertRules> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 51);
 
+/**
+ * @generated from message neoshowcase.protobuf.Alert
+ */
+export type Alert = Message<"neoshowcase.protobuf.Alert"> & {
   /**
-   * @generated from field: optional string url = 3;
+   * @generated from field: string id = 1;
    */
-  url?: string;
+  id: string;
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.CreateRepositoryAuth auth = 4;
+   * @generated from field: string rule_id = 2;
    */
-  auth?: CreateRepositoryAuth;
+  ruleId: string;
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners owner_ids = 5;
+   * @generated from field: string application_id = 3;
    */
-  ownerIds?: UpdateRepositoryRequest_UpdateOwners;
-};
-
-/**
- * Describes the message neoshowcase.protobuf.UpdateRepositoryRequest.
- * Use `create(UpdateRepositoryRequestSchema)` to create a new message.
- */
-export const UpdateRepositoryRequestSchema: GenMessage<UpdateRepositoryRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 45);
+  applicationId: string;
 
-/**
- * @generated from message neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners
- */
-export type UpdateRepositoryRequest_UpdateOwners = Message<"neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners"> & {
   /**
-   * @generated from field: repeated string owner_ids = 1;
+   * @generated from field: string message = 4;
    */
-  ownerIds: string[];
-};
-
-/**
- * Describes the message neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners.
- * Use `create(UpdateRepositoryRequest_UpdateOwnersSchema)` to create a new message.
- */
-export const UpdateRepositoryRequest_UpdateOwnersSchema: GenMessage<UpdateRepositoryRequest_UpdateOwners> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 45, 0);
+  message: string;
 
-/**
- * @generated from message neoshowcase.protobuf.RepositoryIdRequest
- */
-export type RepositoryIdRequest = Message<"neoshowcase.protobuf.RepositoryIdRequest"> & {
   /**
-   * @generated from field: string repository_id = 1;
+   * @generated from field: google.protobuf.Timestamp fired_at = 5;
    */
-  repositoryId: string;
-};
-
-/**
- * Describes the message neoshowcase.protobuf.RepositoryIdRequest.
- * Use `create(RepositoryIdRequestSchema)` to create a new message.
- */
-export const RepositoryIdRequestSchema: GenMessage<RepositoryIdRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 46);
+  firedAt?: Timestamp;
 
-/**
- * @generated from message neoshowcase.protobuf.GetRepositoryCommitsRequest
- */
-export type GetRepositoryCommitsRequest = Message<"neoshowcase.protobuf.GetRepositoryCommitsRequest"> & {
   /**
-   * @generated from field: repeated string hashes = 1;
+   * resolved_at 発火中の場合は無効です
+   *
+   * @generated from field: neoshowcase.protobuf.NullTimestamp resolved_at = 6;
    */
-  hashes: string[];
+  resolvedAt?: NullTimestamp;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetRepositoryCommitsRequest.
- * Use `create(GetRepositoryCommitsRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.Alert.
+ * Use `create(AlertSchema)` to create a new message.
  */
-export const GetRepositoryCommitsRequestSchema: GenMessage<GetRepositoryCommitsRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 47);
+export const AlertSchema: GenMessage<Alert> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 52);
 
 /**
- * @generated from message neoshowcase.protobuf.GetRepositoryCommitsResponse
+ * @generated from message neoshowcase.protobuf.Alerts
  */
-export type GetRepositoryCommitsResponse = Message<"neoshowcase.protobuf.GetRepositoryCommitsResponse"> & {
+export type Alerts = Message<"neoshowcase.protobuf.Alerts"> & {
   /**
-   * @generated from field: repeated neoshowcase.protobuf.SimpleCommit commits = 1;
+   * @generated from field: repeated neoshowcase.protobuf.Alert alerts = 1;
    */
-  commits: SimpleCommit[];
+  alerts: Alert[];
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetRepositoryCommitsResponse.
- * Use `create(GetRepositoryCommitsResponseSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.Alerts.
+ * Use `create(AlertsSchema)` to create a new message.
  */
-export const GetRepositoryCommitsResponseSchema: GenMessage<GetRepositoryCommitsResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 48);
+export const AlertsSchema: GenMessage<Alerts> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 53);
 
 /**
- * @generated from message neoshowcase.protobuf.CreateWebsiteRequest
+ * @generated from message neoshowcase.protobuf.NotificationSubscription
  */
-export type CreateWebsiteRequest = Message<"neoshowcase.protobuf.CreateWebsiteRequest"> & {
+export type NotificationSubscription = Message<"neoshowcase.protobuf.NotificationSubscription"> & {
   /**
-   * @generated from field: string fqdn = 1;
+   * @generated from field: string id = 1;
    */
-  fqdn: string;
+  id: string;
 
   /**
-   * @generated from field: string path_prefix = 2;
+   * @generated from field: string application_id = 2;
    */
-  pathPrefix: string;
+  applicationId: string;
 
   /**
-   * @generated from field: bool strip_prefix = 3;
+   * @generated from field: neoshowcase.protobuf.NotificationSubscription.Sink sink = 3;
    */
-  stripPrefix: boolean;
+  sink: NotificationSubscription_Sink;
 
   /**
-   * @generated from field: bool https = 4;
+   * @generated from field: string target = 4;
    */
-  https: boolean;
+  target: string;
 
   /**
-   * @generated from field: bool h2c = 5;
+   * has_secret 署名用のシークレットが設定されているか シークレット自体は返しません
+   *
+   * @generated from field: bool has_secret = 5;
    */
-  h2c: boolean;
+  hasSecret: boolean;
 
   /**
-   * @generated from field: int32 http_port = 6;
+   * @generated from field: repeated neoshowcase.protobuf.NotificationSubscription.Event events = 6;
    */
-  httpPort: number;
+  events: NotificationSubscription_Event[];
 
   /**
-   * @generated from field: neoshowcase.protobuf.AuthenticationType authentication = 7;
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
    */
-  authentication: AuthenticationType;
+  createdAt?: Timestamp;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.CreateWebsiteRequest.
- * Use `create(CreateWebsiteRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.NotificationSubscription.
+ * Use `create(NotificationSubscriptionSchema)` to create a new message.
  */
-export const CreateWebsiteRequestSchema: GenMessage<CreateWebsiteRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 49);
+export const NotificationSubscriptionSchema: GenMessage<NotificationSubscription> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 54);
 
 /**
- * @generated from message neoshowcase.protobuf.DeleteWebsiteRequest
+ * @generated from enum neoshowcase.protobuf.NotificationSubscription.Sink
  */
-export type DeleteWebsiteRequest = Message<"neoshowcase.protobuf.DeleteWebsiteRequest"> & {
+export enum NotificationSubscription_Sink {
   /**
-   * @generated from field: string id = 1;
+   * WEBHOOK targetのURLに通知をJSONでPOSTします
+   *
+   * @generated from enum value: WEBHOOK = 0;
    */
-  id: string;
-};
+  WEBHOOK = 0,
+
+  /**
+   * SLACK targetのSlack互換のIncoming Webhook URLに通知します
+   *
+   * @generated from enum value: SLACK = 1;
+   */
+  SLACK = 1,
+
+  /**
+   * TRAQ targetのtraQのWebhook URLに通知します
+   *
+   * @generated from enum value: TRAQ = 2;
+   */
+  TRAQ = 2,
+
+  /**
+   * EMAIL targetのメールアドレスに通知します
+   *
+   * @generated from enum value: EMAIL = 3;
+   */
+  EMAIL = 3,
+}
 
 /**
- * Describes the message neoshowcase.protobuf.DeleteWebsiteRequest.
- * Use `create(DeleteWebsiteRequestSchema)` to create a new message.
+ * Describes the enum neoshowcase.protobuf.NotificationSubscription.Sink.
  */
-export const DeleteWebsiteRequestSchema: GenMessage<DeleteWebsiteRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 50);
+export const NotificationSubscription_SinkSchema: GenEnum<NotificationSubscription_Sink> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 54, 0);
 
 /**
- * @generated from message neoshowcase.protobuf.CreateApplicationRequest
+ * @generated from enum neoshowcase.protobuf.NotificationSubscription.Event
  */
-export type CreateApplicationRequest = Message<"neoshowcase.protobuf.CreateApplicationRequest"> & {
-  /**
-   * @generated from field: string name = 1;
-   */
-  name: string;
-
+export enum NotificationSubscription_Event {
   /**
-   * @generated from field: string repository_id = 2;
+   * @generated from enum value: BUILD_FAILED = 0;
    */
-  repositoryId: string;
+  BUILD_FAILED = 0,
 
   /**
-   * @generated from field: string ref_name = 3;
+   * @generated from enum value: BUILD_SUCCEEDED = 1;
    */
-  refName: string;
+  BUILD_SUCCEEDED = 1,
 
   /**
-   * @generated from field: neoshowcase.protobuf.ApplicationConfig config = 4;
+   * @generated from enum value: DEPLOYED = 2;
    */
-  config?: ApplicationConfig;
+  DEPLOYED = 2,
 
   /**
-   * @generated from field: repeated neoshowcase.protobuf.CreateWebsiteRequest websites = 5;
+   * @generated from enum value: CONTAINER_ERRORED = 3;
    */
-  websites: CreateWebsiteRequest[];
+  CONTAINER_ERRORED = 3,
 
   /**
-   * @generated from field: repeated neoshowcase.protobuf.PortPublication port_publications = 6;
+   * @generated from enum value: CERTIFICATE_ERROR = 4;
    */
-  portPublications: PortPublication[];
+  CERTIFICATE_ERROR = 4,
 
   /**
-   * @generated from field: bool start_on_create = 7;
+   * @generated from enum value: ALERT = 5;
    */
-  startOnCreate: boolean;
-};
+  ALERT = 5,
+}
 
 /**
- * Describes the message neoshowcase.protobuf.CreateApplicationRequest.
- * Use `create(CreateApplicationRequestSchema)` to create a new message.
+ * Describes the enum neoshowcase.protobuf.NotificationSubscription.Event.
  */
-export const CreateApplicationRequestSchema: GenMessage<CreateApplicationRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 51);
+export const NotificationSubscription_EventSchema: GenEnum<NotificationSubscription_Event> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 54, 1);
 
 /**
- * @generated from message neoshowcase.protobuf.GetApplicationsRequest
+ * @generated from message neoshowcase.protobuf.NotificationSubscriptions
  */
-export type GetApplicationsRequest = Message<"neoshowcase.protobuf.GetApplicationsRequest"> & {
+export type NotificationSubscriptions = Message<"neoshowcase.protobuf.NotificationSubscriptions"> & {
   /**
-   * @generated from field: neoshowcase.protobuf.GetApplicationsRequest.Scope scope = 1;
-   */
-  scope: GetApplicationsRequest_Scope;
-
-  /**
-   * @generated from field: optional string repository_id = 2;
+   * @generated from field: repeated neoshowcase.protobuf.NotificationSubscription subscriptions = 1;
    */
-  repositoryId?: string;
+  subscriptions: NotificationSubscription[];
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetApplicationsRequest.
- * Use `create(GetApplicationsRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.NotificationSubscriptions.
+ * Use `create(NotificationSubscriptionsSchema)` to create a new message.
  */
-export const GetApplicationsRequestSchema: GenMessage<GetApplicationsRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 52);
+export const NotificationSubscriptionsSchema: GenMessage<NotificationSubscriptions> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 55);
 
 /**
- * @generated from enum neoshowcase.protobuf.GetApplicationsRequest.Scope
+ * @generated from message neoshowcase.protobuf.Build
  */
-export enum GetApplicationsRequest_Scope {
+export type Build = Message<"neoshowcase.protobuf.Build"> & {
   /**
-   * @generated from enum value: MINE = 0;
+   * @generated from field: string id = 1;
    */
-  MINE = 0,
+  id: string;
 
   /**
-   * @generated from enum value: ALL = 1;
+   * @generated from field: string application_id = 2;
    */
-  ALL = 1,
+  applicationId: string;
 
   /**
-   * @generated from enum value: REPOSITORY = 2;
+   * @generated from field: string commit = 3;
    */
-  REPOSITORY = 2,
-}
+  commit: string;
 
-/**
- * Describes the enum neoshowcase.protobuf.GetApplicationsRequest.Scope.
- */
-export const GetApplicationsRequest_ScopeSchema: GenEnum<GetApplicationsRequest_Scope> = /*@__PURE__*/
-  enumDesc(file_neoshowcase_protobuf_gateway, 52, 0);
+  /**
+   * @generated from field: neoshowcase.protobuf.BuildStatus status = 4;
+   */
+  status: BuildStatus;
 
-/**
- * @generated from message neoshowcase.protobuf.UpdateApplicationRequest
- */
-export type UpdateApplicationRequest = Message<"neoshowcase.protobuf.UpdateApplicationRequest"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: google.protobuf.Timestamp queued_at = 5;
    */
-  id: string;
+  queuedAt?: Timestamp;
 
   /**
-   * @generated from field: optional string name = 2;
+   * @generated from field: neoshowcase.protobuf.NullTimestamp started_at = 6;
    */
-  name?: string;
+  startedAt?: NullTimestamp;
 
   /**
-   * @generated from field: optional string ref_name = 4;
+   * @generated from field: neoshowcase.protobuf.NullTimestamp updated_at = 7;
    */
-  refName?: string;
+  updatedAt?: NullTimestamp;
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.ApplicationConfig config = 5;
+   * @generated from field: neoshowcase.protobuf.NullTimestamp finished_at = 8;
    */
-  config?: ApplicationConfig;
+  finishedAt?: NullTimestamp;
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites websites = 6;
+   * @generated from field: bool retriable = 9;
    */
-  websites?: UpdateApplicationRequest_UpdateWebsites;
+  retriable: boolean;
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts port_publications = 7;
+   * @generated from field: repeated neoshowcase.protobuf.Artifact artifacts = 10;
    */
-  portPublications?: UpdateApplicationRequest_UpdatePorts;
+  artifacts: Artifact[];
 
   /**
-   * @generated from field: optional neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners owner_ids = 8;
+   * @generated from field: optional neoshowcase.protobuf.RuntimeImage runtime_image = 11;
    */
-  ownerIds?: UpdateApplicationRequest_UpdateOwners;
+  runtimeImage?: RuntimeImage;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.
- * Use `create(UpdateApplicationRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.Build.
+ * Use `create(BuildSchema)` to create a new message.
  */
-export const UpdateApplicationRequestSchema: GenMessage<UpdateApplicationRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 53);
+export const BuildSchema: GenMessage<Build> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 56);
 
 /**
- * @generated from message neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites
+ * @generated from message neoshowcase.protobuf.BuildLog
  */
-export type UpdateApplicationRequest_UpdateWebsites = Message<"neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites"> & {
+export type BuildLog = Message<"neoshowcase.protobuf.BuildLog"> & {
   /**
-   * @generated from field: repeated neoshowcase.protobuf.CreateWebsiteRequest websites = 1;
+   * @generated from field: bytes log = 1;
    */
-  websites: CreateWebsiteRequest[];
+  log: Uint8Array;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites.
- * Use `create(UpdateApplicationRequest_UpdateWebsitesSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.BuildLog.
+ * Use `create(BuildLogSchema)` to create a new message.
  */
-export const UpdateApplicationRequest_UpdateWebsitesSchema: GenMessage<UpdateApplicationRequest_UpdateWebsites> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 53, 0);
+export const BuildLogSchema: GenMessage<BuildLog> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 57);
 
 /**
- * @generated from message neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts
+ * @generated from message neoshowcase.protobuf.GitRef
  */
-export type UpdateApplicationRequest_UpdatePorts = Message<"neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts"> & {
+export type GitRef = Message<"neoshowcase.protobuf.GitRef"> & {
   /**
-   * @generated from field: repeated neoshowcase.protobuf.PortPublication port_publications = 1;
+   * @generated from field: string ref_name = 1;
    */
-  portPublications: PortPublication[];
-};
+  refName: string;
 
-/**
- * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts.
- * Use `create(UpdateApplicationRequest_UpdatePortsSchema)` to create a new message.
+  /**
+   * @generated from field: string commit = 2;
+   */
+  commit: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GitRef.
+ * Use `create(GitRefSchema)` to create a new message.
+ */
+export const GitRefSchema: GenMessage<GitRef> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 58);
+
+/**
+ * @generated from message neoshowcase.protobuf.GenerateKeyPairResponse
+ */
+export type GenerateKeyPairResponse = Message<"neoshowcase.protobuf.GenerateKeyPairResponse"> & {
+  /**
+   * @generated from field: string key_id = 1;
+   */
+  keyId: string;
+
+  /**
+   * @generated from field: string public_key = 2;
+   */
+  publicKey: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GenerateKeyPairResponse.
+ * Use `create(GenerateKeyPairResponseSchema)` to create a new message.
+ */
+export const GenerateKeyPairResponseSchema: GenMessage<GenerateKeyPairResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 59);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetUsersResponse
+ */
+export type GetUsersResponse = Message<"neoshowcase.protobuf.GetUsersResponse"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.User users = 1;
+   */
+  users: User[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetUsersResponse.
+ * Use `create(GetUsersResponseSchema)` to create a new message.
+ */
+export const GetUsersResponseSchema: GenMessage<GetUsersResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 60);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetUserKeysResponse
+ */
+export type GetUserKeysResponse = Message<"neoshowcase.protobuf.GetUserKeysResponse"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.UserKey keys = 1;
+   */
+  keys: UserKey[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetUserKeysResponse.
+ * Use `create(GetUserKeysResponseSchema)` to create a new message.
+ */
+export const GetUserKeysResponseSchema: GenMessage<GetUserKeysResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 61);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateUserKeyRequest
+ */
+export type CreateUserKeyRequest = Message<"neoshowcase.protobuf.CreateUserKeyRequest"> & {
+  /**
+   * @generated from field: string public_key = 1;
+   */
+  publicKey: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateUserKeyRequest.
+ * Use `create(CreateUserKeyRequestSchema)` to create a new message.
+ */
+export const CreateUserKeyRequestSchema: GenMessage<CreateUserKeyRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 62);
+
+/**
+ * @generated from message neoshowcase.protobuf.DeleteUserKeyRequest
+ */
+export type DeleteUserKeyRequest = Message<"neoshowcase.protobuf.DeleteUserKeyRequest"> & {
+  /**
+   * @generated from field: string key_id = 1;
+   */
+  keyId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.DeleteUserKeyRequest.
+ * Use `create(DeleteUserKeyRequestSchema)` to create a new message.
+ */
+export const DeleteUserKeyRequestSchema: GenMessage<DeleteUserKeyRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 63);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateCustomDomainRequest
+ */
+export type CreateCustomDomainRequest = Message<"neoshowcase.protobuf.CreateCustomDomainRequest"> & {
+  /**
+   * @generated from field: string domain = 1;
+   */
+  domain: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.CustomDomain.VerificationMethod method = 2;
+   */
+  method: CustomDomain_VerificationMethod;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateCustomDomainRequest.
+ * Use `create(CreateCustomDomainRequestSchema)` to create a new message.
+ */
+export const CreateCustomDomainRequestSchema: GenMessage<CreateCustomDomainRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 64);
+
+/**
+ * @generated from message neoshowcase.protobuf.CustomDomainIdRequest
+ */
+export type CustomDomainIdRequest = Message<"neoshowcase.protobuf.CustomDomainIdRequest"> & {
+  /**
+   * @generated from field: string domain_id = 1;
+   */
+  domainId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CustomDomainIdRequest.
+ * Use `create(CustomDomainIdRequestSchema)` to create a new message.
+ */
+export const CustomDomainIdRequestSchema: GenMessage<CustomDomainIdRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 65);
+
+/**
+ * @generated from message neoshowcase.protobuf.UploadTLSCertificateRequest
+ */
+export type UploadTLSCertificateRequest = Message<"neoshowcase.protobuf.UploadTLSCertificateRequest"> & {
+  /**
+   * @generated from field: string fqdn = 1;
+   */
+  fqdn: string;
+
+  /**
+   * certificate PEM形式の証明書チェーン (サーバー証明書が先頭)
+   *
+   * @generated from field: string certificate = 2;
+   */
+  certificate: string;
+
+  /**
+   * private_key PEM形式の秘密鍵
+   *
+   * @generated from field: string private_key = 3;
+   */
+  privateKey: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UploadTLSCertificateRequest.
+ * Use `create(UploadTLSCertificateRequestSchema)` to create a new message.
+ */
+export const UploadTLSCertificateRequestSchema: GenMessage<UploadTLSCertificateRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 66);
+
+/**
+ * @generated from message neoshowcase.protobuf.TLSCertificateIdRequest
+ */
+export type TLSCertificateIdRequest = Message<"neoshowcase.protobuf.TLSCertificateIdRequest"> & {
+  /**
+   * @generated from field: string certificate_id = 1;
+   */
+  certificateId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.TLSCertificateIdRequest.
+ * Use `create(TLSCertificateIdRequestSchema)` to create a new message.
+ */
+export const TLSCertificateIdRequestSchema: GenMessage<TLSCertificateIdRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 67);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetMyUsageResponse
+ */
+export type GetMyUsageResponse = Message<"neoshowcase.protobuf.GetMyUsageResponse"> & {
+  /**
+   * @generated from field: neoshowcase.protobuf.ResourceQuota quota = 1;
+   */
+  quota?: ResourceQuota;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.ResourceUsage usage = 2;
+   */
+  usage?: ResourceUsage;
+
+  /**
+   * custom_quota quotaが管理者によってユーザー個別に設定されたものかどうか
+   *
+   * @generated from field: bool custom_quota = 3;
+   */
+  customQuota: boolean;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetMyUsageResponse.
+ * Use `create(GetMyUsageResponseSchema)` to create a new message.
+ */
+export const GetMyUsageResponseSchema: GenMessage<GetMyUsageResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 68);
+
+/**
+ * @generated from message neoshowcase.protobuf.SetUserQuotaRequest
+ */
+export type SetUserQuotaRequest = Message<"neoshowcase.protobuf.SetUserQuotaRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * quota 未指定の場合はデフォルトの上限に戻します
+   *
+   * @generated from field: optional neoshowcase.protobuf.ResourceQuota quota = 2;
+   */
+  quota?: ResourceQuota;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.SetUserQuotaRequest.
+ * Use `create(SetUserQuotaRequestSchema)` to create a new message.
+ */
+export const SetUserQuotaRequestSchema: GenMessage<SetUserQuotaRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 69);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateRepositoryAuthBasic
+ */
+export type CreateRepositoryAuthBasic = Message<"neoshowcase.protobuf.CreateRepositoryAuthBasic"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateRepositoryAuthBasic.
+ * Use `create(CreateRepositoryAuthBasicSchema)` to create a new message.
+ */
+export const CreateRepositoryAuthBasicSchema: GenMessage<CreateRepositoryAuthBasic> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 70);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateRepositoryAuthSSH
+ */
+export type CreateRepositoryAuthSSH = Message<"neoshowcase.protobuf.CreateRepositoryAuthSSH"> & {
+  /**
+   * @generated from field: string key_id = 1;
+   */
+  keyId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateRepositoryAuthSSH.
+ * Use `create(CreateRepositoryAuthSSHSchema)` to create a new message.
+ */
+export const CreateRepositoryAuthSSHSchema: GenMessage<CreateRepositoryAuthSSH> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 71);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateRepositoryAuth
+ */
+export type CreateRepositoryAuth = Message<"neoshowcase.protobuf.CreateRepositoryAuth"> & {
+  /**
+   * @generated from oneof neoshowcase.protobuf.CreateRepositoryAuth.auth
+   */
+  auth: {
+    /**
+     * @generated from field: google.protobuf.Empty none = 1;
+     */
+    value: Empty;
+    case: "none";
+  } | {
+    /**
+     * @generated from field: neoshowcase.protobuf.CreateRepositoryAuthBasic basic = 2;
+     */
+    value: CreateRepositoryAuthBasic;
+    case: "basic";
+  } | {
+    /**
+     * @generated from field: neoshowcase.protobuf.CreateRepositoryAuthSSH ssh = 3;
+     */
+    value: CreateRepositoryAuthSSH;
+    case: "ssh";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateRepositoryAuth.
+ * Use `create(CreateRepositoryAuthSchema)` to create a new message.
+ */
+export const CreateRepositoryAuthSchema: GenMessage<CreateRepositoryAuth> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 72);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateRepositoryRequest
+ */
+export type CreateRepositoryRequest = Message<"neoshowcase.protobuf.CreateRepositoryRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.CreateRepositoryAuth auth = 3;
+   */
+  auth?: CreateRepositoryAuth;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateRepositoryRequest.
+ * Use `create(CreateRepositoryRequestSchema)` to create a new message.
+ */
+export const CreateRepositoryRequestSchema: GenMessage<CreateRepositoryRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 73);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetRepositoriesRequest
+ */
+export type GetRepositoriesRequest = Message<"neoshowcase.protobuf.GetRepositoriesRequest"> & {
+  /**
+   * @generated from field: neoshowcase.protobuf.GetRepositoriesRequest.Scope scope = 1;
+   */
+  scope: GetRepositoriesRequest_Scope;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetRepositoriesRequest.
+ * Use `create(GetRepositoriesRequestSchema)` to create a new message.
+ */
+export const GetRepositoriesRequestSchema: GenMessage<GetRepositoriesRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 74);
+
+/**
+ * @generated from enum neoshowcase.protobuf.GetRepositoriesRequest.Scope
+ */
+export enum GetRepositoriesRequest_Scope {
+  /**
+   * @generated from enum value: MINE = 0;
+   */
+  MINE = 0,
+
+  /**
+   * @generated from enum value: CREATABLE = 1;
+   */
+  CREATABLE = 1,
+
+  /**
+   * @generated from enum value: PUBLIC = 2;
+   */
+  PUBLIC = 2,
+
+  /**
+   * admin only
+   *
+   * @generated from enum value: ALL = 3;
+   */
+  ALL = 3,
+}
+
+/**
+ * Describes the enum neoshowcase.protobuf.GetRepositoriesRequest.Scope.
+ */
+export const GetRepositoriesRequest_ScopeSchema: GenEnum<GetRepositoriesRequest_Scope> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 74, 0);
+
+/**
+ * @generated from message neoshowcase.protobuf.UpdateRepositoryRequest
+ */
+export type UpdateRepositoryRequest = Message<"neoshowcase.protobuf.UpdateRepositoryRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string url = 3;
+   */
+  url?: string;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.CreateRepositoryAuth auth = 4;
+   */
+  auth?: CreateRepositoryAuth;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners owner_ids = 5;
+   */
+  ownerIds?: UpdateRepositoryRequest_UpdateOwners;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UpdateRepositoryRequest.
+ * Use `create(UpdateRepositoryRequestSchema)` to create a new message.
+ */
+export const UpdateRepositoryRequestSchema: GenMessage<UpdateRepositoryRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 75);
+
+/**
+ * @generated from message neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners
+ */
+export type UpdateRepositoryRequest_UpdateOwners = Message<"neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners"> & {
+  /**
+   * @generated from field: repeated string owner_ids = 1;
+   */
+  ownerIds: string[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners.
+ * Use `create(UpdateRepositoryRequest_UpdateOwnersSchema)` to create a new message.
+ */
+export const UpdateRepositoryRequest_UpdateOwnersSchema: GenMessage<UpdateRepositoryRequest_UpdateOwners> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 75, 0);
+
+/**
+ * @generated from message neoshowcase.protobuf.RepositoryIdRequest
+ */
+export type RepositoryIdRequest = Message<"neoshowcase.protobuf.RepositoryIdRequest"> & {
+  /**
+   * @generated from field: string repository_id = 1;
+   */
+  repositoryId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.RepositoryIdRequest.
+ * Use `create(RepositoryIdRequestSchema)` to create a new message.
+ */
+export const RepositoryIdRequestSchema: GenMessage<RepositoryIdRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 76);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetRepositoryCommitsRequest
+ */
+export type GetRepositoryCommitsRequest = Message<"neoshowcase.protobuf.GetRepositoryCommitsRequest"> & {
+  /**
+   * @generated from field: repeated string hashes = 1;
+   */
+  hashes: string[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetRepositoryCommitsRequest.
+ * Use `create(GetRepositoryCommitsRequestSchema)` to create a new message.
+ */
+export const GetRepositoryCommitsRequestSchema: GenMessage<GetRepositoryCommitsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 77);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetRepositoryCommitsResponse
+ */
+export type GetRepositoryCommitsResponse = Message<"neoshowcase.protobuf.GetRepositoryCommitsResponse"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.SimpleCommit commits = 1;
+   */
+  commits: SimpleCommit[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetRepositoryCommitsResponse.
+ * Use `create(GetRepositoryCommitsResponseSchema)` to create a new message.
+ */
+export const GetRepositoryCommitsResponseSchema: GenMessage<GetRepositoryCommitsResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 78);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateWebsiteRequest
+ */
+export type CreateWebsiteRequest = Message<"neoshowcase.protobuf.CreateWebsiteRequest"> & {
+  /**
+   * @generated from field: string fqdn = 1;
+   */
+  fqdn: string;
+
+  /**
+   * @generated from field: string path_prefix = 2;
+   */
+  pathPrefix: string;
+
+  /**
+   * @generated from field: bool strip_prefix = 3;
+   */
+  stripPrefix: boolean;
+
+  /**
+   * @generated from field: bool https = 4;
+   */
+  https: boolean;
+
+  /**
+   * @generated from field: bool h2c = 5;
+   */
+  h2c: boolean;
+
+  /**
+   * @generated from field: int32 http_port = 6;
+   */
+  httpPort: number;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.AuthenticationType authentication = 7;
+   */
+  authentication: AuthenticationType;
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteRule rules = 8;
+   */
+  rules: WebsiteRule[];
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteHeaderPolicy header_policy = 9;
+   */
+  headerPolicy?: WebsiteHeaderPolicy;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteAccessControl access_control = 10;
+   */
+  accessControl?: WebsiteAccessControl;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.WebsiteRateLimit rate_limit = 11;
+   */
+  rateLimit?: WebsiteRateLimit;
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.WebsiteBackend backends = 12;
+   */
+  backends: WebsiteBackend[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateWebsiteRequest.
+ * Use `create(CreateWebsiteRequestSchema)` to create a new message.
+ */
+export const CreateWebsiteRequestSchema: GenMessage<CreateWebsiteRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 79);
+
+/**
+ * @generated from message neoshowcase.protobuf.DeleteWebsiteRequest
+ */
+export type DeleteWebsiteRequest = Message<"neoshowcase.protobuf.DeleteWebsiteRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.DeleteWebsiteRequest.
+ * Use `create(DeleteWebsiteRequestSchema)` to create a new message.
+ */
+export const DeleteWebsiteRequestSchema: GenMessage<DeleteWebsiteRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 80);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateApplicationRequest
+ */
+export type CreateApplicationRequest = Message<"neoshowcase.protobuf.CreateApplicationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string repository_id = 2;
+   */
+  repositoryId: string;
+
+  /**
+   * @generated from field: string ref_name = 3;
+   */
+  refName: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.ApplicationConfig config = 4;
+   */
+  config?: ApplicationConfig;
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.CreateWebsiteRequest websites = 5;
+   */
+  websites: CreateWebsiteRequest[];
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.PortPublication port_publications = 6;
+   */
+  portPublications: PortPublication[];
+
+  /**
+   * @generated from field: bool start_on_create = 7;
+   */
+  startOnCreate: boolean;
+
+  /**
+   * @generated from field: string config_file_path = 8;
+   */
+  configFilePath: string;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.InternalService internal_service = 9;
+   */
+  internalService?: InternalService;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.CreateApplicationRequest.
+ * Use `create(CreateApplicationRequestSchema)` to create a new message.
+ */
+export const CreateApplicationRequestSchema: GenMessage<CreateApplicationRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 81);
+
+/**
+ * @generated from message neoshowcase.protobuf.DuplicateApplicationRequest
+ */
+export type DuplicateApplicationRequest = Message<"neoshowcase.protobuf.DuplicateApplicationRequest"> & {
+  /**
+   * id 複製元アプリのID
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string ref_name = 3;
+   */
+  refName: string;
+
+  /**
+   * website_fqdns 複製元アプリの各Webサイトを置き換えるFQDN 複製元と同じ順番で指定します
+   *
+   * @generated from field: repeated string website_fqdns = 4;
+   */
+  websiteFqdns: string[];
+
+  /**
+   * internet_ports 複製元アプリの各公開ポートを置き換えるポート番号 複製元と同じ順番で指定します
+   *
+   * @generated from field: repeated int32 internet_ports = 5;
+   */
+  internetPorts: number[];
+
+  /**
+   * @generated from field: bool start_on_create = 6;
+   */
+  startOnCreate: boolean;
+
+  /**
+   * exclude_system_env trueまたは未指定の場合、データベース認証情報などのシステム環境変数をコピーせず、複製したアプリ用に新しく作成します
+   * falseの場合、複製元アプリと同じデータベースを使います 複製したアプリを削除しても、複製元アプリのデータベースは削除されません
+   *
+   * @generated from field: optional bool exclude_system_env = 7;
+   */
+  excludeSystemEnv?: boolean;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.DuplicateApplicationRequest.
+ * Use `create(DuplicateApplicationRequestSchema)` to create a new message.
+ */
+export const DuplicateApplicationRequestSchema: GenMessage<DuplicateApplicationRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 82);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetApplicationsRequest
+ */
+export type GetApplicationsRequest = Message<"neoshowcase.protobuf.GetApplicationsRequest"> & {
+  /**
+   * @generated from field: neoshowcase.protobuf.GetApplicationsRequest.Scope scope = 1;
+   */
+  scope: GetApplicationsRequest_Scope;
+
+  /**
+   * @generated from field: optional string repository_id = 2;
+   */
+  repositoryId?: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetApplicationsRequest.
+ * Use `create(GetApplicationsRequestSchema)` to create a new message.
+ */
+export const GetApplicationsRequestSchema: GenMessage<GetApplicationsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 83);
+
+/**
+ * @generated from enum neoshowcase.protobuf.GetApplicationsRequest.Scope
+ */
+export enum GetApplicationsRequest_Scope {
+  /**
+   * @generated from enum value: MINE = 0;
+   */
+  MINE = 0,
+
+  /**
+   * @generated from enum value: ALL = 1;
+   */
+  ALL = 1,
+
+  /**
+   * @generated from enum value: REPOSITORY = 2;
+   */
+  REPOSITORY = 2,
+}
+
+/**
+ * Describes the enum neoshowcase.protobuf.GetApplicationsRequest.Scope.
+ */
+export const GetApplicationsRequest_ScopeSchema: GenEnum<GetApplicationsRequest_Scope> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 83, 0);
+
+/**
+ * @generated from message neoshowcase.protobuf.UpdateApplicationRequest
+ */
+export type UpdateApplicationRequest = Message<"neoshowcase.protobuf.UpdateApplicationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string ref_name = 4;
+   */
+  refName?: string;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.ApplicationConfig config = 5;
+   */
+  config?: ApplicationConfig;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites websites = 6;
+   */
+  websites?: UpdateApplicationRequest_UpdateWebsites;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts port_publications = 7;
+   */
+  portPublications?: UpdateApplicationRequest_UpdatePorts;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners owner_ids = 8;
+   */
+  ownerIds?: UpdateApplicationRequest_UpdateOwners;
+
+  /**
+   * @generated from field: optional string config_file_path = 9;
+   */
+  configFilePath?: string;
+
+  /**
+   * @generated from field: optional neoshowcase.protobuf.InternalService internal_service = 10;
+   */
+  internalService?: InternalService;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.
+ * Use `create(UpdateApplicationRequestSchema)` to create a new message.
+ */
+export const UpdateApplicationRequestSchema: GenMessage<UpdateApplicationRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 84);
+
+/**
+ * @generated from message neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites
+ */
+export type UpdateApplicationRequest_UpdateWebsites = Message<"neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.CreateWebsiteRequest websites = 1;
+   */
+  websites: CreateWebsiteRequest[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites.
+ * Use `create(UpdateApplicationRequest_UpdateWebsitesSchema)` to create a new message.
+ */
+export const UpdateApplicationRequest_UpdateWebsitesSchema: GenMessage<UpdateApplicationRequest_UpdateWebsites> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 84, 0);
+
+/**
+ * @generated from message neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts
+ */
+export type UpdateApplicationRequest_UpdatePorts = Message<"neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.PortPublication port_publications = 1;
+   */
+  portPublications: PortPublication[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts.
+ * Use `create(UpdateApplicationRequest_UpdatePortsSchema)` to create a new message.
  */
 export const UpdateApplicationRequest_UpdatePortsSchema: GenMessage<UpdateApplicationRequest_UpdatePorts> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 53, 1);
+  messageDesc(file_neoshowcase_protobuf_gateway, 84, 1);
 
 /**
  * @generated from message neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners
  */
-export type UpdateApplicationRequest_UpdateOwners = Message<"neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners"> & {
+export type UpdateApplicationRequest_UpdateOwners = Message<"neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners"> & {
+  /**
+   * @generated from field: repeated string owner_ids = 1;
+   */
+  ownerIds: string[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners.
+ * Use `create(UpdateApplicationRequest_UpdateOwnersSchema)` to create a new message.
+ */
+export const UpdateApplicationRequest_UpdateOwnersSchema: GenMessage<UpdateApplicationRequest_UpdateOwners> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 84, 2);
+
+/**
+ * @generated from message neoshowcase.protobuf.ExportApplicationsRequest
+ */
+export type ExportApplicationsRequest = Message<"neoshowcase.protobuf.ExportApplicationsRequest"> & {
+  /**
+   * @generated from field: repeated string application_ids = 1;
+   */
+  applicationIds: string[];
+
+  /**
+   * @generated from field: neoshowcase.protobuf.ManifestFormat format = 2;
+   */
+  format: ManifestFormat;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ExportApplicationsRequest.
+ * Use `create(ExportApplicationsRequestSchema)` to create a new message.
+ */
+export const ExportApplicationsRequestSchema: GenMessage<ExportApplicationsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 85);
+
+/**
+ * @generated from message neoshowcase.protobuf.ExportApplicationsResponse
+ */
+export type ExportApplicationsResponse = Message<"neoshowcase.protobuf.ExportApplicationsResponse"> & {
+  /**
+   * @generated from field: string manifest = 1;
+   */
+  manifest: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ExportApplicationsResponse.
+ * Use `create(ExportApplicationsResponseSchema)` to create a new message.
+ */
+export const ExportApplicationsResponseSchema: GenMessage<ExportApplicationsResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 86);
+
+/**
+ * @generated from message neoshowcase.protobuf.ApplyManifestRequest
+ */
+export type ApplyManifestRequest = Message<"neoshowcase.protobuf.ApplyManifestRequest"> & {
+  /**
+   * manifest YAMLまたはJSON形式のマニフェスト
+   *
+   * @generated from field: string manifest = 1;
+   */
+  manifest: string;
+
+  /**
+   * dry_run trueの場合、変更を適用せず差分と検証エラーのみを返します
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ApplyManifestRequest.
+ * Use `create(ApplyManifestRequestSchema)` to create a new message.
+ */
+export const ApplyManifestRequestSchema: GenMessage<ApplyManifestRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 87);
+
+/**
+ * @generated from message neoshowcase.protobuf.ManifestFieldDiff
+ */
+export type ManifestFieldDiff = Message<"neoshowcase.protobuf.ManifestFieldDiff"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string before = 2;
+   */
+  before: string;
+
+  /**
+   * @generated from field: string after = 3;
+   */
+  after: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ManifestFieldDiff.
+ * Use `create(ManifestFieldDiffSchema)` to create a new message.
+ */
+export const ManifestFieldDiffSchema: GenMessage<ManifestFieldDiff> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 88);
+
+/**
+ * @generated from message neoshowcase.protobuf.ManifestApplicationResult
+ */
+export type ManifestApplicationResult = Message<"neoshowcase.protobuf.ManifestApplicationResult"> & {
+  /**
+   * application_id 新規作成の場合は空
+   *
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool create = 3;
+   */
+  create: boolean;
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.ManifestFieldDiff diffs = 4;
+   */
+  diffs: ManifestFieldDiff[];
+
+  /**
+   * @generated from field: repeated string errors = 5;
+   */
+  errors: string[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ManifestApplicationResult.
+ * Use `create(ManifestApplicationResultSchema)` to create a new message.
+ */
+export const ManifestApplicationResultSchema: GenMessage<ManifestApplicationResult> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 89);
+
+/**
+ * @generated from message neoshowcase.protobuf.ApplyManifestResponse
+ */
+export type ApplyManifestResponse = Message<"neoshowcase.protobuf.ApplyManifestResponse"> & {
   /**
-   * @generated from field: repeated string owner_ids = 1;
+   * @generated from field: repeated neoshowcase.protobuf.ManifestApplicationResult results = 1;
    */
-  ownerIds: string[];
+  results: ManifestApplicationResult[];
+
+  /**
+   * applied 変更が適用されたか
+   *
+   * @generated from field: bool applied = 2;
+   */
+  applied: boolean;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners.
- * Use `create(UpdateApplicationRequest_UpdateOwnersSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.ApplyManifestResponse.
+ * Use `create(ApplyManifestResponseSchema)` to create a new message.
  */
-export const UpdateApplicationRequest_UpdateOwnersSchema: GenMessage<UpdateApplicationRequest_UpdateOwners> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 53, 2);
+export const ApplyManifestResponseSchema: GenMessage<ApplyManifestResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 90);
 
 /**
  * @generated from message neoshowcase.protobuf.GetRepositoriesResponse
@@ -1854,7 +3497,7 @@ export type GetRepositoriesResponse = Message<"neoshowcase.protobuf.GetRepositor
  * Use `create(GetRepositoriesResponseSchema)` to create a new message.
  */
 export const GetRepositoriesResponseSchema: GenMessage<GetRepositoriesResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 54);
+  messageDesc(file_neoshowcase_protobuf_gateway, 91);
 
 /**
  * @generated from message neoshowcase.protobuf.GetApplicationsResponse
@@ -1871,7 +3514,7 @@ export type GetApplicationsResponse = Message<"neoshowcase.protobuf.GetApplicati
  * Use `create(GetApplicationsResponseSchema)` to create a new message.
  */
 export const GetApplicationsResponseSchema: GenMessage<GetApplicationsResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 55);
+  messageDesc(file_neoshowcase_protobuf_gateway, 92);
 
 /**
  * @generated from message neoshowcase.protobuf.ApplicationIdRequest
@@ -1888,168 +3531,445 @@ export type ApplicationIdRequest = Message<"neoshowcase.protobuf.ApplicationIdRe
  * Use `create(ApplicationIdRequestSchema)` to create a new message.
  */
 export const ApplicationIdRequestSchema: GenMessage<ApplicationIdRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 56);
+  messageDesc(file_neoshowcase_protobuf_gateway, 93);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetAllBuildsRequest
+ */
+export type GetAllBuildsRequest = Message<"neoshowcase.protobuf.GetAllBuildsRequest"> & {
+  /**
+   * zero-indexed page
+   *
+   * @generated from field: int32 page = 1;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetAllBuildsRequest.
+ * Use `create(GetAllBuildsRequestSchema)` to create a new message.
+ */
+export const GetAllBuildsRequestSchema: GenMessage<GetAllBuildsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 94);
+
+/**
+ * @generated from message neoshowcase.protobuf.BuildIdRequest
+ */
+export type BuildIdRequest = Message<"neoshowcase.protobuf.BuildIdRequest"> & {
+  /**
+   * @generated from field: string build_id = 1;
+   */
+  buildId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.BuildIdRequest.
+ * Use `create(BuildIdRequestSchema)` to create a new message.
+ */
+export const BuildIdRequestSchema: GenMessage<BuildIdRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 95);
+
+/**
+ * @generated from message neoshowcase.protobuf.ArtifactIdRequest
+ */
+export type ArtifactIdRequest = Message<"neoshowcase.protobuf.ArtifactIdRequest"> & {
+  /**
+   * @generated from field: string artifact_id = 1;
+   */
+  artifactId: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.ArtifactIdRequest.
+ * Use `create(ArtifactIdRequestSchema)` to create a new message.
+ */
+export const ArtifactIdRequestSchema: GenMessage<ArtifactIdRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 96);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetBuildsResponse
+ */
+export type GetBuildsResponse = Message<"neoshowcase.protobuf.GetBuildsResponse"> & {
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.Build builds = 1;
+   */
+  builds: Build[];
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetBuildsResponse.
+ * Use `create(GetBuildsResponseSchema)` to create a new message.
+ */
+export const GetBuildsResponseSchema: GenMessage<GetBuildsResponse> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 97);
+
+/**
+ * @generated from message neoshowcase.protobuf.SetApplicationEnvVarRequest
+ */
+export type SetApplicationEnvVarRequest = Message<"neoshowcase.protobuf.SetApplicationEnvVarRequest"> & {
+  /**
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.SetApplicationEnvVarRequest.
+ * Use `create(SetApplicationEnvVarRequestSchema)` to create a new message.
+ */
+export const SetApplicationEnvVarRequestSchema: GenMessage<SetApplicationEnvVarRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 98);
+
+/**
+ * @generated from message neoshowcase.protobuf.DeleteApplicationEnvVarRequest
+ */
+export type DeleteApplicationEnvVarRequest = Message<"neoshowcase.protobuf.DeleteApplicationEnvVarRequest"> & {
+  /**
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.DeleteApplicationEnvVarRequest.
+ * Use `create(DeleteApplicationEnvVarRequestSchema)` to create a new message.
+ */
+export const DeleteApplicationEnvVarRequestSchema: GenMessage<DeleteApplicationEnvVarRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 99);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetApplicationMetricsRequest
+ */
+export type GetApplicationMetricsRequest = Message<"neoshowcase.protobuf.GetApplicationMetricsRequest"> & {
+  /**
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: string metrics_name = 2;
+   */
+  metricsName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp before = 3;
+   */
+  before?: Timestamp;
+
+  /**
+   * @generated from field: int64 limit_seconds = 4;
+   */
+  limitSeconds: bigint;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetApplicationMetricsRequest.
+ * Use `create(GetApplicationMetricsRequestSchema)` to create a new message.
+ */
+export const GetApplicationMetricsRequestSchema: GenMessage<GetApplicationMetricsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 100);
+
+/**
+ * @generated from message neoshowcase.protobuf.LogFilter
+ */
+export type LogFilter = Message<"neoshowcase.protobuf.LogFilter"> & {
+  /**
+   * contains 指定した文字列を含む行のみ
+   *
+   * @generated from field: string contains = 1;
+   */
+  contains: string;
+
+  /**
+   * regexp 指定した正規表現 (RE2) にマッチする行のみ
+   *
+   * @generated from field: string regexp = 2;
+   */
+  regexp: string;
+
+  /**
+   * stream 指定した出力先の行のみ ログ基盤が対応している場合のみ指定可能
+   *
+   * @generated from field: neoshowcase.protobuf.LogFilter.Stream stream = 3;
+   */
+  stream: LogFilter_Stream;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.LogFilter.
+ * Use `create(LogFilterSchema)` to create a new message.
+ */
+export const LogFilterSchema: GenMessage<LogFilter> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 101);
+
+/**
+ * @generated from enum neoshowcase.protobuf.LogFilter.Stream
+ */
+export enum LogFilter_Stream {
+  /**
+   * @generated from enum value: ALL = 0;
+   */
+  ALL = 0,
+
+  /**
+   * @generated from enum value: STDOUT = 1;
+   */
+  STDOUT = 1,
+
+  /**
+   * @generated from enum value: STDERR = 2;
+   */
+  STDERR = 2,
+}
+
+/**
+ * Describes the enum neoshowcase.protobuf.LogFilter.Stream.
+ */
+export const LogFilter_StreamSchema: GenEnum<LogFilter_Stream> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 101, 0);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetOutputRequest
+ */
+export type GetOutputRequest = Message<"neoshowcase.protobuf.GetOutputRequest"> & {
+  /**
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp before = 2;
+   */
+  before?: Timestamp;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.LogFilter filter = 4;
+   */
+  filter?: LogFilter;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetOutputRequest.
+ * Use `create(GetOutputRequestSchema)` to create a new message.
+ */
+export const GetOutputRequestSchema: GenMessage<GetOutputRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 102);
+
+/**
+ * @generated from message neoshowcase.protobuf.GetOutputStreamRequest
+ */
+export type GetOutputStreamRequest = Message<"neoshowcase.protobuf.GetOutputStreamRequest"> & {
+  /**
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp begin = 2;
+   */
+  begin?: Timestamp;
+
+  /**
+   * @generated from field: neoshowcase.protobuf.LogFilter filter = 3;
+   */
+  filter?: LogFilter;
+};
+
+/**
+ * Describes the message neoshowcase.protobuf.GetOutputStreamRequest.
+ * Use `create(GetOutputStreamRequestSchema)` to create a new message.
+ */
+export const GetOutputStreamRequestSchema: GenMessage<GetOutputStreamRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 103);
+
+/**
+ * @generated from message neoshowcase.protobuf.CreateAlertRuleRequest
+ */
+export type CreateAlertRuleRequest = Message<"neoshowcase.protobuf.CreateAlertRuleRequest"> & {
+  /**
+   * @generated from field: string application_id = 1;
+   */
+  applicationId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
 
-/**
- * @generated from message neoshowcase.protobuf.GetAllBuildsRequest
- */
-export type GetAllBuildsRequest = Message<"neoshowcase.protobuf.GetAllBuildsRequest"> & {
   /**
-   * zero-indexed page
-   *
-   * @generated from field: int32 page = 1;
+   * @generated from field: neoshowcase.protobuf.AlertRule.Kind kind = 3;
    */
-  page: number;
+  kind: AlertRule_Kind;
 
   /**
-   * @generated from field: int32 limit = 2;
+   * @generated from field: string metric = 4;
    */
-  limit: number;
-};
+  metric: string;
 
-/**
- * Describes the message neoshowcase.protobuf.GetAllBuildsRequest.
- * Use `create(GetAllBuildsRequestSchema)` to create a new message.
- */
-export const GetAllBuildsRequestSchema: GenMessage<GetAllBuildsRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 57);
+  /**
+   * @generated from field: neoshowcase.protobuf.AlertRule.Comparison comparison = 5;
+   */
+  comparison: AlertRule_Comparison;
 
-/**
- * @generated from message neoshowcase.protobuf.BuildIdRequest
- */
-export type BuildIdRequest = Message<"neoshowcase.protobuf.BuildIdRequest"> & {
   /**
-   * @generated from field: string build_id = 1;
+   * @generated from field: double threshold = 6;
    */
-  buildId: string;
-};
+  threshold: number;
 
-/**
- * Describes the message neoshowcase.protobuf.BuildIdRequest.
- * Use `create(BuildIdRequestSchema)` to create a new message.
- */
-export const BuildIdRequestSchema: GenMessage<BuildIdRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 58);
+  /**
+   * @generated from field: int64 duration_seconds = 7;
+   */
+  durationSeconds: bigint;
 
-/**
- * @generated from message neoshowcase.protobuf.ArtifactIdRequest
- */
-export type ArtifactIdRequest = Message<"neoshowcase.protobuf.ArtifactIdRequest"> & {
   /**
-   * @generated from field: string artifact_id = 1;
+   * @generated from field: neoshowcase.protobuf.AlertRule.ThresholdUnit threshold_unit = 8;
    */
-  artifactId: string;
+  thresholdUnit: AlertRule_ThresholdUnit;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.ArtifactIdRequest.
- * Use `create(ArtifactIdRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.CreateAlertRuleRequest.
+ * Use `create(CreateAlertRuleRequestSchema)` to create a new message.
  */
-export const ArtifactIdRequestSchema: GenMessage<ArtifactIdRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 59);
+export const CreateAlertRuleRequestSchema: GenMessage<CreateAlertRuleRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 104);
 
 /**
- * @generated from message neoshowcase.protobuf.GetBuildsResponse
+ * @generated from message neoshowcase.protobuf.DeleteAlertRuleRequest
  */
-export type GetBuildsResponse = Message<"neoshowcase.protobuf.GetBuildsResponse"> & {
+export type DeleteAlertRuleRequest = Message<"neoshowcase.protobuf.DeleteAlertRuleRequest"> & {
   /**
-   * @generated from field: repeated neoshowcase.protobuf.Build builds = 1;
+   * @generated from field: string application_id = 1;
    */
-  builds: Build[];
+  applicationId: string;
+
+  /**
+   * @generated from field: string rule_id = 2;
+   */
+  ruleId: string;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetBuildsResponse.
- * Use `create(GetBuildsResponseSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.DeleteAlertRuleRequest.
+ * Use `create(DeleteAlertRuleRequestSchema)` to create a new message.
  */
-export const GetBuildsResponseSchema: GenMessage<GetBuildsResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 60);
+export const DeleteAlertRuleRequestSchema: GenMessage<DeleteAlertRuleRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 105);
 
 /**
- * @generated from message neoshowcase.protobuf.SetApplicationEnvVarRequest
+ * @generated from message neoshowcase.protobuf.CreateNotificationSubscriptionRequest
  */
-export type SetApplicationEnvVarRequest = Message<"neoshowcase.protobuf.SetApplicationEnvVarRequest"> & {
+export type CreateNotificationSubscriptionRequest = Message<"neoshowcase.protobuf.CreateNotificationSubscriptionRequest"> & {
   /**
    * @generated from field: string application_id = 1;
    */
   applicationId: string;
 
   /**
-   * @generated from field: string key = 2;
+   * @generated from field: neoshowcase.protobuf.NotificationSubscription.Sink sink = 2;
    */
-  key: string;
+  sink: NotificationSubscription_Sink;
 
   /**
-   * @generated from field: string value = 3;
+   * @generated from field: string target = 3;
    */
-  value: string;
+  target: string;
+
+  /**
+   * secret WEBHOOK, TRAQの場合にペイロードの署名に使用します 空の場合は署名しません
+   *
+   * @generated from field: string secret = 4;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: repeated neoshowcase.protobuf.NotificationSubscription.Event events = 5;
+   */
+  events: NotificationSubscription_Event[];
 };
 
 /**
- * Describes the message neoshowcase.protobuf.SetApplicationEnvVarRequest.
- * Use `create(SetApplicationEnvVarRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.CreateNotificationSubscriptionRequest.
+ * Use `create(CreateNotificationSubscriptionRequestSchema)` to create a new message.
  */
-export const SetApplicationEnvVarRequestSchema: GenMessage<SetApplicationEnvVarRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 61);
+export const CreateNotificationSubscriptionRequestSchema: GenMessage<CreateNotificationSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 106);
 
 /**
- * @generated from message neoshowcase.protobuf.DeleteApplicationEnvVarRequest
+ * @generated from message neoshowcase.protobuf.DeleteNotificationSubscriptionRequest
  */
-export type DeleteApplicationEnvVarRequest = Message<"neoshowcase.protobuf.DeleteApplicationEnvVarRequest"> & {
+export type DeleteNotificationSubscriptionRequest = Message<"neoshowcase.protobuf.DeleteNotificationSubscriptionRequest"> & {
   /**
    * @generated from field: string application_id = 1;
    */
   applicationId: string;
 
   /**
-   * @generated from field: string key = 2;
+   * @generated from field: string subscription_id = 2;
    */
-  key: string;
+  subscriptionId: string;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.DeleteApplicationEnvVarRequest.
- * Use `create(DeleteApplicationEnvVarRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.DeleteNotificationSubscriptionRequest.
+ * Use `create(DeleteNotificationSubscriptionRequestSchema)` to create a new message.
  */
-export const DeleteApplicationEnvVarRequestSchema: GenMessage<DeleteApplicationEnvVarRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 62);
+export const DeleteNotificationSubscriptionRequestSchema: GenMessage<DeleteNotificationSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 107);
 
 /**
- * @generated from message neoshowcase.protobuf.GetApplicationMetricsRequest
+ * @generated from message neoshowcase.protobuf.GetAlertsRequest
  */
-export type GetApplicationMetricsRequest = Message<"neoshowcase.protobuf.GetApplicationMetricsRequest"> & {
+export type GetAlertsRequest = Message<"neoshowcase.protobuf.GetAlertsRequest"> & {
   /**
    * @generated from field: string application_id = 1;
    */
   applicationId: string;
 
   /**
-   * @generated from field: string metrics_name = 2;
-   */
-  metricsName: string;
-
-  /**
-   * @generated from field: google.protobuf.Timestamp before = 3;
-   */
-  before?: Timestamp;
-
-  /**
-   * @generated from field: int64 limit_seconds = 4;
+   * limit 0の場合は50件 最大500件
+   *
+   * @generated from field: int32 limit = 2;
    */
-  limitSeconds: bigint;
+  limit: number;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetApplicationMetricsRequest.
- * Use `create(GetApplicationMetricsRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.GetAlertsRequest.
+ * Use `create(GetAlertsRequestSchema)` to create a new message.
  */
-export const GetApplicationMetricsRequestSchema: GenMessage<GetApplicationMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 63);
+export const GetAlertsRequestSchema: GenMessage<GetAlertsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 108);
 
 /**
- * @generated from message neoshowcase.protobuf.GetOutputRequest
+ * @generated from message neoshowcase.protobuf.GetApplicationEventsRequest
  */
-export type GetOutputRequest = Message<"neoshowcase.protobuf.GetOutputRequest"> & {
+export type GetApplicationEventsRequest = Message<"neoshowcase.protobuf.GetApplicationEventsRequest"> & {
   /**
    * @generated from field: string application_id = 1;
    */
@@ -2061,22 +3981,24 @@ export type GetOutputRequest = Message<"neoshowcase.protobuf.GetOutputRequest">
   before?: Timestamp;
 
   /**
+   * limit 0の場合は50件 最大500件
+   *
    * @generated from field: int32 limit = 3;
    */
   limit: number;
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetOutputRequest.
- * Use `create(GetOutputRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.GetApplicationEventsRequest.
+ * Use `create(GetApplicationEventsRequestSchema)` to create a new message.
  */
-export const GetOutputRequestSchema: GenMessage<GetOutputRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 64);
+export const GetApplicationEventsRequestSchema: GenMessage<GetApplicationEventsRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 109);
 
 /**
- * @generated from message neoshowcase.protobuf.GetOutputStreamRequest
+ * @generated from message neoshowcase.protobuf.GetApplicationEventsStreamRequest
  */
-export type GetOutputStreamRequest = Message<"neoshowcase.protobuf.GetOutputStreamRequest"> & {
+export type GetApplicationEventsStreamRequest = Message<"neoshowcase.protobuf.GetApplicationEventsStreamRequest"> & {
   /**
    * @generated from field: string application_id = 1;
    */
@@ -2089,11 +4011,11 @@ export type GetOutputStreamRequest = Message<"neoshowcase.protobuf.GetOutputStre
 };
 
 /**
- * Describes the message neoshowcase.protobuf.GetOutputStreamRequest.
- * Use `create(GetOutputStreamRequestSchema)` to create a new message.
+ * Describes the message neoshowcase.protobuf.GetApplicationEventsStreamRequest.
+ * Use `create(GetApplicationEventsStreamRequestSchema)` to create a new message.
  */
-export const GetOutputStreamRequestSchema: GenMessage<GetOutputStreamRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 65);
+export const GetApplicationEventsStreamRequestSchema: GenMessage<GetApplicationEventsStreamRequest> = /*@__PURE__*/
+  messageDesc(file_neoshowcase_protobuf_gateway, 110);
 
 /**
  * @generated from message neoshowcase.protobuf.RetryCommitBuildRequest
@@ -2115,7 +4037,7 @@ export type RetryCommitBuildRequest = Message<"neoshowcase.protobuf.RetryCommitB
  * Use `create(RetryCommitBuildRequestSchema)` to create a new message.
  */
 export const RetryCommitBuildRequestSchema: GenMessage<RetryCommitBuildRequest> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 66);
+  messageDesc(file_neoshowcase_protobuf_gateway, 111);
 
 /**
  * @generated from message neoshowcase.protobuf.GetRepositoryRefsResponse
@@ -2132,7 +4054,7 @@ export type GetRepositoryRefsResponse = Message<"neoshowcase.protobuf.GetReposit
  * Use `create(GetRepositoryRefsResponseSchema)` to create a new message.
  */
 export const GetRepositoryRefsResponseSchema: GenMessage<GetRepositoryRefsResponse> = /*@__PURE__*/
-  messageDesc(file_neoshowcase_protobuf_gateway, 67);
+  messageDesc(file_neoshowcase_protobuf_gateway, 112);
 
 /**
  * @generated from enum neoshowcase.protobuf.DeployType
@@ -2243,6 +4165,27 @@ export enum BuildStatus {
 export const BuildStatusSchema: GenEnum<BuildStatus> = /*@__PURE__*/
   enumDesc(file_neoshowcase_protobuf_gateway, 3);
 
+/**
+ * @generated from enum neoshowcase.protobuf.ManifestFormat
+ */
+export enum ManifestFormat {
+  /**
+   * @generated from enum value: YAML = 0;
+   */
+  YAML = 0,
+
+  /**
+   * @generated from enum value: JSON = 1;
+   */
+  JSON = 1,
+}
+
+/**
+ * Describes the enum neoshowcase.protobuf.ManifestFormat.
+ */
+export const ManifestFormatSchema: GenEnum<ManifestFormat> = /*@__PURE__*/
+  enumDesc(file_neoshowcase_protobuf_gateway, 4);
+
 /**
  * General / System
  *
@@ -2319,6 +4262,97 @@ export const APIService: GenService<{
     input: typeof DeleteUserKeyRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * GetMyUsage 自身のリソース使用量と上限を取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetMyUsage
+   */
+  getMyUsage: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetMyUsageResponseSchema;
+  },
+  /**
+   * SetUserQuota ユーザーのリソース上限を設定します (admin only)
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.SetUserQuota
+   */
+  setUserQuota: {
+    methodKind: "unary";
+    input: typeof SetUserQuotaRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetCustomDomains 登録した独自ドメイン一覧を取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetCustomDomains
+   */
+  getCustomDomains: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetCustomDomainsResponseSchema;
+  },
+  /**
+   * CreateCustomDomain 独自ドメインを登録します 所有権の確認は定期的に行われます
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.CreateCustomDomain
+   */
+  createCustomDomain: {
+    methodKind: "unary";
+    input: typeof CreateCustomDomainRequestSchema;
+    output: typeof CustomDomainSchema;
+  },
+  /**
+   * VerifyCustomDomain 独自ドメインの所有権を直ちに確認します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.VerifyCustomDomain
+   */
+  verifyCustomDomain: {
+    methodKind: "unary";
+    input: typeof CustomDomainIdRequestSchema;
+    output: typeof CustomDomainSchema;
+  },
+  /**
+   * DeleteCustomDomain 登録した独自ドメインを削除します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.DeleteCustomDomain
+   */
+  deleteCustomDomain: {
+    methodKind: "unary";
+    input: typeof CustomDomainIdRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetTLSCertificates アップロードしたTLS証明書一覧を取得します adminは全ての証明書を取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetTLSCertificates
+   */
+  getTLSCertificates: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetTLSCertificatesResponseSchema;
+  },
+  /**
+   * UploadTLSCertificate TLS証明書をアップロードします 同じfqdnの証明書が既にある場合は置き換えます
+   * admin以外は所有権が確認された独自ドメインの証明書のみアップロードできます
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.UploadTLSCertificate
+   */
+  uploadTLSCertificate: {
+    methodKind: "unary";
+    input: typeof UploadTLSCertificateRequestSchema;
+    output: typeof TLSCertificateSchema;
+  },
+  /**
+   * DeleteTLSCertificate アップロードしたTLS証明書を削除します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.DeleteTLSCertificate
+   */
+  deleteTLSCertificate: {
+    methodKind: "unary";
+    input: typeof TLSCertificateIdRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * CreateRepository リポジトリを登録します
    *
@@ -2409,6 +4443,16 @@ export const APIService: GenService<{
     input: typeof CreateApplicationRequestSchema;
     output: typeof ApplicationSchema;
   },
+  /**
+   * DuplicateApplication アプリの設定・環境変数・公開ポートをコピーして新しいアプリを作成します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.DuplicateApplication
+   */
+  duplicateApplication: {
+    methodKind: "unary";
+    input: typeof DuplicateApplicationRequestSchema;
+    output: typeof ApplicationSchema;
+  },
   /**
    * GetApplications アプリ一覧を取得します
    *
@@ -2449,6 +4493,26 @@ export const APIService: GenService<{
     input: typeof ApplicationIdRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ExportApplications アプリの設定をマニフェストとして出力します 環境変数は値を含まずキーのみ出力します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.ExportApplications
+   */
+  exportApplications: {
+    methodKind: "unary";
+    input: typeof ExportApplicationsRequestSchema;
+    output: typeof ExportApplicationsResponseSchema;
+  },
+  /**
+   * ApplyManifest マニフェストに合わせてアプリを作成・更新します 全てのアプリの検証に成功した場合のみ適用します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.ApplyManifest
+   */
+  applyManifest: {
+    methodKind: "unary";
+    input: typeof ApplyManifestRequestSchema;
+    output: typeof ApplyManifestResponseSchema;
+  },
   /**
    * GetAvailableMetrics 取得可能メトリクス一覧を取得します
    *
@@ -2489,6 +4553,106 @@ export const APIService: GenService<{
     input: typeof GetOutputStreamRequestSchema;
     output: typeof ApplicationOutputSchema;
   },
+  /**
+   * GetApplicationEvents アプリのイベント履歴を新しい順に取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetApplicationEvents
+   */
+  getApplicationEvents: {
+    methodKind: "unary";
+    input: typeof GetApplicationEventsRequestSchema;
+    output: typeof ApplicationEventsSchema;
+  },
+  /**
+   * GetApplicationEventsStream beginより後に発生したアプリのイベントをストリーム形式で取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetApplicationEventsStream
+   */
+  getApplicationEventsStream: {
+    methodKind: "server_streaming";
+    input: typeof GetApplicationEventsStreamRequestSchema;
+    output: typeof ApplicationEventSchema;
+  },
+  /**
+   * GetWebsiteStatus アプリの各ウェブサイトの死活監視の結果と稼働率を取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetWebsiteStatus
+   */
+  getWebsiteStatus: {
+    methodKind: "unary";
+    input: typeof ApplicationIdRequestSchema;
+    output: typeof WebsiteStatusesSchema;
+  },
+  /**
+   * GetAlertRules アプリのアラートルール一覧を取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetAlertRules
+   */
+  getAlertRules: {
+    methodKind: "unary";
+    input: typeof ApplicationIdRequestSchema;
+    output: typeof AlertRulesSchema;
+  },
+  /**
+   * CreateAlertRule アプリのアラートルールを作成します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.CreateAlertRule
+   */
+  createAlertRule: {
+    methodKind: "unary";
+    input: typeof CreateAlertRuleRequestSchema;
+    output: typeof AlertRuleSchema;
+  },
+  /**
+   * DeleteAlertRule アプリのアラートルールを削除します ルールのアラート履歴も削除されます
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.DeleteAlertRule
+   */
+  deleteAlertRule: {
+    methodKind: "unary";
+    input: typeof DeleteAlertRuleRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetAlerts アプリのアラートの発火・解決履歴を新しい順に取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetAlerts
+   */
+  getAlerts: {
+    methodKind: "unary";
+    input: typeof GetAlertsRequestSchema;
+    output: typeof AlertsSchema;
+  },
+  /**
+   * GetNotificationSubscriptions アプリの自分の通知設定一覧を取得します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.GetNotificationSubscriptions
+   */
+  getNotificationSubscriptions: {
+    methodKind: "unary";
+    input: typeof ApplicationIdRequestSchema;
+    output: typeof NotificationSubscriptionsSchema;
+  },
+  /**
+   * CreateNotificationSubscription アプリの通知設定を作成します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.CreateNotificationSubscription
+   */
+  createNotificationSubscription: {
+    methodKind: "unary";
+    input: typeof CreateNotificationSubscriptionRequestSchema;
+    output: typeof NotificationSubscriptionSchema;
+  },
+  /**
+   * DeleteNotificationSubscription アプリの自分の通知設定を削除します
+   *
+   * @generated from rpc neoshowcase.protobuf.APIService.DeleteNotificationSubscription
+   */
+  deleteNotificationSubscription: {
+    methodKind: "unary";
+    input: typeof DeleteNotificationSubscriptionRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * GetEnvVars アプリの環境変数を取得します
    *
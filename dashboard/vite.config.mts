@@ -27,6 +27,14 @@ export default defineConfig(({ mode }) => ({
         target: "http://ns.local.trapti.tech",
         changeOrigin: true,
       },
+      "/api/export": {
+        target: "http://ns.local.trapti.tech",
+        changeOrigin: true,
+      },
+      "/api/status": {
+        target: "http://ns.local.trapti.tech",
+        changeOrigin: true,
+      },
     },
     allowedHosts: ["ns.local.trapti.tech"],
   },
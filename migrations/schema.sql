@@ -21,6 +21,19 @@ CREATE TABLE `user_keys`
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_general_ci COMMENT = 'ユーザーSSHキーテーブル';
 
+CREATE TABLE `user_resource_limits`
+(
+    `user_id`                  CHAR(22) NOT NULL COMMENT 'ユーザーID',
+    `max_applications`         INT      NOT NULL COMMENT 'アプリケーション数上限',
+    `max_running_applications` INT      NOT NULL COMMENT '起動中アプリケーション数上限',
+    `max_port_publications`    INT      NOT NULL COMMENT '公開ポート数上限',
+    `max_databases`            INT      NOT NULL COMMENT 'データベース数上限',
+    PRIMARY KEY (`user_id`),
+    CONSTRAINT `fk_user_resource_limits_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT = 'ユーザーリソース上限テーブル';
+
 CREATE TABLE `repositories`
 (
     `id`   CHAR(22)     NOT NULL COMMENT 'リポジトリID',
@@ -94,6 +107,8 @@ CREATE TABLE `applications`
     `current_build`     CHAR(22)                   NOT NULL COMMENT 'デプロイするビルド',
     `created_at`        DATETIME(6)                NOT NULL COMMENT '作成日時',
     `updated_at`        DATETIME(6)                NOT NULL COMMENT '更新日時',
+    `config_file_path`  VARCHAR(255)               NOT NULL DEFAULT '' COMMENT 'リポジトリ内の設定ファイルのパス(空の場合はneoshowcase.yaml)',
+    `config_file_error` TEXT                       NOT NULL COMMENT '設定ファイルの適用エラー',
     PRIMARY KEY (`id`),
     KEY `fk_applications_repository_id` (`repository_id`),
     CONSTRAINT `fk_applications_repository_id` FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`)
@@ -123,6 +138,10 @@ CREATE TABLE `application_config`
     `build_cmd`       TEXT          NOT NULL COMMENT 'ビルドコマンド',
     `artifact_path`   VARCHAR(100)  NOT NULL COMMENT '静的成果物のパス',
     `spa`             TINYINT(1)    NOT NULL COMMENT '静的成果物をSPAとして配信するか',
+    `not_found_path`  VARCHAR(100)  NOT NULL DEFAULT '' COMMENT '(static only)404ページとして配信する静的成果物内のパス',
+    `asset_cache_control` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '(static only)ハッシュ付きファイル名のアセットのCache-Control',
+    `html_cache_control`  VARCHAR(200) NOT NULL DEFAULT '' COMMENT '(static only)HTMLのCache-Control',
+    `precompressed`   TINYINT(1)    NOT NULL DEFAULT 1 COMMENT '(static only)圧縮済みファイル(.br, .gz)があれば配信するか',
     `dockerfile_name` VARCHAR(100)  NOT NULL COMMENT 'Dockerfile名',
     `context`         VARCHAR(100)  NOT NULL COMMENT 'ビルド時のcontext',
     `entrypoint`      TEXT          NOT NULL COMMENT 'Entrypoint(args)',
@@ -151,6 +170,91 @@ CREATE TABLE `websites`
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_general_ci COMMENT ='Webサイトテーブル';
 
+CREATE TABLE `website_rules`
+(
+    `website_id`     CHAR(22)                     NOT NULL COMMENT 'サイトID',
+    `position`       INT(11)                      NOT NULL COMMENT '評価順',
+    `type`           ENUM ('redirect', 'rewrite') NOT NULL COMMENT 'ルールの種類',
+    `path_regex`     VARCHAR(1000)                NOT NULL COMMENT 'リクエストパスにマッチする正規表現',
+    `target`         VARCHAR(1000)                NOT NULL COMMENT 'リダイレクト先または書き換え後のパス',
+    `status_code`    INT(11)                      NOT NULL DEFAULT 0 COMMENT '(redirect only)リダイレクトのステータスコード',
+    `preserve_query` TINYINT(1)                   NOT NULL DEFAULT 0 COMMENT '(redirect only)クエリ文字列を引き継ぐかどうか',
+    PRIMARY KEY (`website_id`, `position`),
+    CONSTRAINT `fk_website_rules_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Webサイトのリダイレクト・書き換えルールテーブル';
+
+CREATE TABLE `website_headers`
+(
+    `website_id` CHAR(22)      NOT NULL COMMENT 'サイトID',
+    `name`       VARCHAR(100)  NOT NULL COMMENT 'ヘッダー名',
+    `value`      VARCHAR(1000) NOT NULL COMMENT 'ヘッダーの値',
+    PRIMARY KEY (`website_id`, `name`),
+    CONSTRAINT `fk_website_headers_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Webサイトのカスタムレスポンスヘッダーテーブル';
+
+CREATE TABLE `website_cors_policies`
+(
+    `website_id`        CHAR(22)   NOT NULL COMMENT 'サイトID',
+    `allow_origins`     TEXT       NOT NULL COMMENT '許可するオリジン (カンマ区切り)',
+    `allow_methods`     TEXT       NOT NULL COMMENT '許可するメソッド (カンマ区切り)',
+    `allow_headers`     TEXT       NOT NULL COMMENT '許可するリクエストヘッダー (カンマ区切り)',
+    `expose_headers`    TEXT       NOT NULL COMMENT '公開するレスポンスヘッダー (カンマ区切り)',
+    `allow_credentials` TINYINT(1) NOT NULL COMMENT '認証情報を許可するかどうか',
+    `max_age`           INT(11)    NOT NULL COMMENT 'プリフライトレスポンスのキャッシュ秒数',
+    PRIMARY KEY (`website_id`),
+    CONSTRAINT `fk_website_cors_policies_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='WebサイトのCORSポリシーテーブル';
+
+CREATE TABLE `website_ip_allowlist_entries`
+(
+    `website_id` CHAR(22)    NOT NULL COMMENT 'サイトID',
+    `cidr`       VARCHAR(50) NOT NULL COMMENT 'アクセスを許可するアドレス範囲',
+    PRIMARY KEY (`website_id`, `cidr`),
+    CONSTRAINT `fk_website_ip_allowlist_entries_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='WebサイトのIPアドレス許可リストテーブル';
+
+CREATE TABLE `website_basic_auth_users`
+(
+    `website_id`    CHAR(22)     NOT NULL COMMENT 'サイトID',
+    `username`      VARCHAR(64)  NOT NULL COMMENT 'ユーザー名',
+    `password_hash` VARCHAR(100) NOT NULL COMMENT 'パスワードのbcryptハッシュ',
+    PRIMARY KEY (`website_id`, `username`),
+    CONSTRAINT `fk_website_basic_auth_users_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='WebサイトのBasic認証ユーザーテーブル';
+
+CREATE TABLE `website_rate_limits`
+(
+    `website_id`    CHAR(22)     NOT NULL COMMENT 'サイトID',
+    `average`       INT(11)      NOT NULL COMMENT '1秒あたりの平均リクエスト数',
+    `burst`         INT(11)      NOT NULL COMMENT '同時に許可する最大リクエスト数',
+    `source_header` VARCHAR(100) NOT NULL COMMENT 'クライアントを区別するリクエストヘッダー名 (空の場合はIPアドレス)',
+    PRIMARY KEY (`website_id`),
+    CONSTRAINT `fk_website_rate_limits_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Webサイトのレート制限テーブル';
+
+CREATE TABLE `website_backends`
+(
+    `website_id`     CHAR(22) NOT NULL COMMENT 'サイトID',
+    `application_id` CHAR(22) NOT NULL COMMENT 'トラフィックを受けるアプリケーションID',
+    `weight`         INT(11)  NOT NULL COMMENT 'トラフィックの重み',
+    PRIMARY KEY (`website_id`, `application_id`),
+    CONSTRAINT `fk_website_backends_website_id` FOREIGN KEY (`website_id`) REFERENCES `websites` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='Webサイトのトラフィック分配先テーブル';
+
 CREATE TABLE `port_publications`
 (
     `application_id`   CHAR(22) NOT NULL COMMENT 'アプリケーションID',
@@ -163,6 +267,26 @@ CREATE TABLE `port_publications`
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_general_ci COMMENT = '公開ポートテーブル';
 
+CREATE TABLE `application_internal_services`
+(
+    `application_id` CHAR(22) NOT NULL COMMENT 'アプリケーションID',
+    `port`           INT(11)  NOT NULL COMMENT '他のアプリに公開するアプリケーション側ポート',
+    PRIMARY KEY (`application_id`),
+    CONSTRAINT `fk_application_internal_services_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT = 'アプリ間通信用の内部ポートテーブル';
+
+CREATE TABLE `application_internal_service_allowed_apps`
+(
+    `application_id`         CHAR(22) NOT NULL COMMENT 'アプリケーションID',
+    `allowed_application_id` CHAR(22) NOT NULL COMMENT 'アクセスを許可するアプリケーションID',
+    PRIMARY KEY (`application_id`, `allowed_application_id`),
+    CONSTRAINT `fk_application_internal_service_allowed_apps_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT = '内部ポートへのアクセスを許可するアプリテーブル';
+
 CREATE TABLE `application_owners`
 (
     `user_id`        CHAR(22) NOT NULL COMMENT 'ユーザーID',
@@ -238,3 +362,148 @@ CREATE TABLE `environments`
 ) ENGINE = InnoDB
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_general_ci COMMENT ='環境変数テーブル';
+
+CREATE TABLE `application_events`
+(
+    `id`             CHAR(22)     NOT NULL COMMENT 'イベントID',
+    `application_id` CHAR(22)     NOT NULL COMMENT 'アプリケーションID',
+    `type`           ENUM (
+        'container_state',
+        'build_started',
+        'build_finished',
+        'deployed',
+        'config_changed',
+        'started',
+        'stopped'
+        )                         NOT NULL COMMENT 'イベントの種類',
+    `reference`      VARCHAR(22)  NOT NULL DEFAULT '' COMMENT '関連するエンティティのID',
+    `message`        TEXT         NOT NULL COMMENT 'イベントの詳細',
+    `user_id`        VARCHAR(22)  NOT NULL DEFAULT '' COMMENT '操作したユーザーのID (システムによるイベントの場合は空)',
+    `created_at`     DATETIME(6)  NOT NULL COMMENT '発生日時',
+    PRIMARY KEY (`id`),
+    KEY `idx_application_events_application_id_created_at` (`application_id`, `created_at`),
+    CONSTRAINT `fk_application_events_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='アプリケーションイベントテーブル';
+
+CREATE TABLE `website_probes`
+(
+    `id`             CHAR(22)     NOT NULL COMMENT 'プローブID',
+    `website_id`     CHAR(22)     NOT NULL COMMENT 'ウェブサイトID',
+    `application_id` CHAR(22)     NOT NULL COMMENT 'アプリケーションID',
+    `checked_at`     DATETIME(6)  NOT NULL COMMENT '確認日時',
+    `up`             TINYINT(1)   NOT NULL COMMENT '稼働しているか',
+    `status_code`    INT          NOT NULL COMMENT 'HTTPステータスコード (応答がない場合は0)',
+    `latency_ms`     INT          NOT NULL COMMENT '応答時間 (ミリ秒)',
+    `tls_expires_at` DATETIME(6)  NULL COMMENT 'TLS証明書の有効期限',
+    `error`          TEXT         NOT NULL COMMENT 'エラーメッセージ',
+    PRIMARY KEY (`id`),
+    KEY `idx_website_probes_website_id_checked_at` (`website_id`, `checked_at`),
+    KEY `idx_website_probes_checked_at` (`checked_at`),
+    CONSTRAINT `fk_website_probes_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='ウェブサイト死活監視結果テーブル';
+
+CREATE TABLE `alert_rules`
+(
+    `id`               CHAR(22)               NOT NULL COMMENT 'アラートルールID',
+    `application_id`   CHAR(22)               NOT NULL COMMENT 'アプリケーションID',
+    `name`             VARCHAR(100)           NOT NULL COMMENT 'ルール名',
+    `kind`             ENUM ('metric', 'no_log') NOT NULL COMMENT 'ルールの種類',
+    `metric`           VARCHAR(100)           NOT NULL DEFAULT '' COMMENT 'メトリクス名 (kindがmetricの場合)',
+    `comparison`       ENUM ('above', 'below') NOT NULL COMMENT '閾値との比較方法',
+    `threshold`        DOUBLE                 NOT NULL COMMENT '閾値',
+    `threshold_unit`   ENUM ('value', 'limit_percent') NOT NULL DEFAULT 'value' COMMENT '閾値の単位',
+    `duration_seconds` INT                    NOT NULL COMMENT '条件が継続する時間 (秒)',
+    `created_at`       DATETIME(6)            NOT NULL COMMENT '作成日時',
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_alert_rules_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='アラートルールテーブル';
+
+CREATE TABLE `alerts`
+(
+    `id`             CHAR(22)    NOT NULL COMMENT 'アラートID',
+    `rule_id`        CHAR(22)    NOT NULL COMMENT 'アラートルールID',
+    `application_id` CHAR(22)    NOT NULL COMMENT 'アプリケーションID',
+    `message`        TEXT        NOT NULL COMMENT 'アラートの詳細',
+    `fired_at`       DATETIME(6) NOT NULL COMMENT '発火日時',
+    `resolved_at`    DATETIME(6) NULL COMMENT '解決日時 (発火中の場合はNULL)',
+    PRIMARY KEY (`id`),
+    KEY `idx_alerts_application_id_fired_at` (`application_id`, `fired_at`),
+    CONSTRAINT `fk_alerts_rule_id` FOREIGN KEY (`rule_id`) REFERENCES `alert_rules` (`id`),
+    CONSTRAINT `fk_alerts_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='アラート履歴テーブル';
+
+CREATE TABLE `notification_subscriptions`
+(
+    `id`                   CHAR(22)     NOT NULL COMMENT '通知設定ID',
+    `application_id`       CHAR(22)     NOT NULL COMMENT 'アプリケーションID',
+    `user_id`              CHAR(22)     NOT NULL COMMENT 'ユーザーID',
+    `sink`                 ENUM (
+        'webhook',
+        'slack',
+        'traq',
+        'email'
+        )                               NOT NULL COMMENT '通知先の種類',
+    `target`               VARCHAR(512) NOT NULL COMMENT '通知先 (Webhook URLまたはメールアドレス)',
+    `secret`               VARCHAR(256) NOT NULL DEFAULT '' COMMENT 'Webhookの署名用シークレット',
+    `on_build_failed`      TINYINT(1)   NOT NULL COMMENT 'ビルド失敗時に通知するか',
+    `on_build_succeeded`   TINYINT(1)   NOT NULL COMMENT 'ビルド成功時に通知するか',
+    `on_deployed`          TINYINT(1)   NOT NULL COMMENT 'デプロイ完了時に通知するか',
+    `on_container_errored` TINYINT(1)   NOT NULL COMMENT 'コンテナ異常時に通知するか',
+    `on_certificate_error` TINYINT(1)   NOT NULL COMMENT 'TLS証明書の問題発生時に通知するか',
+    `on_alert`             TINYINT(1)   NOT NULL COMMENT 'アラートの発火・解決時に通知するか',
+    `created_at`           DATETIME(6)  NOT NULL COMMENT '作成日時',
+    PRIMARY KEY (`id`),
+    KEY `idx_notification_subscriptions_application_id` (`application_id`),
+    CONSTRAINT `fk_notification_subscriptions_application_id` FOREIGN KEY (`application_id`) REFERENCES `applications` (`id`),
+    CONSTRAINT `fk_notification_subscriptions_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='通知設定テーブル';
+
+CREATE TABLE `custom_domains`
+(
+    `id`          CHAR(22)     NOT NULL COMMENT 'カスタムドメインID',
+    `user_id`     CHAR(22)     NOT NULL COMMENT '所有ユーザーID',
+    `domain`      VARCHAR(253) NOT NULL COMMENT 'ドメイン',
+    `method`      ENUM (
+        'dns',
+        'http'
+        )                      NOT NULL COMMENT '所有確認の方法',
+    `token`       CHAR(32)     NOT NULL COMMENT '所有確認用トークン',
+    `verified`    TINYINT(1)   NOT NULL COMMENT '所有が確認されているか',
+    `verified_at` DATETIME(6)  NULL COMMENT '最後に所有が確認された日時',
+    `checked_at`  DATETIME(6)  NULL COMMENT '最後に所有確認を行った日時',
+    `error`       TEXT         NOT NULL COMMENT '最後の所有確認のエラー',
+    `created_at`  DATETIME(6)  NOT NULL COMMENT '作成日時',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `user_id_domain` (`user_id`, `domain`),
+    KEY `idx_custom_domains_domain` (`domain`),
+    CONSTRAINT `fk_custom_domains_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='カスタムドメインテーブル';
+
+CREATE TABLE `tls_certificates`
+(
+    `id`          CHAR(22)     NOT NULL COMMENT '証明書ID',
+    `user_id`     CHAR(22)     NOT NULL COMMENT 'アップロードしたユーザーID',
+    `fqdn`        VARCHAR(253) NOT NULL COMMENT '証明書を使用するドメイン',
+    `certificate` TEXT         NOT NULL COMMENT 'PEM形式の証明書チェーン',
+    `private_key` BLOB         NOT NULL COMMENT '暗号化されたPEM形式の秘密鍵',
+    `not_before`  DATETIME(6)  NOT NULL COMMENT '証明書の有効期間の開始日時',
+    `not_after`   DATETIME(6)  NOT NULL COMMENT '証明書の有効期限',
+    `created_at`  DATETIME(6)  NOT NULL COMMENT '作成日時',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `fqdn` (`fqdn`),
+    CONSTRAINT `fk_tls_certificates_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='アップロードされたTLS証明書テーブル';
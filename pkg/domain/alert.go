@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+type AlertRuleKind int
+
+const (
+	// AlertRuleKindMetric fires when the metric value stays above or below the threshold for the duration.
+	AlertRuleKindMetric AlertRuleKind = iota
+	// AlertRuleKindNoLog fires when a running application has no log output for the duration.
+	AlertRuleKindNoLog
+)
+
+type AlertComparison int
+
+const (
+	AlertComparisonAbove AlertComparison = iota
+	AlertComparisonBelow
+)
+
+type AlertThresholdUnit int
+
+const (
+	// AlertThresholdUnitValue compares the threshold against raw values of the metric.
+	AlertThresholdUnitValue AlertThresholdUnit = iota
+	// AlertThresholdUnitLimitPercent compares the threshold against values of the metric in percent of its limit,
+	// such as memory usage relative to the memory limit of the container.
+	AlertThresholdUnitLimitPercent
+)
+
+const (
+	alertRuleNameMaxLength = 100
+	alertRuleMinDuration   = time.Minute
+	alertRuleMaxDuration   = 7 * 24 * time.Hour
+	// alertMetricSampleSlack is the tolerance of the oldest value to the start of the duration,
+	// covering the sampling step of the metrics backends (1m for Prometheus, the configured interval for docker stats).
+	alertMetricSampleSlack = time.Minute
+)
+
+// AlertRule is a condition on an application defined by its owners, evaluated periodically by the controller.
+type AlertRule struct {
+	ID            string
+	ApplicationID string
+	Name          string
+	Kind          AlertRuleKind
+	// Metric is the name of the metric in MetricsService, used for AlertRuleKindMetric.
+	Metric        string
+	Comparison    AlertComparison
+	Threshold     float64
+	ThresholdUnit AlertThresholdUnit
+	Duration      time.Duration
+	CreatedAt     time.Time
+}
+
+func NewAlertRule(
+	applicationID string,
+	name string,
+	kind AlertRuleKind,
+	metric string,
+	comparison AlertComparison,
+	threshold float64,
+	thresholdUnit AlertThresholdUnit,
+	duration time.Duration,
+) *AlertRule {
+	return &AlertRule{
+		ID:            NewID(),
+		ApplicationID: applicationID,
+		Name:          name,
+		Kind:          kind,
+		Metric:        metric,
+		Comparison:    comparison,
+		Threshold:     threshold,
+		ThresholdUnit: thresholdUnit,
+		Duration:      duration,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// Validate validates the rule. availableMetrics are the metric names provided by MetricsService.
+func (r *AlertRule) Validate(availableMetrics []string) error {
+	if r.Name == "" {
+		return oops.New("name is required")
+	}
+	if len(r.Name) > alertRuleNameMaxLength {
+		return oops.Errorf("name must be at most %d bytes", alertRuleNameMaxLength)
+	}
+	if r.Duration < alertRuleMinDuration || r.Duration > alertRuleMaxDuration {
+		return oops.Errorf("duration must be between %v and %v", alertRuleMinDuration, alertRuleMaxDuration)
+	}
+	switch r.Kind {
+	case AlertRuleKindMetric:
+		if !slices.Contains(availableMetrics, r.Metric) {
+			return oops.Errorf("unknown metric %q (available metrics: %s)", r.Metric, strings.Join(availableMetrics, ", "))
+		}
+		switch r.ThresholdUnit {
+		case AlertThresholdUnitValue:
+		case AlertThresholdUnitLimitPercent:
+			if r.Threshold < 0 {
+				return oops.New("threshold in percent of limit cannot be negative")
+			}
+		default:
+			return oops.Errorf("unknown threshold unit: %v", r.ThresholdUnit)
+		}
+	case AlertRuleKindNoLog:
+		if r.Metric != "" {
+			return oops.New("metric cannot be set for no log rules")
+		}
+		if r.ThresholdUnit != AlertThresholdUnitValue {
+			return oops.New("threshold unit cannot be set for no log rules")
+		}
+	default:
+		return oops.Errorf("unknown alert rule kind: %v", r.Kind)
+	}
+	return nil
+}
+
+func (r *AlertRule) breaches(value float64) bool {
+	switch r.Comparison {
+	case AlertComparisonAbove:
+		return value > r.Threshold
+	case AlertComparisonBelow:
+		return value < r.Threshold
+	default:
+		return false
+	}
+}
+
+// EvaluateMetric evaluates the metric rule against values of the metric within the last Duration before now.
+// The rule fires if the values span the whole Duration, and all of them breach the threshold.
+// limit is the limit of the metric, required if the threshold is in percent of the limit.
+// If the rule fires, a human-readable message is also returned.
+func (r *AlertRule) EvaluateMetric(now time.Time, values []*AppMetric, limit optional.Of[float64]) (firing bool, message string, err error) {
+	values = slices.SortedFunc(slices.Values(values), func(a, b *AppMetric) int { return a.Time.Compare(b.Time) })
+	unit := ""
+	if r.ThresholdUnit == AlertThresholdUnitLimitPercent {
+		if !limit.Valid || limit.V <= 0 {
+			return false, "", oops.Errorf("limit of metric %v is unknown", r.Metric)
+		}
+		values = lo.Map(values, func(v *AppMetric, _ int) *AppMetric {
+			return &AppMetric{Time: v.Time, Value: v.Value / limit.V * 100}
+		})
+		unit = "% of limit"
+	}
+
+	if !spansDuration(now.Add(-r.Duration), values) {
+		return false, "", nil
+	}
+	if !lo.EveryBy(values, func(v *AppMetric) bool { return r.breaches(v.Value) }) {
+		return false, "", nil
+	}
+	op := ">"
+	if r.Comparison == AlertComparisonBelow {
+		op = "<"
+	}
+	latest := values[len(values)-1]
+	return true, fmt.Sprintf("%s %s %g%s for %v (latest value: %g%s)", r.Metric, op, r.Threshold, unit, r.Duration, latest.Value, unit), nil
+}
+
+// spansDuration reports whether the values in chronological order cover the time since start,
+// i.e. the oldest value is not later than start by more than alertMetricSampleSlack,
+// so that a condition which only started recently does not fire.
+func spansDuration(start time.Time, values []*AppMetric) bool {
+	if len(values) < 2 {
+		return false
+	}
+	return values[0].Time.Sub(start) <= alertMetricSampleSlack
+}
+
+// EvaluateNoLog evaluates the no log rule from the time of the latest log line of the application.
+// latestLog is not valid if no log line was found.
+func (r *AlertRule) EvaluateNoLog(now time.Time, latestLog optional.Of[time.Time]) (firing bool, message string) {
+	if latestLog.Valid && now.Sub(latestLog.V) < r.Duration {
+		return false, ""
+	}
+	if !latestLog.Valid {
+		return true, fmt.Sprintf("no log output for %v", r.Duration)
+	}
+	return true, fmt.Sprintf("no log output for %v (last output at %s)", r.Duration, latestLog.V.Format(time.RFC3339))
+}
+
+// Alert is a firing or resolved occurrence of an AlertRule.
+type Alert struct {
+	ID            string
+	RuleID        string
+	ApplicationID string
+	Message       string
+	FiredAt       time.Time
+	// ResolvedAt is not valid while the alert is firing.
+	ResolvedAt optional.Of[time.Time]
+}
+
+func NewAlert(rule *AlertRule, message string, firedAt time.Time) *Alert {
+	return &Alert{
+		ID:            NewID(),
+		RuleID:        rule.ID,
+		ApplicationID: rule.ApplicationID,
+		Message:       message,
+		FiredAt:       firedAt,
+	}
+}
+
+func (a *Alert) Firing() bool {
+	return !a.ResolvedAt.Valid
+}
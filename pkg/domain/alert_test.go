@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestAlertRule_Validate(t *testing.T) {
+	metrics := []string{"CPU", "Memory"}
+	tests := []struct {
+		name    string
+		rule    *AlertRule
+		wantErr bool
+	}{
+		{"metric", &AlertRule{Name: "high memory", Kind: AlertRuleKindMetric, Metric: "Memory", Duration: 5 * time.Minute}, false},
+		{"no log", &AlertRule{Name: "silent", Kind: AlertRuleKindNoLog, Duration: time.Hour}, false},
+		{"empty name", &AlertRule{Kind: AlertRuleKindNoLog, Duration: time.Hour}, true},
+		{"unknown metric", &AlertRule{Name: "disk", Kind: AlertRuleKindMetric, Metric: "Disk", Duration: 5 * time.Minute}, true},
+		{"metric on no log", &AlertRule{Name: "silent", Kind: AlertRuleKindNoLog, Metric: "CPU", Duration: time.Hour}, true},
+		{"percent of limit", &AlertRule{Name: "high memory", Kind: AlertRuleKindMetric, Metric: "Memory", Threshold: 80, ThresholdUnit: AlertThresholdUnitLimitPercent, Duration: 5 * time.Minute}, false},
+		{"negative percent of limit", &AlertRule{Name: "high memory", Kind: AlertRuleKindMetric, Metric: "Memory", Threshold: -1, ThresholdUnit: AlertThresholdUnitLimitPercent, Duration: 5 * time.Minute}, true},
+		{"percent of limit on no log", &AlertRule{Name: "silent", Kind: AlertRuleKindNoLog, ThresholdUnit: AlertThresholdUnitLimitPercent, Duration: time.Hour}, true},
+		{"too short", &AlertRule{Name: "cpu", Kind: AlertRuleKindMetric, Metric: "CPU", Duration: 10 * time.Second}, true},
+		{"too long", &AlertRule{Name: "cpu", Kind: AlertRuleKindMetric, Metric: "CPU", Duration: 30 * 24 * time.Hour}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(metrics)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAlertRule_EvaluateMetric(t *testing.T) {
+	now := time.Now()
+	// values returns the values sampled every minute, the last one at now
+	values := func(vs ...float64) []*AppMetric {
+		ret := make([]*AppMetric, len(vs))
+		for i, v := range vs {
+			ret[i] = &AppMetric{Time: now.Add(time.Duration(i-len(vs)+1) * time.Minute), Value: v}
+		}
+		return ret
+	}
+	above := &AlertRule{Metric: "Memory", Comparison: AlertComparisonAbove, Threshold: 90, Duration: 5 * time.Minute}
+	below := &AlertRule{Metric: "CPU", Comparison: AlertComparisonBelow, Threshold: 0.01, Duration: 3 * time.Minute}
+	percent := &AlertRule{Metric: "Memory", Comparison: AlertComparisonAbove, Threshold: 80, ThresholdUnit: AlertThresholdUnitLimitPercent, Duration: 3 * time.Minute}
+	noLimit := optional.None[float64]()
+
+	tests := []struct {
+		name        string
+		rule        *AlertRule
+		values      []*AppMetric
+		limit       optional.Of[float64]
+		wantFiring  bool
+		wantMessage string
+		wantErr     bool
+	}{
+		{"above", above, values(91, 95, 99, 92, 93, 99), noLimit, true, "Memory > 90 for 5m0s (latest value: 99)", false},
+		{"not all values breach", above, values(91, 95, 85, 92, 93, 99), noLimit, false, "", false},
+		{"not spanning duration", above, values(91, 95, 99), noLimit, false, "", false},
+		{"single value", above, values(99), noLimit, false, "", false},
+		{"sparse values", &AlertRule{Metric: "Memory", Comparison: AlertComparisonAbove, Threshold: 90, Duration: 10 * time.Minute}, []*AppMetric{
+			{Time: now.Add(-5 * time.Minute), Value: 99},
+			{Time: now, Value: 99},
+		}, noLimit, false, "", false},
+		{"oldest value within slack", above, []*AppMetric{
+			{Time: now.Add(-4*time.Minute - 30*time.Second), Value: 99},
+			{Time: now, Value: 99},
+		}, noLimit, true, "Memory > 90 for 5m0s (latest value: 99)", false},
+		{"no values", above, nil, noLimit, false, "", false},
+		{"below", below, values(0, 0.001, 0, 0), noLimit, true, "CPU < 0.01 for 3m0s (latest value: 0)", false},
+		{"not below", below, values(0, 0.5, 0, 0), noLimit, false, "", false},
+		{"percent of limit", percent, values(900, 850, 950, 1000), optional.From(1000.0), true, "Memory > 80% of limit for 3m0s (latest value: 100% of limit)", false},
+		{"below percent of limit", percent, values(900, 850, 950, 1000), optional.From(2000.0), false, "", false},
+		{"unknown limit", percent, values(900, 850, 950, 1000), noLimit, false, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			firing, message, err := tt.rule.EvaluateMetric(now, tt.values, tt.limit)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFiring, firing)
+			assert.Equal(t, tt.wantMessage, message)
+		})
+	}
+}
+
+func TestAlertRule_EvaluateNoLog(t *testing.T) {
+	now := time.Now()
+	rule := &AlertRule{Kind: AlertRuleKindNoLog, Duration: time.Hour}
+
+	firing, _ := rule.EvaluateNoLog(now, optional.From(now.Add(-10*time.Minute)))
+	assert.False(t, firing)
+
+	firing, message := rule.EvaluateNoLog(now, optional.From(now.Add(-2*time.Hour)))
+	assert.True(t, firing)
+	assert.Contains(t, message, "last output at")
+
+	firing, message = rule.EvaluateNoLog(now, optional.None[time.Time]())
+	assert.True(t, firing)
+	assert.Equal(t, "no log output for 1h0m0s", message)
+}
@@ -56,10 +56,16 @@ type Application struct {
 	CurrentBuild     string
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	// ConfigFilePath is the path to the configuration file in the repository.
+	// If empty, DefaultConfigFilePath is used.
+	ConfigFilePath string
+	// ConfigFileError is the error encountered when applying the configuration file, if any.
+	ConfigFileError string
 
 	Config           ApplicationConfig
 	Websites         []*Website
 	PortPublications []*PortPublication
+	InternalService  InternalService
 	OwnerIDs         []string
 }
 
@@ -76,16 +82,25 @@ func (a *Application) SelfValidate() error {
 	if err := a.Config.Validate(a.DeployType); err != nil {
 		return oops.Wrapf(err, "invalid config")
 	}
+	if err := ValidateConfigFilePath(a.ConfigFilePath); err != nil {
+		return oops.Wrapf(err, "invalid config_file_path")
+	}
 	for _, website := range a.Websites {
 		if err := website.Validate(); err != nil {
 			return oops.Wrapf(err, "invalid website")
 		}
+		if err := a.validateSplit(website); err != nil {
+			return oops.Wrapf(err, "invalid website")
+		}
 	}
 	for _, p := range a.PortPublications {
 		if err := p.Validate(); err != nil {
 			return oops.Wrapf(err, "invalid port publication")
 		}
 	}
+	if err := a.validateInternalService(); err != nil {
+		return oops.Wrapf(err, "invalid internal service")
+	}
 	if len(a.OwnerIDs) == 0 {
 		return oops.New("owner_ids cannot be empty")
 	}
@@ -128,10 +143,45 @@ func (a *Application) Validate(
 			return oops.Errorf("port %d/%s conflicts with existing port publication", p.InternetPort, p.Protocol)
 		}
 	}
+	if a.InternalServiceConflicts(existingApps) {
+		return oops.Errorf("internal hostname %s conflicts with existing application", a.InternalHostname())
+	}
 
 	return nil
 }
 
+func (a *Application) validateInternalService() error {
+	if !a.InternalService.Enabled() {
+		return nil
+	}
+	if a.DeployType != DeployTypeRuntime {
+		return oops.New("internal service is only available for runtime applications")
+	}
+	if err := a.InternalService.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateInternalServiceName(a.Name); err != nil {
+		return err
+	}
+	for _, p := range a.PortPublications {
+		if p.ApplicationPort == a.InternalService.Port {
+			return oops.Errorf("port %d is published to the internet", p.ApplicationPort)
+		}
+	}
+	if lo.Contains(a.InternalService.AllowedAppIDs, a.ID) {
+		return oops.New("allowed apps cannot contain the application itself")
+	}
+	return nil
+}
+
+// ConfigFilePathOrDefault returns the path to the configuration file in the repository.
+func (a *Application) ConfigFilePathOrDefault() string {
+	if a.ConfigFilePath == "" {
+		return DefaultConfigFilePath
+	}
+	return a.ConfigFilePath
+}
+
 func (a *Application) IsOwner(user *User) bool {
 	return user.Admin || lo.Contains(a.OwnerIDs, user.ID)
 }
@@ -2,11 +2,14 @@ package domain
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/google/shlex"
 	"github.com/samber/lo"
 	"github.com/samber/oops"
+	"golang.org/x/net/http/httpguts"
 )
 
 type BuildType int
@@ -126,18 +129,100 @@ func (rc *RuntimeConfig) GetStaticConfig() StaticConfig {
 	panic("not static config")
 }
 
+// StaticHashedAssetPathPattern matches the file names containing a content hash, as emitted by bundlers
+// such as Vite ("index-BxK3a9_d.js") and webpack ("main.3f2a9c1b.js").
+// Such files never change, so they can be cached for a long time.
+const StaticHashedAssetPathPattern = `(\.[0-9a-f]{8,}|-[0-9A-Za-z_-]{8})\.[0-9A-Za-z]+$`
+
+var staticHashedAssetPathRegexp = regexp.MustCompile(StaticHashedAssetPathPattern)
+
+// StaticPrecompressedEncoding is a content encoding of the precompressed files, with the suffix of the files.
+type StaticPrecompressedEncoding struct {
+	Encoding string
+	Suffix   string
+}
+
+// StaticPrecompressedEncodings are the encodings of the precompressed files served, in the order of preference.
+var StaticPrecompressedEncodings = []StaticPrecompressedEncoding{
+	{Encoding: "br", Suffix: ".br"},
+	{Encoding: "gzip", Suffix: ".gz"},
+}
+
+const (
+	maxStaticNotFoundPathLen = 100
+	maxStaticCacheControlLen = 200
+)
+
 type StaticConfig struct {
 	ArtifactPath string
 	SPA          bool
+	// NotFoundPath is the path of the page in the artifact served with status 404, e.g. "/404.html".
+	// The default page is served if empty. With SPA, the page is only served if index.html is not found.
+	NotFoundPath string
+	// AssetCacheControl is the Cache-Control of the hashed assets, see StaticHashedAssetPathPattern.
+	AssetCacheControl string
+	// HTMLCacheControl is the Cache-Control of the HTML documents, including the SPA fallback.
+	HTMLCacheControl string
+	// Precompressed serves the precompressed siblings of the files (e.g. "main.js.br"), if the client accepts them.
+	Precompressed bool
 }
 
 func (sc *StaticConfig) Validate() error {
 	if sc.ArtifactPath == "" {
 		return oops.New("artifact_path is required for static builds")
 	}
+	if sc.NotFoundPath != "" {
+		if len(sc.NotFoundPath) > maxStaticNotFoundPathLen {
+			return oops.Errorf("not_found_path must be at most %d characters", maxStaticNotFoundPathLen)
+		}
+		if !strings.HasPrefix(sc.NotFoundPath, "/") || path.Clean(sc.NotFoundPath) != sc.NotFoundPath || sc.NotFoundPath == "/" {
+			return oops.New("not_found_path must be an absolute and clean path to a file")
+		}
+		if err := validateQuotable(sc.NotFoundPath); err != nil {
+			return oops.Wrapf(err, "invalid not_found_path")
+		}
+	}
+	if err := validateStaticCacheControl("asset_cache_control", sc.AssetCacheControl); err != nil {
+		return err
+	}
+	if err := validateStaticCacheControl("html_cache_control", sc.HTMLCacheControl); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateStaticCacheControl(name, value string) error {
+	if len(value) > maxStaticCacheControlLen {
+		return oops.Errorf("%v must be at most %d characters", name, maxStaticCacheControlLen)
+	}
+	if !httpguts.ValidHeaderFieldValue(value) {
+		return oops.Errorf("%v has invalid characters", name)
+	}
+	if err := validateQuotable(value); err != nil {
+		return oops.Wrapf(err, "invalid %v", name)
+	}
 	return nil
 }
 
+// IsStaticHTMLPath returns true if the request path after the SPA fallback is served as an HTML document.
+// Directories are served with their index.html.
+func IsStaticHTMLPath(p string) bool {
+	return strings.HasSuffix(p, ".html") || strings.HasSuffix(p, "/")
+}
+
+// CacheControl returns the default Cache-Control of the file at the request path after the SPA fallback,
+// or an empty string if none.
+func (sc *StaticConfig) CacheControl(p string) string {
+	switch {
+	case IsStaticHTMLPath(p):
+		return sc.HTMLCacheControl
+	case staticHashedAssetPathRegexp.MatchString(p):
+		return sc.AssetCacheControl
+	default:
+		return ""
+	}
+}
+
 func (sc *StaticConfig) MariaDB() bool {
 	return false
 }
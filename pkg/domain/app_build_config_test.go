@@ -122,3 +122,63 @@ func TestParseArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  StaticConfig
+		wantErr bool
+	}{
+		{"ok", StaticConfig{ArtifactPath: "dist"}, false},
+		{"all options", StaticConfig{
+			ArtifactPath:      "dist",
+			NotFoundPath:      "/404.html",
+			AssetCacheControl: "public, max-age=31536000, immutable",
+			HTMLCacheControl:  "no-cache",
+			Precompressed:     true,
+		}, false},
+		{"no artifact path", StaticConfig{}, true},
+		{"relative not found path", StaticConfig{ArtifactPath: "dist", NotFoundPath: "404.html"}, true},
+		{"unclean not found path", StaticConfig{ArtifactPath: "dist", NotFoundPath: "/../404.html"}, true},
+		{"root not found path", StaticConfig{ArtifactPath: "dist", NotFoundPath: "/"}, true},
+		{"invalid cache control", StaticConfig{ArtifactPath: "dist", HTMLCacheControl: "no-cache\r\nX-Foo: bar"}, true},
+		{"cache control ending with backslash", StaticConfig{ArtifactPath: "dist", AssetCacheControl: `no-cache\`}, true},
+		{"not found path with backslash before quote", StaticConfig{ArtifactPath: "dist", NotFoundPath: `/404\".html`}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaticConfig_CacheControl(t *testing.T) {
+	sc := &StaticConfig{
+		AssetCacheControl: "immutable",
+		HTMLCacheControl:  "no-cache",
+	}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/", "no-cache"},
+		{"/docs/", "no-cache"},
+		{"/index.html", "no-cache"},
+		{"/assets/index-BxK3a9_d.js", "immutable"},
+		{"/static/js/main.3f2a9c1b.js", "immutable"},
+		{"/static/css/main.3f2a9c1b.css", "immutable"},
+		{"/favicon.ico", ""},
+		{"/js/jquery-3.7.1.min.js", ""},
+		{"/assets/logo.png", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, sc.CacheControl(tt.path))
+		})
+	}
+}
@@ -0,0 +1,522 @@
+package domain
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"gopkg.in/yaml.v3"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// DefaultConfigFilePath is the path of the configuration file read from the repository,
+// used when the application does not specify one.
+const DefaultConfigFilePath = "neoshowcase.yaml"
+
+// ValidateConfigFilePath validates a path to the configuration file, relative to the repository root.
+func ValidateConfigFilePath(p string) error {
+	if p == "" {
+		return nil
+	}
+	if strings.HasPrefix(p, "/") {
+		return oops.New("config file path must be relative to the repository root")
+	}
+	if path.Clean(p) != p {
+		return oops.New("config file path must be a clean path")
+	}
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return oops.New("config file path must be within the repository")
+	}
+	return nil
+}
+
+// ConfigFile is the application configuration declared in the repository (neoshowcase.yaml).
+//
+// Only non-secret settings are meant to be declared here; secrets are still managed as env vars in NeoShowcase.
+type ConfigFile struct {
+	Build            ConfigFileBuild              `yaml:"build" json:"build"`
+	Websites         []*ConfigFileWebsite         `yaml:"websites,omitempty" json:"websites,omitempty"`
+	PortPublications []*ConfigFilePortPublication `yaml:"portPublications,omitempty" json:"portPublications,omitempty"`
+	Env              map[string]string            `yaml:"env,omitempty" json:"env,omitempty"`
+}
+
+type ConfigFileBuild struct {
+	Type string `yaml:"type" json:"type"`
+
+	// runtime
+	UseMariaDB   bool   `yaml:"useMariaDB,omitempty" json:"useMariaDB,omitempty"`
+	UseMongoDB   bool   `yaml:"useMongoDB,omitempty" json:"useMongoDB,omitempty"`
+	Entrypoint   string `yaml:"entrypoint,omitempty" json:"entrypoint,omitempty"`
+	Command      string `yaml:"command,omitempty" json:"command,omitempty"`
+	AutoShutdown struct {
+		Enabled bool   `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+		Startup string `yaml:"startup,omitempty" json:"startup,omitempty"`
+	} `yaml:"autoShutdown,omitempty" json:"autoShutdown,omitempty"`
+
+	// static
+	ArtifactPath      string `yaml:"artifactPath,omitempty" json:"artifactPath,omitempty"`
+	SPA               bool   `yaml:"spa,omitempty" json:"spa,omitempty"`
+	NotFoundPath      string `yaml:"notFoundPath,omitempty" json:"notFoundPath,omitempty"`
+	AssetCacheControl string `yaml:"assetCacheControl,omitempty" json:"assetCacheControl,omitempty"`
+	HTMLCacheControl  string `yaml:"htmlCacheControl,omitempty" json:"htmlCacheControl,omitempty"`
+	Precompressed     bool   `yaml:"precompressed,omitempty" json:"precompressed,omitempty"`
+
+	// build
+	Context        string `yaml:"context,omitempty" json:"context,omitempty"`
+	BaseImage      string `yaml:"baseImage,omitempty" json:"baseImage,omitempty"`
+	BuildCmd       string `yaml:"buildCmd,omitempty" json:"buildCmd,omitempty"`
+	DockerfileName string `yaml:"dockerfileName,omitempty" json:"dockerfileName,omitempty"`
+}
+
+type ConfigFileWebsite struct {
+	FQDN           string `yaml:"fqdn" json:"fqdn"`
+	PathPrefix     string `yaml:"pathPrefix,omitempty" json:"pathPrefix,omitempty"`
+	StripPrefix    bool   `yaml:"stripPrefix,omitempty" json:"stripPrefix,omitempty"`
+	HTTPS          bool   `yaml:"https,omitempty" json:"https,omitempty"`
+	H2C            bool   `yaml:"h2c,omitempty" json:"h2c,omitempty"`
+	HTTPPort       int    `yaml:"httpPort,omitempty" json:"httpPort,omitempty"`
+	Authentication string `yaml:"authentication,omitempty" json:"authentication,omitempty"`
+
+	Rules   []*ConfigFileWebsiteRule `yaml:"rules,omitempty" json:"rules,omitempty"`
+	Headers map[string]string        `yaml:"headers,omitempty" json:"headers,omitempty"`
+	CORS    *ConfigFileWebsiteCORS   `yaml:"cors,omitempty" json:"cors,omitempty"`
+
+	IPAllowList []string                   `yaml:"ipAllowList,omitempty" json:"ipAllowList,omitempty"`
+	BasicAuth   []*ConfigFileBasicAuthUser `yaml:"basicAuth,omitempty" json:"basicAuth,omitempty"`
+
+	RateLimit *ConfigFileWebsiteRateLimit `yaml:"rateLimit,omitempty" json:"rateLimit,omitempty"`
+
+	Backends []*ConfigFileWebsiteBackend `yaml:"backends,omitempty" json:"backends,omitempty"`
+}
+
+// ConfigFileWebsiteBackend is an application to split the traffic to, including the application itself.
+type ConfigFileWebsiteBackend struct {
+	AppID  string `yaml:"appId" json:"appId"`
+	Weight int    `yaml:"weight" json:"weight"`
+}
+
+// ConfigFileWebsiteRateLimit enables the rate limit. Average and burst default to the values set by admins if omitted.
+type ConfigFileWebsiteRateLimit struct {
+	Average      int    `yaml:"average,omitempty" json:"average,omitempty"`
+	Burst        int    `yaml:"burst,omitempty" json:"burst,omitempty"`
+	SourceHeader string `yaml:"sourceHeader,omitempty" json:"sourceHeader,omitempty"`
+}
+
+type ConfigFileBasicAuthUser struct {
+	Username string `yaml:"username" json:"username"`
+	// PasswordHash is the bcrypt hash of the password, such as generated by "htpasswd -nB".
+	// The current password of the user is kept if omitted, so that the hash need not be committed.
+	PasswordHash string `yaml:"passwordHash,omitempty" json:"passwordHash,omitempty"`
+}
+
+type ConfigFileWebsiteCORS struct {
+	AllowOrigins     []string `yaml:"allowOrigins" json:"allowOrigins"`
+	AllowMethods     []string `yaml:"allowMethods,omitempty" json:"allowMethods,omitempty"`
+	AllowHeaders     []string `yaml:"allowHeaders,omitempty" json:"allowHeaders,omitempty"`
+	ExposeHeaders    []string `yaml:"exposeHeaders,omitempty" json:"exposeHeaders,omitempty"`
+	AllowCredentials bool     `yaml:"allowCredentials,omitempty" json:"allowCredentials,omitempty"`
+	MaxAge           int      `yaml:"maxAge,omitempty" json:"maxAge,omitempty"`
+}
+
+type ConfigFileWebsiteRule struct {
+	// Type is either "redirect" or "rewrite".
+	Type          string `yaml:"type" json:"type"`
+	Path          string `yaml:"path" json:"path"`
+	Target        string `yaml:"target" json:"target"`
+	StatusCode    int    `yaml:"statusCode,omitempty" json:"statusCode,omitempty"`
+	PreserveQuery bool   `yaml:"preserveQuery,omitempty" json:"preserveQuery,omitempty"`
+}
+
+type ConfigFilePortPublication struct {
+	InternetPort    int    `yaml:"internetPort,omitempty" json:"internetPort,omitempty"`
+	ApplicationPort int    `yaml:"applicationPort,omitempty" json:"applicationPort,omitempty"`
+	Protocol        string `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+}
+
+var configFileBuildTypeMapper = mapper.MustNewValueMapper(map[string]BuildType{
+	BuildTypeRuntimeBuildpack.String():  BuildTypeRuntimeBuildpack,
+	BuildTypeRuntimeCmd.String():        BuildTypeRuntimeCmd,
+	BuildTypeRuntimeDockerfile.String(): BuildTypeRuntimeDockerfile,
+	BuildTypeStaticBuildpack.String():   BuildTypeStaticBuildpack,
+	BuildTypeStaticCmd.String():         BuildTypeStaticCmd,
+	BuildTypeStaticDockerfile.String():  BuildTypeStaticDockerfile,
+})
+
+var configFileStartupMapper = mapper.MustNewValueMapper(map[string]StartupBehavior{
+	"":             StartupBehaviorUndefined,
+	"loading-page": StartupBehaviorLoadingPage,
+	"blocking":     StartupBehaviorBlocking,
+})
+
+var configFileWebsiteRuleTypeMapper = mapper.MustNewValueMapper(map[string]WebsiteRuleType{
+	"redirect": WebsiteRuleTypeRedirect,
+	"rewrite":  WebsiteRuleTypeRewrite,
+})
+
+var configFileAuthMapper = mapper.MustNewValueMapper(map[string]AuthenticationType{
+	"off":  AuthenticationTypeOff,
+	"soft": AuthenticationTypeSoft,
+	"hard": AuthenticationTypeHard,
+})
+
+// ParseConfigFile parses the content of a configuration file.
+func ParseConfigFile(b []byte) (*ConfigFile, error) {
+	var f ConfigFile
+	dec := yaml.NewDecoder(strings.NewReader(string(b)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&f); err != nil {
+		return nil, oops.Wrapf(err, "decoding config file")
+	}
+	return &f, nil
+}
+
+func (b *ConfigFileBuild) buildConfig() (BuildConfig, error) {
+	buildType, ok := configFileBuildTypeMapper.Into(b.Type)
+	if !ok {
+		return nil, oops.Errorf("unknown build type: %v", b.Type)
+	}
+	startup, ok := configFileStartupMapper.Into(b.AutoShutdown.Startup)
+	if !ok {
+		return nil, oops.Errorf("unknown startup behavior: %v", b.AutoShutdown.Startup)
+	}
+	rc := RuntimeConfig{
+		UseMariaDB: b.UseMariaDB,
+		UseMongoDB: b.UseMongoDB,
+		Entrypoint: b.Entrypoint,
+		Command:    b.Command,
+		AutoShutdown: AutoShutdownConfig{
+			Enabled: b.AutoShutdown.Enabled,
+			Startup: startup,
+		},
+	}
+	sc := StaticConfig{
+		ArtifactPath:      b.ArtifactPath,
+		SPA:               b.SPA,
+		NotFoundPath:      b.NotFoundPath,
+		AssetCacheControl: b.AssetCacheControl,
+		HTMLCacheControl:  b.HTMLCacheControl,
+		Precompressed:     b.Precompressed,
+	}
+	switch buildType {
+	case BuildTypeRuntimeBuildpack:
+		return &BuildConfigRuntimeBuildpack{RuntimeConfig: rc, Context: b.Context}, nil
+	case BuildTypeRuntimeCmd:
+		return &BuildConfigRuntimeCmd{RuntimeConfig: rc, BaseImage: b.BaseImage, BuildCmd: b.BuildCmd}, nil
+	case BuildTypeRuntimeDockerfile:
+		return &BuildConfigRuntimeDockerfile{RuntimeConfig: rc, DockerfileName: b.DockerfileName, Context: b.Context}, nil
+	case BuildTypeStaticBuildpack:
+		return &BuildConfigStaticBuildpack{StaticConfig: sc, Context: b.Context}, nil
+	case BuildTypeStaticCmd:
+		return &BuildConfigStaticCmd{StaticConfig: sc, BaseImage: b.BaseImage, BuildCmd: b.BuildCmd}, nil
+	case BuildTypeStaticDockerfile:
+		return &BuildConfigStaticDockerfile{StaticConfig: sc, DockerfileName: b.DockerfileName, Context: b.Context}, nil
+	default:
+		panic("unknown build type")
+	}
+}
+
+func (w *ConfigFileWebsite) website(existing []*Website) (*Website, error) {
+	auth, ok := configFileAuthMapper.Into(lo.CoalesceOrEmpty(w.Authentication, "off"))
+	if !ok {
+		return nil, oops.Errorf("unknown authentication type: %v", w.Authentication)
+	}
+	website := &Website{
+		FQDN:           w.FQDN,
+		PathPrefix:     lo.CoalesceOrEmpty(w.PathPrefix, "/"),
+		StripPrefix:    w.StripPrefix,
+		HTTPS:          w.HTTPS,
+		H2C:            w.H2C,
+		HTTPPort:       lo.CoalesceOrEmpty(w.HTTPPort, 80),
+		Authentication: auth,
+	}
+	for _, r := range w.Rules {
+		rule, err := r.rule()
+		if err != nil {
+			return nil, err
+		}
+		website.Rules = append(website.Rules, rule)
+	}
+	website.HeaderPolicy = w.headerPolicy()
+	website.AccessControl = WebsiteAccessControl{
+		IPAllowList: w.IPAllowList,
+		BasicAuthUsers: ds.Map(w.BasicAuth, func(u *ConfigFileBasicAuthUser) *WebsiteBasicAuthUser {
+			return &WebsiteBasicAuthUser{Username: u.Username, PasswordHash: u.PasswordHash}
+		}),
+	}
+	if rl := w.RateLimit; rl != nil {
+		website.RateLimit = WebsiteRateLimit{
+			Enabled:      true,
+			Average:      rl.Average,
+			Burst:        rl.Burst,
+			SourceHeader: rl.SourceHeader,
+		}
+	}
+	website.Backends = ds.Map(w.Backends, func(b *ConfigFileWebsiteBackend) *WebsiteBackend {
+		return &WebsiteBackend{ApplicationID: b.AppID, Weight: b.Weight}
+	})
+	website.Normalize()
+	// Keep the ID of the same website, so that the routing resources are not re-created
+	if prev, ok := lo.Find(existing, website.Equals); ok {
+		website.ID = prev.ID
+	} else {
+		website.ID = NewID()
+	}
+	if err := website.HashPasswords(existing); err != nil {
+		return nil, err
+	}
+	return website, nil
+}
+
+func (w *ConfigFileWebsite) headerPolicy() WebsiteHeaderPolicy {
+	var policy WebsiteHeaderPolicy
+	for _, name := range slices.Sorted(maps.Keys(w.Headers)) {
+		policy.ResponseHeaders = append(policy.ResponseHeaders, &WebsiteHeader{Name: name, Value: w.Headers[name]})
+	}
+	if w.CORS != nil {
+		policy.CORS = WebsiteCORSPolicy{
+			AllowOrigins:     w.CORS.AllowOrigins,
+			AllowMethods:     w.CORS.AllowMethods,
+			AllowHeaders:     w.CORS.AllowHeaders,
+			ExposeHeaders:    w.CORS.ExposeHeaders,
+			AllowCredentials: w.CORS.AllowCredentials,
+			MaxAge:           w.CORS.MaxAge,
+		}
+	}
+	return policy
+}
+
+func (r *ConfigFileWebsiteRule) rule() (*WebsiteRule, error) {
+	ruleType, ok := configFileWebsiteRuleTypeMapper.Into(r.Type)
+	if !ok {
+		return nil, oops.Errorf("unknown rule type: %v", r.Type)
+	}
+	statusCode := r.StatusCode
+	if ruleType == WebsiteRuleTypeRedirect && statusCode == 0 {
+		statusCode = 302
+	}
+	return &WebsiteRule{
+		Type:          ruleType,
+		PathRegex:     r.Path,
+		Target:        r.Target,
+		StatusCode:    statusCode,
+		PreserveQuery: r.PreserveQuery,
+	}, nil
+}
+
+func (p *ConfigFilePortPublication) portPublication() (*PortPublication, error) {
+	protocol := PortPublicationProtocol(lo.CoalesceOrEmpty(p.Protocol, string(PortPublicationProtocolTCP)))
+	if protocol != PortPublicationProtocolTCP && protocol != PortPublicationProtocolUDP {
+		return nil, oops.Errorf("unknown protocol: %v", p.Protocol)
+	}
+	return &PortPublication{
+		InternetPort:    p.InternetPort,
+		ApplicationPort: p.ApplicationPort,
+		Protocol:        protocol,
+	}, nil
+}
+
+func configFileBuildFrom(bc BuildConfig) ConfigFileBuild {
+	b := ConfigFileBuild{Type: configFileBuildTypeMapper.FromMust(bc.BuildType())}
+	setRuntime := func(rc RuntimeConfig) {
+		b.UseMariaDB = rc.UseMariaDB
+		b.UseMongoDB = rc.UseMongoDB
+		b.Entrypoint = rc.Entrypoint
+		b.Command = rc.Command
+		b.AutoShutdown.Enabled = rc.AutoShutdown.Enabled
+		b.AutoShutdown.Startup = configFileStartupMapper.FromMust(rc.AutoShutdown.Startup)
+	}
+	setStatic := func(sc StaticConfig) {
+		b.ArtifactPath = sc.ArtifactPath
+		b.SPA = sc.SPA
+		b.NotFoundPath = sc.NotFoundPath
+		b.AssetCacheControl = sc.AssetCacheControl
+		b.HTMLCacheControl = sc.HTMLCacheControl
+		b.Precompressed = sc.Precompressed
+	}
+	switch bc := bc.(type) {
+	case *BuildConfigRuntimeBuildpack:
+		setRuntime(bc.RuntimeConfig)
+		b.Context = bc.Context
+	case *BuildConfigRuntimeCmd:
+		setRuntime(bc.RuntimeConfig)
+		b.BaseImage = bc.BaseImage
+		b.BuildCmd = bc.BuildCmd
+	case *BuildConfigRuntimeDockerfile:
+		setRuntime(bc.RuntimeConfig)
+		b.DockerfileName = bc.DockerfileName
+		b.Context = bc.Context
+	case *BuildConfigStaticBuildpack:
+		setStatic(bc.StaticConfig)
+		b.Context = bc.Context
+	case *BuildConfigStaticCmd:
+		setStatic(bc.StaticConfig)
+		b.BaseImage = bc.BaseImage
+		b.BuildCmd = bc.BuildCmd
+	case *BuildConfigStaticDockerfile:
+		setStatic(bc.StaticConfig)
+		b.DockerfileName = bc.DockerfileName
+		b.Context = bc.Context
+	default:
+		panic("unknown build config type")
+	}
+	return b
+}
+
+func configFileWebsiteFrom(w *Website) *ConfigFileWebsite {
+	cw := &ConfigFileWebsite{
+		FQDN:           w.FQDN,
+		PathPrefix:     w.PathPrefix,
+		StripPrefix:    w.StripPrefix,
+		HTTPS:          w.HTTPS,
+		H2C:            w.H2C,
+		HTTPPort:       w.HTTPPort,
+		Authentication: configFileAuthMapper.FromMust(w.Authentication),
+		Rules:          ds.Map(w.Rules, configFileWebsiteRuleFrom),
+		IPAllowList:    w.AccessControl.IPAllowList,
+		// Password hashes are not exported, the current passwords are kept on apply
+		BasicAuth: ds.Map(w.AccessControl.BasicAuthUsers, func(u *WebsiteBasicAuthUser) *ConfigFileBasicAuthUser {
+			return &ConfigFileBasicAuthUser{Username: u.Username}
+		}),
+		Backends: ds.Map(w.Backends, func(b *WebsiteBackend) *ConfigFileWebsiteBackend {
+			return &ConfigFileWebsiteBackend{AppID: b.ApplicationID, Weight: b.Weight}
+		}),
+	}
+	if rl := w.RateLimit; rl.Enabled {
+		cw.RateLimit = &ConfigFileWebsiteRateLimit{
+			Average:      rl.Average,
+			Burst:        rl.Burst,
+			SourceHeader: rl.SourceHeader,
+		}
+	}
+	if len(w.HeaderPolicy.ResponseHeaders) > 0 {
+		cw.Headers = make(map[string]string, len(w.HeaderPolicy.ResponseHeaders))
+		for _, h := range w.HeaderPolicy.ResponseHeaders {
+			cw.Headers[h.Name] = h.Value
+		}
+	}
+	if cors := w.HeaderPolicy.CORS; cors.Enabled() {
+		cw.CORS = &ConfigFileWebsiteCORS{
+			AllowOrigins:     cors.AllowOrigins,
+			AllowMethods:     cors.AllowMethods,
+			AllowHeaders:     cors.AllowHeaders,
+			ExposeHeaders:    cors.ExposeHeaders,
+			AllowCredentials: cors.AllowCredentials,
+			MaxAge:           cors.MaxAge,
+		}
+	}
+	return cw
+}
+
+func configFileWebsiteRuleFrom(r *WebsiteRule) *ConfigFileWebsiteRule {
+	return &ConfigFileWebsiteRule{
+		Type:          configFileWebsiteRuleTypeMapper.FromMust(r.Type),
+		Path:          r.PathRegex,
+		Target:        r.Target,
+		StatusCode:    r.StatusCode,
+		PreserveQuery: r.PreserveQuery,
+	}
+}
+
+func configFilePortPublicationFrom(p *PortPublication) *ConfigFilePortPublication {
+	return &ConfigFilePortPublication{
+		InternetPort:    p.InternetPort,
+		ApplicationPort: p.ApplicationPort,
+		Protocol:        string(p.Protocol),
+	}
+}
+
+// UpdateArgs converts the declared configuration into update args of the application.
+// Websites equal to the ones already present in app keep their IDs.
+func (f *ConfigFile) UpdateArgs(app *Application) (*UpdateApplicationArgs, error) {
+	buildConfig, err := f.Build.buildConfig()
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid build")
+	}
+	websites := make([]*Website, 0, len(f.Websites))
+	for _, w := range f.Websites {
+		website, err := w.website(app.Websites)
+		if err != nil {
+			return nil, oops.Wrapf(err, "invalid website")
+		}
+		websites = append(websites, website)
+	}
+	ports := make([]*PortPublication, 0, len(f.PortPublications))
+	for _, p := range f.PortPublications {
+		port, err := p.portPublication()
+		if err != nil {
+			return nil, oops.Wrapf(err, "invalid port publication")
+		}
+		ports = append(ports, port)
+	}
+	return &UpdateApplicationArgs{
+		Config:           optional.From(ApplicationConfig{BuildConfig: buildConfig}),
+		Websites:         optional.From(websites),
+		PortPublications: optional.From(ports),
+	}, nil
+}
+
+// Envs returns the declared env vars of the application, validated against the current env vars.
+//
+// Env vars not declared in the file are left as they are, since the file is not the only source of env vars.
+func (f *ConfigFile) Envs(appID string, current []*Environment) ([]*Environment, error) {
+	systemKeys := lo.SliceToMap(
+		lo.Filter(current, func(e *Environment, _ int) bool { return e.System }),
+		func(e *Environment) (string, struct{}) { return e.Key, struct{}{} },
+	)
+	keys := lo.Keys(f.Env)
+	slices.Sort(keys)
+	envs := ds.Map(keys, func(key string) *Environment {
+		return &Environment{ApplicationID: appID, Key: key, Value: f.Env[key], System: false}
+	})
+	for _, env := range envs {
+		if err := env.Validate(); err != nil {
+			return nil, oops.Wrapf(err, "invalid env")
+		}
+		if _, ok := systemKeys[env.Key]; ok {
+			return nil, oops.Errorf("env %v is set by the system and cannot be overridden", env.Key)
+		}
+	}
+	return envs, nil
+}
+
+// ValidateUpdate validates app with the declared configuration applied.
+//
+// The configuration file is applied without a user, so the owners of app are used as the actor
+// when checking for website conflicts.
+func (f *ConfigFile) ValidateUpdate(
+	app *Application,
+	args *UpdateApplicationArgs,
+	existingApps []*Application,
+	domains AvailableDomainSlice,
+	ports AvailablePortSlice,
+) error {
+	next := *app
+	next.Apply(args)
+
+	// Validate immutable fields
+	if app.Config.BuildConfig.MariaDB() != next.Config.BuildConfig.MariaDB() {
+		return oops.New("useMariaDB is immutable")
+	}
+	if app.Config.BuildConfig.MongoDB() != next.Config.BuildConfig.MongoDB() {
+		return oops.New("useMongoDB is immutable")
+	}
+
+	actors := ds.Map(app.OwnerIDs, func(id string) *User { return &User{ID: id} })
+	if len(actors) == 0 {
+		actors = []*User{{}}
+	}
+	var err error
+	for _, actor := range actors {
+		err = next.Validate(actor, existingApps, domains, ports)
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
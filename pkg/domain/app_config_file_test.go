@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigFilePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "empty", path: "", wantErr: false},
+		{name: "root", path: "neoshowcase.yaml", wantErr: false},
+		{name: "nested", path: "deploy/neoshowcase.yaml", wantErr: false},
+		{name: "absolute", path: "/neoshowcase.yaml", wantErr: true},
+		{name: "not clean", path: "./neoshowcase.yaml", wantErr: true},
+		{name: "outside repository", path: "../neoshowcase.yaml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfigFilePath(tt.path)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigFile_UpdateArgs(t *testing.T) {
+	existing := &Website{
+		ID:             "website-id",
+		FQDN:           "app.example.com",
+		PathPrefix:     "/",
+		HTTPPort:       8080,
+		Authentication: AuthenticationTypeOff,
+	}
+	app := &Application{ID: "app-id", Websites: []*Website{existing}}
+
+	tests := []struct {
+		name    string
+		content string
+		check   func(t *testing.T, args *UpdateApplicationArgs)
+		wantErr bool
+	}{
+		{
+			name: "runtime dockerfile",
+			content: `
+build:
+  type: runtime_dockerfile
+  dockerfileName: Dockerfile
+  autoShutdown:
+    enabled: true
+    startup: blocking
+websites:
+  - fqdn: app.example.com
+    httpPort: 8080
+  - fqdn: api.example.com
+    pathPrefix: /api
+    authentication: soft
+portPublications:
+  - internetPort: 39000
+    applicationPort: 22
+`,
+			check: func(t *testing.T, args *UpdateApplicationArgs) {
+				require.True(t, args.Config.Valid)
+				bc, ok := args.Config.V.BuildConfig.(*BuildConfigRuntimeDockerfile)
+				require.True(t, ok)
+				assert.Equal(t, "Dockerfile", bc.DockerfileName)
+				assert.Equal(t, StartupBehaviorBlocking, bc.AutoShutdown.Startup)
+
+				require.True(t, args.Websites.Valid)
+				require.Len(t, args.Websites.V, 2)
+				assert.Equal(t, existing.ID, args.Websites.V[0].ID)
+				assert.NotEqual(t, existing.ID, args.Websites.V[1].ID)
+				assert.Equal(t, 80, args.Websites.V[1].HTTPPort)
+				assert.Equal(t, AuthenticationTypeSoft, args.Websites.V[1].Authentication)
+
+				require.True(t, args.PortPublications.Valid)
+				require.Len(t, args.PortPublications.V, 1)
+				assert.Equal(t, PortPublicationProtocolTCP, args.PortPublications.V[0].Protocol)
+			},
+		},
+		{
+			name: "static cmd",
+			content: `
+build:
+  type: static_cmd
+  baseImage: node:22
+  buildCmd: npm run build
+  artifactPath: dist
+  spa: true
+  notFoundPath: /404.html
+  htmlCacheControl: no-cache
+  precompressed: true
+`,
+			check: func(t *testing.T, args *UpdateApplicationArgs) {
+				bc, ok := args.Config.V.BuildConfig.(*BuildConfigStaticCmd)
+				require.True(t, ok)
+				assert.Equal(t, "dist", bc.ArtifactPath)
+				assert.True(t, bc.SPA)
+				assert.Equal(t, "/404.html", bc.NotFoundPath)
+				assert.Equal(t, "no-cache", bc.HTMLCacheControl)
+				assert.Empty(t, bc.AssetCacheControl)
+				assert.True(t, bc.Precompressed)
+				assert.Empty(t, args.Websites.V)
+			},
+		},
+		{
+			name:    "unknown build type",
+			content: "build:\n  type: foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			content: "build:\n  type: static_cmd\n  foo: bar\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown authentication",
+			content: "build:\n  type: static_cmd\nwebsites:\n  - fqdn: app.example.com\n    authentication: foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown protocol",
+			content: "build:\n  type: static_cmd\nportPublications:\n  - internetPort: 39000\n    protocol: sctp\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ParseConfigFile([]byte(tt.content))
+			if err == nil {
+				var args *UpdateApplicationArgs
+				args, err = f.UpdateArgs(app)
+				if err == nil && tt.check != nil {
+					tt.check(t, args)
+				}
+			}
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigFile_Envs(t *testing.T) {
+	current := []*Environment{
+		{ApplicationID: "app-id", Key: "NS_MARIADB_HOST", Value: "mariadb", System: true},
+		{ApplicationID: "app-id", Key: "SECRET", Value: "secret", System: false},
+	}
+
+	t.Run("sorted", func(t *testing.T) {
+		f := &ConfigFile{Env: map[string]string{"B": "2", "A": "1"}}
+		envs, err := f.Envs("app-id", current)
+		require.NoError(t, err)
+		assert.Equal(t, []*Environment{
+			{ApplicationID: "app-id", Key: "A", Value: "1"},
+			{ApplicationID: "app-id", Key: "B", Value: "2"},
+		}, envs)
+	})
+	t.Run("bad key", func(t *testing.T) {
+		f := &ConfigFile{Env: map[string]string{"1A": "1"}}
+		_, err := f.Envs("app-id", current)
+		assert.Error(t, err)
+	})
+	t.Run("overrides system env", func(t *testing.T) {
+		f := &ConfigFile{Env: map[string]string{"NS_MARIADB_HOST": "foo"}}
+		_, err := f.Envs("app-id", current)
+		assert.Error(t, err)
+	})
+}
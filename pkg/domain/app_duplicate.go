@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// DuplicateApplicationArgs is the parameters which must differ from the source application when duplicating.
+type DuplicateApplicationArgs struct {
+	Name    string
+	RefName string
+	// WebsiteFQDNs replaces the FQDN of each website of the source application, in the same order.
+	WebsiteFQDNs []string
+	// InternetPorts replaces the internet port of each port publication of the source application, in the same order.
+	InternetPorts []int
+	StartOnCreate bool
+	// ExcludeSystemEnv excludes the system environment variables (database credentials) of the source application,
+	// leaving the ones provisioned for the new application.
+	// If false, the copy uses the same databases as the source application.
+	ExcludeSystemEnv bool
+}
+
+// Duplicate returns a new application with the same configuration as a,
+// with the name, ref, website FQDNs and internet ports replaced.
+func (a *Application) Duplicate(args *DuplicateApplicationArgs, ownerIDs []string, now time.Time) (*Application, error) {
+	if len(args.WebsiteFQDNs) != len(a.Websites) {
+		return nil, oops.Errorf("%d website FQDNs are required, got %d", len(a.Websites), len(args.WebsiteFQDNs))
+	}
+	if len(args.InternetPorts) != len(a.PortPublications) {
+		return nil, oops.Errorf("%d internet ports are required, got %d", len(a.PortPublications), len(args.InternetPorts))
+	}
+
+	newID := NewID()
+	websites := lo.Map(a.Websites, func(w *Website, i int) *Website {
+		return &Website{
+			ID:             NewID(),
+			FQDN:           args.WebsiteFQDNs[i],
+			PathPrefix:     w.PathPrefix,
+			StripPrefix:    w.StripPrefix,
+			HTTPS:          w.HTTPS,
+			H2C:            w.H2C,
+			HTTPPort:       w.HTTPPort,
+			Authentication: w.Authentication,
+			Rules:          w.Rules,
+			HeaderPolicy:   w.HeaderPolicy,
+			AccessControl:  w.AccessControl,
+			RateLimit:      w.RateLimit,
+			Backends: lo.Map(w.Backends, func(b *WebsiteBackend, _ int) *WebsiteBackend {
+				return &WebsiteBackend{
+					ApplicationID: lo.Ternary(b.ApplicationID == a.ID, newID, b.ApplicationID),
+					Weight:        b.Weight,
+				}
+			}),
+		}
+	})
+	ports := lo.Map(a.PortPublications, func(p *PortPublication, i int) *PortPublication {
+		return &PortPublication{
+			InternetPort:    args.InternetPorts[i],
+			ApplicationPort: p.ApplicationPort,
+			Protocol:        p.Protocol,
+		}
+	})
+
+	return &Application{
+		ID:               newID,
+		Name:             args.Name,
+		RepositoryID:     a.RepositoryID,
+		RefName:          args.RefName,
+		Commit:           EmptyCommit,
+		DeployType:       a.DeployType,
+		Running:          args.StartOnCreate,
+		Container:        ContainerStateMissing,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		ConfigFilePath:   a.ConfigFilePath,
+		Config:           a.Config,
+		Websites:         websites,
+		PortPublications: ports,
+		InternalService:  InternalService{Port: a.InternalService.Port, AllowedAppIDs: slices.Clone(a.InternalService.AllowedAppIDs)},
+		OwnerIDs:         ownerIDs,
+	}, nil
+}
+
+// DuplicateEnvs returns the environment variables of the source application to be set to the duplicated application.
+func DuplicateEnvs(envs []*Environment, appID string, excludeSystem bool) []*Environment {
+	return lo.FilterMap(envs, func(env *Environment, _ int) (*Environment, bool) {
+		if env.System && excludeSystem {
+			return nil, false
+		}
+		return &Environment{
+			ApplicationID: appID,
+			Key:           env.Key,
+			Value:         env.Value,
+			System:        env.System,
+		}, true
+	})
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplication_Duplicate(t *testing.T) {
+	source := &Application{
+		ID:           "source",
+		Name:         "app",
+		RepositoryID: "repo",
+		RefName:      "main",
+		Commit:       "0123456789012345678901234567890123456789",
+		DeployType:   DeployTypeRuntime,
+		Running:      true,
+		Container:    ContainerStateRunning,
+		Config: ApplicationConfig{
+			BuildConfig: &BuildConfigRuntimeBuildpack{RuntimeConfig: RuntimeConfig{UseMariaDB: true}},
+		},
+		Websites: []*Website{
+			{ID: "w1", FQDN: "app.example.com", PathPrefix: "/api", StripPrefix: true, HTTPS: true, HTTPPort: 8080},
+		},
+		PortPublications: []*PortPublication{
+			{InternetPort: 39000, ApplicationPort: 22, Protocol: PortPublicationProtocolTCP},
+		},
+		OwnerIDs: []string{"user1"},
+	}
+	now := time.Now()
+
+	t.Run("ok", func(t *testing.T) {
+		app, err := source.Duplicate(&DuplicateApplicationArgs{
+			Name:          "app-staging",
+			RefName:       "staging",
+			WebsiteFQDNs:  []string{"app-staging.example.com"},
+			InternetPorts: []int{39001},
+		}, []string{"user1", "user2"}, now)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, source.ID, app.ID)
+		assert.Equal(t, "app-staging", app.Name)
+		assert.Equal(t, "repo", app.RepositoryID)
+		assert.Equal(t, "staging", app.RefName)
+		assert.Equal(t, EmptyCommit, app.Commit)
+		assert.False(t, app.Running)
+		assert.Equal(t, ContainerStateMissing, app.Container)
+		assert.Equal(t, source.Config, app.Config)
+		assert.Equal(t, []string{"user1", "user2"}, app.OwnerIDs)
+
+		require.Len(t, app.Websites, 1)
+		assert.NotEqual(t, "w1", app.Websites[0].ID)
+		assert.Equal(t, "app-staging.example.com", app.Websites[0].FQDN)
+		assert.Equal(t, "/api", app.Websites[0].PathPrefix)
+		assert.True(t, app.Websites[0].StripPrefix)
+		assert.Equal(t, 8080, app.Websites[0].HTTPPort)
+		assert.Equal(t, []*PortPublication{
+			{InternetPort: 39001, ApplicationPort: 22, Protocol: PortPublicationProtocolTCP},
+		}, app.PortPublications)
+	})
+	t.Run("website count mismatch", func(t *testing.T) {
+		_, err := source.Duplicate(&DuplicateApplicationArgs{
+			Name:          "app-staging",
+			RefName:       "staging",
+			InternetPorts: []int{39001},
+		}, nil, now)
+		assert.Error(t, err)
+	})
+	t.Run("port count mismatch", func(t *testing.T) {
+		_, err := source.Duplicate(&DuplicateApplicationArgs{
+			Name:         "app-staging",
+			RefName:      "staging",
+			WebsiteFQDNs: []string{"app-staging.example.com"},
+		}, nil, now)
+		assert.Error(t, err)
+	})
+}
+
+func TestDuplicateEnvs(t *testing.T) {
+	envs := []*Environment{
+		{ApplicationID: "source", Key: "FOO", Value: "foo"},
+		{ApplicationID: "source", Key: EnvMariaDBPasswordKey, Value: "secret", System: true},
+	}
+
+	assert.Equal(t, []*Environment{
+		{ApplicationID: "new", Key: "FOO", Value: "foo"},
+		{ApplicationID: "new", Key: EnvMariaDBPasswordKey, Value: "secret", System: true},
+	}, DuplicateEnvs(envs, "new", false))
+	assert.Equal(t, []*Environment{
+		{ApplicationID: "new", Key: "FOO", Value: "foo"},
+	}, DuplicateEnvs(envs, "new", true))
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type ApplicationEventType int
+
+const (
+	// ApplicationEventContainerState indicates that the container state of the application has changed.
+	ApplicationEventContainerState ApplicationEventType = iota
+	// ApplicationEventBuildStarted indicates that a build of the application has started.
+	ApplicationEventBuildStarted
+	// ApplicationEventBuildFinished indicates that a build of the application has finished.
+	ApplicationEventBuildFinished
+	// ApplicationEventDeployed indicates that a new build of the application is being deployed.
+	ApplicationEventDeployed
+	// ApplicationEventConfigChanged indicates that the configuration of the application has changed.
+	ApplicationEventConfigChanged
+	// ApplicationEventStarted indicates that the application has been started.
+	ApplicationEventStarted
+	// ApplicationEventStopped indicates that the application has been stopped.
+	ApplicationEventStopped
+)
+
+// ApplicationEvent is an entry in the timeline of an application.
+type ApplicationEvent struct {
+	ID            string
+	ApplicationID string
+	Type          ApplicationEventType
+	// Reference is the ID of the entity related to the event, e.g. the build ID for build events.
+	Reference string
+	// Message is the human-readable detail of the event.
+	Message string
+	// UserID is the ID of the user who triggered the event, or empty if triggered by the system.
+	UserID    string
+	CreatedAt time.Time
+}
+
+func newApplicationEvent(appID string, typ ApplicationEventType, reference, message, userID string) *ApplicationEvent {
+	return &ApplicationEvent{
+		ID:            NewID(),
+		ApplicationID: appID,
+		Type:          typ,
+		Reference:     reference,
+		Message:       message,
+		UserID:        userID,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// NewContainerStateEvent returns an event if the container state has changed from the application's current state, or nil otherwise.
+func NewContainerStateEvent(app *Application, container *Container) *ApplicationEvent {
+	if app.Container == container.State {
+		return nil
+	}
+	message := app.Container.String() + " -> " + container.State.String()
+	if container.Message != "" {
+		message += ": " + container.Message
+	}
+	return newApplicationEvent(app.ID, ApplicationEventContainerState, "", message, "")
+}
+
+func NewBuildStartedEvent(build *Build) *ApplicationEvent {
+	return newApplicationEvent(build.ApplicationID, ApplicationEventBuildStarted, build.ID, "commit "+build.Commit, "")
+}
+
+func NewBuildFinishedEvent(build *Build) *ApplicationEvent {
+	return newApplicationEvent(build.ApplicationID, ApplicationEventBuildFinished, build.ID, build.Status.String(), "")
+}
+
+func NewDeployedEvent(appID string, buildID string) *ApplicationEvent {
+	return newApplicationEvent(appID, ApplicationEventDeployed, buildID, "", "")
+}
+
+// NewConfigChangedEvent returns an event for the configuration change.
+// userID is empty if the configuration is changed by the system, e.g. from the repository config file.
+func NewConfigChangedEvent(appID string, message string, userID string) *ApplicationEvent {
+	return newApplicationEvent(appID, ApplicationEventConfigChanged, "", message, userID)
+}
+
+// UserChangedFields returns the names of the user-configurable fields set in the args, to be used as the message of ApplicationEventConfigChanged.
+func (args *UpdateApplicationArgs) UserChangedFields() string {
+	var fields []string
+	add := func(name string, valid bool) {
+		if valid {
+			fields = append(fields, name)
+		}
+	}
+	add("name", args.Name.Valid)
+	add("refName", args.RefName.Valid)
+	add("configFilePath", args.ConfigFilePath.Valid)
+	add("config", args.Config.Valid)
+	add("websites", args.Websites.Valid)
+	add("portPublications", args.PortPublications.Valid)
+	add("internalService", args.InternalService.Valid)
+	add("owners", args.OwnerIDs.Valid)
+	return strings.Join(fields, ", ")
+}
+
+func NewStartedEvent(appID string, userID string) *ApplicationEvent {
+	return newApplicationEvent(appID, ApplicationEventStarted, "", "", userID)
+}
+
+// NewStoppedEvent returns an event for stopping the application.
+// userID is empty if the application is stopped by the system.
+func NewStoppedEvent(appID string, message string, userID string) *ApplicationEvent {
+	return newApplicationEvent(appID, ApplicationEventStopped, "", message, userID)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestNewContainerStateEvent(t *testing.T) {
+	app := &Application{ID: "app", Container: ContainerStateRunning}
+
+	t.Run("unchanged", func(t *testing.T) {
+		assert.Nil(t, NewContainerStateEvent(app, &Container{ApplicationID: "app", State: ContainerStateRunning}))
+	})
+	t.Run("changed", func(t *testing.T) {
+		e := NewContainerStateEvent(app, &Container{ApplicationID: "app", State: ContainerStateErrored, Message: "exit code 1"})
+		require.NotNil(t, e)
+		assert.Equal(t, "app", e.ApplicationID)
+		assert.Equal(t, ApplicationEventContainerState, e.Type)
+		assert.Equal(t, "running -> errored: exit code 1", e.Message)
+		assert.Empty(t, e.UserID)
+	})
+}
+
+func TestUpdateApplicationArgs_UserChangedFields(t *testing.T) {
+	args := &UpdateApplicationArgs{
+		Name:      optional.From("app"),
+		Commit:    optional.From(EmptyCommit),
+		UpdatedAt: optional.None[time.Time](),
+		Websites:  optional.From([]*Website{}),
+	}
+	assert.Equal(t, "name, websites", args.UserChangedFields())
+	assert.Equal(t, "", (&UpdateApplicationArgs{}).UserChangedFields())
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// InternalDomain is the domain under which apps are reachable from other apps, as "<app-name>.apps.internal".
+const InternalDomain = "apps.internal"
+
+// maxInternalAppNameLen is the maximum length of app names exposing an internal service,
+// so that the names of the backend resources fit in a DNS label.
+const maxInternalAppNameLen = 48
+
+var internalAppNameRegexp = regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+
+// InternalService is a port of a runtime app exposed privately to other apps, bypassing the public ingress.
+type InternalService struct {
+	// Port is the application port exposed to other apps. Zero means the internal service is disabled.
+	Port int
+	// AllowedAppIDs are the apps allowed to access the internal service, in addition to the apps with the same owners.
+	AllowedAppIDs []string
+}
+
+func (s *InternalService) Enabled() bool {
+	return s.Port != 0
+}
+
+func (s *InternalService) Normalize() {
+	if !s.Enabled() {
+		*s = InternalService{}
+		return
+	}
+	s.AllowedAppIDs = lo.Uniq(s.AllowedAppIDs)
+	slices.Sort(s.AllowedAppIDs)
+}
+
+func (s *InternalService) Validate() error {
+	if !s.Enabled() {
+		return nil
+	}
+	if s.Port < 1 || 65535 < s.Port {
+		return oops.Errorf("invalid port %d (needs to be within 1 to 65535)", s.Port)
+	}
+	for _, id := range s.AllowedAppIDs {
+		if id == "" {
+			return oops.New("allowed app id cannot be empty")
+		}
+	}
+	return nil
+}
+
+// ValidateInternalServiceName checks if the app name can be used as the internal hostname.
+func ValidateInternalServiceName(name string) error {
+	if len(name) > maxInternalAppNameLen {
+		return oops.Errorf("name has to be at most %d characters to expose an internal service", maxInternalAppNameLen)
+	}
+	if !internalAppNameRegexp.MatchString(name) {
+		return oops.Errorf("name %q has to consist of lowercase alphanumerics and hyphens to expose an internal service", name)
+	}
+	return nil
+}
+
+// InternalHostname returns the hostname to reach the internal service of the app.
+func (a *Application) InternalHostname() string {
+	return a.Name + "." + InternalDomain
+}
+
+// AllowsInternalAccessFrom returns true if the client app is allowed to access the internal service of a.
+func (a *Application) AllowsInternalAccessFrom(client *Application) bool {
+	if !a.InternalService.Enabled() || a.ID == client.ID {
+		return false
+	}
+	if lo.Contains(a.InternalService.AllowedAppIDs, client.ID) {
+		return true
+	}
+	return lo.Some(a.OwnerIDs, client.OwnerIDs)
+}
+
+// InternalServiceConflicts returns true if another app exposes an internal service with the same hostname.
+func (a *Application) InternalServiceConflicts(existing []*Application) bool {
+	if !a.InternalService.Enabled() {
+		return false
+	}
+	return lo.ContainsBy(existing, func(app *Application) bool {
+		return app.ID != a.ID && app.InternalService.Enabled() && app.Name == a.Name
+	})
+}
+
+// InternalServiceClients returns the IDs of the apps allowed to access the internal service of a.
+func (a *Application) InternalServiceClients(apps []*Application) []string {
+	return lo.FilterMap(apps, func(client *Application, _ int) (string, bool) {
+		return client.ID, a.AllowsInternalAccessFrom(client)
+	})
+}
+
+// InternalServiceEnvKeyPrefix returns the prefix of the environment variables
+// holding the connection info of the internal service, such as "NS_INTERNAL_MY_BACKEND_".
+func InternalServiceEnvKeyPrefix(appName string) string {
+	return EnvPrefix + "INTERNAL_" + strings.ToUpper(strings.ReplaceAll(appName, "-", "_")) + "_"
+}
+
+// InternalServiceEnvs returns the environment variables holding the connection info of
+// the internal services which the client app is allowed to access.
+func InternalServiceEnvs(client *Application, apps []*Application) map[string]string {
+	envs := make(map[string]string)
+	for _, app := range apps {
+		if !app.AllowsInternalAccessFrom(client) {
+			continue
+		}
+		prefix := InternalServiceEnvKeyPrefix(app.Name)
+		envs[prefix+"HOSTNAME"] = app.InternalHostname()
+		envs[prefix+"PORT"] = strconv.Itoa(app.InternalService.Port)
+	}
+	return envs
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplication_validateInternalService(t *testing.T) {
+	tests := []struct {
+		name    string
+		app     Application
+		wantErr bool
+	}{
+		{"disabled", Application{Name: "My App", DeployType: DeployTypeStatic}, false},
+		{"valid", Application{ID: "a", Name: "backend", InternalService: InternalService{Port: 8080, AllowedAppIDs: []string{"b"}}}, false},
+		{"static", Application{Name: "backend", DeployType: DeployTypeStatic, InternalService: InternalService{Port: 8080}}, true},
+		{"invalid port", Application{Name: "backend", InternalService: InternalService{Port: 65536}}, true},
+		{"uppercase name", Application{Name: "Backend", InternalService: InternalService{Port: 8080}}, true},
+		{"dotted name", Application{Name: "my.backend", InternalService: InternalService{Port: 8080}}, true},
+		{"published port", Application{
+			Name:             "backend",
+			InternalService:  InternalService{Port: 8080},
+			PortPublications: []*PortPublication{{InternetPort: 39000, ApplicationPort: 8080, Protocol: PortPublicationProtocolTCP}},
+		}, true},
+		{"allow self", Application{ID: "a", Name: "backend", InternalService: InternalService{Port: 8080, AllowedAppIDs: []string{"a"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.app.validateInternalService()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInternalServiceEnvs(t *testing.T) {
+	backend := &Application{ID: "backend", Name: "my-backend", OwnerIDs: []string{"alice"}, InternalService: InternalService{Port: 8080, AllowedAppIDs: []string{"other"}}}
+	frontend := &Application{ID: "frontend", Name: "frontend", OwnerIDs: []string{"alice", "bob"}}
+	other := &Application{ID: "other", Name: "other", OwnerIDs: []string{"carol"}}
+	stranger := &Application{ID: "stranger", Name: "stranger", OwnerIDs: []string{"dave"}}
+	apps := []*Application{backend, frontend, other, stranger}
+
+	assert.Equal(t, []string{"frontend", "other"}, backend.InternalServiceClients(apps))
+	assert.Equal(t, map[string]string{
+		"NS_INTERNAL_MY_BACKEND_HOSTNAME": "my-backend.apps.internal",
+		"NS_INTERNAL_MY_BACKEND_PORT":     "8080",
+	}, InternalServiceEnvs(frontend, apps))
+	assert.Empty(t, InternalServiceEnvs(stranger, apps))
+	assert.Empty(t, InternalServiceEnvs(backend, apps))
+
+	conflicting := &Application{ID: "conflicting", Name: "my-backend", InternalService: InternalService{Port: 80}}
+	assert.True(t, conflicting.InternalServiceConflicts(apps))
+	conflicting.InternalService = InternalService{}
+	assert.False(t, conflicting.InternalServiceConflicts(apps))
+}
@@ -3,7 +3,9 @@ package domain
 import (
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/samber/lo"
 	"github.com/samber/oops"
@@ -145,6 +147,14 @@ type Website struct {
 	H2C            bool
 	HTTPPort       int
 	Authentication AuthenticationType
+	// Rules are redirect and rewrite rules, in the order of evaluation.
+	Rules         []*WebsiteRule
+	HeaderPolicy  WebsiteHeaderPolicy
+	AccessControl WebsiteAccessControl
+	RateLimit     WebsiteRateLimit
+	// Backends splits the traffic among the applications by their weights, including the application owning the website.
+	// The owning application serves all traffic if empty.
+	Backends []*WebsiteBackend
 }
 
 func (w *Website) Compare(other *Website) bool {
@@ -174,11 +184,30 @@ func (w *Website) Validate() error {
 	if err = isValidPort(w.HTTPPort); err != nil {
 		return oops.Wrapf(err, "invalid http port")
 	}
+	if err = validateWebsiteRules(w.Rules); err != nil {
+		return err
+	}
+	if err = w.HeaderPolicy.Validate(); err != nil {
+		return oops.Wrapf(err, "invalid header policy")
+	}
+	if err = w.AccessControl.Validate(); err != nil {
+		return oops.Wrapf(err, "invalid access control")
+	}
+	if err = w.RateLimit.Validate(); err != nil {
+		return oops.Wrapf(err, "invalid rate limit")
+	}
+	if err = validateWebsiteBackends(w.Backends); err != nil {
+		return oops.Wrapf(err, "invalid backends")
+	}
 	return nil
 }
 
 func (w *Website) Normalize() {
 	w.FQDN = strings.ToLower(w.FQDN)
+	w.HeaderPolicy.Normalize()
+	w.AccessControl.Normalize()
+	w.RateLimit.Normalize()
+	slices.SortFunc(w.Backends, (*WebsiteBackend).Compare)
 }
 
 func (w *Website) pathComponents() []string {
@@ -231,6 +260,25 @@ func (a *Application) WebsiteConflicts(existing []*Application, actor *User) boo
 				}
 			}
 		}
+		// Sharing the route with other applications has to be a deliberate split
+		if a.splitBackendConflicts(w, existing) {
+			return true
+		}
 	}
 	return false
 }
+
+// validateQuotable returns an error if value cannot be written verbatim in a quoted string of the web server configurations.
+// In a quoted Caddyfile token, a backslash escapes only the following quote or newline and is kept as is otherwise,
+// so a literal backslash cannot precede a quote nor end the value.
+func validateQuotable(value string) error {
+	for i, c := range value {
+		if unicode.IsControl(c) {
+			return oops.New("control characters are not allowed")
+		}
+		if c == '\\' && (i == len(value)-1 || value[i+1] == '"') {
+			return oops.New("backslash cannot precede a quote nor be at the end")
+		}
+	}
+	return nil
+}
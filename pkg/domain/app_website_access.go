@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	maxWebsiteIPAllowList     = 50
+	maxWebsiteBasicAuthUsers  = 20
+	maxBasicAuthUsernameLen   = 64
+	maxBasicAuthPasswordBytes = 72 // limitation of bcrypt
+)
+
+// WebsiteAccessControl restricts the access to a website, in addition to the Website.Authentication.
+// Restrictions are applied in the order of the IP allowlist, basic auth, and then the authentication.
+type WebsiteAccessControl struct {
+	// IPAllowList is the list of CIDRs allowed to access the website. Empty allows all addresses.
+	IPAllowList []string
+	// BasicAuthUsers requires HTTP basic authentication as one of the users, if not empty.
+	BasicAuthUsers []*WebsiteBasicAuthUser
+}
+
+type WebsiteBasicAuthUser struct {
+	Username string
+	// PasswordHash is the bcrypt hash of the password.
+	PasswordHash string
+	// Password is the new plain text password given by the user, which is never saved.
+	// It is replaced with PasswordHash by Website.HashPasswords.
+	Password string
+}
+
+func (c *WebsiteAccessControl) IsEmpty() bool {
+	return len(c.IPAllowList) == 0 && len(c.BasicAuthUsers) == 0
+}
+
+// Normalize converts the IP allowlist entries into the canonical CIDR notation, e.g. "192.168.0.1" into "192.168.0.1/32",
+// and sorts the entries and the users.
+func (c *WebsiteAccessControl) Normalize() {
+	for i, entry := range c.IPAllowList {
+		if prefix, err := parseIPAllowListEntry(entry); err == nil {
+			c.IPAllowList[i] = prefix.String()
+		}
+	}
+	slices.Sort(c.IPAllowList)
+	slices.SortFunc(c.BasicAuthUsers, func(a, b *WebsiteBasicAuthUser) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+}
+
+func parseIPAllowListEntry(entry string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(entry); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+func (c *WebsiteAccessControl) Validate() error {
+	if len(c.IPAllowList) > maxWebsiteIPAllowList {
+		return oops.Errorf("at most %d ip allowlist entries are allowed", maxWebsiteIPAllowList)
+	}
+	for _, entry := range c.IPAllowList {
+		if _, err := parseIPAllowListEntry(entry); err != nil {
+			return oops.Wrapf(err, "invalid ip allowlist entry %q", entry)
+		}
+	}
+	if dup := lo.FindDuplicates(c.IPAllowList); len(dup) > 0 {
+		return oops.Errorf("duplicate ip allowlist entry %v", dup[0])
+	}
+	if len(c.BasicAuthUsers) > maxWebsiteBasicAuthUsers {
+		return oops.Errorf("at most %d basic auth users are allowed", maxWebsiteBasicAuthUsers)
+	}
+	for _, u := range c.BasicAuthUsers {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+	}
+	if dup := lo.FindDuplicatesBy(c.BasicAuthUsers, func(u *WebsiteBasicAuthUser) string { return u.Username }); len(dup) > 0 {
+		return oops.Errorf("duplicate basic auth user %v", dup[0].Username)
+	}
+	return nil
+}
+
+func (u *WebsiteBasicAuthUser) Validate() error {
+	if u.Username == "" || len(u.Username) > maxBasicAuthUsernameLen {
+		return oops.Errorf("basic auth username must be 1 to %d characters", maxBasicAuthUsernameLen)
+	}
+	// Colons and control characters cannot be represented in the htpasswd format
+	if strings.ContainsFunc(u.Username, func(r rune) bool { return r == ':' || r < 0x20 || r == 0x7f }) {
+		return oops.Errorf("invalid basic auth username %q", u.Username)
+	}
+	if u.Password != "" {
+		return oops.Errorf("password of basic auth user %v is not hashed", u.Username)
+	}
+	if u.PasswordHash == "" {
+		return oops.Errorf("password of basic auth user %v is required", u.Username)
+	}
+	if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+		return oops.Wrapf(err, "invalid password hash of basic auth user %v", u.Username)
+	}
+	return nil
+}
+
+// Htpasswd returns the users in the htpasswd format, such as "user:$2a$10$...".
+func (c *WebsiteAccessControl) Htpasswd() []string {
+	return lo.Map(c.BasicAuthUsers, func(u *WebsiteBasicAuthUser, _ int) string {
+		return u.Username + ":" + u.PasswordHash
+	})
+}
+
+// HashPasswords hashes the new passwords of the basic auth users.
+// Users without a new password keep their password of the equal website in existing, if any.
+func (w *Website) HashPasswords(existing []*Website) error {
+	var prevUsers []*WebsiteBasicAuthUser
+	if prev, ok := lo.Find(existing, w.Equals); ok {
+		prevUsers = prev.AccessControl.BasicAuthUsers
+	}
+	for _, u := range w.AccessControl.BasicAuthUsers {
+		if u.Password == "" {
+			if u.PasswordHash != "" {
+				continue
+			}
+			if prevUser, ok := lo.Find(prevUsers, func(p *WebsiteBasicAuthUser) bool { return p.Username == u.Username }); ok {
+				u.PasswordHash = prevUser.PasswordHash
+			}
+			continue
+		}
+		if len(u.Password) > maxBasicAuthPasswordBytes {
+			return oops.Errorf("password of basic auth user %v must be at most %d bytes", u.Username, maxBasicAuthPasswordBytes)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return oops.Wrapf(err, "hashing password")
+		}
+		u.PasswordHash = string(hash)
+		u.Password = ""
+	}
+	return nil
+}
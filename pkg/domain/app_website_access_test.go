@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestWebsiteAccessControl_Validate(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ac      WebsiteAccessControl
+		wantErr bool
+	}{
+		{"empty", WebsiteAccessControl{}, false},
+		{"ip allowlist", WebsiteAccessControl{IPAllowList: []string{"192.168.0.0/16", "2001:db8::/32"}}, false},
+		{"single address", WebsiteAccessControl{IPAllowList: []string{"10.0.0.1"}}, false},
+		{"invalid cidr", WebsiteAccessControl{IPAllowList: []string{"10.0.0.0/33"}}, true},
+		{"hostname", WebsiteAccessControl{IPAllowList: []string{"example.com"}}, true},
+		{"duplicate cidr", WebsiteAccessControl{IPAllowList: []string{"10.0.0.0/8", "10.0.0.0/8"}}, true},
+		{"basic auth", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{{Username: "user", PasswordHash: string(hash)}}}, false},
+		{"not hashed", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{{Username: "user", Password: "password"}}}, true},
+		{"no password", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{{Username: "user"}}}, true},
+		{"invalid hash", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{{Username: "user", PasswordHash: "password"}}}, true},
+		{"empty username", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{{Username: "", PasswordHash: string(hash)}}}, true},
+		{"colon in username", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{{Username: "us:er", PasswordHash: string(hash)}}}, true},
+		{"duplicate user", WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{
+			{Username: "user", PasswordHash: string(hash)},
+			{Username: "user", PasswordHash: string(hash)},
+		}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ac.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebsiteAccessControl_Normalize(t *testing.T) {
+	ac := WebsiteAccessControl{
+		IPAllowList: []string{"192.168.1.1/16", "10.0.0.1", "2001:db8::1"},
+		BasicAuthUsers: []*WebsiteBasicAuthUser{
+			{Username: "bob"},
+			{Username: "alice"},
+		},
+	}
+	ac.Normalize()
+	assert.Equal(t, []string{"10.0.0.1/32", "192.168.0.0/16", "2001:db8::1/128"}, ac.IPAllowList)
+	assert.Equal(t, []*WebsiteBasicAuthUser{{Username: "alice"}, {Username: "bob"}}, ac.BasicAuthUsers)
+}
+
+func TestWebsite_HashPasswords(t *testing.T) {
+	prev := &Website{
+		FQDN:       "test.example.com",
+		PathPrefix: "/",
+		AccessControl: WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{
+			{Username: "alice", PasswordHash: "alice-hash"},
+		}},
+	}
+	w := &Website{
+		FQDN:       "test.example.com",
+		PathPrefix: "/",
+		AccessControl: WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{
+			{Username: "alice"},
+			{Username: "bob", Password: "password"},
+		}},
+	}
+	require.NoError(t, w.HashPasswords([]*Website{prev}))
+
+	users := w.AccessControl.BasicAuthUsers
+	assert.Equal(t, "alice-hash", users[0].PasswordHash)
+	assert.Empty(t, users[1].Password)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(users[1].PasswordHash), []byte("password")))
+	assert.Equal(t, []string{"alice:alice-hash", "bob:" + users[1].PasswordHash}, w.AccessControl.Htpasswd())
+
+	// Passwords are not inherited from a different website
+	other := &Website{
+		FQDN:       "other.example.com",
+		PathPrefix: "/",
+		AccessControl: WebsiteAccessControl{BasicAuthUsers: []*WebsiteBasicAuthUser{
+			{Username: "alice"},
+		}},
+	}
+	require.NoError(t, other.HashPasswords([]*Website{prev}))
+	assert.Empty(t, other.AccessControl.BasicAuthUsers[0].PasswordHash)
+}
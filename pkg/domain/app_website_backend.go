@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// WebsiteBackend is an application serving a share of the traffic to a website.
+type WebsiteBackend struct {
+	ApplicationID string
+	// Weight is the share of the traffic relative to the other backends. Zero sends no traffic to the application.
+	Weight int
+}
+
+func (b *WebsiteBackend) Compare(other *WebsiteBackend) int {
+	return strings.Compare(b.ApplicationID, other.ApplicationID)
+}
+
+func validateWebsiteBackends(backends []*WebsiteBackend) error {
+	if len(backends) == 0 {
+		return nil
+	}
+	totalWeight := 0
+	for _, b := range backends {
+		if b.ApplicationID == "" {
+			return oops.New("application id of backend is required")
+		}
+		if b.Weight < 0 {
+			return oops.Errorf("weight of backend %v has to be non-negative (got %d)", b.ApplicationID, b.Weight)
+		}
+		totalWeight += b.Weight
+	}
+	if totalWeight == 0 {
+		return oops.New("at least one backend needs a positive weight")
+	}
+	if len(lo.UniqBy(backends, func(b *WebsiteBackend) string { return b.ApplicationID })) != len(backends) {
+		return oops.New("duplicate backend application")
+	}
+	return nil
+}
+
+// IsSplit returns true if the traffic to the website is split among multiple applications.
+func (w *Website) IsSplit() bool {
+	return len(w.Backends) > 0
+}
+
+// validateSplit checks the backends of the website owned by the application.
+func (a *Application) validateSplit(w *Website) error {
+	if !w.IsSplit() {
+		return nil
+	}
+	if a.DeployType != DeployTypeRuntime {
+		return oops.New("traffic splitting is only available for runtime applications")
+	}
+	if !lo.ContainsBy(w.Backends, func(b *WebsiteBackend) bool { return b.ApplicationID == a.ID }) {
+		return oops.New("backends have to contain the application itself")
+	}
+	return nil
+}
+
+// hasSameOwners returns true if both applications have the same set of owners.
+func (a *Application) hasSameOwners(other *Application) bool {
+	return lo.ElementsMatch(a.OwnerIDs, other.OwnerIDs)
+}
+
+// ServesPortOf returns true if the application has a website on the same HTTP port and protocol as w,
+// so that the traffic to w can be sent to the application as is.
+func (a *Application) ServesPortOf(w *Website) bool {
+	return lo.ContainsBy(a.Websites, func(w2 *Website) bool { return w2.HTTPPort == w.HTTPPort && w2.H2C == w.H2C })
+}
+
+// splitBackendConflicts returns true if the website splits its traffic to an application not eligible as the backend.
+// Traffic can only be split to the existing runtime applications with the same owners,
+// which serve the same port as the website.
+func (a *Application) splitBackendConflicts(w *Website, existing []*Application) bool {
+	for _, b := range w.Backends {
+		if b.ApplicationID == a.ID {
+			continue
+		}
+		backendApp, ok := lo.Find(existing, func(app *Application) bool { return app.ID == b.ApplicationID })
+		if !ok {
+			return true
+		}
+		if backendApp.DeployType != DeployTypeRuntime || !a.hasSameOwners(backendApp) {
+			return true
+		}
+		if !backendApp.ServesPortOf(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveBackends returns the backends which the traffic is actually sent to,
+// excluding the ones without weight and the ones not eligible, e.g. not running or no longer serving the port of the website.
+// If none is left, the owning application serves all traffic as if the website were not split.
+func (w *Website) ActiveBackends(isEligible func(appID string) bool) []*WebsiteBackend {
+	return lo.Filter(w.Backends, func(b *WebsiteBackend, _ int) bool {
+		return b.Weight > 0 && isEligible(b.ApplicationID)
+	})
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWebsiteBackends(t *testing.T) {
+	tests := []struct {
+		name     string
+		backends []*WebsiteBackend
+		wantErr  bool
+	}{
+		{"empty", nil, false},
+		{"split", []*WebsiteBackend{{ApplicationID: "a", Weight: 90}, {ApplicationID: "b", Weight: 10}}, false},
+		{"zero weight", []*WebsiteBackend{{ApplicationID: "a", Weight: 0}, {ApplicationID: "b", Weight: 1}}, false},
+		{"negative weight", []*WebsiteBackend{{ApplicationID: "a", Weight: -1}, {ApplicationID: "b", Weight: 1}}, true},
+		{"no weight", []*WebsiteBackend{{ApplicationID: "a", Weight: 0}, {ApplicationID: "b", Weight: 0}}, true},
+		{"duplicate", []*WebsiteBackend{{ApplicationID: "a", Weight: 1}, {ApplicationID: "a", Weight: 1}}, true},
+		{"empty id", []*WebsiteBackend{{ApplicationID: "", Weight: 1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWebsiteBackends(tt.backends)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplication_WebsiteConflicts_Split(t *testing.T) {
+	u1 := &User{ID: "user1"}
+	canary := &Application{ID: "canary", OwnerIDs: []string{"user1", "user2"}, Websites: []*Website{
+		{ID: NewID(), FQDN: "canary.trap.games", PathPrefix: "/", HTTPPort: 8080},
+	}}
+	otherPort := &Application{ID: "other-port", OwnerIDs: []string{"user1", "user2"}, Websites: []*Website{
+		{ID: NewID(), FQDN: "other-port.trap.games", PathPrefix: "/", HTTPPort: 3000},
+	}}
+	h2c := &Application{ID: "h2c", OwnerIDs: []string{"user1", "user2"}, Websites: []*Website{
+		{ID: NewID(), FQDN: "h2c.trap.games", PathPrefix: "/", HTTPPort: 8080, H2C: true},
+	}}
+	otherOwners := &Application{ID: "other", OwnerIDs: []string{"user1"}}
+	static := &Application{ID: "static", DeployType: DeployTypeStatic, OwnerIDs: []string{"user1", "user2"}}
+	existing := []*Application{canary, otherPort, h2c, otherOwners, static}
+
+	split := func(backendAppID string) *Application {
+		return &Application{
+			ID:       "target",
+			OwnerIDs: []string{"user2", "user1"},
+			Websites: []*Website{{
+				ID:         NewID(),
+				FQDN:       "foo.trap.games",
+				PathPrefix: "/",
+				HTTPPort:   8080,
+				Backends: []*WebsiteBackend{
+					{ApplicationID: "target", Weight: 90},
+					{ApplicationID: backendAppID, Weight: 10},
+				},
+			}},
+		}
+	}
+
+	assert.False(t, split("canary").WebsiteConflicts(existing, u1))
+	assert.True(t, split("other").WebsiteConflicts(existing, u1), "different owners")
+	assert.True(t, split("static").WebsiteConflicts(existing, u1), "static app")
+	assert.True(t, split("unknown").WebsiteConflicts(existing, u1), "non-existent app")
+	assert.True(t, split("other-port").WebsiteConflicts(existing, u1), "different port")
+	assert.True(t, split("h2c").WebsiteConflicts(existing, u1), "different protocol")
+}
+
+func TestWebsite_ActiveBackends(t *testing.T) {
+	w := &Website{Backends: []*WebsiteBackend{
+		{ApplicationID: "a", Weight: 90},
+		{ApplicationID: "b", Weight: 10},
+		{ApplicationID: "c", Weight: 0},
+	}}
+	assert.Equal(t, w.Backends[:2], w.ActiveBackends(func(string) bool { return true }))
+	assert.Equal(t, w.Backends[:1], w.ActiveBackends(func(appID string) bool { return appID != "b" }))
+}
+
+func TestApplication_validateSplit(t *testing.T) {
+	w := &Website{Backends: []*WebsiteBackend{{ApplicationID: "a", Weight: 1}, {ApplicationID: "b", Weight: 1}}}
+	assert.NoError(t, (&Application{ID: "a"}).validateSplit(w))
+	assert.Error(t, (&Application{ID: "c"}).validateSplit(w), "app itself not contained")
+	assert.Error(t, (&Application{ID: "a", DeployType: DeployTypeStatic}).validateSplit(w), "static app")
+}
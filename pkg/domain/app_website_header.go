@@ -0,0 +1,260 @@
+package domain
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"golang.org/x/net/http/httpguts"
+)
+
+const (
+	maxWebsiteHeaders        = 30
+	maxWebsiteHeaderNameLen  = 100
+	maxWebsiteHeaderValueLen = 1000
+	maxWebsiteCORSOrigins    = 20
+	maxWebsiteCORSMaxAge     = 86400
+)
+
+// hopByHopHeaders are headers meaningful only for a single connection, which must not be set by users.
+var hopByHopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// reservedHeaders are headers managed by the proxy or NeoShowcase itself.
+var reservedHeaders = []string{
+	"Content-Length",
+	// Authenticated user passed to applications by the forward auth
+	"X-Showcase-User",
+}
+
+// reservedHeaderPrefixes are prefixes of headers managed by NeoShowcase,
+// e.g. web.HeaderNameSSGenAppID to route requests in the static server.
+var reservedHeaderPrefixes = []string{
+	"X-Controller-",
+	"X-Ns-",
+	"X-Forwarded-",
+}
+
+// WebsiteHeaderPolicy is the custom response headers and the CORS policy of a website.
+type WebsiteHeaderPolicy struct {
+	// ResponseHeaders are added to all responses, ordered by the name.
+	ResponseHeaders []*WebsiteHeader
+	CORS            WebsiteCORSPolicy
+}
+
+type WebsiteHeader struct {
+	Name  string
+	Value string
+}
+
+// WebsiteCORSPolicy is the CORS policy of a website. CORS is disabled when AllowOrigins is empty.
+type WebsiteCORSPolicy struct {
+	// AllowOrigins are the allowed origins such as "https://example.com", or "*" to allow any origin.
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	AllowCredentials bool
+	// MaxAge is how long the preflight response can be cached, in seconds.
+	MaxAge int
+}
+
+func (p *WebsiteHeaderPolicy) IsEmpty() bool {
+	return len(p.ResponseHeaders) == 0 && !p.CORS.Enabled()
+}
+
+// Normalize canonicalizes the header names, and sorts the headers by the name.
+func (p *WebsiteHeaderPolicy) Normalize() {
+	for _, h := range p.ResponseHeaders {
+		h.Name = http.CanonicalHeaderKey(h.Name)
+	}
+	slices.SortFunc(p.ResponseHeaders, func(a, b *WebsiteHeader) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func (p *WebsiteHeaderPolicy) Validate() error {
+	if len(p.ResponseHeaders) > maxWebsiteHeaders {
+		return oops.Errorf("at most %d headers are allowed", maxWebsiteHeaders)
+	}
+	names := make(map[string]struct{}, len(p.ResponseHeaders))
+	for _, h := range p.ResponseHeaders {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+		name := http.CanonicalHeaderKey(h.Name)
+		if _, ok := names[name]; ok {
+			return oops.Errorf("duplicate header %v", name)
+		}
+		names[name] = struct{}{}
+	}
+	if err := p.CORS.Validate(); err != nil {
+		return oops.Wrapf(err, "invalid cors policy")
+	}
+	return nil
+}
+
+// ResponseHeaderMap returns the custom response headers keyed by the name.
+func (p *WebsiteHeaderPolicy) ResponseHeaderMap() map[string]string {
+	headers := make(map[string]string, len(p.ResponseHeaders))
+	for _, h := range p.ResponseHeaders {
+		headers[h.Name] = h.Value
+	}
+	return headers
+}
+
+// isReservedHeader returns true if the header must not be overridden by users.
+func isReservedHeader(name string) bool {
+	name = http.CanonicalHeaderKey(name)
+	return slices.Contains(hopByHopHeaders, name) ||
+		slices.Contains(reservedHeaders, name) ||
+		lo.ContainsBy(reservedHeaderPrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) })
+}
+
+func (h *WebsiteHeader) Validate() error {
+	if !httpguts.ValidHeaderFieldName(h.Name) || len(h.Name) > maxWebsiteHeaderNameLen {
+		return oops.Errorf("invalid header name %q", h.Name)
+	}
+	if isReservedHeader(h.Name) {
+		return oops.Errorf("header %v cannot be set", h.Name)
+	}
+	if strings.HasPrefix(http.CanonicalHeaderKey(h.Name), "Access-Control-") {
+		return oops.Errorf("header %v cannot be set, use cors policy instead", h.Name)
+	}
+	if len(h.Value) > maxWebsiteHeaderValueLen {
+		return oops.Errorf("value of header %v must be at most %d characters", h.Name, maxWebsiteHeaderValueLen)
+	}
+	if !httpguts.ValidHeaderFieldValue(h.Value) {
+		return oops.Errorf("invalid value of header %v", h.Name)
+	}
+	if err := validateQuotable(h.Value); err != nil {
+		return oops.Wrapf(err, "invalid value of header %v", h.Name)
+	}
+	return nil
+}
+
+func (c *WebsiteCORSPolicy) Enabled() bool {
+	return len(c.AllowOrigins) > 0
+}
+
+func (c *WebsiteCORSPolicy) AllowsAnyOrigin() bool {
+	return slices.Contains(c.AllowOrigins, "*")
+}
+
+func (c *WebsiteCORSPolicy) Validate() error {
+	if !c.Enabled() {
+		if len(c.AllowMethods) > 0 || len(c.AllowHeaders) > 0 || len(c.ExposeHeaders) > 0 || c.AllowCredentials || c.MaxAge != 0 {
+			return oops.New("allow origins is required")
+		}
+		return nil
+	}
+	if len(c.AllowOrigins) > maxWebsiteCORSOrigins {
+		return oops.Errorf("at most %d origins are allowed", maxWebsiteCORSOrigins)
+	}
+	if c.AllowsAnyOrigin() {
+		if len(c.AllowOrigins) > 1 {
+			return oops.New("wildcard origin cannot be combined with other origins")
+		}
+		if c.AllowCredentials {
+			return oops.New("wildcard origin cannot be used with allow credentials")
+		}
+	} else {
+		for _, origin := range c.AllowOrigins {
+			if err := validateOrigin(origin); err != nil {
+				return err
+			}
+		}
+	}
+	for _, method := range c.AllowMethods {
+		if !httpguts.ValidHeaderFieldName(method) || method != strings.ToUpper(method) {
+			return oops.Errorf("invalid method %q", method)
+		}
+	}
+	for _, name := range append(slices.Clone(c.AllowHeaders), c.ExposeHeaders...) {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return oops.Errorf("invalid header name %q", name)
+		}
+	}
+	if c.MaxAge < 0 || c.MaxAge > maxWebsiteCORSMaxAge {
+		return oops.Errorf("max age must be between 0 and %d", maxWebsiteCORSMaxAge)
+	}
+	return nil
+}
+
+func validateOrigin(origin string) error {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return oops.Wrapf(err, "invalid origin %q", origin)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return oops.Errorf("origin %q has to be an http(s) origin", origin)
+	}
+	if u.String() != u.Scheme+"://"+u.Host || u.User != nil {
+		return oops.Errorf("origin %q must not have a path, query or user info", origin)
+	}
+	if origin != strings.ToLower(origin) {
+		return oops.Errorf("origin %q must be lower case", origin)
+	}
+	return nil
+}
+
+// AllowsOrigin returns true if the request from the origin is allowed.
+func (c *WebsiteCORSPolicy) AllowsOrigin(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	return c.AllowsAnyOrigin() || slices.Contains(c.AllowOrigins, origin)
+}
+
+// ResponseHeaders returns the CORS headers for the response to the allowed origin.
+// Note that "Vary: Origin" has to be set to all responses regardless of the origin, unless any origin is allowed.
+func (c *WebsiteCORSPolicy) ResponseHeaders(origin string) http.Header {
+	h := make(http.Header)
+	if c.AllowsAnyOrigin() {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+	if c.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if len(c.ExposeHeaders) > 0 {
+		h.Set("Access-Control-Expose-Headers", strings.Join(c.ExposeHeaders, ", "))
+	}
+	return h
+}
+
+// PreflightHeaders returns the additional CORS headers for the response to the preflight request.
+func (c *WebsiteCORSPolicy) PreflightHeaders() http.Header {
+	h := make(http.Header)
+	if len(c.AllowMethods) > 0 {
+		h.Set("Access-Control-Allow-Methods", strings.Join(c.AllowMethods, ", "))
+	}
+	if len(c.AllowHeaders) > 0 {
+		h.Set("Access-Control-Allow-Headers", strings.Join(c.AllowHeaders, ", "))
+	}
+	if c.MaxAge > 0 {
+		h.Set("Access-Control-Max-Age", strconv.Itoa(c.MaxAge))
+	}
+	return h
+}
+
+// IsPreflightRequest returns true if the request is a CORS preflight request.
+func IsPreflightRequest(r *http.Request) bool {
+	return r.Method == http.MethodOptions &&
+		r.Header.Get("Origin") != "" &&
+		r.Header.Get("Access-Control-Request-Method") != ""
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsiteHeaderPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  WebsiteHeaderPolicy
+		wantErr bool
+	}{
+		{"empty", WebsiteHeaderPolicy{}, false},
+		{"headers", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{
+			{Name: "Content-Security-Policy", Value: "default-src 'self'"},
+			{Name: "Strict-Transport-Security", Value: "max-age=63072000"},
+		}}, false},
+		{"duplicate header", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{
+			{Name: "Cache-Control", Value: "no-cache"},
+			{Name: "cache-control", Value: "no-store"},
+		}}, true},
+		{"hop-by-hop header", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "connection", Value: "close"}}}, true},
+		{"internal header", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "X-Controller-App-Id", Value: "foo"}}}, true},
+		{"cors header", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "Access-Control-Allow-Origin", Value: "*"}}}, true},
+		{"invalid name", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "X Foo", Value: "bar"}}}, true},
+		{"invalid value", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "X-Foo", Value: "bar\r\nX-Bar: baz"}}}, true},
+		{"quoted value", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "X-Foo", Value: `say "hi" \o/`}}}, false},
+		{"backslash before quote", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "X-Foo", Value: `bar\" baz`}}}, true},
+		{"backslash at the end", WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{{Name: "X-Foo", Value: `bar\`}}}, true},
+		{"cors", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{
+			AllowOrigins:     []string{"https://example.com", "http://localhost:3000"},
+			AllowMethods:     []string{"GET", "POST"},
+			AllowHeaders:     []string{"Content-Type"},
+			AllowCredentials: true,
+			MaxAge:           600,
+		}}, false},
+		{"cors wildcard", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"*"}}}, false},
+		{"cors wildcard with credentials", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"*"}, AllowCredentials: true}}, true},
+		{"cors wildcard with others", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"*", "https://example.com"}}}, true},
+		{"cors origin with path", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"https://example.com/"}}}, true},
+		{"cors upper case origin", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"https://Example.com"}}}, true},
+		{"cors lower case method", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"*"}, AllowMethods: []string{"get"}}}, true},
+		{"cors without origins", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowMethods: []string{"GET"}}}, true},
+		{"cors negative max age", WebsiteHeaderPolicy{CORS: WebsiteCORSPolicy{AllowOrigins: []string{"*"}, MaxAge: -1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebsiteHeaderPolicy_Normalize(t *testing.T) {
+	policy := WebsiteHeaderPolicy{ResponseHeaders: []*WebsiteHeader{
+		{Name: "x-frame-options", Value: "DENY"},
+		{Name: "cache-control", Value: "no-cache"},
+	}}
+	policy.Normalize()
+	assert.Equal(t, []*WebsiteHeader{
+		{Name: "Cache-Control", Value: "no-cache"},
+		{Name: "X-Frame-Options", Value: "DENY"},
+	}, policy.ResponseHeaders)
+}
+
+func TestWebsiteCORSPolicy_Headers(t *testing.T) {
+	cors := WebsiteCORSPolicy{
+		AllowOrigins:     []string{"https://example.com"},
+		AllowMethods:     []string{"GET", "PUT"},
+		ExposeHeaders:    []string{"X-Total-Count"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	assert.True(t, cors.AllowsOrigin("https://example.com"))
+	assert.False(t, cors.AllowsOrigin("https://evil.example.com"))
+	assert.False(t, cors.AllowsOrigin(""))
+
+	assert.Equal(t, http.Header{
+		"Access-Control-Allow-Origin":      {"https://example.com"},
+		"Access-Control-Allow-Credentials": {"true"},
+		"Access-Control-Expose-Headers":    {"X-Total-Count"},
+	}, cors.ResponseHeaders("https://example.com"))
+	assert.Equal(t, http.Header{
+		"Access-Control-Allow-Methods": {"GET, PUT"},
+		"Access-Control-Max-Age":       {"600"},
+	}, cors.PreflightHeaders())
+
+	wildcard := WebsiteCORSPolicy{AllowOrigins: []string{"*"}}
+	assert.True(t, wildcard.AllowsOrigin("https://example.com"))
+	assert.Equal(t, "*", wildcard.ResponseHeaders("https://example.com").Get("Access-Control-Allow-Origin"))
+}
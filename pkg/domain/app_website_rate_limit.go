@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"net/http"
+
+	"github.com/samber/oops"
+	"golang.org/x/net/http/httpguts"
+)
+
+// WebsiteRateLimit limits the rate of requests to a website with a token bucket per client.
+type WebsiteRateLimit struct {
+	Enabled bool
+	// Average is the number of requests allowed per second on average.
+	// Zero is replaced with the default by WebsiteRateLimitConfig.Apply.
+	Average int
+	// Burst is the maximum number of requests allowed at once.
+	// Zero is replaced with the default by WebsiteRateLimitConfig.Apply.
+	Burst int
+	// SourceHeader is the name of the request header to tell clients apart, such as "X-Api-Key".
+	// Clients are told apart by their IP addresses if empty.
+	SourceHeader string
+}
+
+func (r *WebsiteRateLimit) Normalize() {
+	if !r.Enabled {
+		*r = WebsiteRateLimit{}
+		return
+	}
+	if r.SourceHeader != "" {
+		r.SourceHeader = http.CanonicalHeaderKey(r.SourceHeader)
+	}
+}
+
+func (r *WebsiteRateLimit) Validate() error {
+	if !r.Enabled {
+		return nil
+	}
+	if r.Average <= 0 {
+		return oops.Errorf("average has to be positive (got %d)", r.Average)
+	}
+	if r.Burst <= 0 {
+		return oops.Errorf("burst has to be positive (got %d)", r.Burst)
+	}
+	if r.SourceHeader != "" {
+		if !httpguts.ValidHeaderFieldName(r.SourceHeader) || len(r.SourceHeader) > maxWebsiteHeaderNameLen {
+			return oops.Errorf("invalid source header name %q", r.SourceHeader)
+		}
+		if isReservedHeader(r.SourceHeader) {
+			return oops.Errorf("header %v cannot be used as source", r.SourceHeader)
+		}
+	}
+	return nil
+}
+
+// WebsiteRateLimitConfig is the rate limit settings by admins, which bounds the rate limits of all websites.
+type WebsiteRateLimitConfig struct {
+	// DefaultAverage and DefaultBurst are used when a website enables the rate limit without specifying them.
+	DefaultAverage int `mapstructure:"defaultAverage" yaml:"defaultAverage"`
+	DefaultBurst   int `mapstructure:"defaultBurst" yaml:"defaultBurst"`
+	// MaxAverage and MaxBurst are the upper bounds of the rate limits. Negative values mean unlimited.
+	MaxAverage int `mapstructure:"maxAverage" yaml:"maxAverage"`
+	MaxBurst   int `mapstructure:"maxBurst" yaml:"maxBurst"`
+}
+
+// Apply fills in the default values to the rate limit, and checks the rate limit against the upper bounds.
+func (c *WebsiteRateLimitConfig) Apply(r *WebsiteRateLimit) error {
+	if !r.Enabled {
+		return nil
+	}
+	if r.Average == 0 {
+		r.Average = c.DefaultAverage
+	}
+	if r.Burst == 0 {
+		r.Burst = c.DefaultBurst
+	}
+	if c.MaxAverage >= 0 && r.Average > c.MaxAverage {
+		return oops.Errorf("average has to be at most %d (got %d)", c.MaxAverage, r.Average)
+	}
+	if c.MaxBurst >= 0 && r.Burst > c.MaxBurst {
+		return oops.Errorf("burst has to be at most %d (got %d)", c.MaxBurst, r.Burst)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsiteRateLimit_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rl      WebsiteRateLimit
+		wantErr bool
+	}{
+		{"disabled", WebsiteRateLimit{}, false},
+		{"ip", WebsiteRateLimit{Enabled: true, Average: 10, Burst: 20}, false},
+		{"header", WebsiteRateLimit{Enabled: true, Average: 10, Burst: 20, SourceHeader: "X-Api-Key"}, false},
+		{"zero average", WebsiteRateLimit{Enabled: true, Burst: 20}, true},
+		{"zero burst", WebsiteRateLimit{Enabled: true, Average: 10}, true},
+		{"negative average", WebsiteRateLimit{Enabled: true, Average: -1, Burst: 20}, true},
+		{"invalid header", WebsiteRateLimit{Enabled: true, Average: 10, Burst: 20, SourceHeader: "X Api Key"}, true},
+		{"internal header", WebsiteRateLimit{Enabled: true, Average: 10, Burst: 20, SourceHeader: "X-Controller-App-Id"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rl.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebsiteRateLimit_Normalize(t *testing.T) {
+	rl := WebsiteRateLimit{Enabled: true, Average: 10, Burst: 20, SourceHeader: "x-api-key"}
+	rl.Normalize()
+	assert.Equal(t, "X-Api-Key", rl.SourceHeader)
+
+	disabled := WebsiteRateLimit{Average: 10, Burst: 20}
+	disabled.Normalize()
+	assert.Equal(t, WebsiteRateLimit{}, disabled)
+}
+
+func TestWebsiteRateLimitConfig_Apply(t *testing.T) {
+	c := WebsiteRateLimitConfig{DefaultAverage: 100, DefaultBurst: 200, MaxAverage: 1000, MaxBurst: -1}
+
+	rl := WebsiteRateLimit{Enabled: true}
+	require.NoError(t, c.Apply(&rl))
+	assert.Equal(t, WebsiteRateLimit{Enabled: true, Average: 100, Burst: 200}, rl)
+
+	rl = WebsiteRateLimit{Enabled: true, Average: 10, Burst: 5000}
+	require.NoError(t, c.Apply(&rl))
+	assert.Equal(t, WebsiteRateLimit{Enabled: true, Average: 10, Burst: 5000}, rl)
+
+	rl = WebsiteRateLimit{Enabled: true, Average: 1001}
+	assert.Error(t, c.Apply(&rl))
+
+	disabled := WebsiteRateLimit{}
+	require.NoError(t, c.Apply(&disabled))
+	assert.Equal(t, WebsiteRateLimit{}, disabled)
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+type WebsiteRuleType int
+
+const (
+	// WebsiteRuleTypeRedirect responds with a redirect to the target.
+	WebsiteRuleTypeRedirect WebsiteRuleType = iota
+	// WebsiteRuleTypeRewrite replaces the request path with the target, and continues to the next rule.
+	WebsiteRuleTypeRewrite
+)
+
+const (
+	maxWebsiteRules         = 20
+	maxWebsiteRuleRegexLen  = 1000
+	maxWebsiteRuleTargetLen = 1000
+)
+
+// WebsiteRule is a redirect or rewrite rule of a website.
+// The host is implied by Website.FQDN, so only the path is matched.
+// Rules are evaluated in the declared order, after the path prefix is stripped if Website.StripPrefix is set.
+type WebsiteRule struct {
+	Type WebsiteRuleType
+	// PathRegex is a regular expression (RE2 syntax) matched against the whole escaped request path.
+	PathRegex string
+	// Target is the replacement, which can reference capture groups of PathRegex as ${1}.
+	// For redirects, it is either an absolute http(s) URL or a path on the website's host.
+	// For rewrites, it is the new path.
+	Target string
+	// StatusCode is the status code of the redirect, one of 301, 302, 307 or 308.
+	// Traefik chooses between 301 and 308 (or 302 and 307) by the request method.
+	StatusCode int
+	// PreserveQuery appends the query string of the request to the redirect target.
+	PreserveQuery bool
+}
+
+func (r *WebsiteRule) Validate() error {
+	if r.PathRegex == "" {
+		return oops.New("path regex is required")
+	}
+	if len(r.PathRegex) > maxWebsiteRuleRegexLen {
+		return oops.Errorf("path regex must be at most %d characters", maxWebsiteRuleRegexLen)
+	}
+	if _, err := regexp.Compile(r.PathRegex); err != nil {
+		return oops.Wrapf(err, "invalid path regex")
+	}
+	if err := validateQuotable(r.AnchoredPathRegex()); err != nil {
+		return oops.Wrapf(err, "invalid path regex")
+	}
+	if len(r.Target) > maxWebsiteRuleTargetLen {
+		return oops.Errorf("target must be at most %d characters", maxWebsiteRuleTargetLen)
+	}
+	if err := validateQuotable(r.Target); err != nil {
+		return oops.Wrapf(err, "invalid target")
+	}
+
+	switch r.Type {
+	case WebsiteRuleTypeRedirect:
+		switch r.StatusCode {
+		case 301, 302, 307, 308:
+		default:
+			return oops.Errorf("invalid redirect status code: %d", r.StatusCode)
+		}
+		if !strings.HasPrefix(r.Target, "/") {
+			u, err := url.Parse(r.Target)
+			if err != nil {
+				return oops.Wrapf(err, "invalid redirect target")
+			}
+			if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+				return oops.New("redirect target has to be a path or an absolute http(s) URL")
+			}
+		}
+		if r.PreserveQuery && strings.Contains(r.Target, "?") {
+			return oops.New("redirect target must not have a query when preserving the query")
+		}
+	case WebsiteRuleTypeRewrite:
+		if !strings.HasPrefix(r.Target, "/") {
+			return oops.New("rewrite target has to start with /")
+		}
+		if strings.Contains(r.Target, "?") {
+			return oops.New("rewrite target must not have a query")
+		}
+		if r.StatusCode != 0 || r.PreserveQuery {
+			return oops.New("status code and preserve query are only available for redirects")
+		}
+	default:
+		return oops.Errorf("unknown rule type: %v", r.Type)
+	}
+	return nil
+}
+
+func validateWebsiteRules(rules []*WebsiteRule) error {
+	if len(rules) > maxWebsiteRules {
+		return oops.Errorf("at most %d rules are allowed", maxWebsiteRules)
+	}
+	for i, r := range rules {
+		if err := r.Validate(); err != nil {
+			return oops.Wrapf(err, "invalid rule %d", i)
+		}
+	}
+	return nil
+}
+
+// pathRegexp returns PathRegex anchored to match the whole path.
+func (r *WebsiteRule) pathRegexp() *regexp.Regexp {
+	return regexp.MustCompile("^(?:" + r.PathRegex + ")$")
+}
+
+// absoluteTarget returns the target with the scheme and host of the website, if the target is a path.
+func (r *WebsiteRule) absoluteTarget(w *Website) string {
+	if !strings.HasPrefix(r.Target, "/") {
+		return r.Target
+	}
+	scheme := "http"
+	if w.HTTPS {
+		scheme = "https"
+	}
+	return scheme + "://" + w.FQDN + r.Target
+}
+
+// Permanent returns true if the redirect is permanent.
+func (r *WebsiteRule) Permanent() bool {
+	return r.StatusCode == 301 || r.StatusCode == 308
+}
+
+// WebsiteRuleMatcher applies a rule of a website with the compiled regex.
+type WebsiteRuleMatcher struct {
+	rule    *WebsiteRule
+	website *Website
+	re      *regexp.Regexp
+}
+
+// Matcher compiles the rule of the website, which has to be validated beforehand.
+func (r *WebsiteRule) Matcher(w *Website) *WebsiteRuleMatcher {
+	return &WebsiteRuleMatcher{rule: r, website: w, re: r.pathRegexp()}
+}
+
+func (m *WebsiteRuleMatcher) Rule() *WebsiteRule {
+	return m.rule
+}
+
+// Apply applies the rule to the escaped path and the raw query of a request to the website.
+// For redirects, it returns the location to redirect to. For rewrites, it returns the new escaped path.
+func (m *WebsiteRuleMatcher) Apply(escapedPath string, rawQuery string) (result string, ok bool) {
+	if !m.re.MatchString(escapedPath) {
+		return "", false
+	}
+	switch m.rule.Type {
+	case WebsiteRuleTypeRedirect:
+		location := m.re.ReplaceAllString(escapedPath, m.rule.absoluteTarget(m.website))
+		if m.rule.PreserveQuery && rawQuery != "" {
+			location += "?" + rawQuery
+		}
+		return location, true
+	case WebsiteRuleTypeRewrite:
+		return m.re.ReplaceAllString(escapedPath, m.rule.Target), true
+	default:
+		return "", false
+	}
+}
+
+// URLRegex returns the regex and the replacement of a redirect rule,
+// for proxies which match against the whole request URL including the query, such as Traefik RedirectRegex.
+func (r *WebsiteRule) URLRegex(w *Website) (regex string, replacement string) {
+	// Prevent the path regex from matching the query, e.g. "/old/(.*)" against "/old/foo?bar"
+	pathRegex := excludeFromRegex(r.PathRegex, '?')
+	numGroups := r.pathRegexp().NumSubexp()
+	regex = "^https?://[^/]+(?:" + pathRegex + ")(\\?.*)?$"
+	replacement = r.absoluteTarget(w)
+	if r.PreserveQuery {
+		replacement += "${" + strconv.Itoa(numGroups+1) + "}"
+	}
+	return regex, replacement
+}
+
+// AnchoredPathRegex returns the regex matching the whole path, for proxies such as Traefik ReplacePathRegex.
+func (r *WebsiteRule) AnchoredPathRegex() string {
+	return r.pathRegexp().String()
+}
+
+// excludeFromRegex returns the regex which does not match c anywhere, keeping the capture groups.
+func excludeFromRegex(regex string, c rune) string {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return regex
+	}
+	excludeRune(re, c)
+	return re.String()
+}
+
+func excludeRune(re *syntax.Regexp, c rune) {
+	switch re.Op {
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		// NOTE: assumes c > '\n'
+		ranges := []rune{0, '\n' - 1, '\n' + 1, c - 1, c + 1, 0x10ffff}
+		if re.Op == syntax.OpAnyChar {
+			ranges = []rune{0, c - 1, c + 1, 0x10ffff}
+		}
+		re.Op = syntax.OpCharClass
+		re.Rune = ranges
+	case syntax.OpCharClass:
+		var ranges []rune
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			lo, hi := re.Rune[i], re.Rune[i+1]
+			if c < lo || hi < c {
+				ranges = append(ranges, lo, hi)
+				continue
+			}
+			if lo <= c-1 {
+				ranges = append(ranges, lo, c-1)
+			}
+			if c+1 <= hi {
+				ranges = append(ranges, c+1, hi)
+			}
+		}
+		re.Rune = ranges
+	}
+	for _, sub := range re.Sub {
+		excludeRune(sub, c)
+	}
+}
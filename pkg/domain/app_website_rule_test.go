@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsiteRule_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    WebsiteRule
+		wantErr bool
+	}{
+		{"redirect path", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old/(.*)", Target: "/new/${1}", StatusCode: 301}, false},
+		{"redirect url", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/docs", Target: "https://example.com/docs", StatusCode: 302, PreserveQuery: true}, false},
+		{"redirect without status", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old", Target: "/new"}, true},
+		{"redirect invalid status", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old", Target: "/new", StatusCode: 200}, true},
+		{"redirect relative target", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old", Target: "new", StatusCode: 302}, true},
+		{"redirect non-http target", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old", Target: "ftp://example.com/", StatusCode: 302}, true},
+		{"redirect query with preserve query", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old", Target: "/new?a=b", StatusCode: 302, PreserveQuery: true}, true},
+		{"rewrite", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/api/(.*)", Target: "/v1/${1}"}, false},
+		{"rewrite query", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/api", Target: "/v1?a=b"}, true},
+		{"rewrite with status", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/api", Target: "/v1", StatusCode: 301}, true},
+		{"empty regex", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "", Target: "/v1"}, true},
+		{"invalid regex", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/(", Target: "/v1"}, true},
+		{"unknown type", WebsiteRule{Type: WebsiteRuleType(100), PathRegex: "/", Target: "/"}, true},
+		{"quotes and backslashes", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: `/a\.b"c\\`, Target: `/"d"\e`}, false},
+		{"regex backslash before quote", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: `/a\\"`, Target: "/v1"}, true},
+		{"regex control character", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/a\nb", Target: "/v1"}, true},
+		{"target backslash before quote", WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/api", Target: `/v1\"`}, true},
+		{"target backslash at the end", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/api", Target: `/v1\`, StatusCode: 302}, true},
+		{"target newline", WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/api", Target: "/v1\n\"}\n", StatusCode: 302}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebsiteRule_URLRegex(t *testing.T) {
+	website := &Website{FQDN: "test.example.com", HTTPS: true}
+	redirect := func(r *WebsiteRule, url string) (string, bool) {
+		regex, replacement := r.URLRegex(website)
+		re := regexp.MustCompile(regex)
+		if !re.MatchString(url) {
+			return "", false
+		}
+		return re.ReplaceAllString(url, replacement), true
+	}
+
+	t.Run("path target", func(t *testing.T) {
+		r := &WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old/(.*)", Target: "/new/${1}", StatusCode: 301}
+		location, ok := redirect(r, "https://test.example.com/old/foo/bar")
+		assert.True(t, ok)
+		assert.Equal(t, "https://test.example.com/new/foo/bar", location)
+
+		location, ok = redirect(r, "https://test.example.com/old/foo?q=1")
+		assert.True(t, ok)
+		assert.Equal(t, "https://test.example.com/new/foo", location, "query should be dropped")
+
+		_, ok = redirect(r, "https://test.example.com/other/old/foo")
+		assert.False(t, ok, "should match the whole path")
+	})
+
+	t.Run("preserve query", func(t *testing.T) {
+		r := &WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old/(.*)", Target: "https://example.com/${1}", StatusCode: 302, PreserveQuery: true}
+		location, ok := redirect(r, "http://test.example.com:8080/old/foo?q=1&r=2")
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/foo?q=1&r=2", location)
+
+		location, ok = redirect(r, "http://test.example.com/old/foo")
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/foo", location)
+	})
+
+	t.Run("char class", func(t *testing.T) {
+		r := &WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/([^/]+)", Target: "/users/${1}", StatusCode: 302}
+		location, ok := redirect(r, "https://test.example.com/alice?tab=1")
+		assert.True(t, ok)
+		assert.Equal(t, "https://test.example.com/users/alice", location)
+	})
+}
+
+func TestWebsiteRule_AnchoredPathRegex(t *testing.T) {
+	r := &WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "/api/(.*)|/legacy", Target: "/v1/${1}"}
+	re := regexp.MustCompile(r.AnchoredPathRegex())
+	assert.Equal(t, "/v1/users", re.ReplaceAllString("/api/users", r.Target))
+	assert.True(t, re.MatchString("/legacy"))
+	assert.False(t, re.MatchString("/foo/api/users"))
+	assert.False(t, re.MatchString("/legacy/foo"))
+}
+
+func TestWebsiteRuleMatcher_Apply(t *testing.T) {
+	website := &Website{FQDN: "test.example.com", HTTPS: true}
+
+	redirect := (&WebsiteRule{Type: WebsiteRuleTypeRedirect, PathRegex: "/old/(.*)", Target: "/new/${1}", StatusCode: 308, PreserveQuery: true}).Matcher(website)
+	location, ok := redirect.Apply("/old/a%20b", "q=1")
+	assert.True(t, ok)
+	assert.Equal(t, "https://test.example.com/new/a%20b?q=1", location)
+	_, ok = redirect.Apply("/other", "")
+	assert.False(t, ok)
+
+	rewrite := (&WebsiteRule{Type: WebsiteRuleTypeRewrite, PathRegex: "(.*)/", Target: "${1}/index.html"}).Matcher(website)
+	path, ok := rewrite.Apply("/docs/", "q=1")
+	assert.True(t, ok)
+	assert.Equal(t, "/docs/index.html", path)
+}
@@ -4,15 +4,22 @@ import (
 	"context"
 	"io"
 	"strings"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 type DesiredState struct {
 	Runtime     []*RuntimeDesiredState
 	StaticSites []*StaticSite
+	// TLSCertificates are the uploaded certificates, served for the matching websites instead of ACME issued ones.
+	TLSCertificates TLSCertificateSlice
 }
 
 type DesiredStateLeader struct {
+	// TLSTargetDomains are the domains to issue certificates for by ACME.
 	TLSTargetDomains []string
+	// TLSCertificates are the uploaded certificates to install.
+	TLSCertificates TLSCertificateSlice
 }
 
 type RuntimeDesiredState struct {
@@ -20,6 +27,8 @@ type RuntimeDesiredState struct {
 	ImageName string
 	ImageTag  string
 	Envs      map[string]string
+	// InternalClientIDs are the IDs of the apps allowed to access the internal service of the app, if enabled.
+	InternalClientIDs []string
 }
 
 type ContainerEvent struct {
@@ -30,6 +39,12 @@ type Container struct {
 	ApplicationID string
 	State         ContainerState
 	Message       string
+	// RestartCount is the number of times the container has been restarted by the backend.
+	RestartCount int
+	// LastExitCode is the exit code of the last terminated container, if any.
+	LastExitCode optional.Of[int]
+	// OOMKilled is true if the last termination was caused by running out of memory.
+	OOMKilled bool
 }
 
 type ContainerState int
@@ -51,6 +66,25 @@ const (
 	ContainerStateUnknown
 )
 
+func (s ContainerState) String() string {
+	switch s {
+	case ContainerStateMissing:
+		return "missing"
+	case ContainerStateStarting:
+		return "starting"
+	case ContainerStateRestarting:
+		return "restarting"
+	case ContainerStateRunning:
+		return "running"
+	case ContainerStateExited:
+		return "exited"
+	case ContainerStateErrored:
+		return "errored"
+	default:
+		return "unknown"
+	}
+}
+
 type WildcardDomains []string
 
 func (wd WildcardDomains) Validate() error {
@@ -12,4 +12,6 @@ type StartBuildRequest struct {
 	App   *Application
 	Envs  []*Environment
 	Build *Build
+	// TraceContext propagates the trace from the controller to the builder.
+	TraceContext map[string]string
 }
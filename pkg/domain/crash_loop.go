@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type CrashLoopConfig struct {
+	// Threshold is the number of restarts within Window to consider an app as crash-looping.
+	// 0 disables the detection.
+	Threshold int `mapstructure:"threshold" yaml:"threshold"`
+	// Window is the duration to count restarts in, e.g. "10m".
+	Window string `mapstructure:"window" yaml:"window"`
+	// AutoStop stops crash-looping apps automatically.
+	AutoStop bool `mapstructure:"autoStop" yaml:"autoStop"`
+}
+
+func (c *CrashLoopConfig) Validate() error {
+	if c.Threshold < 0 {
+		return oops.New("threshold must not be negative")
+	}
+	if c.Threshold == 0 {
+		return nil
+	}
+	window, err := time.ParseDuration(c.Window)
+	if err != nil {
+		return oops.Wrapf(err, "invalid window")
+	}
+	if window <= 0 {
+		return oops.New("window must be positive")
+	}
+	return nil
+}
+
+type restartHistory struct {
+	lastCount    int
+	restarts     []time.Time
+	crashLooping bool
+}
+
+// CrashLoopDetector counts restarts of app containers from the restart counts reported by the backend,
+// and detects apps restarted more than the threshold within the window.
+type CrashLoopDetector struct {
+	threshold int
+	window    time.Duration
+
+	lock    sync.Mutex
+	history map[string]*restartHistory
+}
+
+func NewCrashLoopDetector(c CrashLoopConfig) (*CrashLoopDetector, error) {
+	if err := c.Validate(); err != nil {
+		return nil, oops.Wrapf(err, "invalid crash loop config")
+	}
+	window, _ := time.ParseDuration(c.Window)
+	return &CrashLoopDetector{
+		threshold: c.Threshold,
+		window:    window,
+		history:   make(map[string]*restartHistory),
+	}, nil
+}
+
+// Observe records the container state observed at now.
+//
+// If the app is crash-looping, Observe marks the container as errored with the reason in its message,
+// and returns true for detected on the first observation since the app started crash-looping.
+func (d *CrashLoopDetector) Observe(c *Container, now time.Time) (detected bool) {
+	if d.threshold == 0 {
+		return false
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	h, ok := d.history[c.ApplicationID]
+	if !ok {
+		// First observation only sets the baseline, as restarts may have happened long ago
+		d.history[c.ApplicationID] = &restartHistory{lastCount: c.RestartCount}
+		return false
+	}
+
+	if c.RestartCount < h.lastCount {
+		// Container was re-created, start counting again
+		h.lastCount = c.RestartCount
+		h.restarts = nil
+	}
+	for range c.RestartCount - h.lastCount {
+		h.restarts = append(h.restarts, now)
+	}
+	h.lastCount = c.RestartCount
+	h.restarts = lo.Filter(h.restarts, func(t time.Time, _ int) bool { return now.Sub(t) < d.window })
+
+	wasCrashLooping := h.crashLooping
+	h.crashLooping = len(h.restarts) >= d.threshold
+	if !h.crashLooping {
+		return false
+	}
+
+	c.State = ContainerStateErrored
+	c.Message = crashLoopMessage(c, len(h.restarts), d.window)
+	return !wasCrashLooping
+}
+
+// Forget discards the history of the app, e.g. when the app is stopped.
+func (d *CrashLoopDetector) Forget(appID string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.history, appID)
+}
+
+func crashLoopMessage(c *Container, restarts int, window time.Duration) string {
+	msg := fmt.Sprintf("Crash loop detected: restarted %d times in %v", restarts, window)
+	if c.LastExitCode.Valid {
+		msg += fmt.Sprintf(", last exit code %d", c.LastExitCode.V)
+	}
+	if c.OOMKilled {
+		msg += " (OOMKilled)"
+	}
+	if c.Message != "" {
+		msg += ": " + c.Message
+	}
+	return msg
+}
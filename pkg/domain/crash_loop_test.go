@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestCrashLoopConfig_Validate(t *testing.T) {
+	assert.NoError(t, (&CrashLoopConfig{Threshold: 0}).Validate())
+	assert.NoError(t, (&CrashLoopConfig{Threshold: 3, Window: "10m"}).Validate())
+	assert.Error(t, (&CrashLoopConfig{Threshold: -1}).Validate())
+	assert.Error(t, (&CrashLoopConfig{Threshold: 3, Window: "invalid"}).Validate())
+	assert.Error(t, (&CrashLoopConfig{Threshold: 3, Window: "0s"}).Validate())
+}
+
+func TestCrashLoopDetector_Observe(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	observe := func(d *CrashLoopDetector, restarts int, at time.Duration) (*Container, bool) {
+		c := &Container{
+			ApplicationID: "app",
+			State:         ContainerStateRestarting,
+			Message:       "Restarting",
+			RestartCount:  restarts,
+			LastExitCode:  optional.From(137),
+			OOMKilled:     true,
+		}
+		return c, d.Observe(c, now.Add(at))
+	}
+
+	t.Run("detect", func(t *testing.T) {
+		d, err := NewCrashLoopDetector(CrashLoopConfig{Threshold: 3, Window: "10m"})
+		require.NoError(t, err)
+
+		// baseline
+		_, detected := observe(d, 5, 0)
+		assert.False(t, detected)
+		c, detected := observe(d, 7, time.Minute)
+		assert.False(t, detected)
+		assert.Equal(t, ContainerStateRestarting, c.State)
+
+		c, detected = observe(d, 8, 2*time.Minute)
+		assert.True(t, detected)
+		assert.Equal(t, ContainerStateErrored, c.State)
+		assert.Equal(t, "Crash loop detected: restarted 3 times in 10m0s, last exit code 137 (OOMKilled): Restarting", c.Message)
+
+		// still crash-looping, but not newly detected
+		c, detected = observe(d, 8, 3*time.Minute)
+		assert.False(t, detected)
+		assert.Equal(t, ContainerStateErrored, c.State)
+
+		// restarts went out of window
+		c, detected = observe(d, 8, 12*time.Minute)
+		assert.False(t, detected)
+		assert.Equal(t, ContainerStateRestarting, c.State)
+	})
+
+	t.Run("reset on re-creation", func(t *testing.T) {
+		d, err := NewCrashLoopDetector(CrashLoopConfig{Threshold: 3, Window: "10m"})
+		require.NoError(t, err)
+
+		observe(d, 0, 0)
+		observe(d, 2, time.Minute)
+		_, detected := observe(d, 1, 2*time.Minute)
+		assert.False(t, detected)
+		_, detected = observe(d, 3, 3*time.Minute)
+		assert.False(t, detected)
+	})
+
+	t.Run("forget", func(t *testing.T) {
+		d, err := NewCrashLoopDetector(CrashLoopConfig{Threshold: 1, Window: "10m"})
+		require.NoError(t, err)
+
+		observe(d, 0, 0)
+		d.Forget("app")
+		_, detected := observe(d, 5, time.Minute)
+		assert.False(t, detected)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		d, err := NewCrashLoopDetector(CrashLoopConfig{Threshold: 0})
+		require.NoError(t, err)
+
+		observe(d, 0, 0)
+		_, detected := observe(d, 100, time.Minute)
+		assert.False(t, detected)
+	})
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+	"github.com/traPtitech/neoshowcase/pkg/util/random"
+)
+
+type CustomDomainVerificationMethod int
+
+const (
+	// CustomDomainVerificationDNS verifies ownership by a TXT record at CustomDomain.TXTRecordName.
+	CustomDomainVerificationDNS CustomDomainVerificationMethod = iota
+	// CustomDomainVerificationHTTP verifies ownership by the token served at CustomDomain.HTTPURL.
+	CustomDomainVerificationHTTP
+)
+
+const (
+	customDomainTXTRecordPrefix = "_neoshowcase-challenge."
+	customDomainTXTValuePrefix  = "neoshowcase-verification="
+	customDomainHTTPPath        = "/.well-known/neoshowcase-verification.txt"
+	customDomainTokenLength     = 32
+)
+
+// CustomDomain is a domain registered by a user, to be used for websites of applications owned by the user.
+// The domain can be used once its ownership is verified.
+type CustomDomain struct {
+	ID     string
+	UserID string
+	Domain string
+	Method CustomDomainVerificationMethod
+	Token  string
+	// Verified is true while the ownership is verified.
+	Verified bool
+	// VerifiedAt is the last time the ownership was verified.
+	VerifiedAt optional.Of[time.Time]
+	CheckedAt  optional.Of[time.Time]
+	// Error is the reason of the last failed verification, or empty if succeeded.
+	Error     string
+	CreatedAt time.Time
+}
+
+func NewCustomDomain(userID string, domain string, method CustomDomainVerificationMethod) *CustomDomain {
+	return &CustomDomain{
+		ID:        NewID(),
+		UserID:    userID,
+		Domain:    strings.ToLower(domain),
+		Method:    method,
+		Token:     random.SecureGenerateHex(customDomainTokenLength),
+		CreatedAt: time.Now(),
+	}
+}
+
+// Validate validates the custom domain. systemDomains are the domains available to all users.
+func (d *CustomDomain) Validate(systemDomains AvailableDomainSlice) error {
+	if err := ValidateDomain(d.Domain); err != nil {
+		return err
+	}
+	if !strings.Contains(d.Domain, ".") {
+		return oops.Errorf("domain %v must not be a top level domain", d.Domain)
+	}
+	if systemDomains.IsAvailable(d.Domain) {
+		return oops.Errorf("domain %v is already available without registration", d.Domain)
+	}
+	switch d.Method {
+	case CustomDomainVerificationDNS, CustomDomainVerificationHTTP:
+	default:
+		return oops.Errorf("unknown verification method: %v", d.Method)
+	}
+	return nil
+}
+
+// TXTRecordName is the name of the TXT record to verify the ownership by DNS.
+func (d *CustomDomain) TXTRecordName() string {
+	return customDomainTXTRecordPrefix + d.Domain
+}
+
+// TXTRecordValue is the value of the TXT record to verify the ownership by DNS.
+func (d *CustomDomain) TXTRecordValue() string {
+	return customDomainTXTValuePrefix + d.Token
+}
+
+// HTTPURL is the URL serving Token as the body to verify the ownership by HTTP.
+func (d *CustomDomain) HTTPURL() string {
+	return "http://" + d.Domain + customDomainHTTPPath
+}
+
+// VerificationResult returns the fields to update after verification at now.
+// A verified domain stays verified while verification fails for at most gracePeriod,
+// so that temporary DNS or HTTP failures do not break websites.
+func (d *CustomDomain) VerificationResult(verifyErr error, now time.Time, gracePeriod time.Duration) *UpdateCustomDomainArgs {
+	args := &UpdateCustomDomainArgs{CheckedAt: optional.From(now)}
+	if verifyErr == nil {
+		args.Verified = optional.From(true)
+		args.VerifiedAt = optional.From(now)
+		args.Error = optional.From("")
+		return args
+	}
+	args.Error = optional.From(verifyErr.Error())
+	if d.Verified && d.VerifiedAt.Valid && now.Sub(d.VerifiedAt.V) <= gracePeriod {
+		return args
+	}
+	args.Verified = optional.From(false)
+	return args
+}
+
+// AvailableDomain returns the domain as AvailableDomain.
+// Authentication is not available for custom domains, as cookies of the auth server cannot be shared.
+func (d *CustomDomain) AvailableDomain() *AvailableDomain {
+	return &AvailableDomain{
+		Domain:        d.Domain,
+		AuthAvailable: false,
+	}
+}
+
+// WithCustomDomains returns domains available to the applications owned by ownerIDs,
+// merged with verified custom domains of the owners.
+func (s AvailableDomainSlice) WithCustomDomains(customDomains []*CustomDomain, ownerIDs []string) AvailableDomainSlice {
+	merged := append(AvailableDomainSlice{}, s...)
+	for _, d := range customDomains {
+		if d.Verified && lo.Contains(ownerIDs, d.UserID) {
+			merged = append(merged, d.AvailableDomain())
+		}
+	}
+	return merged
+}
+
+// CustomDomainVerifier verifies the ownership of custom domains.
+type CustomDomainVerifier interface {
+	Verify(ctx context.Context, d *CustomDomain) error
+}
+
+// DNSResolver resolves DNS records. *net.Resolver implements this.
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestCustomDomain_Validate(t *testing.T) {
+	systemDomains := AvailableDomainSlice{
+		{Domain: "*.trap.show"},
+		{Domain: "trap.show"},
+	}
+	tests := []struct {
+		name    string
+		domain  string
+		method  CustomDomainVerificationMethod
+		wantErr bool
+	}{
+		{"ok", "example.dev", CustomDomainVerificationDNS, false},
+		{"ok subdomain", "app.example.dev", CustomDomainVerificationHTTP, false},
+		{"upper case", "Example.Dev", CustomDomainVerificationDNS, false},
+		{"top level", "dev", CustomDomainVerificationDNS, true},
+		{"wildcard", "*.example.dev", CustomDomainVerificationDNS, true},
+		{"invalid", "example..dev", CustomDomainVerificationDNS, true},
+		{"system domain", "foo.trap.show", CustomDomainVerificationDNS, true},
+		{"unknown method", "example.dev", CustomDomainVerificationMethod(100), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewCustomDomain("user", tt.domain, tt.method).Validate(systemDomains)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomDomain_VerificationResult(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	grace := 72 * time.Hour
+	verifyErr := errors.New("TXT record not found")
+
+	t.Run("success", func(t *testing.T) {
+		d := &CustomDomain{}
+		args := d.VerificationResult(nil, now, grace)
+		assert.Equal(t, optional.From(true), args.Verified)
+		assert.Equal(t, optional.From(now), args.VerifiedAt)
+		assert.Equal(t, optional.From(now), args.CheckedAt)
+		assert.Equal(t, optional.From(""), args.Error)
+	})
+	t.Run("never verified", func(t *testing.T) {
+		d := &CustomDomain{}
+		args := d.VerificationResult(verifyErr, now, grace)
+		assert.Equal(t, optional.From(false), args.Verified)
+		assert.False(t, args.VerifiedAt.Valid)
+		assert.Equal(t, optional.From(verifyErr.Error()), args.Error)
+	})
+	t.Run("within grace period", func(t *testing.T) {
+		d := &CustomDomain{Verified: true, VerifiedAt: optional.From(now.Add(-24 * time.Hour))}
+		args := d.VerificationResult(verifyErr, now, grace)
+		assert.False(t, args.Verified.Valid)
+		assert.Equal(t, optional.From(verifyErr.Error()), args.Error)
+	})
+	t.Run("grace period exceeded", func(t *testing.T) {
+		d := &CustomDomain{Verified: true, VerifiedAt: optional.From(now.Add(-96 * time.Hour))}
+		args := d.VerificationResult(verifyErr, now, grace)
+		assert.Equal(t, optional.From(false), args.Verified)
+	})
+}
+
+func TestAvailableDomainSlice_WithCustomDomains(t *testing.T) {
+	systemDomains := AvailableDomainSlice{
+		{Domain: "*.trap.show", AuthAvailable: true},
+	}
+	customDomains := []*CustomDomain{
+		{UserID: "alice", Domain: "alice.dev", Verified: true},
+		{UserID: "alice", Domain: "pending.alice.dev", Verified: false},
+		{UserID: "bob", Domain: "bob.dev", Verified: true},
+	}
+
+	merged := systemDomains.WithCustomDomains(customDomains, []string{"alice"})
+	require.Len(t, merged, 2)
+	assert.True(t, merged.IsAvailable("foo.trap.show"))
+	assert.True(t, merged.IsAvailable("alice.dev"))
+	assert.False(t, merged.IsAvailable("pending.alice.dev"))
+	assert.False(t, merged.IsAvailable("bob.dev"))
+	assert.Len(t, systemDomains, 1, "original slice should not be modified")
+}
@@ -16,4 +16,7 @@ type GitService interface {
 type GitRepository interface {
 	Fetch(ctx context.Context, hashes []string) error
 	GetCommit(hash string) (*RepositoryCommit, error)
+	// ReadFile reads the file at path in the tree of the given commit.
+	// Returns ErrFileNotFound if the file does not exist.
+	ReadFile(hash string, path string) ([]byte, error)
 }
@@ -2,7 +2,12 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"regexp"
 	"time"
+	"unicode/utf8"
+
+	"github.com/samber/oops"
 )
 
 type ContainerLog struct {
@@ -10,8 +15,70 @@ type ContainerLog struct {
 	Log  string
 }
 
+type LogStream int
+
+const (
+	LogStreamAll LogStream = iota
+	LogStreamStdout
+	LogStreamStderr
+)
+
+func (s LogStream) String() string {
+	switch s {
+	case LogStreamStdout:
+		return "stdout"
+	case LogStreamStderr:
+		return "stderr"
+	default:
+		return ""
+	}
+}
+
+const logFilterMaxLength = 256
+
+// ErrUnsupportedLogFilter is returned by ContainerLogger if the backend does not support the filter.
+var ErrUnsupportedLogFilter = errors.New("unsupported log filter")
+
+// LogFilter narrows down container logs. A zero-value LogFilter matches all lines.
+type LogFilter struct {
+	// Contains matches lines containing the substring.
+	Contains string
+	// Regexp matches lines matching the RE2 regular expression.
+	Regexp string
+	// Stream matches lines from the output stream.
+	Stream LogStream
+}
+
+func (f *LogFilter) IsZero() bool {
+	return f.Contains == "" && f.Regexp == "" && f.Stream == LogStreamAll
+}
+
+// Validate validates the user input.
+// Implementations of ContainerLogger still have to quote the values when building queries.
+func (f *LogFilter) Validate() error {
+	for name, s := range map[string]string{"substring": f.Contains, "regexp": f.Regexp} {
+		if len(s) > logFilterMaxLength {
+			return oops.Errorf("%s filter must be at most %d bytes", name, logFilterMaxLength)
+		}
+		if !utf8.ValidString(s) {
+			return oops.Errorf("%s filter must be valid UTF-8", name)
+		}
+	}
+	if f.Regexp != "" {
+		if _, err := regexp.Compile(f.Regexp); err != nil {
+			return oops.Wrapf(err, "invalid regexp filter")
+		}
+	}
+	switch f.Stream {
+	case LogStreamAll, LogStreamStdout, LogStreamStderr:
+	default:
+		return oops.Errorf("unknown stream %d", f.Stream)
+	}
+	return nil
+}
+
 type ContainerLogger interface {
 	LogLimit() int
-	Get(ctx context.Context, app *Application, before time.Time, limit int) ([]*ContainerLog, error)
-	Stream(ctx context.Context, app *Application, begin time.Time) (<-chan *ContainerLog, error)
+	Get(ctx context.Context, app *Application, before time.Time, limit int, filter LogFilter) ([]*ContainerLog, error)
+	Stream(ctx context.Context, app *Application, begin time.Time, filter LogFilter) (<-chan *ContainerLog, error)
 }
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+type LogExportFormat int
+
+const (
+	LogExportFormatNDJSON LogExportFormat = iota
+	LogExportFormatText
+)
+
+func ParseLogExportFormat(s string) (LogExportFormat, error) {
+	switch s {
+	case "", "ndjson":
+		return LogExportFormatNDJSON, nil
+	case "text":
+		return LogExportFormatText, nil
+	default:
+		return 0, oops.Errorf("unknown format %q (supported values: ndjson, text)", s)
+	}
+}
+
+func (f LogExportFormat) Extension() string {
+	switch f {
+	case LogExportFormatText:
+		return ".log"
+	default:
+		return ".ndjson"
+	}
+}
+
+func (f LogExportFormat) ContentType() string {
+	switch f {
+	case LogExportFormatText:
+		return "text/plain; charset=utf-8"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// LogExportFileName returns the file name for the logs of the app exported for the time range.
+func LogExportFileName(app *Application, kind string, since, until time.Time, format LogExportFormat) string {
+	const timeFormat = "20060102T150405Z"
+	return fmt.Sprintf("%s-%s-%s-%s%s", app.Name, kind, since.UTC().Format(timeFormat), until.UTC().Format(timeFormat), format.Extension())
+}
+
+// LogExporter writes logs in the format.
+type LogExporter struct {
+	w      io.Writer
+	format LogExportFormat
+}
+
+func NewLogExporter(w io.Writer, format LogExportFormat) *LogExporter {
+	return &LogExporter{w: w, format: format}
+}
+
+type containerLogLine struct {
+	Time time.Time `json:"time"`
+	Log  string    `json:"log"`
+}
+
+type buildLogLine struct {
+	BuildID string `json:"build_id"`
+	Status  string `json:"status"`
+	Log     string `json:"log"`
+}
+
+func (e *LogExporter) WriteContainerLog(l *ContainerLog) error {
+	switch e.format {
+	case LogExportFormatText:
+		_, err := fmt.Fprintf(e.w, "%s %s\n", l.Time.UTC().Format(time.RFC3339Nano), l.Log)
+		return err
+	default:
+		return json.NewEncoder(e.w).Encode(&containerLogLine{Time: l.Time, Log: l.Log})
+	}
+}
+
+// WriteBuildLog writes the whole log of the build.
+// In NDJSON format, the log is written as a single object, since build logs do not have timestamps per line.
+func (e *LogExporter) WriteBuildLog(build *Build, log []byte) error {
+	switch e.format {
+	case LogExportFormatText:
+		_, err := fmt.Fprintf(e.w, "=== Build %s (%s, queued at %s) ===\n", build.ID, build.Status, build.QueuedAt.UTC().Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+		if _, err = e.w.Write(log); err != nil {
+			return err
+		}
+		if len(log) > 0 && log[len(log)-1] != '\n' {
+			_, err = io.WriteString(e.w, "\n")
+		}
+		return err
+	default:
+		return json.NewEncoder(e.w).Encode(&buildLogLine{BuildID: build.ID, Status: build.Status.String(), Log: string(log)})
+	}
+}
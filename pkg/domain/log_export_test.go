@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogExporter(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	log := &ContainerLog{Time: ts, Log: `hello "world"`}
+	build := &Build{ID: "build", Status: BuildStatusFailed, QueuedAt: ts}
+
+	t.Run("ndjson", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := NewLogExporter(&buf, LogExportFormatNDJSON)
+		require.NoError(t, e.WriteContainerLog(log))
+		require.NoError(t, e.WriteBuildLog(build, []byte("step 1\nstep 2\n")))
+		assert.Equal(t, `{"time":"2024-01-02T03:04:05.0000006Z","log":"hello \"world\""}
+{"build_id":"build","status":"failed","log":"step 1\nstep 2\n"}
+`, buf.String())
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := NewLogExporter(&buf, LogExportFormatText)
+		require.NoError(t, e.WriteContainerLog(log))
+		require.NoError(t, e.WriteBuildLog(build, []byte("step 1\nstep 2")))
+		assert.Equal(t, `2024-01-02T03:04:05.0000006Z hello "world"
+=== Build build (failed, queued at 2024-01-02T03:04:05Z) ===
+step 1
+step 2
+`, buf.String())
+	})
+}
+
+func TestLogExportFileName(t *testing.T) {
+	app := &Application{Name: "my-app"}
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(36 * time.Hour)
+	assert.Equal(t, "my-app-output-20240101T000000Z-20240102T120000Z.ndjson", LogExportFileName(app, "output", since, until, LogExportFormatNDJSON))
+	assert.Equal(t, "my-app-build-logs-20240101T000000Z-20240102T120000Z.log", LogExportFileName(app, "build-logs", since, until, LogExportFormatText))
+}
+
+func TestParseLogExportFormat(t *testing.T) {
+	f, err := ParseLogExportFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, LogExportFormatNDJSON, f)
+	f, err = ParseLogExportFormat("text")
+	require.NoError(t, err)
+	assert.Equal(t, LogExportFormatText, f)
+	_, err = ParseLogExportFormat("csv")
+	assert.Error(t, err)
+}
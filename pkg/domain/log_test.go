@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  LogFilter
+		wantErr bool
+	}{
+		{"empty", LogFilter{}, false},
+		{"valid", LogFilter{Contains: "panic", Regexp: `^\d+ error`, Stream: LogStreamStderr}, false},
+		{"invalid regexp", LogFilter{Regexp: "(a"}, true},
+		{"too long", LogFilter{Contains: strings.Repeat("a", 257)}, true},
+		{"invalid utf-8", LogFilter{Contains: "\xff"}, true},
+		{"unknown stream", LogFilter{Stream: LogStream(3)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"gopkg.in/yaml.v3"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// ManifestVersion is the version of the application manifest format.
+const ManifestVersion = "v1"
+
+type ManifestFormat int
+
+const (
+	ManifestFormatYAML ManifestFormat = iota
+	ManifestFormatJSON
+)
+
+// Manifest is a declarative description of a set of applications,
+// used to export applications and to re-create or update them in bulk.
+type Manifest struct {
+	Version      string                 `yaml:"version" json:"version"`
+	Applications []*ManifestApplication `yaml:"applications" json:"applications"`
+}
+
+// ManifestApplication is an application declared in a manifest.
+//
+// Values of env vars are not included since they may contain secrets; only the keys are.
+// Applying a manifest creates missing keys with empty values, and never removes existing keys.
+type ManifestApplication struct {
+	// ID is the ID of the application to update.
+	// If empty or not found, such as in a manifest exported from another instance, a new application is created.
+	ID               string                       `yaml:"id,omitempty" json:"id,omitempty"`
+	Name             string                       `yaml:"name" json:"name"`
+	RepositoryID     string                       `yaml:"repositoryId" json:"repositoryId"`
+	RefName          string                       `yaml:"refName" json:"refName"`
+	ConfigFilePath   string                       `yaml:"configFilePath,omitempty" json:"configFilePath,omitempty"`
+	Build            ConfigFileBuild              `yaml:"build" json:"build"`
+	Websites         []*ConfigFileWebsite         `yaml:"websites,omitempty" json:"websites,omitempty"`
+	PortPublications []*ConfigFilePortPublication `yaml:"portPublications,omitempty" json:"portPublications,omitempty"`
+	EnvKeys          []string                     `yaml:"envKeys,omitempty" json:"envKeys,omitempty"`
+	// Owners is the list of names of the owner users. If empty, owners are left unchanged.
+	Owners []string `yaml:"owners,omitempty" json:"owners,omitempty"`
+}
+
+// ManifestFieldDiff is a change of a single field of an application.
+type ManifestFieldDiff struct {
+	// Field is the path to the field, e.g. "build.dockerfileName" or "websites[0].fqdn".
+	Field  string
+	Before string
+	After  string
+}
+
+// NewManifestApplication exports app into the manifest representation.
+// envs and users may contain entries unrelated to app.
+func NewManifestApplication(app *Application, envs []*Environment, users []*User) *ManifestApplication {
+	userNames := lo.SliceToMap(users, func(u *User) (string, string) { return u.ID, u.Name })
+	envKeys := lo.FilterMap(envs, func(e *Environment, _ int) (string, bool) {
+		return e.Key, e.ApplicationID == app.ID && !e.System
+	})
+	slices.Sort(envKeys)
+	owners := lo.FilterMap(app.OwnerIDs, func(id string, _ int) (string, bool) {
+		name, ok := userNames[id]
+		return name, ok
+	})
+	slices.Sort(owners)
+	return &ManifestApplication{
+		ID:               app.ID,
+		Name:             app.Name,
+		RepositoryID:     app.RepositoryID,
+		RefName:          app.RefName,
+		ConfigFilePath:   app.ConfigFilePath,
+		Build:            configFileBuildFrom(app.Config.BuildConfig),
+		Websites:         ds.Map(app.Websites, configFileWebsiteFrom),
+		PortPublications: ds.Map(app.PortPublications, configFilePortPublicationFrom),
+		EnvKeys:          envKeys,
+		Owners:           owners,
+	}
+}
+
+// NewManifest exports apps into a manifest.
+func NewManifest(apps []*Application, envs []*Environment, users []*User) *Manifest {
+	return &Manifest{
+		Version: ManifestVersion,
+		Applications: ds.Map(apps, func(app *Application) *ManifestApplication {
+			return NewManifestApplication(app, envs, users)
+		}),
+	}
+}
+
+// ParseManifest parses a manifest. Both YAML and JSON formats are accepted.
+func ParseManifest(b []byte) (*Manifest, error) {
+	var m Manifest
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&m); err != nil {
+		return nil, oops.Wrapf(err, "decoding manifest")
+	}
+	if m.Version != ManifestVersion {
+		return nil, oops.Errorf("unsupported manifest version: %v", m.Version)
+	}
+	return &m, nil
+}
+
+// Marshal encodes the manifest in the given format.
+func (m *Manifest) Marshal(format ManifestFormat) ([]byte, error) {
+	switch format {
+	case ManifestFormatYAML:
+		return yaml.Marshal(m)
+	case ManifestFormatJSON:
+		return json.MarshalIndent(m, "", "  ")
+	default:
+		return nil, oops.Errorf("unknown manifest format: %v", format)
+	}
+}
+
+// UpdateArgs converts the declared application into update args of app.
+// For a new application, app should be the zero application with only the system-generated fields set.
+func (m *ManifestApplication) UpdateArgs(app *Application, users []*User) (*UpdateApplicationArgs, error) {
+	if app.RepositoryID != "" && app.RepositoryID != m.RepositoryID {
+		return nil, oops.New("repositoryId is immutable")
+	}
+	f := &ConfigFile{Build: m.Build, Websites: m.Websites, PortPublications: m.PortPublications}
+	args, err := f.UpdateArgs(app)
+	if err != nil {
+		return nil, err
+	}
+	args.Name = optional.From(m.Name)
+	args.RefName = optional.From(m.RefName)
+	args.ConfigFilePath = optional.From(m.ConfigFilePath)
+	if len(m.Owners) > 0 {
+		userIDs := lo.SliceToMap(users, func(u *User) (string, string) { return u.Name, u.ID })
+		ownerIDs := make([]string, 0, len(m.Owners))
+		for _, name := range lo.Uniq(m.Owners) {
+			id, ok := userIDs[name]
+			if !ok {
+				return nil, oops.Errorf("unknown owner: %v", name)
+			}
+			ownerIDs = append(ownerIDs, id)
+		}
+		args.OwnerIDs = optional.From(ownerIDs)
+	}
+	return args, nil
+}
+
+// Envs returns the env vars to be created for the declared keys missing in current.
+func (m *ManifestApplication) Envs(appID string, current []*Environment) ([]*Environment, error) {
+	currentEnvs := lo.SliceToMap(current, func(e *Environment) (string, *Environment) { return e.Key, e })
+	var envs []*Environment
+	for _, key := range lo.Uniq(m.EnvKeys) {
+		if e, ok := currentEnvs[key]; ok {
+			if e.System {
+				return nil, oops.Errorf("env %v is set by the system", key)
+			}
+			continue
+		}
+		env := &Environment{ApplicationID: appID, Key: key, Value: "", System: false}
+		if err := env.Validate(); err != nil {
+			return nil, oops.Wrapf(err, "invalid env")
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// DiffManifestApplications returns the field-level difference between two exported applications.
+// before is nil if the application is to be created.
+func DiffManifestApplications(before, after *ManifestApplication) ([]*ManifestFieldDiff, error) {
+	beforeFields := make(map[string]string)
+	if before != nil {
+		if err := flattenManifestFields(before, beforeFields); err != nil {
+			return nil, err
+		}
+	}
+	afterFields := make(map[string]string)
+	if err := flattenManifestFields(after, afterFields); err != nil {
+		return nil, err
+	}
+
+	fields := lo.Uniq(append(lo.Keys(beforeFields), lo.Keys(afterFields)...))
+	slices.Sort(fields)
+	var diffs []*ManifestFieldDiff
+	for _, field := range fields {
+		if field == "id" || beforeFields[field] == afterFields[field] {
+			continue
+		}
+		diffs = append(diffs, &ManifestFieldDiff{Field: field, Before: beforeFields[field], After: afterFields[field]})
+	}
+	return diffs, nil
+}
+
+func flattenManifestFields(app *ManifestApplication, fields map[string]string) error {
+	b, err := yaml.Marshal(app)
+	if err != nil {
+		return oops.Wrapf(err, "marshaling application")
+	}
+	var v any
+	if err = yaml.Unmarshal(b, &v); err != nil {
+		return oops.Wrapf(err, "unmarshaling application")
+	}
+	flattenFields("", v, fields)
+	return nil
+}
+
+func flattenFields(prefix string, v any, fields map[string]string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			flattenFields(lo.Ternary(prefix == "", k, prefix+"."+k), child, fields)
+		}
+	case []any:
+		_, isMapList := lo.Find(v, func(child any) bool {
+			_, ok := child.(map[string]any)
+			return ok
+		})
+		if !isMapList {
+			// Show scalar lists such as env keys and owners as a single field
+			fields[prefix] = strings.Join(ds.Map(v, func(child any) string { return fmt.Sprint(child) }), ", ")
+			return
+		}
+		for i, child := range v {
+			flattenFields(fmt.Sprintf("%s[%d]", prefix, i), child, fields)
+		}
+	default:
+		fields[prefix] = fmt.Sprint(v)
+	}
+}
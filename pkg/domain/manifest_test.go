@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testManifestApp() *Application {
+	return &Application{
+		ID:             "app-id",
+		Name:           "app",
+		RepositoryID:   "repo-id",
+		RefName:        "main",
+		ConfigFilePath: "deploy/neoshowcase.yaml",
+		Config: ApplicationConfig{
+			BuildConfig: &BuildConfigRuntimeDockerfile{
+				RuntimeConfig:  RuntimeConfig{UseMariaDB: true},
+				DockerfileName: "Dockerfile",
+			},
+		},
+		Websites: []*Website{
+			{ID: "website-id", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 8080, Authentication: AuthenticationTypeOff},
+		},
+		PortPublications: []*PortPublication{
+			{InternetPort: 39000, ApplicationPort: 22, Protocol: PortPublicationProtocolTCP},
+		},
+		OwnerIDs: []string{"user-1"},
+	}
+}
+
+func TestManifest_RoundTrip(t *testing.T) {
+	app := testManifestApp()
+	envs := []*Environment{
+		{ApplicationID: "app-id", Key: "TOKEN", Value: "secret"},
+		{ApplicationID: "app-id", Key: EnvMariaDBPasswordKey, Value: "password", System: true},
+		{ApplicationID: "other-id", Key: "OTHER", Value: "other"},
+	}
+	users := []*User{{ID: "user-1", Name: "alice"}, {ID: "user-2", Name: "bob"}}
+
+	for _, format := range []ManifestFormat{ManifestFormatYAML, ManifestFormatJSON} {
+		b, err := NewManifest([]*Application{app}, envs, users).Marshal(format)
+		require.NoError(t, err)
+		assert.NotContains(t, string(b), "secret")
+
+		m, err := ParseManifest(b)
+		require.NoError(t, err)
+		require.Len(t, m.Applications, 1)
+		ma := m.Applications[0]
+		assert.Equal(t, []string{"TOKEN"}, ma.EnvKeys)
+		assert.Equal(t, []string{"alice"}, ma.Owners)
+		assert.Equal(t, "deploy/neoshowcase.yaml", ma.ConfigFilePath)
+
+		args, err := ma.UpdateArgs(app, users)
+		require.NoError(t, err)
+		next := *app
+		next.Apply(args)
+		diffs, err := DiffManifestApplications(NewManifestApplication(app, envs, users), NewManifestApplication(&next, envs, users))
+		require.NoError(t, err)
+		assert.Empty(t, diffs)
+		assert.Equal(t, "website-id", next.Websites[0].ID)
+		assert.Equal(t, "deploy/neoshowcase.yaml", next.ConfigFilePath)
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	_, err := ParseManifest([]byte("version: v0\napplications: []\n"))
+	assert.Error(t, err)
+	_, err = ParseManifest([]byte("version: v1\nfoo: bar\n"))
+	assert.Error(t, err)
+	m, err := ParseManifest([]byte(`{"version": "v1", "applications": [{"name": "app", "build": {"type": "static_cmd"}}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "app", m.Applications[0].Name)
+}
+
+func TestManifestApplication_UpdateArgs(t *testing.T) {
+	app := testManifestApp()
+	users := []*User{{ID: "user-1", Name: "alice"}}
+
+	t.Run("unknown owner", func(t *testing.T) {
+		m := NewManifestApplication(app, nil, users)
+		m.Owners = []string{"alice", "mallory"}
+		_, err := m.UpdateArgs(app, users)
+		assert.Error(t, err)
+	})
+	t.Run("repository is immutable", func(t *testing.T) {
+		m := NewManifestApplication(app, nil, users)
+		m.RepositoryID = "other-repo-id"
+		_, err := m.UpdateArgs(app, users)
+		assert.Error(t, err)
+	})
+	t.Run("owners are kept if empty", func(t *testing.T) {
+		m := NewManifestApplication(app, nil, users)
+		m.Owners = nil
+		args, err := m.UpdateArgs(app, users)
+		require.NoError(t, err)
+		assert.False(t, args.OwnerIDs.Valid)
+	})
+}
+
+func TestManifestApplication_Envs(t *testing.T) {
+	current := []*Environment{
+		{ApplicationID: "app-id", Key: "EXISTING", Value: "value"},
+		{ApplicationID: "app-id", Key: EnvMariaDBPasswordKey, Value: "password", System: true},
+	}
+
+	envs, err := (&ManifestApplication{EnvKeys: []string{"EXISTING", "NEW"}}).Envs("app-id", current)
+	require.NoError(t, err)
+	assert.Equal(t, []*Environment{{ApplicationID: "app-id", Key: "NEW", Value: ""}}, envs)
+
+	_, err = (&ManifestApplication{EnvKeys: []string{EnvMariaDBPasswordKey}}).Envs("app-id", current)
+	assert.Error(t, err)
+}
+
+func TestDiffManifestApplications(t *testing.T) {
+	before := &ManifestApplication{
+		ID:       "app-id",
+		Name:     "app",
+		RefName:  "main",
+		Build:    ConfigFileBuild{Type: "runtime_dockerfile", DockerfileName: "Dockerfile"},
+		Websites: []*ConfigFileWebsite{{FQDN: "app.example.com", HTTPPort: 80}},
+		EnvKeys:  []string{"A"},
+	}
+	after := &ManifestApplication{
+		ID:       "app-id",
+		Name:     "app",
+		RefName:  "develop",
+		Build:    ConfigFileBuild{Type: "runtime_dockerfile", DockerfileName: "Dockerfile.dev"},
+		Websites: []*ConfigFileWebsite{{FQDN: "dev.example.com", HTTPPort: 80}},
+		EnvKeys:  []string{"A", "B"},
+	}
+
+	diffs, err := DiffManifestApplications(before, after)
+	require.NoError(t, err)
+	assert.Equal(t, []*ManifestFieldDiff{
+		{Field: "build.dockerfileName", Before: "Dockerfile", After: "Dockerfile.dev"},
+		{Field: "envKeys", Before: "A", After: "A, B"},
+		{Field: "refName", Before: "main", After: "develop"},
+		{Field: "websites[0].fqdn", Before: "app.example.com", After: "dev.example.com"},
+	}, diffs)
+
+	diffs, err = DiffManifestApplications(nil, after)
+	require.NoError(t, err)
+	assert.Contains(t, diffs, &ManifestFieldDiff{Field: "name", Before: "", After: "app"})
+}
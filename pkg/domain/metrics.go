@@ -3,6 +3,8 @@ package domain
 import (
 	"context"
 	"time"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 type AppMetric struct {
@@ -13,4 +15,7 @@ type AppMetric struct {
 type MetricsService interface {
 	AvailableNames() []string
 	Get(ctx context.Context, name string, app *Application, before time.Time, limit time.Duration) ([]*AppMetric, error)
+	// GetLimit returns the current upper bound of the metric of the app, such as the memory limit of the container.
+	// The returned value is not valid if the metric is not limited.
+	GetLimit(ctx context.Context, name string, app *Application) (optional.Of[float64], error)
 }
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type NotificationEvent int
+
+const (
+	NotificationEventBuildFailed NotificationEvent = iota
+	NotificationEventBuildSucceeded
+	NotificationEventDeployed
+	NotificationEventContainerErrored
+	NotificationEventCertificateError
+	NotificationEventAlert
+)
+
+func (e NotificationEvent) String() string {
+	switch e {
+	case NotificationEventBuildFailed:
+		return "build_failed"
+	case NotificationEventBuildSucceeded:
+		return "build_succeeded"
+	case NotificationEventDeployed:
+		return "deployed"
+	case NotificationEventContainerErrored:
+		return "container_errored"
+	case NotificationEventCertificateError:
+		return "certificate_error"
+	case NotificationEventAlert:
+		return "alert"
+	default:
+		return "unknown"
+	}
+}
+
+// Notification is a message sent to users about an application.
+type Notification struct {
+	ApplicationID string
+	Event         NotificationEvent
+	// UserIDs are the recipients of the notification.
+	UserIDs []string
+	Title   string
+	Message string
+	Time    time.Time
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// NewBuildNotification returns a notification if the build has succeeded or failed, or nil otherwise.
+func NewBuildNotification(app *Application, build *Build) *Notification {
+	n := &Notification{
+		ApplicationID: app.ID,
+		UserIDs:       app.OwnerIDs,
+		Message:       fmt.Sprintf("Build %s of commit %s finished with status %s", build.ID, build.Commit, build.Status),
+		Time:          time.Now(),
+	}
+	switch build.Status {
+	case BuildStatusSucceeded:
+		n.Event = NotificationEventBuildSucceeded
+		n.Title = "Build succeeded: " + app.Name
+	case BuildStatusFailed:
+		n.Event = NotificationEventBuildFailed
+		n.Title = "Build failed: " + app.Name
+	default:
+		return nil
+	}
+	return n
+}
+
+// NewContainerStateNotification returns a notification if the container has started running after a (re)deploy,
+// or has errored, compared to the application's current state. Otherwise, it returns nil.
+func NewContainerStateNotification(app *Application, container *Container) *Notification {
+	if container == nil || app.Container == container.State {
+		return nil
+	}
+	n := &Notification{
+		ApplicationID: app.ID,
+		UserIDs:       app.OwnerIDs,
+		Message:       container.Message,
+		Time:          time.Now(),
+	}
+	switch {
+	case container.State == ContainerStateRunning && (app.Container == ContainerStateMissing || app.Container == ContainerStateStarting):
+		n.Event = NotificationEventDeployed
+		n.Title = "Deploy completed: " + app.Name
+		if n.Message == "" {
+			n.Message = "The application is now running"
+		}
+	case container.State == ContainerStateErrored:
+		n.Event = NotificationEventContainerErrored
+		n.Title = "Container errored: " + app.Name
+	default:
+		return nil
+	}
+	return n
+}
+
+type NotificationSinkType int
+
+const (
+	// NotificationSinkWebhook posts notifications as JSON to the URL.
+	NotificationSinkWebhook NotificationSinkType = iota
+	// NotificationSinkSlack posts notifications to the Slack-compatible incoming webhook URL.
+	NotificationSinkSlack
+	// NotificationSinkTraQ posts notifications to the traQ webhook URL.
+	NotificationSinkTraQ
+	// NotificationSinkEmail sends notifications by email to the address.
+	NotificationSinkEmail
+)
+
+const (
+	notificationTargetMaxLength = 512
+	notificationSecretMaxLength = 256
+)
+
+// NotificationSubscription is a user's subscription to notifications of an application.
+type NotificationSubscription struct {
+	ID            string
+	ApplicationID string
+	UserID        string
+	Sink          NotificationSinkType
+	// Target is the webhook URL, or the email address for NotificationSinkEmail.
+	Target string
+	// Secret signs the payload for NotificationSinkWebhook and NotificationSinkTraQ, if not empty.
+	Secret    string
+	Events    []NotificationEvent
+	CreatedAt time.Time
+}
+
+func NewNotificationSubscription(applicationID, userID string, sink NotificationSinkType, target, secret string, events []NotificationEvent) *NotificationSubscription {
+	return &NotificationSubscription{
+		ID:            NewID(),
+		ApplicationID: applicationID,
+		UserID:        userID,
+		Sink:          sink,
+		Target:        target,
+		Secret:        secret,
+		Events:        lo.Uniq(events),
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (s *NotificationSubscription) Validate() error {
+	if len(s.Target) > notificationTargetMaxLength {
+		return oops.Errorf("target must be at most %d bytes", notificationTargetMaxLength)
+	}
+	if len(s.Secret) > notificationSecretMaxLength {
+		return oops.Errorf("secret must be at most %d bytes", notificationSecretMaxLength)
+	}
+	switch s.Sink {
+	case NotificationSinkWebhook, NotificationSinkSlack, NotificationSinkTraQ:
+		u, err := url.Parse(s.Target)
+		if err != nil {
+			return oops.Wrapf(err, "invalid webhook url")
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			return oops.New("webhook url must be an absolute https url")
+		}
+	case NotificationSinkEmail:
+		addr, err := mail.ParseAddress(s.Target)
+		if err != nil || addr.Address != s.Target {
+			return oops.New("invalid email address")
+		}
+	default:
+		return oops.Errorf("unknown sink type: %v", s.Sink)
+	}
+	if s.Secret != "" && s.Sink != NotificationSinkWebhook && s.Sink != NotificationSinkTraQ {
+		return oops.New("secret can only be set for webhook and traQ sinks")
+	}
+	if len(s.Events) == 0 {
+		return oops.New("at least one event is required")
+	}
+	for _, e := range s.Events {
+		if e < NotificationEventBuildFailed || e > NotificationEventAlert {
+			return oops.Errorf("unknown event: %v", e)
+		}
+	}
+	return nil
+}
+
+func (s *NotificationSubscription) Subscribes(n *Notification) bool {
+	return slices.Contains(s.Events, n.Event) && slices.Contains(n.UserIDs, s.UserID)
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBuildNotification(t *testing.T) {
+	app := &Application{ID: "app", Name: "my-app", OwnerIDs: []string{"owner"}}
+
+	n := NewBuildNotification(app, &Build{ID: "build", Commit: "abc", Status: BuildStatusFailed})
+	if assert.NotNil(t, n) {
+		assert.Equal(t, NotificationEventBuildFailed, n.Event)
+		assert.Equal(t, []string{"owner"}, n.UserIDs)
+	}
+	n = NewBuildNotification(app, &Build{ID: "build", Commit: "abc", Status: BuildStatusSucceeded})
+	if assert.NotNil(t, n) {
+		assert.Equal(t, NotificationEventBuildSucceeded, n.Event)
+	}
+	assert.Nil(t, NewBuildNotification(app, &Build{Status: BuildStatusCanceled}))
+	assert.Nil(t, NewBuildNotification(app, &Build{Status: BuildStatusSkipped}))
+}
+
+func TestNewContainerStateNotification(t *testing.T) {
+	tests := []struct {
+		name    string
+		current ContainerState
+		next    ContainerState
+		want    *NotificationEvent
+	}{
+		{"deployed", ContainerStateStarting, ContainerStateRunning, lo.ToPtr(NotificationEventDeployed)},
+		{"deployed from missing", ContainerStateMissing, ContainerStateRunning, lo.ToPtr(NotificationEventDeployed)},
+		{"restarted", ContainerStateRestarting, ContainerStateRunning, nil},
+		{"recovered from unknown", ContainerStateUnknown, ContainerStateRunning, nil},
+		{"still running", ContainerStateRunning, ContainerStateRunning, nil},
+		{"errored", ContainerStateRunning, ContainerStateErrored, lo.ToPtr(NotificationEventContainerErrored)},
+		{"still errored", ContainerStateErrored, ContainerStateErrored, nil},
+		{"exited", ContainerStateRunning, ContainerStateExited, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &Application{ID: "app", Container: tt.current}
+			n := NewContainerStateNotification(app, &Container{ApplicationID: "app", State: tt.next})
+			if tt.want == nil {
+				assert.Nil(t, n)
+			} else if assert.NotNil(t, n) {
+				assert.Equal(t, *tt.want, n.Event)
+			}
+		})
+	}
+}
+
+func TestNotificationSubscription_Validate(t *testing.T) {
+	events := []NotificationEvent{NotificationEventBuildFailed}
+	tests := []struct {
+		name    string
+		sub     *NotificationSubscription
+		wantErr bool
+	}{
+		{"webhook", &NotificationSubscription{Sink: NotificationSinkWebhook, Target: "https://example.com/hook", Secret: "s", Events: events}, false},
+		{"slack", &NotificationSubscription{Sink: NotificationSinkSlack, Target: "https://hooks.slack.com/services/x", Events: events}, false},
+		{"traq", &NotificationSubscription{Sink: NotificationSinkTraQ, Target: "https://q.trap.jp/api/v3/webhooks/x", Secret: "s", Events: events}, false},
+		{"email", &NotificationSubscription{Sink: NotificationSinkEmail, Target: "user@example.com", Events: events}, false},
+		{"http webhook", &NotificationSubscription{Sink: NotificationSinkWebhook, Target: "http://example.com/hook", Events: events}, true},
+		{"relative url", &NotificationSubscription{Sink: NotificationSinkSlack, Target: "/hook", Events: events}, true},
+		{"email with name", &NotificationSubscription{Sink: NotificationSinkEmail, Target: "User <user@example.com>", Events: events}, true},
+		{"slack with secret", &NotificationSubscription{Sink: NotificationSinkSlack, Target: "https://hooks.slack.com/services/x", Secret: "s", Events: events}, true},
+		{"no events", &NotificationSubscription{Sink: NotificationSinkEmail, Target: "user@example.com"}, true},
+		{"unknown event", &NotificationSubscription{Sink: NotificationSinkEmail, Target: "user@example.com", Events: []NotificationEvent{100}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sub.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/samber/oops"
+)
+
+// Quota is the limits of resources a user can consume through the applications they own.
+// A negative value means unlimited.
+type Quota struct {
+	MaxApplications        int `mapstructure:"maxApplications" yaml:"maxApplications"`
+	MaxRunningApplications int `mapstructure:"maxRunningApplications" yaml:"maxRunningApplications"`
+	MaxPortPublications    int `mapstructure:"maxPortPublications" yaml:"maxPortPublications"`
+	MaxDatabases           int `mapstructure:"maxDatabases" yaml:"maxDatabases"`
+}
+
+// UnlimitedQuota is the quota without any limits.
+var UnlimitedQuota = Quota{
+	MaxApplications:        -1,
+	MaxRunningApplications: -1,
+	MaxPortPublications:    -1,
+	MaxDatabases:           -1,
+}
+
+// UserQuota is the quota of a user set by admins, overriding the default quota.
+type UserQuota struct {
+	UserID string
+	Quota
+}
+
+// QuotaExceededError is returned if a change of an application makes one of its owners exceed their quota.
+type QuotaExceededError struct {
+	UserName string
+	Err      error
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded for user %s: %s", e.UserName, e.Err.Error())
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return e.Err
+}
+
+// ResourceUsage is the amount of resources consumed by a user.
+type ResourceUsage struct {
+	Applications        int
+	RunningApplications int
+	PortPublications    int
+	Databases           int
+}
+
+func (u ResourceUsage) add(app *Application, sign int) ResourceUsage {
+	u.Applications += sign
+	if app.Running {
+		u.RunningApplications += sign
+	}
+	u.PortPublications += sign * len(app.PortPublications)
+	if app.Config.BuildConfig.MariaDB() {
+		u.Databases += sign
+	}
+	if app.Config.BuildConfig.MongoDB() {
+		u.Databases += sign
+	}
+	return u
+}
+
+// CalculateUsage calculates the resource usage of the given applications.
+func CalculateUsage(apps []*Application) ResourceUsage {
+	var u ResourceUsage
+	for _, app := range apps {
+		u = u.add(app, 1)
+	}
+	return u
+}
+
+// Replace returns the usage with before replaced by after.
+// before is nil if the application is to be created.
+func (u ResourceUsage) Replace(before, after *Application) ResourceUsage {
+	if before != nil {
+		u = u.add(before, -1)
+	}
+	return u.add(after, 1)
+}
+
+// CheckUsage checks the usage after a change against the quota.
+//
+// Only resources increased by the change are checked, so that users exceeding a lowered quota
+// can still make changes that do not consume more resources.
+func (q Quota) CheckUsage(before, after ResourceUsage) error {
+	check := func(name string, limit, before, after int) error {
+		if limit < 0 || after <= before || after <= limit {
+			return nil
+		}
+		return oops.Errorf("%s would be %d, exceeding the limit of %d", name, after, limit)
+	}
+	if err := check("applications", q.MaxApplications, before.Applications, after.Applications); err != nil {
+		return err
+	}
+	if err := check("running applications", q.MaxRunningApplications, before.RunningApplications, after.RunningApplications); err != nil {
+		return err
+	}
+	if err := check("port publications", q.MaxPortPublications, before.PortPublications, after.PortPublications); err != nil {
+		return err
+	}
+	if err := check("databases", q.MaxDatabases, before.Databases, after.Databases); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateUsage(t *testing.T) {
+	apps := []*Application{
+		{
+			Running: true,
+			Config:  ApplicationConfig{BuildConfig: &BuildConfigRuntimeBuildpack{RuntimeConfig: RuntimeConfig{UseMariaDB: true, UseMongoDB: true}}},
+			PortPublications: []*PortPublication{
+				{InternetPort: 39000, ApplicationPort: 8080, Protocol: PortPublicationProtocolTCP},
+			},
+		},
+		{
+			Running: false,
+			Config:  ApplicationConfig{BuildConfig: &BuildConfigStaticCmd{StaticConfig: StaticConfig{ArtifactPath: "dist"}}},
+		},
+	}
+	assert.Equal(t, ResourceUsage{
+		Applications:        2,
+		RunningApplications: 1,
+		PortPublications:    1,
+		Databases:           2,
+	}, CalculateUsage(apps))
+}
+
+func TestResourceUsage_Replace(t *testing.T) {
+	before := &Application{
+		Running: false,
+		Config:  ApplicationConfig{BuildConfig: &BuildConfigRuntimeBuildpack{RuntimeConfig: RuntimeConfig{UseMariaDB: true}}},
+	}
+	after := &Application{
+		Running: true,
+		Config:  ApplicationConfig{BuildConfig: &BuildConfigRuntimeBuildpack{RuntimeConfig: RuntimeConfig{UseMariaDB: true}}},
+		PortPublications: []*PortPublication{
+			{InternetPort: 39000, ApplicationPort: 8080, Protocol: PortPublicationProtocolTCP},
+		},
+	}
+	usage := ResourceUsage{Applications: 1, Databases: 1}
+
+	assert.Equal(t, ResourceUsage{Applications: 1, RunningApplications: 1, PortPublications: 1, Databases: 1}, usage.Replace(before, after))
+	assert.Equal(t, ResourceUsage{Applications: 2, RunningApplications: 1, PortPublications: 1, Databases: 2}, usage.Replace(nil, after))
+}
+
+func TestQuota_CheckUsage(t *testing.T) {
+	quota := Quota{
+		MaxApplications:        2,
+		MaxRunningApplications: 1,
+		MaxPortPublications:    -1,
+		MaxDatabases:           0,
+	}
+	tests := []struct {
+		name    string
+		before  ResourceUsage
+		after   ResourceUsage
+		wantErr bool
+	}{
+		{
+			name:    "within limits",
+			before:  ResourceUsage{Applications: 1},
+			after:   ResourceUsage{Applications: 2, RunningApplications: 1},
+			wantErr: false,
+		},
+		{
+			name:    "too many applications",
+			before:  ResourceUsage{Applications: 2},
+			after:   ResourceUsage{Applications: 3},
+			wantErr: true,
+		},
+		{
+			name:    "too many running applications",
+			before:  ResourceUsage{Applications: 2, RunningApplications: 1},
+			after:   ResourceUsage{Applications: 2, RunningApplications: 2},
+			wantErr: true,
+		},
+		{
+			name:    "unlimited port publications",
+			before:  ResourceUsage{PortPublications: 100},
+			after:   ResourceUsage{PortPublications: 200},
+			wantErr: false,
+		},
+		{
+			name:    "databases not allowed",
+			before:  ResourceUsage{},
+			after:   ResourceUsage{Applications: 1, Databases: 1},
+			wantErr: true,
+		},
+		{
+			name:    "already exceeding but not increasing",
+			before:  ResourceUsage{Applications: 5, RunningApplications: 3},
+			after:   ResourceUsage{Applications: 5, RunningApplications: 2},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := quota.CheckUsage(tt.before, tt.after)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -24,9 +24,12 @@ type UpdateApplicationArgs struct {
 	ContainerMessage optional.Of[string]
 	CurrentBuild     optional.Of[string]
 	UpdatedAt        optional.Of[time.Time]
+	ConfigFilePath   optional.Of[string]
+	ConfigFileError  optional.Of[string]
 	Config           optional.Of[ApplicationConfig]
 	Websites         optional.Of[[]*Website]
 	PortPublications optional.Of[[]*PortPublication]
+	InternalService  optional.Of[InternalService]
 	OwnerIDs         optional.Of[[]string]
 }
 
@@ -55,6 +58,12 @@ func (a *Application) Apply(args *UpdateApplicationArgs) {
 	if args.UpdatedAt.Valid {
 		a.UpdatedAt = args.UpdatedAt.V
 	}
+	if args.ConfigFilePath.Valid {
+		a.ConfigFilePath = args.ConfigFilePath.V
+	}
+	if args.ConfigFileError.Valid {
+		a.ConfigFileError = args.ConfigFileError.V
+	}
 	if args.Config.Valid {
 		a.DeployType = args.Config.V.BuildConfig.BuildType().DeployType()
 		a.Config = args.Config.V
@@ -65,16 +74,31 @@ func (a *Application) Apply(args *UpdateApplicationArgs) {
 	if args.PortPublications.Valid {
 		a.PortPublications = args.PortPublications.V
 	}
+	if args.InternalService.Valid {
+		a.InternalService = args.InternalService.V
+	}
 	if args.OwnerIDs.Valid {
 		a.OwnerIDs = args.OwnerIDs.V
 	}
 }
 
+// ApplicationChange is the creation or update of an application, applied by ApplicationRepository.ApplyChanges.
+type ApplicationChange struct {
+	// Create is the application to create. If nil, the application of ID is updated with Args.
+	Create *Application
+	ID     string
+	Args   *UpdateApplicationArgs
+	// Envs are the env vars of the application to set.
+	Envs []*Environment
+}
+
 type ApplicationRepository interface {
 	GetApplications(ctx context.Context, cond GetApplicationCondition) ([]*Application, error)
 	GetApplication(ctx context.Context, id string) (*Application, error)
 	CreateApplication(ctx context.Context, app *Application) error
 	UpdateApplication(ctx context.Context, id string, args *UpdateApplicationArgs) error
+	// ApplyChanges applies all the changes in a single transaction.
+	ApplyChanges(ctx context.Context, changes []*ApplicationChange) error
 	BulkUpdateState(ctx context.Context, states []*Container) error
 	DeleteApplication(ctx context.Context, id string) error
 }
@@ -148,6 +172,110 @@ type EnvironmentRepository interface {
 	DeleteEnv(ctx context.Context, cond GetEnvCondition) error
 }
 
+type GetApplicationEventCondition struct {
+	ApplicationID string
+	// Before returns events created before the time, in descending order.
+	Before optional.Of[time.Time]
+	// After returns events created after the time, in ascending order.
+	After optional.Of[time.Time]
+	Limit optional.Of[int]
+}
+
+type ApplicationEventRepository interface {
+	GetEvents(ctx context.Context, cond GetApplicationEventCondition) ([]*ApplicationEvent, error)
+	CreateEvents(ctx context.Context, events []*ApplicationEvent) error
+	DeleteEvents(ctx context.Context, applicationID string) error
+}
+
+type WebsiteProbeRepository interface {
+	CreateProbes(ctx context.Context, probes []*WebsiteProbe) error
+	// GetLatestProbes returns the latest probe of each website.
+	GetLatestProbes(ctx context.Context, websiteIDs []string) ([]*WebsiteProbe, error)
+	// CountProbes counts probes of each website checked at or after since.
+	CountProbes(ctx context.Context, websiteIDs []string, since time.Time) ([]*WebsiteProbeCount, error)
+	// DeleteProbes deletes probes checked before the time.
+	DeleteProbes(ctx context.Context, before time.Time) error
+	DeleteApplicationProbes(ctx context.Context, applicationID string) error
+}
+
+type GetAlertRuleCondition struct {
+	ID            optional.Of[string]
+	ApplicationID optional.Of[string]
+}
+
+type GetAlertCondition struct {
+	ApplicationID optional.Of[string]
+	RuleID        optional.Of[string]
+	// Firing returns only alerts which are firing (true) or resolved (false).
+	Firing optional.Of[bool]
+	// Limit returns the latest alerts up to the number.
+	Limit optional.Of[int]
+}
+
+type AlertRepository interface {
+	GetAlertRules(ctx context.Context, cond GetAlertRuleCondition) ([]*AlertRule, error)
+	CreateAlertRule(ctx context.Context, rule *AlertRule) error
+	// DeleteAlertRule deletes the rule along with its alerts.
+	DeleteAlertRule(ctx context.Context, id string) error
+	// GetAlerts returns alerts in the descending order of the fired time.
+	GetAlerts(ctx context.Context, cond GetAlertCondition) ([]*Alert, error)
+	CreateAlert(ctx context.Context, alert *Alert) error
+	ResolveAlert(ctx context.Context, id string, resolvedAt time.Time) error
+	// DeleteApplicationAlerts deletes all alerts and rules of the application.
+	DeleteApplicationAlerts(ctx context.Context, applicationID string) error
+}
+
+type GetNotificationSubscriptionCondition struct {
+	ID            optional.Of[string]
+	ApplicationID optional.Of[string]
+	UserID        optional.Of[string]
+}
+
+type NotificationSubscriptionRepository interface {
+	GetSubscriptions(ctx context.Context, cond GetNotificationSubscriptionCondition) ([]*NotificationSubscription, error)
+	CreateSubscription(ctx context.Context, sub *NotificationSubscription) error
+	DeleteSubscription(ctx context.Context, id string) error
+	DeleteApplicationSubscriptions(ctx context.Context, applicationID string) error
+}
+
+type GetCustomDomainCondition struct {
+	ID       optional.Of[string]
+	UserID   optional.Of[string]
+	UserIDIn optional.Of[[]string]
+	Verified optional.Of[bool]
+}
+
+type UpdateCustomDomainArgs struct {
+	Verified   optional.Of[bool]
+	VerifiedAt optional.Of[time.Time]
+	CheckedAt  optional.Of[time.Time]
+	Error      optional.Of[string]
+}
+
+type CustomDomainRepository interface {
+	GetCustomDomains(ctx context.Context, cond GetCustomDomainCondition) ([]*CustomDomain, error)
+	CreateCustomDomain(ctx context.Context, d *CustomDomain) error
+	UpdateCustomDomain(ctx context.Context, id string, args *UpdateCustomDomainArgs) error
+	DeleteCustomDomain(ctx context.Context, id string) error
+}
+
+type GetTLSCertificateCondition struct {
+	ID     optional.Of[string]
+	UserID optional.Of[string]
+	FQDN   optional.Of[string]
+}
+
+type TLSCertificateRepository interface {
+	// GetTLSCertificates returns the certificates without their private keys.
+	GetTLSCertificates(ctx context.Context, cond GetTLSCertificateCondition) ([]*TLSCertificate, error)
+	// LoadTLSCertificatePrivateKey decrypts the private key of the certificate into c.PrivateKey.
+	LoadTLSCertificatePrivateKey(ctx context.Context, c *TLSCertificate) error
+	CreateTLSCertificate(ctx context.Context, c *TLSCertificate) error
+	// UpdateTLSCertificate replaces the certificate and the private key of the existing certificate with c.ID.
+	UpdateTLSCertificate(ctx context.Context, c *TLSCertificate) error
+	DeleteTLSCertificate(ctx context.Context, id string) error
+}
+
 type GetRepositoryCondition struct {
 	IDs                optional.Of[[]string]
 	URLs               optional.Of[[]string]
@@ -203,6 +331,10 @@ type GetUserKeyCondition struct {
 	UserIDs optional.Of[[]string]
 }
 
+type GetUserQuotaCondition struct {
+	UserIDs optional.Of[[]string]
+}
+
 type UserRepository interface {
 	EnsureUser(ctx context.Context, name string) (*User, error)
 	EnsureUsers(ctx context.Context, names []string) ([]*User, error)
@@ -210,4 +342,7 @@ type UserRepository interface {
 	GetUserKeys(ctx context.Context, cond GetUserKeyCondition) ([]*UserKey, error)
 	CreateUserKey(ctx context.Context, key *UserKey) error
 	DeleteUserKey(ctx context.Context, keyID string, userID string) error
+	GetUserQuotas(ctx context.Context, cond GetUserQuotaCondition) ([]*UserQuota, error)
+	SetUserQuota(ctx context.Context, quota *UserQuota) error
+	DeleteUserQuota(ctx context.Context, userID string) error
 }
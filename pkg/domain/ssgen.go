@@ -21,7 +21,7 @@ type StaticSite struct {
 	Application *Application
 	Website     *Website
 	ArtifactID  string
-	SPA         bool
+	Config      StaticConfig
 }
 
 func GetActiveStaticSites(
@@ -65,7 +65,7 @@ func GetActiveStaticSites(
 				Application: app,
 				Website:     website,
 				ArtifactID:  artifact.ID,
-				SPA:         app.Config.BuildConfig.GetStaticConfig().SPA,
+				Config:      app.Config.BuildConfig.GetStaticConfig(),
 			})
 		}
 	}
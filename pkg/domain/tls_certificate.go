@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// tlsCertificateExpiryWarning is how long before its expiry an uploaded certificate is reported as expiring.
+// Uploaded certificates are not renewed automatically, so owners are warned well in advance.
+const tlsCertificateExpiryWarning = 30 * 24 * time.Hour
+
+// TLSCertificateEncryptionKey is the key to encrypt the private keys of uploaded TLS certificates at rest.
+// Uploading certificates is not available if empty.
+type TLSCertificateEncryptionKey []byte
+
+// TLSCertificate is a certificate uploaded by a user, for domains which certificates cannot be issued for by ACME.
+// It is served for the websites of FQDN instead of the ones issued by cert-manager or traefik.
+// FQDN may be a wildcard domain such as "*.example.com", covering the direct subdomains.
+type TLSCertificate struct {
+	ID     string
+	UserID string
+	FQDN   string
+	// Certificate is the PEM encoded certificate chain, leaf certificate first.
+	Certificate string
+	// PrivateKey is the PEM encoded private key of the leaf certificate.
+	// Empty when listed, until loaded by TLSCertificateRepository.LoadTLSCertificatePrivateKey.
+	PrivateKey string
+	NotBefore  time.Time
+	NotAfter   time.Time
+	CreatedAt  time.Time
+}
+
+// NewTLSCertificate parses the PEM encoded certificate chain and private key,
+// and checks if the certificate is valid for fqdn at now.
+func NewTLSCertificate(userID, fqdn, certPEM, keyPEM string, now time.Time) (*TLSCertificate, error) {
+	fqdn = strings.ToLower(fqdn)
+	if err := ValidatePossibleWildcardDomain(fqdn); err != nil {
+		return nil, err
+	}
+	pair, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid certificate or private key")
+	}
+	leaf := pair.Leaf
+	if leaf == nil {
+		leaf, err = x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return nil, oops.Wrapf(err, "parsing certificate")
+		}
+	}
+	if !certificateCovers(leaf, fqdn) {
+		return nil, oops.Errorf("certificate is not valid for %v (got %v)", fqdn, strings.Join(leaf.DNSNames, ", "))
+	}
+	if !now.Before(leaf.NotAfter) {
+		return nil, oops.Errorf("certificate has expired at %v", leaf.NotAfter.Format(time.RFC3339))
+	}
+	return &TLSCertificate{
+		ID:          NewID(),
+		UserID:      userID,
+		FQDN:        fqdn,
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+		NotBefore:   leaf.NotBefore,
+		NotAfter:    leaf.NotAfter,
+		CreatedAt:   now,
+	}, nil
+}
+
+// certificateCovers returns true if the leaf certificate is valid for fqdn.
+func certificateCovers(leaf *x509.Certificate, fqdn string) bool {
+	if strings.HasPrefix(fqdn, "*.") {
+		return lo.ContainsBy(leaf.DNSNames, func(name string) bool { return strings.ToLower(name) == fqdn })
+	}
+	return leaf.VerifyHostname(fqdn) == nil
+}
+
+// IsWildcard returns true if the certificate is for a wildcard domain.
+func (c *TLSCertificate) IsWildcard() bool {
+	return strings.HasPrefix(c.FQDN, "*.")
+}
+
+// Matches returns true if the certificate is served for the website FQDN.
+// Wildcard certificates only match the direct subdomains, as in TLS.
+func (c *TLSCertificate) Matches(fqdn string) bool {
+	if !c.IsWildcard() {
+		return c.FQDN == fqdn
+	}
+	_, parent, ok := strings.Cut(fqdn, ".")
+	return ok && parent == strings.TrimPrefix(c.FQDN, "*.")
+}
+
+// Expired returns true if the certificate is no longer valid at now.
+func (c *TLSCertificate) Expired(now time.Time) bool {
+	return !now.Before(c.NotAfter)
+}
+
+// ExpiresSoon returns true if the certificate expires within the warning period at now, or has already expired.
+func (c *TLSCertificate) ExpiresSoon(now time.Time) bool {
+	return c.NotAfter.Sub(now) < tlsCertificateExpiryWarning
+}
+
+// CanManage returns true if the user can replace or delete the certificate.
+func (c *TLSCertificate) CanManage(user *User) bool {
+	return user.Admin || c.UserID == user.ID
+}
+
+type TLSCertificateSlice []*TLSCertificate
+
+// Find returns the certificate served for the website FQDN.
+// A certificate for the exact FQDN takes precedence over a wildcard certificate.
+func (s TLSCertificateSlice) Find(fqdn string) (*TLSCertificate, bool) {
+	if c, ok := lo.Find(s, func(c *TLSCertificate) bool { return !c.IsWildcard() && c.Matches(fqdn) }); ok {
+		return c, true
+	}
+	return lo.Find(s, func(c *TLSCertificate) bool { return c.IsWildcard() && c.Matches(fqdn) })
+}
+
+// Covers returns true if an uploaded certificate is served for the website,
+// in which case no certificate has to be issued by ACME.
+func (s TLSCertificateSlice) Covers(website *Website) bool {
+	if !website.HTTPS {
+		return false
+	}
+	_, ok := s.Find(website.FQDN)
+	return ok
+}
+
+// ExpiryNotifications returns notifications to the owners of the applications
+// which websites are served the uploaded certificates expiring soon at now.
+func (s TLSCertificateSlice) ExpiryNotifications(apps []*Application, now time.Time) []*Notification {
+	var notifications []*Notification
+	for _, app := range apps {
+		for _, website := range app.Websites {
+			if !website.HTTPS {
+				continue
+			}
+			c, ok := s.Find(website.FQDN)
+			if !ok || !c.ExpiresSoon(now) {
+				continue
+			}
+			notifications = append(notifications, &Notification{
+				ApplicationID: app.ID,
+				Event:         NotificationEventCertificateError,
+				UserIDs:       app.OwnerIDs,
+				Title:         "Uploaded certificate expiring: " + website.FQDN,
+				Message:       fmt.Sprintf("%s: the uploaded TLS certificate for %s expires at %s, upload a renewed one", website.URL(), c.FQDN, c.NotAfter.Format(time.RFC3339)),
+				Time:          now,
+			})
+		}
+	}
+	return notifications
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateTestCertificate(t *testing.T, dnsNames []string, notBefore, notAfter time.Time) (certPEM, keyPEM string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return
+}
+
+func TestNewTLSCertificate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	validFrom := now.Add(-24 * time.Hour)
+	validUntil := now.Add(90 * 24 * time.Hour)
+	exactCert, exactKey := generateTestCertificate(t, []string{"app.example.dev"}, validFrom, validUntil)
+	wildcardCert, wildcardKey := generateTestCertificate(t, []string{"*.example.dev"}, validFrom, validUntil)
+	expiredCert, expiredKey := generateTestCertificate(t, []string{"app.example.dev"}, validFrom, now.Add(-time.Hour))
+
+	tests := []struct {
+		name    string
+		fqdn    string
+		cert    string
+		key     string
+		wantErr bool
+	}{
+		{"ok", "app.example.dev", exactCert, exactKey, false},
+		{"upper case", "App.Example.Dev", exactCert, exactKey, false},
+		{"wildcard", "*.example.dev", wildcardCert, wildcardKey, false},
+		{"subdomain of wildcard", "app.example.dev", wildcardCert, wildcardKey, false},
+		{"wrong host", "other.example.dev", exactCert, exactKey, true},
+		{"wildcard for exact cert", "*.example.dev", exactCert, exactKey, true},
+		{"expired", "app.example.dev", expiredCert, expiredKey, true},
+		{"key mismatch", "app.example.dev", exactCert, wildcardKey, true},
+		{"invalid pem", "app.example.dev", "invalid", exactKey, true},
+		{"invalid domain", "example..dev", exactCert, exactKey, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewTLSCertificate("user", tt.fqdn, tt.cert, tt.key, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, validFrom, c.NotBefore.UTC())
+			assert.Equal(t, validUntil, c.NotAfter.UTC())
+		})
+	}
+}
+
+func TestTLSCertificate_Matches(t *testing.T) {
+	exact := &TLSCertificate{FQDN: "app.example.dev"}
+	wildcard := &TLSCertificate{FQDN: "*.example.dev"}
+
+	assert.True(t, exact.Matches("app.example.dev"))
+	assert.False(t, exact.Matches("foo.app.example.dev"))
+	assert.True(t, wildcard.Matches("app.example.dev"))
+	assert.False(t, wildcard.Matches("example.dev"))
+	assert.False(t, wildcard.Matches("foo.app.example.dev"))
+}
+
+func TestTLSCertificateSlice_Find(t *testing.T) {
+	exact := &TLSCertificate{ID: "exact", FQDN: "app.example.dev"}
+	wildcard := &TLSCertificate{ID: "wildcard", FQDN: "*.example.dev"}
+	certs := TLSCertificateSlice{wildcard, exact}
+
+	c, ok := certs.Find("app.example.dev")
+	require.True(t, ok)
+	assert.Equal(t, "exact", c.ID)
+	c, ok = certs.Find("other.example.dev")
+	require.True(t, ok)
+	assert.Equal(t, "wildcard", c.ID)
+	_, ok = certs.Find("example.com")
+	assert.False(t, ok)
+
+	assert.True(t, certs.Covers(&Website{FQDN: "app.example.dev", HTTPS: true}))
+	assert.False(t, certs.Covers(&Website{FQDN: "app.example.dev", HTTPS: false}))
+}
+
+func TestTLSCertificateSlice_ExpiryNotifications(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	certs := TLSCertificateSlice{
+		{FQDN: "app.example.dev", NotAfter: now.Add(90 * 24 * time.Hour)},
+		{FQDN: "*.example.dev", NotAfter: now.Add(7 * 24 * time.Hour)},
+	}
+	apps := []*Application{
+		{
+			ID:       "app1",
+			OwnerIDs: []string{"user"},
+			Websites: []*Website{
+				// Served the exact certificate, not expiring
+				{FQDN: "app.example.dev", PathPrefix: "/", HTTPS: true},
+				{FQDN: "other.example.dev", PathPrefix: "/", HTTPS: true},
+				{FQDN: "plain.example.dev", PathPrefix: "/", HTTPS: false},
+			},
+		},
+		{
+			ID:       "app2",
+			Websites: []*Website{{FQDN: "app2.trap.show", PathPrefix: "/", HTTPS: true}},
+		},
+	}
+
+	notifications := certs.ExpiryNotifications(apps, now)
+	require.Len(t, notifications, 1)
+	n := notifications[0]
+	assert.Equal(t, "app1", n.ApplicationID)
+	assert.Equal(t, NotificationEventCertificateError, n.Event)
+	assert.Equal(t, []string{"user"}, n.UserIDs)
+	assert.Contains(t, n.Title, "other.example.dev")
+
+	assert.True(t, certs[1].ExpiresSoon(now))
+	assert.False(t, certs[1].Expired(now))
+	assert.False(t, certs[0].ExpiresSoon(now))
+}
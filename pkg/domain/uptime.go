@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// WebsiteProbe is the result of probing a website from the controller.
+type WebsiteProbe struct {
+	ID            string
+	WebsiteID     string
+	ApplicationID string
+	CheckedAt     time.Time
+	Up            bool
+	// StatusCode is 0 if no response was received.
+	StatusCode   int
+	Latency      time.Duration
+	TLSExpiresAt optional.Of[time.Time]
+	Error        string
+}
+
+// certificateExpiryWarning is how long before its expiry a certificate is regarded as a problem.
+const certificateExpiryWarning = 7 * 24 * time.Hour
+
+// CertificateProblem returns a description of the problem with the TLS certificate found by the probe,
+// or an empty string if there is none.
+func (p *WebsiteProbe) CertificateProblem() string {
+	if p.TLSExpiresAt.Valid && p.TLSExpiresAt.V.Sub(p.CheckedAt) < certificateExpiryWarning {
+		return "TLS certificate expires at " + p.TLSExpiresAt.V.Format(time.RFC3339)
+	}
+	// Errors from crypto/tls and crypto/x509 are prefixed by the package names
+	if strings.Contains(p.Error, "tls: ") || strings.Contains(p.Error, "x509: ") {
+		return p.Error
+	}
+	return ""
+}
+
+// URL returns the URL of the website to be probed.
+func (w *Website) URL() string {
+	scheme := "http"
+	if w.HTTPS {
+		scheme = "https"
+	}
+	return scheme + "://" + w.FQDN + w.PathPrefix
+}
+
+// IsWebsiteUp returns whether the website is considered up by the status code of the response.
+// Redirects and client errors (e.g. to the login page of sites with authentication) are considered up.
+func IsWebsiteUp(statusCode int) bool {
+	return 100 <= statusCode && statusCode < 500
+}
+
+// WebsiteUptimeWindows are the time windows to calculate uptimes for.
+var WebsiteUptimeWindows = []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour}
+
+// WebsiteProbeCount is the number of probes of a website within a time window.
+type WebsiteProbeCount struct {
+	WebsiteID string
+	Total     int
+	Up        int
+}
+
+type WebsiteUptime struct {
+	Window time.Duration
+	Total  int
+	Up     int
+}
+
+// Ratio returns the ratio of successful probes, or not valid if there are no probes.
+func (u *WebsiteUptime) Ratio() optional.Of[float64] {
+	if u.Total == 0 {
+		return optional.None[float64]()
+	}
+	return optional.From(float64(u.Up) / float64(u.Total))
+}
+
+type WebsiteStatus struct {
+	ApplicationID string
+	Website       *Website
+	// Latest is nil if the website has not been probed yet.
+	Latest  *WebsiteProbe
+	Uptimes []*WebsiteUptime
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestWebsiteProbe_CertificateProblem(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		probe   *WebsiteProbe
+		problem bool
+	}{
+		{"http", &WebsiteProbe{CheckedAt: now, Up: true, StatusCode: 200}, false},
+		{"valid", &WebsiteProbe{CheckedAt: now, Up: true, StatusCode: 200, TLSExpiresAt: optional.From(now.Add(30 * 24 * time.Hour))}, false},
+		{"expiring", &WebsiteProbe{CheckedAt: now, Up: true, StatusCode: 200, TLSExpiresAt: optional.From(now.Add(3 * 24 * time.Hour))}, true},
+		{"expired", &WebsiteProbe{CheckedAt: now, Error: `Get "https://example.com/": tls: failed to verify certificate: x509: certificate has expired or is not yet valid`}, true},
+		{"other error", &WebsiteProbe{CheckedAt: now, Error: `Get "https://example.com/": dial tcp: connection refused`}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.problem, tt.probe.CertificateProblem() != "")
+		})
+	}
+}
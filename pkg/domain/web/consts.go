@@ -1,7 +1,8 @@
 package web
 
 const (
-	HeaderNameSSGenAppID = "X-Controller-App-Id"
+	HeaderNameSSGenAppID     = "X-Controller-App-Id"
+	HeaderNameSSGenWebsiteID = "X-Controller-Website-Id"
 )
 
 const (
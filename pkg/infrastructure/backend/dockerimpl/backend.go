@@ -30,6 +30,7 @@ const (
 const (
 	traefikRuntimeFilename = "apps.yaml"
 	traefikSSFilename      = "ss.yaml"
+	traefikTLSFilename     = "tls.yaml"
 	traefikSSServiceName   = "ss"
 )
 
@@ -201,12 +202,36 @@ func traefikName(website *domain.Website) string {
 	return fmt.Sprintf("nsapp-%s", website.ID)
 }
 
+func splitServiceName(website *domain.Website, backendAppID string) string {
+	return fmt.Sprintf("%s-%s", traefikName(website), backendAppID)
+}
+
 func stripMiddlewareName(website *domain.Website) string {
 	return traefikName(website) + "-strip"
 }
 
+func headerMiddlewareName(website *domain.Website) string {
+	return traefikName(website) + "-headers"
+}
+
+func ipAllowListMiddlewareName(website *domain.Website) string {
+	return traefikName(website) + "-ip-allowlist"
+}
+
+func rateLimitMiddlewareName(website *domain.Website) string {
+	return traefikName(website) + "-rate-limit"
+}
+
+func basicAuthMiddlewareName(website *domain.Website) string {
+	return traefikName(website) + "-basic-auth"
+}
+
+func ruleMiddlewareName(website *domain.Website, index int) string {
+	return fmt.Sprintf("%s-rule-%d", traefikName(website), index)
+}
+
 func ssHeaderMiddlewareName(ss *domain.StaticSite) string {
-	return fmt.Sprintf("nsapp-ss-header-%s", ss.Application.ID)
+	return fmt.Sprintf("nsapp-ss-header-%s", ss.Website.ID)
 }
 
 func sablierMiddlewareName(app *domain.Application) string {
@@ -21,7 +21,7 @@ type (
 	a []any
 )
 
-func (b *Backend) routerBase(app *domain.Application, website *domain.Website, svcName string) (router m, middlewares m) {
+func (b *Backend) routerBase(app *domain.Application, website *domain.Website, svcName string, certs domain.TLSCertificateSlice) (router m, middlewares m) {
 	middlewares = make(m)
 
 	var entrypoints []string
@@ -32,6 +32,37 @@ func (b *Backend) routerBase(app *domain.Application, website *domain.Website, s
 	}
 
 	var middlewareNames []string
+	if ac := website.AccessControl; len(ac.IPAllowList) > 0 {
+		middlewareName := ipAllowListMiddlewareName(website)
+		middlewareNames = append(middlewareNames, middlewareName)
+		middlewares[middlewareName] = m{
+			"ipAllowList": m{
+				"sourceRange": ac.IPAllowList,
+			},
+		}
+	}
+	// Static sites are rate limited in the static server
+	if app.DeployType == domain.DeployTypeRuntime && website.RateLimit.Enabled {
+		middlewareName := rateLimitMiddlewareName(website)
+		middlewareNames = append(middlewareNames, middlewareName)
+		middlewares[middlewareName] = rateLimitMiddleware(&website.RateLimit)
+	}
+	// Placed before the authentication, so that CORS preflight requests without credentials are answered
+	if app.DeployType == domain.DeployTypeRuntime && !website.HeaderPolicy.IsEmpty() {
+		middlewareName := headerMiddlewareName(website)
+		middlewareNames = append(middlewareNames, middlewareName)
+		middlewares[middlewareName] = headerMiddleware(&website.HeaderPolicy)
+	}
+	if ac := website.AccessControl; len(ac.BasicAuthUsers) > 0 {
+		middlewareName := basicAuthMiddlewareName(website)
+		middlewareNames = append(middlewareNames, middlewareName)
+		middlewares[middlewareName] = m{
+			"basicAuth": m{
+				"users": ac.Htpasswd(),
+			},
+		}
+	}
+
 	authConfig := b.targetAuth(website.FQDN)
 	if authConfig != nil {
 		switch website.Authentication {
@@ -66,6 +97,15 @@ func (b *Backend) routerBase(app *domain.Application, website *domain.Website, s
 		}
 	}
 
+	// Static sites apply the rules in the static server
+	if app.DeployType == domain.DeployTypeRuntime {
+		for i, r := range website.Rules {
+			middlewareName := ruleMiddlewareName(website, i)
+			middlewareNames = append(middlewareNames, middlewareName)
+			middlewares[middlewareName] = ruleMiddleware(website, r)
+		}
+	}
+
 	priorityOffset := b.config.Routing.Traefik.PriorityOffset
 	priority := len(rule) + priorityOffset
 
@@ -77,7 +117,10 @@ func (b *Backend) routerBase(app *domain.Application, website *domain.Website, s
 		"service":     svcName,
 	}
 
-	if website.HTTPS {
+	if certs.Covers(website) {
+		// Uploaded certificates are selected from the TLS store by SNI, without the resolver
+		router["tls"] = m{}
+	} else if website.HTTPS {
 		targetDomain := b.config.TLS.Wildcard.Domains.TLSTargetDomain(website)
 		router["tls"] = m{
 			"certResolver": b.config.TLS.CertResolver,
@@ -90,6 +133,57 @@ func (b *Backend) routerBase(app *domain.Application, website *domain.Website, s
 	return router, middlewares
 }
 
+func ruleMiddleware(website *domain.Website, r *domain.WebsiteRule) m {
+	switch r.Type {
+	case domain.WebsiteRuleTypeRedirect:
+		regex, replacement := r.URLRegex(website)
+		return m{
+			"redirectRegex": m{
+				"regex":       regex,
+				"replacement": replacement,
+				"permanent":   r.Permanent(),
+			},
+		}
+	case domain.WebsiteRuleTypeRewrite:
+		return m{
+			"replacePathRegex": m{
+				"regex":       r.AnchoredPathRegex(),
+				"replacement": r.Target,
+			},
+		}
+	default:
+		panic(fmt.Sprintf("unknown website rule type: %v", r.Type))
+	}
+}
+
+func headerMiddleware(policy *domain.WebsiteHeaderPolicy) m {
+	headers := m{}
+	if len(policy.ResponseHeaders) > 0 {
+		headers["customResponseHeaders"] = policy.ResponseHeaderMap()
+	}
+	if cors := policy.CORS; cors.Enabled() {
+		headers["accessControlAllowOriginList"] = cors.AllowOrigins
+		headers["accessControlAllowMethods"] = cors.AllowMethods
+		headers["accessControlAllowHeaders"] = cors.AllowHeaders
+		headers["accessControlExposeHeaders"] = cors.ExposeHeaders
+		headers["accessControlAllowCredentials"] = cors.AllowCredentials
+		headers["accessControlMaxAge"] = cors.MaxAge
+		headers["addVaryHeader"] = !cors.AllowsAnyOrigin()
+	}
+	return m{"headers": headers}
+}
+
+func rateLimitMiddleware(rl *domain.WebsiteRateLimit) m {
+	rateLimit := m{
+		"average": rl.Average,
+		"burst":   rl.Burst,
+	}
+	if rl.SourceHeader != "" {
+		rateLimit["sourceCriterion"] = m{"requestHeaderName": rl.SourceHeader}
+	}
+	return m{"rateLimit": rateLimit}
+}
+
 type runtimeConfigBuilder struct {
 	routers     m
 	middlewares m
@@ -104,28 +198,47 @@ func newRuntimeConfigBuilder() *runtimeConfigBuilder {
 	}
 }
 
-func (b *runtimeConfigBuilder) addWebsite(backend *Backend, app *domain.Application, website *domain.Website) {
+func (b *runtimeConfigBuilder) addWebsite(backend *Backend, app *domain.Application, website *domain.Website, certs domain.TLSCertificateSlice) {
 	svcName := traefikName(website)
 
-	router, middlewares := backend.routerBase(app, website, svcName)
+	router, middlewares := backend.routerBase(app, website, svcName, certs)
+
+	b.routers[svcName] = router
+	maps.Copy(b.middlewares, middlewares)
+	if !website.IsSplit() {
+		b.services[svcName] = loadBalancerService(website, app.ID)
+		return
+	}
 
-	netName := networkName(app.ID)
-	svc := m{
+	// Traefik balances the services by weighted round-robin
+	weighted := make(a, 0, len(website.Backends))
+	for _, backend := range website.Backends {
+		backendSvcName := splitServiceName(website, backend.ApplicationID)
+		b.services[backendSvcName] = loadBalancerService(website, backend.ApplicationID)
+		weighted = append(weighted, m{"name": backendSvcName, "weight": backend.Weight})
+	}
+	b.services[svcName] = m{
+		"weighted": m{
+			"services": weighted,
+		},
+	}
+}
+
+// loadBalancerService returns the service to the application on the port of the website.
+// The backend applications of a split website serve the same port, see domain.Application.ServesPortOf.
+func loadBalancerService(website *domain.Website, appID string) m {
+	return m{
 		"loadBalancer": m{
 			"servers": a{
 				m{"url": fmt.Sprintf(
 					"%s://%s:%d/",
 					lo.Ternary(website.H2C, "h2c", "http"),
-					netName,
+					networkName(appID),
 					website.HTTPPort,
 				)},
 			},
 		},
 	}
-
-	b.routers[svcName] = router
-	maps.Copy(b.middlewares, middlewares)
-	b.services[svcName] = svc
 }
 
 func (b *runtimeConfigBuilder) build() m {
@@ -149,8 +262,37 @@ func (b *runtimeConfigBuilder) build() m {
 	}
 }
 
+// tlsCertificatesConfig returns the TLS store config holding the uploaded certificates.
+func tlsCertificatesConfig(certs domain.TLSCertificateSlice) m {
+	if len(certs) == 0 {
+		return nil
+	}
+	certificates := make(a, 0, len(certs))
+	for _, c := range certs {
+		// Traefik accepts the PEM contents in place of the file paths
+		certificates = append(certificates, m{
+			"certFile": c.Certificate,
+			"keyFile":  c.PrivateKey,
+		})
+	}
+	return m{
+		"tls": m{
+			"certificates": certificates,
+		},
+	}
+}
+
 func (b *Backend) writeConfig(filename string, config any) error {
-	file, err := os.OpenFile(filepath.Join(b.config.ConfDir, filename), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	return b.writeConfigFile(filename, config, 0644)
+}
+
+// writeSecretConfig writes the config only readable by the owner, as it contains private keys.
+func (b *Backend) writeSecretConfig(filename string, config any) error {
+	return b.writeConfigFile(filename, config, 0600)
+}
+
+func (b *Backend) writeConfigFile(filename string, config any, perm os.FileMode) error {
+	file, err := os.OpenFile(filepath.Join(b.config.ConfDir, filename), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
 	if err != nil {
 		return oops.Wrapf(err, "opening config file")
 	}
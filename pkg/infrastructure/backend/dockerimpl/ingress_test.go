@@ -0,0 +1,421 @@
+package dockerimpl
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// newTestBackend returns a backend only for building the configs, without connecting to the docker daemon.
+func newTestBackend() *Backend {
+	var config Config
+	config.Routing.Type = routingTypeTraefik
+	config.TLS.CertResolver = "nsresolver"
+	return &Backend{config: config}
+}
+
+func runtimeApp(websites ...*domain.Website) *domain.Application {
+	return &domain.Application{
+		ID:         "app",
+		DeployType: domain.DeployTypeRuntime,
+		Config: domain.ApplicationConfig{
+			BuildConfig: &domain.BuildConfigRuntimeBuildpack{},
+		},
+		Websites: websites,
+	}
+}
+
+// runtimeConfig returns the dynamic config of the websites of the runtime app.
+func runtimeConfig(b *Backend, app *domain.Application, certs domain.TLSCertificateSlice) (routers m, middlewares m, services m) {
+	cb := newRuntimeConfigBuilder()
+	for _, website := range app.Websites {
+		cb.addWebsite(b, app, website, certs)
+	}
+	return cb.routers, cb.middlewares, cb.services
+}
+
+func TestRuntimeConfigBuilder_Rules(t *testing.T) {
+	b := newTestBackend()
+	website := &domain.Website{
+		ID:          "website",
+		FQDN:        "app.example.com",
+		PathPrefix:  "/sub",
+		StripPrefix: true,
+		HTTPS:       true,
+		HTTPPort:    80,
+		Rules: []*domain.WebsiteRule{
+			{Type: domain.WebsiteRuleTypeRedirect, PathRegex: "/old/(.*)", Target: "/new/${1}", StatusCode: 301, PreserveQuery: true},
+			{Type: domain.WebsiteRuleTypeRewrite, PathRegex: "/api/(.*)", Target: "/v2/${1}"},
+			{Type: domain.WebsiteRuleTypeRedirect, PathRegex: "/docs", Target: "https://docs.example.com/", StatusCode: 302},
+		},
+	}
+	for _, r := range website.Rules {
+		require.NoError(t, r.Validate())
+	}
+
+	t.Run("runtime", func(t *testing.T) {
+		routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+
+		// Rules see the path after the prefix is stripped, in the order of evaluation
+		assert.Equal(t, []string{"nsapp-website-strip", "nsapp-website-rule-0", "nsapp-website-rule-1", "nsapp-website-rule-2"}, routers["nsapp-website"].(m)["middlewares"])
+		assert.Equal(t, m{
+			"nsapp-website-strip": m{
+				"stripPrefix": m{"prefixes": []string{"/sub"}},
+			},
+			"nsapp-website-rule-0": m{
+				"redirectRegex": m{
+					"regex":       `^https?://[^/]+(?:/old/([^\n\?]*))(\?.*)?$`,
+					"replacement": "https://app.example.com/new/${1}${2}",
+					"permanent":   true,
+				},
+			},
+			"nsapp-website-rule-1": m{
+				"replacePathRegex": m{
+					"regex":       "^(?:/api/(.*))$",
+					"replacement": "/v2/${1}",
+				},
+			},
+			"nsapp-website-rule-2": m{
+				"redirectRegex": m{
+					"regex":       `^https?://[^/]+(?:/docs)(\?.*)?$`,
+					"replacement": "https://docs.example.com/",
+					"permanent":   false,
+				},
+			},
+		}, middlewares)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Static sites apply the rules in the static server
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		router, middlewares := b.routerBase(app, website, traefikSSServiceName, nil)
+		assert.Equal(t, []string{"nsapp-website-strip"}, router["middlewares"])
+		assert.Len(t, middlewares, 1)
+	})
+}
+
+func TestRuntimeConfigBuilder_Headers(t *testing.T) {
+	b := newTestBackend()
+	tests := []struct {
+		name   string
+		policy domain.WebsiteHeaderPolicy
+		want   m
+	}{
+		{
+			name: "response headers",
+			policy: domain.WebsiteHeaderPolicy{
+				ResponseHeaders: []*domain.WebsiteHeader{{Name: "Cache-Control", Value: "no-store"}, {Name: "X-Frame-Options", Value: "DENY"}},
+			},
+			want: m{
+				"customResponseHeaders": map[string]string{"Cache-Control": "no-store", "X-Frame-Options": "DENY"},
+			},
+		},
+		{
+			name: "cors",
+			policy: domain.WebsiteHeaderPolicy{
+				CORS: domain.WebsiteCORSPolicy{
+					AllowOrigins:     []string{"https://example.com", "https://example.net"},
+					AllowMethods:     []string{"GET", "POST"},
+					AllowHeaders:     []string{"Authorization"},
+					ExposeHeaders:    []string{"X-Request-Id"},
+					AllowCredentials: true,
+					MaxAge:           600,
+				},
+			},
+			want: m{
+				"accessControlAllowOriginList":  []string{"https://example.com", "https://example.net"},
+				"accessControlAllowMethods":     []string{"GET", "POST"},
+				"accessControlAllowHeaders":     []string{"Authorization"},
+				"accessControlExposeHeaders":    []string{"X-Request-Id"},
+				"accessControlAllowCredentials": true,
+				"accessControlMaxAge":           600,
+				"addVaryHeader":                 true,
+			},
+		},
+		{
+			name: "cors with any origin",
+			policy: domain.WebsiteHeaderPolicy{
+				ResponseHeaders: []*domain.WebsiteHeader{{Name: "X-Robots-Tag", Value: "noindex"}},
+				CORS:            domain.WebsiteCORSPolicy{AllowOrigins: []string{"*"}},
+			},
+			want: m{
+				"customResponseHeaders":         map[string]string{"X-Robots-Tag": "noindex"},
+				"accessControlAllowOriginList":  []string{"*"},
+				"accessControlAllowMethods":     []string(nil),
+				"accessControlAllowHeaders":     []string(nil),
+				"accessControlExposeHeaders":    []string(nil),
+				"accessControlAllowCredentials": false,
+				"accessControlMaxAge":           0,
+				"addVaryHeader":                 false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80, HeaderPolicy: tt.policy}
+			routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+			assert.Equal(t, []string{"nsapp-website-headers"}, routers["nsapp-website"].(m)["middlewares"])
+			assert.Equal(t, m{"nsapp-website-headers": m{"headers": tt.want}}, middlewares)
+		})
+	}
+
+	t.Run("no policy", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80}
+		routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+		assert.Empty(t, routers["nsapp-website"].(m)["middlewares"])
+		assert.Empty(t, middlewares)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Static sites set the headers in the static server, only the static site header is added
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HeaderPolicy: tests[0].policy}
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		cb := newSSConfigBuilder()
+		cb.addStaticSite(b, &domain.StaticSite{Application: app, Website: website}, nil)
+		assert.Equal(t, []string{"nsapp-ss-header-website"}, cb.routers["nsapp-website"].(m)["middlewares"])
+		assert.Equal(t, []string{"nsapp-ss-header-website"}, lo.Keys(cb.middlewares))
+	})
+}
+
+func TestRuntimeConfigBuilder_AccessControl(t *testing.T) {
+	b := newTestBackend()
+	website := &domain.Website{
+		ID:         "website",
+		FQDN:       "app.example.com",
+		PathPrefix: "/",
+		HTTPPort:   80,
+		AccessControl: domain.WebsiteAccessControl{
+			IPAllowList: []string{"10.0.0.0/8", "192.0.2.1/32"},
+			BasicAuthUsers: []*domain.WebsiteBasicAuthUser{
+				{Username: "alice", PasswordHash: "$2a$10$alice"},
+				{Username: "bob", PasswordHash: "$2a$10$bob"},
+			},
+		},
+	}
+	want := m{
+		"nsapp-website-ip-allowlist": m{
+			"ipAllowList": m{"sourceRange": []string{"10.0.0.0/8", "192.0.2.1/32"}},
+		},
+		"nsapp-website-basic-auth": m{
+			"basicAuth": m{"users": []string{"alice:$2a$10$alice", "bob:$2a$10$bob"}},
+		},
+	}
+
+	t.Run("runtime", func(t *testing.T) {
+		routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+		assert.Equal(t, []string{"nsapp-website-ip-allowlist", "nsapp-website-basic-auth"}, routers["nsapp-website"].(m)["middlewares"])
+		assert.Equal(t, want, middlewares)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Access control is applied by Traefik for static sites as well
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		router, middlewares := b.routerBase(app, website, traefikSSServiceName, nil)
+		assert.Equal(t, []string{"nsapp-website-ip-allowlist", "nsapp-website-basic-auth"}, router["middlewares"])
+		assert.Equal(t, want, middlewares)
+	})
+}
+
+func TestRuntimeConfigBuilder_RateLimit(t *testing.T) {
+	b := newTestBackend()
+	tests := []struct {
+		name      string
+		rateLimit domain.WebsiteRateLimit
+		want      m
+	}{
+		{
+			name:      "by ip address",
+			rateLimit: domain.WebsiteRateLimit{Enabled: true, Average: 100, Burst: 200},
+			want:      m{"average": 100, "burst": 200},
+		},
+		{
+			name:      "by header",
+			rateLimit: domain.WebsiteRateLimit{Enabled: true, Average: 10, Burst: 50, SourceHeader: "X-Api-Key"},
+			want:      m{"average": 10, "burst": 50, "sourceCriterion": m{"requestHeaderName": "X-Api-Key"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80, RateLimit: tt.rateLimit}
+			routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+			assert.Equal(t, []string{"nsapp-website-rate-limit"}, routers["nsapp-website"].(m)["middlewares"])
+			assert.Equal(t, m{"nsapp-website-rate-limit": m{"rateLimit": tt.want}}, middlewares)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80, RateLimit: domain.WebsiteRateLimit{Average: 100, Burst: 200}}
+		routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+		assert.Empty(t, routers["nsapp-website"].(m)["middlewares"])
+		assert.Empty(t, middlewares)
+	})
+}
+
+func TestRuntimeConfigBuilder_MiddlewareChain(t *testing.T) {
+	b := newTestBackend()
+	b.config.Domains = []*domainConf{{
+		Domain: "*.example.com",
+		Auth: &domainAuthConf{
+			Available: true,
+			Soft:      []string{"ns-auth-soft@file"},
+			Hard:      []string{"ns-auth-hard@file"},
+		},
+	}}
+	website := &domain.Website{
+		ID:             "website",
+		FQDN:           "app.example.com",
+		PathPrefix:     "/sub",
+		StripPrefix:    true,
+		HTTPPort:       80,
+		Authentication: domain.AuthenticationTypeHard,
+		AccessControl: domain.WebsiteAccessControl{
+			IPAllowList:    []string{"10.0.0.0/8"},
+			BasicAuthUsers: []*domain.WebsiteBasicAuthUser{{Username: "alice", PasswordHash: "$2a$10$alice"}},
+		},
+		RateLimit:    domain.WebsiteRateLimit{Enabled: true, Average: 100, Burst: 200},
+		HeaderPolicy: domain.WebsiteHeaderPolicy{CORS: domain.WebsiteCORSPolicy{AllowOrigins: []string{"https://example.com"}}},
+		Rules:        []*domain.WebsiteRule{{Type: domain.WebsiteRuleTypeRewrite, PathRegex: "/api/(.*)", Target: "/v2/${1}"}},
+	}
+
+	t.Run("runtime", func(t *testing.T) {
+		// Requests are rejected as early as possible, and CORS preflight requests are answered before the authentication
+		routers, middlewares, _ := runtimeConfig(b, runtimeApp(website), nil)
+		assert.Equal(t, []string{
+			"nsapp-website-ip-allowlist",
+			"nsapp-website-rate-limit",
+			"nsapp-website-headers",
+			"nsapp-website-basic-auth",
+			"ns-auth-hard@file",
+			"nsapp-website-strip",
+			"nsapp-website-rule-0",
+		}, routers["nsapp-website"].(m)["middlewares"])
+		assert.Len(t, middlewares, 6)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Static sites are rate limited and set the headers and rules in the static server
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		router, middlewares := b.routerBase(app, website, traefikSSServiceName, nil)
+		assert.Equal(t, []string{
+			"nsapp-website-ip-allowlist",
+			"nsapp-website-basic-auth",
+			"ns-auth-hard@file",
+			"nsapp-website-strip",
+		}, router["middlewares"])
+		assert.Len(t, middlewares, 3)
+	})
+}
+
+func TestRuntimeConfigBuilder_Split(t *testing.T) {
+	b := newTestBackend()
+	website := &domain.Website{
+		ID:         "website",
+		FQDN:       "app.example.com",
+		PathPrefix: "/",
+		HTTPPort:   8080,
+		Backends: []*domain.WebsiteBackend{
+			{ApplicationID: "app", Weight: 90},
+			{ApplicationID: "canary", Weight: 10},
+			{ApplicationID: "drained", Weight: 0},
+		},
+	}
+	routers, _, services := runtimeConfig(b, runtimeApp(website), nil)
+
+	assert.Equal(t, "nsapp-website", routers["nsapp-website"].(m)["service"])
+	assert.Equal(t, m{
+		"nsapp-website": m{
+			"weighted": m{
+				"services": a{
+					m{"name": "nsapp-website-app", "weight": 90},
+					m{"name": "nsapp-website-canary", "weight": 10},
+					m{"name": "nsapp-website-drained", "weight": 0},
+				},
+			},
+		},
+		"nsapp-website-app": m{
+			"loadBalancer": m{"servers": a{m{"url": "http://app.nsapp.internal:8080/"}}},
+		},
+		"nsapp-website-canary": m{
+			"loadBalancer": m{"servers": a{m{"url": "http://canary.nsapp.internal:8080/"}}},
+		},
+		"nsapp-website-drained": m{
+			"loadBalancer": m{"servers": a{m{"url": "http://drained.nsapp.internal:8080/"}}},
+		},
+	}, services)
+
+	t.Run("not split", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 8080, H2C: true}
+		_, _, services := runtimeConfig(b, runtimeApp(website), nil)
+		assert.Equal(t, m{
+			"nsapp-website": m{
+				"loadBalancer": m{"servers": a{m{"url": "h2c://app.nsapp.internal:8080/"}}},
+			},
+		}, services)
+	})
+}
+
+func TestRuntimeConfigBuilder_TLS(t *testing.T) {
+	b := newTestBackend()
+	exact := &domain.TLSCertificate{FQDN: "app.example.com", Certificate: "exact-cert", PrivateKey: "exact-key"}
+	wildcard := &domain.TLSCertificate{FQDN: "*.example.com", Certificate: "wildcard-cert", PrivateKey: "wildcard-key"}
+	certs := domain.TLSCertificateSlice{wildcard, exact}
+	tests := []struct {
+		name  string
+		fqdn  string
+		https bool
+		want  any
+	}{
+		{
+			// Selected from the TLS store by SNI
+			name:  "exact certificate",
+			fqdn:  "app.example.com",
+			https: true,
+			want:  m{},
+		},
+		{
+			name:  "wildcard certificate",
+			fqdn:  "other.example.com",
+			https: true,
+			want:  m{},
+		},
+		{
+			name:  "not covered",
+			fqdn:  "app.example.net",
+			https: true,
+			want:  m{"certResolver": "nsresolver", "domains": a{m{"main": "app.example.net"}}},
+		},
+		{
+			name:  "http",
+			fqdn:  "app.example.com",
+			https: false,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			website := &domain.Website{ID: "website", FQDN: tt.fqdn, PathPrefix: "/", HTTPS: tt.https, HTTPPort: 80}
+			routers, _, _ := runtimeConfig(b, runtimeApp(website), certs)
+			assert.Equal(t, tt.want, routers["nsapp-website"].(m)["tls"])
+		})
+	}
+
+	t.Run("tls store", func(t *testing.T) {
+		assert.Equal(t, m{
+			"tls": m{
+				"certificates": a{
+					m{"certFile": "wildcard-cert", "keyFile": "wildcard-key"},
+					m{"certFile": "exact-cert", "keyFile": "exact-key"},
+				},
+			},
+		}, tlsCertificatesConfig(certs))
+		assert.Nil(t, tlsCertificatesConfig(nil))
+	})
+}
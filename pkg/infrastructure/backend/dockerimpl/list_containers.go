@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"strings"
 
+	cerrdefs "github.com/containerd/errdefs"
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/client"
 	"github.com/samber/oops"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
-	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 func (b *Backend) GetContainer(ctx context.Context, appID string) (*domain.Container, error) {
@@ -29,12 +30,7 @@ func (b *Backend) GetContainer(ctx context.Context, appID string) (*domain.Conta
 			State:         domain.ContainerStateMissing,
 		}, nil
 	}
-	state, msg := getContainerState(&containers.Items[0])
-	return &domain.Container{
-		ApplicationID: appID,
-		State:         state,
-		Message:       msg,
-	}, nil
+	return b.toDomainContainer(ctx, &containers.Items[0])
 }
 
 func (b *Backend) ListContainers(ctx context.Context) ([]*domain.Container, error) {
@@ -46,17 +42,42 @@ func (b *Backend) ListContainers(ctx context.Context) ([]*domain.Container, erro
 		return nil, oops.Wrapf(err, "fetching containers")
 	}
 
-	result := ds.Map(containers.Items, func(c container.Summary) *domain.Container {
-		state, msg := getContainerState(&c)
-		return &domain.Container{
-			ApplicationID: c.Labels[appIDLabel],
-			State:         state,
-			Message:       msg,
+	result := make([]*domain.Container, 0, len(containers.Items))
+	for _, c := range containers.Items {
+		dc, err := b.toDomainContainer(ctx, &c)
+		if err != nil {
+			return nil, err
 		}
-	})
+		result = append(result, dc)
+	}
 	return result, nil
 }
 
+func (b *Backend) toDomainContainer(ctx context.Context, c *container.Summary) (*domain.Container, error) {
+	state, msg := getContainerState(c)
+	dc := &domain.Container{
+		ApplicationID: c.Labels[appIDLabel],
+		State:         state,
+		Message:       msg,
+	}
+
+	// Restart count and last exit status are only available from inspect
+	res, err := b.c.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
+	if cerrdefs.IsNotFound(err) {
+		// Removed in the meantime
+		return dc, nil
+	}
+	if err != nil {
+		return nil, oops.With("container_id", c.ID).Wrapf(err, "inspecting container")
+	}
+	dc.RestartCount = res.Container.RestartCount
+	if st := res.Container.State; st != nil && (st.ExitCode != 0 || st.OOMKilled || dc.RestartCount > 0) {
+		dc.LastExitCode = optional.From(st.ExitCode)
+		dc.OOMKilled = st.OOMKilled
+	}
+	return dc, nil
+}
+
 func getContainerState(c *container.Summary) (state domain.ContainerState, message string) {
 	// https://docs.docker.com/engine/api/v1.42/#tag/Container/operation/ContainerList
 	switch c.State {
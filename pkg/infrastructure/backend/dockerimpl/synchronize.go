@@ -10,14 +10,17 @@ func (b *Backend) Synchronize(ctx context.Context, s *domain.DesiredState) error
 	b.reloadLock.Lock()
 	defer b.reloadLock.Unlock()
 
-	err := b.synchronizeRuntime(ctx, s.Runtime)
+	err := b.synchronizeRuntime(ctx, s.Runtime, s.TLSCertificates)
 	if err != nil {
 		return err
 	}
-	return b.synchronizeSSIngress(ctx, s.StaticSites)
+	return b.synchronizeSSIngress(ctx, s.StaticSites, s.TLSCertificates)
 }
 
-func (b *Backend) SynchronizeShared(_ context.Context, _ *domain.DesiredStateLeader) error {
-	// No shared resources (certificates) are required in docker traefik backend for now
-	return nil
+func (b *Backend) SynchronizeShared(_ context.Context, s *domain.DesiredStateLeader) error {
+	b.reloadLock.Lock()
+	defer b.reloadLock.Unlock()
+
+	// Certificates issued by ACME are managed by the traefik resolver, only uploaded ones are installed
+	return b.writeSecretConfig(traefikTLSFilename, tlsCertificatesConfig(s.TLSCertificates))
 }
@@ -107,9 +107,15 @@ func (b *Backend) syncAppContainer(ctx context.Context, app *domain.RuntimeDesir
 	if b.config.Resources.MemoryReservation != 0 {
 		hostConfig.MemoryReservation = b.config.Resources.MemoryReservation
 	}
+	aliases := []string{networkName(app.App.ID)}
+	if app.App.InternalService.Enabled() {
+		// NOTE: all apps share the network, so access to the internal service is not restricted to the allowed apps
+		aliases = append(aliases, app.App.InternalHostname())
+		config.ExposedPorts[network.MustParsePort(fmt.Sprintf("%d/tcp", app.App.InternalService.Port))] = struct{}{}
+	}
 	networkingConfig := &network.NetworkingConfig{EndpointsConfig: map[string]*network.EndpointSettings{
 		b.config.Network: {
-			Aliases: []string{networkName(app.App.ID)},
+			Aliases: aliases,
 		},
 	}}
 	cont, err := b.c.ContainerCreate(ctx, client.ContainerCreateOptions{
@@ -130,7 +136,7 @@ func (b *Backend) syncAppContainer(ctx context.Context, app *domain.RuntimeDesir
 	return nil
 }
 
-func (b *Backend) synchronizeRuntime(ctx context.Context, apps []*domain.RuntimeDesiredState) error {
+func (b *Backend) synchronizeRuntime(ctx context.Context, apps []*domain.RuntimeDesiredState, certs domain.TLSCertificateSlice) error {
 	// List old resources
 	oldContainers, err := b.c.ContainerList(ctx, client.ContainerListOptions{
 		All:     true,
@@ -156,7 +162,7 @@ func (b *Backend) synchronizeRuntime(ctx context.Context, apps []*domain.Runtime
 	cb := newRuntimeConfigBuilder()
 	for _, app := range apps {
 		for _, website := range app.App.Websites {
-			cb.addWebsite(b, app.App, website)
+			cb.addWebsite(b, app.App, website, certs)
 		}
 	}
 	err = b.writeConfig(traefikRuntimeFilename, cb.build())
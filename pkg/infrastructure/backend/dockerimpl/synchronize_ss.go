@@ -20,8 +20,8 @@ func newSSConfigBuilder() *ssConfigBuilder {
 	}
 }
 
-func (b *ssConfigBuilder) addStaticSite(backend *Backend, site *domain.StaticSite) {
-	router, newMiddlewares := backend.routerBase(site.Application, site.Website, traefikSSServiceName)
+func (b *ssConfigBuilder) addStaticSite(backend *Backend, site *domain.StaticSite, certs domain.TLSCertificateSlice) {
+	router, newMiddlewares := backend.routerBase(site.Application, site.Website, traefikSSServiceName, certs)
 	maps.Copy(b.middlewares, newMiddlewares)
 
 	middlewareName := ssHeaderMiddlewareName(site)
@@ -29,7 +29,8 @@ func (b *ssConfigBuilder) addStaticSite(backend *Backend, site *domain.StaticSit
 	b.middlewares[middlewareName] = m{
 		"headers": m{
 			"customRequestHeaders": m{
-				web.HeaderNameSSGenAppID: site.Application.ID,
+				web.HeaderNameSSGenAppID:     site.Application.ID,
+				web.HeaderNameSSGenWebsiteID: site.Website.ID,
 			},
 		},
 	}
@@ -60,10 +61,10 @@ func (b *ssConfigBuilder) build(ssURL string) m {
 	}
 }
 
-func (b *Backend) synchronizeSSIngress(_ context.Context, sites []*domain.StaticSite) error {
+func (b *Backend) synchronizeSSIngress(_ context.Context, sites []*domain.StaticSite, certs domain.TLSCertificateSlice) error {
 	cb := newSSConfigBuilder()
 	for _, site := range sites {
-		cb.addStaticSite(b, site)
+		cb.addStaticSite(b, site, certs)
 	}
 	return b.writeConfig(traefikSSFilename, cb.build(b.config.SS.URL))
 }
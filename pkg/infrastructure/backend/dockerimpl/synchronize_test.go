@@ -2,9 +2,11 @@ package dockerimpl
 
 import (
 	"context"
+	"net/netip"
 	"testing"
 	"time"
 
+	"github.com/moby/moby/api/types/network"
 	"github.com/moby/moby/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -110,4 +112,62 @@ func TestDockerBackend_CreateContainer(t *testing.T) {
 		})
 		require.NoError(t, err)
 	})
+
+	t.Run("ポートを公開してコンテナを作成", func(t *testing.T) {
+		t.Parallel()
+		image := "tianon/sleeping-beauty"
+		appID := "pjoi3jfoiwe0"
+
+		app := domain.Application{
+			ID:        appID,
+			UpdatedAt: time.Now(),
+			Config: domain.ApplicationConfig{
+				BuildConfig: &domain.BuildConfigRuntimeBuildpack{},
+			},
+			PortPublications: []*domain.PortPublication{
+				{InternetPort: 30100, ApplicationPort: 25565, Protocol: domain.PortPublicationProtocolTCP},
+				{InternetPort: 30100, ApplicationPort: 25565, Protocol: domain.PortPublicationProtocolUDP},
+				{InternetPort: 30101, ApplicationPort: 1883, Protocol: domain.PortPublicationProtocolTCP},
+			},
+		}
+		st := domain.DesiredState{
+			Runtime: []*domain.RuntimeDesiredState{{
+				App:       &app,
+				ImageName: image,
+				ImageTag:  "latest",
+			}},
+		}
+		err := m.Synchronize(context.Background(), &st)
+		require.NoError(t, err)
+
+		res, err := c.ContainerInspect(context.Background(), containerName(appID), client.ContainerInspectOptions{})
+		require.NoError(t, err)
+
+		for _, p := range []string{"25565/tcp", "25565/udp", "1883/tcp"} {
+			assert.Contains(t, res.Container.Config.ExposedPorts, network.MustParsePort(p))
+		}
+		assert.Equal(t, network.PortMap{
+			network.MustParsePort("25565/tcp"): {{HostIP: netip.IPv4Unspecified(), HostPort: "30100"}},
+			network.MustParsePort("25565/udp"): {{HostIP: netip.IPv4Unspecified(), HostPort: "30100"}},
+			network.MustParsePort("1883/tcp"):  {{HostIP: netip.IPv4Unspecified(), HostPort: "30101"}},
+		}, res.Container.HostConfig.PortBindings)
+
+		// Changing the publications recreates the container with the new bindings
+		app.PortPublications = app.PortPublications[2:]
+		app.UpdatedAt = time.Now()
+		err = m.Synchronize(context.Background(), &st)
+		require.NoError(t, err)
+
+		res, err = c.ContainerInspect(context.Background(), containerName(appID), client.ContainerInspectOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, network.PortMap{
+			network.MustParsePort("1883/tcp"): {{HostIP: netip.IPv4Unspecified(), HostPort: "30101"}},
+		}, res.Container.HostConfig.PortBindings)
+
+		_, err = c.ContainerRemove(context.Background(), res.Container.ID, client.ContainerRemoveOptions{
+			RemoveVolumes: true,
+			Force:         true,
+		})
+		require.NoError(t, err)
+	})
 }
@@ -30,6 +30,8 @@ const (
 	shardLabel = "ns.trap.jp/shard"
 	// appIDLabel indicates the related application ID.
 	appIDLabel = "ns.trap.jp/app-id"
+	// tlsCertificateLabel (always "true") indicates the secret holds a TLS certificate uploaded by a user.
+	tlsCertificateLabel = "ns.trap.jp/tls-certificate"
 	// appRestartAnnotation instructs StatefulSets to restart pods when necessary.
 	appRestartAnnotation = "ns.trap.jp/restarted-at"
 	// resourceHashAnnotation is hex-encoded 64-bit XXH3 hash of the resource before this annotation is applied.
@@ -168,6 +170,13 @@ func allSelector() map[string]string {
 	}
 }
 
+func tlsCertificateSelector() map[string]string {
+	return map[string]string{
+		managedLabel:        "true",
+		tlsCertificateLabel: "true",
+	}
+}
+
 func (b *Backend) shardedAllSelector() map[string]string {
 	return map[string]string{
 		managedLabel: "true",
@@ -200,16 +209,44 @@ func serviceName(website *domain.Website) string {
 	return fmt.Sprintf("nsapp-%s", website.ID)
 }
 
+func splitServiceName(website *domain.Website, backendAppID string) string {
+	return fmt.Sprintf("nsapp-%s-%s", website.ID, backendAppID)
+}
+
 func portServiceName(port *domain.PortPublication) string {
 	return fmt.Sprintf("nsapp-port-%s-%d", port.Protocol, port.InternetPort)
 }
 
+func internalServiceName(app *domain.Application) string {
+	return fmt.Sprintf("nsapp-internal-%s", app.Name)
+}
+
 func stripMiddlewareName(website *domain.Website) string {
 	return serviceName(website) + "-strip"
 }
 
+func headerMiddlewareName(website *domain.Website) string {
+	return serviceName(website) + "-headers"
+}
+
+func ipAllowListMiddlewareName(website *domain.Website) string {
+	return serviceName(website) + "-ip-allowlist"
+}
+
+func rateLimitMiddlewareName(website *domain.Website) string {
+	return serviceName(website) + "-rate-limit"
+}
+
+func basicAuthMiddlewareName(website *domain.Website) string {
+	return serviceName(website) + "-basic-auth"
+}
+
+func ruleMiddlewareName(website *domain.Website, index int) string {
+	return fmt.Sprintf("%s-rule-%d", serviceName(website), index)
+}
+
 func ssHeaderMiddlewareName(ss *domain.StaticSite) string {
-	return fmt.Sprintf("nsapp-ss-header-%s", ss.Application.ID)
+	return fmt.Sprintf("nsapp-ss-header-%s", ss.Website.ID)
 }
 
 func sablierMiddlewareName(appID string) string {
@@ -243,3 +280,7 @@ func certificateName(fqdn string) string {
 func tlsSecretName(fqdn string) string {
 	return certificateName(fqdn) + "-tls"
 }
+
+func uploadedTLSSecretName(fqdn string) string {
+	return certificateName(fqdn) + "-uploaded-tls"
+}
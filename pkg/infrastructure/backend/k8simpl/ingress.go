@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -12,11 +13,13 @@ import (
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	traefikv1alpha1 "github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
 	"github.com/traefik/traefik/v3/pkg/types"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
 )
 
 func (b *Backend) stripMiddleware(app *domain.Application, website *domain.Website) *traefikv1alpha1.Middleware {
@@ -36,6 +39,141 @@ func (b *Backend) stripMiddleware(app *domain.Application, website *domain.Websi
 	}
 }
 
+func (b *Backend) ruleMiddleware(app *domain.Application, website *domain.Website, index int, r *domain.WebsiteRule) *traefikv1alpha1.Middleware {
+	var spec traefikv1alpha1.MiddlewareSpec
+	switch r.Type {
+	case domain.WebsiteRuleTypeRedirect:
+		regex, replacement := r.URLRegex(website)
+		spec.RedirectRegex = &dynamic.RedirectRegex{
+			Regex:       regex,
+			Replacement: replacement,
+			Permanent:   r.Permanent(),
+		}
+	case domain.WebsiteRuleTypeRewrite:
+		spec.ReplacePathRegex = &dynamic.ReplacePathRegex{
+			Regex:       r.AnchoredPathRegex(),
+			Replacement: r.Target,
+		}
+	default:
+		panic(fmt.Sprintf("unknown website rule type: %v", r.Type))
+	}
+	return &traefikv1alpha1.Middleware{
+		Kind:       "Middleware",
+		APIVersion: "traefik.io/v1alpha1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ruleMiddlewareName(website, index),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: spec,
+	}
+}
+
+func (b *Backend) headerMiddleware(app *domain.Application, website *domain.Website) *traefikv1alpha1.Middleware {
+	policy := &website.HeaderPolicy
+	headers := &dynamic.Headers{}
+	if len(policy.ResponseHeaders) > 0 {
+		headers.CustomResponseHeaders = policy.ResponseHeaderMap()
+	}
+	if cors := policy.CORS; cors.Enabled() {
+		headers.AccessControlAllowOriginList = cors.AllowOrigins
+		headers.AccessControlAllowMethods = cors.AllowMethods
+		headers.AccessControlAllowHeaders = cors.AllowHeaders
+		headers.AccessControlExposeHeaders = cors.ExposeHeaders
+		headers.AccessControlAllowCredentials = cors.AllowCredentials
+		headers.AccessControlMaxAge = new(int64(cors.MaxAge))
+		headers.AddVaryHeader = !cors.AllowsAnyOrigin()
+	}
+	return &traefikv1alpha1.Middleware{
+		Kind:       "Middleware",
+		APIVersion: "traefik.io/v1alpha1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      headerMiddlewareName(website),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: traefikv1alpha1.MiddlewareSpec{
+			Headers: headers,
+		},
+	}
+}
+
+func (b *Backend) ipAllowListMiddleware(app *domain.Application, website *domain.Website) *traefikv1alpha1.Middleware {
+	return &traefikv1alpha1.Middleware{
+		Kind:       "Middleware",
+		APIVersion: "traefik.io/v1alpha1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ipAllowListMiddlewareName(website),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: traefikv1alpha1.MiddlewareSpec{
+			IPAllowList: &dynamic.IPAllowList{
+				SourceRange: website.AccessControl.IPAllowList,
+			},
+		},
+	}
+}
+
+func (b *Backend) rateLimitMiddleware(app *domain.Application, website *domain.Website) *traefikv1alpha1.Middleware {
+	rl := &website.RateLimit
+	rateLimit := &traefikv1alpha1.RateLimit{
+		Average: new(int64(rl.Average)),
+		Burst:   new(int64(rl.Burst)),
+	}
+	if rl.SourceHeader != "" {
+		rateLimit.SourceCriterion = &dynamic.SourceCriterion{RequestHeaderName: rl.SourceHeader}
+	}
+	return &traefikv1alpha1.Middleware{
+		Kind:       "Middleware",
+		APIVersion: "traefik.io/v1alpha1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rateLimitMiddlewareName(website),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: traefikv1alpha1.MiddlewareSpec{
+			RateLimit: rateLimit,
+		},
+	}
+}
+
+func (b *Backend) basicAuthMiddleware(app *domain.Application, website *domain.Website) *traefikv1alpha1.Middleware {
+	return &traefikv1alpha1.Middleware{
+		Kind:       "Middleware",
+		APIVersion: "traefik.io/v1alpha1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      basicAuthMiddlewareName(website),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: traefikv1alpha1.MiddlewareSpec{
+			BasicAuth: &traefikv1alpha1.BasicAuth{
+				Secret: basicAuthMiddlewareName(website),
+			},
+		},
+	}
+}
+
+// basicAuthSecret returns the secret referenced by the basic auth middleware, or nil if the website does not use basic auth.
+func (b *Backend) basicAuthSecret(app *domain.Application, website *domain.Website) *corev1.Secret {
+	if len(website.AccessControl.BasicAuthUsers) == 0 {
+		return nil
+	}
+	return &corev1.Secret{
+		Kind:       "Secret",
+		APIVersion: "v1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      basicAuthMiddlewareName(website),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		StringData: map[string]string{
+			"users": strings.Join(website.AccessControl.Htpasswd(), "\n"),
+		},
+	}
+}
+
 func (b *Backend) certificate(targetDomain string) *certmanagerv1.Certificate {
 	return &certmanagerv1.Certificate{
 		APIVersion: "cert-manager.io/v1",
@@ -61,6 +199,26 @@ func (b *Backend) certificate(targetDomain string) *certmanagerv1.Certificate {
 	}
 }
 
+// uploadedCertificateSecret returns the TLS secret of the certificate uploaded by a user.
+func (b *Backend) uploadedCertificateSecret(c *domain.TLSCertificate) *corev1.Secret {
+	return &corev1.Secret{
+		Kind:       "Secret",
+		APIVersion: "v1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      uploadedTLSSecretName(c.FQDN),
+			Namespace: b.config.Namespace,
+			Labels: ds.MergeMap(b.generalLabel(), map[string]string{ // certificate may be shared by one or more apps
+				tlsCertificateLabel: "true",
+			}),
+		},
+		Type: corev1.SecretTypeTLS,
+		StringData: map[string]string{
+			corev1.TLSCertKey:       c.Certificate,
+			corev1.TLSPrivateKeyKey: c.PrivateKey,
+		},
+	}
+}
+
 func (b *Backend) jsonSablierConfig(app *domain.Application) []byte {
 	type DynamicConfig = struct {
 		DisplayName string `json:"displayName"`
@@ -123,6 +281,7 @@ func (b *Backend) ingressRoute(
 	app *domain.Application,
 	website *domain.Website,
 	serviceRefs []traefikv1alpha1.Service,
+	certs domain.TLSCertificateSlice,
 ) (
 	*traefikv1alpha1.IngressRoute,
 	[]*traefikv1alpha1.Middleware,
@@ -135,6 +294,30 @@ func (b *Backend) ingressRoute(
 	}
 
 	var middlewareRefs []traefikv1alpha1.MiddlewareRef
+	var middlewares []*traefikv1alpha1.Middleware
+	if len(website.AccessControl.IPAllowList) > 0 {
+		middleware := b.ipAllowListMiddleware(app, website)
+		middlewares = append(middlewares, middleware)
+		middlewareRefs = append(middlewareRefs, traefikv1alpha1.MiddlewareRef{Name: middleware.Name})
+	}
+	// Static sites are rate limited in the static server
+	if app.DeployType == domain.DeployTypeRuntime && website.RateLimit.Enabled {
+		middleware := b.rateLimitMiddleware(app, website)
+		middlewares = append(middlewares, middleware)
+		middlewareRefs = append(middlewareRefs, traefikv1alpha1.MiddlewareRef{Name: middleware.Name})
+	}
+	// Placed before the authentication, so that CORS preflight requests without credentials are answered
+	if app.DeployType == domain.DeployTypeRuntime && !website.HeaderPolicy.IsEmpty() {
+		middleware := b.headerMiddleware(app, website)
+		middlewares = append(middlewares, middleware)
+		middlewareRefs = append(middlewareRefs, traefikv1alpha1.MiddlewareRef{Name: middleware.Name})
+	}
+	if len(website.AccessControl.BasicAuthUsers) > 0 {
+		middleware := b.basicAuthMiddleware(app, website)
+		middlewares = append(middlewares, middleware)
+		middlewareRefs = append(middlewareRefs, traefikv1alpha1.MiddlewareRef{Name: middleware.Name})
+	}
+
 	authConfig := b.targetAuth(website.FQDN)
 	if authConfig != nil {
 		switch website.Authentication {
@@ -152,7 +335,6 @@ func (b *Backend) ingressRoute(
 	}
 
 	var rule string
-	var middlewares []*traefikv1alpha1.Middleware
 	if website.PathPrefix == "/" {
 		rule = fmt.Sprintf("Host(`%s`)", website.FQDN)
 	} else {
@@ -163,6 +345,14 @@ func (b *Backend) ingressRoute(
 			middlewareRefs = append(middlewareRefs, traefikv1alpha1.MiddlewareRef{Name: middleware.Name})
 		}
 	}
+	// Static sites apply the rules in the static server
+	if app.DeployType == domain.DeployTypeRuntime {
+		for i, r := range website.Rules {
+			middleware := b.ruleMiddleware(app, website, i, r)
+			middlewares = append(middlewares, middleware)
+			middlewareRefs = append(middlewareRefs, traefikv1alpha1.MiddlewareRef{Name: middleware.Name})
+		}
+	}
 	if b.useSablier(app) {
 		middleware := b.sablierMiddleware(app)
 		middlewares = append(middlewares, middleware)
@@ -175,7 +365,12 @@ func (b *Backend) ingressRoute(
 	}
 
 	var tls *traefikv1alpha1.TLS
-	if website.HTTPS {
+	if cert, ok := certs.Find(website.FQDN); ok && website.HTTPS {
+		// Uploaded certificates are not managed by the resolver
+		tls = &traefikv1alpha1.TLS{
+			SecretName: uploadedTLSSecretName(cert.FQDN),
+		}
+	} else if website.HTTPS {
 		switch b.config.TLS.Type {
 		case tlsTypeTraefik:
 			targetDomain := b.config.TLS.Traefik.Wildcard.Domains.TLSTargetDomain(website)
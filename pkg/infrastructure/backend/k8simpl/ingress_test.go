@@ -0,0 +1,443 @@
+package k8simpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	traefikv1alpha1 "github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
+	"github.com/traefik/traefik/v3/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+)
+
+// newTestBackend returns a backend only for building the resources, without connecting to the cluster.
+func newTestBackend(t *testing.T) *Backend {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	c := discovery.NewCluster(discovery.NewSingleDiscoverer("127.0.0.1"))
+	go func() { _ = c.Start(ctx) }()
+
+	var config Config
+	config.Namespace = "neoshowcase-apps"
+	config.Routing.Type = routingTypeTraefik
+	config.TLS.Type = tlsTypeTraefik
+	config.TLS.Traefik.CertResolver = "nsresolver"
+	return &Backend{cluster: c, config: config}
+}
+
+func runtimeApp(websites ...*domain.Website) *domain.Application {
+	return &domain.Application{
+		ID:         "app",
+		DeployType: domain.DeployTypeRuntime,
+		Config: domain.ApplicationConfig{
+			BuildConfig: &domain.BuildConfigRuntimeBuildpack{},
+		},
+		Websites: websites,
+	}
+}
+
+// middlewareChain returns the names of the middlewares referenced by the route, in the order of application.
+func middlewareChain(route *traefikv1alpha1.IngressRoute) []string {
+	return lo.Map(route.Spec.Routes[0].Middlewares, func(ref traefikv1alpha1.MiddlewareRef, _ int) string { return ref.Name })
+}
+
+// middlewareSpecs returns the specs of the middlewares by their names.
+func middlewareSpecs(t *testing.T, route *traefikv1alpha1.IngressRoute, middlewares []*traefikv1alpha1.Middleware) map[string]traefikv1alpha1.MiddlewareSpec {
+	specs := lo.SliceToMap(middlewares, func(mw *traefikv1alpha1.Middleware) (string, traefikv1alpha1.MiddlewareSpec) {
+		assert.Equal(t, route.Namespace, mw.Namespace)
+		assert.Equal(t, route.Labels, mw.Labels)
+		return mw.Name, mw.Spec
+	})
+	require.Len(t, specs, len(middlewares), "duplicate middleware names")
+	return specs
+}
+
+func TestBackend_ingressRoute_Rules(t *testing.T) {
+	b := newTestBackend(t)
+	website := &domain.Website{
+		ID:          "website",
+		FQDN:        "app.example.com",
+		PathPrefix:  "/sub",
+		StripPrefix: true,
+		HTTPS:       true,
+		HTTPPort:    80,
+		Rules: []*domain.WebsiteRule{
+			{Type: domain.WebsiteRuleTypeRedirect, PathRegex: "/old/(.*)", Target: "/new/${1}", StatusCode: 301, PreserveQuery: true},
+			{Type: domain.WebsiteRuleTypeRewrite, PathRegex: "/api/(.*)", Target: "/v2/${1}"},
+			{Type: domain.WebsiteRuleTypeRedirect, PathRegex: "/docs", Target: "https://docs.example.com/", StatusCode: 302},
+		},
+	}
+	for _, r := range website.Rules {
+		require.NoError(t, r.Validate())
+	}
+
+	t.Run("runtime", func(t *testing.T) {
+		app := runtimeApp(website)
+		route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+
+		// Rules see the path after the prefix is stripped, in the order of evaluation
+		assert.Equal(t, []string{"nsapp-website-strip", "nsapp-website-rule-0", "nsapp-website-rule-1", "nsapp-website-rule-2"}, middlewareChain(route))
+		specs := middlewareSpecs(t, route, middlewares)
+		assert.Equal(t, &dynamic.RedirectRegex{
+			Regex:       `^https?://[^/]+(?:/old/([^\n\?]*))(\?.*)?$`,
+			Replacement: "https://app.example.com/new/${1}${2}",
+			Permanent:   true,
+		}, specs["nsapp-website-rule-0"].RedirectRegex)
+		assert.Equal(t, &dynamic.ReplacePathRegex{
+			Regex:       "^(?:/api/(.*))$",
+			Replacement: "/v2/${1}",
+		}, specs["nsapp-website-rule-1"].ReplacePathRegex)
+		assert.Equal(t, &dynamic.RedirectRegex{
+			Regex:       `^https?://[^/]+(?:/docs)(\?.*)?$`,
+			Replacement: "https://docs.example.com/",
+			Permanent:   false,
+		}, specs["nsapp-website-rule-2"].RedirectRegex)
+		assert.Nil(t, specs["nsapp-website-rule-1"].RedirectRegex)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Static sites apply the rules in the static server
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		route, middlewares := b.ingressRoute(app, website, b.ssServiceRef(), nil)
+		assert.Equal(t, []string{"nsapp-website-strip"}, middlewareChain(route))
+		assert.Len(t, middlewares, 1)
+	})
+}
+
+func TestBackend_ingressRoute_Headers(t *testing.T) {
+	b := newTestBackend(t)
+	tests := []struct {
+		name   string
+		policy domain.WebsiteHeaderPolicy
+		want   *dynamic.Headers
+	}{
+		{
+			name: "response headers",
+			policy: domain.WebsiteHeaderPolicy{
+				ResponseHeaders: []*domain.WebsiteHeader{{Name: "Cache-Control", Value: "no-store"}, {Name: "X-Frame-Options", Value: "DENY"}},
+			},
+			want: &dynamic.Headers{
+				CustomResponseHeaders: map[string]string{"Cache-Control": "no-store", "X-Frame-Options": "DENY"},
+			},
+		},
+		{
+			name: "cors",
+			policy: domain.WebsiteHeaderPolicy{
+				CORS: domain.WebsiteCORSPolicy{
+					AllowOrigins:     []string{"https://example.com", "https://example.net"},
+					AllowMethods:     []string{"GET", "POST"},
+					AllowHeaders:     []string{"Authorization"},
+					ExposeHeaders:    []string{"X-Request-Id"},
+					AllowCredentials: true,
+					MaxAge:           600,
+				},
+			},
+			want: &dynamic.Headers{
+				AccessControlAllowOriginList:  []string{"https://example.com", "https://example.net"},
+				AccessControlAllowMethods:     []string{"GET", "POST"},
+				AccessControlAllowHeaders:     []string{"Authorization"},
+				AccessControlExposeHeaders:    []string{"X-Request-Id"},
+				AccessControlAllowCredentials: true,
+				AccessControlMaxAge:           new(int64(600)),
+				AddVaryHeader:                 true,
+			},
+		},
+		{
+			name: "cors with any origin",
+			policy: domain.WebsiteHeaderPolicy{
+				ResponseHeaders: []*domain.WebsiteHeader{{Name: "X-Robots-Tag", Value: "noindex"}},
+				CORS:            domain.WebsiteCORSPolicy{AllowOrigins: []string{"*"}},
+			},
+			want: &dynamic.Headers{
+				CustomResponseHeaders:        map[string]string{"X-Robots-Tag": "noindex"},
+				AccessControlAllowOriginList: []string{"*"},
+				AccessControlMaxAge:          new(int64(0)),
+				AddVaryHeader:                false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80, HeaderPolicy: tt.policy}
+			app := runtimeApp(website)
+			route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+			assert.Equal(t, []string{"nsapp-website-headers"}, middlewareChain(route))
+			assert.Equal(t, tt.want, middlewareSpecs(t, route, middlewares)["nsapp-website-headers"].Headers)
+		})
+	}
+
+	t.Run("no policy", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80}
+		app := runtimeApp(website)
+		route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+		assert.Empty(t, middlewareChain(route))
+		assert.Empty(t, middlewares)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Static sites set the headers in the static server, only the static site header is added
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HeaderPolicy: tests[0].policy}
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		var next resources
+		b.ssResources(&next, []*domain.StaticSite{{Application: app, Website: website}}, nil)
+		require.Len(t, next.ingressRoutes, 1)
+		assert.Equal(t, []string{"nsapp-ss-header-website"}, middlewareChain(next.ingressRoutes[0]))
+		specs := middlewareSpecs(t, next.ingressRoutes[0], next.middlewares)
+		assert.Empty(t, specs["nsapp-ss-header-website"].Headers.CustomResponseHeaders)
+	})
+}
+
+func TestBackend_ingressRoute_AccessControl(t *testing.T) {
+	b := newTestBackend(t)
+	website := &domain.Website{
+		ID:         "website",
+		FQDN:       "app.example.com",
+		PathPrefix: "/",
+		HTTPPort:   80,
+		AccessControl: domain.WebsiteAccessControl{
+			IPAllowList: []string{"10.0.0.0/8", "192.0.2.1/32"},
+			BasicAuthUsers: []*domain.WebsiteBasicAuthUser{
+				{Username: "alice", PasswordHash: "$2a$10$alice"},
+				{Username: "bob", PasswordHash: "$2a$10$bob"},
+			},
+		},
+	}
+
+	// Access control is applied by Traefik for both runtime apps and static sites
+	for name, deployType := range map[string]domain.DeployType{"runtime": domain.DeployTypeRuntime, "static site": domain.DeployTypeStatic} {
+		t.Run(name, func(t *testing.T) {
+			app := runtimeApp(website)
+			app.DeployType = deployType
+			route, middlewares := b.ingressRoute(app, website, b.ssServiceRef(), nil)
+			assert.Equal(t, []string{"nsapp-website-ip-allowlist", "nsapp-website-basic-auth"}, middlewareChain(route))
+			specs := middlewareSpecs(t, route, middlewares)
+			assert.Equal(t, &dynamic.IPAllowList{SourceRange: []string{"10.0.0.0/8", "192.0.2.1/32"}}, specs["nsapp-website-ip-allowlist"].IPAllowList)
+			assert.Equal(t, &traefikv1alpha1.BasicAuth{Secret: "nsapp-website-basic-auth"}, specs["nsapp-website-basic-auth"].BasicAuth)
+
+			secret := b.basicAuthSecret(app, website)
+			require.NotNil(t, secret)
+			assert.Equal(t, "nsapp-website-basic-auth", secret.Name)
+			assert.Equal(t, route.Namespace, secret.Namespace)
+			assert.Equal(t, route.Labels, secret.Labels)
+			assert.Equal(t, map[string]string{"users": "alice:$2a$10$alice\nbob:$2a$10$bob"}, secret.StringData)
+		})
+	}
+
+	t.Run("no access control", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80}
+		app := runtimeApp(website)
+		route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+		assert.Empty(t, middlewareChain(route))
+		assert.Empty(t, middlewares)
+		assert.Nil(t, b.basicAuthSecret(app, website))
+	})
+}
+
+func TestBackend_ingressRoute_RateLimit(t *testing.T) {
+	b := newTestBackend(t)
+	tests := []struct {
+		name      string
+		rateLimit domain.WebsiteRateLimit
+		want      *traefikv1alpha1.RateLimit
+	}{
+		{
+			name:      "by ip address",
+			rateLimit: domain.WebsiteRateLimit{Enabled: true, Average: 100, Burst: 200},
+			want:      &traefikv1alpha1.RateLimit{Average: new(int64(100)), Burst: new(int64(200))},
+		},
+		{
+			name:      "by header",
+			rateLimit: domain.WebsiteRateLimit{Enabled: true, Average: 10, Burst: 50, SourceHeader: "X-Api-Key"},
+			want: &traefikv1alpha1.RateLimit{
+				Average:         new(int64(10)),
+				Burst:           new(int64(50)),
+				SourceCriterion: &dynamic.SourceCriterion{RequestHeaderName: "X-Api-Key"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80, RateLimit: tt.rateLimit}
+			app := runtimeApp(website)
+			route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+			assert.Equal(t, []string{"nsapp-website-rate-limit"}, middlewareChain(route))
+			assert.Equal(t, tt.want, middlewareSpecs(t, route, middlewares)["nsapp-website-rate-limit"].RateLimit)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 80, RateLimit: domain.WebsiteRateLimit{Average: 100, Burst: 200}}
+		app := runtimeApp(website)
+		route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+		assert.Empty(t, middlewareChain(route))
+		assert.Empty(t, middlewares)
+	})
+}
+
+func TestBackend_ingressRoute_MiddlewareChain(t *testing.T) {
+	b := newTestBackend(t)
+	b.config.Domains = []*domainConf{{
+		Domain: "*.example.com",
+		Auth: &domainAuthConf{
+			Available: true,
+			Soft:      []middleware{{Name: "ns-auth-soft", Namespace: "neoshowcase"}},
+			Hard:      []middleware{{Name: "ns-auth-hard", Namespace: "neoshowcase"}},
+		},
+	}}
+	website := &domain.Website{
+		ID:             "website",
+		FQDN:           "app.example.com",
+		PathPrefix:     "/sub",
+		StripPrefix:    true,
+		HTTPPort:       80,
+		Authentication: domain.AuthenticationTypeHard,
+		AccessControl: domain.WebsiteAccessControl{
+			IPAllowList:    []string{"10.0.0.0/8"},
+			BasicAuthUsers: []*domain.WebsiteBasicAuthUser{{Username: "alice", PasswordHash: "$2a$10$alice"}},
+		},
+		RateLimit:    domain.WebsiteRateLimit{Enabled: true, Average: 100, Burst: 200},
+		HeaderPolicy: domain.WebsiteHeaderPolicy{CORS: domain.WebsiteCORSPolicy{AllowOrigins: []string{"https://example.com"}}},
+		Rules:        []*domain.WebsiteRule{{Type: domain.WebsiteRuleTypeRewrite, PathRegex: "/api/(.*)", Target: "/v2/${1}"}},
+	}
+
+	t.Run("runtime", func(t *testing.T) {
+		// Requests are rejected as early as possible, and CORS preflight requests are answered before the authentication
+		app := runtimeApp(website)
+		route, middlewares := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+		assert.Equal(t, []string{
+			"nsapp-website-ip-allowlist",
+			"nsapp-website-rate-limit",
+			"nsapp-website-headers",
+			"nsapp-website-basic-auth",
+			"ns-auth-hard",
+			"nsapp-website-strip",
+			"nsapp-website-rule-0",
+		}, middlewareChain(route))
+		assert.Equal(t, "neoshowcase", route.Spec.Routes[0].Middlewares[4].Namespace)
+		assert.Len(t, middlewareSpecs(t, route, middlewares), 6)
+	})
+
+	t.Run("static site", func(t *testing.T) {
+		// Static sites are rate limited and set the headers and rules in the static server
+		app := runtimeApp(website)
+		app.DeployType = domain.DeployTypeStatic
+		route, middlewares := b.ingressRoute(app, website, b.ssServiceRef(), nil)
+		assert.Equal(t, []string{
+			"nsapp-website-ip-allowlist",
+			"nsapp-website-basic-auth",
+			"ns-auth-hard",
+			"nsapp-website-strip",
+		}, middlewareChain(route))
+		assert.Len(t, middlewareSpecs(t, route, middlewares), 3)
+	})
+}
+
+func TestBackend_ingressRoute_Split(t *testing.T) {
+	b := newTestBackend(t)
+	website := &domain.Website{
+		ID:         "website",
+		FQDN:       "app.example.com",
+		PathPrefix: "/",
+		HTTPPort:   8080,
+		Backends: []*domain.WebsiteBackend{
+			{ApplicationID: "app", Weight: 90},
+			{ApplicationID: "canary", Weight: 10},
+			{ApplicationID: "drained", Weight: 0},
+		},
+	}
+	app := runtimeApp(website)
+
+	route, _ := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+	// The own application is served by its own service, and the others by the split services
+	assert.Equal(t, []traefikv1alpha1.Service{
+		{LoadBalancerSpec: traefikv1alpha1.LoadBalancerSpec{Name: "nsapp-app", Kind: "Service", Namespace: "neoshowcase-apps", Port: intstr.FromInt(8080), Scheme: "http", Weight: new(90)}},
+		{LoadBalancerSpec: traefikv1alpha1.LoadBalancerSpec{Name: "nsapp-website-canary", Kind: "Service", Namespace: "neoshowcase-apps", Port: intstr.FromInt(8080), Scheme: "http", Weight: new(10)}},
+		{LoadBalancerSpec: traefikv1alpha1.LoadBalancerSpec{Name: "nsapp-website-drained", Kind: "Service", Namespace: "neoshowcase-apps", Port: intstr.FromInt(8080), Scheme: "http", Weight: new(0)}},
+	}, route.Spec.Routes[0].Services)
+
+	svc := b.runtimeSplitService(app, website, "canary")
+	assert.Equal(t, "nsapp-website-canary", svc.Name)
+	assert.Equal(t, route.Namespace, svc.Namespace)
+	// Owned by the application of the website, selecting the pods of the backend application
+	assert.Equal(t, route.Labels, svc.Labels)
+	assert.Equal(t, map[string]string{managedLabel: "true", appIDLabel: "canary"}, svc.Spec.Selector)
+	assert.Equal(t, []corev1.ServicePort{{Name: "tcp-8080", Protocol: corev1.ProtocolTCP, Port: 8080, TargetPort: intstr.FromInt(8080)}}, svc.Spec.Ports)
+
+	t.Run("not split", func(t *testing.T) {
+		website := &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/", HTTPPort: 8080, H2C: true}
+		app := runtimeApp(website)
+		route, _ := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), nil)
+		assert.Equal(t, []traefikv1alpha1.Service{
+			{LoadBalancerSpec: traefikv1alpha1.LoadBalancerSpec{Name: "nsapp-app", Kind: "Service", Namespace: "neoshowcase-apps", Port: intstr.FromInt(8080), Scheme: "h2c"}},
+		}, route.Spec.Routes[0].Services)
+	})
+}
+
+func TestBackend_ingressRoute_TLS(t *testing.T) {
+	b := newTestBackend(t)
+	exact := &domain.TLSCertificate{FQDN: "app.example.com", Certificate: "exact-cert", PrivateKey: "exact-key"}
+	wildcard := &domain.TLSCertificate{FQDN: "*.example.com", Certificate: "wildcard-cert", PrivateKey: "wildcard-key"}
+	certs := domain.TLSCertificateSlice{wildcard, exact}
+	tests := []struct {
+		name  string
+		fqdn  string
+		https bool
+		want  *traefikv1alpha1.TLS
+	}{
+		{
+			name:  "exact certificate is preferred",
+			fqdn:  "app.example.com",
+			https: true,
+			want:  &traefikv1alpha1.TLS{SecretName: "nsapp-app-example-com-uploaded-tls"},
+		},
+		{
+			name:  "wildcard certificate",
+			fqdn:  "other.example.com",
+			https: true,
+			want:  &traefikv1alpha1.TLS{SecretName: "nsapp-example-com-wildcard-uploaded-tls"},
+		},
+		{
+			name:  "not covered",
+			fqdn:  "app.example.net",
+			https: true,
+			want: &traefikv1alpha1.TLS{
+				SecretName:   "nsapp-app-example-net-tls",
+				CertResolver: "nsresolver",
+				Domains:      []types.Domain{{Main: "app.example.net"}},
+			},
+		},
+		{
+			name:  "http",
+			fqdn:  "app.example.com",
+			https: false,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			website := &domain.Website{ID: "website", FQDN: tt.fqdn, PathPrefix: "/", HTTPS: tt.https, HTTPPort: 80}
+			app := runtimeApp(website)
+			route, _ := b.ingressRoute(app, website, b.runtimeServiceRef(app, website), certs)
+			assert.Equal(t, tt.want, route.Spec.TLS)
+		})
+	}
+
+	t.Run("uploaded certificate secret", func(t *testing.T) {
+		secret := b.uploadedCertificateSecret(wildcard)
+		assert.Equal(t, "nsapp-example-com-wildcard-uploaded-tls", secret.Name)
+		assert.Equal(t, "neoshowcase-apps", secret.Namespace)
+		// Shared by the apps, so not labeled with an app
+		assert.Equal(t, map[string]string{managedLabel: "true", tlsCertificateLabel: "true"}, secret.Labels)
+		assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+		assert.Equal(t, map[string]string{corev1.TLSCertKey: "wildcard-cert", corev1.TLSPrivateKeyKey: "wildcard-key"}, secret.StringData)
+	})
+}
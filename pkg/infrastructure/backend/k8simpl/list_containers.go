@@ -13,6 +13,7 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/util/ds"
 	"github.com/traPtitech/neoshowcase/pkg/util/fmtutil"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 func (b *Backend) GetContainer(ctx context.Context, appID string) (*domain.Container, error) {
@@ -29,12 +30,7 @@ func (b *Backend) GetContainer(ctx context.Context, appID string) (*domain.Conta
 			State:         domain.ContainerStateMissing,
 		}, nil
 	}
-	state, msg := getContainerState(list.Items[0].Status)
-	return &domain.Container{
-		ApplicationID: appID,
-		State:         state,
-		Message:       msg,
-	}, nil
+	return toDomainContainer(&list.Items[0]), nil
 }
 
 func (b *Backend) ListContainers(ctx context.Context) ([]*domain.Container, error) {
@@ -46,17 +42,35 @@ func (b *Backend) ListContainers(ctx context.Context) ([]*domain.Container, erro
 	}
 
 	result := ds.Map(list.Items, func(pod v1.Pod) *domain.Container {
-		state, msg := getContainerState(pod.Status)
-		return &domain.Container{
-			ApplicationID: pod.Labels[appIDLabel],
-			State:         state,
-			Message:       msg,
-		}
+		return toDomainContainer(&pod)
 	})
 
 	return result, nil
 }
 
+func toDomainContainer(pod *v1.Pod) *domain.Container {
+	state, msg := getContainerState(pod.Status)
+	c := &domain.Container{
+		ApplicationID: pod.Labels[appIDLabel],
+		State:         state,
+		Message:       msg,
+	}
+	cs, ok := lo.Find(pod.Status.ContainerStatuses, func(cs v1.ContainerStatus) bool { return cs.Name == podContainerName })
+	if !ok {
+		return c
+	}
+	c.RestartCount = int(cs.RestartCount)
+	terminated := cs.State.Terminated
+	if terminated == nil {
+		terminated = cs.LastTerminationState.Terminated
+	}
+	if terminated != nil {
+		c.LastExitCode = optional.From(int(terminated.ExitCode))
+		c.OOMKilled = terminated.Reason == "OOMKilled"
+	}
+	return c
+}
+
 func getContainerState(status v1.PodStatus) (state domain.ContainerState, message string) {
 	cs, ok := lo.Find(status.ContainerStatuses, func(cs v1.ContainerStatus) bool { return cs.Name == podContainerName })
 	if !ok {
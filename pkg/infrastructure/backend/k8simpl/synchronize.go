@@ -8,6 +8,7 @@ import (
 	traefikv1alpha1 "github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
@@ -15,15 +16,17 @@ import (
 )
 
 type resources struct {
-	statefulSets  []*appsv1.StatefulSet
-	secrets       []*v1.Secret
-	services      []*v1.Service
-	middlewares   []*traefikv1alpha1.Middleware
-	ingressRoutes []*traefikv1alpha1.IngressRoute
+	statefulSets    []*appsv1.StatefulSet
+	secrets         []*v1.Secret
+	services        []*v1.Service
+	networkPolicies []*networkingv1.NetworkPolicy
+	middlewares     []*traefikv1alpha1.Middleware
+	ingressRoutes   []*traefikv1alpha1.IngressRoute
 }
 
 type sharedResources struct {
 	certificates []*certmanagerv1.Certificate
+	secrets      []*v1.Secret
 }
 
 func (b *Backend) listCurrentResources(ctx context.Context) (*resources, error) {
@@ -48,6 +51,12 @@ func (b *Backend) listCurrentResources(ctx context.Context) (*resources, error)
 	}
 	rsc.services = ds.SliceOfPtr(svc.Items)
 
+	np, err := b.client.NetworkingV1().NetworkPolicies(b.config.Namespace).List(ctx, listOpt)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting network policies")
+	}
+	rsc.networkPolicies = ds.SliceOfPtr(np.Items)
+
 	mw, err := b.traefikClient.Middlewares(b.config.Namespace).List(ctx, listOpt)
 	if err != nil {
 		return nil, oops.Wrapf(err, "getting middlewares")
@@ -75,6 +84,13 @@ func (b *Backend) listCurrentSharedResources(ctx context.Context) (*sharedResour
 		rsc.certificates = ds.SliceOfPtr(certs.Items)
 	}
 
+	// Shared secrets are selected separately from the sharded ones, which have the same managed label
+	secrets, err := b.client.CoreV1().Secrets(b.config.Namespace).List(ctx, metav1.ListOptions{LabelSelector: toSelectorString(tlsCertificateSelector())})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting secrets")
+	}
+	rsc.secrets = ds.SliceOfPtr(secrets.Items)
+
 	return &rsc, nil
 }
 
@@ -84,8 +100,8 @@ func (b *Backend) Synchronize(ctx context.Context, s *domain.DesiredState) error
 
 	// Calculate next resources to apply
 	var next resources
-	b.runtimeResources(&next, s.Runtime)
-	b.ssResources(&next, s.StaticSites)
+	b.runtimeResources(&next, s.Runtime, s.TLSCertificates)
+	b.ssResources(&next, s.StaticSites, s.TLSCertificates)
 
 	// List old resources
 	old, err := b.listCurrentResources(ctx)
@@ -106,6 +122,10 @@ func (b *Backend) Synchronize(ctx context.Context, s *domain.DesiredState) error
 	if err != nil {
 		return oops.Wrapf(err, "syncing services")
 	}
+	err = syncResources[*networkingv1.NetworkPolicy](ctx, b.cluster, "networkpolicies", old.networkPolicies, next.networkPolicies, b.client.NetworkingV1().NetworkPolicies(b.config.Namespace))
+	if err != nil {
+		return oops.Wrapf(err, "syncing network policies")
+	}
 	err = syncResources[*traefikv1alpha1.Middleware](ctx, b.cluster, "middlewares", old.middlewares, next.middlewares, b.traefikClient.Middlewares(b.config.Namespace))
 	if err != nil {
 		return oops.Wrapf(err, "syncing middlewares")
@@ -122,6 +142,7 @@ func (b *Backend) SynchronizeShared(ctx context.Context, s *domain.DesiredStateL
 	// Calculate next resources to apply
 	var next sharedResources
 	next.certificates = ds.Map(s.TLSTargetDomains, b.certificate)
+	next.secrets = ds.Map(s.TLSCertificates, b.uploadedCertificateSecret)
 
 	// List old resources
 	old, err := b.listCurrentSharedResources(ctx)
@@ -134,6 +155,10 @@ func (b *Backend) SynchronizeShared(ctx context.Context, s *domain.DesiredStateL
 	if err != nil {
 		return oops.Wrapf(err, "syncing certificates")
 	}
+	err = syncResources[*v1.Secret](ctx, b.cluster, "secrets", old.secrets, next.secrets, b.client.CoreV1().Secrets(b.config.Namespace))
+	if err != nil {
+		return oops.Wrapf(err, "syncing uploaded certificate secrets")
+	}
 
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	traefikv1alpha1 "github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -79,6 +80,12 @@ func (b *Backend) runtimeSpec(app *domain.RuntimeDesiredState) (*appsv1.Stateful
 			Protocol:      protocolMapper.IntoMust(p.Protocol),
 		})
 	}
+	if app.App.InternalService.Enabled() {
+		cont.Ports = append(cont.Ports, v1.ContainerPort{
+			ContainerPort: int32(app.App.InternalService.Port),
+			Protocol:      v1.ProtocolTCP,
+		})
+	}
 	cont.Ports = lo.UniqBy(cont.Ports, comparePort)
 	slices.SortFunc(cont.Ports, ds.LessFunc(comparePort))
 
@@ -162,13 +169,52 @@ func (b *Backend) runtimeSpec(app *domain.RuntimeDesiredState) (*appsv1.Stateful
 }
 
 func (b *Backend) runtimeServiceRef(app *domain.Application, website *domain.Website) []traefikv1alpha1.Service {
-	return []traefikv1alpha1.Service{{
-		Name:      deploymentName(app.ID),
-		Kind:      "Service",
-		Namespace: b.config.Namespace,
-		Port:      intstr.FromInt(website.HTTPPort),
-		Scheme:    lo.Ternary(website.H2C, "h2c", "http"),
-	}}
+	if !website.IsSplit() {
+		return []traefikv1alpha1.Service{{
+			Name:      deploymentName(app.ID),
+			Kind:      "Service",
+			Namespace: b.config.Namespace,
+			Port:      intstr.FromInt(website.HTTPPort),
+			Scheme:    lo.Ternary(website.H2C, "h2c", "http"),
+		}}
+	}
+	// Traefik balances the services by weighted round-robin
+	return ds.Map(website.Backends, func(backend *domain.WebsiteBackend) traefikv1alpha1.Service {
+		return traefikv1alpha1.Service{
+			Name:      lo.Ternary(backend.ApplicationID == app.ID, deploymentName(app.ID), splitServiceName(website, backend.ApplicationID)),
+			Kind:      "Service",
+			Namespace: b.config.Namespace,
+			Port:      intstr.FromInt(website.HTTPPort),
+			Scheme:    lo.Ternary(website.H2C, "h2c", "http"),
+			Weight:    new(backend.Weight),
+		}
+	})
+}
+
+// runtimeSplitService returns the service to the other application the traffic of the website is split to.
+// The backend application serves the same port as the website, see domain.Application.ServesPortOf.
+func (b *Backend) runtimeSplitService(app *domain.Application, website *domain.Website, backendAppID string) *v1.Service {
+	return &v1.Service{
+		Kind:       "Service",
+		APIVersion: "v1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      splitServiceName(website, backendAppID),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: v1.ServiceSpec{
+			Type:           "ClusterIP",
+			IPFamilies:     b.config.serviceIPFamilies(),
+			IPFamilyPolicy: b.config.serviceIPFamilyPolicy(),
+			Selector:       appSelector(backendAppID),
+			Ports: []v1.ServicePort{{
+				Name:       fmt.Sprintf("tcp-%d", website.HTTPPort),
+				Protocol:   v1.ProtocolTCP,
+				Port:       int32(website.HTTPPort),
+				TargetPort: intstr.FromInt(website.HTTPPort),
+			}},
+		},
+	}
 }
 
 var protocolMapper = mapper.MustNewValueMapper(map[domain.PortPublicationProtocol]v1.Protocol{
@@ -199,7 +245,91 @@ func (b *Backend) runtimePortService(app *domain.Application, port *domain.PortP
 	}
 }
 
-func (b *Backend) runtimeResources(next *resources, apps []*domain.RuntimeDesiredState) {
+// runtimeInternalService returns the service to reach the internal service of the app from other apps.
+// "<app-name>.apps.internal" is expected to be rewritten to this service by the cluster DNS.
+func (b *Backend) runtimeInternalService(app *domain.Application) *v1.Service {
+	return &v1.Service{
+		Kind:       "Service",
+		APIVersion: "v1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      internalServiceName(app),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.ID),
+		},
+		Spec: v1.ServiceSpec{
+			Type:           "ClusterIP",
+			IPFamilies:     b.config.serviceIPFamilies(),
+			IPFamilyPolicy: b.config.serviceIPFamilyPolicy(),
+			Selector:       appSelector(app.ID),
+			Ports: []v1.ServicePort{{
+				Protocol:   v1.ProtocolTCP,
+				Port:       int32(app.InternalService.Port),
+				TargetPort: intstr.FromInt(app.InternalService.Port),
+			}},
+		},
+	}
+}
+
+// runtimeNetworkPolicy restricts access to the app exposing an internal service:
+// other apps may only access the internal port if allowed, while non-app pods (such as the ingress controller)
+// and published ports are not restricted.
+func (b *Backend) runtimeNetworkPolicy(app *domain.RuntimeDesiredState) *networkingv1.NetworkPolicy {
+	rules := []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{},
+			PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      appIDLabel,
+				Operator: metav1.LabelSelectorOpDoesNotExist,
+			}}},
+		}},
+	}}
+	if len(app.InternalClientIDs) > 0 {
+		clientIDs := slices.Clone(app.InternalClientIDs)
+		slices.Sort(clientIDs)
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: new(v1.ProtocolTCP),
+				Port:     new(intstr.FromInt(app.App.InternalService.Port)),
+			}},
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      appIDLabel,
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   clientIDs,
+				}}},
+			}},
+		})
+	}
+	if len(app.App.PortPublications) > 0 {
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+			Ports: ds.Map(app.App.PortPublications, func(p *domain.PortPublication) networkingv1.NetworkPolicyPort {
+				return networkingv1.NetworkPolicyPort{
+					Protocol: new(protocolMapper.IntoMust(p.Protocol)),
+					Port:     new(intstr.FromInt(p.ApplicationPort)),
+				}
+			}),
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: "networking.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploymentName(app.App.ID),
+			Namespace: b.config.Namespace,
+			Labels:    b.appLabel(app.App.ID),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: appSelector(app.App.ID)},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     rules,
+		},
+	}
+}
+
+func (b *Backend) runtimeResources(next *resources, apps []*domain.RuntimeDesiredState, certs domain.TLSCertificateSlice) {
 	for _, app := range apps {
 		// Filter to sharded apps
 		if !b.cluster.IsAssigned(app.App.ID) {
@@ -215,12 +345,24 @@ func (b *Backend) runtimeResources(next *resources, apps []*domain.RuntimeDesire
 			next.secrets = append(next.secrets, secret)
 		}
 		for _, website := range app.App.Websites {
-			ingressRoute, mw := b.ingressRoute(app.App, website, b.runtimeServiceRef(app.App, website))
+			ingressRoute, mw := b.ingressRoute(app.App, website, b.runtimeServiceRef(app.App, website), certs)
 			next.middlewares = append(next.middlewares, mw...)
 			next.ingressRoutes = append(next.ingressRoutes, ingressRoute)
+			if secret := b.basicAuthSecret(app.App, website); secret != nil {
+				next.secrets = append(next.secrets, secret)
+			}
+			for _, backend := range website.Backends {
+				if backend.ApplicationID != app.App.ID {
+					next.services = append(next.services, b.runtimeSplitService(app.App, website, backend.ApplicationID))
+				}
+			}
 		}
 		for _, p := range app.App.PortPublications {
 			next.services = append(next.services, b.runtimePortService(app.App, p))
 		}
+		if app.App.InternalService.Enabled() {
+			next.services = append(next.services, b.runtimeInternalService(app.App))
+			next.networkPolicies = append(next.networkPolicies, b.runtimeNetworkPolicy(app))
+		}
 	}
 }
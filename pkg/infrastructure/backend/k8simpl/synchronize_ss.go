@@ -32,21 +32,22 @@ func (b *Backend) ssHeaderMiddleware(ss *domain.StaticSite) *traefikv1alpha1.Mid
 		Spec: traefikv1alpha1.MiddlewareSpec{
 			Headers: &dynamic.Headers{
 				CustomRequestHeaders: map[string]string{
-					web.HeaderNameSSGenAppID: ss.Application.ID,
+					web.HeaderNameSSGenAppID:     ss.Application.ID,
+					web.HeaderNameSSGenWebsiteID: ss.Website.ID,
 				},
 			},
 		},
 	}
 }
 
-func (b *Backend) ssResources(next *resources, sites []*domain.StaticSite) {
+func (b *Backend) ssResources(next *resources, sites []*domain.StaticSite, certs domain.TLSCertificateSlice) {
 	for _, site := range sites {
 		// Filter to sharded apps
 		if !b.cluster.IsAssigned(site.Application.ID) {
 			continue
 		}
 
-		ingressRoute, mw := b.ingressRoute(site.Application, site.Website, b.ssServiceRef())
+		ingressRoute, mw := b.ingressRoute(site.Application, site.Website, b.ssServiceRef(), certs)
 
 		ssHeaderMW := b.ssHeaderMiddleware(site)
 		ingressRoute.Spec.Routes[0].Middlewares = append(ingressRoute.Spec.Routes[0].Middlewares, traefikv1alpha1.MiddlewareRef{Name: ssHeaderMW.Name})
@@ -54,5 +55,8 @@ func (b *Backend) ssResources(next *resources, sites []*domain.StaticSite) {
 
 		next.middlewares = append(next.middlewares, mw...)
 		next.ingressRoutes = append(next.ingressRoutes, ingressRoute)
+		if secret := b.basicAuthSecret(site.Application, site.Website); secret != nil {
+			next.secrets = append(next.secrets, secret)
+		}
 	}
 }
@@ -9,6 +9,7 @@ import (
 	traefikv1alpha1 "github.com/traefik/traefik/v3/pkg/provider/kubernetes/crd/traefikio/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 )
@@ -140,4 +141,39 @@ func TestK8sBackend_Synchronize(t *testing.T) {
 		notExists[*traefikv1alpha1.IngressRoute](t, serviceName(website), tc.IngressRoutes(appNamespace))
 		notExists[*traefikv1alpha1.Middleware](t, stripMiddlewareName(website), tc.Middlewares(appNamespace))
 	})
+	t.Run("Podを正常に起動 (内部サービス)", func(t *testing.T) {
+		image := "tianon/sleeping-beauty"
+		appID := "nbvcxzlkjhgf"
+
+		app := domain.Application{
+			ID:   appID,
+			Name: "internal-test",
+			Config: domain.ApplicationConfig{
+				BuildConfig: &domain.BuildConfigRuntimeBuildpack{},
+			},
+			InternalService: domain.InternalService{Port: 8080},
+			UpdatedAt:       time.Now(),
+		}
+		st := domain.DesiredState{
+			Runtime: []*domain.RuntimeDesiredState{{
+				App:               &app,
+				ImageName:         image,
+				ImageTag:          "latest",
+				InternalClientIDs: []string{"qwertyuiopas"},
+			}},
+		}
+		err := m.Synchronize(context.Background(), &st)
+		require.NoError(t, err)
+		exists[*appsv1.StatefulSet](t, deploymentName(appID), c.AppsV1().StatefulSets(appNamespace))
+		exists[*corev1.Service](t, internalServiceName(&app), c.CoreV1().Services(appNamespace))
+		exists[*networkingv1.NetworkPolicy](t, deploymentName(appID), c.NetworkingV1().NetworkPolicies(appNamespace))
+		waitPodRunning(t, m, appID)
+
+		err = m.Synchronize(context.Background(), &domain.DesiredState{})
+		require.NoError(t, err)
+		waitPodDeleted(t, m, appID)
+		waitNotExists[*appsv1.StatefulSet](t, deploymentName(appID), c.AppsV1().StatefulSets(appNamespace))
+		notExists[*corev1.Service](t, internalServiceName(&app), c.CoreV1().Services(appNamespace))
+		notExists[*networkingv1.NetworkPolicy](t, deploymentName(appID), c.NetworkingV1().NetworkPolicies(appNamespace))
+	})
 }
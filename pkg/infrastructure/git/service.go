@@ -2,6 +2,7 @@ package git
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -217,6 +218,25 @@ func (r *repository) GetCommit(hash string) (*domain.RepositoryCommit, error) {
 	return toRepositoryCommit(commit), nil
 }
 
+func (r *repository) ReadFile(hash string, path string) ([]byte, error) {
+	commit, err := r.repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, oops.Wrapf(err, "get commit")
+	}
+	file, err := commit.File(path)
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, domain.ErrFileNotFound
+	}
+	if err != nil {
+		return nil, oops.Wrapf(err, "get file")
+	}
+	contents, err := file.Contents()
+	if err != nil {
+		return nil, oops.Wrapf(err, "read file")
+	}
+	return []byte(contents), nil
+}
+
 func toRepositoryCommit(c *object.Commit) *domain.RepositoryCommit {
 	return &domain.RepositoryCommit{
 		Hash: c.Hash.String(),
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pbconvert"
 	"github.com/traPtitech/neoshowcase/pkg/util/ds"
@@ -48,7 +49,7 @@ func (s *APIService) GetOutput(ctx context.Context, req *connect.Request[pb.GetO
 		return nil, connect.NewError(connect.CodeInvalidArgument, oops.New("before cannot be null"))
 	}
 	before := msg.Before.AsTime()
-	logs, err := s.svc.GetOutput(ctx, msg.ApplicationId, before, int(msg.Limit))
+	logs, err := s.svc.GetOutput(ctx, msg.ApplicationId, before, int(msg.Limit), pbconvert.FromPBLogFilter(msg.Filter))
 	if err != nil {
 		return nil, handleUseCaseError(err)
 	}
@@ -63,7 +64,7 @@ func (s *APIService) GetOutputStream(ctx context.Context, req *connect.Request[p
 		return connect.NewError(connect.CodeInvalidArgument, oops.New("begin cannot be null"))
 	}
 	begin := req.Msg.Begin.AsTime()
-	err := s.svc.GetOutputStream(ctx, req.Msg.ApplicationId, begin, func(l *domain.ContainerLog) error {
+	err := s.svc.GetOutputStream(ctx, req.Msg.ApplicationId, begin, pbconvert.FromPBLogFilter(req.Msg.Filter), func(l *domain.ContainerLog) error {
 		return st.Send(pbconvert.ToPBApplicationOutput(l))
 	})
 	if err != nil {
@@ -71,3 +72,115 @@ func (s *APIService) GetOutputStream(ctx context.Context, req *connect.Request[p
 	}
 	return nil
 }
+
+func (s *APIService) GetApplicationEvents(ctx context.Context, req *connect.Request[pb.GetApplicationEventsRequest]) (*connect.Response[pb.ApplicationEvents], error) {
+	msg := req.Msg
+	if msg.Before == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, oops.New("before cannot be null"))
+	}
+	events, err := s.svc.GetApplicationEvents(ctx, msg.ApplicationId, msg.Before.AsTime(), int(msg.Limit))
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.ApplicationEvents{
+		Events: ds.Map(events, pbconvert.ToPBApplicationEvent),
+	})
+	return res, nil
+}
+
+func (s *APIService) GetApplicationEventsStream(ctx context.Context, req *connect.Request[pb.GetApplicationEventsStreamRequest], st *connect.ServerStream[pb.ApplicationEvent]) error {
+	if req.Msg.Begin == nil {
+		return connect.NewError(connect.CodeInvalidArgument, oops.New("begin cannot be null"))
+	}
+	err := s.svc.GetApplicationEventsStream(ctx, req.Msg.ApplicationId, req.Msg.Begin.AsTime(), func(e *domain.ApplicationEvent) error {
+		return st.Send(pbconvert.ToPBApplicationEvent(e))
+	})
+	if err != nil {
+		return handleUseCaseError(err)
+	}
+	return nil
+}
+
+func (s *APIService) GetWebsiteStatus(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.WebsiteStatuses], error) {
+	statuses, err := s.svc.GetWebsiteStatus(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.WebsiteStatuses{
+		Statuses: ds.Map(statuses, pbconvert.ToPBWebsiteStatus),
+	})
+	return res, nil
+}
+
+func (s *APIService) GetAlertRules(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.AlertRules], error) {
+	rules, err := s.svc.GetAlertRules(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.AlertRules{
+		Rules: ds.Map(rules, pbconvert.ToPBAlertRule),
+	})
+	return res, nil
+}
+
+func (s *APIService) CreateAlertRule(ctx context.Context, req *connect.Request[pb.CreateAlertRuleRequest]) (*connect.Response[pb.AlertRule], error) {
+	rule, err := s.svc.CreateAlertRule(ctx, pbconvert.FromPBCreateAlertRuleRequest(req.Msg))
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(pbconvert.ToPBAlertRule(rule))
+	return res, nil
+}
+
+func (s *APIService) DeleteAlertRule(ctx context.Context, req *connect.Request[pb.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	msg := req.Msg
+	err := s.svc.DeleteAlertRule(ctx, msg.ApplicationId, msg.RuleId)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&emptypb.Empty{})
+	return res, nil
+}
+
+func (s *APIService) GetAlerts(ctx context.Context, req *connect.Request[pb.GetAlertsRequest]) (*connect.Response[pb.Alerts], error) {
+	msg := req.Msg
+	alerts, err := s.svc.GetAlerts(ctx, msg.ApplicationId, int(msg.Limit))
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.Alerts{
+		Alerts: ds.Map(alerts, pbconvert.ToPBAlert),
+	})
+	return res, nil
+}
+
+func (s *APIService) GetNotificationSubscriptions(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.NotificationSubscriptions], error) {
+	subs, err := s.svc.GetNotificationSubscriptions(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.NotificationSubscriptions{
+		Subscriptions: ds.Map(subs, pbconvert.ToPBNotificationSubscription),
+	})
+	return res, nil
+}
+
+func (s *APIService) CreateNotificationSubscription(ctx context.Context, req *connect.Request[pb.CreateNotificationSubscriptionRequest]) (*connect.Response[pb.NotificationSubscription], error) {
+	user := web.GetUser(ctx)
+	sub, err := s.svc.CreateNotificationSubscription(ctx, pbconvert.FromPBCreateNotificationSubscriptionRequest(req.Msg, user.ID))
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(pbconvert.ToPBNotificationSubscription(sub))
+	return res, nil
+}
+
+func (s *APIService) DeleteNotificationSubscription(ctx context.Context, req *connect.Request[pb.DeleteNotificationSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	msg := req.Msg
+	err := s.svc.DeleteNotificationSubscription(ctx, msg.ApplicationId, msg.SubscriptionId)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&emptypb.Empty{})
+	return res, nil
+}
@@ -44,7 +44,9 @@ func (s *APIService) CreateApplication(ctx context.Context, req *connect.Request
 		Config:           config,
 		Websites:         ds.Map(msg.Websites, pbconvert.FromPBCreateWebsiteRequest),
 		PortPublications: ds.Map(msg.PortPublications, pbconvert.FromPBPortPublication),
+		InternalService:  pbconvert.FromPBInternalService(msg.InternalService),
 		OwnerIDs:         ownerIDs,
+		ConfigFilePath:   msg.ConfigFilePath,
 	}
 	app, err = s.svc.CreateApplication(ctx, app)
 	if err != nil {
@@ -54,6 +56,24 @@ func (s *APIService) CreateApplication(ctx context.Context, req *connect.Request
 	return res, nil
 }
 
+func (s *APIService) DuplicateApplication(ctx context.Context, req *connect.Request[pb.DuplicateApplicationRequest]) (*connect.Response[pb.Application], error) {
+	msg := req.Msg
+	app, err := s.svc.DuplicateApplication(ctx, msg.Id, &domain.DuplicateApplicationArgs{
+		Name:          msg.Name,
+		RefName:       msg.RefName,
+		WebsiteFQDNs:  msg.WebsiteFqdns,
+		InternetPorts: ds.Map(msg.InternetPorts, func(port int32) int { return int(port) }),
+		StartOnCreate: msg.StartOnCreate,
+		// System env is excluded unless explicitly requested, so that the copy does not share the databases by accident
+		ExcludeSystemEnv: msg.ExcludeSystemEnv == nil || *msg.ExcludeSystemEnv,
+	})
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(pbconvert.ToPBApplication(app, nil))
+	return res, nil
+}
+
 func (s *APIService) GetApplications(ctx context.Context, req *connect.Request[pb.GetApplicationsRequest]) (*connect.Response[pb.GetApplicationsResponse], error) {
 	scope := apiserver.GetAppScope{
 		Scope:        pbconvert.AppScopeMapper.IntoMust(req.Msg.Scope),
@@ -89,7 +109,9 @@ func (s *APIService) UpdateApplication(ctx context.Context, req *connect.Request
 		Config:           optional.FromNonZero(msg.Config).Map(pbconvert.FromPBApplicationConfig),
 		Websites:         optional.FromNonZero(msg.Websites).Map(pbconvert.FromPBUpdateWebsites),
 		PortPublications: optional.FromNonZero(msg.PortPublications).Map(pbconvert.FromPBUpdatePorts),
+		InternalService:  optional.FromNonZero(msg.InternalService).Map(pbconvert.FromPBInternalService),
 		OwnerIDs:         optional.FromNonZero(msg.OwnerIds).Map(pbconvert.FromPBUpdateOwners),
+		ConfigFilePath:   optional.FromPtr(msg.ConfigFilePath),
 	})
 	if err != nil {
 		return nil, handleUseCaseError(err)
@@ -106,3 +128,28 @@ func (s *APIService) DeleteApplication(ctx context.Context, req *connect.Request
 	res := connect.NewResponse(&emptypb.Empty{})
 	return res, nil
 }
+
+func (s *APIService) ExportApplications(ctx context.Context, req *connect.Request[pb.ExportApplicationsRequest]) (*connect.Response[pb.ExportApplicationsResponse], error) {
+	msg := req.Msg
+	manifest, err := s.svc.ExportApplications(ctx, msg.ApplicationIds, pbconvert.ManifestFormatMapper.FromMust(msg.Format))
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.ExportApplicationsResponse{
+		Manifest: string(manifest),
+	})
+	return res, nil
+}
+
+func (s *APIService) ApplyManifest(ctx context.Context, req *connect.Request[pb.ApplyManifestRequest]) (*connect.Response[pb.ApplyManifestResponse], error) {
+	msg := req.Msg
+	results, applied, err := s.svc.ApplyManifest(ctx, []byte(msg.Manifest), msg.DryRun)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.ApplyManifestResponse{
+		Results: ds.Map(results, pbconvert.ToPBManifestApplicationResult),
+		Applied: applied,
+	})
+	return res, nil
+}
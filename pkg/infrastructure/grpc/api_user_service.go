@@ -7,9 +7,11 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pbconvert"
 	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 func (s *APIService) GetMe(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[pb.User], error) {
@@ -59,3 +61,95 @@ func (s *APIService) DeleteUserKey(ctx context.Context, c *connect.Request[pb.De
 	res := connect.NewResponse(&emptypb.Empty{})
 	return res, nil
 }
+
+func (s *APIService) GetMyUsage(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetMyUsageResponse], error) {
+	quota, usage, custom, err := s.svc.GetMyUsage(ctx)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.GetMyUsageResponse{
+		Quota:       pbconvert.ToPBResourceQuota(quota),
+		Usage:       pbconvert.ToPBResourceUsage(usage),
+		CustomQuota: custom,
+	})
+	return res, nil
+}
+
+func (s *APIService) SetUserQuota(ctx context.Context, c *connect.Request[pb.SetUserQuotaRequest]) (*connect.Response[emptypb.Empty], error) {
+	quota := optional.FromNonZero(c.Msg.Quota).Map(pbconvert.FromPBResourceQuota)
+	err := s.svc.SetUserQuota(ctx, c.Msg.UserId, quota)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&emptypb.Empty{})
+	return res, nil
+}
+
+func (s *APIService) GetCustomDomains(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetCustomDomainsResponse], error) {
+	domains, err := s.svc.GetCustomDomains(ctx)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.GetCustomDomainsResponse{
+		Domains: ds.Map(domains, pbconvert.ToPBCustomDomain),
+	})
+	return res, nil
+}
+
+func (s *APIService) CreateCustomDomain(ctx context.Context, req *connect.Request[pb.CreateCustomDomainRequest]) (*connect.Response[pb.CustomDomain], error) {
+	user := web.GetUser(ctx)
+	d, err := s.svc.CreateCustomDomain(ctx, pbconvert.FromPBCreateCustomDomainRequest(req.Msg, user.ID))
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(pbconvert.ToPBCustomDomain(d))
+	return res, nil
+}
+
+func (s *APIService) VerifyCustomDomain(ctx context.Context, req *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[pb.CustomDomain], error) {
+	d, err := s.svc.VerifyCustomDomain(ctx, req.Msg.DomainId)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(pbconvert.ToPBCustomDomain(d))
+	return res, nil
+}
+
+func (s *APIService) DeleteCustomDomain(ctx context.Context, req *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[emptypb.Empty], error) {
+	err := s.svc.DeleteCustomDomain(ctx, req.Msg.DomainId)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&emptypb.Empty{})
+	return res, nil
+}
+
+func (s *APIService) GetTLSCertificates(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetTLSCertificatesResponse], error) {
+	certs, err := s.svc.GetTLSCertificates(ctx)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&pb.GetTLSCertificatesResponse{
+		Certificates: ds.Map(certs, pbconvert.ToPBTLSCertificate),
+	})
+	return res, nil
+}
+
+func (s *APIService) UploadTLSCertificate(ctx context.Context, req *connect.Request[pb.UploadTLSCertificateRequest]) (*connect.Response[pb.TLSCertificate], error) {
+	msg := req.Msg
+	c, err := s.svc.UploadTLSCertificate(ctx, msg.Fqdn, msg.Certificate, msg.PrivateKey)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(pbconvert.ToPBTLSCertificate(c))
+	return res, nil
+}
+
+func (s *APIService) DeleteTLSCertificate(ctx context.Context, req *connect.Request[pb.TLSCertificateIdRequest]) (*connect.Response[emptypb.Empty], error) {
+	err := s.svc.DeleteTLSCertificate(ctx, req.Msg.CertificateId)
+	if err != nil {
+		return nil, handleUseCaseError(err)
+	}
+	res := connect.NewResponse(&emptypb.Empty{})
+	return res, nil
+}
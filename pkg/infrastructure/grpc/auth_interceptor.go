@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -10,30 +11,62 @@ import (
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/oidc"
 )
 
 type AuthInterceptor struct {
 	userCache *sc.Cache[string, *domain.User]
 	header    string
+	sessions  *oidc.Provider
 }
 
 type AuthHeader string
 
 var _ connect.Interceptor = &AuthInterceptor{}
 
+// NewAuthInterceptor creates an interceptor authenticating users by the session of the built-in login (if sessions is non-nil),
+// or by the header set by the forward-auth proxy (if header is non-empty).
+// The header is ignored when the built-in login is enabled, since the gateway is then expected to be reachable
+// without the proxy, where the header could be spoofed by clients.
 func NewAuthInterceptor(
 	userRepo domain.UserRepository,
 	header AuthHeader,
+	sessions *oidc.Provider,
 ) *AuthInterceptor {
+	if sessions != nil && header != "" {
+		slog.Warn("authentication by header is disabled since the built-in login is enabled", "header", header)
+		header = ""
+	}
 	return &AuthInterceptor{
 		userCache: sc.NewMust(func(ctx context.Context, name string) (*domain.User, error) {
 			return userRepo.EnsureUser(ctx, name)
 		}, 1*time.Minute, 2*time.Minute),
-		header: string(header),
+		header:   string(header),
+		sessions: sessions,
 	}
 }
 
 func (a *AuthInterceptor) authenticate(ctx *context.Context, headers http.Header) error {
+	if a.sessions != nil {
+		if session, ok := a.sessions.Authenticate(headers); ok {
+			user, err := a.userCache.Get(*ctx, session.Name)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+			if session.Admin && !user.Admin {
+				// Admin granted by the identity provider claims
+				adminUser := *user
+				adminUser.Admin = true
+				user = &adminUser
+			}
+			web.SetUser(ctx, user)
+			return nil
+		}
+	}
+
+	if a.header == "" {
+		return connect.NewError(connect.CodeUnauthenticated, nil)
+	}
 	name := headers.Get(a.header)
 	if name == "" {
 		return connect.NewError(connect.CodeUnauthenticated, nil)
@@ -69,3 +102,16 @@ func (a *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 		return next(ctx, conn)
 	}
 }
+
+// Middleware authenticates plain HTTP requests served outside of connect, such as file downloads.
+func (a *AuthInterceptor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		err := a.authenticate(&ctx, r.Header)
+		if err != nil {
+			writeHTTPError(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -13,6 +13,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/samber/lo"
 	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
@@ -61,6 +64,8 @@ type ControllerBuilderService struct {
 	buildRepo        domain.BuildRepository
 	envRepo          domain.EnvironmentRepository
 	gitRepo          domain.GitRepositoryRepository
+	eventRepo        domain.ApplicationEventRepository
+	notifier         domain.Notifier
 
 	idle    domain.PubSub[struct{}]
 	settled domain.PubSub[struct{}]
@@ -82,6 +87,8 @@ func NewControllerBuilderService(
 	buildRepo domain.BuildRepository,
 	envRepo domain.EnvironmentRepository,
 	gitRepo domain.GitRepositoryRepository,
+	eventRepo domain.ApplicationEventRepository,
+	notifier domain.Notifier,
 	metrics *observability.ControllerMetrics,
 ) domain.ControllerBuilderService {
 	return &ControllerBuilderService{
@@ -97,6 +104,8 @@ func NewControllerBuilderService(
 		buildRepo:        buildRepo,
 		envRepo:          envRepo,
 		gitRepo:          gitRepo,
+		eventRepo:        eventRepo,
+		notifier:         notifier,
 		metrics:          metrics,
 	}
 }
@@ -145,6 +154,7 @@ func (s *ControllerBuilderService) SaveArtifact(ctx context.Context, req *connec
 	if err != nil {
 		return nil, err
 	}
+	s.metrics.ObserveArtifactSize(artifact.Size)
 
 	res := connect.NewResponse(&emptypb.Empty{})
 	return res, nil
@@ -165,6 +175,7 @@ func (s *ControllerBuilderService) SaveRuntimeImage(ctx context.Context, req *co
 	if err != nil {
 		return nil, err
 	}
+	s.metrics.ObserveRuntimeImageSize(image.Size)
 	res := connect.NewResponse(&emptypb.Empty{})
 	return res, nil
 }
@@ -181,12 +192,14 @@ func (s *ControllerBuilderService) ConnectBuilder(ctx context.Context, st *conne
 	conn := &builderConnection{reqSender: reqSender}
 	s.lock.Lock()
 	s.builderConnections = append(s.builderConnections, conn)
+	s.updateBuilderMetrics()
 	s.lock.Unlock()
 
 	defer func() {
 		s.lock.Lock()
 		defer s.lock.Unlock()
 		s.builderConnections = lo.Without(s.builderConnections, conn)
+		s.updateBuilderMetrics()
 	}()
 
 	go func() {
@@ -211,11 +224,12 @@ func (s *ControllerBuilderService) ConnectBuilder(ctx context.Context, st *conne
 			case pb.BuilderResponse_BUILD_SETTLED:
 				payload := res.Body.(*pb.BuilderResponse_Settled).Settled
 				status := pbconvert.BuildStatusMapper.FromMust(payload.Status)
-				err := s.finishBuild(ctx, payload.BuildId, status)
+				err := s.finishBuild(ctx, payload.BuildId, status, payload.Steps)
 				if err != nil {
 					slog.ErrorContext(ctx, "error finishing build", "error", err)
 				}
 				conn.ClearBuildID()
+				s.updateBuilderMetrics()
 				s.idle.Publish(struct{}{})
 				s.settled.Publish(struct{}{})
 				s.logStream.CloseBuildLog(payload.BuildId)
@@ -240,6 +254,13 @@ loop:
 	return nil
 }
 
+// updateBuilderMetrics updates the builder slot utilization metrics.
+// lock must be held by the caller.
+func (s *ControllerBuilderService) updateBuilderMetrics() {
+	busy := lo.CountBy(s.builderConnections, (*builderConnection).Busy)
+	s.metrics.SetBuilders(len(s.builderConnections), busy)
+}
+
 func (s *ControllerBuilderService) ListenBuilderIdle() (sub <-chan struct{}, unsub func()) {
 	return s.idle.Subscribe()
 }
@@ -275,7 +296,16 @@ func (s *ControllerBuilderService) startBuildPayload(ctx context.Context, buildI
 
 var errBuildLockConflict = errors.New("build lock conflict")
 
-func (s *ControllerBuilderService) startBuild(ctx context.Context, conn *builderConnection, buildID string) error {
+func (s *ControllerBuilderService) startBuild(ctx context.Context, conn *builderConnection, buildID string) (err error) {
+	ctx, span := observability.Tracer().Start(ctx, "StartBuild", trace.WithAttributes(attribute.String("build.id", buildID)))
+	defer func() {
+		if err != nil && !errors.Is(err, errBuildLockConflict) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	// Change build status in order to acquire lock
 	now := time.Now()
 	updateCond := domain.GetBuildCondition{
@@ -300,6 +330,8 @@ func (s *ControllerBuilderService) startBuild(ctx context.Context, conn *builder
 	if err != nil {
 		return oops.With("build_id", buildID).Wrapf(err, "constructing start build payload")
 	}
+	span.SetAttributes(attribute.String("app.id", req.App.ID))
+	req.TraceContext = observability.InjectTraceContext(ctx)
 	// Send payload to builder
 	conn.Send(&pb.BuilderRequest{
 		Type: pb.BuilderRequest_START_BUILD,
@@ -307,14 +339,21 @@ func (s *ControllerBuilderService) startBuild(ctx context.Context, conn *builder
 	})
 	// Mark connection as busy
 	conn.SetBuildID(buildID)
+	s.updateBuilderMetrics()
+	s.metrics.ObserveQueueWait(req.App.Config.BuildConfig.BuildType(), now.Sub(req.Build.QueuedAt))
 
 	// Start log stream service
 	s.logStream.StartBuildLog(buildID)
 
+	err = s.eventRepo.CreateEvents(ctx, []*domain.ApplicationEvent{domain.NewBuildStartedEvent(req.Build)})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record build started event", "build_id", buildID, "error", err) // fail-safe
+	}
+
 	return nil
 }
 
-func (s *ControllerBuilderService) finishBuild(ctx context.Context, buildID string, status domain.BuildStatus) error {
+func (s *ControllerBuilderService) finishBuild(ctx context.Context, buildID string, status domain.BuildStatus, steps []*pb.BuildStepDuration) error {
 	now := time.Now()
 	updateCond := domain.GetBuildCondition{
 		ID:     optional.From(buildID),
@@ -333,19 +372,36 @@ func (s *ControllerBuilderService) finishBuild(ctx context.Context, buildID stri
 		return oops.With("build_id", buildID).New("changing build status from building to finished: no row updated, builder scheduling may be malfunctioning")
 	}
 
-	// metrics
+	// events, metrics and notifications
 	// errors are ignored
 	build, err := s.buildRepo.GetBuild(ctx, buildID)
 	if err != nil {
 		slog.WarnContext(ctx, "getting build for metrics", "error", err)
 		return nil
 	}
+	err = s.eventRepo.CreateEvents(ctx, []*domain.ApplicationEvent{domain.NewBuildFinishedEvent(build)})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record build finished event", "build_id", buildID, "error", err)
+	}
 	app, err := s.appRepo.GetApplication(ctx, build.ApplicationID)
 	if err != nil {
 		slog.WarnContext(ctx, "getting application for metrics", "error", err)
 		return nil
 	}
-	s.metrics.IncrementBuild(status, app.Config.BuildConfig.BuildType())
+	buildType := app.Config.BuildConfig.BuildType()
+	s.metrics.IncrementBuild(status, buildType)
+	if build.StartedAt.Valid {
+		s.metrics.ObserveBuildDuration(status, buildType, now.Sub(build.StartedAt.V))
+	}
+	for _, step := range steps {
+		s.metrics.ObserveBuildStep(buildType, step.Name, step.Duration.AsDuration())
+	}
+	if n := domain.NewBuildNotification(app, build); n != nil {
+		err = s.notifier.Notify(ctx, n)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to notify build result", "build_id", buildID, "error", err)
+		}
+	}
 
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
@@ -25,15 +26,19 @@ func NewControllerBuilderServiceClient(
 	c ControllerServiceClientConfig,
 	priority int,
 	auth *TokenAuthInterceptor,
-) domain.ControllerBuilderServiceClient {
+) (domain.ControllerBuilderServiceClient, error) {
+	otelInterceptor, err := otelconnect.NewInterceptor()
+	if err != nil {
+		return nil, err
+	}
 	return &ControllerBuilderServiceClient{
 		client: pbconnect.NewControllerBuilderServiceClient(
 			web.NewH2CClient(),
 			c.URL,
-			connect.WithInterceptors(auth),
+			connect.WithInterceptors(auth, otelInterceptor),
 		),
 		priority: priority,
-	}
+	}, nil
 }
 
 func (c *ControllerBuilderServiceClient) GetBuilderSystemInfo(ctx context.Context) (*domain.BuilderSystemInfo, error) {
@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/builder"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb/pbconnect"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
+	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/logstream"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+type fakeBuildRepository struct {
+	domain.BuildRepository
+	build *domain.Build
+}
+
+func (r *fakeBuildRepository) GetBuild(context.Context, string) (*domain.Build, error) {
+	return r.build, nil
+}
+
+func (r *fakeBuildRepository) UpdateBuild(_ context.Context, cond domain.GetBuildCondition, args domain.UpdateBuildArgs) (int64, error) {
+	if cond.Status.Valid && cond.Status.V != r.build.Status {
+		return 0, nil
+	}
+	if args.Status.Valid {
+		r.build.Status = args.Status.V
+	}
+	if args.StartedAt.Valid {
+		r.build.StartedAt = args.StartedAt
+	}
+	return 1, nil
+}
+
+type fakeEnvironmentRepository struct {
+	domain.EnvironmentRepository
+}
+
+func (r *fakeEnvironmentRepository) GetEnv(context.Context, domain.GetEnvCondition) ([]*domain.Environment, error) {
+	return nil, nil
+}
+
+type fakeGitRepositoryRepository struct {
+	domain.GitRepositoryRepository
+	repo *domain.Repository
+}
+
+func (r *fakeGitRepositoryRepository) GetRepository(context.Context, string) (*domain.Repository, error) {
+	return r.repo, nil
+}
+
+type fakeEventRepository struct {
+	domain.ApplicationEventRepository
+}
+
+func (r *fakeEventRepository) CreateEvents(context.Context, []*domain.ApplicationEvent) error {
+	return nil
+}
+
+type fakeNotifier struct{}
+
+func (fakeNotifier) Notify(context.Context, *domain.Notification) error { return nil }
+
+// controllerMetrics registers the metrics to the default registry only once, even if the tests are run multiple times.
+var controllerMetrics = sync.OnceValue(observability.NewControllerMetrics)
+
+// builderGauge returns the value of the builders gauge in the default registry.
+func builderGauge(t *testing.T, state string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != "neoshowcase_controller_builders" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "state" && l.GetValue() == state {
+					return m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestControllerBuilderService_BuilderMetrics(t *testing.T) {
+	app := &domain.Application{
+		ID:           "app",
+		Name:         "app",
+		RepositoryID: "repo",
+		RefName:      "main",
+		DeployType:   domain.DeployTypeRuntime,
+		Config: domain.ApplicationConfig{
+			BuildConfig: &domain.BuildConfigRuntimeDockerfile{DockerfileName: "Dockerfile"},
+		},
+	}
+	build := domain.NewBuild(app, nil)
+	appRepo := &mocks.ApplicationRepositoryMock{
+		GetApplicationFunc: func(ctx context.Context, id string) (*domain.Application, error) {
+			return app, nil
+		},
+	}
+	buildRepo := &fakeBuildRepository{build: build}
+	gitRepo := &fakeGitRepositoryRepository{repo: &domain.Repository{ID: "repo", URL: "https://example.com/repo.git", Auth: optional.None[domain.RepositoryAuth]()}}
+
+	svc := NewControllerBuilderService(
+		logstream.NewService(), nil, builder.ImageConfig{}, nil,
+		appRepo, nil, nil, buildRepo, &fakeEnvironmentRepository{}, gitRepo, &fakeEventRepository{}, fakeNotifier{},
+		controllerMetrics(),
+	).(*ControllerBuilderService)
+	srv := httptest.NewUnstartedServer(http.NewServeMux())
+	path, handler := pbconnect.NewControllerBuilderServiceHandler(svc)
+	srv.Config.Handler.(*http.ServeMux).Handle(path, handler)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	client := pbconnect.NewControllerBuilderServiceClient(srv.Client(), srv.URL)
+
+	assertGauges := func(idle, busy float64) {
+		t.Helper()
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, idle, builderGauge(t, "idle"))
+			assert.Equal(c, busy, builderGauge(t, "busy"))
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	st := client.ConnectBuilder(ctx)
+	require.NoError(t, st.Send(&pb.BuilderResponse{
+		Type: pb.BuilderResponse_CONNECTED,
+		Body: &pb.BuilderResponse_Connected{Connected: &pb.ConnectedBody{}},
+	}))
+	assertGauges(1, 0)
+
+	svc.StartBuilds(ctx, []string{build.ID})
+	req, err := st.Receive()
+	require.NoError(t, err)
+	assert.Equal(t, pb.BuilderRequest_START_BUILD, req.Type)
+	assertGauges(0, 1)
+
+	require.NoError(t, st.Send(&pb.BuilderResponse{
+		Type: pb.BuilderResponse_BUILD_SETTLED,
+		Body: &pb.BuilderResponse_Settled{Settled: &pb.BuildSettled{BuildId: build.ID, Status: pb.BuildStatus_SUCCEEDED}},
+	}))
+	assertGauges(1, 0)
+
+	require.NoError(t, st.CloseRequest())
+	require.NoError(t, st.CloseResponse())
+	assertGauges(0, 0)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
@@ -19,10 +20,17 @@ type GiteaIntegrationServiceURL string
 
 func NewGiteaIntegrationServiceClient(
 	url GiteaIntegrationServiceURL,
-) domain.GiteaIntegrationServiceClient {
-	return &GiteaIntegrationServiceClient{
-		client: pbconnect.NewGiteaIntegrationServiceClient(web.NewH2CClient(), string(url)),
+) (domain.GiteaIntegrationServiceClient, error) {
+	otelInterceptor, err := otelconnect.NewInterceptor()
+	if err != nil {
+		return nil, err
 	}
+	return &GiteaIntegrationServiceClient{
+		client: pbconnect.NewGiteaIntegrationServiceClient(
+			web.NewH2CClient(),
+			string(url),
+			connect.WithInterceptors(otelInterceptor)),
+	}, nil
 }
 
 func (c *GiteaIntegrationServiceClient) Sync(ctx context.Context) error {
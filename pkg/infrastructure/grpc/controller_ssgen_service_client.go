@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
@@ -18,10 +19,17 @@ type ControllerSSGenServiceClient struct {
 
 func NewControllerSSGenServiceClient(
 	c ControllerServiceClientConfig,
-) domain.ControllerSSGenServiceClient {
-	return &ControllerSSGenServiceClient{
-		client: pbconnect.NewControllerSSGenServiceClient(web.NewH2CClient(), c.URL),
+) (domain.ControllerSSGenServiceClient, error) {
+	otelInterceptor, err := otelconnect.NewInterceptor()
+	if err != nil {
+		return nil, err
 	}
+	return &ControllerSSGenServiceClient{
+		client: pbconnect.NewControllerSSGenServiceClient(
+			web.NewH2CClient(),
+			c.URL,
+			connect.WithInterceptors(otelInterceptor)),
+	}, nil
 }
 
 func (c *ControllerSSGenServiceClient) ConnectSSGen(ctx context.Context, onRequest func(req *pb.SSGenRequest)) error {
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"bufio"
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/apiserver"
+)
+
+// LogExportBasePath is the path prefix of the log export endpoints served by the gateway.
+const LogExportBasePath = "/api/export/"
+
+// LogExportHandler serves logs as file downloads, which do not fit into the message size of the RPCs.
+//
+//   - GET /api/export/applications/{id}/output?since=&until=&format=&contains=&regexp=&stream=
+//   - GET /api/export/applications/{id}/build-logs?since=&until=&format=
+//
+// since and until are in RFC3339, format is either "ndjson" (default) or "text".
+type LogExportHandler struct {
+	svc *apiserver.Service
+	mux *http.ServeMux
+}
+
+func NewLogExportHandler(svc *apiserver.Service) *LogExportHandler {
+	h := &LogExportHandler{svc: svc, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET "+LogExportBasePath+"applications/{id}/output", h.exportOutput)
+	h.mux.HandleFunc("GET "+LogExportBasePath+"applications/{id}/build-logs", h.exportBuildLogs)
+	return h
+}
+
+func (h *LogExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+type exportParams struct {
+	since, until time.Time
+	format       domain.LogExportFormat
+}
+
+func parseExportParams(r *http.Request) (*exportParams, error) {
+	q := r.URL.Query()
+	since, err := time.Parse(time.RFC3339, q.Get("since"))
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid since")
+	}
+	until, err := time.Parse(time.RFC3339, q.Get("until"))
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid until")
+	}
+	format, err := domain.ParseLogExportFormat(q.Get("format"))
+	if err != nil {
+		return nil, err
+	}
+	return &exportParams{since: since, until: until, format: format}, nil
+}
+
+func parseLogFilter(r *http.Request) (domain.LogFilter, error) {
+	q := r.URL.Query()
+	filter := domain.LogFilter{
+		Contains: q.Get("contains"),
+		Regexp:   q.Get("regexp"),
+	}
+	switch q.Get("stream") {
+	case "", "all":
+		filter.Stream = domain.LogStreamAll
+	case "stdout":
+		filter.Stream = domain.LogStreamStdout
+	case "stderr":
+		filter.Stream = domain.LogStreamStderr
+	default:
+		return domain.LogFilter{}, oops.Errorf("unknown stream %q (supported values: all, stdout, stderr)", q.Get("stream"))
+	}
+	return filter, nil
+}
+
+func (h *LogExportHandler) exportOutput(w http.ResponseWriter, r *http.Request) {
+	params, err := parseExportParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter, err := parseLogFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.export(w, r, "output", params, func(e *domain.LogExporter) error {
+		return h.svc.ExportOutput(r.Context(), r.PathValue("id"), params.since, params.until, filter, e)
+	})
+}
+
+func (h *LogExportHandler) exportBuildLogs(w http.ResponseWriter, r *http.Request) {
+	params, err := parseExportParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.export(w, r, "build-logs", params, func(e *domain.LogExporter) error {
+		return h.svc.ExportBuildLogs(r.Context(), r.PathValue("id"), params.since, params.until, e)
+	})
+}
+
+func (h *LogExportHandler) export(w http.ResponseWriter, r *http.Request, kind string, params *exportParams, export func(e *domain.LogExporter) error) {
+	ctx := r.Context()
+	app, err := h.svc.GetApplication(ctx, r.PathValue("id"))
+	if err != nil {
+		writeHTTPError(w, r, handleUseCaseError(err))
+		return
+	}
+
+	dw := &downloadWriter{
+		w:           w,
+		fileName:    domain.LogExportFileName(app.App, kind, params.since, params.until, params.format),
+		contentType: params.format.ContentType(),
+	}
+	bw := bufio.NewWriter(dw)
+	err = export(domain.NewLogExporter(bw, params.format))
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		if !dw.started {
+			writeHTTPError(w, r, handleUseCaseError(err))
+			return
+		}
+		// Response has already started, the client sees a truncated file
+		slog.ErrorContext(ctx, "failed to export logs", "app_id", app.App.ID, "error", err)
+		return
+	}
+	dw.start() // in case of empty file
+}
+
+// downloadWriter sends the headers of a file download on the first write,
+// so that errors before any write can still be sent as an error response.
+type downloadWriter struct {
+	w           http.ResponseWriter
+	fileName    string
+	contentType string
+	started     bool
+}
+
+func (d *downloadWriter) start() {
+	if d.started {
+		return
+	}
+	d.started = true
+	d.w.Header().Set("Content-Type", d.contentType)
+	d.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": d.fileName}))
+	d.w.WriteHeader(http.StatusOK)
+}
+
+func (d *downloadWriter) Write(p []byte) (int, error) {
+	d.start()
+	return d.w.Write(p)
+}
+
+var httpStatuses = map[connect.Code]int{
+	connect.CodeInvalidArgument:    http.StatusBadRequest,
+	connect.CodeUnauthenticated:    http.StatusUnauthorized,
+	connect.CodePermissionDenied:   http.StatusForbidden,
+	connect.CodeNotFound:           http.StatusNotFound,
+	connect.CodeAlreadyExists:      http.StatusConflict,
+	connect.CodeFailedPrecondition: http.StatusPreconditionFailed,
+}
+
+// writeHTTPError writes the error returned by handleUseCaseError to plain HTTP responses.
+func writeHTTPError(w http.ResponseWriter, r *http.Request, err error) {
+	status, ok := httpStatuses[connect.CodeOf(err)]
+	if !ok {
+		status = http.StatusInternalServerError
+		slog.ErrorContext(r.Context(), "failed to handle request", "path", r.URL.Path, "error", err)
+	}
+	message := http.StatusText(status)
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Message() != "" {
+		message = connectErr.Message()
+	}
+	http.Error(w, message, status)
+}
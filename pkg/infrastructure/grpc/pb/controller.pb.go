@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
@@ -111,7 +112,7 @@ func (x BuilderResponse_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BuilderResponse_Type.Descriptor instead.
 func (BuilderResponse_Type) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{12, 0}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type HelperExecResponse_Type int32
@@ -157,7 +158,7 @@ func (x HelperExecResponse_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HelperExecResponse_Type.Descriptor instead.
 func (HelperExecResponse_Type) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{16, 0}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type SSGenRequest_Type int32
@@ -200,7 +201,7 @@ func (x SSGenRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SSGenRequest_Type.Descriptor instead.
 func (SSGenRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{17, 0}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type GiteaIntegrationRequest_Type int32
@@ -243,7 +244,7 @@ func (x GiteaIntegrationRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GiteaIntegrationRequest_Type.Descriptor instead.
 func (GiteaIntegrationRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{18, 0}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type AddressInfo struct {
@@ -679,11 +680,13 @@ func (x *RepositoryPrivate) GetSshKey() string {
 }
 
 type StartBuildRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          *RepositoryPrivate     `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	App           *Application           `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
-	AppEnvs       *ApplicationEnvVars    `protobuf:"bytes,3,opt,name=app_envs,json=appEnvs,proto3" json:"app_envs,omitempty"`
-	Build         *Build                 `protobuf:"bytes,4,opt,name=build,proto3" json:"build,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Repo    *RepositoryPrivate     `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	App     *Application           `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	AppEnvs *ApplicationEnvVars    `protobuf:"bytes,3,opt,name=app_envs,json=appEnvs,proto3" json:"app_envs,omitempty"`
+	Build   *Build                 `protobuf:"bytes,4,opt,name=build,proto3" json:"build,omitempty"`
+	// Trace context of the controller, to continue the trace in the builder
+	TraceContext  map[string]string `protobuf:"bytes,5,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -746,6 +749,13 @@ func (x *StartBuildRequest) GetBuild() *Build {
 	return nil
 }
 
+func (x *StartBuildRequest) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 type BuilderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Type  BuilderRequest_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=neoshowcase.protobuf.BuilderRequest_Type" json:"type,omitempty"`
@@ -881,17 +891,71 @@ func (x *ConnectedBody) GetPriority() int64 {
 	return 0
 }
 
-type BuildSettled struct {
+type BuildStepDuration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuildId       string                 `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	Status        BuildStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=neoshowcase.protobuf.BuildStatus" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildStepDuration) Reset() {
+	*x = BuildStepDuration{}
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildStepDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildStepDuration) ProtoMessage() {}
+
+func (x *BuildStepDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildStepDuration.ProtoReflect.Descriptor instead.
+func (*BuildStepDuration) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BuildStepDuration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildStepDuration) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type BuildSettled struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BuildId string                 `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Status  BuildStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=neoshowcase.protobuf.BuildStatus" json:"status,omitempty"`
+	// Durations of the executed steps, for metrics
+	Steps         []*BuildStepDuration `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuildSettled) Reset() {
 	*x = BuildSettled{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[11]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +967,7 @@ func (x *BuildSettled) String() string {
 func (*BuildSettled) ProtoMessage() {}
 
 func (x *BuildSettled) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[11]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +980,7 @@ func (x *BuildSettled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildSettled.ProtoReflect.Descriptor instead.
 func (*BuildSettled) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{11}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BuildSettled) GetBuildId() string {
@@ -933,6 +997,13 @@ func (x *BuildSettled) GetStatus() BuildStatus {
 	return BuildStatus_QUEUED
 }
 
+func (x *BuildSettled) GetSteps() []*BuildStepDuration {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
 type BuilderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Type  BuilderResponse_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=neoshowcase.protobuf.BuilderResponse_Type" json:"type,omitempty"`
@@ -947,7 +1018,7 @@ type BuilderResponse struct {
 
 func (x *BuilderResponse) Reset() {
 	*x = BuilderResponse{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[12]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1030,7 @@ func (x *BuilderResponse) String() string {
 func (*BuilderResponse) ProtoMessage() {}
 
 func (x *BuilderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[12]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1043,7 @@ func (x *BuilderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuilderResponse.ProtoReflect.Descriptor instead.
 func (*BuilderResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{12}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BuilderResponse) GetType() BuilderResponse_Type {
@@ -1033,7 +1104,7 @@ type CopyFileTreeRequest struct {
 
 func (x *CopyFileTreeRequest) Reset() {
 	*x = CopyFileTreeRequest{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[13]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1116,7 @@ func (x *CopyFileTreeRequest) String() string {
 func (*CopyFileTreeRequest) ProtoMessage() {}
 
 func (x *CopyFileTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[13]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1129,7 @@ func (x *CopyFileTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyFileTreeRequest.ProtoReflect.Descriptor instead.
 func (*CopyFileTreeRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{13}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CopyFileTreeRequest) GetDestination() string {
@@ -1085,7 +1156,7 @@ type HelperExecEnv struct {
 
 func (x *HelperExecEnv) Reset() {
 	*x = HelperExecEnv{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[14]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1097,7 +1168,7 @@ func (x *HelperExecEnv) String() string {
 func (*HelperExecEnv) ProtoMessage() {}
 
 func (x *HelperExecEnv) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[14]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,7 +1181,7 @@ func (x *HelperExecEnv) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelperExecEnv.ProtoReflect.Descriptor instead.
 func (*HelperExecEnv) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{14}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HelperExecEnv) GetKey() string {
@@ -1138,7 +1209,7 @@ type HelperExecRequest struct {
 
 func (x *HelperExecRequest) Reset() {
 	*x = HelperExecRequest{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[15]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1150,7 +1221,7 @@ func (x *HelperExecRequest) String() string {
 func (*HelperExecRequest) ProtoMessage() {}
 
 func (x *HelperExecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[15]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +1234,7 @@ func (x *HelperExecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelperExecRequest.ProtoReflect.Descriptor instead.
 func (*HelperExecRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{15}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HelperExecRequest) GetWorkDir() string {
@@ -1201,7 +1272,7 @@ type HelperExecResponse struct {
 
 func (x *HelperExecResponse) Reset() {
 	*x = HelperExecResponse{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[16]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1213,7 +1284,7 @@ func (x *HelperExecResponse) String() string {
 func (*HelperExecResponse) ProtoMessage() {}
 
 func (x *HelperExecResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[16]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,7 +1297,7 @@ func (x *HelperExecResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelperExecResponse.ProtoReflect.Descriptor instead.
 func (*HelperExecResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{16}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HelperExecResponse) GetType() HelperExecResponse_Type {
@@ -1286,7 +1357,7 @@ type SSGenRequest struct {
 
 func (x *SSGenRequest) Reset() {
 	*x = SSGenRequest{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[17]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1369,7 @@ func (x *SSGenRequest) String() string {
 func (*SSGenRequest) ProtoMessage() {}
 
 func (x *SSGenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[17]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1382,7 @@ func (x *SSGenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSGenRequest.ProtoReflect.Descriptor instead.
 func (*SSGenRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{17}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SSGenRequest) GetType() SSGenRequest_Type {
@@ -1330,7 +1401,7 @@ type GiteaIntegrationRequest struct {
 
 func (x *GiteaIntegrationRequest) Reset() {
 	*x = GiteaIntegrationRequest{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[18]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1342,7 +1413,7 @@ func (x *GiteaIntegrationRequest) String() string {
 func (*GiteaIntegrationRequest) ProtoMessage() {}
 
 func (x *GiteaIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[18]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +1426,7 @@ func (x *GiteaIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GiteaIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*GiteaIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{18}
+	return file_neoshowcase_protobuf_controller_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GiteaIntegrationRequest) GetType() GiteaIntegrationRequest_Type {
@@ -1377,7 +1448,7 @@ type ImageConfig_RegistryConfig struct {
 
 func (x *ImageConfig_RegistryConfig) Reset() {
 	*x = ImageConfig_RegistryConfig{}
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[19]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1389,7 +1460,7 @@ func (x *ImageConfig_RegistryConfig) String() string {
 func (*ImageConfig_RegistryConfig) ProtoMessage() {}
 
 func (x *ImageConfig_RegistryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[19]
+	mi := &file_neoshowcase_protobuf_controller_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,7 +1508,7 @@ var File_neoshowcase_protobuf_controller_proto protoreflect.FileDescriptor
 
 const file_neoshowcase_protobuf_controller_proto_rawDesc = "" +
 	"\n" +
-	"%neoshowcase/protobuf/controller.proto\x12\x14neoshowcase.protobuf\x1a\x1bgoogle/protobuf/empty.proto\x1a\"neoshowcase/protobuf/gateway.proto\"8\n" +
+	"%neoshowcase/protobuf/controller.proto\x12\x14neoshowcase.protobuf\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\"neoshowcase/protobuf/gateway.proto\"8\n" +
 	"\vAddressInfo\x12\x1d\n" +
 	"\aaddress\x18\x01 \x01(\tH\x00R\aaddress\x88\x01\x01B\n" +
 	"\n" +
@@ -1472,12 +1543,16 @@ const file_neoshowcase_protobuf_controller_proto_rawDesc = "" +
 	"\x04repo\x18\x01 \x01(\v2 .neoshowcase.protobuf.RepositoryR\x04repo\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x17\n" +
-	"\assh_key\x18\x04 \x01(\tR\x06sshKey\"\xfd\x01\n" +
+	"\assh_key\x18\x04 \x01(\tR\x06sshKey\"\x9e\x03\n" +
 	"\x11StartBuildRequest\x12;\n" +
 	"\x04repo\x18\x01 \x01(\v2'.neoshowcase.protobuf.RepositoryPrivateR\x04repo\x123\n" +
 	"\x03app\x18\x02 \x01(\v2!.neoshowcase.protobuf.ApplicationR\x03app\x12C\n" +
 	"\bapp_envs\x18\x03 \x01(\v2(.neoshowcase.protobuf.ApplicationEnvVarsR\aappEnvs\x121\n" +
-	"\x05build\x18\x04 \x01(\v2\x1b.neoshowcase.protobuf.BuildR\x05build\"\x99\x02\n" +
+	"\x05build\x18\x04 \x01(\v2\x1b.neoshowcase.protobuf.BuildR\x05build\x12^\n" +
+	"\rtrace_context\x18\x05 \x03(\v29.neoshowcase.protobuf.StartBuildRequest.TraceContextEntryR\ftraceContext\x1a?\n" +
+	"\x11TraceContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x02\n" +
 	"\x0eBuilderRequest\x12=\n" +
 	"\x04type\x18\x01 \x01(\x0e2).neoshowcase.protobuf.BuilderRequest.TypeR\x04type\x12J\n" +
 	"\vstart_build\x18\x02 \x01(\v2'.neoshowcase.protobuf.StartBuildRequestH\x00R\n" +
@@ -1488,10 +1563,14 @@ const file_neoshowcase_protobuf_controller_proto_rawDesc = "" +
 	"\fCANCEL_BUILD\x10\x01B\x06\n" +
 	"\x04body\"+\n" +
 	"\rConnectedBody\x12\x1a\n" +
-	"\bpriority\x18\x01 \x01(\x03R\bpriority\"d\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\"^\n" +
+	"\x11BuildStepDuration\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\"\xa3\x01\n" +
 	"\fBuildSettled\x12\x19\n" +
 	"\bbuild_id\x18\x01 \x01(\tR\abuildId\x129\n" +
-	"\x06status\x18\x02 \x01(\x0e2!.neoshowcase.protobuf.BuildStatusR\x06status\"\x88\x02\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.neoshowcase.protobuf.BuildStatusR\x06status\x12=\n" +
+	"\x05steps\x18\x03 \x03(\v2'.neoshowcase.protobuf.BuildStepDurationR\x05steps\"\x88\x02\n" +
 	"\x0fBuilderResponse\x12>\n" +
 	"\x04type\x18\x01 \x01(\x0e2*.neoshowcase.protobuf.BuilderResponse.TypeR\x04type\x12C\n" +
 	"\tconnected\x18\x02 \x01(\v2#.neoshowcase.protobuf.ConnectedBodyH\x00R\tconnected\x12>\n" +
@@ -1573,7 +1652,7 @@ func file_neoshowcase_protobuf_controller_proto_rawDescGZIP() []byte {
 }
 
 var file_neoshowcase_protobuf_controller_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_neoshowcase_protobuf_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_neoshowcase_protobuf_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_neoshowcase_protobuf_controller_proto_goTypes = []any{
 	(BuilderRequest_Type)(0),           // 0: neoshowcase.protobuf.BuilderRequest.Type
 	(BuilderResponse_Type)(0),          // 1: neoshowcase.protobuf.BuilderResponse.Type
@@ -1591,99 +1670,105 @@ var file_neoshowcase_protobuf_controller_proto_goTypes = []any{
 	(*StartBuildRequest)(nil),          // 13: neoshowcase.protobuf.StartBuildRequest
 	(*BuilderRequest)(nil),             // 14: neoshowcase.protobuf.BuilderRequest
 	(*ConnectedBody)(nil),              // 15: neoshowcase.protobuf.ConnectedBody
-	(*BuildSettled)(nil),               // 16: neoshowcase.protobuf.BuildSettled
-	(*BuilderResponse)(nil),            // 17: neoshowcase.protobuf.BuilderResponse
-	(*CopyFileTreeRequest)(nil),        // 18: neoshowcase.protobuf.CopyFileTreeRequest
-	(*HelperExecEnv)(nil),              // 19: neoshowcase.protobuf.HelperExecEnv
-	(*HelperExecRequest)(nil),          // 20: neoshowcase.protobuf.HelperExecRequest
-	(*HelperExecResponse)(nil),         // 21: neoshowcase.protobuf.HelperExecResponse
-	(*SSGenRequest)(nil),               // 22: neoshowcase.protobuf.SSGenRequest
-	(*GiteaIntegrationRequest)(nil),    // 23: neoshowcase.protobuf.GiteaIntegrationRequest
-	(*ImageConfig_RegistryConfig)(nil), // 24: neoshowcase.protobuf.ImageConfig.RegistryConfig
-	(*Artifact)(nil),                   // 25: neoshowcase.protobuf.Artifact
-	(*Repository)(nil),                 // 26: neoshowcase.protobuf.Repository
-	(*Application)(nil),                // 27: neoshowcase.protobuf.Application
-	(*ApplicationEnvVars)(nil),         // 28: neoshowcase.protobuf.ApplicationEnvVars
-	(*Build)(nil),                      // 29: neoshowcase.protobuf.Build
-	(*BuildIdRequest)(nil),             // 30: neoshowcase.protobuf.BuildIdRequest
-	(BuildStatus)(0),                   // 31: neoshowcase.protobuf.BuildStatus
-	(*emptypb.Empty)(nil),              // 32: google.protobuf.Empty
-	(*RepositoryIdRequest)(nil),        // 33: neoshowcase.protobuf.RepositoryIdRequest
-	(*ApplicationIdRequest)(nil),       // 34: neoshowcase.protobuf.ApplicationIdRequest
-	(*SystemInfo)(nil),                 // 35: neoshowcase.protobuf.SystemInfo
-	(*BuildLog)(nil),                   // 36: neoshowcase.protobuf.BuildLog
+	(*BuildStepDuration)(nil),          // 16: neoshowcase.protobuf.BuildStepDuration
+	(*BuildSettled)(nil),               // 17: neoshowcase.protobuf.BuildSettled
+	(*BuilderResponse)(nil),            // 18: neoshowcase.protobuf.BuilderResponse
+	(*CopyFileTreeRequest)(nil),        // 19: neoshowcase.protobuf.CopyFileTreeRequest
+	(*HelperExecEnv)(nil),              // 20: neoshowcase.protobuf.HelperExecEnv
+	(*HelperExecRequest)(nil),          // 21: neoshowcase.protobuf.HelperExecRequest
+	(*HelperExecResponse)(nil),         // 22: neoshowcase.protobuf.HelperExecResponse
+	(*SSGenRequest)(nil),               // 23: neoshowcase.protobuf.SSGenRequest
+	(*GiteaIntegrationRequest)(nil),    // 24: neoshowcase.protobuf.GiteaIntegrationRequest
+	(*ImageConfig_RegistryConfig)(nil), // 25: neoshowcase.protobuf.ImageConfig.RegistryConfig
+	nil,                                // 26: neoshowcase.protobuf.StartBuildRequest.TraceContextEntry
+	(*Artifact)(nil),                   // 27: neoshowcase.protobuf.Artifact
+	(*Repository)(nil),                 // 28: neoshowcase.protobuf.Repository
+	(*Application)(nil),                // 29: neoshowcase.protobuf.Application
+	(*ApplicationEnvVars)(nil),         // 30: neoshowcase.protobuf.ApplicationEnvVars
+	(*Build)(nil),                      // 31: neoshowcase.protobuf.Build
+	(*BuildIdRequest)(nil),             // 32: neoshowcase.protobuf.BuildIdRequest
+	(*durationpb.Duration)(nil),        // 33: google.protobuf.Duration
+	(BuildStatus)(0),                   // 34: neoshowcase.protobuf.BuildStatus
+	(*emptypb.Empty)(nil),              // 35: google.protobuf.Empty
+	(*RepositoryIdRequest)(nil),        // 36: neoshowcase.protobuf.RepositoryIdRequest
+	(*ApplicationIdRequest)(nil),       // 37: neoshowcase.protobuf.ApplicationIdRequest
+	(*SystemInfo)(nil),                 // 38: neoshowcase.protobuf.SystemInfo
+	(*BuildLog)(nil),                   // 39: neoshowcase.protobuf.BuildLog
 }
 var file_neoshowcase_protobuf_controller_proto_depIdxs = []int32{
-	24, // 0: neoshowcase.protobuf.ImageConfig.registry:type_name -> neoshowcase.protobuf.ImageConfig.RegistryConfig
+	25, // 0: neoshowcase.protobuf.ImageConfig.registry:type_name -> neoshowcase.protobuf.ImageConfig.RegistryConfig
 	6,  // 1: neoshowcase.protobuf.BuilderSystemInfo.image_config:type_name -> neoshowcase.protobuf.ImageConfig
-	25, // 2: neoshowcase.protobuf.SaveArtifactRequest.artifact:type_name -> neoshowcase.protobuf.Artifact
-	26, // 3: neoshowcase.protobuf.RepositoryPrivate.repo:type_name -> neoshowcase.protobuf.Repository
+	27, // 2: neoshowcase.protobuf.SaveArtifactRequest.artifact:type_name -> neoshowcase.protobuf.Artifact
+	28, // 3: neoshowcase.protobuf.RepositoryPrivate.repo:type_name -> neoshowcase.protobuf.Repository
 	12, // 4: neoshowcase.protobuf.StartBuildRequest.repo:type_name -> neoshowcase.protobuf.RepositoryPrivate
-	27, // 5: neoshowcase.protobuf.StartBuildRequest.app:type_name -> neoshowcase.protobuf.Application
-	28, // 6: neoshowcase.protobuf.StartBuildRequest.app_envs:type_name -> neoshowcase.protobuf.ApplicationEnvVars
-	29, // 7: neoshowcase.protobuf.StartBuildRequest.build:type_name -> neoshowcase.protobuf.Build
-	0,  // 8: neoshowcase.protobuf.BuilderRequest.type:type_name -> neoshowcase.protobuf.BuilderRequest.Type
-	13, // 9: neoshowcase.protobuf.BuilderRequest.start_build:type_name -> neoshowcase.protobuf.StartBuildRequest
-	30, // 10: neoshowcase.protobuf.BuilderRequest.cancel_build:type_name -> neoshowcase.protobuf.BuildIdRequest
-	31, // 11: neoshowcase.protobuf.BuildSettled.status:type_name -> neoshowcase.protobuf.BuildStatus
-	1,  // 12: neoshowcase.protobuf.BuilderResponse.type:type_name -> neoshowcase.protobuf.BuilderResponse.Type
-	15, // 13: neoshowcase.protobuf.BuilderResponse.connected:type_name -> neoshowcase.protobuf.ConnectedBody
-	16, // 14: neoshowcase.protobuf.BuilderResponse.settled:type_name -> neoshowcase.protobuf.BuildSettled
-	19, // 15: neoshowcase.protobuf.HelperExecRequest.envs:type_name -> neoshowcase.protobuf.HelperExecEnv
-	2,  // 16: neoshowcase.protobuf.HelperExecResponse.type:type_name -> neoshowcase.protobuf.HelperExecResponse.Type
-	3,  // 17: neoshowcase.protobuf.SSGenRequest.type:type_name -> neoshowcase.protobuf.SSGenRequest.Type
-	4,  // 18: neoshowcase.protobuf.GiteaIntegrationRequest.type:type_name -> neoshowcase.protobuf.GiteaIntegrationRequest.Type
-	32, // 19: neoshowcase.protobuf.ControllerService.GetSystemInfo:input_type -> google.protobuf.Empty
-	33, // 20: neoshowcase.protobuf.ControllerService.FetchRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
-	34, // 21: neoshowcase.protobuf.ControllerService.RegisterBuild:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	32, // 22: neoshowcase.protobuf.ControllerService.SyncDeployments:input_type -> google.protobuf.Empty
-	30, // 23: neoshowcase.protobuf.ControllerService.DiscoverBuildLogInstance:input_type -> neoshowcase.protobuf.BuildIdRequest
-	30, // 24: neoshowcase.protobuf.ControllerService.StreamBuildLog:input_type -> neoshowcase.protobuf.BuildIdRequest
-	32, // 25: neoshowcase.protobuf.ControllerService.StartBuild:input_type -> google.protobuf.Empty
-	30, // 26: neoshowcase.protobuf.ControllerService.CancelBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
-	30, // 27: neoshowcase.protobuf.ControllerService.DiscoverBuildLogLocal:input_type -> neoshowcase.protobuf.BuildIdRequest
-	32, // 28: neoshowcase.protobuf.ControllerService.StartBuildLocal:input_type -> google.protobuf.Empty
-	32, // 29: neoshowcase.protobuf.ControllerService.SyncDeploymentsLocal:input_type -> google.protobuf.Empty
-	30, // 30: neoshowcase.protobuf.ControllerService.CancelBuildLocal:input_type -> neoshowcase.protobuf.BuildIdRequest
-	32, // 31: neoshowcase.protobuf.ControllerBuilderService.GetBuilderSystemInfo:input_type -> google.protobuf.Empty
-	30, // 32: neoshowcase.protobuf.ControllerBuilderService.PingBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
-	8,  // 33: neoshowcase.protobuf.ControllerBuilderService.StreamBuildLog:input_type -> neoshowcase.protobuf.BuildLogPortion
-	9,  // 34: neoshowcase.protobuf.ControllerBuilderService.SaveArtifact:input_type -> neoshowcase.protobuf.SaveArtifactRequest
-	10, // 35: neoshowcase.protobuf.ControllerBuilderService.SaveBuildLog:input_type -> neoshowcase.protobuf.SaveBuildLogRequest
-	11, // 36: neoshowcase.protobuf.ControllerBuilderService.SaveRuntimeImage:input_type -> neoshowcase.protobuf.SaveRuntimeImageRequest
-	17, // 37: neoshowcase.protobuf.ControllerBuilderService.ConnectBuilder:input_type -> neoshowcase.protobuf.BuilderResponse
-	18, // 38: neoshowcase.protobuf.BuildpackHelperService.CopyFileTree:input_type -> neoshowcase.protobuf.CopyFileTreeRequest
-	20, // 39: neoshowcase.protobuf.BuildpackHelperService.Exec:input_type -> neoshowcase.protobuf.HelperExecRequest
-	32, // 40: neoshowcase.protobuf.ControllerSSGenService.ConnectSSGen:input_type -> google.protobuf.Empty
-	32, // 41: neoshowcase.protobuf.GiteaIntegrationService.Sync:input_type -> google.protobuf.Empty
-	35, // 42: neoshowcase.protobuf.ControllerService.GetSystemInfo:output_type -> neoshowcase.protobuf.SystemInfo
-	32, // 43: neoshowcase.protobuf.ControllerService.FetchRepository:output_type -> google.protobuf.Empty
-	32, // 44: neoshowcase.protobuf.ControllerService.RegisterBuild:output_type -> google.protobuf.Empty
-	32, // 45: neoshowcase.protobuf.ControllerService.SyncDeployments:output_type -> google.protobuf.Empty
-	5,  // 46: neoshowcase.protobuf.ControllerService.DiscoverBuildLogInstance:output_type -> neoshowcase.protobuf.AddressInfo
-	36, // 47: neoshowcase.protobuf.ControllerService.StreamBuildLog:output_type -> neoshowcase.protobuf.BuildLog
-	32, // 48: neoshowcase.protobuf.ControllerService.StartBuild:output_type -> google.protobuf.Empty
-	32, // 49: neoshowcase.protobuf.ControllerService.CancelBuild:output_type -> google.protobuf.Empty
-	5,  // 50: neoshowcase.protobuf.ControllerService.DiscoverBuildLogLocal:output_type -> neoshowcase.protobuf.AddressInfo
-	32, // 51: neoshowcase.protobuf.ControllerService.StartBuildLocal:output_type -> google.protobuf.Empty
-	32, // 52: neoshowcase.protobuf.ControllerService.SyncDeploymentsLocal:output_type -> google.protobuf.Empty
-	32, // 53: neoshowcase.protobuf.ControllerService.CancelBuildLocal:output_type -> google.protobuf.Empty
-	7,  // 54: neoshowcase.protobuf.ControllerBuilderService.GetBuilderSystemInfo:output_type -> neoshowcase.protobuf.BuilderSystemInfo
-	32, // 55: neoshowcase.protobuf.ControllerBuilderService.PingBuild:output_type -> google.protobuf.Empty
-	32, // 56: neoshowcase.protobuf.ControllerBuilderService.StreamBuildLog:output_type -> google.protobuf.Empty
-	32, // 57: neoshowcase.protobuf.ControllerBuilderService.SaveArtifact:output_type -> google.protobuf.Empty
-	32, // 58: neoshowcase.protobuf.ControllerBuilderService.SaveBuildLog:output_type -> google.protobuf.Empty
-	32, // 59: neoshowcase.protobuf.ControllerBuilderService.SaveRuntimeImage:output_type -> google.protobuf.Empty
-	14, // 60: neoshowcase.protobuf.ControllerBuilderService.ConnectBuilder:output_type -> neoshowcase.protobuf.BuilderRequest
-	32, // 61: neoshowcase.protobuf.BuildpackHelperService.CopyFileTree:output_type -> google.protobuf.Empty
-	21, // 62: neoshowcase.protobuf.BuildpackHelperService.Exec:output_type -> neoshowcase.protobuf.HelperExecResponse
-	22, // 63: neoshowcase.protobuf.ControllerSSGenService.ConnectSSGen:output_type -> neoshowcase.protobuf.SSGenRequest
-	32, // 64: neoshowcase.protobuf.GiteaIntegrationService.Sync:output_type -> google.protobuf.Empty
-	42, // [42:65] is the sub-list for method output_type
-	19, // [19:42] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	29, // 5: neoshowcase.protobuf.StartBuildRequest.app:type_name -> neoshowcase.protobuf.Application
+	30, // 6: neoshowcase.protobuf.StartBuildRequest.app_envs:type_name -> neoshowcase.protobuf.ApplicationEnvVars
+	31, // 7: neoshowcase.protobuf.StartBuildRequest.build:type_name -> neoshowcase.protobuf.Build
+	26, // 8: neoshowcase.protobuf.StartBuildRequest.trace_context:type_name -> neoshowcase.protobuf.StartBuildRequest.TraceContextEntry
+	0,  // 9: neoshowcase.protobuf.BuilderRequest.type:type_name -> neoshowcase.protobuf.BuilderRequest.Type
+	13, // 10: neoshowcase.protobuf.BuilderRequest.start_build:type_name -> neoshowcase.protobuf.StartBuildRequest
+	32, // 11: neoshowcase.protobuf.BuilderRequest.cancel_build:type_name -> neoshowcase.protobuf.BuildIdRequest
+	33, // 12: neoshowcase.protobuf.BuildStepDuration.duration:type_name -> google.protobuf.Duration
+	34, // 13: neoshowcase.protobuf.BuildSettled.status:type_name -> neoshowcase.protobuf.BuildStatus
+	16, // 14: neoshowcase.protobuf.BuildSettled.steps:type_name -> neoshowcase.protobuf.BuildStepDuration
+	1,  // 15: neoshowcase.protobuf.BuilderResponse.type:type_name -> neoshowcase.protobuf.BuilderResponse.Type
+	15, // 16: neoshowcase.protobuf.BuilderResponse.connected:type_name -> neoshowcase.protobuf.ConnectedBody
+	17, // 17: neoshowcase.protobuf.BuilderResponse.settled:type_name -> neoshowcase.protobuf.BuildSettled
+	20, // 18: neoshowcase.protobuf.HelperExecRequest.envs:type_name -> neoshowcase.protobuf.HelperExecEnv
+	2,  // 19: neoshowcase.protobuf.HelperExecResponse.type:type_name -> neoshowcase.protobuf.HelperExecResponse.Type
+	3,  // 20: neoshowcase.protobuf.SSGenRequest.type:type_name -> neoshowcase.protobuf.SSGenRequest.Type
+	4,  // 21: neoshowcase.protobuf.GiteaIntegrationRequest.type:type_name -> neoshowcase.protobuf.GiteaIntegrationRequest.Type
+	35, // 22: neoshowcase.protobuf.ControllerService.GetSystemInfo:input_type -> google.protobuf.Empty
+	36, // 23: neoshowcase.protobuf.ControllerService.FetchRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
+	37, // 24: neoshowcase.protobuf.ControllerService.RegisterBuild:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	35, // 25: neoshowcase.protobuf.ControllerService.SyncDeployments:input_type -> google.protobuf.Empty
+	32, // 26: neoshowcase.protobuf.ControllerService.DiscoverBuildLogInstance:input_type -> neoshowcase.protobuf.BuildIdRequest
+	32, // 27: neoshowcase.protobuf.ControllerService.StreamBuildLog:input_type -> neoshowcase.protobuf.BuildIdRequest
+	35, // 28: neoshowcase.protobuf.ControllerService.StartBuild:input_type -> google.protobuf.Empty
+	32, // 29: neoshowcase.protobuf.ControllerService.CancelBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
+	32, // 30: neoshowcase.protobuf.ControllerService.DiscoverBuildLogLocal:input_type -> neoshowcase.protobuf.BuildIdRequest
+	35, // 31: neoshowcase.protobuf.ControllerService.StartBuildLocal:input_type -> google.protobuf.Empty
+	35, // 32: neoshowcase.protobuf.ControllerService.SyncDeploymentsLocal:input_type -> google.protobuf.Empty
+	32, // 33: neoshowcase.protobuf.ControllerService.CancelBuildLocal:input_type -> neoshowcase.protobuf.BuildIdRequest
+	35, // 34: neoshowcase.protobuf.ControllerBuilderService.GetBuilderSystemInfo:input_type -> google.protobuf.Empty
+	32, // 35: neoshowcase.protobuf.ControllerBuilderService.PingBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
+	8,  // 36: neoshowcase.protobuf.ControllerBuilderService.StreamBuildLog:input_type -> neoshowcase.protobuf.BuildLogPortion
+	9,  // 37: neoshowcase.protobuf.ControllerBuilderService.SaveArtifact:input_type -> neoshowcase.protobuf.SaveArtifactRequest
+	10, // 38: neoshowcase.protobuf.ControllerBuilderService.SaveBuildLog:input_type -> neoshowcase.protobuf.SaveBuildLogRequest
+	11, // 39: neoshowcase.protobuf.ControllerBuilderService.SaveRuntimeImage:input_type -> neoshowcase.protobuf.SaveRuntimeImageRequest
+	18, // 40: neoshowcase.protobuf.ControllerBuilderService.ConnectBuilder:input_type -> neoshowcase.protobuf.BuilderResponse
+	19, // 41: neoshowcase.protobuf.BuildpackHelperService.CopyFileTree:input_type -> neoshowcase.protobuf.CopyFileTreeRequest
+	21, // 42: neoshowcase.protobuf.BuildpackHelperService.Exec:input_type -> neoshowcase.protobuf.HelperExecRequest
+	35, // 43: neoshowcase.protobuf.ControllerSSGenService.ConnectSSGen:input_type -> google.protobuf.Empty
+	35, // 44: neoshowcase.protobuf.GiteaIntegrationService.Sync:input_type -> google.protobuf.Empty
+	38, // 45: neoshowcase.protobuf.ControllerService.GetSystemInfo:output_type -> neoshowcase.protobuf.SystemInfo
+	35, // 46: neoshowcase.protobuf.ControllerService.FetchRepository:output_type -> google.protobuf.Empty
+	35, // 47: neoshowcase.protobuf.ControllerService.RegisterBuild:output_type -> google.protobuf.Empty
+	35, // 48: neoshowcase.protobuf.ControllerService.SyncDeployments:output_type -> google.protobuf.Empty
+	5,  // 49: neoshowcase.protobuf.ControllerService.DiscoverBuildLogInstance:output_type -> neoshowcase.protobuf.AddressInfo
+	39, // 50: neoshowcase.protobuf.ControllerService.StreamBuildLog:output_type -> neoshowcase.protobuf.BuildLog
+	35, // 51: neoshowcase.protobuf.ControllerService.StartBuild:output_type -> google.protobuf.Empty
+	35, // 52: neoshowcase.protobuf.ControllerService.CancelBuild:output_type -> google.protobuf.Empty
+	5,  // 53: neoshowcase.protobuf.ControllerService.DiscoverBuildLogLocal:output_type -> neoshowcase.protobuf.AddressInfo
+	35, // 54: neoshowcase.protobuf.ControllerService.StartBuildLocal:output_type -> google.protobuf.Empty
+	35, // 55: neoshowcase.protobuf.ControllerService.SyncDeploymentsLocal:output_type -> google.protobuf.Empty
+	35, // 56: neoshowcase.protobuf.ControllerService.CancelBuildLocal:output_type -> google.protobuf.Empty
+	7,  // 57: neoshowcase.protobuf.ControllerBuilderService.GetBuilderSystemInfo:output_type -> neoshowcase.protobuf.BuilderSystemInfo
+	35, // 58: neoshowcase.protobuf.ControllerBuilderService.PingBuild:output_type -> google.protobuf.Empty
+	35, // 59: neoshowcase.protobuf.ControllerBuilderService.StreamBuildLog:output_type -> google.protobuf.Empty
+	35, // 60: neoshowcase.protobuf.ControllerBuilderService.SaveArtifact:output_type -> google.protobuf.Empty
+	35, // 61: neoshowcase.protobuf.ControllerBuilderService.SaveBuildLog:output_type -> google.protobuf.Empty
+	35, // 62: neoshowcase.protobuf.ControllerBuilderService.SaveRuntimeImage:output_type -> google.protobuf.Empty
+	14, // 63: neoshowcase.protobuf.ControllerBuilderService.ConnectBuilder:output_type -> neoshowcase.protobuf.BuilderRequest
+	35, // 64: neoshowcase.protobuf.BuildpackHelperService.CopyFileTree:output_type -> google.protobuf.Empty
+	22, // 65: neoshowcase.protobuf.BuildpackHelperService.Exec:output_type -> neoshowcase.protobuf.HelperExecResponse
+	23, // 66: neoshowcase.protobuf.ControllerSSGenService.ConnectSSGen:output_type -> neoshowcase.protobuf.SSGenRequest
+	35, // 67: neoshowcase.protobuf.GiteaIntegrationService.Sync:output_type -> google.protobuf.Empty
+	45, // [45:68] is the sub-list for method output_type
+	22, // [22:45] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_neoshowcase_protobuf_controller_proto_init() }
@@ -1697,11 +1782,11 @@ func file_neoshowcase_protobuf_controller_proto_init() {
 		(*BuilderRequest_StartBuild)(nil),
 		(*BuilderRequest_CancelBuild)(nil),
 	}
-	file_neoshowcase_protobuf_controller_proto_msgTypes[12].OneofWrappers = []any{
+	file_neoshowcase_protobuf_controller_proto_msgTypes[13].OneofWrappers = []any{
 		(*BuilderResponse_Connected)(nil),
 		(*BuilderResponse_Settled)(nil),
 	}
-	file_neoshowcase_protobuf_controller_proto_msgTypes[16].OneofWrappers = []any{
+	file_neoshowcase_protobuf_controller_proto_msgTypes[17].OneofWrappers = []any{
 		(*HelperExecResponse_Log)(nil),
 		(*HelperExecResponse_ExitCode)(nil),
 	}
@@ -1711,7 +1796,7 @@ func file_neoshowcase_protobuf_controller_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_neoshowcase_protobuf_controller_proto_rawDesc), len(file_neoshowcase_protobuf_controller_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
@@ -222,6 +222,100 @@ func (BuildStatus) EnumDescriptor() ([]byte, []int) {
 	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{3}
 }
 
+type ManifestFormat int32
+
+const (
+	ManifestFormat_YAML ManifestFormat = 0
+	ManifestFormat_JSON ManifestFormat = 1
+)
+
+// Enum value maps for ManifestFormat.
+var (
+	ManifestFormat_name = map[int32]string{
+		0: "YAML",
+		1: "JSON",
+	}
+	ManifestFormat_value = map[string]int32{
+		"YAML": 0,
+		"JSON": 1,
+	}
+)
+
+func (x ManifestFormat) Enum() *ManifestFormat {
+	p := new(ManifestFormat)
+	*p = x
+	return p
+}
+
+func (x ManifestFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ManifestFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[4].Descriptor()
+}
+
+func (ManifestFormat) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[4]
+}
+
+func (x ManifestFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ManifestFormat.Descriptor instead.
+func (ManifestFormat) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+type CustomDomain_VerificationMethod int32
+
+const (
+	// DNS txt_record_nameのTXTレコードにtxt_record_valueを設定して確認します
+	CustomDomain_DNS CustomDomain_VerificationMethod = 0
+	// HTTP http_urlでtokenを返すことで確認します
+	CustomDomain_HTTP CustomDomain_VerificationMethod = 1
+)
+
+// Enum value maps for CustomDomain_VerificationMethod.
+var (
+	CustomDomain_VerificationMethod_name = map[int32]string{
+		0: "DNS",
+		1: "HTTP",
+	}
+	CustomDomain_VerificationMethod_value = map[string]int32{
+		"DNS":  0,
+		"HTTP": 1,
+	}
+)
+
+func (x CustomDomain_VerificationMethod) Enum() *CustomDomain_VerificationMethod {
+	p := new(CustomDomain_VerificationMethod)
+	*p = x
+	return p
+}
+
+func (x CustomDomain_VerificationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomDomain_VerificationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[5].Descriptor()
+}
+
+func (CustomDomain_VerificationMethod) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[5]
+}
+
+func (x CustomDomain_VerificationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomDomain_VerificationMethod.Descriptor instead.
+func (CustomDomain_VerificationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type Repository_AuthMethod int32
 
 const (
@@ -255,11 +349,11 @@ func (x Repository_AuthMethod) String() string {
 }
 
 func (Repository_AuthMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_neoshowcase_protobuf_gateway_proto_enumTypes[4].Descriptor()
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[6].Descriptor()
 }
 
 func (Repository_AuthMethod) Type() protoreflect.EnumType {
-	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[4]
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[6]
 }
 
 func (x Repository_AuthMethod) Number() protoreflect.EnumNumber {
@@ -268,7 +362,7 @@ func (x Repository_AuthMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Repository_AuthMethod.Descriptor instead.
 func (Repository_AuthMethod) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{7, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type AutoShutdownConfig_StartupBehavior int32
@@ -304,11 +398,11 @@ func (x AutoShutdownConfig_StartupBehavior) String() string {
 }
 
 func (AutoShutdownConfig_StartupBehavior) Descriptor() protoreflect.EnumDescriptor {
-	return file_neoshowcase_protobuf_gateway_proto_enumTypes[5].Descriptor()
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[7].Descriptor()
 }
 
 func (AutoShutdownConfig_StartupBehavior) Type() protoreflect.EnumType {
-	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[5]
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[7]
 }
 
 func (x AutoShutdownConfig_StartupBehavior) Number() protoreflect.EnumNumber {
@@ -317,7 +411,55 @@ func (x AutoShutdownConfig_StartupBehavior) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AutoShutdownConfig_StartupBehavior.Descriptor instead.
 func (AutoShutdownConfig_StartupBehavior) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{9, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type WebsiteRule_Type int32
+
+const (
+	// REDIRECT targetへリダイレクトします
+	WebsiteRule_REDIRECT WebsiteRule_Type = 0
+	// REWRITE リクエストパスをtargetに書き換えます
+	WebsiteRule_REWRITE WebsiteRule_Type = 1
+)
+
+// Enum value maps for WebsiteRule_Type.
+var (
+	WebsiteRule_Type_name = map[int32]string{
+		0: "REDIRECT",
+		1: "REWRITE",
+	}
+	WebsiteRule_Type_value = map[string]int32{
+		"REDIRECT": 0,
+		"REWRITE":  1,
+	}
+)
+
+func (x WebsiteRule_Type) Enum() *WebsiteRule_Type {
+	p := new(WebsiteRule_Type)
+	*p = x
+	return p
+}
+
+func (x WebsiteRule_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebsiteRule_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[8].Descriptor()
+}
+
+func (WebsiteRule_Type) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[8]
+}
+
+func (x WebsiteRule_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebsiteRule_Type.Descriptor instead.
+func (WebsiteRule_Type) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type Application_ContainerState int32
@@ -365,11 +507,11 @@ func (x Application_ContainerState) String() string {
 }
 
 func (Application_ContainerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_neoshowcase_protobuf_gateway_proto_enumTypes[6].Descriptor()
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[9].Descriptor()
 }
 
 func (Application_ContainerState) Type() protoreflect.EnumType {
-	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[6]
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[9]
 }
 
 func (x Application_ContainerState) Number() protoreflect.EnumNumber {
@@ -378,7 +520,324 @@ func (x Application_ContainerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Application_ContainerState.Descriptor instead.
 func (Application_ContainerState) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{21, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type ApplicationEvent_Type int32
+
+const (
+	ApplicationEvent_CONTAINER_STATE ApplicationEvent_Type = 0
+	ApplicationEvent_BUILD_STARTED   ApplicationEvent_Type = 1
+	ApplicationEvent_BUILD_FINISHED  ApplicationEvent_Type = 2
+	ApplicationEvent_DEPLOYED        ApplicationEvent_Type = 3
+	ApplicationEvent_CONFIG_CHANGED  ApplicationEvent_Type = 4
+	ApplicationEvent_STARTED         ApplicationEvent_Type = 5
+	ApplicationEvent_STOPPED         ApplicationEvent_Type = 6
+)
+
+// Enum value maps for ApplicationEvent_Type.
+var (
+	ApplicationEvent_Type_name = map[int32]string{
+		0: "CONTAINER_STATE",
+		1: "BUILD_STARTED",
+		2: "BUILD_FINISHED",
+		3: "DEPLOYED",
+		4: "CONFIG_CHANGED",
+		5: "STARTED",
+		6: "STOPPED",
+	}
+	ApplicationEvent_Type_value = map[string]int32{
+		"CONTAINER_STATE": 0,
+		"BUILD_STARTED":   1,
+		"BUILD_FINISHED":  2,
+		"DEPLOYED":        3,
+		"CONFIG_CHANGED":  4,
+		"STARTED":         5,
+		"STOPPED":         6,
+	}
+)
+
+func (x ApplicationEvent_Type) Enum() *ApplicationEvent_Type {
+	p := new(ApplicationEvent_Type)
+	*p = x
+	return p
+}
+
+func (x ApplicationEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[10].Descriptor()
+}
+
+func (ApplicationEvent_Type) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[10]
+}
+
+func (x ApplicationEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationEvent_Type.Descriptor instead.
+func (ApplicationEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type AlertRule_Kind int32
+
+const (
+	// METRIC メトリクスの値が閾値を超えた状態が継続した場合に発火します
+	AlertRule_METRIC AlertRule_Kind = 0
+	// NO_LOG 起動中のアプリのログ出力が継続してない場合に発火します
+	AlertRule_NO_LOG AlertRule_Kind = 1
+)
+
+// Enum value maps for AlertRule_Kind.
+var (
+	AlertRule_Kind_name = map[int32]string{
+		0: "METRIC",
+		1: "NO_LOG",
+	}
+	AlertRule_Kind_value = map[string]int32{
+		"METRIC": 0,
+		"NO_LOG": 1,
+	}
+)
+
+func (x AlertRule_Kind) Enum() *AlertRule_Kind {
+	p := new(AlertRule_Kind)
+	*p = x
+	return p
+}
+
+func (x AlertRule_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertRule_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[11].Descriptor()
+}
+
+func (AlertRule_Kind) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[11]
+}
+
+func (x AlertRule_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertRule_Kind.Descriptor instead.
+func (AlertRule_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{50, 0}
+}
+
+type AlertRule_Comparison int32
+
+const (
+	AlertRule_ABOVE AlertRule_Comparison = 0
+	AlertRule_BELOW AlertRule_Comparison = 1
+)
+
+// Enum value maps for AlertRule_Comparison.
+var (
+	AlertRule_Comparison_name = map[int32]string{
+		0: "ABOVE",
+		1: "BELOW",
+	}
+	AlertRule_Comparison_value = map[string]int32{
+		"ABOVE": 0,
+		"BELOW": 1,
+	}
+)
+
+func (x AlertRule_Comparison) Enum() *AlertRule_Comparison {
+	p := new(AlertRule_Comparison)
+	*p = x
+	return p
+}
+
+func (x AlertRule_Comparison) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertRule_Comparison) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[12].Descriptor()
+}
+
+func (AlertRule_Comparison) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[12]
+}
+
+func (x AlertRule_Comparison) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertRule_Comparison.Descriptor instead.
+func (AlertRule_Comparison) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{50, 1}
+}
+
+type AlertRule_ThresholdUnit int32
+
+const (
+	// VALUE メトリクスの値そのものと比較します
+	AlertRule_VALUE AlertRule_ThresholdUnit = 0
+	// LIMIT_PERCENT メトリクスの上限 (メモリ上限など) に対する割合 (%) と比較します
+	AlertRule_LIMIT_PERCENT AlertRule_ThresholdUnit = 1
+)
+
+// Enum value maps for AlertRule_ThresholdUnit.
+var (
+	AlertRule_ThresholdUnit_name = map[int32]string{
+		0: "VALUE",
+		1: "LIMIT_PERCENT",
+	}
+	AlertRule_ThresholdUnit_value = map[string]int32{
+		"VALUE":         0,
+		"LIMIT_PERCENT": 1,
+	}
+)
+
+func (x AlertRule_ThresholdUnit) Enum() *AlertRule_ThresholdUnit {
+	p := new(AlertRule_ThresholdUnit)
+	*p = x
+	return p
+}
+
+func (x AlertRule_ThresholdUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertRule_ThresholdUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[13].Descriptor()
+}
+
+func (AlertRule_ThresholdUnit) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[13]
+}
+
+func (x AlertRule_ThresholdUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertRule_ThresholdUnit.Descriptor instead.
+func (AlertRule_ThresholdUnit) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{50, 2}
+}
+
+type NotificationSubscription_Sink int32
+
+const (
+	// WEBHOOK targetのURLに通知をJSONでPOSTします
+	NotificationSubscription_WEBHOOK NotificationSubscription_Sink = 0
+	// SLACK targetのSlack互換のIncoming Webhook URLに通知します
+	NotificationSubscription_SLACK NotificationSubscription_Sink = 1
+	// TRAQ targetのtraQのWebhook URLに通知します
+	NotificationSubscription_TRAQ NotificationSubscription_Sink = 2
+	// EMAIL targetのメールアドレスに通知します
+	NotificationSubscription_EMAIL NotificationSubscription_Sink = 3
+)
+
+// Enum value maps for NotificationSubscription_Sink.
+var (
+	NotificationSubscription_Sink_name = map[int32]string{
+		0: "WEBHOOK",
+		1: "SLACK",
+		2: "TRAQ",
+		3: "EMAIL",
+	}
+	NotificationSubscription_Sink_value = map[string]int32{
+		"WEBHOOK": 0,
+		"SLACK":   1,
+		"TRAQ":    2,
+		"EMAIL":   3,
+	}
+)
+
+func (x NotificationSubscription_Sink) Enum() *NotificationSubscription_Sink {
+	p := new(NotificationSubscription_Sink)
+	*p = x
+	return p
+}
+
+func (x NotificationSubscription_Sink) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationSubscription_Sink) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[14].Descriptor()
+}
+
+func (NotificationSubscription_Sink) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[14]
+}
+
+func (x NotificationSubscription_Sink) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationSubscription_Sink.Descriptor instead.
+func (NotificationSubscription_Sink) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{54, 0}
+}
+
+type NotificationSubscription_Event int32
+
+const (
+	NotificationSubscription_BUILD_FAILED      NotificationSubscription_Event = 0
+	NotificationSubscription_BUILD_SUCCEEDED   NotificationSubscription_Event = 1
+	NotificationSubscription_DEPLOYED          NotificationSubscription_Event = 2
+	NotificationSubscription_CONTAINER_ERRORED NotificationSubscription_Event = 3
+	NotificationSubscription_CERTIFICATE_ERROR NotificationSubscription_Event = 4
+	NotificationSubscription_ALERT             NotificationSubscription_Event = 5
+)
+
+// Enum value maps for NotificationSubscription_Event.
+var (
+	NotificationSubscription_Event_name = map[int32]string{
+		0: "BUILD_FAILED",
+		1: "BUILD_SUCCEEDED",
+		2: "DEPLOYED",
+		3: "CONTAINER_ERRORED",
+		4: "CERTIFICATE_ERROR",
+		5: "ALERT",
+	}
+	NotificationSubscription_Event_value = map[string]int32{
+		"BUILD_FAILED":      0,
+		"BUILD_SUCCEEDED":   1,
+		"DEPLOYED":          2,
+		"CONTAINER_ERRORED": 3,
+		"CERTIFICATE_ERROR": 4,
+		"ALERT":             5,
+	}
+)
+
+func (x NotificationSubscription_Event) Enum() *NotificationSubscription_Event {
+	p := new(NotificationSubscription_Event)
+	*p = x
+	return p
+}
+
+func (x NotificationSubscription_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationSubscription_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[15].Descriptor()
+}
+
+func (NotificationSubscription_Event) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[15]
+}
+
+func (x NotificationSubscription_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationSubscription_Event.Descriptor instead.
+func (NotificationSubscription_Event) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{54, 1}
 }
 
 type GetRepositoriesRequest_Scope int32
@@ -418,11 +877,11 @@ func (x GetRepositoriesRequest_Scope) String() string {
 }
 
 func (GetRepositoriesRequest_Scope) Descriptor() protoreflect.EnumDescriptor {
-	return file_neoshowcase_protobuf_gateway_proto_enumTypes[7].Descriptor()
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[16].Descriptor()
 }
 
 func (GetRepositoriesRequest_Scope) Type() protoreflect.EnumType {
-	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[7]
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[16]
 }
 
 func (x GetRepositoriesRequest_Scope) Number() protoreflect.EnumNumber {
@@ -431,7 +890,7 @@ func (x GetRepositoriesRequest_Scope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetRepositoriesRequest_Scope.Descriptor instead.
 func (GetRepositoriesRequest_Scope) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{44, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{74, 0}
 }
 
 type GetApplicationsRequest_Scope int32
@@ -467,11 +926,11 @@ func (x GetApplicationsRequest_Scope) String() string {
 }
 
 func (GetApplicationsRequest_Scope) Descriptor() protoreflect.EnumDescriptor {
-	return file_neoshowcase_protobuf_gateway_proto_enumTypes[8].Descriptor()
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[17].Descriptor()
 }
 
 func (GetApplicationsRequest_Scope) Type() protoreflect.EnumType {
-	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[8]
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[17]
 }
 
 func (x GetApplicationsRequest_Scope) Number() protoreflect.EnumNumber {
@@ -480,19 +939,68 @@ func (x GetApplicationsRequest_Scope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetApplicationsRequest_Scope.Descriptor instead.
 func (GetApplicationsRequest_Scope) EnumDescriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{52, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{83, 0}
 }
 
-type SSHInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+type LogFilter_Stream int32
 
-func (x *SSHInfo) Reset() {
-	*x = SSHInfo{}
+const (
+	LogFilter_ALL    LogFilter_Stream = 0
+	LogFilter_STDOUT LogFilter_Stream = 1
+	LogFilter_STDERR LogFilter_Stream = 2
+)
+
+// Enum value maps for LogFilter_Stream.
+var (
+	LogFilter_Stream_name = map[int32]string{
+		0: "ALL",
+		1: "STDOUT",
+		2: "STDERR",
+	}
+	LogFilter_Stream_value = map[string]int32{
+		"ALL":    0,
+		"STDOUT": 1,
+		"STDERR": 2,
+	}
+)
+
+func (x LogFilter_Stream) Enum() *LogFilter_Stream {
+	p := new(LogFilter_Stream)
+	*p = x
+	return p
+}
+
+func (x LogFilter_Stream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogFilter_Stream) Descriptor() protoreflect.EnumDescriptor {
+	return file_neoshowcase_protobuf_gateway_proto_enumTypes[18].Descriptor()
+}
+
+func (LogFilter_Stream) Type() protoreflect.EnumType {
+	return &file_neoshowcase_protobuf_gateway_proto_enumTypes[18]
+}
+
+func (x LogFilter_Stream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogFilter_Stream.Descriptor instead.
+func (LogFilter_Stream) EnumDescriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{101, 0}
+}
+
+type SSHInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHInfo) Reset() {
+	*x = SSHInfo{}
 	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
@@ -959,32 +1467,40 @@ func (x *UserKey) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Repository struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	HtmlUrl       string                 `protobuf:"bytes,4,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"`
-	AuthMethod    Repository_AuthMethod  `protobuf:"varint,5,opt,name=auth_method,json=authMethod,proto3,enum=neoshowcase.protobuf.Repository_AuthMethod" json:"auth_method,omitempty"`
-	OwnerIds      []string               `protobuf:"bytes,6,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+// CustomDomain ユーザーが登録した独自ドメイン 所有権が確認されると自身のアプリで使用できます
+type CustomDomain struct {
+	state          protoimpl.MessageState          `protogen:"open.v1"`
+	Id             string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain         string                          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Method         CustomDomain_VerificationMethod `protobuf:"varint,3,opt,name=method,proto3,enum=neoshowcase.protobuf.CustomDomain_VerificationMethod" json:"method,omitempty"`
+	Token          string                          `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	TxtRecordName  string                          `protobuf:"bytes,5,opt,name=txt_record_name,json=txtRecordName,proto3" json:"txt_record_name,omitempty"`
+	TxtRecordValue string                          `protobuf:"bytes,6,opt,name=txt_record_value,json=txtRecordValue,proto3" json:"txt_record_value,omitempty"`
+	HttpUrl        string                          `protobuf:"bytes,7,opt,name=http_url,json=httpUrl,proto3" json:"http_url,omitempty"`
+	Verified       bool                            `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerifiedAt     *NullTimestamp                  `protobuf:"bytes,9,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	CheckedAt      *NullTimestamp                  `protobuf:"bytes,10,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	// error 最後に失敗した確認の理由
+	Error         string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Repository) Reset() {
-	*x = Repository{}
+func (x *CustomDomain) Reset() {
+	*x = CustomDomain{}
 	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Repository) String() string {
+func (x *CustomDomain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repository) ProtoMessage() {}
+func (*CustomDomain) ProtoMessage() {}
 
-func (x *Repository) ProtoReflect() protoreflect.Message {
+func (x *CustomDomain) ProtoReflect() protoreflect.Message {
 	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,144 +1512,117 @@ func (x *Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
-func (*Repository) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomDomain.ProtoReflect.Descriptor instead.
+func (*CustomDomain) Descriptor() ([]byte, []int) {
 	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Repository) GetId() string {
+func (x *CustomDomain) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Repository) GetName() string {
+func (x *CustomDomain) GetDomain() string {
 	if x != nil {
-		return x.Name
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *Repository) GetUrl() string {
+func (x *CustomDomain) GetMethod() CustomDomain_VerificationMethod {
 	if x != nil {
-		return x.Url
+		return x.Method
 	}
-	return ""
+	return CustomDomain_DNS
 }
 
-func (x *Repository) GetHtmlUrl() string {
+func (x *CustomDomain) GetToken() string {
 	if x != nil {
-		return x.HtmlUrl
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Repository) GetAuthMethod() Repository_AuthMethod {
+func (x *CustomDomain) GetTxtRecordName() string {
 	if x != nil {
-		return x.AuthMethod
+		return x.TxtRecordName
 	}
-	return Repository_NONE
+	return ""
 }
 
-func (x *Repository) GetOwnerIds() []string {
+func (x *CustomDomain) GetTxtRecordValue() string {
 	if x != nil {
-		return x.OwnerIds
+		return x.TxtRecordValue
 	}
-	return nil
-}
-
-type SimpleCommit struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	AuthorName    string                 `protobuf:"bytes,2,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	CommitDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=commit_date,json=commitDate,proto3" json:"commit_date,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SimpleCommit) Reset() {
-	*x = SimpleCommit{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SimpleCommit) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*SimpleCommit) ProtoMessage() {}
-
-func (x *SimpleCommit) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[8]
+func (x *CustomDomain) GetHttpUrl() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.HttpUrl
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SimpleCommit.ProtoReflect.Descriptor instead.
-func (*SimpleCommit) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{8}
+	return ""
 }
 
-func (x *SimpleCommit) GetHash() string {
+func (x *CustomDomain) GetVerified() bool {
 	if x != nil {
-		return x.Hash
+		return x.Verified
 	}
-	return ""
+	return false
 }
 
-func (x *SimpleCommit) GetAuthorName() string {
+func (x *CustomDomain) GetVerifiedAt() *NullTimestamp {
 	if x != nil {
-		return x.AuthorName
+		return x.VerifiedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *SimpleCommit) GetCommitDate() *timestamppb.Timestamp {
+func (x *CustomDomain) GetCheckedAt() *NullTimestamp {
 	if x != nil {
-		return x.CommitDate
+		return x.CheckedAt
 	}
 	return nil
 }
 
-func (x *SimpleCommit) GetMessage() string {
+func (x *CustomDomain) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-type AutoShutdownConfig struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Enabled       bool                               `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Startup       AutoShutdownConfig_StartupBehavior `protobuf:"varint,2,opt,name=startup,proto3,enum=neoshowcase.protobuf.AutoShutdownConfig_StartupBehavior" json:"startup,omitempty"`
+func (x *CustomDomain) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetCustomDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*CustomDomain        `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AutoShutdownConfig) Reset() {
-	*x = AutoShutdownConfig{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[9]
+func (x *GetCustomDomainsResponse) Reset() {
+	*x = GetCustomDomainsResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutoShutdownConfig) String() string {
+func (x *GetCustomDomainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoShutdownConfig) ProtoMessage() {}
+func (*GetCustomDomainsResponse) ProtoMessage() {}
 
-func (x *AutoShutdownConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[9]
+func (x *GetCustomDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,51 +1633,50 @@ func (x *AutoShutdownConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoShutdownConfig.ProtoReflect.Descriptor instead.
-func (*AutoShutdownConfig) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *AutoShutdownConfig) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use GetCustomDomainsResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AutoShutdownConfig) GetStartup() AutoShutdownConfig_StartupBehavior {
+func (x *GetCustomDomainsResponse) GetDomains() []*CustomDomain {
 	if x != nil {
-		return x.Startup
+		return x.Domains
 	}
-	return AutoShutdownConfig_UNDEFINED
+	return nil
 }
 
-type RuntimeConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UseMariadb    bool                   `protobuf:"varint,1,opt,name=use_mariadb,json=useMariadb,proto3" json:"use_mariadb,omitempty"`
-	UseMongodb    bool                   `protobuf:"varint,2,opt,name=use_mongodb,json=useMongodb,proto3" json:"use_mongodb,omitempty"`
-	Entrypoint    string                 `protobuf:"bytes,3,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
-	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
-	AutoShutdown  *AutoShutdownConfig    `protobuf:"bytes,5,opt,name=auto_shutdown,json=autoShutdown,proto3" json:"auto_shutdown,omitempty"`
+// TLSCertificate アップロードされたTLS証明書 ACMEで発行された証明書の代わりにfqdnのWebサイトで使用されます
+type TLSCertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id アップロードしたユーザーのID
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// fqdn "*.example.com"のようなワイルドカードドメインの場合 直下のサブドメインで使用されます
+	Fqdn      string                 `protobuf:"bytes,3,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// expiring 有効期限が近いか 既に切れているか 自動では更新されないため再アップロードが必要です
+	Expiring      bool                   `protobuf:"varint,6,opt,name=expiring,proto3" json:"expiring,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuntimeConfig) Reset() {
-	*x = RuntimeConfig{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[10]
+func (x *TLSCertificate) Reset() {
+	*x = TLSCertificate{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuntimeConfig) String() string {
+func (x *TLSCertificate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuntimeConfig) ProtoMessage() {}
+func (*TLSCertificate) ProtoMessage() {}
 
-func (x *RuntimeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[10]
+func (x *TLSCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,69 +1687,82 @@ func (x *RuntimeConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuntimeConfig.ProtoReflect.Descriptor instead.
-func (*RuntimeConfig) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TLSCertificate.ProtoReflect.Descriptor instead.
+func (*TLSCertificate) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RuntimeConfig) GetUseMariadb() bool {
+func (x *TLSCertificate) GetId() string {
 	if x != nil {
-		return x.UseMariadb
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *RuntimeConfig) GetUseMongodb() bool {
+func (x *TLSCertificate) GetUserId() string {
 	if x != nil {
-		return x.UseMongodb
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-func (x *RuntimeConfig) GetEntrypoint() string {
+func (x *TLSCertificate) GetFqdn() string {
 	if x != nil {
-		return x.Entrypoint
+		return x.Fqdn
 	}
 	return ""
 }
 
-func (x *RuntimeConfig) GetCommand() string {
+func (x *TLSCertificate) GetNotBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Command
+		return x.NotBefore
 	}
-	return ""
+	return nil
 }
 
-func (x *RuntimeConfig) GetAutoShutdown() *AutoShutdownConfig {
+func (x *TLSCertificate) GetNotAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AutoShutdown
+		return x.NotAfter
 	}
 	return nil
 }
 
-type BuildConfigRuntimeBuildpack struct {
+func (x *TLSCertificate) GetExpiring() bool {
+	if x != nil {
+		return x.Expiring
+	}
+	return false
+}
+
+func (x *TLSCertificate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetTLSCertificatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuntimeConfig *RuntimeConfig         `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
-	Context       string                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Certificates  []*TLSCertificate      `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildConfigRuntimeBuildpack) Reset() {
-	*x = BuildConfigRuntimeBuildpack{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[11]
+func (x *GetTLSCertificatesResponse) Reset() {
+	*x = GetTLSCertificatesResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildConfigRuntimeBuildpack) String() string {
+func (x *GetTLSCertificatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildConfigRuntimeBuildpack) ProtoMessage() {}
+func (*GetTLSCertificatesResponse) ProtoMessage() {}
 
-func (x *BuildConfigRuntimeBuildpack) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[11]
+func (x *GetTLSCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,49 +1773,44 @@ func (x *BuildConfigRuntimeBuildpack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildConfigRuntimeBuildpack.ProtoReflect.Descriptor instead.
-func (*BuildConfigRuntimeBuildpack) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetTLSCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*GetTLSCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BuildConfigRuntimeBuildpack) GetRuntimeConfig() *RuntimeConfig {
+func (x *GetTLSCertificatesResponse) GetCertificates() []*TLSCertificate {
 	if x != nil {
-		return x.RuntimeConfig
+		return x.Certificates
 	}
 	return nil
 }
 
-func (x *BuildConfigRuntimeBuildpack) GetContext() string {
-	if x != nil {
-		return x.Context
-	}
-	return ""
-}
-
-type BuildConfigRuntimeCmd struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuntimeConfig *RuntimeConfig         `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
-	BaseImage     string                 `protobuf:"bytes,2,opt,name=base_image,json=baseImage,proto3" json:"base_image,omitempty"`
-	BuildCmd      string                 `protobuf:"bytes,3,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ResourceQuota ユーザーが所有するアプリケーションで使えるリソースの上限 負の値は無制限を表します
+type ResourceQuota struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MaxApplications        int32                  `protobuf:"varint,1,opt,name=max_applications,json=maxApplications,proto3" json:"max_applications,omitempty"`
+	MaxRunningApplications int32                  `protobuf:"varint,2,opt,name=max_running_applications,json=maxRunningApplications,proto3" json:"max_running_applications,omitempty"`
+	MaxPortPublications    int32                  `protobuf:"varint,3,opt,name=max_port_publications,json=maxPortPublications,proto3" json:"max_port_publications,omitempty"`
+	MaxDatabases           int32                  `protobuf:"varint,4,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *BuildConfigRuntimeCmd) Reset() {
-	*x = BuildConfigRuntimeCmd{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[12]
+func (x *ResourceQuota) Reset() {
+	*x = ResourceQuota{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildConfigRuntimeCmd) String() string {
+func (x *ResourceQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildConfigRuntimeCmd) ProtoMessage() {}
+func (*ResourceQuota) ProtoMessage() {}
 
-func (x *BuildConfigRuntimeCmd) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[12]
+func (x *ResourceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,56 +1821,64 @@ func (x *BuildConfigRuntimeCmd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildConfigRuntimeCmd.ProtoReflect.Descriptor instead.
-func (*BuildConfigRuntimeCmd) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ResourceQuota.ProtoReflect.Descriptor instead.
+func (*ResourceQuota) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BuildConfigRuntimeCmd) GetRuntimeConfig() *RuntimeConfig {
+func (x *ResourceQuota) GetMaxApplications() int32 {
 	if x != nil {
-		return x.RuntimeConfig
+		return x.MaxApplications
 	}
-	return nil
+	return 0
 }
 
-func (x *BuildConfigRuntimeCmd) GetBaseImage() string {
+func (x *ResourceQuota) GetMaxRunningApplications() int32 {
 	if x != nil {
-		return x.BaseImage
+		return x.MaxRunningApplications
 	}
-	return ""
+	return 0
 }
 
-func (x *BuildConfigRuntimeCmd) GetBuildCmd() string {
+func (x *ResourceQuota) GetMaxPortPublications() int32 {
 	if x != nil {
-		return x.BuildCmd
+		return x.MaxPortPublications
 	}
-	return ""
+	return 0
 }
 
-type BuildConfigRuntimeDockerfile struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RuntimeConfig  *RuntimeConfig         `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
-	DockerfileName string                 `protobuf:"bytes,2,opt,name=dockerfile_name,json=dockerfileName,proto3" json:"dockerfile_name,omitempty"`
-	Context        string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ResourceQuota) GetMaxDatabases() int32 {
+	if x != nil {
+		return x.MaxDatabases
+	}
+	return 0
 }
 
-func (x *BuildConfigRuntimeDockerfile) Reset() {
-	*x = BuildConfigRuntimeDockerfile{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[13]
+type ResourceUsage struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Applications        int32                  `protobuf:"varint,1,opt,name=applications,proto3" json:"applications,omitempty"`
+	RunningApplications int32                  `protobuf:"varint,2,opt,name=running_applications,json=runningApplications,proto3" json:"running_applications,omitempty"`
+	PortPublications    int32                  `protobuf:"varint,3,opt,name=port_publications,json=portPublications,proto3" json:"port_publications,omitempty"`
+	Databases           int32                  `protobuf:"varint,4,opt,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildConfigRuntimeDockerfile) String() string {
+func (x *ResourceUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildConfigRuntimeDockerfile) ProtoMessage() {}
+func (*ResourceUsage) ProtoMessage() {}
 
-func (x *BuildConfigRuntimeDockerfile) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[13]
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,55 +1889,66 @@ func (x *BuildConfigRuntimeDockerfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildConfigRuntimeDockerfile.ProtoReflect.Descriptor instead.
-func (*BuildConfigRuntimeDockerfile) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BuildConfigRuntimeDockerfile) GetRuntimeConfig() *RuntimeConfig {
+func (x *ResourceUsage) GetApplications() int32 {
 	if x != nil {
-		return x.RuntimeConfig
+		return x.Applications
 	}
-	return nil
+	return 0
 }
 
-func (x *BuildConfigRuntimeDockerfile) GetDockerfileName() string {
+func (x *ResourceUsage) GetRunningApplications() int32 {
 	if x != nil {
-		return x.DockerfileName
+		return x.RunningApplications
 	}
-	return ""
+	return 0
 }
 
-func (x *BuildConfigRuntimeDockerfile) GetContext() string {
+func (x *ResourceUsage) GetPortPublications() int32 {
 	if x != nil {
-		return x.Context
+		return x.PortPublications
 	}
-	return ""
+	return 0
 }
 
-type StaticConfig struct {
+func (x *ResourceUsage) GetDatabases() int32 {
+	if x != nil {
+		return x.Databases
+	}
+	return 0
+}
+
+type Repository struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactPath  string                 `protobuf:"bytes,1,opt,name=artifact_path,json=artifactPath,proto3" json:"artifact_path,omitempty"`
-	Spa           bool                   `protobuf:"varint,2,opt,name=spa,proto3" json:"spa,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlUrl       string                 `protobuf:"bytes,4,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"`
+	AuthMethod    Repository_AuthMethod  `protobuf:"varint,5,opt,name=auth_method,json=authMethod,proto3,enum=neoshowcase.protobuf.Repository_AuthMethod" json:"auth_method,omitempty"`
+	OwnerIds      []string               `protobuf:"bytes,6,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StaticConfig) Reset() {
-	*x = StaticConfig{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[14]
+func (x *Repository) Reset() {
+	*x = Repository{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StaticConfig) String() string {
+func (x *Repository) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StaticConfig) ProtoMessage() {}
+func (*Repository) ProtoMessage() {}
 
-func (x *StaticConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[14]
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,48 +1959,78 @@ func (x *StaticConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StaticConfig.ProtoReflect.Descriptor instead.
-func (*StaticConfig) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StaticConfig) GetArtifactPath() string {
+func (x *Repository) GetId() string {
 	if x != nil {
-		return x.ArtifactPath
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StaticConfig) GetSpa() bool {
+func (x *Repository) GetName() string {
 	if x != nil {
-		return x.Spa
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type BuildConfigStaticBuildpack struct {
+func (x *Repository) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Repository) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
+	}
+	return ""
+}
+
+func (x *Repository) GetAuthMethod() Repository_AuthMethod {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return Repository_NONE
+}
+
+func (x *Repository) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+type SimpleCommit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StaticConfig  *StaticConfig          `protobuf:"bytes,1,opt,name=static_config,json=staticConfig,proto3" json:"static_config,omitempty"`
-	Context       string                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,2,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	CommitDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=commit_date,json=commitDate,proto3" json:"commit_date,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildConfigStaticBuildpack) Reset() {
-	*x = BuildConfigStaticBuildpack{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[15]
+func (x *SimpleCommit) Reset() {
+	*x = SimpleCommit{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildConfigStaticBuildpack) String() string {
+func (x *SimpleCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildConfigStaticBuildpack) ProtoMessage() {}
+func (*SimpleCommit) ProtoMessage() {}
 
-func (x *BuildConfigStaticBuildpack) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[15]
+func (x *SimpleCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,49 +2041,62 @@ func (x *BuildConfigStaticBuildpack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildConfigStaticBuildpack.ProtoReflect.Descriptor instead.
-func (*BuildConfigStaticBuildpack) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SimpleCommit.ProtoReflect.Descriptor instead.
+func (*SimpleCommit) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *BuildConfigStaticBuildpack) GetStaticConfig() *StaticConfig {
+func (x *SimpleCommit) GetHash() string {
 	if x != nil {
-		return x.StaticConfig
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SimpleCommit) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *SimpleCommit) GetCommitDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitDate
 	}
 	return nil
 }
 
-func (x *BuildConfigStaticBuildpack) GetContext() string {
+func (x *SimpleCommit) GetMessage() string {
 	if x != nil {
-		return x.Context
+		return x.Message
 	}
 	return ""
 }
 
-type BuildConfigStaticCmd struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StaticConfig  *StaticConfig          `protobuf:"bytes,1,opt,name=static_config,json=staticConfig,proto3" json:"static_config,omitempty"`
-	BaseImage     string                 `protobuf:"bytes,2,opt,name=base_image,json=baseImage,proto3" json:"base_image,omitempty"`
-	BuildCmd      string                 `protobuf:"bytes,3,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
+type AutoShutdownConfig struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Enabled       bool                               `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Startup       AutoShutdownConfig_StartupBehavior `protobuf:"varint,2,opt,name=startup,proto3,enum=neoshowcase.protobuf.AutoShutdownConfig_StartupBehavior" json:"startup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildConfigStaticCmd) Reset() {
-	*x = BuildConfigStaticCmd{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[16]
+func (x *AutoShutdownConfig) Reset() {
+	*x = AutoShutdownConfig{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildConfigStaticCmd) String() string {
+func (x *AutoShutdownConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildConfigStaticCmd) ProtoMessage() {}
+func (*AutoShutdownConfig) ProtoMessage() {}
 
-func (x *BuildConfigStaticCmd) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[16]
+func (x *AutoShutdownConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,56 +2107,51 @@ func (x *BuildConfigStaticCmd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildConfigStaticCmd.ProtoReflect.Descriptor instead.
-func (*BuildConfigStaticCmd) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *BuildConfigStaticCmd) GetStaticConfig() *StaticConfig {
-	if x != nil {
-		return x.StaticConfig
-	}
-	return nil
+// Deprecated: Use AutoShutdownConfig.ProtoReflect.Descriptor instead.
+func (*AutoShutdownConfig) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BuildConfigStaticCmd) GetBaseImage() string {
+func (x *AutoShutdownConfig) GetEnabled() bool {
 	if x != nil {
-		return x.BaseImage
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *BuildConfigStaticCmd) GetBuildCmd() string {
+func (x *AutoShutdownConfig) GetStartup() AutoShutdownConfig_StartupBehavior {
 	if x != nil {
-		return x.BuildCmd
+		return x.Startup
 	}
-	return ""
+	return AutoShutdownConfig_UNDEFINED
 }
 
-type BuildConfigStaticDockerfile struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StaticConfig   *StaticConfig          `protobuf:"bytes,1,opt,name=static_config,json=staticConfig,proto3" json:"static_config,omitempty"`
-	DockerfileName string                 `protobuf:"bytes,2,opt,name=dockerfile_name,json=dockerfileName,proto3" json:"dockerfile_name,omitempty"`
-	Context        string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RuntimeConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UseMariadb    bool                   `protobuf:"varint,1,opt,name=use_mariadb,json=useMariadb,proto3" json:"use_mariadb,omitempty"`
+	UseMongodb    bool                   `protobuf:"varint,2,opt,name=use_mongodb,json=useMongodb,proto3" json:"use_mongodb,omitempty"`
+	Entrypoint    string                 `protobuf:"bytes,3,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	AutoShutdown  *AutoShutdownConfig    `protobuf:"bytes,5,opt,name=auto_shutdown,json=autoShutdown,proto3" json:"auto_shutdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildConfigStaticDockerfile) Reset() {
-	*x = BuildConfigStaticDockerfile{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[17]
+func (x *RuntimeConfig) Reset() {
+	*x = RuntimeConfig{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildConfigStaticDockerfile) String() string {
+func (x *RuntimeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildConfigStaticDockerfile) ProtoMessage() {}
+func (*RuntimeConfig) ProtoMessage() {}
 
-func (x *BuildConfigStaticDockerfile) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[17]
+func (x *RuntimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,61 +2162,121 @@ func (x *BuildConfigStaticDockerfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildConfigStaticDockerfile.ProtoReflect.Descriptor instead.
-func (*BuildConfigStaticDockerfile) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RuntimeConfig.ProtoReflect.Descriptor instead.
+func (*RuntimeConfig) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BuildConfigStaticDockerfile) GetStaticConfig() *StaticConfig {
+func (x *RuntimeConfig) GetUseMariadb() bool {
 	if x != nil {
-		return x.StaticConfig
+		return x.UseMariadb
 	}
-	return nil
+	return false
 }
 
-func (x *BuildConfigStaticDockerfile) GetDockerfileName() string {
+func (x *RuntimeConfig) GetUseMongodb() bool {
 	if x != nil {
-		return x.DockerfileName
+		return x.UseMongodb
+	}
+	return false
+}
+
+func (x *RuntimeConfig) GetEntrypoint() string {
+	if x != nil {
+		return x.Entrypoint
 	}
 	return ""
 }
 
-func (x *BuildConfigStaticDockerfile) GetContext() string {
+func (x *RuntimeConfig) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *RuntimeConfig) GetAutoShutdown() *AutoShutdownConfig {
+	if x != nil {
+		return x.AutoShutdown
+	}
+	return nil
+}
+
+type BuildConfigRuntimeBuildpack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeConfig *RuntimeConfig         `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
+	Context       string                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildConfigRuntimeBuildpack) Reset() {
+	*x = BuildConfigRuntimeBuildpack{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildConfigRuntimeBuildpack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildConfigRuntimeBuildpack) ProtoMessage() {}
+
+func (x *BuildConfigRuntimeBuildpack) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildConfigRuntimeBuildpack.ProtoReflect.Descriptor instead.
+func (*BuildConfigRuntimeBuildpack) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BuildConfigRuntimeBuildpack) GetRuntimeConfig() *RuntimeConfig {
+	if x != nil {
+		return x.RuntimeConfig
+	}
+	return nil
+}
+
+func (x *BuildConfigRuntimeBuildpack) GetContext() string {
 	if x != nil {
 		return x.Context
 	}
 	return ""
 }
 
-type ApplicationConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to BuildConfig:
-	//
-	//	*ApplicationConfig_RuntimeBuildpack
-	//	*ApplicationConfig_RuntimeCmd
-	//	*ApplicationConfig_RuntimeDockerfile
-	//	*ApplicationConfig_StaticBuildpack
-	//	*ApplicationConfig_StaticCmd
-	//	*ApplicationConfig_StaticDockerfile
-	BuildConfig   isApplicationConfig_BuildConfig `protobuf_oneof:"build_config"`
+type BuildConfigRuntimeCmd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeConfig *RuntimeConfig         `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
+	BaseImage     string                 `protobuf:"bytes,2,opt,name=base_image,json=baseImage,proto3" json:"base_image,omitempty"`
+	BuildCmd      string                 `protobuf:"bytes,3,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationConfig) Reset() {
-	*x = ApplicationConfig{}
+func (x *BuildConfigRuntimeCmd) Reset() {
+	*x = BuildConfigRuntimeCmd{}
 	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationConfig) String() string {
+func (x *BuildConfigRuntimeCmd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationConfig) ProtoMessage() {}
+func (*BuildConfigRuntimeCmd) ProtoMessage() {}
 
-func (x *ApplicationConfig) ProtoReflect() protoreflect.Message {
+func (x *BuildConfigRuntimeCmd) ProtoReflect() protoreflect.Message {
 	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1675,141 +2288,3036 @@ func (x *ApplicationConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationConfig.ProtoReflect.Descriptor instead.
-func (*ApplicationConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuildConfigRuntimeCmd.ProtoReflect.Descriptor instead.
+func (*BuildConfigRuntimeCmd) Descriptor() ([]byte, []int) {
 	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ApplicationConfig) GetBuildConfig() isApplicationConfig_BuildConfig {
+func (x *BuildConfigRuntimeCmd) GetRuntimeConfig() *RuntimeConfig {
 	if x != nil {
-		return x.BuildConfig
+		return x.RuntimeConfig
 	}
 	return nil
 }
 
-func (x *ApplicationConfig) GetRuntimeBuildpack() *BuildConfigRuntimeBuildpack {
+func (x *BuildConfigRuntimeCmd) GetBaseImage() string {
 	if x != nil {
-		if x, ok := x.BuildConfig.(*ApplicationConfig_RuntimeBuildpack); ok {
-			return x.RuntimeBuildpack
-		}
+		return x.BaseImage
 	}
-	return nil
+	return ""
 }
 
-func (x *ApplicationConfig) GetRuntimeCmd() *BuildConfigRuntimeCmd {
+func (x *BuildConfigRuntimeCmd) GetBuildCmd() string {
 	if x != nil {
-		if x, ok := x.BuildConfig.(*ApplicationConfig_RuntimeCmd); ok {
-			return x.RuntimeCmd
-		}
+		return x.BuildCmd
 	}
-	return nil
+	return ""
 }
 
-func (x *ApplicationConfig) GetRuntimeDockerfile() *BuildConfigRuntimeDockerfile {
+type BuildConfigRuntimeDockerfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeConfig  *RuntimeConfig         `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
+	DockerfileName string                 `protobuf:"bytes,2,opt,name=dockerfile_name,json=dockerfileName,proto3" json:"dockerfile_name,omitempty"`
+	Context        string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BuildConfigRuntimeDockerfile) Reset() {
+	*x = BuildConfigRuntimeDockerfile{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildConfigRuntimeDockerfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildConfigRuntimeDockerfile) ProtoMessage() {}
+
+func (x *BuildConfigRuntimeDockerfile) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[19]
 	if x != nil {
-		if x, ok := x.BuildConfig.(*ApplicationConfig_RuntimeDockerfile); ok {
-			return x.RuntimeDockerfile
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ApplicationConfig) GetStaticBuildpack() *BuildConfigStaticBuildpack {
+// Deprecated: Use BuildConfigRuntimeDockerfile.ProtoReflect.Descriptor instead.
+func (*BuildConfigRuntimeDockerfile) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BuildConfigRuntimeDockerfile) GetRuntimeConfig() *RuntimeConfig {
 	if x != nil {
-		if x, ok := x.BuildConfig.(*ApplicationConfig_StaticBuildpack); ok {
-			return x.StaticBuildpack
-		}
+		return x.RuntimeConfig
 	}
 	return nil
 }
 
-func (x *ApplicationConfig) GetStaticCmd() *BuildConfigStaticCmd {
+func (x *BuildConfigRuntimeDockerfile) GetDockerfileName() string {
 	if x != nil {
-		if x, ok := x.BuildConfig.(*ApplicationConfig_StaticCmd); ok {
-			return x.StaticCmd
-		}
+		return x.DockerfileName
 	}
-	return nil
+	return ""
 }
 
-func (x *ApplicationConfig) GetStaticDockerfile() *BuildConfigStaticDockerfile {
+func (x *BuildConfigRuntimeDockerfile) GetContext() string {
 	if x != nil {
-		if x, ok := x.BuildConfig.(*ApplicationConfig_StaticDockerfile); ok {
-			return x.StaticDockerfile
-		}
+		return x.Context
 	}
-	return nil
+	return ""
 }
 
-type isApplicationConfig_BuildConfig interface {
-	isApplicationConfig_BuildConfig()
+type StaticConfig struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactPath string                 `protobuf:"bytes,1,opt,name=artifact_path,json=artifactPath,proto3" json:"artifact_path,omitempty"`
+	Spa          bool                   `protobuf:"varint,2,opt,name=spa,proto3" json:"spa,omitempty"`
+	// 404ページとして配信する静的成果物内のパス (例: "/404.html") 空の場合はデフォルトのページ
+	NotFoundPath string `protobuf:"bytes,3,opt,name=not_found_path,json=notFoundPath,proto3" json:"not_found_path,omitempty"`
+	// ハッシュ付きファイル名のアセット (例: "/assets/index-BxK3a9_d.js") のCache-Control 空の場合は付与しない
+	AssetCacheControl string `protobuf:"bytes,4,opt,name=asset_cache_control,json=assetCacheControl,proto3" json:"asset_cache_control,omitempty"`
+	// HTMLのCache-Control 空の場合は付与しない
+	HtmlCacheControl string `protobuf:"bytes,5,opt,name=html_cache_control,json=htmlCacheControl,proto3" json:"html_cache_control,omitempty"`
+	// クライアントが対応していれば、圧縮済みファイル (.br, .gz) を配信するか
+	Precompressed bool `protobuf:"varint,6,opt,name=precompressed,proto3" json:"precompressed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type ApplicationConfig_RuntimeBuildpack struct {
-	RuntimeBuildpack *BuildConfigRuntimeBuildpack `protobuf:"bytes,1,opt,name=runtime_buildpack,json=runtimeBuildpack,proto3,oneof"`
+func (x *StaticConfig) Reset() {
+	*x = StaticConfig{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ApplicationConfig_RuntimeCmd struct {
-	RuntimeCmd *BuildConfigRuntimeCmd `protobuf:"bytes,2,opt,name=runtime_cmd,json=runtimeCmd,proto3,oneof"`
+func (x *StaticConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ApplicationConfig_RuntimeDockerfile struct {
-	RuntimeDockerfile *BuildConfigRuntimeDockerfile `protobuf:"bytes,3,opt,name=runtime_dockerfile,json=runtimeDockerfile,proto3,oneof"`
+func (*StaticConfig) ProtoMessage() {}
+
+func (x *StaticConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ApplicationConfig_StaticBuildpack struct {
-	StaticBuildpack *BuildConfigStaticBuildpack `protobuf:"bytes,4,opt,name=static_buildpack,json=staticBuildpack,proto3,oneof"`
+// Deprecated: Use StaticConfig.ProtoReflect.Descriptor instead.
+func (*StaticConfig) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{20}
 }
 
-type ApplicationConfig_StaticCmd struct {
-	StaticCmd *BuildConfigStaticCmd `protobuf:"bytes,5,opt,name=static_cmd,json=staticCmd,proto3,oneof"`
+func (x *StaticConfig) GetArtifactPath() string {
+	if x != nil {
+		return x.ArtifactPath
+	}
+	return ""
 }
 
-type ApplicationConfig_StaticDockerfile struct {
-	StaticDockerfile *BuildConfigStaticDockerfile `protobuf:"bytes,6,opt,name=static_dockerfile,json=staticDockerfile,proto3,oneof"`
+func (x *StaticConfig) GetSpa() bool {
+	if x != nil {
+		return x.Spa
+	}
+	return false
 }
 
-func (*ApplicationConfig_RuntimeBuildpack) isApplicationConfig_BuildConfig() {}
+func (x *StaticConfig) GetNotFoundPath() string {
+	if x != nil {
+		return x.NotFoundPath
+	}
+	return ""
+}
 
-func (*ApplicationConfig_RuntimeCmd) isApplicationConfig_BuildConfig() {}
+func (x *StaticConfig) GetAssetCacheControl() string {
+	if x != nil {
+		return x.AssetCacheControl
+	}
+	return ""
+}
 
-func (*ApplicationConfig_RuntimeDockerfile) isApplicationConfig_BuildConfig() {}
+func (x *StaticConfig) GetHtmlCacheControl() string {
+	if x != nil {
+		return x.HtmlCacheControl
+	}
+	return ""
+}
 
-func (*ApplicationConfig_StaticBuildpack) isApplicationConfig_BuildConfig() {}
+func (x *StaticConfig) GetPrecompressed() bool {
+	if x != nil {
+		return x.Precompressed
+	}
+	return false
+}
 
-func (*ApplicationConfig_StaticCmd) isApplicationConfig_BuildConfig() {}
+type BuildConfigStaticBuildpack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaticConfig  *StaticConfig          `protobuf:"bytes,1,opt,name=static_config,json=staticConfig,proto3" json:"static_config,omitempty"`
+	Context       string                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*ApplicationConfig_StaticDockerfile) isApplicationConfig_BuildConfig() {}
+func (x *BuildConfigStaticBuildpack) Reset() {
+	*x = BuildConfigStaticBuildpack{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-type Website struct {
+func (x *BuildConfigStaticBuildpack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildConfigStaticBuildpack) ProtoMessage() {}
+
+func (x *BuildConfigStaticBuildpack) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildConfigStaticBuildpack.ProtoReflect.Descriptor instead.
+func (*BuildConfigStaticBuildpack) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BuildConfigStaticBuildpack) GetStaticConfig() *StaticConfig {
+	if x != nil {
+		return x.StaticConfig
+	}
+	return nil
+}
+
+func (x *BuildConfigStaticBuildpack) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+type BuildConfigStaticCmd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaticConfig  *StaticConfig          `protobuf:"bytes,1,opt,name=static_config,json=staticConfig,proto3" json:"static_config,omitempty"`
+	BaseImage     string                 `protobuf:"bytes,2,opt,name=base_image,json=baseImage,proto3" json:"base_image,omitempty"`
+	BuildCmd      string                 `protobuf:"bytes,3,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildConfigStaticCmd) Reset() {
+	*x = BuildConfigStaticCmd{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildConfigStaticCmd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildConfigStaticCmd) ProtoMessage() {}
+
+func (x *BuildConfigStaticCmd) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildConfigStaticCmd.ProtoReflect.Descriptor instead.
+func (*BuildConfigStaticCmd) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BuildConfigStaticCmd) GetStaticConfig() *StaticConfig {
+	if x != nil {
+		return x.StaticConfig
+	}
+	return nil
+}
+
+func (x *BuildConfigStaticCmd) GetBaseImage() string {
+	if x != nil {
+		return x.BaseImage
+	}
+	return ""
+}
+
+func (x *BuildConfigStaticCmd) GetBuildCmd() string {
+	if x != nil {
+		return x.BuildCmd
+	}
+	return ""
+}
+
+type BuildConfigStaticDockerfile struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fqdn           string                 `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
-	PathPrefix     string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	StripPrefix    bool                   `protobuf:"varint,4,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
-	Https          bool                   `protobuf:"varint,5,opt,name=https,proto3" json:"https,omitempty"`
-	H2C            bool                   `protobuf:"varint,6,opt,name=h2c,proto3" json:"h2c,omitempty"`
-	HttpPort       int32                  `protobuf:"varint,7,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
-	Authentication AuthenticationType     `protobuf:"varint,8,opt,name=authentication,proto3,enum=neoshowcase.protobuf.AuthenticationType" json:"authentication,omitempty"`
+	StaticConfig   *StaticConfig          `protobuf:"bytes,1,opt,name=static_config,json=staticConfig,proto3" json:"static_config,omitempty"`
+	DockerfileName string                 `protobuf:"bytes,2,opt,name=dockerfile_name,json=dockerfileName,proto3" json:"dockerfile_name,omitempty"`
+	Context        string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Website) Reset() {
-	*x = Website{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[19]
+func (x *BuildConfigStaticDockerfile) Reset() {
+	*x = BuildConfigStaticDockerfile{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildConfigStaticDockerfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildConfigStaticDockerfile) ProtoMessage() {}
+
+func (x *BuildConfigStaticDockerfile) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildConfigStaticDockerfile.ProtoReflect.Descriptor instead.
+func (*BuildConfigStaticDockerfile) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BuildConfigStaticDockerfile) GetStaticConfig() *StaticConfig {
+	if x != nil {
+		return x.StaticConfig
+	}
+	return nil
+}
+
+func (x *BuildConfigStaticDockerfile) GetDockerfileName() string {
+	if x != nil {
+		return x.DockerfileName
+	}
+	return ""
+}
+
+func (x *BuildConfigStaticDockerfile) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+type ApplicationConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to BuildConfig:
+	//
+	//	*ApplicationConfig_RuntimeBuildpack
+	//	*ApplicationConfig_RuntimeCmd
+	//	*ApplicationConfig_RuntimeDockerfile
+	//	*ApplicationConfig_StaticBuildpack
+	//	*ApplicationConfig_StaticCmd
+	//	*ApplicationConfig_StaticDockerfile
+	BuildConfig   isApplicationConfig_BuildConfig `protobuf_oneof:"build_config"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationConfig) Reset() {
+	*x = ApplicationConfig{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationConfig) ProtoMessage() {}
+
+func (x *ApplicationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationConfig.ProtoReflect.Descriptor instead.
+func (*ApplicationConfig) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApplicationConfig) GetBuildConfig() isApplicationConfig_BuildConfig {
+	if x != nil {
+		return x.BuildConfig
+	}
+	return nil
+}
+
+func (x *ApplicationConfig) GetRuntimeBuildpack() *BuildConfigRuntimeBuildpack {
+	if x != nil {
+		if x, ok := x.BuildConfig.(*ApplicationConfig_RuntimeBuildpack); ok {
+			return x.RuntimeBuildpack
+		}
+	}
+	return nil
+}
+
+func (x *ApplicationConfig) GetRuntimeCmd() *BuildConfigRuntimeCmd {
+	if x != nil {
+		if x, ok := x.BuildConfig.(*ApplicationConfig_RuntimeCmd); ok {
+			return x.RuntimeCmd
+		}
+	}
+	return nil
+}
+
+func (x *ApplicationConfig) GetRuntimeDockerfile() *BuildConfigRuntimeDockerfile {
+	if x != nil {
+		if x, ok := x.BuildConfig.(*ApplicationConfig_RuntimeDockerfile); ok {
+			return x.RuntimeDockerfile
+		}
+	}
+	return nil
+}
+
+func (x *ApplicationConfig) GetStaticBuildpack() *BuildConfigStaticBuildpack {
+	if x != nil {
+		if x, ok := x.BuildConfig.(*ApplicationConfig_StaticBuildpack); ok {
+			return x.StaticBuildpack
+		}
+	}
+	return nil
+}
+
+func (x *ApplicationConfig) GetStaticCmd() *BuildConfigStaticCmd {
+	if x != nil {
+		if x, ok := x.BuildConfig.(*ApplicationConfig_StaticCmd); ok {
+			return x.StaticCmd
+		}
+	}
+	return nil
+}
+
+func (x *ApplicationConfig) GetStaticDockerfile() *BuildConfigStaticDockerfile {
+	if x != nil {
+		if x, ok := x.BuildConfig.(*ApplicationConfig_StaticDockerfile); ok {
+			return x.StaticDockerfile
+		}
+	}
+	return nil
+}
+
+type isApplicationConfig_BuildConfig interface {
+	isApplicationConfig_BuildConfig()
+}
+
+type ApplicationConfig_RuntimeBuildpack struct {
+	RuntimeBuildpack *BuildConfigRuntimeBuildpack `protobuf:"bytes,1,opt,name=runtime_buildpack,json=runtimeBuildpack,proto3,oneof"`
+}
+
+type ApplicationConfig_RuntimeCmd struct {
+	RuntimeCmd *BuildConfigRuntimeCmd `protobuf:"bytes,2,opt,name=runtime_cmd,json=runtimeCmd,proto3,oneof"`
+}
+
+type ApplicationConfig_RuntimeDockerfile struct {
+	RuntimeDockerfile *BuildConfigRuntimeDockerfile `protobuf:"bytes,3,opt,name=runtime_dockerfile,json=runtimeDockerfile,proto3,oneof"`
+}
+
+type ApplicationConfig_StaticBuildpack struct {
+	StaticBuildpack *BuildConfigStaticBuildpack `protobuf:"bytes,4,opt,name=static_buildpack,json=staticBuildpack,proto3,oneof"`
+}
+
+type ApplicationConfig_StaticCmd struct {
+	StaticCmd *BuildConfigStaticCmd `protobuf:"bytes,5,opt,name=static_cmd,json=staticCmd,proto3,oneof"`
+}
+
+type ApplicationConfig_StaticDockerfile struct {
+	StaticDockerfile *BuildConfigStaticDockerfile `protobuf:"bytes,6,opt,name=static_dockerfile,json=staticDockerfile,proto3,oneof"`
+}
+
+func (*ApplicationConfig_RuntimeBuildpack) isApplicationConfig_BuildConfig() {}
+
+func (*ApplicationConfig_RuntimeCmd) isApplicationConfig_BuildConfig() {}
+
+func (*ApplicationConfig_RuntimeDockerfile) isApplicationConfig_BuildConfig() {}
+
+func (*ApplicationConfig_StaticBuildpack) isApplicationConfig_BuildConfig() {}
+
+func (*ApplicationConfig_StaticCmd) isApplicationConfig_BuildConfig() {}
+
+func (*ApplicationConfig_StaticDockerfile) isApplicationConfig_BuildConfig() {}
+
+type Website struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fqdn           string                 `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	PathPrefix     string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	StripPrefix    bool                   `protobuf:"varint,4,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	Https          bool                   `protobuf:"varint,5,opt,name=https,proto3" json:"https,omitempty"`
+	H2C            bool                   `protobuf:"varint,6,opt,name=h2c,proto3" json:"h2c,omitempty"`
+	HttpPort       int32                  `protobuf:"varint,7,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	Authentication AuthenticationType     `protobuf:"varint,8,opt,name=authentication,proto3,enum=neoshowcase.protobuf.AuthenticationType" json:"authentication,omitempty"`
+	Rules          []*WebsiteRule         `protobuf:"bytes,9,rep,name=rules,proto3" json:"rules,omitempty"`
+	HeaderPolicy   *WebsiteHeaderPolicy   `protobuf:"bytes,10,opt,name=header_policy,json=headerPolicy,proto3" json:"header_policy,omitempty"`
+	AccessControl  *WebsiteAccessControl  `protobuf:"bytes,11,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+	RateLimit      *WebsiteRateLimit      `protobuf:"bytes,12,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// backends 重み付けしてトラフィックを分配するアプリ (このアプリ自身を含む) 空の場合はこのアプリが全てのトラフィックを受けます
+	Backends      []*WebsiteBackend `protobuf:"bytes,13,rep,name=backends,proto3" json:"backends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Website) Reset() {
+	*x = Website{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Website) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Website) ProtoMessage() {}
+
+func (x *Website) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Website.ProtoReflect.Descriptor instead.
+func (*Website) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Website) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Website) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *Website) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *Website) GetStripPrefix() bool {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return false
+}
+
+func (x *Website) GetHttps() bool {
+	if x != nil {
+		return x.Https
+	}
+	return false
+}
+
+func (x *Website) GetH2C() bool {
+	if x != nil {
+		return x.H2C
+	}
+	return false
+}
+
+func (x *Website) GetHttpPort() int32 {
+	if x != nil {
+		return x.HttpPort
+	}
+	return 0
+}
+
+func (x *Website) GetAuthentication() AuthenticationType {
+	if x != nil {
+		return x.Authentication
+	}
+	return AuthenticationType_OFF
+}
+
+func (x *Website) GetRules() []*WebsiteRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Website) GetHeaderPolicy() *WebsiteHeaderPolicy {
+	if x != nil {
+		return x.HeaderPolicy
+	}
+	return nil
+}
+
+func (x *Website) GetAccessControl() *WebsiteAccessControl {
+	if x != nil {
+		return x.AccessControl
+	}
+	return nil
+}
+
+func (x *Website) GetRateLimit() *WebsiteRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Website) GetBackends() []*WebsiteBackend {
+	if x != nil {
+		return x.Backends
+	}
+	return nil
+}
+
+// WebsiteBackend トラフィックの一部を受けるアプリ
+type WebsiteBackend struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// application_id 同じオーナーのランタイムアプリ 分配元のWebサイトと同じポート・プロトコルのWebサイトを持つ必要があります
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// weight 他のbackendに対する相対的なトラフィックの割合 0の場合はトラフィックを送りません
+	Weight        int32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteBackend) Reset() {
+	*x = WebsiteBackend{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteBackend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteBackend) ProtoMessage() {}
+
+func (x *WebsiteBackend) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteBackend.ProtoReflect.Descriptor instead.
+func (*WebsiteBackend) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WebsiteBackend) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *WebsiteBackend) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// WebsiteRule Webサイトのリダイレクト・書き換えルール 宣言順に評価されます
+type WebsiteRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  WebsiteRule_Type       `protobuf:"varint,1,opt,name=type,proto3,enum=neoshowcase.protobuf.WebsiteRule_Type" json:"type,omitempty"`
+	// path_regex リクエストパス全体にマッチする正規表現 (RE2)
+	PathRegex string `protobuf:"bytes,2,opt,name=path_regex,json=pathRegex,proto3" json:"path_regex,omitempty"`
+	// target リダイレクト先のパスまたはURL、または書き換え後のパス ${1} でキャプチャグループを参照できます
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// status_code (REDIRECT only) 301, 302, 307, 308 のいずれか
+	StatusCode int32 `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// preserve_query (REDIRECT only) クエリ文字列をリダイレクト先に引き継ぎます
+	PreserveQuery bool `protobuf:"varint,5,opt,name=preserve_query,json=preserveQuery,proto3" json:"preserve_query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteRule) Reset() {
+	*x = WebsiteRule{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteRule) ProtoMessage() {}
+
+func (x *WebsiteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteRule.ProtoReflect.Descriptor instead.
+func (*WebsiteRule) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WebsiteRule) GetType() WebsiteRule_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WebsiteRule_REDIRECT
+}
+
+func (x *WebsiteRule) GetPathRegex() string {
+	if x != nil {
+		return x.PathRegex
+	}
+	return ""
+}
+
+func (x *WebsiteRule) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *WebsiteRule) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebsiteRule) GetPreserveQuery() bool {
+	if x != nil {
+		return x.PreserveQuery
+	}
+	return false
+}
+
+// WebsiteHeaderPolicy Webサイトのカスタムレスポンスヘッダーとオリジン間リソース共有 (CORS) の設定
+type WebsiteHeaderPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response_headers 全てのレスポンスに付与するヘッダー hop-by-hopヘッダーや内部で使用するヘッダーは設定できません
+	ResponseHeaders []*WebsiteHeaderPolicy_Header   `protobuf:"bytes,1,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty"`
+	Cors            *WebsiteHeaderPolicy_CORSPolicy `protobuf:"bytes,2,opt,name=cors,proto3" json:"cors,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebsiteHeaderPolicy) Reset() {
+	*x = WebsiteHeaderPolicy{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteHeaderPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteHeaderPolicy) ProtoMessage() {}
+
+func (x *WebsiteHeaderPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteHeaderPolicy.ProtoReflect.Descriptor instead.
+func (*WebsiteHeaderPolicy) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WebsiteHeaderPolicy) GetResponseHeaders() []*WebsiteHeaderPolicy_Header {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *WebsiteHeaderPolicy) GetCors() *WebsiteHeaderPolicy_CORSPolicy {
+	if x != nil {
+		return x.Cors
+	}
+	return nil
+}
+
+// WebsiteAccessControl Webサイトへのアクセス制限 authenticationとは別に、IPアドレス制限、Basic認証の順に適用されます
+type WebsiteAccessControl struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ip_allow_list アクセスを許可するIPアドレスまたはアドレス範囲 (CIDR) 空の場合は制限しません
+	IpAllowList []string `protobuf:"bytes,1,rep,name=ip_allow_list,json=ipAllowList,proto3" json:"ip_allow_list,omitempty"`
+	// basic_auth_users 空でない場合、いずれかのユーザーでのBasic認証を要求します
+	BasicAuthUsers []*WebsiteAccessControl_BasicAuthUser `protobuf:"bytes,2,rep,name=basic_auth_users,json=basicAuthUsers,proto3" json:"basic_auth_users,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebsiteAccessControl) Reset() {
+	*x = WebsiteAccessControl{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteAccessControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteAccessControl) ProtoMessage() {}
+
+func (x *WebsiteAccessControl) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteAccessControl.ProtoReflect.Descriptor instead.
+func (*WebsiteAccessControl) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WebsiteAccessControl) GetIpAllowList() []string {
+	if x != nil {
+		return x.IpAllowList
+	}
+	return nil
+}
+
+func (x *WebsiteAccessControl) GetBasicAuthUsers() []*WebsiteAccessControl_BasicAuthUser {
+	if x != nil {
+		return x.BasicAuthUsers
+	}
+	return nil
+}
+
+// WebsiteRateLimit Webサイトへのリクエストのレート制限 クライアントごとにトークンバケットで制限します
+type WebsiteRateLimit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// average 1秒あたりの平均リクエスト数 0の場合は管理者の設定したデフォルト値を使用します
+	Average int32 `protobuf:"varint,2,opt,name=average,proto3" json:"average,omitempty"`
+	// burst 同時に許可する最大リクエスト数 0の場合は管理者の設定したデフォルト値を使用します
+	Burst int32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	// source_header クライアントを区別するリクエストヘッダー名 空の場合はIPアドレスで区別します
+	SourceHeader  string `protobuf:"bytes,4,opt,name=source_header,json=sourceHeader,proto3" json:"source_header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteRateLimit) Reset() {
+	*x = WebsiteRateLimit{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteRateLimit) ProtoMessage() {}
+
+func (x *WebsiteRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteRateLimit.ProtoReflect.Descriptor instead.
+func (*WebsiteRateLimit) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WebsiteRateLimit) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebsiteRateLimit) GetAverage() int32 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *WebsiteRateLimit) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *WebsiteRateLimit) GetSourceHeader() string {
+	if x != nil {
+		return x.SourceHeader
+	}
+	return ""
+}
+
+type PortPublication struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	InternetPort    int32                   `protobuf:"varint,1,opt,name=internet_port,json=internetPort,proto3" json:"internet_port,omitempty"`
+	ApplicationPort int32                   `protobuf:"varint,2,opt,name=application_port,json=applicationPort,proto3" json:"application_port,omitempty"`
+	Protocol        PortPublicationProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=neoshowcase.protobuf.PortPublicationProtocol" json:"protocol,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PortPublication) Reset() {
+	*x = PortPublication{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortPublication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortPublication) ProtoMessage() {}
+
+func (x *PortPublication) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortPublication.ProtoReflect.Descriptor instead.
+func (*PortPublication) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PortPublication) GetInternetPort() int32 {
+	if x != nil {
+		return x.InternetPort
+	}
+	return 0
+}
+
+func (x *PortPublication) GetApplicationPort() int32 {
+	if x != nil {
+		return x.ApplicationPort
+	}
+	return 0
+}
+
+func (x *PortPublication) GetProtocol() PortPublicationProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return PortPublicationProtocol_TCP
+}
+
+// InternalService 公開ingressを経由せず他のアプリからアクセスできる内部ポート
+// 他のアプリからは "<アプリ名>.apps.internal" でアクセスできます
+type InternalService struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// port 他のアプリに公開するアプリのポート 0の場合は無効
+	Port int32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// allowed_app_ids 同じオーナーのアプリに加えてアクセスを許可するアプリのID
+	AllowedAppIds []string `protobuf:"bytes,2,rep,name=allowed_app_ids,json=allowedAppIds,proto3" json:"allowed_app_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalService) Reset() {
+	*x = InternalService{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalService) ProtoMessage() {}
+
+func (x *InternalService) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalService.ProtoReflect.Descriptor instead.
+func (*InternalService) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InternalService) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *InternalService) GetAllowedAppIds() []string {
+	if x != nil {
+		return x.AllowedAppIds
+	}
+	return nil
+}
+
+type Application struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Id                string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RepositoryId      string                     `protobuf:"bytes,3,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	RefName           string                     `protobuf:"bytes,4,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	Commit            string                     `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty"`
+	DeployType        DeployType                 `protobuf:"varint,6,opt,name=deploy_type,json=deployType,proto3,enum=neoshowcase.protobuf.DeployType" json:"deploy_type,omitempty"`
+	Running           bool                       `protobuf:"varint,7,opt,name=running,proto3" json:"running,omitempty"`
+	Container         Application_ContainerState `protobuf:"varint,8,opt,name=container,proto3,enum=neoshowcase.protobuf.Application_ContainerState" json:"container,omitempty"`
+	ContainerMessage  string                     `protobuf:"bytes,9,opt,name=container_message,json=containerMessage,proto3" json:"container_message,omitempty"`
+	CurrentBuild      string                     `protobuf:"bytes,10,opt,name=current_build,json=currentBuild,proto3" json:"current_build,omitempty"`
+	CreatedAt         *timestamppb.Timestamp     `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp     `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Config            *ApplicationConfig         `protobuf:"bytes,13,opt,name=config,proto3" json:"config,omitempty"`
+	Websites          []*Website                 `protobuf:"bytes,14,rep,name=websites,proto3" json:"websites,omitempty"`
+	PortPublications  []*PortPublication         `protobuf:"bytes,15,rep,name=port_publications,json=portPublications,proto3" json:"port_publications,omitempty"`
+	OwnerIds          []string                   `protobuf:"bytes,16,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	LatestBuildStatus *BuildStatus               `protobuf:"varint,17,opt,name=latest_build_status,json=latestBuildStatus,proto3,enum=neoshowcase.protobuf.BuildStatus,oneof" json:"latest_build_status,omitempty"`
+	ConfigFilePath    string                     `protobuf:"bytes,18,opt,name=config_file_path,json=configFilePath,proto3" json:"config_file_path,omitempty"`
+	ConfigFileError   string                     `protobuf:"bytes,19,opt,name=config_file_error,json=configFileError,proto3" json:"config_file_error,omitempty"`
+	InternalService   *InternalService           `protobuf:"bytes,20,opt,name=internal_service,json=internalService,proto3" json:"internal_service,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Application) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Application) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Application) GetRepositoryId() string {
+	if x != nil {
+		return x.RepositoryId
+	}
+	return ""
+}
+
+func (x *Application) GetRefName() string {
+	if x != nil {
+		return x.RefName
+	}
+	return ""
+}
+
+func (x *Application) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Application) GetDeployType() DeployType {
+	if x != nil {
+		return x.DeployType
+	}
+	return DeployType_RUNTIME
+}
+
+func (x *Application) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *Application) GetContainer() Application_ContainerState {
+	if x != nil {
+		return x.Container
+	}
+	return Application_MISSING
+}
+
+func (x *Application) GetContainerMessage() string {
+	if x != nil {
+		return x.ContainerMessage
+	}
+	return ""
+}
+
+func (x *Application) GetCurrentBuild() string {
+	if x != nil {
+		return x.CurrentBuild
+	}
+	return ""
+}
+
+func (x *Application) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Application) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Application) GetConfig() *ApplicationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Application) GetWebsites() []*Website {
+	if x != nil {
+		return x.Websites
+	}
+	return nil
+}
+
+func (x *Application) GetPortPublications() []*PortPublication {
+	if x != nil {
+		return x.PortPublications
+	}
+	return nil
+}
+
+func (x *Application) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *Application) GetLatestBuildStatus() BuildStatus {
+	if x != nil && x.LatestBuildStatus != nil {
+		return *x.LatestBuildStatus
+	}
+	return BuildStatus_QUEUED
+}
+
+func (x *Application) GetConfigFilePath() string {
+	if x != nil {
+		return x.ConfigFilePath
+	}
+	return ""
+}
+
+func (x *Application) GetConfigFileError() string {
+	if x != nil {
+		return x.ConfigFileError
+	}
+	return ""
+}
+
+func (x *Application) GetInternalService() *InternalService {
+	if x != nil {
+		return x.InternalService
+	}
+	return nil
+}
+
+type ApplicationEnvVar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	System        bool                   `protobuf:"varint,4,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationEnvVar) Reset() {
+	*x = ApplicationEnvVar{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationEnvVar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEnvVar) ProtoMessage() {}
+
+func (x *ApplicationEnvVar) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEnvVar.ProtoReflect.Descriptor instead.
+func (*ApplicationEnvVar) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ApplicationEnvVar) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationEnvVar) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ApplicationEnvVar) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ApplicationEnvVar) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
+type ApplicationEnvVars struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     []*ApplicationEnvVar   `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationEnvVars) Reset() {
+	*x = ApplicationEnvVars{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationEnvVars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEnvVars) ProtoMessage() {}
+
+func (x *ApplicationEnvVars) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEnvVars.ProtoReflect.Descriptor instead.
+func (*ApplicationEnvVars) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApplicationEnvVars) GetVariables() []*ApplicationEnvVar {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type Artifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BuildId       string                 `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt     *NullTimestamp         `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Artifact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Artifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artifact) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *Artifact) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Artifact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Artifact) GetDeletedAt() *NullTimestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ArtifactContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArtifactContent) Reset() {
+	*x = ArtifactContent{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtifactContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactContent) ProtoMessage() {}
+
+func (x *ArtifactContent) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactContent.ProtoReflect.Descriptor instead.
+func (*ArtifactContent) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ArtifactContent) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ArtifactContent) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type RuntimeImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuildId       string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuntimeImage) Reset() {
+	*x = RuntimeImage{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuntimeImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeImage) ProtoMessage() {}
+
+func (x *RuntimeImage) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeImage.ProtoReflect.Descriptor instead.
+func (*RuntimeImage) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RuntimeImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuntimeImage) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *RuntimeImage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *RuntimeImage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AvailableMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MetricsNames  []string               `protobuf:"bytes,1,rep,name=metrics_names,json=metricsNames,proto3" json:"metrics_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailableMetrics) Reset() {
+	*x = AvailableMetrics{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableMetrics) ProtoMessage() {}
+
+func (x *AvailableMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableMetrics.ProtoReflect.Descriptor instead.
+func (*AvailableMetrics) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AvailableMetrics) GetMetricsNames() []string {
+	if x != nil {
+		return x.MetricsNames
+	}
+	return nil
+}
+
+type ApplicationMetric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationMetric) Reset() {
+	*x = ApplicationMetric{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationMetric) ProtoMessage() {}
+
+func (x *ApplicationMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationMetric.ProtoReflect.Descriptor instead.
+func (*ApplicationMetric) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApplicationMetric) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ApplicationMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ApplicationMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*ApplicationMetric   `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationMetrics) Reset() {
+	*x = ApplicationMetrics{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationMetrics) ProtoMessage() {}
+
+func (x *ApplicationMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationMetrics.ProtoReflect.Descriptor instead.
+func (*ApplicationMetrics) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ApplicationMetrics) GetMetrics() []*ApplicationMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type ApplicationOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Log           string                 `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationOutput) Reset() {
+	*x = ApplicationOutput{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationOutput) ProtoMessage() {}
+
+func (x *ApplicationOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationOutput.ProtoReflect.Descriptor instead.
+func (*ApplicationOutput) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ApplicationOutput) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ApplicationOutput) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+type ApplicationOutputs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outputs       []*ApplicationOutput   `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationOutputs) Reset() {
+	*x = ApplicationOutputs{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationOutputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationOutputs) ProtoMessage() {}
+
+func (x *ApplicationOutputs) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationOutputs.ProtoReflect.Descriptor instead.
+func (*ApplicationOutputs) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApplicationOutputs) GetOutputs() []*ApplicationOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type ApplicationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Type          ApplicationEvent_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=neoshowcase.protobuf.ApplicationEvent_Type" json:"type,omitempty"`
+	// reference イベントに関連するエンティティのID (ビルドIDなど)
+	Reference string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Message   string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// user_id イベントを発生させたユーザーのID システムによるイベントの場合は空です
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationEvent) Reset() {
+	*x = ApplicationEvent{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEvent) ProtoMessage() {}
+
+func (x *ApplicationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEvent.ProtoReflect.Descriptor instead.
+func (*ApplicationEvent) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ApplicationEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplicationEvent) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationEvent) GetType() ApplicationEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ApplicationEvent_CONTAINER_STATE
+}
+
+func (x *ApplicationEvent) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ApplicationEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApplicationEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ApplicationEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ApplicationEvents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ApplicationEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationEvents) Reset() {
+	*x = ApplicationEvents{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEvents) ProtoMessage() {}
+
+func (x *ApplicationEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEvents.ProtoReflect.Descriptor instead.
+func (*ApplicationEvents) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ApplicationEvents) GetEvents() []*ApplicationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type WebsiteProbe struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	Up        bool                   `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`
+	// status_code 応答がなかった場合は0です
+	StatusCode int32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	LatencyMs  int64 `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// tls_expires_at HTTPSの場合のみ有効です
+	TlsExpiresAt  *NullTimestamp `protobuf:"bytes,5,opt,name=tls_expires_at,json=tlsExpiresAt,proto3" json:"tls_expires_at,omitempty"`
+	Error         string         `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteProbe) Reset() {
+	*x = WebsiteProbe{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteProbe) ProtoMessage() {}
+
+func (x *WebsiteProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteProbe.ProtoReflect.Descriptor instead.
+func (*WebsiteProbe) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WebsiteProbe) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *WebsiteProbe) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *WebsiteProbe) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebsiteProbe) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *WebsiteProbe) GetTlsExpiresAt() *NullTimestamp {
+	if x != nil {
+		return x.TlsExpiresAt
+	}
+	return nil
+}
+
+func (x *WebsiteProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WebsiteUptime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowSeconds int64                  `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Up            int32                  `protobuf:"varint,3,opt,name=up,proto3" json:"up,omitempty"`
+	// ratio 確認が一度もない場合は設定されません
+	Ratio         *float64 `protobuf:"fixed64,4,opt,name=ratio,proto3,oneof" json:"ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteUptime) Reset() {
+	*x = WebsiteUptime{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteUptime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteUptime) ProtoMessage() {}
+
+func (x *WebsiteUptime) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteUptime.ProtoReflect.Descriptor instead.
+func (*WebsiteUptime) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WebsiteUptime) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *WebsiteUptime) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WebsiteUptime) GetUp() int32 {
+	if x != nil {
+		return x.Up
+	}
+	return 0
+}
+
+func (x *WebsiteUptime) GetRatio() float64 {
+	if x != nil && x.Ratio != nil {
+		return *x.Ratio
+	}
+	return 0
+}
+
+type WebsiteStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Website       *Website               `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	// latest まだ一度も確認されていない場合は設定されません
+	Latest        *WebsiteProbe    `protobuf:"bytes,3,opt,name=latest,proto3,oneof" json:"latest,omitempty"`
+	Uptimes       []*WebsiteUptime `protobuf:"bytes,4,rep,name=uptimes,proto3" json:"uptimes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteStatus) Reset() {
+	*x = WebsiteStatus{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteStatus) ProtoMessage() {}
+
+func (x *WebsiteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteStatus.ProtoReflect.Descriptor instead.
+func (*WebsiteStatus) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WebsiteStatus) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *WebsiteStatus) GetWebsite() *Website {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+func (x *WebsiteStatus) GetLatest() *WebsiteProbe {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *WebsiteStatus) GetUptimes() []*WebsiteUptime {
+	if x != nil {
+		return x.Uptimes
+	}
+	return nil
+}
+
+type WebsiteStatuses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*WebsiteStatus       `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteStatuses) Reset() {
+	*x = WebsiteStatuses{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteStatuses) ProtoMessage() {}
+
+func (x *WebsiteStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteStatuses.ProtoReflect.Descriptor instead.
+func (*WebsiteStatuses) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebsiteStatuses) GetStatuses() []*WebsiteStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          AlertRule_Kind         `protobuf:"varint,4,opt,name=kind,proto3,enum=neoshowcase.protobuf.AlertRule_Kind" json:"kind,omitempty"`
+	// metric kindがMETRICの場合のメトリクス名 GetAvailableMetricsで取得できるものです
+	Metric          string                  `protobuf:"bytes,5,opt,name=metric,proto3" json:"metric,omitempty"`
+	Comparison      AlertRule_Comparison    `protobuf:"varint,6,opt,name=comparison,proto3,enum=neoshowcase.protobuf.AlertRule_Comparison" json:"comparison,omitempty"`
+	Threshold       float64                 `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	DurationSeconds int64                   `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ThresholdUnit   AlertRule_ThresholdUnit `protobuf:"varint,10,opt,name=threshold_unit,json=thresholdUnit,proto3,enum=neoshowcase.protobuf.AlertRule_ThresholdUnit" json:"threshold_unit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AlertRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertRule) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetKind() AlertRule_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return AlertRule_METRIC
+}
+
+func (x *AlertRule) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *AlertRule) GetComparison() AlertRule_Comparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return AlertRule_ABOVE
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertRule) GetThresholdUnit() AlertRule_ThresholdUnit {
+	if x != nil {
+		return x.ThresholdUnit
+	}
+	return AlertRule_VALUE
+}
+
+type AlertRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRules) Reset() {
+	*x = AlertRules{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRules) ProtoMessage() {}
+
+func (x *AlertRules) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRules.ProtoReflect.Descriptor instead.
+func (*AlertRules) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AlertRules) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	FiredAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	// resolved_at 発火中の場合は無効です
+	ResolvedAt    *NullTimestamp `protobuf:"bytes,6,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *Alert) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiredAt
+	}
+	return nil
+}
+
+func (x *Alert) GetResolvedAt() *NullTimestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type Alerts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alerts) Reset() {
+	*x = Alerts{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alerts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerts) ProtoMessage() {}
+
+func (x *Alerts) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerts.ProtoReflect.Descriptor instead.
+func (*Alerts) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Alerts) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type NotificationSubscription struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Id            string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                        `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Sink          NotificationSubscription_Sink `protobuf:"varint,3,opt,name=sink,proto3,enum=neoshowcase.protobuf.NotificationSubscription_Sink" json:"sink,omitempty"`
+	Target        string                        `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// has_secret 署名用のシークレットが設定されているか シークレット自体は返しません
+	HasSecret     bool                             `protobuf:"varint,5,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"`
+	Events        []NotificationSubscription_Event `protobuf:"varint,6,rep,packed,name=events,proto3,enum=neoshowcase.protobuf.NotificationSubscription_Event" json:"events,omitempty"`
+	CreatedAt     *timestamppb.Timestamp           `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSubscription) Reset() {
+	*x = NotificationSubscription{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSubscription) ProtoMessage() {}
+
+func (x *NotificationSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSubscription.ProtoReflect.Descriptor instead.
+func (*NotificationSubscription) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NotificationSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetSink() NotificationSubscription_Sink {
+	if x != nil {
+		return x.Sink
+	}
+	return NotificationSubscription_WEBHOOK
+}
+
+func (x *NotificationSubscription) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+func (x *NotificationSubscription) GetEvents() []NotificationSubscription_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *NotificationSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type NotificationSubscriptions struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Subscriptions []*NotificationSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSubscriptions) Reset() {
+	*x = NotificationSubscriptions{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSubscriptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSubscriptions) ProtoMessage() {}
+
+func (x *NotificationSubscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSubscriptions.ProtoReflect.Descriptor instead.
+func (*NotificationSubscriptions) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NotificationSubscriptions) GetSubscriptions() []*NotificationSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type Build struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Commit        string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Status        BuildStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=neoshowcase.protobuf.BuildStatus" json:"status,omitempty"`
+	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	StartedAt     *NullTimestamp         `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt     *NullTimestamp         `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt    *NullTimestamp         `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Retriable     bool                   `protobuf:"varint,9,opt,name=retriable,proto3" json:"retriable,omitempty"`
+	Artifacts     []*Artifact            `protobuf:"bytes,10,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	RuntimeImage  *RuntimeImage          `protobuf:"bytes,11,opt,name=runtime_image,json=runtimeImage,proto3,oneof" json:"runtime_image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Build) Reset() {
+	*x = Build{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Build) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Build) ProtoMessage() {}
+
+func (x *Build) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Build.ProtoReflect.Descriptor instead.
+func (*Build) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Build) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Build) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Build) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Build) GetStatus() BuildStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BuildStatus_QUEUED
+}
+
+func (x *Build) GetQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return nil
+}
+
+func (x *Build) GetStartedAt() *NullTimestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Build) GetUpdatedAt() *NullTimestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Build) GetFinishedAt() *NullTimestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Build) GetRetriable() bool {
+	if x != nil {
+		return x.Retriable
+	}
+	return false
+}
+
+func (x *Build) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *Build) GetRuntimeImage() *RuntimeImage {
+	if x != nil {
+		return x.RuntimeImage
+	}
+	return nil
+}
+
+type BuildLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Log           []byte                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildLog) Reset() {
+	*x = BuildLog{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLog) ProtoMessage() {}
+
+func (x *BuildLog) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLog.ProtoReflect.Descriptor instead.
+func (*BuildLog) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BuildLog) GetLog() []byte {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+type GitRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefName       string                 `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRef) Reset() {
+	*x = GitRef{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRef) ProtoMessage() {}
+
+func (x *GitRef) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRef.ProtoReflect.Descriptor instead.
+func (*GitRef) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GitRef) GetRefName() string {
+	if x != nil {
+		return x.RefName
+	}
+	return ""
+}
+
+func (x *GitRef) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+type GenerateKeyPairResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateKeyPairResponse) Reset() {
+	*x = GenerateKeyPairResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateKeyPairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateKeyPairResponse) ProtoMessage() {}
+
+func (x *GenerateKeyPairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateKeyPairResponse.ProtoReflect.Descriptor instead.
+func (*GenerateKeyPairResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GenerateKeyPairResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *GenerateKeyPairResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type GetUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersResponse) Reset() {
+	*x = GetUsersResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersResponse) ProtoMessage() {}
+
+func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUserKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*UserKey             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserKeysResponse) Reset() {
+	*x = GetUserKeysResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserKeysResponse) ProtoMessage() {}
+
+func (x *GetUserKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetUserKeysResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetUserKeysResponse) GetKeys() []*UserKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type CreateUserKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserKeyRequest) Reset() {
+	*x = CreateUserKeyRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Website) String() string {
+func (x *CreateUserKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Website) ProtoMessage() {}
+func (*CreateUserKeyRequest) ProtoMessage() {}
 
-func (x *Website) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[19]
+func (x *CreateUserKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1820,91 +5328,92 @@ func (x *Website) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Website.ProtoReflect.Descriptor instead.
-func (*Website) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CreateUserKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserKeyRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *Website) GetId() string {
+func (x *CreateUserKeyRequest) GetPublicKey() string {
 	if x != nil {
-		return x.Id
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *Website) GetFqdn() string {
+func (x *CreateUserKeyRequest) GetName() string {
 	if x != nil {
-		return x.Fqdn
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Website) GetPathPrefix() string {
-	if x != nil {
-		return x.PathPrefix
-	}
-	return ""
+type DeleteUserKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Website) GetStripPrefix() bool {
-	if x != nil {
-		return x.StripPrefix
-	}
-	return false
+func (x *DeleteUserKeyRequest) Reset() {
+	*x = DeleteUserKeyRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Website) GetHttps() bool {
-	if x != nil {
-		return x.Https
-	}
-	return false
+func (x *DeleteUserKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Website) GetH2C() bool {
+func (*DeleteUserKeyRequest) ProtoMessage() {}
+
+func (x *DeleteUserKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[63]
 	if x != nil {
-		return x.H2C
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Website) GetHttpPort() int32 {
-	if x != nil {
-		return x.HttpPort
-	}
-	return 0
+// Deprecated: Use DeleteUserKeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserKeyRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Website) GetAuthentication() AuthenticationType {
+func (x *DeleteUserKeyRequest) GetKeyId() string {
 	if x != nil {
-		return x.Authentication
+		return x.KeyId
 	}
-	return AuthenticationType_OFF
+	return ""
 }
 
-type PortPublication struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	InternetPort    int32                   `protobuf:"varint,1,opt,name=internet_port,json=internetPort,proto3" json:"internet_port,omitempty"`
-	ApplicationPort int32                   `protobuf:"varint,2,opt,name=application_port,json=applicationPort,proto3" json:"application_port,omitempty"`
-	Protocol        PortPublicationProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=neoshowcase.protobuf.PortPublicationProtocol" json:"protocol,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CreateCustomDomainRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Domain        string                          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Method        CustomDomain_VerificationMethod `protobuf:"varint,2,opt,name=method,proto3,enum=neoshowcase.protobuf.CustomDomain_VerificationMethod" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortPublication) Reset() {
-	*x = PortPublication{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[20]
+func (x *CreateCustomDomainRequest) Reset() {
+	*x = CreateCustomDomainRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortPublication) String() string {
+func (x *CreateCustomDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortPublication) ProtoMessage() {}
+func (*CreateCustomDomainRequest) ProtoMessage() {}
 
-func (x *PortPublication) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[20]
+func (x *CreateCustomDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1915,70 +5424,47 @@ func (x *PortPublication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortPublication.ProtoReflect.Descriptor instead.
-func (*PortPublication) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *PortPublication) GetInternetPort() int32 {
-	if x != nil {
-		return x.InternetPort
-	}
-	return 0
+// Deprecated: Use CreateCustomDomainRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomDomainRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PortPublication) GetApplicationPort() int32 {
+func (x *CreateCustomDomainRequest) GetDomain() string {
 	if x != nil {
-		return x.ApplicationPort
+		return x.Domain
 	}
-	return 0
+	return ""
 }
 
-func (x *PortPublication) GetProtocol() PortPublicationProtocol {
+func (x *CreateCustomDomainRequest) GetMethod() CustomDomain_VerificationMethod {
 	if x != nil {
-		return x.Protocol
+		return x.Method
 	}
-	return PortPublicationProtocol_TCP
+	return CustomDomain_DNS
 }
 
-type Application struct {
-	state             protoimpl.MessageState     `protogen:"open.v1"`
-	Id                string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	RepositoryId      string                     `protobuf:"bytes,3,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
-	RefName           string                     `protobuf:"bytes,4,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
-	Commit            string                     `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty"`
-	DeployType        DeployType                 `protobuf:"varint,6,opt,name=deploy_type,json=deployType,proto3,enum=neoshowcase.protobuf.DeployType" json:"deploy_type,omitempty"`
-	Running           bool                       `protobuf:"varint,7,opt,name=running,proto3" json:"running,omitempty"`
-	Container         Application_ContainerState `protobuf:"varint,8,opt,name=container,proto3,enum=neoshowcase.protobuf.Application_ContainerState" json:"container,omitempty"`
-	ContainerMessage  string                     `protobuf:"bytes,9,opt,name=container_message,json=containerMessage,proto3" json:"container_message,omitempty"`
-	CurrentBuild      string                     `protobuf:"bytes,10,opt,name=current_build,json=currentBuild,proto3" json:"current_build,omitempty"`
-	CreatedAt         *timestamppb.Timestamp     `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp     `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Config            *ApplicationConfig         `protobuf:"bytes,13,opt,name=config,proto3" json:"config,omitempty"`
-	Websites          []*Website                 `protobuf:"bytes,14,rep,name=websites,proto3" json:"websites,omitempty"`
-	PortPublications  []*PortPublication         `protobuf:"bytes,15,rep,name=port_publications,json=portPublications,proto3" json:"port_publications,omitempty"`
-	OwnerIds          []string                   `protobuf:"bytes,16,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
-	LatestBuildStatus *BuildStatus               `protobuf:"varint,17,opt,name=latest_build_status,json=latestBuildStatus,proto3,enum=neoshowcase.protobuf.BuildStatus,oneof" json:"latest_build_status,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CustomDomainIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      string                 `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Application) Reset() {
-	*x = Application{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[21]
+func (x *CustomDomainIdRequest) Reset() {
+	*x = CustomDomainIdRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Application) String() string {
+func (x *CustomDomainIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Application) ProtoMessage() {}
+func (*CustomDomainIdRequest) ProtoMessage() {}
 
-func (x *Application) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[21]
+func (x *CustomDomainIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1989,155 +5475,149 @@ func (x *Application) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Application.ProtoReflect.Descriptor instead.
-func (*Application) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CustomDomainIdRequest.ProtoReflect.Descriptor instead.
+func (*CustomDomainIdRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Application) GetId() string {
+func (x *CustomDomainIdRequest) GetDomainId() string {
 	if x != nil {
-		return x.Id
+		return x.DomainId
 	}
 	return ""
 }
 
-func (x *Application) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type UploadTLSCertificateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Fqdn  string                 `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// certificate PEM形式の証明書チェーン (サーバー証明書が先頭)
+	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// private_key PEM形式の秘密鍵
+	PrivateKey    string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Application) GetRepositoryId() string {
-	if x != nil {
-		return x.RepositoryId
-	}
-	return ""
+func (x *UploadTLSCertificateRequest) Reset() {
+	*x = UploadTLSCertificateRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Application) GetRefName() string {
-	if x != nil {
-		return x.RefName
-	}
-	return ""
+func (x *UploadTLSCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Application) GetCommit() string {
-	if x != nil {
-		return x.Commit
-	}
-	return ""
-}
+func (*UploadTLSCertificateRequest) ProtoMessage() {}
 
-func (x *Application) GetDeployType() DeployType {
+func (x *UploadTLSCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[66]
 	if x != nil {
-		return x.DeployType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return DeployType_RUNTIME
+	return mi.MessageOf(x)
 }
 
-func (x *Application) GetRunning() bool {
-	if x != nil {
-		return x.Running
-	}
-	return false
+// Deprecated: Use UploadTLSCertificateRequest.ProtoReflect.Descriptor instead.
+func (*UploadTLSCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Application) GetContainer() Application_ContainerState {
+func (x *UploadTLSCertificateRequest) GetFqdn() string {
 	if x != nil {
-		return x.Container
+		return x.Fqdn
 	}
-	return Application_MISSING
+	return ""
 }
 
-func (x *Application) GetContainerMessage() string {
+func (x *UploadTLSCertificateRequest) GetCertificate() string {
 	if x != nil {
-		return x.ContainerMessage
+		return x.Certificate
 	}
 	return ""
 }
 
-func (x *Application) GetCurrentBuild() string {
+func (x *UploadTLSCertificateRequest) GetPrivateKey() string {
 	if x != nil {
-		return x.CurrentBuild
+		return x.PrivateKey
 	}
 	return ""
 }
 
-func (x *Application) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type TLSCertificateIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CertificateId string                 `protobuf:"bytes,1,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Application) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *TLSCertificateIdRequest) Reset() {
+	*x = TLSCertificateIdRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Application) GetConfig() *ApplicationConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+func (x *TLSCertificateIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Application) GetWebsites() []*Website {
-	if x != nil {
-		return x.Websites
-	}
-	return nil
-}
+func (*TLSCertificateIdRequest) ProtoMessage() {}
 
-func (x *Application) GetPortPublications() []*PortPublication {
+func (x *TLSCertificateIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[67]
 	if x != nil {
-		return x.PortPublications
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Application) GetOwnerIds() []string {
-	if x != nil {
-		return x.OwnerIds
-	}
-	return nil
+// Deprecated: Use TLSCertificateIdRequest.ProtoReflect.Descriptor instead.
+func (*TLSCertificateIdRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Application) GetLatestBuildStatus() BuildStatus {
-	if x != nil && x.LatestBuildStatus != nil {
-		return *x.LatestBuildStatus
+func (x *TLSCertificateIdRequest) GetCertificateId() string {
+	if x != nil {
+		return x.CertificateId
 	}
-	return BuildStatus_QUEUED
+	return ""
 }
 
-type ApplicationEnvVar struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	System        bool                   `protobuf:"varint,4,opt,name=system,proto3" json:"system,omitempty"`
+type GetMyUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Quota *ResourceQuota         `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Usage *ResourceUsage         `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	// custom_quota quotaが管理者によってユーザー個別に設定されたものかどうか
+	CustomQuota   bool `protobuf:"varint,3,opt,name=custom_quota,json=customQuota,proto3" json:"custom_quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationEnvVar) Reset() {
-	*x = ApplicationEnvVar{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[22]
+func (x *GetMyUsageResponse) Reset() {
+	*x = GetMyUsageResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationEnvVar) String() string {
+func (x *GetMyUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationEnvVar) ProtoMessage() {}
+func (*GetMyUsageResponse) ProtoMessage() {}
 
-func (x *ApplicationEnvVar) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[22]
+func (x *GetMyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,61 +5628,56 @@ func (x *ApplicationEnvVar) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationEnvVar.ProtoReflect.Descriptor instead.
-func (*ApplicationEnvVar) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ApplicationEnvVar) GetApplicationId() string {
-	if x != nil {
-		return x.ApplicationId
-	}
-	return ""
+// Deprecated: Use GetMyUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetMyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ApplicationEnvVar) GetKey() string {
+func (x *GetMyUsageResponse) GetQuota() *ResourceQuota {
 	if x != nil {
-		return x.Key
+		return x.Quota
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationEnvVar) GetValue() string {
+func (x *GetMyUsageResponse) GetUsage() *ResourceUsage {
 	if x != nil {
-		return x.Value
+		return x.Usage
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationEnvVar) GetSystem() bool {
+func (x *GetMyUsageResponse) GetCustomQuota() bool {
 	if x != nil {
-		return x.System
+		return x.CustomQuota
 	}
 	return false
 }
 
-type ApplicationEnvVars struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Variables     []*ApplicationEnvVar   `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+type SetUserQuotaRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// quota 未指定の場合はデフォルトの上限に戻します
+	Quota         *ResourceQuota `protobuf:"bytes,2,opt,name=quota,proto3,oneof" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationEnvVars) Reset() {
-	*x = ApplicationEnvVars{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[23]
+func (x *SetUserQuotaRequest) Reset() {
+	*x = SetUserQuotaRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationEnvVars) String() string {
+func (x *SetUserQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationEnvVars) ProtoMessage() {}
+func (*SetUserQuotaRequest) ProtoMessage() {}
 
-func (x *ApplicationEnvVars) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[23]
+func (x *SetUserQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2213,45 +5688,48 @@ func (x *ApplicationEnvVars) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationEnvVars.ProtoReflect.Descriptor instead.
-func (*ApplicationEnvVars) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SetUserQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetUserQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ApplicationEnvVars) GetVariables() []*ApplicationEnvVar {
+func (x *SetUserQuotaRequest) GetUserId() string {
 	if x != nil {
-		return x.Variables
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserQuotaRequest) GetQuota() *ResourceQuota {
+	if x != nil {
+		return x.Quota
 	}
 	return nil
 }
 
-type Artifact struct {
+type CreateRepositoryAuthBasic struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BuildId       string                 `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	DeletedAt     *NullTimestamp         `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Artifact) Reset() {
-	*x = Artifact{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[24]
+func (x *CreateRepositoryAuthBasic) Reset() {
+	*x = CreateRepositoryAuthBasic{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Artifact) String() string {
+func (x *CreateRepositoryAuthBasic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Artifact) ProtoMessage() {}
+func (*CreateRepositoryAuthBasic) ProtoMessage() {}
 
-func (x *Artifact) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[24]
+func (x *CreateRepositoryAuthBasic) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,76 +5740,96 @@ func (x *Artifact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
-func (*Artifact) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateRepositoryAuthBasic.ProtoReflect.Descriptor instead.
+func (*CreateRepositoryAuthBasic) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Artifact) GetId() string {
+func (x *CreateRepositoryAuthBasic) GetUsername() string {
 	if x != nil {
-		return x.Id
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Artifact) GetName() string {
+func (x *CreateRepositoryAuthBasic) GetPassword() string {
 	if x != nil {
-		return x.Name
+		return x.Password
 	}
 	return ""
 }
 
-func (x *Artifact) GetBuildId() string {
-	if x != nil {
-		return x.BuildId
-	}
-	return ""
+type CreateRepositoryAuthSSH struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Artifact) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (x *CreateRepositoryAuthSSH) Reset() {
+	*x = CreateRepositoryAuthSSH{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Artifact) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateRepositoryAuthSSH) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepositoryAuthSSH) ProtoMessage() {}
+
+func (x *CreateRepositoryAuthSSH) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[71]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Artifact) GetDeletedAt() *NullTimestamp {
+// Deprecated: Use CreateRepositoryAuthSSH.ProtoReflect.Descriptor instead.
+func (*CreateRepositoryAuthSSH) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateRepositoryAuthSSH) GetKeyId() string {
 	if x != nil {
-		return x.DeletedAt
+		return x.KeyId
 	}
-	return nil
+	return ""
 }
 
-type ArtifactContent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+type CreateRepositoryAuth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Auth:
+	//
+	//	*CreateRepositoryAuth_None
+	//	*CreateRepositoryAuth_Basic
+	//	*CreateRepositoryAuth_Ssh
+	Auth          isCreateRepositoryAuth_Auth `protobuf_oneof:"auth"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArtifactContent) Reset() {
-	*x = ArtifactContent{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[25]
+func (x *CreateRepositoryAuth) Reset() {
+	*x = CreateRepositoryAuth{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArtifactContent) String() string {
+func (x *CreateRepositoryAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArtifactContent) ProtoMessage() {}
+func (*CreateRepositoryAuth) ProtoMessage() {}
 
-func (x *ArtifactContent) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[25]
+func (x *CreateRepositoryAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,50 +5840,91 @@ func (x *ArtifactContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArtifactContent.ProtoReflect.Descriptor instead.
-func (*ArtifactContent) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CreateRepositoryAuth.ProtoReflect.Descriptor instead.
+func (*CreateRepositoryAuth) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ArtifactContent) GetFilename() string {
+func (x *CreateRepositoryAuth) GetAuth() isCreateRepositoryAuth_Auth {
 	if x != nil {
-		return x.Filename
+		return x.Auth
 	}
-	return ""
+	return nil
 }
 
-func (x *ArtifactContent) GetContent() []byte {
+func (x *CreateRepositoryAuth) GetNone() *emptypb.Empty {
 	if x != nil {
-		return x.Content
+		if x, ok := x.Auth.(*CreateRepositoryAuth_None); ok {
+			return x.None
+		}
 	}
 	return nil
 }
 
-type RuntimeImage struct {
+func (x *CreateRepositoryAuth) GetBasic() *CreateRepositoryAuthBasic {
+	if x != nil {
+		if x, ok := x.Auth.(*CreateRepositoryAuth_Basic); ok {
+			return x.Basic
+		}
+	}
+	return nil
+}
+
+func (x *CreateRepositoryAuth) GetSsh() *CreateRepositoryAuthSSH {
+	if x != nil {
+		if x, ok := x.Auth.(*CreateRepositoryAuth_Ssh); ok {
+			return x.Ssh
+		}
+	}
+	return nil
+}
+
+type isCreateRepositoryAuth_Auth interface {
+	isCreateRepositoryAuth_Auth()
+}
+
+type CreateRepositoryAuth_None struct {
+	None *emptypb.Empty `protobuf:"bytes,1,opt,name=none,proto3,oneof"`
+}
+
+type CreateRepositoryAuth_Basic struct {
+	Basic *CreateRepositoryAuthBasic `protobuf:"bytes,2,opt,name=basic,proto3,oneof"`
+}
+
+type CreateRepositoryAuth_Ssh struct {
+	Ssh *CreateRepositoryAuthSSH `protobuf:"bytes,3,opt,name=ssh,proto3,oneof"`
+}
+
+func (*CreateRepositoryAuth_None) isCreateRepositoryAuth_Auth() {}
+
+func (*CreateRepositoryAuth_Basic) isCreateRepositoryAuth_Auth() {}
+
+func (*CreateRepositoryAuth_Ssh) isCreateRepositoryAuth_Auth() {}
+
+type CreateRepositoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BuildId       string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Auth          *CreateRepositoryAuth  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuntimeImage) Reset() {
-	*x = RuntimeImage{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[26]
+func (x *CreateRepositoryRequest) Reset() {
+	*x = CreateRepositoryRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuntimeImage) String() string {
+func (x *CreateRepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuntimeImage) ProtoMessage() {}
+func (*CreateRepositoryRequest) ProtoMessage() {}
 
-func (x *RuntimeImage) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[26]
+func (x *CreateRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2396,61 +5935,54 @@ func (x *RuntimeImage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuntimeImage.ProtoReflect.Descriptor instead.
-func (*RuntimeImage) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CreateRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RuntimeImage) GetId() string {
+func (x *CreateRepositoryRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RuntimeImage) GetBuildId() string {
+func (x *CreateRepositoryRequest) GetUrl() string {
 	if x != nil {
-		return x.BuildId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *RuntimeImage) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *RuntimeImage) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateRepositoryRequest) GetAuth() *CreateRepositoryAuth {
 	if x != nil {
-		return x.CreatedAt
+		return x.Auth
 	}
 	return nil
 }
 
-type AvailableMetrics struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MetricsNames  []string               `protobuf:"bytes,1,rep,name=metrics_names,json=metricsNames,proto3" json:"metrics_names,omitempty"`
+type GetRepositoriesRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Scope         GetRepositoriesRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=neoshowcase.protobuf.GetRepositoriesRequest_Scope" json:"scope,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AvailableMetrics) Reset() {
-	*x = AvailableMetrics{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[27]
+func (x *GetRepositoriesRequest) Reset() {
+	*x = GetRepositoriesRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvailableMetrics) String() string {
+func (x *GetRepositoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailableMetrics) ProtoMessage() {}
+func (*GetRepositoriesRequest) ProtoMessage() {}
 
-func (x *AvailableMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[27]
+func (x *GetRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2461,41 +5993,44 @@ func (x *AvailableMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailableMetrics.ProtoReflect.Descriptor instead.
-func (*AvailableMetrics) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AvailableMetrics) GetMetricsNames() []string {
+func (x *GetRepositoriesRequest) GetScope() GetRepositoriesRequest_Scope {
 	if x != nil {
-		return x.MetricsNames
+		return x.Scope
 	}
-	return nil
+	return GetRepositoriesRequest_MINE
 }
 
-type ApplicationMetric struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+type UpdateRepositoryRequest struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Id            string                                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                               `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Url           *string                               `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Auth          *CreateRepositoryAuth                 `protobuf:"bytes,4,opt,name=auth,proto3,oneof" json:"auth,omitempty"`
+	OwnerIds      *UpdateRepositoryRequest_UpdateOwners `protobuf:"bytes,5,opt,name=owner_ids,json=ownerIds,proto3,oneof" json:"owner_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationMetric) Reset() {
-	*x = ApplicationMetric{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[28]
+func (x *UpdateRepositoryRequest) Reset() {
+	*x = UpdateRepositoryRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationMetric) String() string {
+func (x *UpdateRepositoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationMetric) ProtoMessage() {}
+func (*UpdateRepositoryRequest) ProtoMessage() {}
 
-func (x *ApplicationMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[28]
+func (x *UpdateRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2506,47 +6041,68 @@ func (x *ApplicationMetric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationMetric.ProtoReflect.Descriptor instead.
-func (*ApplicationMetric) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ApplicationMetric) GetTime() *timestamppb.Timestamp {
+func (x *UpdateRepositoryRequest) GetId() string {
 	if x != nil {
-		return x.Time
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRepositoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRepositoryRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateRepositoryRequest) GetAuth() *CreateRepositoryAuth {
+	if x != nil {
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *ApplicationMetric) GetValue() float64 {
+func (x *UpdateRepositoryRequest) GetOwnerIds() *UpdateRepositoryRequest_UpdateOwners {
 	if x != nil {
-		return x.Value
+		return x.OwnerIds
 	}
-	return 0
+	return nil
 }
 
-type ApplicationMetrics struct {
+type RepositoryIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       []*ApplicationMetric   `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationMetrics) Reset() {
-	*x = ApplicationMetrics{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[29]
+func (x *RepositoryIdRequest) Reset() {
+	*x = RepositoryIdRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationMetrics) String() string {
+func (x *RepositoryIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationMetrics) ProtoMessage() {}
+func (*RepositoryIdRequest) ProtoMessage() {}
 
-func (x *ApplicationMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[29]
+func (x *RepositoryIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,41 +6113,40 @@ func (x *ApplicationMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationMetrics.ProtoReflect.Descriptor instead.
-func (*ApplicationMetrics) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RepositoryIdRequest.ProtoReflect.Descriptor instead.
+func (*RepositoryIdRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ApplicationMetrics) GetMetrics() []*ApplicationMetric {
+func (x *RepositoryIdRequest) GetRepositoryId() string {
 	if x != nil {
-		return x.Metrics
+		return x.RepositoryId
 	}
-	return nil
+	return ""
 }
 
-type ApplicationOutput struct {
+type GetRepositoryCommitsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Log           string                 `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	Hashes        []string               `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationOutput) Reset() {
-	*x = ApplicationOutput{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[30]
+func (x *GetRepositoryCommitsRequest) Reset() {
+	*x = GetRepositoryCommitsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationOutput) String() string {
+func (x *GetRepositoryCommitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationOutput) ProtoMessage() {}
+func (*GetRepositoryCommitsRequest) ProtoMessage() {}
 
-func (x *ApplicationOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[30]
+func (x *GetRepositoryCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2602,47 +6157,40 @@ func (x *ApplicationOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationOutput.ProtoReflect.Descriptor instead.
-func (*ApplicationOutput) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetRepositoryCommitsRequest.ProtoReflect.Descriptor instead.
+func (*GetRepositoryCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ApplicationOutput) GetTime() *timestamppb.Timestamp {
+func (x *GetRepositoryCommitsRequest) GetHashes() []string {
 	if x != nil {
-		return x.Time
+		return x.Hashes
 	}
 	return nil
 }
 
-func (x *ApplicationOutput) GetLog() string {
-	if x != nil {
-		return x.Log
-	}
-	return ""
-}
-
-type ApplicationOutputs struct {
+type GetRepositoryCommitsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outputs       []*ApplicationOutput   `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Commits       []*SimpleCommit        `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationOutputs) Reset() {
-	*x = ApplicationOutputs{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[31]
+func (x *GetRepositoryCommitsResponse) Reset() {
+	*x = GetRepositoryCommitsResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationOutputs) String() string {
+func (x *GetRepositoryCommitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationOutputs) ProtoMessage() {}
+func (*GetRepositoryCommitsResponse) ProtoMessage() {}
 
-func (x *ApplicationOutputs) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[31]
+func (x *GetRepositoryCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2653,50 +6201,51 @@ func (x *ApplicationOutputs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationOutputs.ProtoReflect.Descriptor instead.
-func (*ApplicationOutputs) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetRepositoryCommitsResponse.ProtoReflect.Descriptor instead.
+func (*GetRepositoryCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ApplicationOutputs) GetOutputs() []*ApplicationOutput {
+func (x *GetRepositoryCommitsResponse) GetCommits() []*SimpleCommit {
 	if x != nil {
-		return x.Outputs
+		return x.Commits
 	}
 	return nil
 }
 
-type Build struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Commit        string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
-	Status        BuildStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=neoshowcase.protobuf.BuildStatus" json:"status,omitempty"`
-	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
-	StartedAt     *NullTimestamp         `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	UpdatedAt     *NullTimestamp         `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	FinishedAt    *NullTimestamp         `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
-	Retriable     bool                   `protobuf:"varint,9,opt,name=retriable,proto3" json:"retriable,omitempty"`
-	Artifacts     []*Artifact            `protobuf:"bytes,10,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
-	RuntimeImage  *RuntimeImage          `protobuf:"bytes,11,opt,name=runtime_image,json=runtimeImage,proto3,oneof" json:"runtime_image,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateWebsiteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Fqdn           string                 `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	PathPrefix     string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	StripPrefix    bool                   `protobuf:"varint,3,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	Https          bool                   `protobuf:"varint,4,opt,name=https,proto3" json:"https,omitempty"`
+	H2C            bool                   `protobuf:"varint,5,opt,name=h2c,proto3" json:"h2c,omitempty"`
+	HttpPort       int32                  `protobuf:"varint,6,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	Authentication AuthenticationType     `protobuf:"varint,7,opt,name=authentication,proto3,enum=neoshowcase.protobuf.AuthenticationType" json:"authentication,omitempty"`
+	Rules          []*WebsiteRule         `protobuf:"bytes,8,rep,name=rules,proto3" json:"rules,omitempty"`
+	HeaderPolicy   *WebsiteHeaderPolicy   `protobuf:"bytes,9,opt,name=header_policy,json=headerPolicy,proto3" json:"header_policy,omitempty"`
+	AccessControl  *WebsiteAccessControl  `protobuf:"bytes,10,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+	RateLimit      *WebsiteRateLimit      `protobuf:"bytes,11,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Backends       []*WebsiteBackend      `protobuf:"bytes,12,rep,name=backends,proto3" json:"backends,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Build) Reset() {
-	*x = Build{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[32]
+func (x *CreateWebsiteRequest) Reset() {
+	*x = CreateWebsiteRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Build) String() string {
+func (x *CreateWebsiteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Build) ProtoMessage() {}
+func (*CreateWebsiteRequest) ProtoMessage() {}
 
-func (x *Build) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[32]
+func (x *CreateWebsiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2707,155 +6256,117 @@ func (x *Build) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Build.ProtoReflect.Descriptor instead.
-func (*Build) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *Build) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateWebsiteRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebsiteRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *Build) GetApplicationId() string {
+func (x *CreateWebsiteRequest) GetFqdn() string {
 	if x != nil {
-		return x.ApplicationId
+		return x.Fqdn
 	}
 	return ""
 }
 
-func (x *Build) GetCommit() string {
+func (x *CreateWebsiteRequest) GetPathPrefix() string {
 	if x != nil {
-		return x.Commit
+		return x.PathPrefix
 	}
 	return ""
 }
 
-func (x *Build) GetStatus() BuildStatus {
+func (x *CreateWebsiteRequest) GetStripPrefix() bool {
 	if x != nil {
-		return x.Status
+		return x.StripPrefix
 	}
-	return BuildStatus_QUEUED
+	return false
 }
 
-func (x *Build) GetQueuedAt() *timestamppb.Timestamp {
+func (x *CreateWebsiteRequest) GetHttps() bool {
 	if x != nil {
-		return x.QueuedAt
+		return x.Https
 	}
-	return nil
+	return false
 }
 
-func (x *Build) GetStartedAt() *NullTimestamp {
+func (x *CreateWebsiteRequest) GetH2C() bool {
 	if x != nil {
-		return x.StartedAt
+		return x.H2C
 	}
-	return nil
+	return false
 }
 
-func (x *Build) GetUpdatedAt() *NullTimestamp {
+func (x *CreateWebsiteRequest) GetHttpPort() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.HttpPort
 	}
-	return nil
+	return 0
 }
 
-func (x *Build) GetFinishedAt() *NullTimestamp {
+func (x *CreateWebsiteRequest) GetAuthentication() AuthenticationType {
 	if x != nil {
-		return x.FinishedAt
+		return x.Authentication
 	}
-	return nil
+	return AuthenticationType_OFF
 }
 
-func (x *Build) GetRetriable() bool {
+func (x *CreateWebsiteRequest) GetRules() []*WebsiteRule {
 	if x != nil {
-		return x.Retriable
+		return x.Rules
 	}
-	return false
+	return nil
 }
 
-func (x *Build) GetArtifacts() []*Artifact {
+func (x *CreateWebsiteRequest) GetHeaderPolicy() *WebsiteHeaderPolicy {
 	if x != nil {
-		return x.Artifacts
+		return x.HeaderPolicy
 	}
 	return nil
 }
 
-func (x *Build) GetRuntimeImage() *RuntimeImage {
+func (x *CreateWebsiteRequest) GetAccessControl() *WebsiteAccessControl {
 	if x != nil {
-		return x.RuntimeImage
+		return x.AccessControl
 	}
 	return nil
 }
 
-type BuildLog struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Log           []byte                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BuildLog) Reset() {
-	*x = BuildLog{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BuildLog) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BuildLog) ProtoMessage() {}
-
-func (x *BuildLog) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[33]
+func (x *CreateWebsiteRequest) GetRateLimit() *WebsiteRateLimit {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RateLimit
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BuildLog.ProtoReflect.Descriptor instead.
-func (*BuildLog) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{33}
+	return nil
 }
 
-func (x *BuildLog) GetLog() []byte {
+func (x *CreateWebsiteRequest) GetBackends() []*WebsiteBackend {
 	if x != nil {
-		return x.Log
+		return x.Backends
 	}
 	return nil
 }
 
-type GitRef struct {
+type DeleteWebsiteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefName       string                 `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
-	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitRef) Reset() {
-	*x = GitRef{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[34]
+func (x *DeleteWebsiteRequest) Reset() {
+	*x = DeleteWebsiteRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitRef) String() string {
+func (x *DeleteWebsiteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitRef) ProtoMessage() {}
+func (*DeleteWebsiteRequest) ProtoMessage() {}
 
-func (x *GitRef) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[34]
+func (x *DeleteWebsiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2866,48 +6377,48 @@ func (x *GitRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitRef.ProtoReflect.Descriptor instead.
-func (*GitRef) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *GitRef) GetRefName() string {
-	if x != nil {
-		return x.RefName
-	}
-	return ""
+// Deprecated: Use DeleteWebsiteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebsiteRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GitRef) GetCommit() string {
+func (x *DeleteWebsiteRequest) GetId() string {
 	if x != nil {
-		return x.Commit
+		return x.Id
 	}
 	return ""
 }
 
-type GenerateKeyPairResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateApplicationRequest struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Name             string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RepositoryId     string                  `protobuf:"bytes,2,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	RefName          string                  `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	Config           *ApplicationConfig      `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Websites         []*CreateWebsiteRequest `protobuf:"bytes,5,rep,name=websites,proto3" json:"websites,omitempty"`
+	PortPublications []*PortPublication      `protobuf:"bytes,6,rep,name=port_publications,json=portPublications,proto3" json:"port_publications,omitempty"`
+	StartOnCreate    bool                    `protobuf:"varint,7,opt,name=start_on_create,json=startOnCreate,proto3" json:"start_on_create,omitempty"`
+	ConfigFilePath   string                  `protobuf:"bytes,8,opt,name=config_file_path,json=configFilePath,proto3" json:"config_file_path,omitempty"`
+	InternalService  *InternalService        `protobuf:"bytes,9,opt,name=internal_service,json=internalService,proto3" json:"internal_service,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GenerateKeyPairResponse) Reset() {
-	*x = GenerateKeyPairResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[35]
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateKeyPairResponse) String() string {
+func (x *CreateApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateKeyPairResponse) ProtoMessage() {}
+func (*CreateApplicationRequest) ProtoMessage() {}
 
-func (x *GenerateKeyPairResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[35]
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,136 +6429,107 @@ func (x *GenerateKeyPairResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateKeyPairResponse.ProtoReflect.Descriptor instead.
-func (*GenerateKeyPairResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GenerateKeyPairResponse) GetKeyId() string {
+func (x *CreateApplicationRequest) GetName() string {
 	if x != nil {
-		return x.KeyId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GenerateKeyPairResponse) GetPublicKey() string {
+func (x *CreateApplicationRequest) GetRepositoryId() string {
 	if x != nil {
-		return x.PublicKey
+		return x.RepositoryId
 	}
 	return ""
 }
 
-type GetUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUsersResponse) Reset() {
-	*x = GetUsersResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUsersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetUsersResponse) ProtoMessage() {}
-
-func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[36]
+func (x *CreateApplicationRequest) GetRefName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RefName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{36}
+	return ""
 }
 
-func (x *GetUsersResponse) GetUsers() []*User {
+func (x *CreateApplicationRequest) GetConfig() *ApplicationConfig {
 	if x != nil {
-		return x.Users
+		return x.Config
 	}
 	return nil
 }
 
-type GetUserKeysResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keys          []*UserKey             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUserKeysResponse) Reset() {
-	*x = GetUserKeysResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateApplicationRequest) GetWebsites() []*CreateWebsiteRequest {
+	if x != nil {
+		return x.Websites
+	}
+	return nil
 }
 
-func (x *GetUserKeysResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateApplicationRequest) GetPortPublications() []*PortPublication {
+	if x != nil {
+		return x.PortPublications
+	}
+	return nil
 }
 
-func (*GetUserKeysResponse) ProtoMessage() {}
-
-func (x *GetUserKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[37]
+func (x *CreateApplicationRequest) GetStartOnCreate() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartOnCreate
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GetUserKeysResponse.ProtoReflect.Descriptor instead.
-func (*GetUserKeysResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{37}
+func (x *CreateApplicationRequest) GetConfigFilePath() string {
+	if x != nil {
+		return x.ConfigFilePath
+	}
+	return ""
 }
 
-func (x *GetUserKeysResponse) GetKeys() []*UserKey {
+func (x *CreateApplicationRequest) GetInternalService() *InternalService {
 	if x != nil {
-		return x.Keys
+		return x.InternalService
 	}
 	return nil
 }
 
-type CreateUserKeyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PublicKey     string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DuplicateApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id 複製元アプリのID
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RefName string `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	// website_fqdns 複製元アプリの各Webサイトを置き換えるFQDN 複製元と同じ順番で指定します
+	WebsiteFqdns []string `protobuf:"bytes,4,rep,name=website_fqdns,json=websiteFqdns,proto3" json:"website_fqdns,omitempty"`
+	// internet_ports 複製元アプリの各公開ポートを置き換えるポート番号 複製元と同じ順番で指定します
+	InternetPorts []int32 `protobuf:"varint,5,rep,packed,name=internet_ports,json=internetPorts,proto3" json:"internet_ports,omitempty"`
+	StartOnCreate bool    `protobuf:"varint,6,opt,name=start_on_create,json=startOnCreate,proto3" json:"start_on_create,omitempty"`
+	// exclude_system_env trueまたは未指定の場合、データベース認証情報などのシステム環境変数をコピーせず、複製したアプリ用に新しく作成します
+	// falseの場合、複製元アプリと同じデータベースを使います 複製したアプリを削除しても、複製元アプリのデータベースは削除されません
+	ExcludeSystemEnv *bool `protobuf:"varint,7,opt,name=exclude_system_env,json=excludeSystemEnv,proto3,oneof" json:"exclude_system_env,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateUserKeyRequest) Reset() {
-	*x = CreateUserKeyRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[38]
+func (x *DuplicateApplicationRequest) Reset() {
+	*x = DuplicateApplicationRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserKeyRequest) String() string {
+func (x *DuplicateApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserKeyRequest) ProtoMessage() {}
+func (*DuplicateApplicationRequest) ProtoMessage() {}
 
-func (x *CreateUserKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[38]
+func (x *DuplicateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,47 +6540,83 @@ func (x *CreateUserKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserKeyRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserKeyRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DuplicateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CreateUserKeyRequest) GetPublicKey() string {
+func (x *DuplicateApplicationRequest) GetId() string {
 	if x != nil {
-		return x.PublicKey
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateUserKeyRequest) GetName() string {
+func (x *DuplicateApplicationRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type DeleteUserKeyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+func (x *DuplicateApplicationRequest) GetRefName() string {
+	if x != nil {
+		return x.RefName
+	}
+	return ""
+}
+
+func (x *DuplicateApplicationRequest) GetWebsiteFqdns() []string {
+	if x != nil {
+		return x.WebsiteFqdns
+	}
+	return nil
+}
+
+func (x *DuplicateApplicationRequest) GetInternetPorts() []int32 {
+	if x != nil {
+		return x.InternetPorts
+	}
+	return nil
+}
+
+func (x *DuplicateApplicationRequest) GetStartOnCreate() bool {
+	if x != nil {
+		return x.StartOnCreate
+	}
+	return false
+}
+
+func (x *DuplicateApplicationRequest) GetExcludeSystemEnv() bool {
+	if x != nil && x.ExcludeSystemEnv != nil {
+		return *x.ExcludeSystemEnv
+	}
+	return false
+}
+
+type GetApplicationsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Scope         GetApplicationsRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=neoshowcase.protobuf.GetApplicationsRequest_Scope" json:"scope,omitempty"`
+	RepositoryId  *string                      `protobuf:"bytes,2,opt,name=repository_id,json=repositoryId,proto3,oneof" json:"repository_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserKeyRequest) Reset() {
-	*x = DeleteUserKeyRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[39]
+func (x *GetApplicationsRequest) Reset() {
+	*x = GetApplicationsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserKeyRequest) String() string {
+func (x *GetApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserKeyRequest) ProtoMessage() {}
+func (*GetApplicationsRequest) ProtoMessage() {}
 
-func (x *DeleteUserKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[39]
+func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,41 +6627,55 @@ func (x *DeleteUserKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserKeyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserKeyRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DeleteUserKeyRequest) GetKeyId() string {
+func (x *GetApplicationsRequest) GetScope() GetApplicationsRequest_Scope {
 	if x != nil {
-		return x.KeyId
+		return x.Scope
+	}
+	return GetApplicationsRequest_MINE
+}
+
+func (x *GetApplicationsRequest) GetRepositoryId() string {
+	if x != nil && x.RepositoryId != nil {
+		return *x.RepositoryId
 	}
 	return ""
 }
 
-type CreateRepositoryAuthBasic struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateApplicationRequest struct {
+	state            protoimpl.MessageState                   `protogen:"open.v1"`
+	Id               string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             *string                                  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	RefName          *string                                  `protobuf:"bytes,4,opt,name=ref_name,json=refName,proto3,oneof" json:"ref_name,omitempty"`
+	Config           *ApplicationConfig                       `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	Websites         *UpdateApplicationRequest_UpdateWebsites `protobuf:"bytes,6,opt,name=websites,proto3,oneof" json:"websites,omitempty"`
+	PortPublications *UpdateApplicationRequest_UpdatePorts    `protobuf:"bytes,7,opt,name=port_publications,json=portPublications,proto3,oneof" json:"port_publications,omitempty"`
+	OwnerIds         *UpdateApplicationRequest_UpdateOwners   `protobuf:"bytes,8,opt,name=owner_ids,json=ownerIds,proto3,oneof" json:"owner_ids,omitempty"`
+	ConfigFilePath   *string                                  `protobuf:"bytes,9,opt,name=config_file_path,json=configFilePath,proto3,oneof" json:"config_file_path,omitempty"`
+	InternalService  *InternalService                         `protobuf:"bytes,10,opt,name=internal_service,json=internalService,proto3,oneof" json:"internal_service,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateRepositoryAuthBasic) Reset() {
-	*x = CreateRepositoryAuthBasic{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[40]
+func (x *UpdateApplicationRequest) Reset() {
+	*x = UpdateApplicationRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRepositoryAuthBasic) String() string {
+func (x *UpdateApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepositoryAuthBasic) ProtoMessage() {}
+func (*UpdateApplicationRequest) ProtoMessage() {}
 
-func (x *CreateRepositoryAuthBasic) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[40]
+func (x *UpdateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3154,96 +6686,97 @@ func (x *CreateRepositoryAuthBasic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepositoryAuthBasic.ProtoReflect.Descriptor instead.
-func (*CreateRepositoryAuthBasic) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CreateRepositoryAuthBasic) GetUsername() string {
+func (x *UpdateApplicationRequest) GetId() string {
 	if x != nil {
-		return x.Username
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateRepositoryAuthBasic) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *UpdateApplicationRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-type CreateRepositoryAuthSSH struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateApplicationRequest) GetRefName() string {
+	if x != nil && x.RefName != nil {
+		return *x.RefName
+	}
+	return ""
 }
 
-func (x *CreateRepositoryAuthSSH) Reset() {
-	*x = CreateRepositoryAuthSSH{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateApplicationRequest) GetConfig() *ApplicationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
 }
 
-func (x *CreateRepositoryAuthSSH) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateApplicationRequest) GetWebsites() *UpdateApplicationRequest_UpdateWebsites {
+	if x != nil {
+		return x.Websites
+	}
+	return nil
 }
 
-func (*CreateRepositoryAuthSSH) ProtoMessage() {}
+func (x *UpdateApplicationRequest) GetPortPublications() *UpdateApplicationRequest_UpdatePorts {
+	if x != nil {
+		return x.PortPublications
+	}
+	return nil
+}
 
-func (x *CreateRepositoryAuthSSH) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[41]
+func (x *UpdateApplicationRequest) GetOwnerIds() *UpdateApplicationRequest_UpdateOwners {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OwnerIds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateRepositoryAuthSSH.ProtoReflect.Descriptor instead.
-func (*CreateRepositoryAuthSSH) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{41}
+func (x *UpdateApplicationRequest) GetConfigFilePath() string {
+	if x != nil && x.ConfigFilePath != nil {
+		return *x.ConfigFilePath
+	}
+	return ""
 }
 
-func (x *CreateRepositoryAuthSSH) GetKeyId() string {
+func (x *UpdateApplicationRequest) GetInternalService() *InternalService {
 	if x != nil {
-		return x.KeyId
+		return x.InternalService
 	}
-	return ""
+	return nil
 }
 
-type CreateRepositoryAuth struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Auth:
-	//
-	//	*CreateRepositoryAuth_None
-	//	*CreateRepositoryAuth_Basic
-	//	*CreateRepositoryAuth_Ssh
-	Auth          isCreateRepositoryAuth_Auth `protobuf_oneof:"auth"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExportApplicationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationIds []string               `protobuf:"bytes,1,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	Format         ManifestFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=neoshowcase.protobuf.ManifestFormat" json:"format,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateRepositoryAuth) Reset() {
-	*x = CreateRepositoryAuth{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[42]
+func (x *ExportApplicationsRequest) Reset() {
+	*x = ExportApplicationsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRepositoryAuth) String() string {
+func (x *ExportApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepositoryAuth) ProtoMessage() {}
+func (*ExportApplicationsRequest) ProtoMessage() {}
 
-func (x *CreateRepositoryAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[42]
+func (x *ExportApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3254,91 +6787,94 @@ func (x *CreateRepositoryAuth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepositoryAuth.ProtoReflect.Descriptor instead.
-func (*CreateRepositoryAuth) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ExportApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateRepositoryAuth) GetAuth() isCreateRepositoryAuth_Auth {
+func (x *ExportApplicationsRequest) GetApplicationIds() []string {
 	if x != nil {
-		return x.Auth
+		return x.ApplicationIds
 	}
 	return nil
 }
 
-func (x *CreateRepositoryAuth) GetNone() *emptypb.Empty {
+func (x *ExportApplicationsRequest) GetFormat() ManifestFormat {
 	if x != nil {
-		if x, ok := x.Auth.(*CreateRepositoryAuth_None); ok {
-			return x.None
-		}
+		return x.Format
 	}
-	return nil
+	return ManifestFormat_YAML
 }
 
-func (x *CreateRepositoryAuth) GetBasic() *CreateRepositoryAuthBasic {
-	if x != nil {
-		if x, ok := x.Auth.(*CreateRepositoryAuth_Basic); ok {
-			return x.Basic
-		}
-	}
-	return nil
+type ExportApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      string                 `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRepositoryAuth) GetSsh() *CreateRepositoryAuthSSH {
-	if x != nil {
-		if x, ok := x.Auth.(*CreateRepositoryAuth_Ssh); ok {
-			return x.Ssh
-		}
-	}
-	return nil
+func (x *ExportApplicationsResponse) Reset() {
+	*x = ExportApplicationsResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isCreateRepositoryAuth_Auth interface {
-	isCreateRepositoryAuth_Auth()
+func (x *ExportApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CreateRepositoryAuth_None struct {
-	None *emptypb.Empty `protobuf:"bytes,1,opt,name=none,proto3,oneof"`
-}
+func (*ExportApplicationsResponse) ProtoMessage() {}
 
-type CreateRepositoryAuth_Basic struct {
-	Basic *CreateRepositoryAuthBasic `protobuf:"bytes,2,opt,name=basic,proto3,oneof"`
+func (x *ExportApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type CreateRepositoryAuth_Ssh struct {
-	Ssh *CreateRepositoryAuthSSH `protobuf:"bytes,3,opt,name=ssh,proto3,oneof"`
+// Deprecated: Use ExportApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ExportApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{86}
 }
 
-func (*CreateRepositoryAuth_None) isCreateRepositoryAuth_Auth() {}
-
-func (*CreateRepositoryAuth_Basic) isCreateRepositoryAuth_Auth() {}
-
-func (*CreateRepositoryAuth_Ssh) isCreateRepositoryAuth_Auth() {}
+func (x *ExportApplicationsResponse) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
 
-type CreateRepositoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Auth          *CreateRepositoryAuth  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+type ApplyManifestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manifest YAMLまたはJSON形式のマニフェスト
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// dry_run trueの場合、変更を適用せず差分と検証エラーのみを返します
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRepositoryRequest) Reset() {
-	*x = CreateRepositoryRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[43]
+func (x *ApplyManifestRequest) Reset() {
+	*x = ApplyManifestRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRepositoryRequest) String() string {
+func (x *ApplyManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepositoryRequest) ProtoMessage() {}
-
-func (x *CreateRepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[43]
+func (*ApplyManifestRequest) ProtoMessage() {}
+
+func (x *ApplyManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3349,54 +6885,49 @@ func (x *CreateRepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepositoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateRepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *CreateRepositoryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ApplyManifestRequest.ProtoReflect.Descriptor instead.
+func (*ApplyManifestRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CreateRepositoryRequest) GetUrl() string {
+func (x *ApplyManifestRequest) GetManifest() string {
 	if x != nil {
-		return x.Url
+		return x.Manifest
 	}
 	return ""
 }
 
-func (x *CreateRepositoryRequest) GetAuth() *CreateRepositoryAuth {
+func (x *ApplyManifestRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Auth
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-type GetRepositoriesRequest struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Scope         GetRepositoriesRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=neoshowcase.protobuf.GetRepositoriesRequest_Scope" json:"scope,omitempty"`
+type ManifestFieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoriesRequest) Reset() {
-	*x = GetRepositoriesRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[44]
+func (x *ManifestFieldDiff) Reset() {
+	*x = ManifestFieldDiff{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoriesRequest) String() string {
+func (x *ManifestFieldDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoriesRequest) ProtoMessage() {}
+func (*ManifestFieldDiff) ProtoMessage() {}
 
-func (x *GetRepositoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[44]
+func (x *ManifestFieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3407,44 +6938,59 @@ func (x *GetRepositoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoriesRequest.ProtoReflect.Descriptor instead.
-func (*GetRepositoriesRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ManifestFieldDiff.ProtoReflect.Descriptor instead.
+func (*ManifestFieldDiff) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetRepositoriesRequest) GetScope() GetRepositoriesRequest_Scope {
+func (x *ManifestFieldDiff) GetField() string {
 	if x != nil {
-		return x.Scope
+		return x.Field
 	}
-	return GetRepositoriesRequest_MINE
+	return ""
 }
 
-type UpdateRepositoryRequest struct {
-	state         protoimpl.MessageState                `protogen:"open.v1"`
-	Id            string                                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                               `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Url           *string                               `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
-	Auth          *CreateRepositoryAuth                 `protobuf:"bytes,4,opt,name=auth,proto3,oneof" json:"auth,omitempty"`
-	OwnerIds      *UpdateRepositoryRequest_UpdateOwners `protobuf:"bytes,5,opt,name=owner_ids,json=ownerIds,proto3,oneof" json:"owner_ids,omitempty"`
+func (x *ManifestFieldDiff) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *ManifestFieldDiff) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type ManifestApplicationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// application_id 新規作成の場合は空
+	ApplicationId string               `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Name          string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Create        bool                 `protobuf:"varint,3,opt,name=create,proto3" json:"create,omitempty"`
+	Diffs         []*ManifestFieldDiff `protobuf:"bytes,4,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	Errors        []string             `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRepositoryRequest) Reset() {
-	*x = UpdateRepositoryRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[45]
+func (x *ManifestApplicationResult) Reset() {
+	*x = ManifestApplicationResult{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRepositoryRequest) String() string {
+func (x *ManifestApplicationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRepositoryRequest) ProtoMessage() {}
+func (*ManifestApplicationResult) ProtoMessage() {}
 
-func (x *UpdateRepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[45]
+func (x *ManifestApplicationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3455,68 +7001,70 @@ func (x *UpdateRepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRepositoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ManifestApplicationResult.ProtoReflect.Descriptor instead.
+func (*ManifestApplicationResult) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateRepositoryRequest) GetId() string {
+func (x *ManifestApplicationResult) GetApplicationId() string {
 	if x != nil {
-		return x.Id
+		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *UpdateRepositoryRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ManifestApplicationResult) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateRepositoryRequest) GetUrl() string {
-	if x != nil && x.Url != nil {
-		return *x.Url
+func (x *ManifestApplicationResult) GetCreate() bool {
+	if x != nil {
+		return x.Create
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateRepositoryRequest) GetAuth() *CreateRepositoryAuth {
+func (x *ManifestApplicationResult) GetDiffs() []*ManifestFieldDiff {
 	if x != nil {
-		return x.Auth
+		return x.Diffs
 	}
 	return nil
 }
 
-func (x *UpdateRepositoryRequest) GetOwnerIds() *UpdateRepositoryRequest_UpdateOwners {
+func (x *ManifestApplicationResult) GetErrors() []string {
 	if x != nil {
-		return x.OwnerIds
+		return x.Errors
 	}
 	return nil
 }
 
-type RepositoryIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+type ApplyManifestResponse struct {
+	state   protoimpl.MessageState       `protogen:"open.v1"`
+	Results []*ManifestApplicationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// applied 変更が適用されたか
+	Applied       bool `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RepositoryIdRequest) Reset() {
-	*x = RepositoryIdRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[46]
+func (x *ApplyManifestResponse) Reset() {
+	*x = ApplyManifestResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepositoryIdRequest) String() string {
+func (x *ApplyManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryIdRequest) ProtoMessage() {}
+func (*ApplyManifestResponse) ProtoMessage() {}
 
-func (x *RepositoryIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[46]
+func (x *ApplyManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,40 +7075,47 @@ func (x *RepositoryIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryIdRequest.ProtoReflect.Descriptor instead.
-func (*RepositoryIdRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ApplyManifestResponse.ProtoReflect.Descriptor instead.
+func (*ApplyManifestResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RepositoryIdRequest) GetRepositoryId() string {
+func (x *ApplyManifestResponse) GetResults() []*ManifestApplicationResult {
 	if x != nil {
-		return x.RepositoryId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-type GetRepositoryCommitsRequest struct {
+func (x *ApplyManifestResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type GetRepositoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hashes        []string               `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Repositories  []*Repository          `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoryCommitsRequest) Reset() {
-	*x = GetRepositoryCommitsRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[47]
+func (x *GetRepositoriesResponse) Reset() {
+	*x = GetRepositoriesResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoryCommitsRequest) String() string {
+func (x *GetRepositoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoryCommitsRequest) ProtoMessage() {}
+func (*GetRepositoriesResponse) ProtoMessage() {}
 
-func (x *GetRepositoryCommitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[47]
+func (x *GetRepositoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3571,40 +7126,40 @@ func (x *GetRepositoryCommitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoryCommitsRequest.ProtoReflect.Descriptor instead.
-func (*GetRepositoryCommitsRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetRepositoryCommitsRequest) GetHashes() []string {
+func (x *GetRepositoriesResponse) GetRepositories() []*Repository {
 	if x != nil {
-		return x.Hashes
+		return x.Repositories
 	}
 	return nil
 }
 
-type GetRepositoryCommitsResponse struct {
+type GetApplicationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Commits       []*SimpleCommit        `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	Applications  []*Application         `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoryCommitsResponse) Reset() {
-	*x = GetRepositoryCommitsResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[48]
+func (x *GetApplicationsResponse) Reset() {
+	*x = GetApplicationsResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoryCommitsResponse) String() string {
+func (x *GetApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoryCommitsResponse) ProtoMessage() {}
+func (*GetApplicationsResponse) ProtoMessage() {}
 
-func (x *GetRepositoryCommitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[48]
+func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3615,46 +7170,40 @@ func (x *GetRepositoryCommitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoryCommitsResponse.ProtoReflect.Descriptor instead.
-func (*GetRepositoryCommitsResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GetApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetRepositoryCommitsResponse) GetCommits() []*SimpleCommit {
+func (x *GetApplicationsResponse) GetApplications() []*Application {
 	if x != nil {
-		return x.Commits
+		return x.Applications
 	}
 	return nil
 }
 
-type CreateWebsiteRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Fqdn           string                 `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
-	PathPrefix     string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	StripPrefix    bool                   `protobuf:"varint,3,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
-	Https          bool                   `protobuf:"varint,4,opt,name=https,proto3" json:"https,omitempty"`
-	H2C            bool                   `protobuf:"varint,5,opt,name=h2c,proto3" json:"h2c,omitempty"`
-	HttpPort       int32                  `protobuf:"varint,6,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
-	Authentication AuthenticationType     `protobuf:"varint,7,opt,name=authentication,proto3,enum=neoshowcase.protobuf.AuthenticationType" json:"authentication,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ApplicationIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWebsiteRequest) Reset() {
-	*x = CreateWebsiteRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[49]
+func (x *ApplicationIdRequest) Reset() {
+	*x = ApplicationIdRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWebsiteRequest) String() string {
+func (x *ApplicationIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWebsiteRequest) ProtoMessage() {}
+func (*ApplicationIdRequest) ProtoMessage() {}
 
-func (x *CreateWebsiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[49]
+func (x *ApplicationIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3665,82 +7214,93 @@ func (x *CreateWebsiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWebsiteRequest.ProtoReflect.Descriptor instead.
-func (*CreateWebsiteRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ApplicationIdRequest.ProtoReflect.Descriptor instead.
+func (*ApplicationIdRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *CreateWebsiteRequest) GetFqdn() string {
+func (x *ApplicationIdRequest) GetId() string {
 	if x != nil {
-		return x.Fqdn
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateWebsiteRequest) GetPathPrefix() string {
-	if x != nil {
-		return x.PathPrefix
-	}
-	return ""
+type GetAllBuildsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// zero-indexed page
+	Page          int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWebsiteRequest) GetStripPrefix() bool {
-	if x != nil {
-		return x.StripPrefix
-	}
-	return false
+func (x *GetAllBuildsRequest) Reset() {
+	*x = GetAllBuildsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWebsiteRequest) GetHttps() bool {
-	if x != nil {
-		return x.Https
-	}
-	return false
+func (x *GetAllBuildsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateWebsiteRequest) GetH2C() bool {
+func (*GetAllBuildsRequest) ProtoMessage() {}
+
+func (x *GetAllBuildsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[94]
 	if x != nil {
-		return x.H2C
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateWebsiteRequest) GetHttpPort() int32 {
+// Deprecated: Use GetAllBuildsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllBuildsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetAllBuildsRequest) GetPage() int32 {
 	if x != nil {
-		return x.HttpPort
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CreateWebsiteRequest) GetAuthentication() AuthenticationType {
+func (x *GetAllBuildsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Authentication
+		return x.Limit
 	}
-	return AuthenticationType_OFF
+	return 0
 }
 
-type DeleteWebsiteRequest struct {
+type BuildIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuildId       string                 `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWebsiteRequest) Reset() {
-	*x = DeleteWebsiteRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[50]
+func (x *BuildIdRequest) Reset() {
+	*x = BuildIdRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWebsiteRequest) String() string {
+func (x *BuildIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWebsiteRequest) ProtoMessage() {}
+func (*BuildIdRequest) ProtoMessage() {}
 
-func (x *DeleteWebsiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[50]
+func (x *BuildIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3751,46 +7311,40 @@ func (x *DeleteWebsiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWebsiteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWebsiteRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use BuildIdRequest.ProtoReflect.Descriptor instead.
+func (*BuildIdRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *DeleteWebsiteRequest) GetId() string {
+func (x *BuildIdRequest) GetBuildId() string {
 	if x != nil {
-		return x.Id
+		return x.BuildId
 	}
 	return ""
 }
 
-type CreateApplicationRequest struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	Name             string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	RepositoryId     string                  `protobuf:"bytes,2,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
-	RefName          string                  `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
-	Config           *ApplicationConfig      `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	Websites         []*CreateWebsiteRequest `protobuf:"bytes,5,rep,name=websites,proto3" json:"websites,omitempty"`
-	PortPublications []*PortPublication      `protobuf:"bytes,6,rep,name=port_publications,json=portPublications,proto3" json:"port_publications,omitempty"`
-	StartOnCreate    bool                    `protobuf:"varint,7,opt,name=start_on_create,json=startOnCreate,proto3" json:"start_on_create,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ArtifactIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateApplicationRequest) Reset() {
-	*x = CreateApplicationRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[51]
+func (x *ArtifactIdRequest) Reset() {
+	*x = ArtifactIdRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationRequest) String() string {
+func (x *ArtifactIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateApplicationRequest) ProtoMessage() {}
+func (*ArtifactIdRequest) ProtoMessage() {}
 
-func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[51]
+func (x *ArtifactIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3801,83 +7355,86 @@ func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ArtifactIdRequest.ProtoReflect.Descriptor instead.
+func (*ArtifactIdRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CreateApplicationRequest) GetName() string {
+func (x *ArtifactIdRequest) GetArtifactId() string {
 	if x != nil {
-		return x.Name
+		return x.ArtifactId
 	}
 	return ""
 }
 
-func (x *CreateApplicationRequest) GetRepositoryId() string {
-	if x != nil {
-		return x.RepositoryId
-	}
-	return ""
+type GetBuildsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Builds        []*Build               `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateApplicationRequest) GetRefName() string {
-	if x != nil {
-		return x.RefName
-	}
-	return ""
+func (x *GetBuildsResponse) Reset() {
+	*x = GetBuildsResponse{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationRequest) GetConfig() *ApplicationConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+func (x *GetBuildsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateApplicationRequest) GetWebsites() []*CreateWebsiteRequest {
+func (*GetBuildsResponse) ProtoMessage() {}
+
+func (x *GetBuildsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[97]
 	if x != nil {
-		return x.Websites
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateApplicationRequest) GetPortPublications() []*PortPublication {
-	if x != nil {
-		return x.PortPublications
-	}
-	return nil
+// Deprecated: Use GetBuildsResponse.ProtoReflect.Descriptor instead.
+func (*GetBuildsResponse) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *CreateApplicationRequest) GetStartOnCreate() bool {
+func (x *GetBuildsResponse) GetBuilds() []*Build {
 	if x != nil {
-		return x.StartOnCreate
+		return x.Builds
 	}
-	return false
+	return nil
 }
 
-type GetApplicationsRequest struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Scope         GetApplicationsRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=neoshowcase.protobuf.GetApplicationsRequest_Scope" json:"scope,omitempty"`
-	RepositoryId  *string                      `protobuf:"bytes,2,opt,name=repository_id,json=repositoryId,proto3,oneof" json:"repository_id,omitempty"`
+type SetApplicationEnvVarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationsRequest) Reset() {
-	*x = GetApplicationsRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[52]
+func (x *SetApplicationEnvVarRequest) Reset() {
+	*x = SetApplicationEnvVarRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationsRequest) String() string {
+func (x *SetApplicationEnvVarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationsRequest) ProtoMessage() {}
+func (*SetApplicationEnvVarRequest) ProtoMessage() {}
 
-func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[52]
+func (x *SetApplicationEnvVarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3888,53 +7445,55 @@ func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use SetApplicationEnvVarRequest.ProtoReflect.Descriptor instead.
+func (*SetApplicationEnvVarRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetApplicationsRequest) GetScope() GetApplicationsRequest_Scope {
+func (x *SetApplicationEnvVarRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Scope
+		return x.ApplicationId
 	}
-	return GetApplicationsRequest_MINE
+	return ""
 }
 
-func (x *GetApplicationsRequest) GetRepositoryId() string {
-	if x != nil && x.RepositoryId != nil {
-		return *x.RepositoryId
+func (x *SetApplicationEnvVarRequest) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-type UpdateApplicationRequest struct {
-	state            protoimpl.MessageState                   `protogen:"open.v1"`
-	Id               string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             *string                                  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	RefName          *string                                  `protobuf:"bytes,4,opt,name=ref_name,json=refName,proto3,oneof" json:"ref_name,omitempty"`
-	Config           *ApplicationConfig                       `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
-	Websites         *UpdateApplicationRequest_UpdateWebsites `protobuf:"bytes,6,opt,name=websites,proto3,oneof" json:"websites,omitempty"`
-	PortPublications *UpdateApplicationRequest_UpdatePorts    `protobuf:"bytes,7,opt,name=port_publications,json=portPublications,proto3,oneof" json:"port_publications,omitempty"`
-	OwnerIds         *UpdateApplicationRequest_UpdateOwners   `protobuf:"bytes,8,opt,name=owner_ids,json=ownerIds,proto3,oneof" json:"owner_ids,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *SetApplicationEnvVarRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-func (x *UpdateApplicationRequest) Reset() {
-	*x = UpdateApplicationRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[53]
+type DeleteApplicationEnvVarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationEnvVarRequest) Reset() {
+	*x = DeleteApplicationEnvVarRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateApplicationRequest) String() string {
+func (x *DeleteApplicationEnvVarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateApplicationRequest) ProtoMessage() {}
+func (*DeleteApplicationEnvVarRequest) ProtoMessage() {}
 
-func (x *UpdateApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[53]
+func (x *DeleteApplicationEnvVarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3945,82 +7504,50 @@ func (x *UpdateApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateApplicationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use DeleteApplicationEnvVarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationEnvVarRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *UpdateApplicationRequest) GetId() string {
+func (x *DeleteApplicationEnvVarRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateApplicationRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateApplicationRequest) GetRefName() string {
-	if x != nil && x.RefName != nil {
-		return *x.RefName
+		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *UpdateApplicationRequest) GetConfig() *ApplicationConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-func (x *UpdateApplicationRequest) GetWebsites() *UpdateApplicationRequest_UpdateWebsites {
-	if x != nil {
-		return x.Websites
-	}
-	return nil
-}
-
-func (x *UpdateApplicationRequest) GetPortPublications() *UpdateApplicationRequest_UpdatePorts {
-	if x != nil {
-		return x.PortPublications
-	}
-	return nil
-}
-
-func (x *UpdateApplicationRequest) GetOwnerIds() *UpdateApplicationRequest_UpdateOwners {
+func (x *DeleteApplicationEnvVarRequest) GetKey() string {
 	if x != nil {
-		return x.OwnerIds
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type GetRepositoriesResponse struct {
+type GetApplicationMetricsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repositories  []*Repository          `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	MetricsName   string                 `protobuf:"bytes,2,opt,name=metrics_name,json=metricsName,proto3" json:"metrics_name,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	LimitSeconds  int64                  `protobuf:"varint,4,opt,name=limit_seconds,json=limitSeconds,proto3" json:"limit_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoriesResponse) Reset() {
-	*x = GetRepositoriesResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[54]
+func (x *GetApplicationMetricsRequest) Reset() {
+	*x = GetApplicationMetricsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoriesResponse) String() string {
+func (x *GetApplicationMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoriesResponse) ProtoMessage() {}
+func (*GetApplicationMetricsRequest) ProtoMessage() {}
 
-func (x *GetRepositoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[54]
+func (x *GetApplicationMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4031,40 +7558,66 @@ func (x *GetRepositoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoriesResponse.ProtoReflect.Descriptor instead.
-func (*GetRepositoriesResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use GetApplicationMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetApplicationMetricsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GetApplicationMetricsRequest) GetMetricsName() string {
+	if x != nil {
+		return x.MetricsName
+	}
+	return ""
 }
 
-func (x *GetRepositoriesResponse) GetRepositories() []*Repository {
+func (x *GetApplicationMetricsRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Repositories
+		return x.Before
 	}
 	return nil
 }
 
-type GetApplicationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Applications  []*Application         `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+func (x *GetApplicationMetricsRequest) GetLimitSeconds() int64 {
+	if x != nil {
+		return x.LimitSeconds
+	}
+	return 0
+}
+
+type LogFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// contains 指定した文字列を含む行のみ
+	Contains string `protobuf:"bytes,1,opt,name=contains,proto3" json:"contains,omitempty"`
+	// regexp 指定した正規表現 (RE2) にマッチする行のみ
+	Regexp string `protobuf:"bytes,2,opt,name=regexp,proto3" json:"regexp,omitempty"`
+	// stream 指定した出力先の行のみ ログ基盤が対応している場合のみ指定可能
+	Stream        LogFilter_Stream `protobuf:"varint,3,opt,name=stream,proto3,enum=neoshowcase.protobuf.LogFilter_Stream" json:"stream,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationsResponse) Reset() {
-	*x = GetApplicationsResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[55]
+func (x *LogFilter) Reset() {
+	*x = LogFilter{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationsResponse) String() string {
+func (x *LogFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationsResponse) ProtoMessage() {}
+func (*LogFilter) ProtoMessage() {}
 
-func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[55]
+func (x *LogFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4075,40 +7628,57 @@ func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*GetApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use LogFilter.ProtoReflect.Descriptor instead.
+func (*LogFilter) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetApplicationsResponse) GetApplications() []*Application {
+func (x *LogFilter) GetContains() string {
 	if x != nil {
-		return x.Applications
+		return x.Contains
 	}
-	return nil
+	return ""
 }
 
-type ApplicationIdRequest struct {
+func (x *LogFilter) GetRegexp() string {
+	if x != nil {
+		return x.Regexp
+	}
+	return ""
+}
+
+func (x *LogFilter) GetStream() LogFilter_Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return LogFilter_ALL
+}
+
+type GetOutputRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter        *LogFilter             `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationIdRequest) Reset() {
-	*x = ApplicationIdRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[56]
+func (x *GetOutputRequest) Reset() {
+	*x = GetOutputRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationIdRequest) String() string {
+func (x *GetOutputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationIdRequest) ProtoMessage() {}
+func (*GetOutputRequest) ProtoMessage() {}
 
-func (x *ApplicationIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[56]
+func (x *GetOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4119,42 +7689,63 @@ func (x *ApplicationIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationIdRequest.ProtoReflect.Descriptor instead.
-func (*ApplicationIdRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetOutputRequest.ProtoReflect.Descriptor instead.
+func (*GetOutputRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ApplicationIdRequest) GetId() string {
+func (x *GetOutputRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Id
+		return x.ApplicationId
 	}
 	return ""
 }
 
-type GetAllBuildsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// zero-indexed page
-	Page          int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *GetOutputRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *GetOutputRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetOutputRequest) GetFilter() *LogFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetOutputStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Begin         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=begin,proto3" json:"begin,omitempty"`
+	Filter        *LogFilter             `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllBuildsRequest) Reset() {
-	*x = GetAllBuildsRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[57]
+func (x *GetOutputStreamRequest) Reset() {
+	*x = GetOutputStreamRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllBuildsRequest) String() string {
+func (x *GetOutputStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllBuildsRequest) ProtoMessage() {}
+func (*GetOutputStreamRequest) ProtoMessage() {}
 
-func (x *GetAllBuildsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[57]
+func (x *GetOutputStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4165,47 +7756,61 @@ func (x *GetAllBuildsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllBuildsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllBuildsRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetOutputStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetOutputStreamRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetAllBuildsRequest) GetPage() int32 {
+func (x *GetOutputStreamRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Page
+		return x.ApplicationId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAllBuildsRequest) GetLimit() int32 {
+func (x *GetOutputStreamRequest) GetBegin() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.Begin
 	}
-	return 0
+	return nil
 }
 
-type BuildIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuildId       string                 `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOutputStreamRequest) GetFilter() *LogFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (x *BuildIdRequest) Reset() {
-	*x = BuildIdRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[58]
+type CreateAlertRuleRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	ApplicationId   string                  `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Name            string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind            AlertRule_Kind          `protobuf:"varint,3,opt,name=kind,proto3,enum=neoshowcase.protobuf.AlertRule_Kind" json:"kind,omitempty"`
+	Metric          string                  `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Comparison      AlertRule_Comparison    `protobuf:"varint,5,opt,name=comparison,proto3,enum=neoshowcase.protobuf.AlertRule_Comparison" json:"comparison,omitempty"`
+	Threshold       float64                 `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	DurationSeconds int64                   `protobuf:"varint,7,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	ThresholdUnit   AlertRule_ThresholdUnit `protobuf:"varint,8,opt,name=threshold_unit,json=thresholdUnit,proto3,enum=neoshowcase.protobuf.AlertRule_ThresholdUnit" json:"threshold_unit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildIdRequest) String() string {
+func (x *CreateAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildIdRequest) ProtoMessage() {}
+func (*CreateAlertRuleRequest) ProtoMessage() {}
 
-func (x *BuildIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[58]
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4216,84 +7821,90 @@ func (x *BuildIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildIdRequest.ProtoReflect.Descriptor instead.
-func (*BuildIdRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *BuildIdRequest) GetBuildId() string {
+func (x *CreateAlertRuleRequest) GetApplicationId() string {
 	if x != nil {
-		return x.BuildId
+		return x.ApplicationId
 	}
 	return ""
 }
 
-type ArtifactIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateAlertRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ArtifactIdRequest) Reset() {
-	*x = ArtifactIdRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[59]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateAlertRuleRequest) GetKind() AlertRule_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return AlertRule_METRIC
 }
 
-func (x *ArtifactIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateAlertRuleRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
 }
 
-func (*ArtifactIdRequest) ProtoMessage() {}
+func (x *CreateAlertRuleRequest) GetComparison() AlertRule_Comparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return AlertRule_ABOVE
+}
 
-func (x *ArtifactIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[59]
+func (x *CreateAlertRuleRequest) GetThreshold() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Threshold
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ArtifactIdRequest.ProtoReflect.Descriptor instead.
-func (*ArtifactIdRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{59}
+func (x *CreateAlertRuleRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
 }
 
-func (x *ArtifactIdRequest) GetArtifactId() string {
+func (x *CreateAlertRuleRequest) GetThresholdUnit() AlertRule_ThresholdUnit {
 	if x != nil {
-		return x.ArtifactId
+		return x.ThresholdUnit
 	}
-	return ""
+	return AlertRule_VALUE
 }
 
-type GetBuildsResponse struct {
+type DeleteAlertRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Builds        []*Build               `protobuf:"bytes,1,rep,name=builds,proto3" json:"builds,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBuildsResponse) Reset() {
-	*x = GetBuildsResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[60]
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBuildsResponse) String() string {
+func (x *DeleteAlertRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBuildsResponse) ProtoMessage() {}
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
 
-func (x *GetBuildsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[60]
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4304,42 +7915,52 @@ func (x *GetBuildsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBuildsResponse.ProtoReflect.Descriptor instead.
-func (*GetBuildsResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetBuildsResponse) GetBuilds() []*Build {
+func (x *DeleteAlertRuleRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Builds
+		return x.ApplicationId
 	}
-	return nil
+	return ""
 }
 
-type SetApplicationEnvVarRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+func (x *DeleteAlertRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type CreateNotificationSubscriptionRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	ApplicationId string                        `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Sink          NotificationSubscription_Sink `protobuf:"varint,2,opt,name=sink,proto3,enum=neoshowcase.protobuf.NotificationSubscription_Sink" json:"sink,omitempty"`
+	Target        string                        `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// secret WEBHOOK, TRAQの場合にペイロードの署名に使用します 空の場合は署名しません
+	Secret        string                           `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Events        []NotificationSubscription_Event `protobuf:"varint,5,rep,packed,name=events,proto3,enum=neoshowcase.protobuf.NotificationSubscription_Event" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetApplicationEnvVarRequest) Reset() {
-	*x = SetApplicationEnvVarRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[61]
+func (x *CreateNotificationSubscriptionRequest) Reset() {
+	*x = CreateNotificationSubscriptionRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetApplicationEnvVarRequest) String() string {
+func (x *CreateNotificationSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetApplicationEnvVarRequest) ProtoMessage() {}
+func (*CreateNotificationSubscriptionRequest) ProtoMessage() {}
 
-func (x *SetApplicationEnvVarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[61]
+func (x *CreateNotificationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4350,55 +7971,69 @@ func (x *SetApplicationEnvVarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetApplicationEnvVarRequest.ProtoReflect.Descriptor instead.
-func (*SetApplicationEnvVarRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use CreateNotificationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *SetApplicationEnvVarRequest) GetApplicationId() string {
+func (x *CreateNotificationSubscriptionRequest) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *SetApplicationEnvVarRequest) GetKey() string {
+func (x *CreateNotificationSubscriptionRequest) GetSink() NotificationSubscription_Sink {
 	if x != nil {
-		return x.Key
+		return x.Sink
+	}
+	return NotificationSubscription_WEBHOOK
+}
+
+func (x *CreateNotificationSubscriptionRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
 	}
 	return ""
 }
 
-func (x *SetApplicationEnvVarRequest) GetValue() string {
+func (x *CreateNotificationSubscriptionRequest) GetSecret() string {
 	if x != nil {
-		return x.Value
+		return x.Secret
 	}
 	return ""
 }
 
-type DeleteApplicationEnvVarRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateNotificationSubscriptionRequest) GetEvents() []NotificationSubscription_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DeleteNotificationSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId  string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteApplicationEnvVarRequest) Reset() {
-	*x = DeleteApplicationEnvVarRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[62]
+func (x *DeleteNotificationSubscriptionRequest) Reset() {
+	*x = DeleteNotificationSubscriptionRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteApplicationEnvVarRequest) String() string {
+func (x *DeleteNotificationSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteApplicationEnvVarRequest) ProtoMessage() {}
+func (*DeleteNotificationSubscriptionRequest) ProtoMessage() {}
 
-func (x *DeleteApplicationEnvVarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[62]
+func (x *DeleteNotificationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4409,50 +8044,49 @@ func (x *DeleteApplicationEnvVarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteApplicationEnvVarRequest.ProtoReflect.Descriptor instead.
-func (*DeleteApplicationEnvVarRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use DeleteNotificationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DeleteApplicationEnvVarRequest) GetApplicationId() string {
+func (x *DeleteNotificationSubscriptionRequest) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *DeleteApplicationEnvVarRequest) GetKey() string {
+func (x *DeleteNotificationSubscriptionRequest) GetSubscriptionId() string {
 	if x != nil {
-		return x.Key
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-type GetApplicationMetricsRequest struct {
+type GetAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	MetricsName   string                 `protobuf:"bytes,2,opt,name=metrics_name,json=metricsName,proto3" json:"metrics_name,omitempty"`
-	Before        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
-	LimitSeconds  int64                  `protobuf:"varint,4,opt,name=limit_seconds,json=limitSeconds,proto3" json:"limit_seconds,omitempty"`
+	// limit 0の場合は50件 最大500件
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationMetricsRequest) Reset() {
-	*x = GetApplicationMetricsRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[63]
+func (x *GetAlertsRequest) Reset() {
+	*x = GetAlertsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationMetricsRequest) String() string {
+func (x *GetAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationMetricsRequest) ProtoMessage() {}
+func (*GetAlertsRequest) ProtoMessage() {}
 
-func (x *GetApplicationMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[63]
+func (x *GetAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4463,63 +8097,50 @@ func (x *GetApplicationMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use GetAlertsRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetApplicationMetricsRequest) GetApplicationId() string {
+func (x *GetAlertsRequest) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *GetApplicationMetricsRequest) GetMetricsName() string {
-	if x != nil {
-		return x.MetricsName
-	}
-	return ""
-}
-
-func (x *GetApplicationMetricsRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
-	return nil
-}
-
-func (x *GetApplicationMetricsRequest) GetLimitSeconds() int64 {
+func (x *GetAlertsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.LimitSeconds
+		return x.Limit
 	}
 	return 0
 }
 
-type GetOutputRequest struct {
+type GetApplicationEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// limit 0の場合は50件 最大500件
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOutputRequest) Reset() {
-	*x = GetOutputRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[64]
+func (x *GetApplicationEventsRequest) Reset() {
+	*x = GetApplicationEventsRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOutputRequest) String() string {
+func (x *GetApplicationEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOutputRequest) ProtoMessage() {}
+func (*GetApplicationEventsRequest) ProtoMessage() {}
 
-func (x *GetOutputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[64]
+func (x *GetApplicationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4530,33 +8151,33 @@ func (x *GetOutputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOutputRequest.ProtoReflect.Descriptor instead.
-func (*GetOutputRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use GetApplicationEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetOutputRequest) GetApplicationId() string {
+func (x *GetApplicationEventsRequest) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *GetOutputRequest) GetBefore() *timestamppb.Timestamp {
+func (x *GetApplicationEventsRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *GetOutputRequest) GetLimit() int32 {
+func (x *GetApplicationEventsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type GetOutputStreamRequest struct {
+type GetApplicationEventsStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 	Begin         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=begin,proto3" json:"begin,omitempty"`
@@ -4564,21 +8185,21 @@ type GetOutputStreamRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOutputStreamRequest) Reset() {
-	*x = GetOutputStreamRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[65]
+func (x *GetApplicationEventsStreamRequest) Reset() {
+	*x = GetApplicationEventsStreamRequest{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOutputStreamRequest) String() string {
+func (x *GetApplicationEventsStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOutputStreamRequest) ProtoMessage() {}
+func (*GetApplicationEventsStreamRequest) ProtoMessage() {}
 
-func (x *GetOutputStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[65]
+func (x *GetApplicationEventsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4589,19 +8210,19 @@ func (x *GetOutputStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOutputStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetOutputStreamRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetApplicationEventsStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationEventsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetOutputStreamRequest) GetApplicationId() string {
+func (x *GetApplicationEventsStreamRequest) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *GetOutputStreamRequest) GetBegin() *timestamppb.Timestamp {
+func (x *GetApplicationEventsStreamRequest) GetBegin() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Begin
 	}
@@ -4618,7 +8239,7 @@ type RetryCommitBuildRequest struct {
 
 func (x *RetryCommitBuildRequest) Reset() {
 	*x = RetryCommitBuildRequest{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[66]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4630,7 +8251,7 @@ func (x *RetryCommitBuildRequest) String() string {
 func (*RetryCommitBuildRequest) ProtoMessage() {}
 
 func (x *RetryCommitBuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[66]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4643,7 +8264,7 @@ func (x *RetryCommitBuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryCommitBuildRequest.ProtoReflect.Descriptor instead.
 func (*RetryCommitBuildRequest) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{66}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *RetryCommitBuildRequest) GetApplicationId() string {
@@ -4669,7 +8290,7 @@ type GetRepositoryRefsResponse struct {
 
 func (x *GetRepositoryRefsResponse) Reset() {
 	*x = GetRepositoryRefsResponse{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[67]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4681,7 +8302,7 @@ func (x *GetRepositoryRefsResponse) String() string {
 func (*GetRepositoryRefsResponse) ProtoMessage() {}
 
 func (x *GetRepositoryRefsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[67]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4694,7 +8315,7 @@ func (x *GetRepositoryRefsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRepositoryRefsResponse.ProtoReflect.Descriptor instead.
 func (*GetRepositoryRefsResponse) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{67}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetRepositoryRefsResponse) GetRefs() []*GitRef {
@@ -4704,6 +8325,198 @@ func (x *GetRepositoryRefsResponse) GetRefs() []*GitRef {
 	return nil
 }
 
+type WebsiteHeaderPolicy_Header struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteHeaderPolicy_Header) Reset() {
+	*x = WebsiteHeaderPolicy_Header{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteHeaderPolicy_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteHeaderPolicy_Header) ProtoMessage() {}
+
+func (x *WebsiteHeaderPolicy_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteHeaderPolicy_Header.ProtoReflect.Descriptor instead.
+func (*WebsiteHeaderPolicy_Header) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *WebsiteHeaderPolicy_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebsiteHeaderPolicy_Header) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// CORSPolicy allow_originsが空の場合はCORSを無効にします
+type WebsiteHeaderPolicy_CORSPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// allow_origins 許可するオリジン "*" で全てのオリジンを許可します
+	AllowOrigins     []string `protobuf:"bytes,1,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"`
+	AllowMethods     []string `protobuf:"bytes,2,rep,name=allow_methods,json=allowMethods,proto3" json:"allow_methods,omitempty"`
+	AllowHeaders     []string `protobuf:"bytes,3,rep,name=allow_headers,json=allowHeaders,proto3" json:"allow_headers,omitempty"`
+	ExposeHeaders    []string `protobuf:"bytes,4,rep,name=expose_headers,json=exposeHeaders,proto3" json:"expose_headers,omitempty"`
+	AllowCredentials bool     `protobuf:"varint,5,opt,name=allow_credentials,json=allowCredentials,proto3" json:"allow_credentials,omitempty"`
+	// max_age プリフライトレスポンスのキャッシュ秒数
+	MaxAge        int32 `protobuf:"varint,6,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) Reset() {
+	*x = WebsiteHeaderPolicy_CORSPolicy{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteHeaderPolicy_CORSPolicy) ProtoMessage() {}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteHeaderPolicy_CORSPolicy.ProtoReflect.Descriptor instead.
+func (*WebsiteHeaderPolicy_CORSPolicy) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) GetAllowOrigins() []string {
+	if x != nil {
+		return x.AllowOrigins
+	}
+	return nil
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) GetAllowMethods() []string {
+	if x != nil {
+		return x.AllowMethods
+	}
+	return nil
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) GetAllowHeaders() []string {
+	if x != nil {
+		return x.AllowHeaders
+	}
+	return nil
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) GetExposeHeaders() []string {
+	if x != nil {
+		return x.ExposeHeaders
+	}
+	return nil
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+func (x *WebsiteHeaderPolicy_CORSPolicy) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+type WebsiteAccessControl_BasicAuthUser struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// password (input only) 新しいパスワード 空の場合は同じユーザーの既存のパスワードを引き継ぎます
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteAccessControl_BasicAuthUser) Reset() {
+	*x = WebsiteAccessControl_BasicAuthUser{}
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteAccessControl_BasicAuthUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteAccessControl_BasicAuthUser) ProtoMessage() {}
+
+func (x *WebsiteAccessControl_BasicAuthUser) ProtoReflect() protoreflect.Message {
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteAccessControl_BasicAuthUser.ProtoReflect.Descriptor instead.
+func (*WebsiteAccessControl_BasicAuthUser) Descriptor() ([]byte, []int) {
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *WebsiteAccessControl_BasicAuthUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WebsiteAccessControl_BasicAuthUser) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 type UpdateRepositoryRequest_UpdateOwners struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerIds      []string               `protobuf:"bytes,1,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
@@ -4713,7 +8526,7 @@ type UpdateRepositoryRequest_UpdateOwners struct {
 
 func (x *UpdateRepositoryRequest_UpdateOwners) Reset() {
 	*x = UpdateRepositoryRequest_UpdateOwners{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[68]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4725,7 +8538,7 @@ func (x *UpdateRepositoryRequest_UpdateOwners) String() string {
 func (*UpdateRepositoryRequest_UpdateOwners) ProtoMessage() {}
 
 func (x *UpdateRepositoryRequest_UpdateOwners) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[68]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4738,7 +8551,7 @@ func (x *UpdateRepositoryRequest_UpdateOwners) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateRepositoryRequest_UpdateOwners.ProtoReflect.Descriptor instead.
 func (*UpdateRepositoryRequest_UpdateOwners) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{45, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{75, 0}
 }
 
 func (x *UpdateRepositoryRequest_UpdateOwners) GetOwnerIds() []string {
@@ -4757,7 +8570,7 @@ type UpdateApplicationRequest_UpdateWebsites struct {
 
 func (x *UpdateApplicationRequest_UpdateWebsites) Reset() {
 	*x = UpdateApplicationRequest_UpdateWebsites{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[69]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4769,7 +8582,7 @@ func (x *UpdateApplicationRequest_UpdateWebsites) String() string {
 func (*UpdateApplicationRequest_UpdateWebsites) ProtoMessage() {}
 
 func (x *UpdateApplicationRequest_UpdateWebsites) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[69]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4782,7 +8595,7 @@ func (x *UpdateApplicationRequest_UpdateWebsites) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UpdateApplicationRequest_UpdateWebsites.ProtoReflect.Descriptor instead.
 func (*UpdateApplicationRequest_UpdateWebsites) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{53, 0}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{84, 0}
 }
 
 func (x *UpdateApplicationRequest_UpdateWebsites) GetWebsites() []*CreateWebsiteRequest {
@@ -4801,7 +8614,7 @@ type UpdateApplicationRequest_UpdatePorts struct {
 
 func (x *UpdateApplicationRequest_UpdatePorts) Reset() {
 	*x = UpdateApplicationRequest_UpdatePorts{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[70]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4813,7 +8626,7 @@ func (x *UpdateApplicationRequest_UpdatePorts) String() string {
 func (*UpdateApplicationRequest_UpdatePorts) ProtoMessage() {}
 
 func (x *UpdateApplicationRequest_UpdatePorts) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[70]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4826,7 +8639,7 @@ func (x *UpdateApplicationRequest_UpdatePorts) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateApplicationRequest_UpdatePorts.ProtoReflect.Descriptor instead.
 func (*UpdateApplicationRequest_UpdatePorts) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{53, 1}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{84, 1}
 }
 
 func (x *UpdateApplicationRequest_UpdatePorts) GetPortPublications() []*PortPublication {
@@ -4845,7 +8658,7 @@ type UpdateApplicationRequest_UpdateOwners struct {
 
 func (x *UpdateApplicationRequest_UpdateOwners) Reset() {
 	*x = UpdateApplicationRequest_UpdateOwners{}
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[71]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4857,7 +8670,7 @@ func (x *UpdateApplicationRequest_UpdateOwners) String() string {
 func (*UpdateApplicationRequest_UpdateOwners) ProtoMessage() {}
 
 func (x *UpdateApplicationRequest_UpdateOwners) ProtoReflect() protoreflect.Message {
-	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[71]
+	mi := &file_neoshowcase_protobuf_gateway_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4870,7 +8683,7 @@ func (x *UpdateApplicationRequest_UpdateOwners) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateApplicationRequest_UpdateOwners.ProtoReflect.Descriptor instead.
 func (*UpdateApplicationRequest_UpdateOwners) Descriptor() ([]byte, []int) {
-	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{53, 2}
+	return file_neoshowcase_protobuf_gateway_proto_rawDescGZIP(), []int{84, 2}
 }
 
 func (x *UpdateApplicationRequest_UpdateOwners) GetOwnerIds() []string {
@@ -4924,7 +8737,51 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"public_key\x18\x03 \x01(\tR\tpublicKey\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf4\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\x04\n" +
+	"\fCustomDomain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12M\n" +
+	"\x06method\x18\x03 \x01(\x0e25.neoshowcase.protobuf.CustomDomain.VerificationMethodR\x06method\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12&\n" +
+	"\x0ftxt_record_name\x18\x05 \x01(\tR\rtxtRecordName\x12(\n" +
+	"\x10txt_record_value\x18\x06 \x01(\tR\x0etxtRecordValue\x12\x19\n" +
+	"\bhttp_url\x18\a \x01(\tR\ahttpUrl\x12\x1a\n" +
+	"\bverified\x18\b \x01(\bR\bverified\x12D\n" +
+	"\vverified_at\x18\t \x01(\v2#.neoshowcase.protobuf.NullTimestampR\n" +
+	"verifiedAt\x12B\n" +
+	"\n" +
+	"checked_at\x18\n" +
+	" \x01(\v2#.neoshowcase.protobuf.NullTimestampR\tcheckedAt\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"'\n" +
+	"\x12VerificationMethod\x12\a\n" +
+	"\x03DNS\x10\x00\x12\b\n" +
+	"\x04HTTP\x10\x01\"X\n" +
+	"\x18GetCustomDomainsResponse\x12<\n" +
+	"\adomains\x18\x01 \x03(\v2\".neoshowcase.protobuf.CustomDomainR\adomains\"\x98\x02\n" +
+	"\x0eTLSCertificate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04fqdn\x18\x03 \x01(\tR\x04fqdn\x129\n" +
+	"\n" +
+	"not_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tnotBefore\x127\n" +
+	"\tnot_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\x12\x1a\n" +
+	"\bexpiring\x18\x06 \x01(\bR\bexpiring\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"f\n" +
+	"\x1aGetTLSCertificatesResponse\x12H\n" +
+	"\fcertificates\x18\x01 \x03(\v2$.neoshowcase.protobuf.TLSCertificateR\fcertificates\"\xcd\x01\n" +
+	"\rResourceQuota\x12)\n" +
+	"\x10max_applications\x18\x01 \x01(\x05R\x0fmaxApplications\x128\n" +
+	"\x18max_running_applications\x18\x02 \x01(\x05R\x16maxRunningApplications\x122\n" +
+	"\x15max_port_publications\x18\x03 \x01(\x05R\x13maxPortPublications\x12#\n" +
+	"\rmax_databases\x18\x04 \x01(\x05R\fmaxDatabases\"\xb1\x01\n" +
+	"\rResourceUsage\x12\"\n" +
+	"\fapplications\x18\x01 \x01(\x05R\fapplications\x121\n" +
+	"\x14running_applications\x18\x02 \x01(\x05R\x13runningApplications\x12+\n" +
+	"\x11port_publications\x18\x03 \x01(\x05R\x10portPublications\x12\x1c\n" +
+	"\tdatabases\x18\x04 \x01(\x05R\tdatabases\"\xf4\x01\n" +
 	"\n" +
 	"Repository\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -4974,10 +8831,14 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x1cBuildConfigRuntimeDockerfile\x12J\n" +
 	"\x0eruntime_config\x18\x01 \x01(\v2#.neoshowcase.protobuf.RuntimeConfigR\rruntimeConfig\x12'\n" +
 	"\x0fdockerfile_name\x18\x02 \x01(\tR\x0edockerfileName\x12\x18\n" +
-	"\acontext\x18\x03 \x01(\tR\acontext\"E\n" +
+	"\acontext\x18\x03 \x01(\tR\acontext\"\xef\x01\n" +
 	"\fStaticConfig\x12#\n" +
 	"\rartifact_path\x18\x01 \x01(\tR\fartifactPath\x12\x10\n" +
-	"\x03spa\x18\x02 \x01(\bR\x03spa\"\x7f\n" +
+	"\x03spa\x18\x02 \x01(\bR\x03spa\x12$\n" +
+	"\x0enot_found_path\x18\x03 \x01(\tR\fnotFoundPath\x12.\n" +
+	"\x13asset_cache_control\x18\x04 \x01(\tR\x11assetCacheControl\x12,\n" +
+	"\x12html_cache_control\x18\x05 \x01(\tR\x10htmlCacheControl\x12$\n" +
+	"\rprecompressed\x18\x06 \x01(\bR\rprecompressed\"\x7f\n" +
 	"\x1aBuildConfigStaticBuildpack\x12G\n" +
 	"\rstatic_config\x18\x01 \x01(\v2\".neoshowcase.protobuf.StaticConfigR\fstaticConfig\x12\x18\n" +
 	"\acontext\x18\x02 \x01(\tR\acontext\"\x9b\x01\n" +
@@ -4999,7 +8860,7 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\n" +
 	"static_cmd\x18\x05 \x01(\v2*.neoshowcase.protobuf.BuildConfigStaticCmdH\x00R\tstaticCmd\x12`\n" +
 	"\x11static_dockerfile\x18\x06 \x01(\v21.neoshowcase.protobuf.BuildConfigStaticDockerfileH\x00R\x10staticDockerfileB\x0e\n" +
-	"\fbuild_config\"\x88\x02\n" +
+	"\fbuild_config\"\xed\x04\n" +
 	"\aWebsite\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04fqdn\x18\x02 \x01(\tR\x04fqdn\x12\x1f\n" +
@@ -5009,11 +8870,60 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x05https\x18\x05 \x01(\bR\x05https\x12\x10\n" +
 	"\x03h2c\x18\x06 \x01(\bR\x03h2c\x12\x1b\n" +
 	"\thttp_port\x18\a \x01(\x05R\bhttpPort\x12P\n" +
-	"\x0eauthentication\x18\b \x01(\x0e2(.neoshowcase.protobuf.AuthenticationTypeR\x0eauthentication\"\xac\x01\n" +
+	"\x0eauthentication\x18\b \x01(\x0e2(.neoshowcase.protobuf.AuthenticationTypeR\x0eauthentication\x127\n" +
+	"\x05rules\x18\t \x03(\v2!.neoshowcase.protobuf.WebsiteRuleR\x05rules\x12N\n" +
+	"\rheader_policy\x18\n" +
+	" \x01(\v2).neoshowcase.protobuf.WebsiteHeaderPolicyR\fheaderPolicy\x12Q\n" +
+	"\x0eaccess_control\x18\v \x01(\v2*.neoshowcase.protobuf.WebsiteAccessControlR\raccessControl\x12E\n" +
+	"\n" +
+	"rate_limit\x18\f \x01(\v2&.neoshowcase.protobuf.WebsiteRateLimitR\trateLimit\x12@\n" +
+	"\bbackends\x18\r \x03(\v2$.neoshowcase.protobuf.WebsiteBackendR\bbackends\"O\n" +
+	"\x0eWebsiteBackend\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x05R\x06weight\"\xeb\x01\n" +
+	"\vWebsiteRule\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.neoshowcase.protobuf.WebsiteRule.TypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"path_regex\x18\x02 \x01(\tR\tpathRegex\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x1f\n" +
+	"\vstatus_code\x18\x04 \x01(\x05R\n" +
+	"statusCode\x12%\n" +
+	"\x0epreserve_query\x18\x05 \x01(\bR\rpreserveQuery\"!\n" +
+	"\x04Type\x12\f\n" +
+	"\bREDIRECT\x10\x00\x12\v\n" +
+	"\aREWRITE\x10\x01\"\xdb\x03\n" +
+	"\x13WebsiteHeaderPolicy\x12[\n" +
+	"\x10response_headers\x18\x01 \x03(\v20.neoshowcase.protobuf.WebsiteHeaderPolicy.HeaderR\x0fresponseHeaders\x12H\n" +
+	"\x04cors\x18\x02 \x01(\v24.neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicyR\x04cors\x1a2\n" +
+	"\x06Header\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x1a\xe8\x01\n" +
+	"\n" +
+	"CORSPolicy\x12#\n" +
+	"\rallow_origins\x18\x01 \x03(\tR\fallowOrigins\x12#\n" +
+	"\rallow_methods\x18\x02 \x03(\tR\fallowMethods\x12#\n" +
+	"\rallow_headers\x18\x03 \x03(\tR\fallowHeaders\x12%\n" +
+	"\x0eexpose_headers\x18\x04 \x03(\tR\rexposeHeaders\x12+\n" +
+	"\x11allow_credentials\x18\x05 \x01(\bR\x10allowCredentials\x12\x17\n" +
+	"\amax_age\x18\x06 \x01(\x05R\x06maxAge\"\xe7\x01\n" +
+	"\x14WebsiteAccessControl\x12\"\n" +
+	"\rip_allow_list\x18\x01 \x03(\tR\vipAllowList\x12b\n" +
+	"\x10basic_auth_users\x18\x02 \x03(\v28.neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUserR\x0ebasicAuthUsers\x1aG\n" +
+	"\rBasicAuthUser\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x81\x01\n" +
+	"\x10WebsiteRateLimit\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aaverage\x18\x02 \x01(\x05R\aaverage\x12\x14\n" +
+	"\x05burst\x18\x03 \x01(\x05R\x05burst\x12#\n" +
+	"\rsource_header\x18\x04 \x01(\tR\fsourceHeader\"\xac\x01\n" +
 	"\x0fPortPublication\x12#\n" +
 	"\rinternet_port\x18\x01 \x01(\x05R\finternetPort\x12)\n" +
 	"\x10application_port\x18\x02 \x01(\x05R\x0fapplicationPort\x12I\n" +
-	"\bprotocol\x18\x03 \x01(\x0e2-.neoshowcase.protobuf.PortPublicationProtocolR\bprotocol\"\xcb\a\n" +
+	"\bprotocol\x18\x03 \x01(\x0e2-.neoshowcase.protobuf.PortPublicationProtocolR\bprotocol\"M\n" +
+	"\x0fInternalService\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\x12&\n" +
+	"\x0fallowed_app_ids\x18\x02 \x03(\tR\rallowedAppIds\"\xf3\b\n" +
 	"\vApplication\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -5035,7 +8945,10 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\bwebsites\x18\x0e \x03(\v2\x1d.neoshowcase.protobuf.WebsiteR\bwebsites\x12R\n" +
 	"\x11port_publications\x18\x0f \x03(\v2%.neoshowcase.protobuf.PortPublicationR\x10portPublications\x12\x1b\n" +
 	"\towner_ids\x18\x10 \x03(\tR\bownerIds\x12V\n" +
-	"\x13latest_build_status\x18\x11 \x01(\x0e2!.neoshowcase.protobuf.BuildStatusH\x00R\x11latestBuildStatus\x88\x01\x01\"n\n" +
+	"\x13latest_build_status\x18\x11 \x01(\x0e2!.neoshowcase.protobuf.BuildStatusH\x00R\x11latestBuildStatus\x88\x01\x01\x12(\n" +
+	"\x10config_file_path\x18\x12 \x01(\tR\x0econfigFilePath\x12*\n" +
+	"\x11config_file_error\x18\x13 \x01(\tR\x0fconfigFileError\x12P\n" +
+	"\x10internal_service\x18\x14 \x01(\v2%.neoshowcase.protobuf.InternalServiceR\x0finternalService\"n\n" +
 	"\x0eContainerState\x12\v\n" +
 	"\aMISSING\x10\x00\x12\f\n" +
 	"\bSTARTING\x10\x01\x12\x0e\n" +
@@ -5083,7 +8996,114 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x10\n" +
 	"\x03log\x18\x02 \x01(\tR\x03log\"W\n" +
 	"\x12ApplicationOutputs\x12A\n" +
-	"\aoutputs\x18\x01 \x03(\v2'.neoshowcase.protobuf.ApplicationOutputR\aoutputs\"\xd4\x04\n" +
+	"\aoutputs\x18\x01 \x03(\v2'.neoshowcase.protobuf.ApplicationOutputR\aoutputs\"\x96\x03\n" +
+	"\x10ApplicationEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12?\n" +
+	"\x04type\x18\x03 \x01(\x0e2+.neoshowcase.protobuf.ApplicationEvent.TypeR\x04type\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"~\n" +
+	"\x04Type\x12\x13\n" +
+	"\x0fCONTAINER_STATE\x10\x00\x12\x11\n" +
+	"\rBUILD_STARTED\x10\x01\x12\x12\n" +
+	"\x0eBUILD_FINISHED\x10\x02\x12\f\n" +
+	"\bDEPLOYED\x10\x03\x12\x12\n" +
+	"\x0eCONFIG_CHANGED\x10\x04\x12\v\n" +
+	"\aSTARTED\x10\x05\x12\v\n" +
+	"\aSTOPPED\x10\x06\"S\n" +
+	"\x11ApplicationEvents\x12>\n" +
+	"\x06events\x18\x01 \x03(\v2&.neoshowcase.protobuf.ApplicationEventR\x06events\"\xfa\x01\n" +
+	"\fWebsiteProbe\x129\n" +
+	"\n" +
+	"checked_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12\x0e\n" +
+	"\x02up\x18\x02 \x01(\bR\x02up\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x04 \x01(\x03R\tlatencyMs\x12I\n" +
+	"\x0etls_expires_at\x18\x05 \x01(\v2#.neoshowcase.protobuf.NullTimestampR\ftlsExpiresAt\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x81\x01\n" +
+	"\rWebsiteUptime\x12%\n" +
+	"\x0ewindow_seconds\x18\x01 \x01(\x03R\rwindowSeconds\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x0e\n" +
+	"\x02up\x18\x03 \x01(\x05R\x02up\x12\x19\n" +
+	"\x05ratio\x18\x04 \x01(\x01H\x00R\x05ratio\x88\x01\x01B\b\n" +
+	"\x06_ratio\"\xfa\x01\n" +
+	"\rWebsiteStatus\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x127\n" +
+	"\awebsite\x18\x02 \x01(\v2\x1d.neoshowcase.protobuf.WebsiteR\awebsite\x12?\n" +
+	"\x06latest\x18\x03 \x01(\v2\".neoshowcase.protobuf.WebsiteProbeH\x00R\x06latest\x88\x01\x01\x12=\n" +
+	"\auptimes\x18\x04 \x03(\v2#.neoshowcase.protobuf.WebsiteUptimeR\auptimesB\t\n" +
+	"\a_latest\"R\n" +
+	"\x0fWebsiteStatuses\x12?\n" +
+	"\bstatuses\x18\x01 \x03(\v2#.neoshowcase.protobuf.WebsiteStatusR\bstatuses\"\xc1\x04\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x128\n" +
+	"\x04kind\x18\x04 \x01(\x0e2$.neoshowcase.protobuf.AlertRule.KindR\x04kind\x12\x16\n" +
+	"\x06metric\x18\x05 \x01(\tR\x06metric\x12J\n" +
+	"\n" +
+	"comparison\x18\x06 \x01(\x0e2*.neoshowcase.protobuf.AlertRule.ComparisonR\n" +
+	"comparison\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x01R\tthreshold\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\x03R\x0fdurationSeconds\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12T\n" +
+	"\x0ethreshold_unit\x18\n" +
+	" \x01(\x0e2-.neoshowcase.protobuf.AlertRule.ThresholdUnitR\rthresholdUnit\"\x1e\n" +
+	"\x04Kind\x12\n" +
+	"\n" +
+	"\x06METRIC\x10\x00\x12\n" +
+	"\n" +
+	"\x06NO_LOG\x10\x01\"\"\n" +
+	"\n" +
+	"Comparison\x12\t\n" +
+	"\x05ABOVE\x10\x00\x12\t\n" +
+	"\x05BELOW\x10\x01\"-\n" +
+	"\rThresholdUnit\x12\t\n" +
+	"\x05VALUE\x10\x00\x12\x11\n" +
+	"\rLIMIT_PERCENT\x10\x01\"C\n" +
+	"\n" +
+	"AlertRules\x125\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1f.neoshowcase.protobuf.AlertRuleR\x05rules\"\xee\x01\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12%\n" +
+	"\x0eapplication_id\x18\x03 \x01(\tR\rapplicationId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x125\n" +
+	"\bfired_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\afiredAt\x12D\n" +
+	"\vresolved_at\x18\x06 \x01(\v2#.neoshowcase.protobuf.NullTimestampR\n" +
+	"resolvedAt\"=\n" +
+	"\x06Alerts\x123\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x1b.neoshowcase.protobuf.AlertR\x06alerts\"\x86\x04\n" +
+	"\x18NotificationSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12G\n" +
+	"\x04sink\x18\x03 \x01(\x0e23.neoshowcase.protobuf.NotificationSubscription.SinkR\x04sink\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"has_secret\x18\x05 \x01(\bR\thasSecret\x12L\n" +
+	"\x06events\x18\x06 \x03(\x0e24.neoshowcase.protobuf.NotificationSubscription.EventR\x06events\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x04Sink\x12\v\n" +
+	"\aWEBHOOK\x10\x00\x12\t\n" +
+	"\x05SLACK\x10\x01\x12\b\n" +
+	"\x04TRAQ\x10\x02\x12\t\n" +
+	"\x05EMAIL\x10\x03\"u\n" +
+	"\x05Event\x12\x10\n" +
+	"\fBUILD_FAILED\x10\x00\x12\x13\n" +
+	"\x0fBUILD_SUCCEEDED\x10\x01\x12\f\n" +
+	"\bDEPLOYED\x10\x02\x12\x15\n" +
+	"\x11CONTAINER_ERRORED\x10\x03\x12\x15\n" +
+	"\x11CERTIFICATE_ERROR\x10\x04\x12\t\n" +
+	"\x05ALERT\x10\x05\"q\n" +
+	"\x19NotificationSubscriptions\x12T\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2..neoshowcase.protobuf.NotificationSubscriptionR\rsubscriptions\"\xd4\x04\n" +
 	"\x05Build\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x16\n" +
@@ -5119,7 +9139,27 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"-\n" +
 	"\x14DeleteUserKeyRequest\x12\x15\n" +
-	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"S\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"\x82\x01\n" +
+	"\x19CreateCustomDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12M\n" +
+	"\x06method\x18\x02 \x01(\x0e25.neoshowcase.protobuf.CustomDomain.VerificationMethodR\x06method\"4\n" +
+	"\x15CustomDomainIdRequest\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\tR\bdomainId\"t\n" +
+	"\x1bUploadTLSCertificateRequest\x12\x12\n" +
+	"\x04fqdn\x18\x01 \x01(\tR\x04fqdn\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\tR\vcertificate\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\"@\n" +
+	"\x17TLSCertificateIdRequest\x12%\n" +
+	"\x0ecertificate_id\x18\x01 \x01(\tR\rcertificateId\"\xad\x01\n" +
+	"\x12GetMyUsageResponse\x129\n" +
+	"\x05quota\x18\x01 \x01(\v2#.neoshowcase.protobuf.ResourceQuotaR\x05quota\x129\n" +
+	"\x05usage\x18\x02 \x01(\v2#.neoshowcase.protobuf.ResourceUsageR\x05usage\x12!\n" +
+	"\fcustom_quota\x18\x03 \x01(\bR\vcustomQuota\"x\n" +
+	"\x13SetUserQuotaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12>\n" +
+	"\x05quota\x18\x02 \x01(\v2#.neoshowcase.protobuf.ResourceQuotaH\x00R\x05quota\x88\x01\x01B\b\n" +
+	"\x06_quota\"S\n" +
 	"\x19CreateRepositoryAuthBasic\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"0\n" +
@@ -5160,7 +9200,7 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x1bGetRepositoryCommitsRequest\x12\x16\n" +
 	"\x06hashes\x18\x01 \x03(\tR\x06hashes\"\\\n" +
 	"\x1cGetRepositoryCommitsResponse\x12<\n" +
-	"\acommits\x18\x01 \x03(\v2\".neoshowcase.protobuf.SimpleCommitR\acommits\"\x85\x02\n" +
+	"\acommits\x18\x01 \x03(\v2\".neoshowcase.protobuf.SimpleCommitR\acommits\"\xea\x04\n" +
 	"\x14CreateWebsiteRequest\x12\x12\n" +
 	"\x04fqdn\x18\x01 \x01(\tR\x04fqdn\x12\x1f\n" +
 	"\vpath_prefix\x18\x02 \x01(\tR\n" +
@@ -5169,9 +9209,16 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x05https\x18\x04 \x01(\bR\x05https\x12\x10\n" +
 	"\x03h2c\x18\x05 \x01(\bR\x03h2c\x12\x1b\n" +
 	"\thttp_port\x18\x06 \x01(\x05R\bhttpPort\x12P\n" +
-	"\x0eauthentication\x18\a \x01(\x0e2(.neoshowcase.protobuf.AuthenticationTypeR\x0eauthentication\"&\n" +
+	"\x0eauthentication\x18\a \x01(\x0e2(.neoshowcase.protobuf.AuthenticationTypeR\x0eauthentication\x127\n" +
+	"\x05rules\x18\b \x03(\v2!.neoshowcase.protobuf.WebsiteRuleR\x05rules\x12N\n" +
+	"\rheader_policy\x18\t \x01(\v2).neoshowcase.protobuf.WebsiteHeaderPolicyR\fheaderPolicy\x12Q\n" +
+	"\x0eaccess_control\x18\n" +
+	" \x01(\v2*.neoshowcase.protobuf.WebsiteAccessControlR\raccessControl\x12E\n" +
+	"\n" +
+	"rate_limit\x18\v \x01(\v2&.neoshowcase.protobuf.WebsiteRateLimitR\trateLimit\x12@\n" +
+	"\bbackends\x18\f \x03(\v2$.neoshowcase.protobuf.WebsiteBackendR\bbackends\"&\n" +
 	"\x14DeleteWebsiteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xf3\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xef\x03\n" +
 	"\x18CreateApplicationRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
 	"\rrepository_id\x18\x02 \x01(\tR\frepositoryId\x12\x19\n" +
@@ -5179,7 +9226,18 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x06config\x18\x04 \x01(\v2'.neoshowcase.protobuf.ApplicationConfigR\x06config\x12F\n" +
 	"\bwebsites\x18\x05 \x03(\v2*.neoshowcase.protobuf.CreateWebsiteRequestR\bwebsites\x12R\n" +
 	"\x11port_publications\x18\x06 \x03(\v2%.neoshowcase.protobuf.PortPublicationR\x10portPublications\x12&\n" +
-	"\x0fstart_on_create\x18\a \x01(\bR\rstartOnCreate\"\xca\x01\n" +
+	"\x0fstart_on_create\x18\a \x01(\bR\rstartOnCreate\x12(\n" +
+	"\x10config_file_path\x18\b \x01(\tR\x0econfigFilePath\x12P\n" +
+	"\x10internal_service\x18\t \x01(\v2%.neoshowcase.protobuf.InternalServiceR\x0finternalService\"\x9a\x02\n" +
+	"\x1bDuplicateApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bref_name\x18\x03 \x01(\tR\arefName\x12#\n" +
+	"\rwebsite_fqdns\x18\x04 \x03(\tR\fwebsiteFqdns\x12%\n" +
+	"\x0einternet_ports\x18\x05 \x03(\x05R\rinternetPorts\x12&\n" +
+	"\x0fstart_on_create\x18\x06 \x01(\bR\rstartOnCreate\x121\n" +
+	"\x12exclude_system_env\x18\a \x01(\bH\x00R\x10excludeSystemEnv\x88\x01\x01B\x15\n" +
+	"\x13_exclude_system_env\"\xca\x01\n" +
 	"\x16GetApplicationsRequest\x12H\n" +
 	"\x05scope\x18\x01 \x01(\x0e22.neoshowcase.protobuf.GetApplicationsRequest.ScopeR\x05scope\x12(\n" +
 	"\rrepository_id\x18\x02 \x01(\tH\x00R\frepositoryId\x88\x01\x01\"*\n" +
@@ -5188,7 +9246,7 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x03ALL\x10\x01\x12\x0e\n" +
 	"\n" +
 	"REPOSITORY\x10\x02B\x10\n" +
-	"\x0e_repository_id\"\x98\x06\n" +
+	"\x0e_repository_id\"\xc8\a\n" +
 	"\x18UpdateApplicationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1e\n" +
@@ -5196,7 +9254,10 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x06config\x18\x05 \x01(\v2'.neoshowcase.protobuf.ApplicationConfigH\x02R\x06config\x88\x01\x01\x12^\n" +
 	"\bwebsites\x18\x06 \x01(\v2=.neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsitesH\x03R\bwebsites\x88\x01\x01\x12l\n" +
 	"\x11port_publications\x18\a \x01(\v2:.neoshowcase.protobuf.UpdateApplicationRequest.UpdatePortsH\x04R\x10portPublications\x88\x01\x01\x12]\n" +
-	"\towner_ids\x18\b \x01(\v2;.neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwnersH\x05R\bownerIds\x88\x01\x01\x1aX\n" +
+	"\towner_ids\x18\b \x01(\v2;.neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwnersH\x05R\bownerIds\x88\x01\x01\x12-\n" +
+	"\x10config_file_path\x18\t \x01(\tH\x06R\x0econfigFilePath\x88\x01\x01\x12U\n" +
+	"\x10internal_service\x18\n" +
+	" \x01(\v2%.neoshowcase.protobuf.InternalServiceH\aR\x0finternalService\x88\x01\x01\x1aX\n" +
 	"\x0eUpdateWebsites\x12F\n" +
 	"\bwebsites\x18\x01 \x03(\v2*.neoshowcase.protobuf.CreateWebsiteRequestR\bwebsites\x1aa\n" +
 	"\vUpdatePorts\x12R\n" +
@@ -5209,7 +9270,30 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\t_websitesB\x14\n" +
 	"\x12_port_publicationsB\f\n" +
 	"\n" +
-	"_owner_idsJ\x04\b\x03\x10\x04\"_\n" +
+	"_owner_idsB\x13\n" +
+	"\x11_config_file_pathB\x13\n" +
+	"\x11_internal_serviceJ\x04\b\x03\x10\x04\"\x82\x01\n" +
+	"\x19ExportApplicationsRequest\x12'\n" +
+	"\x0fapplication_ids\x18\x01 \x03(\tR\x0eapplicationIds\x12<\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.neoshowcase.protobuf.ManifestFormatR\x06format\"8\n" +
+	"\x1aExportApplicationsResponse\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\"K\n" +
+	"\x14ApplyManifestRequest\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"W\n" +
+	"\x11ManifestFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xc5\x01\n" +
+	"\x19ManifestApplicationResult\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06create\x18\x03 \x01(\bR\x06create\x12=\n" +
+	"\x05diffs\x18\x04 \x03(\v2'.neoshowcase.protobuf.ManifestFieldDiffR\x05diffs\x12\x16\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\"|\n" +
+	"\x15ApplyManifestResponse\x12I\n" +
+	"\aresults\x18\x01 \x03(\v2/.neoshowcase.protobuf.ManifestApplicationResultR\aresults\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\"_\n" +
 	"\x17GetRepositoriesResponse\x12D\n" +
 	"\frepositories\x18\x01 \x03(\v2 .neoshowcase.protobuf.RepositoryR\frepositories\"`\n" +
 	"\x17GetApplicationsResponse\x12E\n" +
@@ -5237,13 +9321,58 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12!\n" +
 	"\fmetrics_name\x18\x02 \x01(\tR\vmetricsName\x122\n" +
 	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12#\n" +
-	"\rlimit_seconds\x18\x04 \x01(\x03R\flimitSeconds\"\x83\x01\n" +
+	"\rlimit_seconds\x18\x04 \x01(\x03R\flimitSeconds\"\xaa\x01\n" +
+	"\tLogFilter\x12\x1a\n" +
+	"\bcontains\x18\x01 \x01(\tR\bcontains\x12\x16\n" +
+	"\x06regexp\x18\x02 \x01(\tR\x06regexp\x12>\n" +
+	"\x06stream\x18\x03 \x01(\x0e2&.neoshowcase.protobuf.LogFilter.StreamR\x06stream\")\n" +
+	"\x06Stream\x12\a\n" +
+	"\x03ALL\x10\x00\x12\n" +
+	"\n" +
+	"\x06STDOUT\x10\x01\x12\n" +
+	"\n" +
+	"\x06STDERR\x10\x02\"\xbc\x01\n" +
 	"\x10GetOutputRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x122\n" +
 	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"q\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x127\n" +
+	"\x06filter\x18\x04 \x01(\v2\x1f.neoshowcase.protobuf.LogFilterR\x06filter\"\xaa\x01\n" +
 	"\x16GetOutputStreamRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x120\n" +
+	"\x05begin\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05begin\x127\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1f.neoshowcase.protobuf.LogFilterR\x06filter\"\x90\x03\n" +
+	"\x16CreateAlertRuleRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x128\n" +
+	"\x04kind\x18\x03 \x01(\x0e2$.neoshowcase.protobuf.AlertRule.KindR\x04kind\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\tR\x06metric\x12J\n" +
+	"\n" +
+	"comparison\x18\x05 \x01(\x0e2*.neoshowcase.protobuf.AlertRule.ComparisonR\n" +
+	"comparison\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12)\n" +
+	"\x10duration_seconds\x18\a \x01(\x03R\x0fdurationSeconds\x12T\n" +
+	"\x0ethreshold_unit\x18\b \x01(\x0e2-.neoshowcase.protobuf.AlertRule.ThresholdUnitR\rthresholdUnit\"X\n" +
+	"\x16DeleteAlertRuleRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"\x95\x02\n" +
+	"%CreateNotificationSubscriptionRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12G\n" +
+	"\x04sink\x18\x02 \x01(\x0e23.neoshowcase.protobuf.NotificationSubscription.SinkR\x04sink\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12L\n" +
+	"\x06events\x18\x05 \x03(\x0e24.neoshowcase.protobuf.NotificationSubscription.EventR\x06events\"w\n" +
+	"%DeleteNotificationSubscriptionRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\"O\n" +
+	"\x10GetAlertsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8e\x01\n" +
+	"\x1bGetApplicationEventsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"|\n" +
+	"!GetApplicationEventsStreamRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x120\n" +
 	"\x05begin\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05begin\"X\n" +
 	"\x17RetryCommitBuildRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
@@ -5270,7 +9399,10 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\n" +
 	"\x06FAILED\x10\x03\x12\r\n" +
 	"\tCANCELLED\x10\x04\x12\v\n" +
-	"\aSKIPPED\x10\x052\xee\x1b\n" +
+	"\aSKIPPED\x10\x05*$\n" +
+	"\x0eManifestFormat\x12\b\n" +
+	"\x04YAML\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x012\x96.\n" +
 	"\n" +
 	"APIService\x12N\n" +
 	"\rGetSystemInfo\x12\x16.google.protobuf.Empty\x1a .neoshowcase.protobuf.SystemInfo\"\x03\x90\x02\x01\x12X\n" +
@@ -5279,7 +9411,17 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\bGetUsers\x12\x16.google.protobuf.Empty\x1a&.neoshowcase.protobuf.GetUsersResponse\"\x03\x90\x02\x01\x12Z\n" +
 	"\rCreateUserKey\x12*.neoshowcase.protobuf.CreateUserKeyRequest\x1a\x1d.neoshowcase.protobuf.UserKey\x12U\n" +
 	"\vGetUserKeys\x12\x16.google.protobuf.Empty\x1a).neoshowcase.protobuf.GetUserKeysResponse\"\x03\x90\x02\x01\x12S\n" +
-	"\rDeleteUserKey\x12*.neoshowcase.protobuf.DeleteUserKeyRequest\x1a\x16.google.protobuf.Empty\x12c\n" +
+	"\rDeleteUserKey\x12*.neoshowcase.protobuf.DeleteUserKeyRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\n" +
+	"GetMyUsage\x12\x16.google.protobuf.Empty\x1a(.neoshowcase.protobuf.GetMyUsageResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\fSetUserQuota\x12).neoshowcase.protobuf.SetUserQuotaRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x10GetCustomDomains\x12\x16.google.protobuf.Empty\x1a..neoshowcase.protobuf.GetCustomDomainsResponse\"\x03\x90\x02\x01\x12i\n" +
+	"\x12CreateCustomDomain\x12/.neoshowcase.protobuf.CreateCustomDomainRequest\x1a\".neoshowcase.protobuf.CustomDomain\x12e\n" +
+	"\x12VerifyCustomDomain\x12+.neoshowcase.protobuf.CustomDomainIdRequest\x1a\".neoshowcase.protobuf.CustomDomain\x12Y\n" +
+	"\x12DeleteCustomDomain\x12+.neoshowcase.protobuf.CustomDomainIdRequest\x1a\x16.google.protobuf.Empty\x12c\n" +
+	"\x12GetTLSCertificates\x12\x16.google.protobuf.Empty\x1a0.neoshowcase.protobuf.GetTLSCertificatesResponse\"\x03\x90\x02\x01\x12o\n" +
+	"\x14UploadTLSCertificate\x121.neoshowcase.protobuf.UploadTLSCertificateRequest\x1a$.neoshowcase.protobuf.TLSCertificate\x12]\n" +
+	"\x14DeleteTLSCertificate\x12-.neoshowcase.protobuf.TLSCertificateIdRequest\x1a\x16.google.protobuf.Empty\x12c\n" +
 	"\x10CreateRepository\x12-.neoshowcase.protobuf.CreateRepositoryRequest\x1a .neoshowcase.protobuf.Repository\x12s\n" +
 	"\x0fGetRepositories\x12,.neoshowcase.protobuf.GetRepositoriesRequest\x1a-.neoshowcase.protobuf.GetRepositoriesResponse\"\x03\x90\x02\x01\x12\x82\x01\n" +
 	"\x14GetRepositoryCommits\x121.neoshowcase.protobuf.GetRepositoryCommitsRequest\x1a2.neoshowcase.protobuf.GetRepositoryCommitsResponse\"\x03\x90\x02\x01\x12a\n" +
@@ -5288,15 +9430,28 @@ const file_neoshowcase_protobuf_gateway_proto_rawDesc = "" +
 	"\x10UpdateRepository\x12-.neoshowcase.protobuf.UpdateRepositoryRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
 	"\x11RefreshRepository\x12).neoshowcase.protobuf.RepositoryIdRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
 	"\x10DeleteRepository\x12).neoshowcase.protobuf.RepositoryIdRequest\x1a\x16.google.protobuf.Empty\x12f\n" +
-	"\x11CreateApplication\x12..neoshowcase.protobuf.CreateApplicationRequest\x1a!.neoshowcase.protobuf.Application\x12s\n" +
+	"\x11CreateApplication\x12..neoshowcase.protobuf.CreateApplicationRequest\x1a!.neoshowcase.protobuf.Application\x12l\n" +
+	"\x14DuplicateApplication\x121.neoshowcase.protobuf.DuplicateApplicationRequest\x1a!.neoshowcase.protobuf.Application\x12s\n" +
 	"\x0fGetApplications\x12,.neoshowcase.protobuf.GetApplicationsRequest\x1a-.neoshowcase.protobuf.GetApplicationsResponse\"\x03\x90\x02\x01\x12d\n" +
 	"\x0eGetApplication\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a!.neoshowcase.protobuf.Application\"\x03\x90\x02\x01\x12[\n" +
 	"\x11UpdateApplication\x12..neoshowcase.protobuf.UpdateApplicationRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
-	"\x11DeleteApplication\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\x11DeleteApplication\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a\x16.google.protobuf.Empty\x12|\n" +
+	"\x12ExportApplications\x12/.neoshowcase.protobuf.ExportApplicationsRequest\x1a0.neoshowcase.protobuf.ExportApplicationsResponse\"\x03\x90\x02\x01\x12h\n" +
+	"\rApplyManifest\x12*.neoshowcase.protobuf.ApplyManifestRequest\x1a+.neoshowcase.protobuf.ApplyManifestResponse\x12Z\n" +
 	"\x13GetAvailableMetrics\x12\x16.google.protobuf.Empty\x1a&.neoshowcase.protobuf.AvailableMetrics\"\x03\x90\x02\x01\x12z\n" +
 	"\x15GetApplicationMetrics\x122.neoshowcase.protobuf.GetApplicationMetricsRequest\x1a(.neoshowcase.protobuf.ApplicationMetrics\"\x03\x90\x02\x01\x12b\n" +
 	"\tGetOutput\x12&.neoshowcase.protobuf.GetOutputRequest\x1a(.neoshowcase.protobuf.ApplicationOutputs\"\x03\x90\x02\x01\x12j\n" +
-	"\x0fGetOutputStream\x12,.neoshowcase.protobuf.GetOutputStreamRequest\x1a'.neoshowcase.protobuf.ApplicationOutput0\x01\x12g\n" +
+	"\x0fGetOutputStream\x12,.neoshowcase.protobuf.GetOutputStreamRequest\x1a'.neoshowcase.protobuf.ApplicationOutput0\x01\x12w\n" +
+	"\x14GetApplicationEvents\x121.neoshowcase.protobuf.GetApplicationEventsRequest\x1a'.neoshowcase.protobuf.ApplicationEvents\"\x03\x90\x02\x01\x12\x7f\n" +
+	"\x1aGetApplicationEventsStream\x127.neoshowcase.protobuf.GetApplicationEventsStreamRequest\x1a&.neoshowcase.protobuf.ApplicationEvent0\x01\x12j\n" +
+	"\x10GetWebsiteStatus\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a%.neoshowcase.protobuf.WebsiteStatuses\"\x03\x90\x02\x01\x12b\n" +
+	"\rGetAlertRules\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a .neoshowcase.protobuf.AlertRules\"\x03\x90\x02\x01\x12`\n" +
+	"\x0fCreateAlertRule\x12,.neoshowcase.protobuf.CreateAlertRuleRequest\x1a\x1f.neoshowcase.protobuf.AlertRule\x12W\n" +
+	"\x0fDeleteAlertRule\x12,.neoshowcase.protobuf.DeleteAlertRuleRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\tGetAlerts\x12&.neoshowcase.protobuf.GetAlertsRequest\x1a\x1c.neoshowcase.protobuf.Alerts\"\x03\x90\x02\x01\x12\x80\x01\n" +
+	"\x1cGetNotificationSubscriptions\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a/.neoshowcase.protobuf.NotificationSubscriptions\"\x03\x90\x02\x01\x12\x8d\x01\n" +
+	"\x1eCreateNotificationSubscription\x12;.neoshowcase.protobuf.CreateNotificationSubscriptionRequest\x1a..neoshowcase.protobuf.NotificationSubscription\x12u\n" +
+	"\x1eDeleteNotificationSubscription\x12;.neoshowcase.protobuf.DeleteNotificationSubscriptionRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
 	"\n" +
 	"GetEnvVars\x12*.neoshowcase.protobuf.ApplicationIdRequest\x1a(.neoshowcase.protobuf.ApplicationEnvVars\"\x03\x90\x02\x01\x12V\n" +
 	"\tSetEnvVar\x121.neoshowcase.protobuf.SetApplicationEnvVarRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
@@ -5325,249 +9480,415 @@ func file_neoshowcase_protobuf_gateway_proto_rawDescGZIP() []byte {
 	return file_neoshowcase_protobuf_gateway_proto_rawDescData
 }
 
-var file_neoshowcase_protobuf_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_neoshowcase_protobuf_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_neoshowcase_protobuf_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
+var file_neoshowcase_protobuf_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 120)
 var file_neoshowcase_protobuf_gateway_proto_goTypes = []any{
 	(DeployType)(0),                                 // 0: neoshowcase.protobuf.DeployType
 	(AuthenticationType)(0),                         // 1: neoshowcase.protobuf.AuthenticationType
 	(PortPublicationProtocol)(0),                    // 2: neoshowcase.protobuf.PortPublicationProtocol
 	(BuildStatus)(0),                                // 3: neoshowcase.protobuf.BuildStatus
-	(Repository_AuthMethod)(0),                      // 4: neoshowcase.protobuf.Repository.AuthMethod
-	(AutoShutdownConfig_StartupBehavior)(0),         // 5: neoshowcase.protobuf.AutoShutdownConfig.StartupBehavior
-	(Application_ContainerState)(0),                 // 6: neoshowcase.protobuf.Application.ContainerState
-	(GetRepositoriesRequest_Scope)(0),               // 7: neoshowcase.protobuf.GetRepositoriesRequest.Scope
-	(GetApplicationsRequest_Scope)(0),               // 8: neoshowcase.protobuf.GetApplicationsRequest.Scope
-	(*SSHInfo)(nil),                                 // 9: neoshowcase.protobuf.SSHInfo
-	(*AvailableDomain)(nil),                         // 10: neoshowcase.protobuf.AvailableDomain
-	(*AvailablePort)(nil),                           // 11: neoshowcase.protobuf.AvailablePort
-	(*AdditionalLink)(nil),                          // 12: neoshowcase.protobuf.AdditionalLink
-	(*SystemInfo)(nil),                              // 13: neoshowcase.protobuf.SystemInfo
-	(*User)(nil),                                    // 14: neoshowcase.protobuf.User
-	(*UserKey)(nil),                                 // 15: neoshowcase.protobuf.UserKey
-	(*Repository)(nil),                              // 16: neoshowcase.protobuf.Repository
-	(*SimpleCommit)(nil),                            // 17: neoshowcase.protobuf.SimpleCommit
-	(*AutoShutdownConfig)(nil),                      // 18: neoshowcase.protobuf.AutoShutdownConfig
-	(*RuntimeConfig)(nil),                           // 19: neoshowcase.protobuf.RuntimeConfig
-	(*BuildConfigRuntimeBuildpack)(nil),             // 20: neoshowcase.protobuf.BuildConfigRuntimeBuildpack
-	(*BuildConfigRuntimeCmd)(nil),                   // 21: neoshowcase.protobuf.BuildConfigRuntimeCmd
-	(*BuildConfigRuntimeDockerfile)(nil),            // 22: neoshowcase.protobuf.BuildConfigRuntimeDockerfile
-	(*StaticConfig)(nil),                            // 23: neoshowcase.protobuf.StaticConfig
-	(*BuildConfigStaticBuildpack)(nil),              // 24: neoshowcase.protobuf.BuildConfigStaticBuildpack
-	(*BuildConfigStaticCmd)(nil),                    // 25: neoshowcase.protobuf.BuildConfigStaticCmd
-	(*BuildConfigStaticDockerfile)(nil),             // 26: neoshowcase.protobuf.BuildConfigStaticDockerfile
-	(*ApplicationConfig)(nil),                       // 27: neoshowcase.protobuf.ApplicationConfig
-	(*Website)(nil),                                 // 28: neoshowcase.protobuf.Website
-	(*PortPublication)(nil),                         // 29: neoshowcase.protobuf.PortPublication
-	(*Application)(nil),                             // 30: neoshowcase.protobuf.Application
-	(*ApplicationEnvVar)(nil),                       // 31: neoshowcase.protobuf.ApplicationEnvVar
-	(*ApplicationEnvVars)(nil),                      // 32: neoshowcase.protobuf.ApplicationEnvVars
-	(*Artifact)(nil),                                // 33: neoshowcase.protobuf.Artifact
-	(*ArtifactContent)(nil),                         // 34: neoshowcase.protobuf.ArtifactContent
-	(*RuntimeImage)(nil),                            // 35: neoshowcase.protobuf.RuntimeImage
-	(*AvailableMetrics)(nil),                        // 36: neoshowcase.protobuf.AvailableMetrics
-	(*ApplicationMetric)(nil),                       // 37: neoshowcase.protobuf.ApplicationMetric
-	(*ApplicationMetrics)(nil),                      // 38: neoshowcase.protobuf.ApplicationMetrics
-	(*ApplicationOutput)(nil),                       // 39: neoshowcase.protobuf.ApplicationOutput
-	(*ApplicationOutputs)(nil),                      // 40: neoshowcase.protobuf.ApplicationOutputs
-	(*Build)(nil),                                   // 41: neoshowcase.protobuf.Build
-	(*BuildLog)(nil),                                // 42: neoshowcase.protobuf.BuildLog
-	(*GitRef)(nil),                                  // 43: neoshowcase.protobuf.GitRef
-	(*GenerateKeyPairResponse)(nil),                 // 44: neoshowcase.protobuf.GenerateKeyPairResponse
-	(*GetUsersResponse)(nil),                        // 45: neoshowcase.protobuf.GetUsersResponse
-	(*GetUserKeysResponse)(nil),                     // 46: neoshowcase.protobuf.GetUserKeysResponse
-	(*CreateUserKeyRequest)(nil),                    // 47: neoshowcase.protobuf.CreateUserKeyRequest
-	(*DeleteUserKeyRequest)(nil),                    // 48: neoshowcase.protobuf.DeleteUserKeyRequest
-	(*CreateRepositoryAuthBasic)(nil),               // 49: neoshowcase.protobuf.CreateRepositoryAuthBasic
-	(*CreateRepositoryAuthSSH)(nil),                 // 50: neoshowcase.protobuf.CreateRepositoryAuthSSH
-	(*CreateRepositoryAuth)(nil),                    // 51: neoshowcase.protobuf.CreateRepositoryAuth
-	(*CreateRepositoryRequest)(nil),                 // 52: neoshowcase.protobuf.CreateRepositoryRequest
-	(*GetRepositoriesRequest)(nil),                  // 53: neoshowcase.protobuf.GetRepositoriesRequest
-	(*UpdateRepositoryRequest)(nil),                 // 54: neoshowcase.protobuf.UpdateRepositoryRequest
-	(*RepositoryIdRequest)(nil),                     // 55: neoshowcase.protobuf.RepositoryIdRequest
-	(*GetRepositoryCommitsRequest)(nil),             // 56: neoshowcase.protobuf.GetRepositoryCommitsRequest
-	(*GetRepositoryCommitsResponse)(nil),            // 57: neoshowcase.protobuf.GetRepositoryCommitsResponse
-	(*CreateWebsiteRequest)(nil),                    // 58: neoshowcase.protobuf.CreateWebsiteRequest
-	(*DeleteWebsiteRequest)(nil),                    // 59: neoshowcase.protobuf.DeleteWebsiteRequest
-	(*CreateApplicationRequest)(nil),                // 60: neoshowcase.protobuf.CreateApplicationRequest
-	(*GetApplicationsRequest)(nil),                  // 61: neoshowcase.protobuf.GetApplicationsRequest
-	(*UpdateApplicationRequest)(nil),                // 62: neoshowcase.protobuf.UpdateApplicationRequest
-	(*GetRepositoriesResponse)(nil),                 // 63: neoshowcase.protobuf.GetRepositoriesResponse
-	(*GetApplicationsResponse)(nil),                 // 64: neoshowcase.protobuf.GetApplicationsResponse
-	(*ApplicationIdRequest)(nil),                    // 65: neoshowcase.protobuf.ApplicationIdRequest
-	(*GetAllBuildsRequest)(nil),                     // 66: neoshowcase.protobuf.GetAllBuildsRequest
-	(*BuildIdRequest)(nil),                          // 67: neoshowcase.protobuf.BuildIdRequest
-	(*ArtifactIdRequest)(nil),                       // 68: neoshowcase.protobuf.ArtifactIdRequest
-	(*GetBuildsResponse)(nil),                       // 69: neoshowcase.protobuf.GetBuildsResponse
-	(*SetApplicationEnvVarRequest)(nil),             // 70: neoshowcase.protobuf.SetApplicationEnvVarRequest
-	(*DeleteApplicationEnvVarRequest)(nil),          // 71: neoshowcase.protobuf.DeleteApplicationEnvVarRequest
-	(*GetApplicationMetricsRequest)(nil),            // 72: neoshowcase.protobuf.GetApplicationMetricsRequest
-	(*GetOutputRequest)(nil),                        // 73: neoshowcase.protobuf.GetOutputRequest
-	(*GetOutputStreamRequest)(nil),                  // 74: neoshowcase.protobuf.GetOutputStreamRequest
-	(*RetryCommitBuildRequest)(nil),                 // 75: neoshowcase.protobuf.RetryCommitBuildRequest
-	(*GetRepositoryRefsResponse)(nil),               // 76: neoshowcase.protobuf.GetRepositoryRefsResponse
-	(*UpdateRepositoryRequest_UpdateOwners)(nil),    // 77: neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners
-	(*UpdateApplicationRequest_UpdateWebsites)(nil), // 78: neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites
-	(*UpdateApplicationRequest_UpdatePorts)(nil),    // 79: neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts
-	(*UpdateApplicationRequest_UpdateOwners)(nil),   // 80: neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners
-	(*timestamppb.Timestamp)(nil),                   // 81: google.protobuf.Timestamp
-	(*NullTimestamp)(nil),                           // 82: neoshowcase.protobuf.NullTimestamp
-	(*emptypb.Empty)(nil),                           // 83: google.protobuf.Empty
+	(ManifestFormat)(0),                             // 4: neoshowcase.protobuf.ManifestFormat
+	(CustomDomain_VerificationMethod)(0),            // 5: neoshowcase.protobuf.CustomDomain.VerificationMethod
+	(Repository_AuthMethod)(0),                      // 6: neoshowcase.protobuf.Repository.AuthMethod
+	(AutoShutdownConfig_StartupBehavior)(0),         // 7: neoshowcase.protobuf.AutoShutdownConfig.StartupBehavior
+	(WebsiteRule_Type)(0),                           // 8: neoshowcase.protobuf.WebsiteRule.Type
+	(Application_ContainerState)(0),                 // 9: neoshowcase.protobuf.Application.ContainerState
+	(ApplicationEvent_Type)(0),                      // 10: neoshowcase.protobuf.ApplicationEvent.Type
+	(AlertRule_Kind)(0),                             // 11: neoshowcase.protobuf.AlertRule.Kind
+	(AlertRule_Comparison)(0),                       // 12: neoshowcase.protobuf.AlertRule.Comparison
+	(AlertRule_ThresholdUnit)(0),                    // 13: neoshowcase.protobuf.AlertRule.ThresholdUnit
+	(NotificationSubscription_Sink)(0),              // 14: neoshowcase.protobuf.NotificationSubscription.Sink
+	(NotificationSubscription_Event)(0),             // 15: neoshowcase.protobuf.NotificationSubscription.Event
+	(GetRepositoriesRequest_Scope)(0),               // 16: neoshowcase.protobuf.GetRepositoriesRequest.Scope
+	(GetApplicationsRequest_Scope)(0),               // 17: neoshowcase.protobuf.GetApplicationsRequest.Scope
+	(LogFilter_Stream)(0),                           // 18: neoshowcase.protobuf.LogFilter.Stream
+	(*SSHInfo)(nil),                                 // 19: neoshowcase.protobuf.SSHInfo
+	(*AvailableDomain)(nil),                         // 20: neoshowcase.protobuf.AvailableDomain
+	(*AvailablePort)(nil),                           // 21: neoshowcase.protobuf.AvailablePort
+	(*AdditionalLink)(nil),                          // 22: neoshowcase.protobuf.AdditionalLink
+	(*SystemInfo)(nil),                              // 23: neoshowcase.protobuf.SystemInfo
+	(*User)(nil),                                    // 24: neoshowcase.protobuf.User
+	(*UserKey)(nil),                                 // 25: neoshowcase.protobuf.UserKey
+	(*CustomDomain)(nil),                            // 26: neoshowcase.protobuf.CustomDomain
+	(*GetCustomDomainsResponse)(nil),                // 27: neoshowcase.protobuf.GetCustomDomainsResponse
+	(*TLSCertificate)(nil),                          // 28: neoshowcase.protobuf.TLSCertificate
+	(*GetTLSCertificatesResponse)(nil),              // 29: neoshowcase.protobuf.GetTLSCertificatesResponse
+	(*ResourceQuota)(nil),                           // 30: neoshowcase.protobuf.ResourceQuota
+	(*ResourceUsage)(nil),                           // 31: neoshowcase.protobuf.ResourceUsage
+	(*Repository)(nil),                              // 32: neoshowcase.protobuf.Repository
+	(*SimpleCommit)(nil),                            // 33: neoshowcase.protobuf.SimpleCommit
+	(*AutoShutdownConfig)(nil),                      // 34: neoshowcase.protobuf.AutoShutdownConfig
+	(*RuntimeConfig)(nil),                           // 35: neoshowcase.protobuf.RuntimeConfig
+	(*BuildConfigRuntimeBuildpack)(nil),             // 36: neoshowcase.protobuf.BuildConfigRuntimeBuildpack
+	(*BuildConfigRuntimeCmd)(nil),                   // 37: neoshowcase.protobuf.BuildConfigRuntimeCmd
+	(*BuildConfigRuntimeDockerfile)(nil),            // 38: neoshowcase.protobuf.BuildConfigRuntimeDockerfile
+	(*StaticConfig)(nil),                            // 39: neoshowcase.protobuf.StaticConfig
+	(*BuildConfigStaticBuildpack)(nil),              // 40: neoshowcase.protobuf.BuildConfigStaticBuildpack
+	(*BuildConfigStaticCmd)(nil),                    // 41: neoshowcase.protobuf.BuildConfigStaticCmd
+	(*BuildConfigStaticDockerfile)(nil),             // 42: neoshowcase.protobuf.BuildConfigStaticDockerfile
+	(*ApplicationConfig)(nil),                       // 43: neoshowcase.protobuf.ApplicationConfig
+	(*Website)(nil),                                 // 44: neoshowcase.protobuf.Website
+	(*WebsiteBackend)(nil),                          // 45: neoshowcase.protobuf.WebsiteBackend
+	(*WebsiteRule)(nil),                             // 46: neoshowcase.protobuf.WebsiteRule
+	(*WebsiteHeaderPolicy)(nil),                     // 47: neoshowcase.protobuf.WebsiteHeaderPolicy
+	(*WebsiteAccessControl)(nil),                    // 48: neoshowcase.protobuf.WebsiteAccessControl
+	(*WebsiteRateLimit)(nil),                        // 49: neoshowcase.protobuf.WebsiteRateLimit
+	(*PortPublication)(nil),                         // 50: neoshowcase.protobuf.PortPublication
+	(*InternalService)(nil),                         // 51: neoshowcase.protobuf.InternalService
+	(*Application)(nil),                             // 52: neoshowcase.protobuf.Application
+	(*ApplicationEnvVar)(nil),                       // 53: neoshowcase.protobuf.ApplicationEnvVar
+	(*ApplicationEnvVars)(nil),                      // 54: neoshowcase.protobuf.ApplicationEnvVars
+	(*Artifact)(nil),                                // 55: neoshowcase.protobuf.Artifact
+	(*ArtifactContent)(nil),                         // 56: neoshowcase.protobuf.ArtifactContent
+	(*RuntimeImage)(nil),                            // 57: neoshowcase.protobuf.RuntimeImage
+	(*AvailableMetrics)(nil),                        // 58: neoshowcase.protobuf.AvailableMetrics
+	(*ApplicationMetric)(nil),                       // 59: neoshowcase.protobuf.ApplicationMetric
+	(*ApplicationMetrics)(nil),                      // 60: neoshowcase.protobuf.ApplicationMetrics
+	(*ApplicationOutput)(nil),                       // 61: neoshowcase.protobuf.ApplicationOutput
+	(*ApplicationOutputs)(nil),                      // 62: neoshowcase.protobuf.ApplicationOutputs
+	(*ApplicationEvent)(nil),                        // 63: neoshowcase.protobuf.ApplicationEvent
+	(*ApplicationEvents)(nil),                       // 64: neoshowcase.protobuf.ApplicationEvents
+	(*WebsiteProbe)(nil),                            // 65: neoshowcase.protobuf.WebsiteProbe
+	(*WebsiteUptime)(nil),                           // 66: neoshowcase.protobuf.WebsiteUptime
+	(*WebsiteStatus)(nil),                           // 67: neoshowcase.protobuf.WebsiteStatus
+	(*WebsiteStatuses)(nil),                         // 68: neoshowcase.protobuf.WebsiteStatuses
+	(*AlertRule)(nil),                               // 69: neoshowcase.protobuf.AlertRule
+	(*AlertRules)(nil),                              // 70: neoshowcase.protobuf.AlertRules
+	(*Alert)(nil),                                   // 71: neoshowcase.protobuf.Alert
+	(*Alerts)(nil),                                  // 72: neoshowcase.protobuf.Alerts
+	(*NotificationSubscription)(nil),                // 73: neoshowcase.protobuf.NotificationSubscription
+	(*NotificationSubscriptions)(nil),               // 74: neoshowcase.protobuf.NotificationSubscriptions
+	(*Build)(nil),                                   // 75: neoshowcase.protobuf.Build
+	(*BuildLog)(nil),                                // 76: neoshowcase.protobuf.BuildLog
+	(*GitRef)(nil),                                  // 77: neoshowcase.protobuf.GitRef
+	(*GenerateKeyPairResponse)(nil),                 // 78: neoshowcase.protobuf.GenerateKeyPairResponse
+	(*GetUsersResponse)(nil),                        // 79: neoshowcase.protobuf.GetUsersResponse
+	(*GetUserKeysResponse)(nil),                     // 80: neoshowcase.protobuf.GetUserKeysResponse
+	(*CreateUserKeyRequest)(nil),                    // 81: neoshowcase.protobuf.CreateUserKeyRequest
+	(*DeleteUserKeyRequest)(nil),                    // 82: neoshowcase.protobuf.DeleteUserKeyRequest
+	(*CreateCustomDomainRequest)(nil),               // 83: neoshowcase.protobuf.CreateCustomDomainRequest
+	(*CustomDomainIdRequest)(nil),                   // 84: neoshowcase.protobuf.CustomDomainIdRequest
+	(*UploadTLSCertificateRequest)(nil),             // 85: neoshowcase.protobuf.UploadTLSCertificateRequest
+	(*TLSCertificateIdRequest)(nil),                 // 86: neoshowcase.protobuf.TLSCertificateIdRequest
+	(*GetMyUsageResponse)(nil),                      // 87: neoshowcase.protobuf.GetMyUsageResponse
+	(*SetUserQuotaRequest)(nil),                     // 88: neoshowcase.protobuf.SetUserQuotaRequest
+	(*CreateRepositoryAuthBasic)(nil),               // 89: neoshowcase.protobuf.CreateRepositoryAuthBasic
+	(*CreateRepositoryAuthSSH)(nil),                 // 90: neoshowcase.protobuf.CreateRepositoryAuthSSH
+	(*CreateRepositoryAuth)(nil),                    // 91: neoshowcase.protobuf.CreateRepositoryAuth
+	(*CreateRepositoryRequest)(nil),                 // 92: neoshowcase.protobuf.CreateRepositoryRequest
+	(*GetRepositoriesRequest)(nil),                  // 93: neoshowcase.protobuf.GetRepositoriesRequest
+	(*UpdateRepositoryRequest)(nil),                 // 94: neoshowcase.protobuf.UpdateRepositoryRequest
+	(*RepositoryIdRequest)(nil),                     // 95: neoshowcase.protobuf.RepositoryIdRequest
+	(*GetRepositoryCommitsRequest)(nil),             // 96: neoshowcase.protobuf.GetRepositoryCommitsRequest
+	(*GetRepositoryCommitsResponse)(nil),            // 97: neoshowcase.protobuf.GetRepositoryCommitsResponse
+	(*CreateWebsiteRequest)(nil),                    // 98: neoshowcase.protobuf.CreateWebsiteRequest
+	(*DeleteWebsiteRequest)(nil),                    // 99: neoshowcase.protobuf.DeleteWebsiteRequest
+	(*CreateApplicationRequest)(nil),                // 100: neoshowcase.protobuf.CreateApplicationRequest
+	(*DuplicateApplicationRequest)(nil),             // 101: neoshowcase.protobuf.DuplicateApplicationRequest
+	(*GetApplicationsRequest)(nil),                  // 102: neoshowcase.protobuf.GetApplicationsRequest
+	(*UpdateApplicationRequest)(nil),                // 103: neoshowcase.protobuf.UpdateApplicationRequest
+	(*ExportApplicationsRequest)(nil),               // 104: neoshowcase.protobuf.ExportApplicationsRequest
+	(*ExportApplicationsResponse)(nil),              // 105: neoshowcase.protobuf.ExportApplicationsResponse
+	(*ApplyManifestRequest)(nil),                    // 106: neoshowcase.protobuf.ApplyManifestRequest
+	(*ManifestFieldDiff)(nil),                       // 107: neoshowcase.protobuf.ManifestFieldDiff
+	(*ManifestApplicationResult)(nil),               // 108: neoshowcase.protobuf.ManifestApplicationResult
+	(*ApplyManifestResponse)(nil),                   // 109: neoshowcase.protobuf.ApplyManifestResponse
+	(*GetRepositoriesResponse)(nil),                 // 110: neoshowcase.protobuf.GetRepositoriesResponse
+	(*GetApplicationsResponse)(nil),                 // 111: neoshowcase.protobuf.GetApplicationsResponse
+	(*ApplicationIdRequest)(nil),                    // 112: neoshowcase.protobuf.ApplicationIdRequest
+	(*GetAllBuildsRequest)(nil),                     // 113: neoshowcase.protobuf.GetAllBuildsRequest
+	(*BuildIdRequest)(nil),                          // 114: neoshowcase.protobuf.BuildIdRequest
+	(*ArtifactIdRequest)(nil),                       // 115: neoshowcase.protobuf.ArtifactIdRequest
+	(*GetBuildsResponse)(nil),                       // 116: neoshowcase.protobuf.GetBuildsResponse
+	(*SetApplicationEnvVarRequest)(nil),             // 117: neoshowcase.protobuf.SetApplicationEnvVarRequest
+	(*DeleteApplicationEnvVarRequest)(nil),          // 118: neoshowcase.protobuf.DeleteApplicationEnvVarRequest
+	(*GetApplicationMetricsRequest)(nil),            // 119: neoshowcase.protobuf.GetApplicationMetricsRequest
+	(*LogFilter)(nil),                               // 120: neoshowcase.protobuf.LogFilter
+	(*GetOutputRequest)(nil),                        // 121: neoshowcase.protobuf.GetOutputRequest
+	(*GetOutputStreamRequest)(nil),                  // 122: neoshowcase.protobuf.GetOutputStreamRequest
+	(*CreateAlertRuleRequest)(nil),                  // 123: neoshowcase.protobuf.CreateAlertRuleRequest
+	(*DeleteAlertRuleRequest)(nil),                  // 124: neoshowcase.protobuf.DeleteAlertRuleRequest
+	(*CreateNotificationSubscriptionRequest)(nil),   // 125: neoshowcase.protobuf.CreateNotificationSubscriptionRequest
+	(*DeleteNotificationSubscriptionRequest)(nil),   // 126: neoshowcase.protobuf.DeleteNotificationSubscriptionRequest
+	(*GetAlertsRequest)(nil),                        // 127: neoshowcase.protobuf.GetAlertsRequest
+	(*GetApplicationEventsRequest)(nil),             // 128: neoshowcase.protobuf.GetApplicationEventsRequest
+	(*GetApplicationEventsStreamRequest)(nil),       // 129: neoshowcase.protobuf.GetApplicationEventsStreamRequest
+	(*RetryCommitBuildRequest)(nil),                 // 130: neoshowcase.protobuf.RetryCommitBuildRequest
+	(*GetRepositoryRefsResponse)(nil),               // 131: neoshowcase.protobuf.GetRepositoryRefsResponse
+	(*WebsiteHeaderPolicy_Header)(nil),              // 132: neoshowcase.protobuf.WebsiteHeaderPolicy.Header
+	(*WebsiteHeaderPolicy_CORSPolicy)(nil),          // 133: neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicy
+	(*WebsiteAccessControl_BasicAuthUser)(nil),      // 134: neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUser
+	(*UpdateRepositoryRequest_UpdateOwners)(nil),    // 135: neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners
+	(*UpdateApplicationRequest_UpdateWebsites)(nil), // 136: neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites
+	(*UpdateApplicationRequest_UpdatePorts)(nil),    // 137: neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts
+	(*UpdateApplicationRequest_UpdateOwners)(nil),   // 138: neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners
+	(*timestamppb.Timestamp)(nil),                   // 139: google.protobuf.Timestamp
+	(*NullTimestamp)(nil),                           // 140: neoshowcase.protobuf.NullTimestamp
+	(*emptypb.Empty)(nil),                           // 141: google.protobuf.Empty
 }
 var file_neoshowcase_protobuf_gateway_proto_depIdxs = []int32{
 	2,   // 0: neoshowcase.protobuf.AvailablePort.protocol:type_name -> neoshowcase.protobuf.PortPublicationProtocol
-	9,   // 1: neoshowcase.protobuf.SystemInfo.ssh:type_name -> neoshowcase.protobuf.SSHInfo
-	10,  // 2: neoshowcase.protobuf.SystemInfo.domains:type_name -> neoshowcase.protobuf.AvailableDomain
-	11,  // 3: neoshowcase.protobuf.SystemInfo.ports:type_name -> neoshowcase.protobuf.AvailablePort
-	12,  // 4: neoshowcase.protobuf.SystemInfo.additional_links:type_name -> neoshowcase.protobuf.AdditionalLink
-	81,  // 5: neoshowcase.protobuf.UserKey.created_at:type_name -> google.protobuf.Timestamp
-	4,   // 6: neoshowcase.protobuf.Repository.auth_method:type_name -> neoshowcase.protobuf.Repository.AuthMethod
-	81,  // 7: neoshowcase.protobuf.SimpleCommit.commit_date:type_name -> google.protobuf.Timestamp
-	5,   // 8: neoshowcase.protobuf.AutoShutdownConfig.startup:type_name -> neoshowcase.protobuf.AutoShutdownConfig.StartupBehavior
-	18,  // 9: neoshowcase.protobuf.RuntimeConfig.auto_shutdown:type_name -> neoshowcase.protobuf.AutoShutdownConfig
-	19,  // 10: neoshowcase.protobuf.BuildConfigRuntimeBuildpack.runtime_config:type_name -> neoshowcase.protobuf.RuntimeConfig
-	19,  // 11: neoshowcase.protobuf.BuildConfigRuntimeCmd.runtime_config:type_name -> neoshowcase.protobuf.RuntimeConfig
-	19,  // 12: neoshowcase.protobuf.BuildConfigRuntimeDockerfile.runtime_config:type_name -> neoshowcase.protobuf.RuntimeConfig
-	23,  // 13: neoshowcase.protobuf.BuildConfigStaticBuildpack.static_config:type_name -> neoshowcase.protobuf.StaticConfig
-	23,  // 14: neoshowcase.protobuf.BuildConfigStaticCmd.static_config:type_name -> neoshowcase.protobuf.StaticConfig
-	23,  // 15: neoshowcase.protobuf.BuildConfigStaticDockerfile.static_config:type_name -> neoshowcase.protobuf.StaticConfig
-	20,  // 16: neoshowcase.protobuf.ApplicationConfig.runtime_buildpack:type_name -> neoshowcase.protobuf.BuildConfigRuntimeBuildpack
-	21,  // 17: neoshowcase.protobuf.ApplicationConfig.runtime_cmd:type_name -> neoshowcase.protobuf.BuildConfigRuntimeCmd
-	22,  // 18: neoshowcase.protobuf.ApplicationConfig.runtime_dockerfile:type_name -> neoshowcase.protobuf.BuildConfigRuntimeDockerfile
-	24,  // 19: neoshowcase.protobuf.ApplicationConfig.static_buildpack:type_name -> neoshowcase.protobuf.BuildConfigStaticBuildpack
-	25,  // 20: neoshowcase.protobuf.ApplicationConfig.static_cmd:type_name -> neoshowcase.protobuf.BuildConfigStaticCmd
-	26,  // 21: neoshowcase.protobuf.ApplicationConfig.static_dockerfile:type_name -> neoshowcase.protobuf.BuildConfigStaticDockerfile
-	1,   // 22: neoshowcase.protobuf.Website.authentication:type_name -> neoshowcase.protobuf.AuthenticationType
-	2,   // 23: neoshowcase.protobuf.PortPublication.protocol:type_name -> neoshowcase.protobuf.PortPublicationProtocol
-	0,   // 24: neoshowcase.protobuf.Application.deploy_type:type_name -> neoshowcase.protobuf.DeployType
-	6,   // 25: neoshowcase.protobuf.Application.container:type_name -> neoshowcase.protobuf.Application.ContainerState
-	81,  // 26: neoshowcase.protobuf.Application.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 27: neoshowcase.protobuf.Application.updated_at:type_name -> google.protobuf.Timestamp
-	27,  // 28: neoshowcase.protobuf.Application.config:type_name -> neoshowcase.protobuf.ApplicationConfig
-	28,  // 29: neoshowcase.protobuf.Application.websites:type_name -> neoshowcase.protobuf.Website
-	29,  // 30: neoshowcase.protobuf.Application.port_publications:type_name -> neoshowcase.protobuf.PortPublication
-	3,   // 31: neoshowcase.protobuf.Application.latest_build_status:type_name -> neoshowcase.protobuf.BuildStatus
-	31,  // 32: neoshowcase.protobuf.ApplicationEnvVars.variables:type_name -> neoshowcase.protobuf.ApplicationEnvVar
-	81,  // 33: neoshowcase.protobuf.Artifact.created_at:type_name -> google.protobuf.Timestamp
-	82,  // 34: neoshowcase.protobuf.Artifact.deleted_at:type_name -> neoshowcase.protobuf.NullTimestamp
-	81,  // 35: neoshowcase.protobuf.RuntimeImage.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 36: neoshowcase.protobuf.ApplicationMetric.time:type_name -> google.protobuf.Timestamp
-	37,  // 37: neoshowcase.protobuf.ApplicationMetrics.metrics:type_name -> neoshowcase.protobuf.ApplicationMetric
-	81,  // 38: neoshowcase.protobuf.ApplicationOutput.time:type_name -> google.protobuf.Timestamp
-	39,  // 39: neoshowcase.protobuf.ApplicationOutputs.outputs:type_name -> neoshowcase.protobuf.ApplicationOutput
-	3,   // 40: neoshowcase.protobuf.Build.status:type_name -> neoshowcase.protobuf.BuildStatus
-	81,  // 41: neoshowcase.protobuf.Build.queued_at:type_name -> google.protobuf.Timestamp
-	82,  // 42: neoshowcase.protobuf.Build.started_at:type_name -> neoshowcase.protobuf.NullTimestamp
-	82,  // 43: neoshowcase.protobuf.Build.updated_at:type_name -> neoshowcase.protobuf.NullTimestamp
-	82,  // 44: neoshowcase.protobuf.Build.finished_at:type_name -> neoshowcase.protobuf.NullTimestamp
-	33,  // 45: neoshowcase.protobuf.Build.artifacts:type_name -> neoshowcase.protobuf.Artifact
-	35,  // 46: neoshowcase.protobuf.Build.runtime_image:type_name -> neoshowcase.protobuf.RuntimeImage
-	14,  // 47: neoshowcase.protobuf.GetUsersResponse.users:type_name -> neoshowcase.protobuf.User
-	15,  // 48: neoshowcase.protobuf.GetUserKeysResponse.keys:type_name -> neoshowcase.protobuf.UserKey
-	83,  // 49: neoshowcase.protobuf.CreateRepositoryAuth.none:type_name -> google.protobuf.Empty
-	49,  // 50: neoshowcase.protobuf.CreateRepositoryAuth.basic:type_name -> neoshowcase.protobuf.CreateRepositoryAuthBasic
-	50,  // 51: neoshowcase.protobuf.CreateRepositoryAuth.ssh:type_name -> neoshowcase.protobuf.CreateRepositoryAuthSSH
-	51,  // 52: neoshowcase.protobuf.CreateRepositoryRequest.auth:type_name -> neoshowcase.protobuf.CreateRepositoryAuth
-	7,   // 53: neoshowcase.protobuf.GetRepositoriesRequest.scope:type_name -> neoshowcase.protobuf.GetRepositoriesRequest.Scope
-	51,  // 54: neoshowcase.protobuf.UpdateRepositoryRequest.auth:type_name -> neoshowcase.protobuf.CreateRepositoryAuth
-	77,  // 55: neoshowcase.protobuf.UpdateRepositoryRequest.owner_ids:type_name -> neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners
-	17,  // 56: neoshowcase.protobuf.GetRepositoryCommitsResponse.commits:type_name -> neoshowcase.protobuf.SimpleCommit
-	1,   // 57: neoshowcase.protobuf.CreateWebsiteRequest.authentication:type_name -> neoshowcase.protobuf.AuthenticationType
-	27,  // 58: neoshowcase.protobuf.CreateApplicationRequest.config:type_name -> neoshowcase.protobuf.ApplicationConfig
-	58,  // 59: neoshowcase.protobuf.CreateApplicationRequest.websites:type_name -> neoshowcase.protobuf.CreateWebsiteRequest
-	29,  // 60: neoshowcase.protobuf.CreateApplicationRequest.port_publications:type_name -> neoshowcase.protobuf.PortPublication
-	8,   // 61: neoshowcase.protobuf.GetApplicationsRequest.scope:type_name -> neoshowcase.protobuf.GetApplicationsRequest.Scope
-	27,  // 62: neoshowcase.protobuf.UpdateApplicationRequest.config:type_name -> neoshowcase.protobuf.ApplicationConfig
-	78,  // 63: neoshowcase.protobuf.UpdateApplicationRequest.websites:type_name -> neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites
-	79,  // 64: neoshowcase.protobuf.UpdateApplicationRequest.port_publications:type_name -> neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts
-	80,  // 65: neoshowcase.protobuf.UpdateApplicationRequest.owner_ids:type_name -> neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners
-	16,  // 66: neoshowcase.protobuf.GetRepositoriesResponse.repositories:type_name -> neoshowcase.protobuf.Repository
-	30,  // 67: neoshowcase.protobuf.GetApplicationsResponse.applications:type_name -> neoshowcase.protobuf.Application
-	41,  // 68: neoshowcase.protobuf.GetBuildsResponse.builds:type_name -> neoshowcase.protobuf.Build
-	81,  // 69: neoshowcase.protobuf.GetApplicationMetricsRequest.before:type_name -> google.protobuf.Timestamp
-	81,  // 70: neoshowcase.protobuf.GetOutputRequest.before:type_name -> google.protobuf.Timestamp
-	81,  // 71: neoshowcase.protobuf.GetOutputStreamRequest.begin:type_name -> google.protobuf.Timestamp
-	43,  // 72: neoshowcase.protobuf.GetRepositoryRefsResponse.refs:type_name -> neoshowcase.protobuf.GitRef
-	58,  // 73: neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites.websites:type_name -> neoshowcase.protobuf.CreateWebsiteRequest
-	29,  // 74: neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts.port_publications:type_name -> neoshowcase.protobuf.PortPublication
-	83,  // 75: neoshowcase.protobuf.APIService.GetSystemInfo:input_type -> google.protobuf.Empty
-	83,  // 76: neoshowcase.protobuf.APIService.GenerateKeyPair:input_type -> google.protobuf.Empty
-	83,  // 77: neoshowcase.protobuf.APIService.GetMe:input_type -> google.protobuf.Empty
-	83,  // 78: neoshowcase.protobuf.APIService.GetUsers:input_type -> google.protobuf.Empty
-	47,  // 79: neoshowcase.protobuf.APIService.CreateUserKey:input_type -> neoshowcase.protobuf.CreateUserKeyRequest
-	83,  // 80: neoshowcase.protobuf.APIService.GetUserKeys:input_type -> google.protobuf.Empty
-	48,  // 81: neoshowcase.protobuf.APIService.DeleteUserKey:input_type -> neoshowcase.protobuf.DeleteUserKeyRequest
-	52,  // 82: neoshowcase.protobuf.APIService.CreateRepository:input_type -> neoshowcase.protobuf.CreateRepositoryRequest
-	53,  // 83: neoshowcase.protobuf.APIService.GetRepositories:input_type -> neoshowcase.protobuf.GetRepositoriesRequest
-	56,  // 84: neoshowcase.protobuf.APIService.GetRepositoryCommits:input_type -> neoshowcase.protobuf.GetRepositoryCommitsRequest
-	55,  // 85: neoshowcase.protobuf.APIService.GetRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
-	55,  // 86: neoshowcase.protobuf.APIService.GetRepositoryRefs:input_type -> neoshowcase.protobuf.RepositoryIdRequest
-	54,  // 87: neoshowcase.protobuf.APIService.UpdateRepository:input_type -> neoshowcase.protobuf.UpdateRepositoryRequest
-	55,  // 88: neoshowcase.protobuf.APIService.RefreshRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
-	55,  // 89: neoshowcase.protobuf.APIService.DeleteRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
-	60,  // 90: neoshowcase.protobuf.APIService.CreateApplication:input_type -> neoshowcase.protobuf.CreateApplicationRequest
-	61,  // 91: neoshowcase.protobuf.APIService.GetApplications:input_type -> neoshowcase.protobuf.GetApplicationsRequest
-	65,  // 92: neoshowcase.protobuf.APIService.GetApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	62,  // 93: neoshowcase.protobuf.APIService.UpdateApplication:input_type -> neoshowcase.protobuf.UpdateApplicationRequest
-	65,  // 94: neoshowcase.protobuf.APIService.DeleteApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	83,  // 95: neoshowcase.protobuf.APIService.GetAvailableMetrics:input_type -> google.protobuf.Empty
-	72,  // 96: neoshowcase.protobuf.APIService.GetApplicationMetrics:input_type -> neoshowcase.protobuf.GetApplicationMetricsRequest
-	73,  // 97: neoshowcase.protobuf.APIService.GetOutput:input_type -> neoshowcase.protobuf.GetOutputRequest
-	74,  // 98: neoshowcase.protobuf.APIService.GetOutputStream:input_type -> neoshowcase.protobuf.GetOutputStreamRequest
-	65,  // 99: neoshowcase.protobuf.APIService.GetEnvVars:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	70,  // 100: neoshowcase.protobuf.APIService.SetEnvVar:input_type -> neoshowcase.protobuf.SetApplicationEnvVarRequest
-	71,  // 101: neoshowcase.protobuf.APIService.DeleteEnvVar:input_type -> neoshowcase.protobuf.DeleteApplicationEnvVarRequest
-	65,  // 102: neoshowcase.protobuf.APIService.StartApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	65,  // 103: neoshowcase.protobuf.APIService.StopApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	66,  // 104: neoshowcase.protobuf.APIService.GetAllBuilds:input_type -> neoshowcase.protobuf.GetAllBuildsRequest
-	65,  // 105: neoshowcase.protobuf.APIService.GetBuilds:input_type -> neoshowcase.protobuf.ApplicationIdRequest
-	67,  // 106: neoshowcase.protobuf.APIService.GetBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
-	75,  // 107: neoshowcase.protobuf.APIService.RetryCommitBuild:input_type -> neoshowcase.protobuf.RetryCommitBuildRequest
-	67,  // 108: neoshowcase.protobuf.APIService.CancelBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
-	67,  // 109: neoshowcase.protobuf.APIService.GetBuildLog:input_type -> neoshowcase.protobuf.BuildIdRequest
-	67,  // 110: neoshowcase.protobuf.APIService.GetBuildLogStream:input_type -> neoshowcase.protobuf.BuildIdRequest
-	68,  // 111: neoshowcase.protobuf.APIService.GetBuildArtifact:input_type -> neoshowcase.protobuf.ArtifactIdRequest
-	13,  // 112: neoshowcase.protobuf.APIService.GetSystemInfo:output_type -> neoshowcase.protobuf.SystemInfo
-	44,  // 113: neoshowcase.protobuf.APIService.GenerateKeyPair:output_type -> neoshowcase.protobuf.GenerateKeyPairResponse
-	14,  // 114: neoshowcase.protobuf.APIService.GetMe:output_type -> neoshowcase.protobuf.User
-	45,  // 115: neoshowcase.protobuf.APIService.GetUsers:output_type -> neoshowcase.protobuf.GetUsersResponse
-	15,  // 116: neoshowcase.protobuf.APIService.CreateUserKey:output_type -> neoshowcase.protobuf.UserKey
-	46,  // 117: neoshowcase.protobuf.APIService.GetUserKeys:output_type -> neoshowcase.protobuf.GetUserKeysResponse
-	83,  // 118: neoshowcase.protobuf.APIService.DeleteUserKey:output_type -> google.protobuf.Empty
-	16,  // 119: neoshowcase.protobuf.APIService.CreateRepository:output_type -> neoshowcase.protobuf.Repository
-	63,  // 120: neoshowcase.protobuf.APIService.GetRepositories:output_type -> neoshowcase.protobuf.GetRepositoriesResponse
-	57,  // 121: neoshowcase.protobuf.APIService.GetRepositoryCommits:output_type -> neoshowcase.protobuf.GetRepositoryCommitsResponse
-	16,  // 122: neoshowcase.protobuf.APIService.GetRepository:output_type -> neoshowcase.protobuf.Repository
-	76,  // 123: neoshowcase.protobuf.APIService.GetRepositoryRefs:output_type -> neoshowcase.protobuf.GetRepositoryRefsResponse
-	83,  // 124: neoshowcase.protobuf.APIService.UpdateRepository:output_type -> google.protobuf.Empty
-	83,  // 125: neoshowcase.protobuf.APIService.RefreshRepository:output_type -> google.protobuf.Empty
-	83,  // 126: neoshowcase.protobuf.APIService.DeleteRepository:output_type -> google.protobuf.Empty
-	30,  // 127: neoshowcase.protobuf.APIService.CreateApplication:output_type -> neoshowcase.protobuf.Application
-	64,  // 128: neoshowcase.protobuf.APIService.GetApplications:output_type -> neoshowcase.protobuf.GetApplicationsResponse
-	30,  // 129: neoshowcase.protobuf.APIService.GetApplication:output_type -> neoshowcase.protobuf.Application
-	83,  // 130: neoshowcase.protobuf.APIService.UpdateApplication:output_type -> google.protobuf.Empty
-	83,  // 131: neoshowcase.protobuf.APIService.DeleteApplication:output_type -> google.protobuf.Empty
-	36,  // 132: neoshowcase.protobuf.APIService.GetAvailableMetrics:output_type -> neoshowcase.protobuf.AvailableMetrics
-	38,  // 133: neoshowcase.protobuf.APIService.GetApplicationMetrics:output_type -> neoshowcase.protobuf.ApplicationMetrics
-	40,  // 134: neoshowcase.protobuf.APIService.GetOutput:output_type -> neoshowcase.protobuf.ApplicationOutputs
-	39,  // 135: neoshowcase.protobuf.APIService.GetOutputStream:output_type -> neoshowcase.protobuf.ApplicationOutput
-	32,  // 136: neoshowcase.protobuf.APIService.GetEnvVars:output_type -> neoshowcase.protobuf.ApplicationEnvVars
-	83,  // 137: neoshowcase.protobuf.APIService.SetEnvVar:output_type -> google.protobuf.Empty
-	83,  // 138: neoshowcase.protobuf.APIService.DeleteEnvVar:output_type -> google.protobuf.Empty
-	83,  // 139: neoshowcase.protobuf.APIService.StartApplication:output_type -> google.protobuf.Empty
-	83,  // 140: neoshowcase.protobuf.APIService.StopApplication:output_type -> google.protobuf.Empty
-	69,  // 141: neoshowcase.protobuf.APIService.GetAllBuilds:output_type -> neoshowcase.protobuf.GetBuildsResponse
-	69,  // 142: neoshowcase.protobuf.APIService.GetBuilds:output_type -> neoshowcase.protobuf.GetBuildsResponse
-	41,  // 143: neoshowcase.protobuf.APIService.GetBuild:output_type -> neoshowcase.protobuf.Build
-	83,  // 144: neoshowcase.protobuf.APIService.RetryCommitBuild:output_type -> google.protobuf.Empty
-	83,  // 145: neoshowcase.protobuf.APIService.CancelBuild:output_type -> google.protobuf.Empty
-	42,  // 146: neoshowcase.protobuf.APIService.GetBuildLog:output_type -> neoshowcase.protobuf.BuildLog
-	42,  // 147: neoshowcase.protobuf.APIService.GetBuildLogStream:output_type -> neoshowcase.protobuf.BuildLog
-	34,  // 148: neoshowcase.protobuf.APIService.GetBuildArtifact:output_type -> neoshowcase.protobuf.ArtifactContent
-	112, // [112:149] is the sub-list for method output_type
-	75,  // [75:112] is the sub-list for method input_type
-	75,  // [75:75] is the sub-list for extension type_name
-	75,  // [75:75] is the sub-list for extension extendee
-	0,   // [0:75] is the sub-list for field type_name
+	19,  // 1: neoshowcase.protobuf.SystemInfo.ssh:type_name -> neoshowcase.protobuf.SSHInfo
+	20,  // 2: neoshowcase.protobuf.SystemInfo.domains:type_name -> neoshowcase.protobuf.AvailableDomain
+	21,  // 3: neoshowcase.protobuf.SystemInfo.ports:type_name -> neoshowcase.protobuf.AvailablePort
+	22,  // 4: neoshowcase.protobuf.SystemInfo.additional_links:type_name -> neoshowcase.protobuf.AdditionalLink
+	139, // 5: neoshowcase.protobuf.UserKey.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 6: neoshowcase.protobuf.CustomDomain.method:type_name -> neoshowcase.protobuf.CustomDomain.VerificationMethod
+	140, // 7: neoshowcase.protobuf.CustomDomain.verified_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	140, // 8: neoshowcase.protobuf.CustomDomain.checked_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	139, // 9: neoshowcase.protobuf.CustomDomain.created_at:type_name -> google.protobuf.Timestamp
+	26,  // 10: neoshowcase.protobuf.GetCustomDomainsResponse.domains:type_name -> neoshowcase.protobuf.CustomDomain
+	139, // 11: neoshowcase.protobuf.TLSCertificate.not_before:type_name -> google.protobuf.Timestamp
+	139, // 12: neoshowcase.protobuf.TLSCertificate.not_after:type_name -> google.protobuf.Timestamp
+	139, // 13: neoshowcase.protobuf.TLSCertificate.created_at:type_name -> google.protobuf.Timestamp
+	28,  // 14: neoshowcase.protobuf.GetTLSCertificatesResponse.certificates:type_name -> neoshowcase.protobuf.TLSCertificate
+	6,   // 15: neoshowcase.protobuf.Repository.auth_method:type_name -> neoshowcase.protobuf.Repository.AuthMethod
+	139, // 16: neoshowcase.protobuf.SimpleCommit.commit_date:type_name -> google.protobuf.Timestamp
+	7,   // 17: neoshowcase.protobuf.AutoShutdownConfig.startup:type_name -> neoshowcase.protobuf.AutoShutdownConfig.StartupBehavior
+	34,  // 18: neoshowcase.protobuf.RuntimeConfig.auto_shutdown:type_name -> neoshowcase.protobuf.AutoShutdownConfig
+	35,  // 19: neoshowcase.protobuf.BuildConfigRuntimeBuildpack.runtime_config:type_name -> neoshowcase.protobuf.RuntimeConfig
+	35,  // 20: neoshowcase.protobuf.BuildConfigRuntimeCmd.runtime_config:type_name -> neoshowcase.protobuf.RuntimeConfig
+	35,  // 21: neoshowcase.protobuf.BuildConfigRuntimeDockerfile.runtime_config:type_name -> neoshowcase.protobuf.RuntimeConfig
+	39,  // 22: neoshowcase.protobuf.BuildConfigStaticBuildpack.static_config:type_name -> neoshowcase.protobuf.StaticConfig
+	39,  // 23: neoshowcase.protobuf.BuildConfigStaticCmd.static_config:type_name -> neoshowcase.protobuf.StaticConfig
+	39,  // 24: neoshowcase.protobuf.BuildConfigStaticDockerfile.static_config:type_name -> neoshowcase.protobuf.StaticConfig
+	36,  // 25: neoshowcase.protobuf.ApplicationConfig.runtime_buildpack:type_name -> neoshowcase.protobuf.BuildConfigRuntimeBuildpack
+	37,  // 26: neoshowcase.protobuf.ApplicationConfig.runtime_cmd:type_name -> neoshowcase.protobuf.BuildConfigRuntimeCmd
+	38,  // 27: neoshowcase.protobuf.ApplicationConfig.runtime_dockerfile:type_name -> neoshowcase.protobuf.BuildConfigRuntimeDockerfile
+	40,  // 28: neoshowcase.protobuf.ApplicationConfig.static_buildpack:type_name -> neoshowcase.protobuf.BuildConfigStaticBuildpack
+	41,  // 29: neoshowcase.protobuf.ApplicationConfig.static_cmd:type_name -> neoshowcase.protobuf.BuildConfigStaticCmd
+	42,  // 30: neoshowcase.protobuf.ApplicationConfig.static_dockerfile:type_name -> neoshowcase.protobuf.BuildConfigStaticDockerfile
+	1,   // 31: neoshowcase.protobuf.Website.authentication:type_name -> neoshowcase.protobuf.AuthenticationType
+	46,  // 32: neoshowcase.protobuf.Website.rules:type_name -> neoshowcase.protobuf.WebsiteRule
+	47,  // 33: neoshowcase.protobuf.Website.header_policy:type_name -> neoshowcase.protobuf.WebsiteHeaderPolicy
+	48,  // 34: neoshowcase.protobuf.Website.access_control:type_name -> neoshowcase.protobuf.WebsiteAccessControl
+	49,  // 35: neoshowcase.protobuf.Website.rate_limit:type_name -> neoshowcase.protobuf.WebsiteRateLimit
+	45,  // 36: neoshowcase.protobuf.Website.backends:type_name -> neoshowcase.protobuf.WebsiteBackend
+	8,   // 37: neoshowcase.protobuf.WebsiteRule.type:type_name -> neoshowcase.protobuf.WebsiteRule.Type
+	132, // 38: neoshowcase.protobuf.WebsiteHeaderPolicy.response_headers:type_name -> neoshowcase.protobuf.WebsiteHeaderPolicy.Header
+	133, // 39: neoshowcase.protobuf.WebsiteHeaderPolicy.cors:type_name -> neoshowcase.protobuf.WebsiteHeaderPolicy.CORSPolicy
+	134, // 40: neoshowcase.protobuf.WebsiteAccessControl.basic_auth_users:type_name -> neoshowcase.protobuf.WebsiteAccessControl.BasicAuthUser
+	2,   // 41: neoshowcase.protobuf.PortPublication.protocol:type_name -> neoshowcase.protobuf.PortPublicationProtocol
+	0,   // 42: neoshowcase.protobuf.Application.deploy_type:type_name -> neoshowcase.protobuf.DeployType
+	9,   // 43: neoshowcase.protobuf.Application.container:type_name -> neoshowcase.protobuf.Application.ContainerState
+	139, // 44: neoshowcase.protobuf.Application.created_at:type_name -> google.protobuf.Timestamp
+	139, // 45: neoshowcase.protobuf.Application.updated_at:type_name -> google.protobuf.Timestamp
+	43,  // 46: neoshowcase.protobuf.Application.config:type_name -> neoshowcase.protobuf.ApplicationConfig
+	44,  // 47: neoshowcase.protobuf.Application.websites:type_name -> neoshowcase.protobuf.Website
+	50,  // 48: neoshowcase.protobuf.Application.port_publications:type_name -> neoshowcase.protobuf.PortPublication
+	3,   // 49: neoshowcase.protobuf.Application.latest_build_status:type_name -> neoshowcase.protobuf.BuildStatus
+	51,  // 50: neoshowcase.protobuf.Application.internal_service:type_name -> neoshowcase.protobuf.InternalService
+	53,  // 51: neoshowcase.protobuf.ApplicationEnvVars.variables:type_name -> neoshowcase.protobuf.ApplicationEnvVar
+	139, // 52: neoshowcase.protobuf.Artifact.created_at:type_name -> google.protobuf.Timestamp
+	140, // 53: neoshowcase.protobuf.Artifact.deleted_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	139, // 54: neoshowcase.protobuf.RuntimeImage.created_at:type_name -> google.protobuf.Timestamp
+	139, // 55: neoshowcase.protobuf.ApplicationMetric.time:type_name -> google.protobuf.Timestamp
+	59,  // 56: neoshowcase.protobuf.ApplicationMetrics.metrics:type_name -> neoshowcase.protobuf.ApplicationMetric
+	139, // 57: neoshowcase.protobuf.ApplicationOutput.time:type_name -> google.protobuf.Timestamp
+	61,  // 58: neoshowcase.protobuf.ApplicationOutputs.outputs:type_name -> neoshowcase.protobuf.ApplicationOutput
+	10,  // 59: neoshowcase.protobuf.ApplicationEvent.type:type_name -> neoshowcase.protobuf.ApplicationEvent.Type
+	139, // 60: neoshowcase.protobuf.ApplicationEvent.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 61: neoshowcase.protobuf.ApplicationEvents.events:type_name -> neoshowcase.protobuf.ApplicationEvent
+	139, // 62: neoshowcase.protobuf.WebsiteProbe.checked_at:type_name -> google.protobuf.Timestamp
+	140, // 63: neoshowcase.protobuf.WebsiteProbe.tls_expires_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	44,  // 64: neoshowcase.protobuf.WebsiteStatus.website:type_name -> neoshowcase.protobuf.Website
+	65,  // 65: neoshowcase.protobuf.WebsiteStatus.latest:type_name -> neoshowcase.protobuf.WebsiteProbe
+	66,  // 66: neoshowcase.protobuf.WebsiteStatus.uptimes:type_name -> neoshowcase.protobuf.WebsiteUptime
+	67,  // 67: neoshowcase.protobuf.WebsiteStatuses.statuses:type_name -> neoshowcase.protobuf.WebsiteStatus
+	11,  // 68: neoshowcase.protobuf.AlertRule.kind:type_name -> neoshowcase.protobuf.AlertRule.Kind
+	12,  // 69: neoshowcase.protobuf.AlertRule.comparison:type_name -> neoshowcase.protobuf.AlertRule.Comparison
+	139, // 70: neoshowcase.protobuf.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	13,  // 71: neoshowcase.protobuf.AlertRule.threshold_unit:type_name -> neoshowcase.protobuf.AlertRule.ThresholdUnit
+	69,  // 72: neoshowcase.protobuf.AlertRules.rules:type_name -> neoshowcase.protobuf.AlertRule
+	139, // 73: neoshowcase.protobuf.Alert.fired_at:type_name -> google.protobuf.Timestamp
+	140, // 74: neoshowcase.protobuf.Alert.resolved_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	71,  // 75: neoshowcase.protobuf.Alerts.alerts:type_name -> neoshowcase.protobuf.Alert
+	14,  // 76: neoshowcase.protobuf.NotificationSubscription.sink:type_name -> neoshowcase.protobuf.NotificationSubscription.Sink
+	15,  // 77: neoshowcase.protobuf.NotificationSubscription.events:type_name -> neoshowcase.protobuf.NotificationSubscription.Event
+	139, // 78: neoshowcase.protobuf.NotificationSubscription.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 79: neoshowcase.protobuf.NotificationSubscriptions.subscriptions:type_name -> neoshowcase.protobuf.NotificationSubscription
+	3,   // 80: neoshowcase.protobuf.Build.status:type_name -> neoshowcase.protobuf.BuildStatus
+	139, // 81: neoshowcase.protobuf.Build.queued_at:type_name -> google.protobuf.Timestamp
+	140, // 82: neoshowcase.protobuf.Build.started_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	140, // 83: neoshowcase.protobuf.Build.updated_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	140, // 84: neoshowcase.protobuf.Build.finished_at:type_name -> neoshowcase.protobuf.NullTimestamp
+	55,  // 85: neoshowcase.protobuf.Build.artifacts:type_name -> neoshowcase.protobuf.Artifact
+	57,  // 86: neoshowcase.protobuf.Build.runtime_image:type_name -> neoshowcase.protobuf.RuntimeImage
+	24,  // 87: neoshowcase.protobuf.GetUsersResponse.users:type_name -> neoshowcase.protobuf.User
+	25,  // 88: neoshowcase.protobuf.GetUserKeysResponse.keys:type_name -> neoshowcase.protobuf.UserKey
+	5,   // 89: neoshowcase.protobuf.CreateCustomDomainRequest.method:type_name -> neoshowcase.protobuf.CustomDomain.VerificationMethod
+	30,  // 90: neoshowcase.protobuf.GetMyUsageResponse.quota:type_name -> neoshowcase.protobuf.ResourceQuota
+	31,  // 91: neoshowcase.protobuf.GetMyUsageResponse.usage:type_name -> neoshowcase.protobuf.ResourceUsage
+	30,  // 92: neoshowcase.protobuf.SetUserQuotaRequest.quota:type_name -> neoshowcase.protobuf.ResourceQuota
+	141, // 93: neoshowcase.protobuf.CreateRepositoryAuth.none:type_name -> google.protobuf.Empty
+	89,  // 94: neoshowcase.protobuf.CreateRepositoryAuth.basic:type_name -> neoshowcase.protobuf.CreateRepositoryAuthBasic
+	90,  // 95: neoshowcase.protobuf.CreateRepositoryAuth.ssh:type_name -> neoshowcase.protobuf.CreateRepositoryAuthSSH
+	91,  // 96: neoshowcase.protobuf.CreateRepositoryRequest.auth:type_name -> neoshowcase.protobuf.CreateRepositoryAuth
+	16,  // 97: neoshowcase.protobuf.GetRepositoriesRequest.scope:type_name -> neoshowcase.protobuf.GetRepositoriesRequest.Scope
+	91,  // 98: neoshowcase.protobuf.UpdateRepositoryRequest.auth:type_name -> neoshowcase.protobuf.CreateRepositoryAuth
+	135, // 99: neoshowcase.protobuf.UpdateRepositoryRequest.owner_ids:type_name -> neoshowcase.protobuf.UpdateRepositoryRequest.UpdateOwners
+	33,  // 100: neoshowcase.protobuf.GetRepositoryCommitsResponse.commits:type_name -> neoshowcase.protobuf.SimpleCommit
+	1,   // 101: neoshowcase.protobuf.CreateWebsiteRequest.authentication:type_name -> neoshowcase.protobuf.AuthenticationType
+	46,  // 102: neoshowcase.protobuf.CreateWebsiteRequest.rules:type_name -> neoshowcase.protobuf.WebsiteRule
+	47,  // 103: neoshowcase.protobuf.CreateWebsiteRequest.header_policy:type_name -> neoshowcase.protobuf.WebsiteHeaderPolicy
+	48,  // 104: neoshowcase.protobuf.CreateWebsiteRequest.access_control:type_name -> neoshowcase.protobuf.WebsiteAccessControl
+	49,  // 105: neoshowcase.protobuf.CreateWebsiteRequest.rate_limit:type_name -> neoshowcase.protobuf.WebsiteRateLimit
+	45,  // 106: neoshowcase.protobuf.CreateWebsiteRequest.backends:type_name -> neoshowcase.protobuf.WebsiteBackend
+	43,  // 107: neoshowcase.protobuf.CreateApplicationRequest.config:type_name -> neoshowcase.protobuf.ApplicationConfig
+	98,  // 108: neoshowcase.protobuf.CreateApplicationRequest.websites:type_name -> neoshowcase.protobuf.CreateWebsiteRequest
+	50,  // 109: neoshowcase.protobuf.CreateApplicationRequest.port_publications:type_name -> neoshowcase.protobuf.PortPublication
+	51,  // 110: neoshowcase.protobuf.CreateApplicationRequest.internal_service:type_name -> neoshowcase.protobuf.InternalService
+	17,  // 111: neoshowcase.protobuf.GetApplicationsRequest.scope:type_name -> neoshowcase.protobuf.GetApplicationsRequest.Scope
+	43,  // 112: neoshowcase.protobuf.UpdateApplicationRequest.config:type_name -> neoshowcase.protobuf.ApplicationConfig
+	136, // 113: neoshowcase.protobuf.UpdateApplicationRequest.websites:type_name -> neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites
+	137, // 114: neoshowcase.protobuf.UpdateApplicationRequest.port_publications:type_name -> neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts
+	138, // 115: neoshowcase.protobuf.UpdateApplicationRequest.owner_ids:type_name -> neoshowcase.protobuf.UpdateApplicationRequest.UpdateOwners
+	51,  // 116: neoshowcase.protobuf.UpdateApplicationRequest.internal_service:type_name -> neoshowcase.protobuf.InternalService
+	4,   // 117: neoshowcase.protobuf.ExportApplicationsRequest.format:type_name -> neoshowcase.protobuf.ManifestFormat
+	107, // 118: neoshowcase.protobuf.ManifestApplicationResult.diffs:type_name -> neoshowcase.protobuf.ManifestFieldDiff
+	108, // 119: neoshowcase.protobuf.ApplyManifestResponse.results:type_name -> neoshowcase.protobuf.ManifestApplicationResult
+	32,  // 120: neoshowcase.protobuf.GetRepositoriesResponse.repositories:type_name -> neoshowcase.protobuf.Repository
+	52,  // 121: neoshowcase.protobuf.GetApplicationsResponse.applications:type_name -> neoshowcase.protobuf.Application
+	75,  // 122: neoshowcase.protobuf.GetBuildsResponse.builds:type_name -> neoshowcase.protobuf.Build
+	139, // 123: neoshowcase.protobuf.GetApplicationMetricsRequest.before:type_name -> google.protobuf.Timestamp
+	18,  // 124: neoshowcase.protobuf.LogFilter.stream:type_name -> neoshowcase.protobuf.LogFilter.Stream
+	139, // 125: neoshowcase.protobuf.GetOutputRequest.before:type_name -> google.protobuf.Timestamp
+	120, // 126: neoshowcase.protobuf.GetOutputRequest.filter:type_name -> neoshowcase.protobuf.LogFilter
+	139, // 127: neoshowcase.protobuf.GetOutputStreamRequest.begin:type_name -> google.protobuf.Timestamp
+	120, // 128: neoshowcase.protobuf.GetOutputStreamRequest.filter:type_name -> neoshowcase.protobuf.LogFilter
+	11,  // 129: neoshowcase.protobuf.CreateAlertRuleRequest.kind:type_name -> neoshowcase.protobuf.AlertRule.Kind
+	12,  // 130: neoshowcase.protobuf.CreateAlertRuleRequest.comparison:type_name -> neoshowcase.protobuf.AlertRule.Comparison
+	13,  // 131: neoshowcase.protobuf.CreateAlertRuleRequest.threshold_unit:type_name -> neoshowcase.protobuf.AlertRule.ThresholdUnit
+	14,  // 132: neoshowcase.protobuf.CreateNotificationSubscriptionRequest.sink:type_name -> neoshowcase.protobuf.NotificationSubscription.Sink
+	15,  // 133: neoshowcase.protobuf.CreateNotificationSubscriptionRequest.events:type_name -> neoshowcase.protobuf.NotificationSubscription.Event
+	139, // 134: neoshowcase.protobuf.GetApplicationEventsRequest.before:type_name -> google.protobuf.Timestamp
+	139, // 135: neoshowcase.protobuf.GetApplicationEventsStreamRequest.begin:type_name -> google.protobuf.Timestamp
+	77,  // 136: neoshowcase.protobuf.GetRepositoryRefsResponse.refs:type_name -> neoshowcase.protobuf.GitRef
+	98,  // 137: neoshowcase.protobuf.UpdateApplicationRequest.UpdateWebsites.websites:type_name -> neoshowcase.protobuf.CreateWebsiteRequest
+	50,  // 138: neoshowcase.protobuf.UpdateApplicationRequest.UpdatePorts.port_publications:type_name -> neoshowcase.protobuf.PortPublication
+	141, // 139: neoshowcase.protobuf.APIService.GetSystemInfo:input_type -> google.protobuf.Empty
+	141, // 140: neoshowcase.protobuf.APIService.GenerateKeyPair:input_type -> google.protobuf.Empty
+	141, // 141: neoshowcase.protobuf.APIService.GetMe:input_type -> google.protobuf.Empty
+	141, // 142: neoshowcase.protobuf.APIService.GetUsers:input_type -> google.protobuf.Empty
+	81,  // 143: neoshowcase.protobuf.APIService.CreateUserKey:input_type -> neoshowcase.protobuf.CreateUserKeyRequest
+	141, // 144: neoshowcase.protobuf.APIService.GetUserKeys:input_type -> google.protobuf.Empty
+	82,  // 145: neoshowcase.protobuf.APIService.DeleteUserKey:input_type -> neoshowcase.protobuf.DeleteUserKeyRequest
+	141, // 146: neoshowcase.protobuf.APIService.GetMyUsage:input_type -> google.protobuf.Empty
+	88,  // 147: neoshowcase.protobuf.APIService.SetUserQuota:input_type -> neoshowcase.protobuf.SetUserQuotaRequest
+	141, // 148: neoshowcase.protobuf.APIService.GetCustomDomains:input_type -> google.protobuf.Empty
+	83,  // 149: neoshowcase.protobuf.APIService.CreateCustomDomain:input_type -> neoshowcase.protobuf.CreateCustomDomainRequest
+	84,  // 150: neoshowcase.protobuf.APIService.VerifyCustomDomain:input_type -> neoshowcase.protobuf.CustomDomainIdRequest
+	84,  // 151: neoshowcase.protobuf.APIService.DeleteCustomDomain:input_type -> neoshowcase.protobuf.CustomDomainIdRequest
+	141, // 152: neoshowcase.protobuf.APIService.GetTLSCertificates:input_type -> google.protobuf.Empty
+	85,  // 153: neoshowcase.protobuf.APIService.UploadTLSCertificate:input_type -> neoshowcase.protobuf.UploadTLSCertificateRequest
+	86,  // 154: neoshowcase.protobuf.APIService.DeleteTLSCertificate:input_type -> neoshowcase.protobuf.TLSCertificateIdRequest
+	92,  // 155: neoshowcase.protobuf.APIService.CreateRepository:input_type -> neoshowcase.protobuf.CreateRepositoryRequest
+	93,  // 156: neoshowcase.protobuf.APIService.GetRepositories:input_type -> neoshowcase.protobuf.GetRepositoriesRequest
+	96,  // 157: neoshowcase.protobuf.APIService.GetRepositoryCommits:input_type -> neoshowcase.protobuf.GetRepositoryCommitsRequest
+	95,  // 158: neoshowcase.protobuf.APIService.GetRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
+	95,  // 159: neoshowcase.protobuf.APIService.GetRepositoryRefs:input_type -> neoshowcase.protobuf.RepositoryIdRequest
+	94,  // 160: neoshowcase.protobuf.APIService.UpdateRepository:input_type -> neoshowcase.protobuf.UpdateRepositoryRequest
+	95,  // 161: neoshowcase.protobuf.APIService.RefreshRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
+	95,  // 162: neoshowcase.protobuf.APIService.DeleteRepository:input_type -> neoshowcase.protobuf.RepositoryIdRequest
+	100, // 163: neoshowcase.protobuf.APIService.CreateApplication:input_type -> neoshowcase.protobuf.CreateApplicationRequest
+	101, // 164: neoshowcase.protobuf.APIService.DuplicateApplication:input_type -> neoshowcase.protobuf.DuplicateApplicationRequest
+	102, // 165: neoshowcase.protobuf.APIService.GetApplications:input_type -> neoshowcase.protobuf.GetApplicationsRequest
+	112, // 166: neoshowcase.protobuf.APIService.GetApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	103, // 167: neoshowcase.protobuf.APIService.UpdateApplication:input_type -> neoshowcase.protobuf.UpdateApplicationRequest
+	112, // 168: neoshowcase.protobuf.APIService.DeleteApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	104, // 169: neoshowcase.protobuf.APIService.ExportApplications:input_type -> neoshowcase.protobuf.ExportApplicationsRequest
+	106, // 170: neoshowcase.protobuf.APIService.ApplyManifest:input_type -> neoshowcase.protobuf.ApplyManifestRequest
+	141, // 171: neoshowcase.protobuf.APIService.GetAvailableMetrics:input_type -> google.protobuf.Empty
+	119, // 172: neoshowcase.protobuf.APIService.GetApplicationMetrics:input_type -> neoshowcase.protobuf.GetApplicationMetricsRequest
+	121, // 173: neoshowcase.protobuf.APIService.GetOutput:input_type -> neoshowcase.protobuf.GetOutputRequest
+	122, // 174: neoshowcase.protobuf.APIService.GetOutputStream:input_type -> neoshowcase.protobuf.GetOutputStreamRequest
+	128, // 175: neoshowcase.protobuf.APIService.GetApplicationEvents:input_type -> neoshowcase.protobuf.GetApplicationEventsRequest
+	129, // 176: neoshowcase.protobuf.APIService.GetApplicationEventsStream:input_type -> neoshowcase.protobuf.GetApplicationEventsStreamRequest
+	112, // 177: neoshowcase.protobuf.APIService.GetWebsiteStatus:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	112, // 178: neoshowcase.protobuf.APIService.GetAlertRules:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	123, // 179: neoshowcase.protobuf.APIService.CreateAlertRule:input_type -> neoshowcase.protobuf.CreateAlertRuleRequest
+	124, // 180: neoshowcase.protobuf.APIService.DeleteAlertRule:input_type -> neoshowcase.protobuf.DeleteAlertRuleRequest
+	127, // 181: neoshowcase.protobuf.APIService.GetAlerts:input_type -> neoshowcase.protobuf.GetAlertsRequest
+	112, // 182: neoshowcase.protobuf.APIService.GetNotificationSubscriptions:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	125, // 183: neoshowcase.protobuf.APIService.CreateNotificationSubscription:input_type -> neoshowcase.protobuf.CreateNotificationSubscriptionRequest
+	126, // 184: neoshowcase.protobuf.APIService.DeleteNotificationSubscription:input_type -> neoshowcase.protobuf.DeleteNotificationSubscriptionRequest
+	112, // 185: neoshowcase.protobuf.APIService.GetEnvVars:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	117, // 186: neoshowcase.protobuf.APIService.SetEnvVar:input_type -> neoshowcase.protobuf.SetApplicationEnvVarRequest
+	118, // 187: neoshowcase.protobuf.APIService.DeleteEnvVar:input_type -> neoshowcase.protobuf.DeleteApplicationEnvVarRequest
+	112, // 188: neoshowcase.protobuf.APIService.StartApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	112, // 189: neoshowcase.protobuf.APIService.StopApplication:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	113, // 190: neoshowcase.protobuf.APIService.GetAllBuilds:input_type -> neoshowcase.protobuf.GetAllBuildsRequest
+	112, // 191: neoshowcase.protobuf.APIService.GetBuilds:input_type -> neoshowcase.protobuf.ApplicationIdRequest
+	114, // 192: neoshowcase.protobuf.APIService.GetBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
+	130, // 193: neoshowcase.protobuf.APIService.RetryCommitBuild:input_type -> neoshowcase.protobuf.RetryCommitBuildRequest
+	114, // 194: neoshowcase.protobuf.APIService.CancelBuild:input_type -> neoshowcase.protobuf.BuildIdRequest
+	114, // 195: neoshowcase.protobuf.APIService.GetBuildLog:input_type -> neoshowcase.protobuf.BuildIdRequest
+	114, // 196: neoshowcase.protobuf.APIService.GetBuildLogStream:input_type -> neoshowcase.protobuf.BuildIdRequest
+	115, // 197: neoshowcase.protobuf.APIService.GetBuildArtifact:input_type -> neoshowcase.protobuf.ArtifactIdRequest
+	23,  // 198: neoshowcase.protobuf.APIService.GetSystemInfo:output_type -> neoshowcase.protobuf.SystemInfo
+	78,  // 199: neoshowcase.protobuf.APIService.GenerateKeyPair:output_type -> neoshowcase.protobuf.GenerateKeyPairResponse
+	24,  // 200: neoshowcase.protobuf.APIService.GetMe:output_type -> neoshowcase.protobuf.User
+	79,  // 201: neoshowcase.protobuf.APIService.GetUsers:output_type -> neoshowcase.protobuf.GetUsersResponse
+	25,  // 202: neoshowcase.protobuf.APIService.CreateUserKey:output_type -> neoshowcase.protobuf.UserKey
+	80,  // 203: neoshowcase.protobuf.APIService.GetUserKeys:output_type -> neoshowcase.protobuf.GetUserKeysResponse
+	141, // 204: neoshowcase.protobuf.APIService.DeleteUserKey:output_type -> google.protobuf.Empty
+	87,  // 205: neoshowcase.protobuf.APIService.GetMyUsage:output_type -> neoshowcase.protobuf.GetMyUsageResponse
+	141, // 206: neoshowcase.protobuf.APIService.SetUserQuota:output_type -> google.protobuf.Empty
+	27,  // 207: neoshowcase.protobuf.APIService.GetCustomDomains:output_type -> neoshowcase.protobuf.GetCustomDomainsResponse
+	26,  // 208: neoshowcase.protobuf.APIService.CreateCustomDomain:output_type -> neoshowcase.protobuf.CustomDomain
+	26,  // 209: neoshowcase.protobuf.APIService.VerifyCustomDomain:output_type -> neoshowcase.protobuf.CustomDomain
+	141, // 210: neoshowcase.protobuf.APIService.DeleteCustomDomain:output_type -> google.protobuf.Empty
+	29,  // 211: neoshowcase.protobuf.APIService.GetTLSCertificates:output_type -> neoshowcase.protobuf.GetTLSCertificatesResponse
+	28,  // 212: neoshowcase.protobuf.APIService.UploadTLSCertificate:output_type -> neoshowcase.protobuf.TLSCertificate
+	141, // 213: neoshowcase.protobuf.APIService.DeleteTLSCertificate:output_type -> google.protobuf.Empty
+	32,  // 214: neoshowcase.protobuf.APIService.CreateRepository:output_type -> neoshowcase.protobuf.Repository
+	110, // 215: neoshowcase.protobuf.APIService.GetRepositories:output_type -> neoshowcase.protobuf.GetRepositoriesResponse
+	97,  // 216: neoshowcase.protobuf.APIService.GetRepositoryCommits:output_type -> neoshowcase.protobuf.GetRepositoryCommitsResponse
+	32,  // 217: neoshowcase.protobuf.APIService.GetRepository:output_type -> neoshowcase.protobuf.Repository
+	131, // 218: neoshowcase.protobuf.APIService.GetRepositoryRefs:output_type -> neoshowcase.protobuf.GetRepositoryRefsResponse
+	141, // 219: neoshowcase.protobuf.APIService.UpdateRepository:output_type -> google.protobuf.Empty
+	141, // 220: neoshowcase.protobuf.APIService.RefreshRepository:output_type -> google.protobuf.Empty
+	141, // 221: neoshowcase.protobuf.APIService.DeleteRepository:output_type -> google.protobuf.Empty
+	52,  // 222: neoshowcase.protobuf.APIService.CreateApplication:output_type -> neoshowcase.protobuf.Application
+	52,  // 223: neoshowcase.protobuf.APIService.DuplicateApplication:output_type -> neoshowcase.protobuf.Application
+	111, // 224: neoshowcase.protobuf.APIService.GetApplications:output_type -> neoshowcase.protobuf.GetApplicationsResponse
+	52,  // 225: neoshowcase.protobuf.APIService.GetApplication:output_type -> neoshowcase.protobuf.Application
+	141, // 226: neoshowcase.protobuf.APIService.UpdateApplication:output_type -> google.protobuf.Empty
+	141, // 227: neoshowcase.protobuf.APIService.DeleteApplication:output_type -> google.protobuf.Empty
+	105, // 228: neoshowcase.protobuf.APIService.ExportApplications:output_type -> neoshowcase.protobuf.ExportApplicationsResponse
+	109, // 229: neoshowcase.protobuf.APIService.ApplyManifest:output_type -> neoshowcase.protobuf.ApplyManifestResponse
+	58,  // 230: neoshowcase.protobuf.APIService.GetAvailableMetrics:output_type -> neoshowcase.protobuf.AvailableMetrics
+	60,  // 231: neoshowcase.protobuf.APIService.GetApplicationMetrics:output_type -> neoshowcase.protobuf.ApplicationMetrics
+	62,  // 232: neoshowcase.protobuf.APIService.GetOutput:output_type -> neoshowcase.protobuf.ApplicationOutputs
+	61,  // 233: neoshowcase.protobuf.APIService.GetOutputStream:output_type -> neoshowcase.protobuf.ApplicationOutput
+	64,  // 234: neoshowcase.protobuf.APIService.GetApplicationEvents:output_type -> neoshowcase.protobuf.ApplicationEvents
+	63,  // 235: neoshowcase.protobuf.APIService.GetApplicationEventsStream:output_type -> neoshowcase.protobuf.ApplicationEvent
+	68,  // 236: neoshowcase.protobuf.APIService.GetWebsiteStatus:output_type -> neoshowcase.protobuf.WebsiteStatuses
+	70,  // 237: neoshowcase.protobuf.APIService.GetAlertRules:output_type -> neoshowcase.protobuf.AlertRules
+	69,  // 238: neoshowcase.protobuf.APIService.CreateAlertRule:output_type -> neoshowcase.protobuf.AlertRule
+	141, // 239: neoshowcase.protobuf.APIService.DeleteAlertRule:output_type -> google.protobuf.Empty
+	72,  // 240: neoshowcase.protobuf.APIService.GetAlerts:output_type -> neoshowcase.protobuf.Alerts
+	74,  // 241: neoshowcase.protobuf.APIService.GetNotificationSubscriptions:output_type -> neoshowcase.protobuf.NotificationSubscriptions
+	73,  // 242: neoshowcase.protobuf.APIService.CreateNotificationSubscription:output_type -> neoshowcase.protobuf.NotificationSubscription
+	141, // 243: neoshowcase.protobuf.APIService.DeleteNotificationSubscription:output_type -> google.protobuf.Empty
+	54,  // 244: neoshowcase.protobuf.APIService.GetEnvVars:output_type -> neoshowcase.protobuf.ApplicationEnvVars
+	141, // 245: neoshowcase.protobuf.APIService.SetEnvVar:output_type -> google.protobuf.Empty
+	141, // 246: neoshowcase.protobuf.APIService.DeleteEnvVar:output_type -> google.protobuf.Empty
+	141, // 247: neoshowcase.protobuf.APIService.StartApplication:output_type -> google.protobuf.Empty
+	141, // 248: neoshowcase.protobuf.APIService.StopApplication:output_type -> google.protobuf.Empty
+	116, // 249: neoshowcase.protobuf.APIService.GetAllBuilds:output_type -> neoshowcase.protobuf.GetBuildsResponse
+	116, // 250: neoshowcase.protobuf.APIService.GetBuilds:output_type -> neoshowcase.protobuf.GetBuildsResponse
+	75,  // 251: neoshowcase.protobuf.APIService.GetBuild:output_type -> neoshowcase.protobuf.Build
+	141, // 252: neoshowcase.protobuf.APIService.RetryCommitBuild:output_type -> google.protobuf.Empty
+	141, // 253: neoshowcase.protobuf.APIService.CancelBuild:output_type -> google.protobuf.Empty
+	76,  // 254: neoshowcase.protobuf.APIService.GetBuildLog:output_type -> neoshowcase.protobuf.BuildLog
+	76,  // 255: neoshowcase.protobuf.APIService.GetBuildLogStream:output_type -> neoshowcase.protobuf.BuildLog
+	56,  // 256: neoshowcase.protobuf.APIService.GetBuildArtifact:output_type -> neoshowcase.protobuf.ArtifactContent
+	198, // [198:257] is the sub-list for method output_type
+	139, // [139:198] is the sub-list for method input_type
+	139, // [139:139] is the sub-list for extension type_name
+	139, // [139:139] is the sub-list for extension extendee
+	0,   // [0:139] is the sub-list for field type_name
 }
 
 func init() { file_neoshowcase_protobuf_gateway_proto_init() }
@@ -5576,7 +9897,7 @@ func file_neoshowcase_protobuf_gateway_proto_init() {
 		return
 	}
 	file_neoshowcase_protobuf_null_proto_init()
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[18].OneofWrappers = []any{
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[24].OneofWrappers = []any{
 		(*ApplicationConfig_RuntimeBuildpack)(nil),
 		(*ApplicationConfig_RuntimeCmd)(nil),
 		(*ApplicationConfig_RuntimeDockerfile)(nil),
@@ -5584,23 +9905,27 @@ func file_neoshowcase_protobuf_gateway_proto_init() {
 		(*ApplicationConfig_StaticCmd)(nil),
 		(*ApplicationConfig_StaticDockerfile)(nil),
 	}
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[21].OneofWrappers = []any{}
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[32].OneofWrappers = []any{}
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[42].OneofWrappers = []any{
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[33].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[47].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[48].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[56].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[69].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[72].OneofWrappers = []any{
 		(*CreateRepositoryAuth_None)(nil),
 		(*CreateRepositoryAuth_Basic)(nil),
 		(*CreateRepositoryAuth_Ssh)(nil),
 	}
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[45].OneofWrappers = []any{}
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[52].OneofWrappers = []any{}
-	file_neoshowcase_protobuf_gateway_proto_msgTypes[53].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[75].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[82].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[83].OneofWrappers = []any{}
+	file_neoshowcase_protobuf_gateway_proto_msgTypes[84].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_neoshowcase_protobuf_gateway_proto_rawDesc), len(file_neoshowcase_protobuf_gateway_proto_rawDesc)),
-			NumEnums:      9,
-			NumMessages:   72,
+			NumEnums:      19,
+			NumMessages:   120,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -52,6 +52,31 @@ const (
 	// APIServiceDeleteUserKeyProcedure is the fully-qualified name of the APIService's DeleteUserKey
 	// RPC.
 	APIServiceDeleteUserKeyProcedure = "/neoshowcase.protobuf.APIService/DeleteUserKey"
+	// APIServiceGetMyUsageProcedure is the fully-qualified name of the APIService's GetMyUsage RPC.
+	APIServiceGetMyUsageProcedure = "/neoshowcase.protobuf.APIService/GetMyUsage"
+	// APIServiceSetUserQuotaProcedure is the fully-qualified name of the APIService's SetUserQuota RPC.
+	APIServiceSetUserQuotaProcedure = "/neoshowcase.protobuf.APIService/SetUserQuota"
+	// APIServiceGetCustomDomainsProcedure is the fully-qualified name of the APIService's
+	// GetCustomDomains RPC.
+	APIServiceGetCustomDomainsProcedure = "/neoshowcase.protobuf.APIService/GetCustomDomains"
+	// APIServiceCreateCustomDomainProcedure is the fully-qualified name of the APIService's
+	// CreateCustomDomain RPC.
+	APIServiceCreateCustomDomainProcedure = "/neoshowcase.protobuf.APIService/CreateCustomDomain"
+	// APIServiceVerifyCustomDomainProcedure is the fully-qualified name of the APIService's
+	// VerifyCustomDomain RPC.
+	APIServiceVerifyCustomDomainProcedure = "/neoshowcase.protobuf.APIService/VerifyCustomDomain"
+	// APIServiceDeleteCustomDomainProcedure is the fully-qualified name of the APIService's
+	// DeleteCustomDomain RPC.
+	APIServiceDeleteCustomDomainProcedure = "/neoshowcase.protobuf.APIService/DeleteCustomDomain"
+	// APIServiceGetTLSCertificatesProcedure is the fully-qualified name of the APIService's
+	// GetTLSCertificates RPC.
+	APIServiceGetTLSCertificatesProcedure = "/neoshowcase.protobuf.APIService/GetTLSCertificates"
+	// APIServiceUploadTLSCertificateProcedure is the fully-qualified name of the APIService's
+	// UploadTLSCertificate RPC.
+	APIServiceUploadTLSCertificateProcedure = "/neoshowcase.protobuf.APIService/UploadTLSCertificate"
+	// APIServiceDeleteTLSCertificateProcedure is the fully-qualified name of the APIService's
+	// DeleteTLSCertificate RPC.
+	APIServiceDeleteTLSCertificateProcedure = "/neoshowcase.protobuf.APIService/DeleteTLSCertificate"
 	// APIServiceCreateRepositoryProcedure is the fully-qualified name of the APIService's
 	// CreateRepository RPC.
 	APIServiceCreateRepositoryProcedure = "/neoshowcase.protobuf.APIService/CreateRepository"
@@ -79,6 +104,9 @@ const (
 	// APIServiceCreateApplicationProcedure is the fully-qualified name of the APIService's
 	// CreateApplication RPC.
 	APIServiceCreateApplicationProcedure = "/neoshowcase.protobuf.APIService/CreateApplication"
+	// APIServiceDuplicateApplicationProcedure is the fully-qualified name of the APIService's
+	// DuplicateApplication RPC.
+	APIServiceDuplicateApplicationProcedure = "/neoshowcase.protobuf.APIService/DuplicateApplication"
 	// APIServiceGetApplicationsProcedure is the fully-qualified name of the APIService's
 	// GetApplications RPC.
 	APIServiceGetApplicationsProcedure = "/neoshowcase.protobuf.APIService/GetApplications"
@@ -91,6 +119,12 @@ const (
 	// APIServiceDeleteApplicationProcedure is the fully-qualified name of the APIService's
 	// DeleteApplication RPC.
 	APIServiceDeleteApplicationProcedure = "/neoshowcase.protobuf.APIService/DeleteApplication"
+	// APIServiceExportApplicationsProcedure is the fully-qualified name of the APIService's
+	// ExportApplications RPC.
+	APIServiceExportApplicationsProcedure = "/neoshowcase.protobuf.APIService/ExportApplications"
+	// APIServiceApplyManifestProcedure is the fully-qualified name of the APIService's ApplyManifest
+	// RPC.
+	APIServiceApplyManifestProcedure = "/neoshowcase.protobuf.APIService/ApplyManifest"
 	// APIServiceGetAvailableMetricsProcedure is the fully-qualified name of the APIService's
 	// GetAvailableMetrics RPC.
 	APIServiceGetAvailableMetricsProcedure = "/neoshowcase.protobuf.APIService/GetAvailableMetrics"
@@ -102,6 +136,35 @@ const (
 	// APIServiceGetOutputStreamProcedure is the fully-qualified name of the APIService's
 	// GetOutputStream RPC.
 	APIServiceGetOutputStreamProcedure = "/neoshowcase.protobuf.APIService/GetOutputStream"
+	// APIServiceGetApplicationEventsProcedure is the fully-qualified name of the APIService's
+	// GetApplicationEvents RPC.
+	APIServiceGetApplicationEventsProcedure = "/neoshowcase.protobuf.APIService/GetApplicationEvents"
+	// APIServiceGetApplicationEventsStreamProcedure is the fully-qualified name of the APIService's
+	// GetApplicationEventsStream RPC.
+	APIServiceGetApplicationEventsStreamProcedure = "/neoshowcase.protobuf.APIService/GetApplicationEventsStream"
+	// APIServiceGetWebsiteStatusProcedure is the fully-qualified name of the APIService's
+	// GetWebsiteStatus RPC.
+	APIServiceGetWebsiteStatusProcedure = "/neoshowcase.protobuf.APIService/GetWebsiteStatus"
+	// APIServiceGetAlertRulesProcedure is the fully-qualified name of the APIService's GetAlertRules
+	// RPC.
+	APIServiceGetAlertRulesProcedure = "/neoshowcase.protobuf.APIService/GetAlertRules"
+	// APIServiceCreateAlertRuleProcedure is the fully-qualified name of the APIService's
+	// CreateAlertRule RPC.
+	APIServiceCreateAlertRuleProcedure = "/neoshowcase.protobuf.APIService/CreateAlertRule"
+	// APIServiceDeleteAlertRuleProcedure is the fully-qualified name of the APIService's
+	// DeleteAlertRule RPC.
+	APIServiceDeleteAlertRuleProcedure = "/neoshowcase.protobuf.APIService/DeleteAlertRule"
+	// APIServiceGetAlertsProcedure is the fully-qualified name of the APIService's GetAlerts RPC.
+	APIServiceGetAlertsProcedure = "/neoshowcase.protobuf.APIService/GetAlerts"
+	// APIServiceGetNotificationSubscriptionsProcedure is the fully-qualified name of the APIService's
+	// GetNotificationSubscriptions RPC.
+	APIServiceGetNotificationSubscriptionsProcedure = "/neoshowcase.protobuf.APIService/GetNotificationSubscriptions"
+	// APIServiceCreateNotificationSubscriptionProcedure is the fully-qualified name of the APIService's
+	// CreateNotificationSubscription RPC.
+	APIServiceCreateNotificationSubscriptionProcedure = "/neoshowcase.protobuf.APIService/CreateNotificationSubscription"
+	// APIServiceDeleteNotificationSubscriptionProcedure is the fully-qualified name of the APIService's
+	// DeleteNotificationSubscription RPC.
+	APIServiceDeleteNotificationSubscriptionProcedure = "/neoshowcase.protobuf.APIService/DeleteNotificationSubscription"
 	// APIServiceGetEnvVarsProcedure is the fully-qualified name of the APIService's GetEnvVars RPC.
 	APIServiceGetEnvVarsProcedure = "/neoshowcase.protobuf.APIService/GetEnvVars"
 	// APIServiceSetEnvVarProcedure is the fully-qualified name of the APIService's SetEnvVar RPC.
@@ -151,6 +214,25 @@ type APIServiceClient interface {
 	GetUserKeys(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetUserKeysResponse], error)
 	// DeleteUserKey 登録した公開鍵を削除します
 	DeleteUserKey(context.Context, *connect.Request[pb.DeleteUserKeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetMyUsage 自身のリソース使用量と上限を取得します
+	GetMyUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetMyUsageResponse], error)
+	// SetUserQuota ユーザーのリソース上限を設定します (admin only)
+	SetUserQuota(context.Context, *connect.Request[pb.SetUserQuotaRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetCustomDomains 登録した独自ドメイン一覧を取得します
+	GetCustomDomains(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetCustomDomainsResponse], error)
+	// CreateCustomDomain 独自ドメインを登録します 所有権の確認は定期的に行われます
+	CreateCustomDomain(context.Context, *connect.Request[pb.CreateCustomDomainRequest]) (*connect.Response[pb.CustomDomain], error)
+	// VerifyCustomDomain 独自ドメインの所有権を直ちに確認します
+	VerifyCustomDomain(context.Context, *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[pb.CustomDomain], error)
+	// DeleteCustomDomain 登録した独自ドメインを削除します
+	DeleteCustomDomain(context.Context, *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetTLSCertificates アップロードしたTLS証明書一覧を取得します adminは全ての証明書を取得します
+	GetTLSCertificates(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetTLSCertificatesResponse], error)
+	// UploadTLSCertificate TLS証明書をアップロードします 同じfqdnの証明書が既にある場合は置き換えます
+	// admin以外は所有権が確認された独自ドメインの証明書のみアップロードできます
+	UploadTLSCertificate(context.Context, *connect.Request[pb.UploadTLSCertificateRequest]) (*connect.Response[pb.TLSCertificate], error)
+	// DeleteTLSCertificate アップロードしたTLS証明書を削除します
+	DeleteTLSCertificate(context.Context, *connect.Request[pb.TLSCertificateIdRequest]) (*connect.Response[emptypb.Empty], error)
 	// CreateRepository リポジトリを登録します
 	CreateRepository(context.Context, *connect.Request[pb.CreateRepositoryRequest]) (*connect.Response[pb.Repository], error)
 	// GetRepositories リポジトリ一覧を取得します
@@ -169,6 +251,8 @@ type APIServiceClient interface {
 	DeleteRepository(context.Context, *connect.Request[pb.RepositoryIdRequest]) (*connect.Response[emptypb.Empty], error)
 	// CreateApplication アプリを作成します
 	CreateApplication(context.Context, *connect.Request[pb.CreateApplicationRequest]) (*connect.Response[pb.Application], error)
+	// DuplicateApplication アプリの設定・環境変数・公開ポートをコピーして新しいアプリを作成します
+	DuplicateApplication(context.Context, *connect.Request[pb.DuplicateApplicationRequest]) (*connect.Response[pb.Application], error)
 	// GetApplications アプリ一覧を取得します
 	GetApplications(context.Context, *connect.Request[pb.GetApplicationsRequest]) (*connect.Response[pb.GetApplicationsResponse], error)
 	// GetApplication アプリを取得します
@@ -177,6 +261,10 @@ type APIServiceClient interface {
 	UpdateApplication(context.Context, *connect.Request[pb.UpdateApplicationRequest]) (*connect.Response[emptypb.Empty], error)
 	// DeleteApplication アプリを削除します 先にアプリのシャットダウンが必要です
 	DeleteApplication(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[emptypb.Empty], error)
+	// ExportApplications アプリの設定をマニフェストとして出力します 環境変数は値を含まずキーのみ出力します
+	ExportApplications(context.Context, *connect.Request[pb.ExportApplicationsRequest]) (*connect.Response[pb.ExportApplicationsResponse], error)
+	// ApplyManifest マニフェストに合わせてアプリを作成・更新します 全てのアプリの検証に成功した場合のみ適用します
+	ApplyManifest(context.Context, *connect.Request[pb.ApplyManifestRequest]) (*connect.Response[pb.ApplyManifestResponse], error)
 	// GetAvailableMetrics 取得可能メトリクス一覧を取得します
 	GetAvailableMetrics(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.AvailableMetrics], error)
 	// GetApplicationMetrics アプリのメトリクスを取得します
@@ -185,6 +273,26 @@ type APIServiceClient interface {
 	GetOutput(context.Context, *connect.Request[pb.GetOutputRequest]) (*connect.Response[pb.ApplicationOutputs], error)
 	// GetOutputStream アプリの出力をストリーム形式で取得します
 	GetOutputStream(context.Context, *connect.Request[pb.GetOutputStreamRequest]) (*connect.ServerStreamForClient[pb.ApplicationOutput], error)
+	// GetApplicationEvents アプリのイベント履歴を新しい順に取得します
+	GetApplicationEvents(context.Context, *connect.Request[pb.GetApplicationEventsRequest]) (*connect.Response[pb.ApplicationEvents], error)
+	// GetApplicationEventsStream beginより後に発生したアプリのイベントをストリーム形式で取得します
+	GetApplicationEventsStream(context.Context, *connect.Request[pb.GetApplicationEventsStreamRequest]) (*connect.ServerStreamForClient[pb.ApplicationEvent], error)
+	// GetWebsiteStatus アプリの各ウェブサイトの死活監視の結果と稼働率を取得します
+	GetWebsiteStatus(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.WebsiteStatuses], error)
+	// GetAlertRules アプリのアラートルール一覧を取得します
+	GetAlertRules(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.AlertRules], error)
+	// CreateAlertRule アプリのアラートルールを作成します
+	CreateAlertRule(context.Context, *connect.Request[pb.CreateAlertRuleRequest]) (*connect.Response[pb.AlertRule], error)
+	// DeleteAlertRule アプリのアラートルールを削除します ルールのアラート履歴も削除されます
+	DeleteAlertRule(context.Context, *connect.Request[pb.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetAlerts アプリのアラートの発火・解決履歴を新しい順に取得します
+	GetAlerts(context.Context, *connect.Request[pb.GetAlertsRequest]) (*connect.Response[pb.Alerts], error)
+	// GetNotificationSubscriptions アプリの自分の通知設定一覧を取得します
+	GetNotificationSubscriptions(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.NotificationSubscriptions], error)
+	// CreateNotificationSubscription アプリの通知設定を作成します
+	CreateNotificationSubscription(context.Context, *connect.Request[pb.CreateNotificationSubscriptionRequest]) (*connect.Response[pb.NotificationSubscription], error)
+	// DeleteNotificationSubscription アプリの自分の通知設定を削除します
+	DeleteNotificationSubscription(context.Context, *connect.Request[pb.DeleteNotificationSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
 	// GetEnvVars アプリの環境変数を取得します
 	GetEnvVars(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.ApplicationEnvVars], error)
 	// SetEnvVar アプリの環境変数をセットします システムによって設定された環境変数は上書きできません
@@ -270,6 +378,63 @@ func NewAPIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(aPIServiceMethods.ByName("DeleteUserKey")),
 			connect.WithClientOptions(opts...),
 		),
+		getMyUsage: connect.NewClient[emptypb.Empty, pb.GetMyUsageResponse](
+			httpClient,
+			baseURL+APIServiceGetMyUsageProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetMyUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setUserQuota: connect.NewClient[pb.SetUserQuotaRequest, emptypb.Empty](
+			httpClient,
+			baseURL+APIServiceSetUserQuotaProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("SetUserQuota")),
+			connect.WithClientOptions(opts...),
+		),
+		getCustomDomains: connect.NewClient[emptypb.Empty, pb.GetCustomDomainsResponse](
+			httpClient,
+			baseURL+APIServiceGetCustomDomainsProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetCustomDomains")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createCustomDomain: connect.NewClient[pb.CreateCustomDomainRequest, pb.CustomDomain](
+			httpClient,
+			baseURL+APIServiceCreateCustomDomainProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("CreateCustomDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyCustomDomain: connect.NewClient[pb.CustomDomainIdRequest, pb.CustomDomain](
+			httpClient,
+			baseURL+APIServiceVerifyCustomDomainProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("VerifyCustomDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCustomDomain: connect.NewClient[pb.CustomDomainIdRequest, emptypb.Empty](
+			httpClient,
+			baseURL+APIServiceDeleteCustomDomainProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("DeleteCustomDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		getTLSCertificates: connect.NewClient[emptypb.Empty, pb.GetTLSCertificatesResponse](
+			httpClient,
+			baseURL+APIServiceGetTLSCertificatesProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetTLSCertificates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		uploadTLSCertificate: connect.NewClient[pb.UploadTLSCertificateRequest, pb.TLSCertificate](
+			httpClient,
+			baseURL+APIServiceUploadTLSCertificateProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("UploadTLSCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTLSCertificate: connect.NewClient[pb.TLSCertificateIdRequest, emptypb.Empty](
+			httpClient,
+			baseURL+APIServiceDeleteTLSCertificateProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("DeleteTLSCertificate")),
+			connect.WithClientOptions(opts...),
+		),
 		createRepository: connect.NewClient[pb.CreateRepositoryRequest, pb.Repository](
 			httpClient,
 			baseURL+APIServiceCreateRepositoryProcedure,
@@ -328,6 +493,12 @@ func NewAPIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(aPIServiceMethods.ByName("CreateApplication")),
 			connect.WithClientOptions(opts...),
 		),
+		duplicateApplication: connect.NewClient[pb.DuplicateApplicationRequest, pb.Application](
+			httpClient,
+			baseURL+APIServiceDuplicateApplicationProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("DuplicateApplication")),
+			connect.WithClientOptions(opts...),
+		),
 		getApplications: connect.NewClient[pb.GetApplicationsRequest, pb.GetApplicationsResponse](
 			httpClient,
 			baseURL+APIServiceGetApplicationsProcedure,
@@ -354,6 +525,19 @@ func NewAPIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(aPIServiceMethods.ByName("DeleteApplication")),
 			connect.WithClientOptions(opts...),
 		),
+		exportApplications: connect.NewClient[pb.ExportApplicationsRequest, pb.ExportApplicationsResponse](
+			httpClient,
+			baseURL+APIServiceExportApplicationsProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("ExportApplications")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		applyManifest: connect.NewClient[pb.ApplyManifestRequest, pb.ApplyManifestResponse](
+			httpClient,
+			baseURL+APIServiceApplyManifestProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("ApplyManifest")),
+			connect.WithClientOptions(opts...),
+		),
 		getAvailableMetrics: connect.NewClient[emptypb.Empty, pb.AvailableMetrics](
 			httpClient,
 			baseURL+APIServiceGetAvailableMetricsProcedure,
@@ -381,6 +565,71 @@ func NewAPIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(aPIServiceMethods.ByName("GetOutputStream")),
 			connect.WithClientOptions(opts...),
 		),
+		getApplicationEvents: connect.NewClient[pb.GetApplicationEventsRequest, pb.ApplicationEvents](
+			httpClient,
+			baseURL+APIServiceGetApplicationEventsProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetApplicationEvents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getApplicationEventsStream: connect.NewClient[pb.GetApplicationEventsStreamRequest, pb.ApplicationEvent](
+			httpClient,
+			baseURL+APIServiceGetApplicationEventsStreamProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetApplicationEventsStream")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebsiteStatus: connect.NewClient[pb.ApplicationIdRequest, pb.WebsiteStatuses](
+			httpClient,
+			baseURL+APIServiceGetWebsiteStatusProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetWebsiteStatus")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getAlertRules: connect.NewClient[pb.ApplicationIdRequest, pb.AlertRules](
+			httpClient,
+			baseURL+APIServiceGetAlertRulesProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetAlertRules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createAlertRule: connect.NewClient[pb.CreateAlertRuleRequest, pb.AlertRule](
+			httpClient,
+			baseURL+APIServiceCreateAlertRuleProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("CreateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertRule: connect.NewClient[pb.DeleteAlertRuleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+APIServiceDeleteAlertRuleProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("DeleteAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlerts: connect.NewClient[pb.GetAlertsRequest, pb.Alerts](
+			httpClient,
+			baseURL+APIServiceGetAlertsProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetAlerts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationSubscriptions: connect.NewClient[pb.ApplicationIdRequest, pb.NotificationSubscriptions](
+			httpClient,
+			baseURL+APIServiceGetNotificationSubscriptionsProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("GetNotificationSubscriptions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createNotificationSubscription: connect.NewClient[pb.CreateNotificationSubscriptionRequest, pb.NotificationSubscription](
+			httpClient,
+			baseURL+APIServiceCreateNotificationSubscriptionProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("CreateNotificationSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationSubscription: connect.NewClient[pb.DeleteNotificationSubscriptionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+APIServiceDeleteNotificationSubscriptionProcedure,
+			connect.WithSchema(aPIServiceMethods.ByName("DeleteNotificationSubscription")),
+			connect.WithClientOptions(opts...),
+		),
 		getEnvVars: connect.NewClient[pb.ApplicationIdRequest, pb.ApplicationEnvVars](
 			httpClient,
 			baseURL+APIServiceGetEnvVarsProcedure,
@@ -470,43 +719,65 @@ func NewAPIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 
 // aPIServiceClient implements APIServiceClient.
 type aPIServiceClient struct {
-	getSystemInfo         *connect.Client[emptypb.Empty, pb.SystemInfo]
-	generateKeyPair       *connect.Client[emptypb.Empty, pb.GenerateKeyPairResponse]
-	getMe                 *connect.Client[emptypb.Empty, pb.User]
-	getUsers              *connect.Client[emptypb.Empty, pb.GetUsersResponse]
-	createUserKey         *connect.Client[pb.CreateUserKeyRequest, pb.UserKey]
-	getUserKeys           *connect.Client[emptypb.Empty, pb.GetUserKeysResponse]
-	deleteUserKey         *connect.Client[pb.DeleteUserKeyRequest, emptypb.Empty]
-	createRepository      *connect.Client[pb.CreateRepositoryRequest, pb.Repository]
-	getRepositories       *connect.Client[pb.GetRepositoriesRequest, pb.GetRepositoriesResponse]
-	getRepositoryCommits  *connect.Client[pb.GetRepositoryCommitsRequest, pb.GetRepositoryCommitsResponse]
-	getRepository         *connect.Client[pb.RepositoryIdRequest, pb.Repository]
-	getRepositoryRefs     *connect.Client[pb.RepositoryIdRequest, pb.GetRepositoryRefsResponse]
-	updateRepository      *connect.Client[pb.UpdateRepositoryRequest, emptypb.Empty]
-	refreshRepository     *connect.Client[pb.RepositoryIdRequest, emptypb.Empty]
-	deleteRepository      *connect.Client[pb.RepositoryIdRequest, emptypb.Empty]
-	createApplication     *connect.Client[pb.CreateApplicationRequest, pb.Application]
-	getApplications       *connect.Client[pb.GetApplicationsRequest, pb.GetApplicationsResponse]
-	getApplication        *connect.Client[pb.ApplicationIdRequest, pb.Application]
-	updateApplication     *connect.Client[pb.UpdateApplicationRequest, emptypb.Empty]
-	deleteApplication     *connect.Client[pb.ApplicationIdRequest, emptypb.Empty]
-	getAvailableMetrics   *connect.Client[emptypb.Empty, pb.AvailableMetrics]
-	getApplicationMetrics *connect.Client[pb.GetApplicationMetricsRequest, pb.ApplicationMetrics]
-	getOutput             *connect.Client[pb.GetOutputRequest, pb.ApplicationOutputs]
-	getOutputStream       *connect.Client[pb.GetOutputStreamRequest, pb.ApplicationOutput]
-	getEnvVars            *connect.Client[pb.ApplicationIdRequest, pb.ApplicationEnvVars]
-	setEnvVar             *connect.Client[pb.SetApplicationEnvVarRequest, emptypb.Empty]
-	deleteEnvVar          *connect.Client[pb.DeleteApplicationEnvVarRequest, emptypb.Empty]
-	startApplication      *connect.Client[pb.ApplicationIdRequest, emptypb.Empty]
-	stopApplication       *connect.Client[pb.ApplicationIdRequest, emptypb.Empty]
-	getAllBuilds          *connect.Client[pb.GetAllBuildsRequest, pb.GetBuildsResponse]
-	getBuilds             *connect.Client[pb.ApplicationIdRequest, pb.GetBuildsResponse]
-	getBuild              *connect.Client[pb.BuildIdRequest, pb.Build]
-	retryCommitBuild      *connect.Client[pb.RetryCommitBuildRequest, emptypb.Empty]
-	cancelBuild           *connect.Client[pb.BuildIdRequest, emptypb.Empty]
-	getBuildLog           *connect.Client[pb.BuildIdRequest, pb.BuildLog]
-	getBuildLogStream     *connect.Client[pb.BuildIdRequest, pb.BuildLog]
-	getBuildArtifact      *connect.Client[pb.ArtifactIdRequest, pb.ArtifactContent]
+	getSystemInfo                  *connect.Client[emptypb.Empty, pb.SystemInfo]
+	generateKeyPair                *connect.Client[emptypb.Empty, pb.GenerateKeyPairResponse]
+	getMe                          *connect.Client[emptypb.Empty, pb.User]
+	getUsers                       *connect.Client[emptypb.Empty, pb.GetUsersResponse]
+	createUserKey                  *connect.Client[pb.CreateUserKeyRequest, pb.UserKey]
+	getUserKeys                    *connect.Client[emptypb.Empty, pb.GetUserKeysResponse]
+	deleteUserKey                  *connect.Client[pb.DeleteUserKeyRequest, emptypb.Empty]
+	getMyUsage                     *connect.Client[emptypb.Empty, pb.GetMyUsageResponse]
+	setUserQuota                   *connect.Client[pb.SetUserQuotaRequest, emptypb.Empty]
+	getCustomDomains               *connect.Client[emptypb.Empty, pb.GetCustomDomainsResponse]
+	createCustomDomain             *connect.Client[pb.CreateCustomDomainRequest, pb.CustomDomain]
+	verifyCustomDomain             *connect.Client[pb.CustomDomainIdRequest, pb.CustomDomain]
+	deleteCustomDomain             *connect.Client[pb.CustomDomainIdRequest, emptypb.Empty]
+	getTLSCertificates             *connect.Client[emptypb.Empty, pb.GetTLSCertificatesResponse]
+	uploadTLSCertificate           *connect.Client[pb.UploadTLSCertificateRequest, pb.TLSCertificate]
+	deleteTLSCertificate           *connect.Client[pb.TLSCertificateIdRequest, emptypb.Empty]
+	createRepository               *connect.Client[pb.CreateRepositoryRequest, pb.Repository]
+	getRepositories                *connect.Client[pb.GetRepositoriesRequest, pb.GetRepositoriesResponse]
+	getRepositoryCommits           *connect.Client[pb.GetRepositoryCommitsRequest, pb.GetRepositoryCommitsResponse]
+	getRepository                  *connect.Client[pb.RepositoryIdRequest, pb.Repository]
+	getRepositoryRefs              *connect.Client[pb.RepositoryIdRequest, pb.GetRepositoryRefsResponse]
+	updateRepository               *connect.Client[pb.UpdateRepositoryRequest, emptypb.Empty]
+	refreshRepository              *connect.Client[pb.RepositoryIdRequest, emptypb.Empty]
+	deleteRepository               *connect.Client[pb.RepositoryIdRequest, emptypb.Empty]
+	createApplication              *connect.Client[pb.CreateApplicationRequest, pb.Application]
+	duplicateApplication           *connect.Client[pb.DuplicateApplicationRequest, pb.Application]
+	getApplications                *connect.Client[pb.GetApplicationsRequest, pb.GetApplicationsResponse]
+	getApplication                 *connect.Client[pb.ApplicationIdRequest, pb.Application]
+	updateApplication              *connect.Client[pb.UpdateApplicationRequest, emptypb.Empty]
+	deleteApplication              *connect.Client[pb.ApplicationIdRequest, emptypb.Empty]
+	exportApplications             *connect.Client[pb.ExportApplicationsRequest, pb.ExportApplicationsResponse]
+	applyManifest                  *connect.Client[pb.ApplyManifestRequest, pb.ApplyManifestResponse]
+	getAvailableMetrics            *connect.Client[emptypb.Empty, pb.AvailableMetrics]
+	getApplicationMetrics          *connect.Client[pb.GetApplicationMetricsRequest, pb.ApplicationMetrics]
+	getOutput                      *connect.Client[pb.GetOutputRequest, pb.ApplicationOutputs]
+	getOutputStream                *connect.Client[pb.GetOutputStreamRequest, pb.ApplicationOutput]
+	getApplicationEvents           *connect.Client[pb.GetApplicationEventsRequest, pb.ApplicationEvents]
+	getApplicationEventsStream     *connect.Client[pb.GetApplicationEventsStreamRequest, pb.ApplicationEvent]
+	getWebsiteStatus               *connect.Client[pb.ApplicationIdRequest, pb.WebsiteStatuses]
+	getAlertRules                  *connect.Client[pb.ApplicationIdRequest, pb.AlertRules]
+	createAlertRule                *connect.Client[pb.CreateAlertRuleRequest, pb.AlertRule]
+	deleteAlertRule                *connect.Client[pb.DeleteAlertRuleRequest, emptypb.Empty]
+	getAlerts                      *connect.Client[pb.GetAlertsRequest, pb.Alerts]
+	getNotificationSubscriptions   *connect.Client[pb.ApplicationIdRequest, pb.NotificationSubscriptions]
+	createNotificationSubscription *connect.Client[pb.CreateNotificationSubscriptionRequest, pb.NotificationSubscription]
+	deleteNotificationSubscription *connect.Client[pb.DeleteNotificationSubscriptionRequest, emptypb.Empty]
+	getEnvVars                     *connect.Client[pb.ApplicationIdRequest, pb.ApplicationEnvVars]
+	setEnvVar                      *connect.Client[pb.SetApplicationEnvVarRequest, emptypb.Empty]
+	deleteEnvVar                   *connect.Client[pb.DeleteApplicationEnvVarRequest, emptypb.Empty]
+	startApplication               *connect.Client[pb.ApplicationIdRequest, emptypb.Empty]
+	stopApplication                *connect.Client[pb.ApplicationIdRequest, emptypb.Empty]
+	getAllBuilds                   *connect.Client[pb.GetAllBuildsRequest, pb.GetBuildsResponse]
+	getBuilds                      *connect.Client[pb.ApplicationIdRequest, pb.GetBuildsResponse]
+	getBuild                       *connect.Client[pb.BuildIdRequest, pb.Build]
+	retryCommitBuild               *connect.Client[pb.RetryCommitBuildRequest, emptypb.Empty]
+	cancelBuild                    *connect.Client[pb.BuildIdRequest, emptypb.Empty]
+	getBuildLog                    *connect.Client[pb.BuildIdRequest, pb.BuildLog]
+	getBuildLogStream              *connect.Client[pb.BuildIdRequest, pb.BuildLog]
+	getBuildArtifact               *connect.Client[pb.ArtifactIdRequest, pb.ArtifactContent]
 }
 
 // GetSystemInfo calls neoshowcase.protobuf.APIService.GetSystemInfo.
@@ -544,6 +815,51 @@ func (c *aPIServiceClient) DeleteUserKey(ctx context.Context, req *connect.Reque
 	return c.deleteUserKey.CallUnary(ctx, req)
 }
 
+// GetMyUsage calls neoshowcase.protobuf.APIService.GetMyUsage.
+func (c *aPIServiceClient) GetMyUsage(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetMyUsageResponse], error) {
+	return c.getMyUsage.CallUnary(ctx, req)
+}
+
+// SetUserQuota calls neoshowcase.protobuf.APIService.SetUserQuota.
+func (c *aPIServiceClient) SetUserQuota(ctx context.Context, req *connect.Request[pb.SetUserQuotaRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.setUserQuota.CallUnary(ctx, req)
+}
+
+// GetCustomDomains calls neoshowcase.protobuf.APIService.GetCustomDomains.
+func (c *aPIServiceClient) GetCustomDomains(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetCustomDomainsResponse], error) {
+	return c.getCustomDomains.CallUnary(ctx, req)
+}
+
+// CreateCustomDomain calls neoshowcase.protobuf.APIService.CreateCustomDomain.
+func (c *aPIServiceClient) CreateCustomDomain(ctx context.Context, req *connect.Request[pb.CreateCustomDomainRequest]) (*connect.Response[pb.CustomDomain], error) {
+	return c.createCustomDomain.CallUnary(ctx, req)
+}
+
+// VerifyCustomDomain calls neoshowcase.protobuf.APIService.VerifyCustomDomain.
+func (c *aPIServiceClient) VerifyCustomDomain(ctx context.Context, req *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[pb.CustomDomain], error) {
+	return c.verifyCustomDomain.CallUnary(ctx, req)
+}
+
+// DeleteCustomDomain calls neoshowcase.protobuf.APIService.DeleteCustomDomain.
+func (c *aPIServiceClient) DeleteCustomDomain(ctx context.Context, req *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteCustomDomain.CallUnary(ctx, req)
+}
+
+// GetTLSCertificates calls neoshowcase.protobuf.APIService.GetTLSCertificates.
+func (c *aPIServiceClient) GetTLSCertificates(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetTLSCertificatesResponse], error) {
+	return c.getTLSCertificates.CallUnary(ctx, req)
+}
+
+// UploadTLSCertificate calls neoshowcase.protobuf.APIService.UploadTLSCertificate.
+func (c *aPIServiceClient) UploadTLSCertificate(ctx context.Context, req *connect.Request[pb.UploadTLSCertificateRequest]) (*connect.Response[pb.TLSCertificate], error) {
+	return c.uploadTLSCertificate.CallUnary(ctx, req)
+}
+
+// DeleteTLSCertificate calls neoshowcase.protobuf.APIService.DeleteTLSCertificate.
+func (c *aPIServiceClient) DeleteTLSCertificate(ctx context.Context, req *connect.Request[pb.TLSCertificateIdRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteTLSCertificate.CallUnary(ctx, req)
+}
+
 // CreateRepository calls neoshowcase.protobuf.APIService.CreateRepository.
 func (c *aPIServiceClient) CreateRepository(ctx context.Context, req *connect.Request[pb.CreateRepositoryRequest]) (*connect.Response[pb.Repository], error) {
 	return c.createRepository.CallUnary(ctx, req)
@@ -589,6 +905,11 @@ func (c *aPIServiceClient) CreateApplication(ctx context.Context, req *connect.R
 	return c.createApplication.CallUnary(ctx, req)
 }
 
+// DuplicateApplication calls neoshowcase.protobuf.APIService.DuplicateApplication.
+func (c *aPIServiceClient) DuplicateApplication(ctx context.Context, req *connect.Request[pb.DuplicateApplicationRequest]) (*connect.Response[pb.Application], error) {
+	return c.duplicateApplication.CallUnary(ctx, req)
+}
+
 // GetApplications calls neoshowcase.protobuf.APIService.GetApplications.
 func (c *aPIServiceClient) GetApplications(ctx context.Context, req *connect.Request[pb.GetApplicationsRequest]) (*connect.Response[pb.GetApplicationsResponse], error) {
 	return c.getApplications.CallUnary(ctx, req)
@@ -609,6 +930,16 @@ func (c *aPIServiceClient) DeleteApplication(ctx context.Context, req *connect.R
 	return c.deleteApplication.CallUnary(ctx, req)
 }
 
+// ExportApplications calls neoshowcase.protobuf.APIService.ExportApplications.
+func (c *aPIServiceClient) ExportApplications(ctx context.Context, req *connect.Request[pb.ExportApplicationsRequest]) (*connect.Response[pb.ExportApplicationsResponse], error) {
+	return c.exportApplications.CallUnary(ctx, req)
+}
+
+// ApplyManifest calls neoshowcase.protobuf.APIService.ApplyManifest.
+func (c *aPIServiceClient) ApplyManifest(ctx context.Context, req *connect.Request[pb.ApplyManifestRequest]) (*connect.Response[pb.ApplyManifestResponse], error) {
+	return c.applyManifest.CallUnary(ctx, req)
+}
+
 // GetAvailableMetrics calls neoshowcase.protobuf.APIService.GetAvailableMetrics.
 func (c *aPIServiceClient) GetAvailableMetrics(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[pb.AvailableMetrics], error) {
 	return c.getAvailableMetrics.CallUnary(ctx, req)
@@ -629,6 +960,58 @@ func (c *aPIServiceClient) GetOutputStream(ctx context.Context, req *connect.Req
 	return c.getOutputStream.CallServerStream(ctx, req)
 }
 
+// GetApplicationEvents calls neoshowcase.protobuf.APIService.GetApplicationEvents.
+func (c *aPIServiceClient) GetApplicationEvents(ctx context.Context, req *connect.Request[pb.GetApplicationEventsRequest]) (*connect.Response[pb.ApplicationEvents], error) {
+	return c.getApplicationEvents.CallUnary(ctx, req)
+}
+
+// GetApplicationEventsStream calls neoshowcase.protobuf.APIService.GetApplicationEventsStream.
+func (c *aPIServiceClient) GetApplicationEventsStream(ctx context.Context, req *connect.Request[pb.GetApplicationEventsStreamRequest]) (*connect.ServerStreamForClient[pb.ApplicationEvent], error) {
+	return c.getApplicationEventsStream.CallServerStream(ctx, req)
+}
+
+// GetWebsiteStatus calls neoshowcase.protobuf.APIService.GetWebsiteStatus.
+func (c *aPIServiceClient) GetWebsiteStatus(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.WebsiteStatuses], error) {
+	return c.getWebsiteStatus.CallUnary(ctx, req)
+}
+
+// GetAlertRules calls neoshowcase.protobuf.APIService.GetAlertRules.
+func (c *aPIServiceClient) GetAlertRules(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.AlertRules], error) {
+	return c.getAlertRules.CallUnary(ctx, req)
+}
+
+// CreateAlertRule calls neoshowcase.protobuf.APIService.CreateAlertRule.
+func (c *aPIServiceClient) CreateAlertRule(ctx context.Context, req *connect.Request[pb.CreateAlertRuleRequest]) (*connect.Response[pb.AlertRule], error) {
+	return c.createAlertRule.CallUnary(ctx, req)
+}
+
+// DeleteAlertRule calls neoshowcase.protobuf.APIService.DeleteAlertRule.
+func (c *aPIServiceClient) DeleteAlertRule(ctx context.Context, req *connect.Request[pb.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteAlertRule.CallUnary(ctx, req)
+}
+
+// GetAlerts calls neoshowcase.protobuf.APIService.GetAlerts.
+func (c *aPIServiceClient) GetAlerts(ctx context.Context, req *connect.Request[pb.GetAlertsRequest]) (*connect.Response[pb.Alerts], error) {
+	return c.getAlerts.CallUnary(ctx, req)
+}
+
+// GetNotificationSubscriptions calls neoshowcase.protobuf.APIService.GetNotificationSubscriptions.
+func (c *aPIServiceClient) GetNotificationSubscriptions(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.NotificationSubscriptions], error) {
+	return c.getNotificationSubscriptions.CallUnary(ctx, req)
+}
+
+// CreateNotificationSubscription calls
+// neoshowcase.protobuf.APIService.CreateNotificationSubscription.
+func (c *aPIServiceClient) CreateNotificationSubscription(ctx context.Context, req *connect.Request[pb.CreateNotificationSubscriptionRequest]) (*connect.Response[pb.NotificationSubscription], error) {
+	return c.createNotificationSubscription.CallUnary(ctx, req)
+}
+
+// DeleteNotificationSubscription calls
+// neoshowcase.protobuf.APIService.DeleteNotificationSubscription.
+func (c *aPIServiceClient) DeleteNotificationSubscription(ctx context.Context, req *connect.Request[pb.DeleteNotificationSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteNotificationSubscription.CallUnary(ctx, req)
+}
+
 // GetEnvVars calls neoshowcase.protobuf.APIService.GetEnvVars.
 func (c *aPIServiceClient) GetEnvVars(ctx context.Context, req *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.ApplicationEnvVars], error) {
 	return c.getEnvVars.CallUnary(ctx, req)
@@ -710,6 +1093,25 @@ type APIServiceHandler interface {
 	GetUserKeys(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetUserKeysResponse], error)
 	// DeleteUserKey 登録した公開鍵を削除します
 	DeleteUserKey(context.Context, *connect.Request[pb.DeleteUserKeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetMyUsage 自身のリソース使用量と上限を取得します
+	GetMyUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetMyUsageResponse], error)
+	// SetUserQuota ユーザーのリソース上限を設定します (admin only)
+	SetUserQuota(context.Context, *connect.Request[pb.SetUserQuotaRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetCustomDomains 登録した独自ドメイン一覧を取得します
+	GetCustomDomains(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetCustomDomainsResponse], error)
+	// CreateCustomDomain 独自ドメインを登録します 所有権の確認は定期的に行われます
+	CreateCustomDomain(context.Context, *connect.Request[pb.CreateCustomDomainRequest]) (*connect.Response[pb.CustomDomain], error)
+	// VerifyCustomDomain 独自ドメインの所有権を直ちに確認します
+	VerifyCustomDomain(context.Context, *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[pb.CustomDomain], error)
+	// DeleteCustomDomain 登録した独自ドメインを削除します
+	DeleteCustomDomain(context.Context, *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetTLSCertificates アップロードしたTLS証明書一覧を取得します adminは全ての証明書を取得します
+	GetTLSCertificates(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetTLSCertificatesResponse], error)
+	// UploadTLSCertificate TLS証明書をアップロードします 同じfqdnの証明書が既にある場合は置き換えます
+	// admin以外は所有権が確認された独自ドメインの証明書のみアップロードできます
+	UploadTLSCertificate(context.Context, *connect.Request[pb.UploadTLSCertificateRequest]) (*connect.Response[pb.TLSCertificate], error)
+	// DeleteTLSCertificate アップロードしたTLS証明書を削除します
+	DeleteTLSCertificate(context.Context, *connect.Request[pb.TLSCertificateIdRequest]) (*connect.Response[emptypb.Empty], error)
 	// CreateRepository リポジトリを登録します
 	CreateRepository(context.Context, *connect.Request[pb.CreateRepositoryRequest]) (*connect.Response[pb.Repository], error)
 	// GetRepositories リポジトリ一覧を取得します
@@ -728,6 +1130,8 @@ type APIServiceHandler interface {
 	DeleteRepository(context.Context, *connect.Request[pb.RepositoryIdRequest]) (*connect.Response[emptypb.Empty], error)
 	// CreateApplication アプリを作成します
 	CreateApplication(context.Context, *connect.Request[pb.CreateApplicationRequest]) (*connect.Response[pb.Application], error)
+	// DuplicateApplication アプリの設定・環境変数・公開ポートをコピーして新しいアプリを作成します
+	DuplicateApplication(context.Context, *connect.Request[pb.DuplicateApplicationRequest]) (*connect.Response[pb.Application], error)
 	// GetApplications アプリ一覧を取得します
 	GetApplications(context.Context, *connect.Request[pb.GetApplicationsRequest]) (*connect.Response[pb.GetApplicationsResponse], error)
 	// GetApplication アプリを取得します
@@ -736,6 +1140,10 @@ type APIServiceHandler interface {
 	UpdateApplication(context.Context, *connect.Request[pb.UpdateApplicationRequest]) (*connect.Response[emptypb.Empty], error)
 	// DeleteApplication アプリを削除します 先にアプリのシャットダウンが必要です
 	DeleteApplication(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[emptypb.Empty], error)
+	// ExportApplications アプリの設定をマニフェストとして出力します 環境変数は値を含まずキーのみ出力します
+	ExportApplications(context.Context, *connect.Request[pb.ExportApplicationsRequest]) (*connect.Response[pb.ExportApplicationsResponse], error)
+	// ApplyManifest マニフェストに合わせてアプリを作成・更新します 全てのアプリの検証に成功した場合のみ適用します
+	ApplyManifest(context.Context, *connect.Request[pb.ApplyManifestRequest]) (*connect.Response[pb.ApplyManifestResponse], error)
 	// GetAvailableMetrics 取得可能メトリクス一覧を取得します
 	GetAvailableMetrics(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.AvailableMetrics], error)
 	// GetApplicationMetrics アプリのメトリクスを取得します
@@ -744,6 +1152,26 @@ type APIServiceHandler interface {
 	GetOutput(context.Context, *connect.Request[pb.GetOutputRequest]) (*connect.Response[pb.ApplicationOutputs], error)
 	// GetOutputStream アプリの出力をストリーム形式で取得します
 	GetOutputStream(context.Context, *connect.Request[pb.GetOutputStreamRequest], *connect.ServerStream[pb.ApplicationOutput]) error
+	// GetApplicationEvents アプリのイベント履歴を新しい順に取得します
+	GetApplicationEvents(context.Context, *connect.Request[pb.GetApplicationEventsRequest]) (*connect.Response[pb.ApplicationEvents], error)
+	// GetApplicationEventsStream beginより後に発生したアプリのイベントをストリーム形式で取得します
+	GetApplicationEventsStream(context.Context, *connect.Request[pb.GetApplicationEventsStreamRequest], *connect.ServerStream[pb.ApplicationEvent]) error
+	// GetWebsiteStatus アプリの各ウェブサイトの死活監視の結果と稼働率を取得します
+	GetWebsiteStatus(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.WebsiteStatuses], error)
+	// GetAlertRules アプリのアラートルール一覧を取得します
+	GetAlertRules(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.AlertRules], error)
+	// CreateAlertRule アプリのアラートルールを作成します
+	CreateAlertRule(context.Context, *connect.Request[pb.CreateAlertRuleRequest]) (*connect.Response[pb.AlertRule], error)
+	// DeleteAlertRule アプリのアラートルールを削除します ルールのアラート履歴も削除されます
+	DeleteAlertRule(context.Context, *connect.Request[pb.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetAlerts アプリのアラートの発火・解決履歴を新しい順に取得します
+	GetAlerts(context.Context, *connect.Request[pb.GetAlertsRequest]) (*connect.Response[pb.Alerts], error)
+	// GetNotificationSubscriptions アプリの自分の通知設定一覧を取得します
+	GetNotificationSubscriptions(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.NotificationSubscriptions], error)
+	// CreateNotificationSubscription アプリの通知設定を作成します
+	CreateNotificationSubscription(context.Context, *connect.Request[pb.CreateNotificationSubscriptionRequest]) (*connect.Response[pb.NotificationSubscription], error)
+	// DeleteNotificationSubscription アプリの自分の通知設定を削除します
+	DeleteNotificationSubscription(context.Context, *connect.Request[pb.DeleteNotificationSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
 	// GetEnvVars アプリの環境変数を取得します
 	GetEnvVars(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.ApplicationEnvVars], error)
 	// SetEnvVar アプリの環境変数をセットします システムによって設定された環境変数は上書きできません
@@ -825,6 +1253,63 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(aPIServiceMethods.ByName("DeleteUserKey")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aPIServiceGetMyUsageHandler := connect.NewUnaryHandler(
+		APIServiceGetMyUsageProcedure,
+		svc.GetMyUsage,
+		connect.WithSchema(aPIServiceMethods.ByName("GetMyUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceSetUserQuotaHandler := connect.NewUnaryHandler(
+		APIServiceSetUserQuotaProcedure,
+		svc.SetUserQuota,
+		connect.WithSchema(aPIServiceMethods.ByName("SetUserQuota")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetCustomDomainsHandler := connect.NewUnaryHandler(
+		APIServiceGetCustomDomainsProcedure,
+		svc.GetCustomDomains,
+		connect.WithSchema(aPIServiceMethods.ByName("GetCustomDomains")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceCreateCustomDomainHandler := connect.NewUnaryHandler(
+		APIServiceCreateCustomDomainProcedure,
+		svc.CreateCustomDomain,
+		connect.WithSchema(aPIServiceMethods.ByName("CreateCustomDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceVerifyCustomDomainHandler := connect.NewUnaryHandler(
+		APIServiceVerifyCustomDomainProcedure,
+		svc.VerifyCustomDomain,
+		connect.WithSchema(aPIServiceMethods.ByName("VerifyCustomDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceDeleteCustomDomainHandler := connect.NewUnaryHandler(
+		APIServiceDeleteCustomDomainProcedure,
+		svc.DeleteCustomDomain,
+		connect.WithSchema(aPIServiceMethods.ByName("DeleteCustomDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetTLSCertificatesHandler := connect.NewUnaryHandler(
+		APIServiceGetTLSCertificatesProcedure,
+		svc.GetTLSCertificates,
+		connect.WithSchema(aPIServiceMethods.ByName("GetTLSCertificates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceUploadTLSCertificateHandler := connect.NewUnaryHandler(
+		APIServiceUploadTLSCertificateProcedure,
+		svc.UploadTLSCertificate,
+		connect.WithSchema(aPIServiceMethods.ByName("UploadTLSCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceDeleteTLSCertificateHandler := connect.NewUnaryHandler(
+		APIServiceDeleteTLSCertificateProcedure,
+		svc.DeleteTLSCertificate,
+		connect.WithSchema(aPIServiceMethods.ByName("DeleteTLSCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aPIServiceCreateRepositoryHandler := connect.NewUnaryHandler(
 		APIServiceCreateRepositoryProcedure,
 		svc.CreateRepository,
@@ -883,6 +1368,12 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(aPIServiceMethods.ByName("CreateApplication")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aPIServiceDuplicateApplicationHandler := connect.NewUnaryHandler(
+		APIServiceDuplicateApplicationProcedure,
+		svc.DuplicateApplication,
+		connect.WithSchema(aPIServiceMethods.ByName("DuplicateApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aPIServiceGetApplicationsHandler := connect.NewUnaryHandler(
 		APIServiceGetApplicationsProcedure,
 		svc.GetApplications,
@@ -909,6 +1400,19 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(aPIServiceMethods.ByName("DeleteApplication")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aPIServiceExportApplicationsHandler := connect.NewUnaryHandler(
+		APIServiceExportApplicationsProcedure,
+		svc.ExportApplications,
+		connect.WithSchema(aPIServiceMethods.ByName("ExportApplications")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceApplyManifestHandler := connect.NewUnaryHandler(
+		APIServiceApplyManifestProcedure,
+		svc.ApplyManifest,
+		connect.WithSchema(aPIServiceMethods.ByName("ApplyManifest")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aPIServiceGetAvailableMetricsHandler := connect.NewUnaryHandler(
 		APIServiceGetAvailableMetricsProcedure,
 		svc.GetAvailableMetrics,
@@ -936,6 +1440,71 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(aPIServiceMethods.ByName("GetOutputStream")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aPIServiceGetApplicationEventsHandler := connect.NewUnaryHandler(
+		APIServiceGetApplicationEventsProcedure,
+		svc.GetApplicationEvents,
+		connect.WithSchema(aPIServiceMethods.ByName("GetApplicationEvents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetApplicationEventsStreamHandler := connect.NewServerStreamHandler(
+		APIServiceGetApplicationEventsStreamProcedure,
+		svc.GetApplicationEventsStream,
+		connect.WithSchema(aPIServiceMethods.ByName("GetApplicationEventsStream")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetWebsiteStatusHandler := connect.NewUnaryHandler(
+		APIServiceGetWebsiteStatusProcedure,
+		svc.GetWebsiteStatus,
+		connect.WithSchema(aPIServiceMethods.ByName("GetWebsiteStatus")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetAlertRulesHandler := connect.NewUnaryHandler(
+		APIServiceGetAlertRulesProcedure,
+		svc.GetAlertRules,
+		connect.WithSchema(aPIServiceMethods.ByName("GetAlertRules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceCreateAlertRuleHandler := connect.NewUnaryHandler(
+		APIServiceCreateAlertRuleProcedure,
+		svc.CreateAlertRule,
+		connect.WithSchema(aPIServiceMethods.ByName("CreateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceDeleteAlertRuleHandler := connect.NewUnaryHandler(
+		APIServiceDeleteAlertRuleProcedure,
+		svc.DeleteAlertRule,
+		connect.WithSchema(aPIServiceMethods.ByName("DeleteAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetAlertsHandler := connect.NewUnaryHandler(
+		APIServiceGetAlertsProcedure,
+		svc.GetAlerts,
+		connect.WithSchema(aPIServiceMethods.ByName("GetAlerts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceGetNotificationSubscriptionsHandler := connect.NewUnaryHandler(
+		APIServiceGetNotificationSubscriptionsProcedure,
+		svc.GetNotificationSubscriptions,
+		connect.WithSchema(aPIServiceMethods.ByName("GetNotificationSubscriptions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceCreateNotificationSubscriptionHandler := connect.NewUnaryHandler(
+		APIServiceCreateNotificationSubscriptionProcedure,
+		svc.CreateNotificationSubscription,
+		connect.WithSchema(aPIServiceMethods.ByName("CreateNotificationSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aPIServiceDeleteNotificationSubscriptionHandler := connect.NewUnaryHandler(
+		APIServiceDeleteNotificationSubscriptionProcedure,
+		svc.DeleteNotificationSubscription,
+		connect.WithSchema(aPIServiceMethods.ByName("DeleteNotificationSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aPIServiceGetEnvVarsHandler := connect.NewUnaryHandler(
 		APIServiceGetEnvVarsProcedure,
 		svc.GetEnvVars,
@@ -1036,6 +1605,24 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 			aPIServiceGetUserKeysHandler.ServeHTTP(w, r)
 		case APIServiceDeleteUserKeyProcedure:
 			aPIServiceDeleteUserKeyHandler.ServeHTTP(w, r)
+		case APIServiceGetMyUsageProcedure:
+			aPIServiceGetMyUsageHandler.ServeHTTP(w, r)
+		case APIServiceSetUserQuotaProcedure:
+			aPIServiceSetUserQuotaHandler.ServeHTTP(w, r)
+		case APIServiceGetCustomDomainsProcedure:
+			aPIServiceGetCustomDomainsHandler.ServeHTTP(w, r)
+		case APIServiceCreateCustomDomainProcedure:
+			aPIServiceCreateCustomDomainHandler.ServeHTTP(w, r)
+		case APIServiceVerifyCustomDomainProcedure:
+			aPIServiceVerifyCustomDomainHandler.ServeHTTP(w, r)
+		case APIServiceDeleteCustomDomainProcedure:
+			aPIServiceDeleteCustomDomainHandler.ServeHTTP(w, r)
+		case APIServiceGetTLSCertificatesProcedure:
+			aPIServiceGetTLSCertificatesHandler.ServeHTTP(w, r)
+		case APIServiceUploadTLSCertificateProcedure:
+			aPIServiceUploadTLSCertificateHandler.ServeHTTP(w, r)
+		case APIServiceDeleteTLSCertificateProcedure:
+			aPIServiceDeleteTLSCertificateHandler.ServeHTTP(w, r)
 		case APIServiceCreateRepositoryProcedure:
 			aPIServiceCreateRepositoryHandler.ServeHTTP(w, r)
 		case APIServiceGetRepositoriesProcedure:
@@ -1054,6 +1641,8 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 			aPIServiceDeleteRepositoryHandler.ServeHTTP(w, r)
 		case APIServiceCreateApplicationProcedure:
 			aPIServiceCreateApplicationHandler.ServeHTTP(w, r)
+		case APIServiceDuplicateApplicationProcedure:
+			aPIServiceDuplicateApplicationHandler.ServeHTTP(w, r)
 		case APIServiceGetApplicationsProcedure:
 			aPIServiceGetApplicationsHandler.ServeHTTP(w, r)
 		case APIServiceGetApplicationProcedure:
@@ -1062,6 +1651,10 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 			aPIServiceUpdateApplicationHandler.ServeHTTP(w, r)
 		case APIServiceDeleteApplicationProcedure:
 			aPIServiceDeleteApplicationHandler.ServeHTTP(w, r)
+		case APIServiceExportApplicationsProcedure:
+			aPIServiceExportApplicationsHandler.ServeHTTP(w, r)
+		case APIServiceApplyManifestProcedure:
+			aPIServiceApplyManifestHandler.ServeHTTP(w, r)
 		case APIServiceGetAvailableMetricsProcedure:
 			aPIServiceGetAvailableMetricsHandler.ServeHTTP(w, r)
 		case APIServiceGetApplicationMetricsProcedure:
@@ -1070,6 +1663,26 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect.HandlerOption)
 			aPIServiceGetOutputHandler.ServeHTTP(w, r)
 		case APIServiceGetOutputStreamProcedure:
 			aPIServiceGetOutputStreamHandler.ServeHTTP(w, r)
+		case APIServiceGetApplicationEventsProcedure:
+			aPIServiceGetApplicationEventsHandler.ServeHTTP(w, r)
+		case APIServiceGetApplicationEventsStreamProcedure:
+			aPIServiceGetApplicationEventsStreamHandler.ServeHTTP(w, r)
+		case APIServiceGetWebsiteStatusProcedure:
+			aPIServiceGetWebsiteStatusHandler.ServeHTTP(w, r)
+		case APIServiceGetAlertRulesProcedure:
+			aPIServiceGetAlertRulesHandler.ServeHTTP(w, r)
+		case APIServiceCreateAlertRuleProcedure:
+			aPIServiceCreateAlertRuleHandler.ServeHTTP(w, r)
+		case APIServiceDeleteAlertRuleProcedure:
+			aPIServiceDeleteAlertRuleHandler.ServeHTTP(w, r)
+		case APIServiceGetAlertsProcedure:
+			aPIServiceGetAlertsHandler.ServeHTTP(w, r)
+		case APIServiceGetNotificationSubscriptionsProcedure:
+			aPIServiceGetNotificationSubscriptionsHandler.ServeHTTP(w, r)
+		case APIServiceCreateNotificationSubscriptionProcedure:
+			aPIServiceCreateNotificationSubscriptionHandler.ServeHTTP(w, r)
+		case APIServiceDeleteNotificationSubscriptionProcedure:
+			aPIServiceDeleteNotificationSubscriptionHandler.ServeHTTP(w, r)
 		case APIServiceGetEnvVarsProcedure:
 			aPIServiceGetEnvVarsHandler.ServeHTTP(w, r)
 		case APIServiceSetEnvVarProcedure:
@@ -1133,6 +1746,42 @@ func (UnimplementedAPIServiceHandler) DeleteUserKey(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DeleteUserKey is not implemented"))
 }
 
+func (UnimplementedAPIServiceHandler) GetMyUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetMyUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetMyUsage is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) SetUserQuota(context.Context, *connect.Request[pb.SetUserQuotaRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.SetUserQuota is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetCustomDomains(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetCustomDomainsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetCustomDomains is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) CreateCustomDomain(context.Context, *connect.Request[pb.CreateCustomDomainRequest]) (*connect.Response[pb.CustomDomain], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.CreateCustomDomain is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) VerifyCustomDomain(context.Context, *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[pb.CustomDomain], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.VerifyCustomDomain is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) DeleteCustomDomain(context.Context, *connect.Request[pb.CustomDomainIdRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DeleteCustomDomain is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetTLSCertificates(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetTLSCertificatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetTLSCertificates is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) UploadTLSCertificate(context.Context, *connect.Request[pb.UploadTLSCertificateRequest]) (*connect.Response[pb.TLSCertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.UploadTLSCertificate is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) DeleteTLSCertificate(context.Context, *connect.Request[pb.TLSCertificateIdRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DeleteTLSCertificate is not implemented"))
+}
+
 func (UnimplementedAPIServiceHandler) CreateRepository(context.Context, *connect.Request[pb.CreateRepositoryRequest]) (*connect.Response[pb.Repository], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.CreateRepository is not implemented"))
 }
@@ -1169,6 +1818,10 @@ func (UnimplementedAPIServiceHandler) CreateApplication(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.CreateApplication is not implemented"))
 }
 
+func (UnimplementedAPIServiceHandler) DuplicateApplication(context.Context, *connect.Request[pb.DuplicateApplicationRequest]) (*connect.Response[pb.Application], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DuplicateApplication is not implemented"))
+}
+
 func (UnimplementedAPIServiceHandler) GetApplications(context.Context, *connect.Request[pb.GetApplicationsRequest]) (*connect.Response[pb.GetApplicationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetApplications is not implemented"))
 }
@@ -1185,6 +1838,14 @@ func (UnimplementedAPIServiceHandler) DeleteApplication(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DeleteApplication is not implemented"))
 }
 
+func (UnimplementedAPIServiceHandler) ExportApplications(context.Context, *connect.Request[pb.ExportApplicationsRequest]) (*connect.Response[pb.ExportApplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.ExportApplications is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) ApplyManifest(context.Context, *connect.Request[pb.ApplyManifestRequest]) (*connect.Response[pb.ApplyManifestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.ApplyManifest is not implemented"))
+}
+
 func (UnimplementedAPIServiceHandler) GetAvailableMetrics(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.AvailableMetrics], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetAvailableMetrics is not implemented"))
 }
@@ -1201,6 +1862,46 @@ func (UnimplementedAPIServiceHandler) GetOutputStream(context.Context, *connect.
 	return connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetOutputStream is not implemented"))
 }
 
+func (UnimplementedAPIServiceHandler) GetApplicationEvents(context.Context, *connect.Request[pb.GetApplicationEventsRequest]) (*connect.Response[pb.ApplicationEvents], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetApplicationEvents is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetApplicationEventsStream(context.Context, *connect.Request[pb.GetApplicationEventsStreamRequest], *connect.ServerStream[pb.ApplicationEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetApplicationEventsStream is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetWebsiteStatus(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.WebsiteStatuses], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetWebsiteStatus is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetAlertRules(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.AlertRules], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetAlertRules is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) CreateAlertRule(context.Context, *connect.Request[pb.CreateAlertRuleRequest]) (*connect.Response[pb.AlertRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.CreateAlertRule is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) DeleteAlertRule(context.Context, *connect.Request[pb.DeleteAlertRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DeleteAlertRule is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetAlerts(context.Context, *connect.Request[pb.GetAlertsRequest]) (*connect.Response[pb.Alerts], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetAlerts is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetNotificationSubscriptions(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.NotificationSubscriptions], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetNotificationSubscriptions is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) CreateNotificationSubscription(context.Context, *connect.Request[pb.CreateNotificationSubscriptionRequest]) (*connect.Response[pb.NotificationSubscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.CreateNotificationSubscription is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) DeleteNotificationSubscription(context.Context, *connect.Request[pb.DeleteNotificationSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.DeleteNotificationSubscription is not implemented"))
+}
+
 func (UnimplementedAPIServiceHandler) GetEnvVars(context.Context, *connect.Request[pb.ApplicationIdRequest]) (*connect.Response[pb.ApplicationEnvVars], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("neoshowcase.protobuf.APIService.GetEnvVars is not implemented"))
 }
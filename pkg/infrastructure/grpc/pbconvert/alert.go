@@ -0,0 +1,65 @@
+package pbconvert
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var AlertRuleKindMapper = mapper.MustNewValueMapper(map[domain.AlertRuleKind]pb.AlertRule_Kind{
+	domain.AlertRuleKindMetric: pb.AlertRule_METRIC,
+	domain.AlertRuleKindNoLog:  pb.AlertRule_NO_LOG,
+})
+
+var AlertComparisonMapper = mapper.MustNewValueMapper(map[domain.AlertComparison]pb.AlertRule_Comparison{
+	domain.AlertComparisonAbove: pb.AlertRule_ABOVE,
+	domain.AlertComparisonBelow: pb.AlertRule_BELOW,
+})
+
+var AlertThresholdUnitMapper = mapper.MustNewValueMapper(map[domain.AlertThresholdUnit]pb.AlertRule_ThresholdUnit{
+	domain.AlertThresholdUnitValue:        pb.AlertRule_VALUE,
+	domain.AlertThresholdUnitLimitPercent: pb.AlertRule_LIMIT_PERCENT,
+})
+
+func FromPBCreateAlertRuleRequest(req *pb.CreateAlertRuleRequest) *domain.AlertRule {
+	return domain.NewAlertRule(
+		req.ApplicationId,
+		req.Name,
+		AlertRuleKindMapper.FromMust(req.Kind),
+		req.Metric,
+		AlertComparisonMapper.FromMust(req.Comparison),
+		req.Threshold,
+		AlertThresholdUnitMapper.FromMust(req.ThresholdUnit),
+		time.Duration(req.DurationSeconds)*time.Second,
+	)
+}
+
+func ToPBAlertRule(r *domain.AlertRule) *pb.AlertRule {
+	return &pb.AlertRule{
+		Id:              r.ID,
+		ApplicationId:   r.ApplicationID,
+		Name:            r.Name,
+		Kind:            AlertRuleKindMapper.IntoMust(r.Kind),
+		Metric:          r.Metric,
+		Comparison:      AlertComparisonMapper.IntoMust(r.Comparison),
+		Threshold:       r.Threshold,
+		ThresholdUnit:   AlertThresholdUnitMapper.IntoMust(r.ThresholdUnit),
+		DurationSeconds: int64(r.Duration.Seconds()),
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+	}
+}
+
+func ToPBAlert(a *domain.Alert) *pb.Alert {
+	return &pb.Alert{
+		Id:            a.ID,
+		RuleId:        a.RuleID,
+		ApplicationId: a.ApplicationID,
+		Message:       a.Message,
+		FiredAt:       timestamppb.New(a.FiredAt),
+		ResolvedAt:    ToPBNullTimestamp(a.ResolvedAt),
+	}
+}
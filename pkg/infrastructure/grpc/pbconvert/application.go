@@ -48,7 +48,10 @@ func ToPBApplication(app *domain.Application, latestBuild *domain.Build) *pb.App
 		Config:           ToPBApplicationConfig(app.Config),
 		Websites:         ds.Map(app.Websites, ToPBWebsite),
 		PortPublications: ds.Map(app.PortPublications, ToPBPortPublication),
+		InternalService:  ToPBInternalService(&app.InternalService),
 		OwnerIds:         app.OwnerIDs,
+		ConfigFilePath:   app.ConfigFilePath,
+		ConfigFileError:  app.ConfigFileError,
 	}
 	if latestBuild != nil {
 		status := BuildStatusMapper.IntoMust(latestBuild.Status)
@@ -74,7 +77,10 @@ func FromPBApplication(app *pb.Application) *domain.Application {
 		Config:           FromPBApplicationConfig(app.Config),
 		Websites:         ds.Map(app.Websites, FromPBWebsite),
 		PortPublications: ds.Map(app.PortPublications, FromPBPortPublication),
+		InternalService:  FromPBInternalService(app.InternalService),
 		OwnerIDs:         app.OwnerIds,
+		ConfigFilePath:   app.ConfigFilePath,
+		ConfigFileError:  app.ConfigFileError,
 	}
 }
 
@@ -27,15 +27,23 @@ func ToPBRuntimeConfig(c *domain.RuntimeConfig) *pb.RuntimeConfig {
 
 func FromPBStaticConfig(c *pb.StaticConfig) domain.StaticConfig {
 	return domain.StaticConfig{
-		ArtifactPath: c.ArtifactPath,
-		SPA:          c.Spa,
+		ArtifactPath:      c.ArtifactPath,
+		SPA:               c.Spa,
+		NotFoundPath:      c.NotFoundPath,
+		AssetCacheControl: c.AssetCacheControl,
+		HTMLCacheControl:  c.HtmlCacheControl,
+		Precompressed:     c.Precompressed,
 	}
 }
 
 func ToPBStaticConfig(c *domain.StaticConfig) *pb.StaticConfig {
 	return &pb.StaticConfig{
-		ArtifactPath: c.ArtifactPath,
-		Spa:          c.SPA,
+		ArtifactPath:      c.ArtifactPath,
+		Spa:               c.SPA,
+		NotFoundPath:      c.NotFoundPath,
+		AssetCacheControl: c.AssetCacheControl,
+		HtmlCacheControl:  c.HTMLCacheControl,
+		Precompressed:     c.Precompressed,
 	}
 }
 
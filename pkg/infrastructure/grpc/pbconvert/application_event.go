@@ -0,0 +1,31 @@
+package pbconvert
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var ApplicationEventTypeMapper = mapper.MustNewValueMapper(map[domain.ApplicationEventType]pb.ApplicationEvent_Type{
+	domain.ApplicationEventContainerState: pb.ApplicationEvent_CONTAINER_STATE,
+	domain.ApplicationEventBuildStarted:   pb.ApplicationEvent_BUILD_STARTED,
+	domain.ApplicationEventBuildFinished:  pb.ApplicationEvent_BUILD_FINISHED,
+	domain.ApplicationEventDeployed:       pb.ApplicationEvent_DEPLOYED,
+	domain.ApplicationEventConfigChanged:  pb.ApplicationEvent_CONFIG_CHANGED,
+	domain.ApplicationEventStarted:        pb.ApplicationEvent_STARTED,
+	domain.ApplicationEventStopped:        pb.ApplicationEvent_STOPPED,
+})
+
+func ToPBApplicationEvent(e *domain.ApplicationEvent) *pb.ApplicationEvent {
+	return &pb.ApplicationEvent{
+		Id:            e.ID,
+		ApplicationId: e.ApplicationID,
+		Type:          ApplicationEventTypeMapper.IntoMust(e.Type),
+		Reference:     e.Reference,
+		Message:       e.Message,
+		UserId:        e.UserID,
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+}
@@ -63,15 +63,17 @@ func ToPBStartBuildRequest(req *domain.StartBuildRequest) *pb.StartBuildRequest
 		AppEnvs: &pb.ApplicationEnvVars{
 			Variables: ds.Map(req.Envs, ToPBEnvironment),
 		},
-		Build: ToPBBuild(req.Build),
+		Build:        ToPBBuild(req.Build),
+		TraceContext: req.TraceContext,
 	}
 }
 
 func FromPBStartBuildRequest(req *pb.StartBuildRequest) *domain.StartBuildRequest {
 	return &domain.StartBuildRequest{
-		Repo:  FromPBRepositoryPrivate(req.Repo),
-		App:   FromPBApplication(req.App),
-		Envs:  ds.Map(req.AppEnvs.Variables, FromPBEnvironment),
-		Build: FromPBBuild(req.Build),
+		Repo:         FromPBRepositoryPrivate(req.Repo),
+		App:          FromPBApplication(req.App),
+		Envs:         ds.Map(req.AppEnvs.Variables, FromPBEnvironment),
+		Build:        FromPBBuild(req.Build),
+		TraceContext: req.TraceContext,
 	}
 }
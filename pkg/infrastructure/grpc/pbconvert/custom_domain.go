@@ -0,0 +1,35 @@
+package pbconvert
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var CustomDomainVerificationMethodMapper = mapper.MustNewValueMapper(map[domain.CustomDomainVerificationMethod]pb.CustomDomain_VerificationMethod{
+	domain.CustomDomainVerificationDNS:  pb.CustomDomain_DNS,
+	domain.CustomDomainVerificationHTTP: pb.CustomDomain_HTTP,
+})
+
+func FromPBCreateCustomDomainRequest(req *pb.CreateCustomDomainRequest, userID string) *domain.CustomDomain {
+	return domain.NewCustomDomain(userID, req.Domain, CustomDomainVerificationMethodMapper.FromMust(req.Method))
+}
+
+func ToPBCustomDomain(d *domain.CustomDomain) *pb.CustomDomain {
+	return &pb.CustomDomain{
+		Id:             d.ID,
+		Domain:         d.Domain,
+		Method:         CustomDomainVerificationMethodMapper.IntoMust(d.Method),
+		Token:          d.Token,
+		TxtRecordName:  d.TXTRecordName(),
+		TxtRecordValue: d.TXTRecordValue(),
+		HttpUrl:        d.HTTPURL(),
+		Verified:       d.Verified,
+		VerifiedAt:     ToPBNullTimestamp(d.VerifiedAt),
+		CheckedAt:      ToPBNullTimestamp(d.CheckedAt),
+		Error:          d.Error,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+}
@@ -0,0 +1,20 @@
+package pbconvert
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+)
+
+func FromPBInternalService(s *pb.InternalService) domain.InternalService {
+	return domain.InternalService{
+		Port:          int(s.GetPort()),
+		AllowedAppIDs: s.GetAllowedAppIds(),
+	}
+}
+
+func ToPBInternalService(s *domain.InternalService) *pb.InternalService {
+	return &pb.InternalService{
+		Port:          int32(s.Port),
+		AllowedAppIds: s.AllowedAppIDs,
+	}
+}
@@ -0,0 +1,32 @@
+package pbconvert
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/apiserver"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var ManifestFormatMapper = mapper.MustNewValueMapper(map[domain.ManifestFormat]pb.ManifestFormat{
+	domain.ManifestFormatYAML: pb.ManifestFormat_YAML,
+	domain.ManifestFormatJSON: pb.ManifestFormat_JSON,
+})
+
+func ToPBManifestFieldDiff(diff *domain.ManifestFieldDiff) *pb.ManifestFieldDiff {
+	return &pb.ManifestFieldDiff{
+		Field:  diff.Field,
+		Before: diff.Before,
+		After:  diff.After,
+	}
+}
+
+func ToPBManifestApplicationResult(result *apiserver.ManifestApplicationResult) *pb.ManifestApplicationResult {
+	return &pb.ManifestApplicationResult{
+		ApplicationId: result.ApplicationID,
+		Name:          result.Name,
+		Create:        result.Create,
+		Diffs:         ds.Map(result.Diffs, ToPBManifestFieldDiff),
+		Errors:        result.Errors,
+	}
+}
@@ -0,0 +1,49 @@
+package pbconvert
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var NotificationSinkMapper = mapper.MustNewValueMapper(map[domain.NotificationSinkType]pb.NotificationSubscription_Sink{
+	domain.NotificationSinkWebhook: pb.NotificationSubscription_WEBHOOK,
+	domain.NotificationSinkSlack:   pb.NotificationSubscription_SLACK,
+	domain.NotificationSinkTraQ:    pb.NotificationSubscription_TRAQ,
+	domain.NotificationSinkEmail:   pb.NotificationSubscription_EMAIL,
+})
+
+var NotificationEventMapper = mapper.MustNewValueMapper(map[domain.NotificationEvent]pb.NotificationSubscription_Event{
+	domain.NotificationEventBuildFailed:      pb.NotificationSubscription_BUILD_FAILED,
+	domain.NotificationEventBuildSucceeded:   pb.NotificationSubscription_BUILD_SUCCEEDED,
+	domain.NotificationEventDeployed:         pb.NotificationSubscription_DEPLOYED,
+	domain.NotificationEventContainerErrored: pb.NotificationSubscription_CONTAINER_ERRORED,
+	domain.NotificationEventCertificateError: pb.NotificationSubscription_CERTIFICATE_ERROR,
+	domain.NotificationEventAlert:            pb.NotificationSubscription_ALERT,
+})
+
+func FromPBCreateNotificationSubscriptionRequest(req *pb.CreateNotificationSubscriptionRequest, userID string) *domain.NotificationSubscription {
+	return domain.NewNotificationSubscription(
+		req.ApplicationId,
+		userID,
+		NotificationSinkMapper.FromMust(req.Sink),
+		req.Target,
+		req.Secret,
+		ds.Map(req.Events, NotificationEventMapper.FromMust),
+	)
+}
+
+func ToPBNotificationSubscription(s *domain.NotificationSubscription) *pb.NotificationSubscription {
+	return &pb.NotificationSubscription{
+		Id:            s.ID,
+		ApplicationId: s.ApplicationID,
+		Sink:          NotificationSinkMapper.IntoMust(s.Sink),
+		Target:        s.Target,
+		HasSecret:     s.Secret != "",
+		Events:        ds.Map(s.Events, NotificationEventMapper.IntoMust),
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+	}
+}
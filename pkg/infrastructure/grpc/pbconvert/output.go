@@ -5,8 +5,23 @@ import (
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
 )
 
+var LogStreamMapper = mapper.MustNewValueMapper(map[domain.LogStream]pb.LogFilter_Stream{
+	domain.LogStreamAll:    pb.LogFilter_ALL,
+	domain.LogStreamStdout: pb.LogFilter_STDOUT,
+	domain.LogStreamStderr: pb.LogFilter_STDERR,
+})
+
+func FromPBLogFilter(f *pb.LogFilter) domain.LogFilter {
+	return domain.LogFilter{
+		Contains: f.GetContains(),
+		Regexp:   f.GetRegexp(),
+		Stream:   LogStreamMapper.FromMust(f.GetStream()),
+	}
+}
+
 func ToPBApplicationOutput(l *domain.ContainerLog) *pb.ApplicationOutput {
 	return &pb.ApplicationOutput{
 		Time: timestamppb.New(l.Time),
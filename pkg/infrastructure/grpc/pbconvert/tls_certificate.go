@@ -0,0 +1,22 @@
+package pbconvert
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+)
+
+func ToPBTLSCertificate(c *domain.TLSCertificate) *pb.TLSCertificate {
+	return &pb.TLSCertificate{
+		Id:        c.ID,
+		UserId:    c.UserID,
+		Fqdn:      c.FQDN,
+		NotBefore: timestamppb.New(c.NotBefore),
+		NotAfter:  timestamppb.New(c.NotAfter),
+		Expiring:  c.ExpiresSoon(time.Now()),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+}
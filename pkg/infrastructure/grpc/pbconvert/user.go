@@ -25,3 +25,30 @@ func ToPBUserKey(key *domain.UserKey) *pb.UserKey {
 		CreatedAt: timestamppb.New(key.CreatedAt),
 	}
 }
+
+func FromPBResourceQuota(q *pb.ResourceQuota) domain.Quota {
+	return domain.Quota{
+		MaxApplications:        int(q.MaxApplications),
+		MaxRunningApplications: int(q.MaxRunningApplications),
+		MaxPortPublications:    int(q.MaxPortPublications),
+		MaxDatabases:           int(q.MaxDatabases),
+	}
+}
+
+func ToPBResourceQuota(q domain.Quota) *pb.ResourceQuota {
+	return &pb.ResourceQuota{
+		MaxApplications:        int32(q.MaxApplications),
+		MaxRunningApplications: int32(q.MaxRunningApplications),
+		MaxPortPublications:    int32(q.MaxPortPublications),
+		MaxDatabases:           int32(q.MaxDatabases),
+	}
+}
+
+func ToPBResourceUsage(u domain.ResourceUsage) *pb.ResourceUsage {
+	return &pb.ResourceUsage{
+		Applications:        int32(u.Applications),
+		RunningApplications: int32(u.RunningApplications),
+		PortPublications:    int32(u.PortPublications),
+		Databases:           int32(u.Databases),
+	}
+}
@@ -13,6 +13,11 @@ var AuthTypeMapper = mapper.MustNewValueMapper(map[domain.AuthenticationType]pb.
 	domain.AuthenticationTypeHard: pb.AuthenticationType_HARD,
 })
 
+var WebsiteRuleTypeMapper = mapper.MustNewValueMapper(map[domain.WebsiteRuleType]pb.WebsiteRule_Type{
+	domain.WebsiteRuleTypeRedirect: pb.WebsiteRule_REDIRECT,
+	domain.WebsiteRuleTypeRewrite:  pb.WebsiteRule_REWRITE,
+})
+
 func FromPBCreateWebsiteRequest(req *pb.CreateWebsiteRequest) *domain.Website {
 	return &domain.Website{
 		ID:             domain.NewID(),
@@ -23,6 +28,11 @@ func FromPBCreateWebsiteRequest(req *pb.CreateWebsiteRequest) *domain.Website {
 		H2C:            req.H2C,
 		HTTPPort:       int(req.HttpPort),
 		Authentication: AuthTypeMapper.FromMust(req.Authentication),
+		Rules:          ds.Map(req.Rules, FromPBWebsiteRule),
+		HeaderPolicy:   FromPBWebsiteHeaderPolicy(req.HeaderPolicy),
+		AccessControl:  FromPBWebsiteAccessControl(req.AccessControl),
+		RateLimit:      FromPBWebsiteRateLimit(req.RateLimit),
+		Backends:       ds.Map(req.Backends, FromPBWebsiteBackend),
 	}
 }
 
@@ -40,6 +50,11 @@ func ToPBWebsite(website *domain.Website) *pb.Website {
 		H2C:            website.H2C,
 		HttpPort:       int32(website.HTTPPort),
 		Authentication: AuthTypeMapper.IntoMust(website.Authentication),
+		Rules:          ds.Map(website.Rules, ToPBWebsiteRule),
+		HeaderPolicy:   ToPBWebsiteHeaderPolicy(&website.HeaderPolicy),
+		AccessControl:  ToPBWebsiteAccessControl(&website.AccessControl),
+		RateLimit:      ToPBWebsiteRateLimit(&website.RateLimit),
+		Backends:       ds.Map(website.Backends, ToPBWebsiteBackend),
 	}
 }
 
@@ -53,5 +68,114 @@ func FromPBWebsite(website *pb.Website) *domain.Website {
 		H2C:            website.H2C,
 		HTTPPort:       int(website.HttpPort),
 		Authentication: AuthTypeMapper.FromMust(website.Authentication),
+		Rules:          ds.Map(website.Rules, FromPBWebsiteRule),
+		HeaderPolicy:   FromPBWebsiteHeaderPolicy(website.HeaderPolicy),
+		AccessControl:  FromPBWebsiteAccessControl(website.AccessControl),
+		RateLimit:      FromPBWebsiteRateLimit(website.RateLimit),
+		Backends:       ds.Map(website.Backends, FromPBWebsiteBackend),
+	}
+}
+
+func FromPBWebsiteRule(rule *pb.WebsiteRule) *domain.WebsiteRule {
+	return &domain.WebsiteRule{
+		Type:          WebsiteRuleTypeMapper.FromMust(rule.Type),
+		PathRegex:     rule.PathRegex,
+		Target:        rule.Target,
+		StatusCode:    int(rule.StatusCode),
+		PreserveQuery: rule.PreserveQuery,
+	}
+}
+
+func ToPBWebsiteRule(rule *domain.WebsiteRule) *pb.WebsiteRule {
+	return &pb.WebsiteRule{
+		Type:          WebsiteRuleTypeMapper.IntoMust(rule.Type),
+		PathRegex:     rule.PathRegex,
+		Target:        rule.Target,
+		StatusCode:    int32(rule.StatusCode),
+		PreserveQuery: rule.PreserveQuery,
+	}
+}
+
+func FromPBWebsiteHeaderPolicy(policy *pb.WebsiteHeaderPolicy) domain.WebsiteHeaderPolicy {
+	cors := policy.GetCors()
+	return domain.WebsiteHeaderPolicy{
+		ResponseHeaders: ds.Map(policy.GetResponseHeaders(), func(h *pb.WebsiteHeaderPolicy_Header) *domain.WebsiteHeader {
+			return &domain.WebsiteHeader{Name: h.Name, Value: h.Value}
+		}),
+		CORS: domain.WebsiteCORSPolicy{
+			AllowOrigins:     cors.GetAllowOrigins(),
+			AllowMethods:     cors.GetAllowMethods(),
+			AllowHeaders:     cors.GetAllowHeaders(),
+			ExposeHeaders:    cors.GetExposeHeaders(),
+			AllowCredentials: cors.GetAllowCredentials(),
+			MaxAge:           int(cors.GetMaxAge()),
+		},
+	}
+}
+
+func ToPBWebsiteHeaderPolicy(policy *domain.WebsiteHeaderPolicy) *pb.WebsiteHeaderPolicy {
+	return &pb.WebsiteHeaderPolicy{
+		ResponseHeaders: ds.Map(policy.ResponseHeaders, func(h *domain.WebsiteHeader) *pb.WebsiteHeaderPolicy_Header {
+			return &pb.WebsiteHeaderPolicy_Header{Name: h.Name, Value: h.Value}
+		}),
+		Cors: &pb.WebsiteHeaderPolicy_CORSPolicy{
+			AllowOrigins:     policy.CORS.AllowOrigins,
+			AllowMethods:     policy.CORS.AllowMethods,
+			AllowHeaders:     policy.CORS.AllowHeaders,
+			ExposeHeaders:    policy.CORS.ExposeHeaders,
+			AllowCredentials: policy.CORS.AllowCredentials,
+			MaxAge:           int32(policy.CORS.MaxAge),
+		},
+	}
+}
+
+func FromPBWebsiteAccessControl(ac *pb.WebsiteAccessControl) domain.WebsiteAccessControl {
+	return domain.WebsiteAccessControl{
+		IPAllowList: ac.GetIpAllowList(),
+		BasicAuthUsers: ds.Map(ac.GetBasicAuthUsers(), func(u *pb.WebsiteAccessControl_BasicAuthUser) *domain.WebsiteBasicAuthUser {
+			return &domain.WebsiteBasicAuthUser{Username: u.Username, Password: u.Password}
+		}),
+	}
+}
+
+// ToPBWebsiteAccessControl converts the access control, without the passwords.
+func ToPBWebsiteAccessControl(ac *domain.WebsiteAccessControl) *pb.WebsiteAccessControl {
+	return &pb.WebsiteAccessControl{
+		IpAllowList: ac.IPAllowList,
+		BasicAuthUsers: ds.Map(ac.BasicAuthUsers, func(u *domain.WebsiteBasicAuthUser) *pb.WebsiteAccessControl_BasicAuthUser {
+			return &pb.WebsiteAccessControl_BasicAuthUser{Username: u.Username}
+		}),
+	}
+}
+
+func FromPBWebsiteRateLimit(rl *pb.WebsiteRateLimit) domain.WebsiteRateLimit {
+	return domain.WebsiteRateLimit{
+		Enabled:      rl.GetEnabled(),
+		Average:      int(rl.GetAverage()),
+		Burst:        int(rl.GetBurst()),
+		SourceHeader: rl.GetSourceHeader(),
+	}
+}
+
+func ToPBWebsiteRateLimit(rl *domain.WebsiteRateLimit) *pb.WebsiteRateLimit {
+	return &pb.WebsiteRateLimit{
+		Enabled:      rl.Enabled,
+		Average:      int32(rl.Average),
+		Burst:        int32(rl.Burst),
+		SourceHeader: rl.SourceHeader,
+	}
+}
+
+func FromPBWebsiteBackend(b *pb.WebsiteBackend) *domain.WebsiteBackend {
+	return &domain.WebsiteBackend{
+		ApplicationID: b.ApplicationId,
+		Weight:        int(b.Weight),
+	}
+}
+
+func ToPBWebsiteBackend(b *domain.WebsiteBackend) *pb.WebsiteBackend {
+	return &pb.WebsiteBackend{
+		ApplicationId: b.ApplicationID,
+		Weight:        int32(b.Weight),
 	}
 }
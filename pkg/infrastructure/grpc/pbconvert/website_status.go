@@ -0,0 +1,44 @@
+package pbconvert
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+func ToPBWebsiteProbe(p *domain.WebsiteProbe) *pb.WebsiteProbe {
+	return &pb.WebsiteProbe{
+		CheckedAt:    timestamppb.New(p.CheckedAt),
+		Up:           p.Up,
+		StatusCode:   int32(p.StatusCode),
+		LatencyMs:    p.Latency.Milliseconds(),
+		TlsExpiresAt: ToPBNullTimestamp(p.TLSExpiresAt),
+		Error:        p.Error,
+	}
+}
+
+func ToPBWebsiteUptime(u *domain.WebsiteUptime) *pb.WebsiteUptime {
+	ret := &pb.WebsiteUptime{
+		WindowSeconds: int64(u.Window.Seconds()),
+		Total:         int32(u.Total),
+		Up:            int32(u.Up),
+	}
+	if ratio := u.Ratio(); ratio.Valid {
+		ret.Ratio = &ratio.V
+	}
+	return ret
+}
+
+func ToPBWebsiteStatus(st *domain.WebsiteStatus) *pb.WebsiteStatus {
+	ret := &pb.WebsiteStatus{
+		ApplicationId: st.ApplicationID,
+		Website:       ToPBWebsite(st.Website),
+		Uptimes:       ds.Map(st.Uptimes, ToPBWebsiteUptime),
+	}
+	if st.Latest != nil {
+		ret.Latest = ToPBWebsiteProbe(st.Latest)
+	}
+	return ret
+}
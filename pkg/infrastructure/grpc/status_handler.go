@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/apiserver"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+// StatusBasePath is the path prefix of the website status endpoints served by the gateway.
+const StatusBasePath = "/api/status"
+
+// StatusHandler serves website uptime statuses as plain JSON, for use from external status pages and scripts.
+//
+//   - GET /api/status returns statuses of websites of all running applications
+//   - GET /api/status/applications/{id} returns statuses of websites of the application
+type StatusHandler struct {
+	svc *apiserver.Service
+	mux *http.ServeMux
+}
+
+func NewStatusHandler(svc *apiserver.Service) *StatusHandler {
+	h := &StatusHandler{svc: svc, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET "+StatusBasePath, h.getRunningStatuses)
+	h.mux.HandleFunc("GET "+StatusBasePath+"/applications/{id}", h.getApplicationStatuses)
+	return h
+}
+
+func (h *StatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+type websiteProbeJSON struct {
+	CheckedAt    time.Time  `json:"checkedAt"`
+	Up           bool       `json:"up"`
+	StatusCode   int        `json:"statusCode"`
+	LatencyMs    int64      `json:"latencyMs"`
+	TLSExpiresAt *time.Time `json:"tlsExpiresAt,omitempty"`
+	Error        string     `json:"error,omitempty"`
+}
+
+type websiteUptimeJSON struct {
+	WindowSeconds int64 `json:"windowSeconds"`
+	Total         int   `json:"total"`
+	Up            int   `json:"up"`
+	// Percentage is null if the website has not been probed within the window.
+	Percentage *float64 `json:"percentage"`
+}
+
+type websiteStatusJSON struct {
+	ApplicationID string              `json:"applicationId"`
+	WebsiteID     string              `json:"websiteId"`
+	URL           string              `json:"url"`
+	Latest        *websiteProbeJSON   `json:"latest"`
+	Uptimes       []websiteUptimeJSON `json:"uptimes"`
+}
+
+func toWebsiteStatusJSON(st *domain.WebsiteStatus) websiteStatusJSON {
+	ret := websiteStatusJSON{
+		ApplicationID: st.ApplicationID,
+		WebsiteID:     st.Website.ID,
+		URL:           st.Website.URL(),
+		Uptimes: ds.Map(st.Uptimes, func(u *domain.WebsiteUptime) websiteUptimeJSON {
+			uj := websiteUptimeJSON{
+				WindowSeconds: int64(u.Window.Seconds()),
+				Total:         u.Total,
+				Up:            u.Up,
+			}
+			if ratio := u.Ratio(); ratio.Valid {
+				percentage := ratio.V * 100
+				uj.Percentage = &percentage
+			}
+			return uj
+		}),
+	}
+	if p := st.Latest; p != nil {
+		ret.Latest = &websiteProbeJSON{
+			CheckedAt:  p.CheckedAt,
+			Up:         p.Up,
+			StatusCode: p.StatusCode,
+			LatencyMs:  p.Latency.Milliseconds(),
+			Error:      p.Error,
+		}
+		if p.TLSExpiresAt.Valid {
+			ret.Latest.TLSExpiresAt = &p.TLSExpiresAt.V
+		}
+	}
+	return ret
+}
+
+func (h *StatusHandler) getRunningStatuses(w http.ResponseWriter, r *http.Request) {
+	statuses, err := h.svc.GetRunningWebsiteStatuses(r.Context())
+	h.writeStatuses(w, r, statuses, err)
+}
+
+func (h *StatusHandler) getApplicationStatuses(w http.ResponseWriter, r *http.Request) {
+	statuses, err := h.svc.GetWebsiteStatus(r.Context(), r.PathValue("id"))
+	h.writeStatuses(w, r, statuses, err)
+}
+
+func (h *StatusHandler) writeStatuses(w http.ResponseWriter, r *http.Request, statuses []*domain.WebsiteStatus, err error) {
+	if err != nil {
+		writeHTTPError(w, r, handleUseCaseError(err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(ds.Map(statuses, toWebsiteStatusJSON))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to write website statuses", "error", err)
+	}
+}
@@ -0,0 +1,248 @@
+package dockerlogs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/client"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// appIDLabel is the label set to app containers by the docker backend.
+const appIDLabel = "ns.trap.jp/app-id"
+
+const (
+	// searchWindow is how far Get searches back from before, same as the other implementations.
+	searchWindow = 24 * time.Hour
+	// maxLineSize is the maximum size of a single log line.
+	maxLineSize = 1024 * 1024
+	// retryInterval is the interval to re-attach to the container in Stream, e.g. after the container is re-created.
+	retryInterval = 3 * time.Second
+)
+
+type Config struct {
+	LogLimit int `mapstructure:"logLimit" yaml:"logLimit"`
+}
+
+type dockerLogger struct {
+	config Config
+	c      *client.Client
+}
+
+// NewDockerLogger returns a ContainerLogger reading logs of app containers directly from the Docker Engine,
+// for single-host installations without a log aggregation system.
+func NewDockerLogger(config Config, c *client.Client) (domain.ContainerLogger, error) {
+	return &dockerLogger{
+		config: config,
+		c:      c,
+	}, nil
+}
+
+func (l *dockerLogger) LogLimit() int {
+	return l.config.LogLimit
+}
+
+func dockerTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+func (l *dockerLogger) findContainer(ctx context.Context, appID string) (id string, ok bool, err error) {
+	containers, err := l.c.ContainerList(ctx, client.ContainerListOptions{
+		All:     true,
+		Filters: make(client.Filters).Add("label", fmt.Sprintf("%s=%s", appIDLabel, appID)),
+	})
+	if err != nil {
+		return "", false, oops.Wrapf(err, "fetching containers")
+	}
+	if len(containers.Items) == 0 {
+		return "", false, nil
+	}
+	return containers.Items[0].ID, true, nil
+}
+
+func (l *dockerLogger) Get(ctx context.Context, app *domain.Application, before time.Time, limit int, filter domain.LogFilter) ([]*domain.ContainerLog, error) {
+	match, err := lineMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+	id, ok, err := l.findContainer(ctx, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	// Tail cannot be combined with until, so read the whole window and keep the last lines
+	rc, err := l.c.ContainerLogs(ctx, id, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Since:      dockerTimestamp(before.Add(-searchWindow)),
+		Until:      dockerTimestamp(before),
+		Timestamps: true,
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "reading container logs")
+	}
+	defer rc.Close()
+
+	var lines []*domain.ContainerLog
+	for line, err := range readLines(rc) {
+		if err != nil {
+			return nil, oops.Wrapf(err, "reading container logs")
+		}
+		if !line.Time.Before(before) || !match(line.Log) {
+			continue
+		}
+		lines = append(lines, line)
+		if len(lines) > limit {
+			lines = lines[1:]
+		}
+	}
+	return lines, nil
+}
+
+func (l *dockerLogger) Stream(ctx context.Context, app *domain.Application, begin time.Time, filter domain.LogFilter) (<-chan *domain.ContainerLog, error) {
+	match, err := lineMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *domain.ContainerLog, 100)
+
+	go func() {
+		defer close(ch)
+
+		lastSeenTime := begin
+		for {
+			err := l.follow(ctx, app.ID, lastSeenTime, func(line *domain.ContainerLog) bool {
+				switch {
+				case line.Time.After(lastSeenTime):
+					lastSeenTime = line.Time
+				case line.Time.Before(lastSeenTime):
+					return true
+				}
+				if !match(line.Log) {
+					return true
+				}
+				select {
+				case ch <- line:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+			if err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "failed to follow container logs", "app_id", app.ID, "error", err)
+			}
+
+			// Container stopped or re-created, wait for the next one
+			select {
+			case <-time.After(retryInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// follow reads logs of the current container of the app until the container stops, or yield returns false.
+func (l *dockerLogger) follow(ctx context.Context, appID string, since time.Time, yield func(line *domain.ContainerLog) bool) error {
+	id, ok, err := l.findContainer(ctx, appID)
+	if err != nil || !ok {
+		return err
+	}
+	rc, err := l.c.ContainerLogs(ctx, id, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Since:      dockerTimestamp(since),
+		Timestamps: true,
+		Follow:     true,
+	})
+	if err != nil {
+		return oops.Wrapf(err, "following container logs")
+	}
+	defer rc.Close()
+
+	for line, err := range readLines(rc) {
+		if err != nil {
+			return err
+		}
+		if !yield(line) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// lineMatcher returns a function matching log lines with the filter.
+// App containers are run with TTY, where stdout and stderr are merged, so the stream filter is not supported.
+func lineMatcher(filter domain.LogFilter) (func(line string) bool, error) {
+	if filter.Stream != domain.LogStreamAll {
+		return nil, oops.Wrapf(domain.ErrUnsupportedLogFilter, "app containers are run with tty")
+	}
+	var re *regexp.Regexp
+	if filter.Regexp != "" {
+		var err error
+		re, err = regexp.Compile(filter.Regexp)
+		if err != nil {
+			return nil, oops.Wrapf(err, "compiling regexp")
+		}
+	}
+	return func(line string) bool {
+		if filter.Contains != "" && !strings.Contains(line, filter.Contains) {
+			return false
+		}
+		if re != nil && !re.MatchString(line) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// readLines reads lines of logs with timestamps, from containers run with TTY (i.e. not multiplexed).
+func readLines(r io.Reader) iter.Seq2[*domain.ContainerLog, error] {
+	return func(yield func(*domain.ContainerLog, error) bool) {
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		for sc.Scan() {
+			line, ok := parseLine(sc.Text())
+			if !ok {
+				continue
+			}
+			if !yield(line, nil) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// parseLine parses a log line in the form of "<RFC3339Nano timestamp> <log>".
+func parseLine(s string) (*domain.ContainerLog, bool) {
+	ts, log, ok := strings.Cut(s, " ")
+	if !ok {
+		return nil, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, false
+	}
+	return &domain.ContainerLog{
+		Time: t,
+		Log:  strings.TrimSuffix(log, "\r"),
+	}, true
+}
@@ -0,0 +1,47 @@
+package dockerlogs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+func TestReadLines(t *testing.T) {
+	r := strings.NewReader("2024-01-02T03:04:05.123456789Z hello world\r\n" +
+		"invalid line\r\n" +
+		"2024-01-02T03:04:06Z \r\n")
+
+	var lines []*domain.ContainerLog
+	for line, err := range readLines(r) {
+		require.NoError(t, err)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, []*domain.ContainerLog{
+		{Time: time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC), Log: "hello world"},
+		{Time: time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC), Log: ""},
+	}, lines)
+}
+
+func TestLineMatcher(t *testing.T) {
+	match, err := lineMatcher(domain.LogFilter{Contains: "error", Regexp: `code=\d+`})
+	require.NoError(t, err)
+	assert.True(t, match("error: code=500"))
+	assert.False(t, match("error: code=?"))
+	assert.False(t, match("info: code=200"))
+
+	match, err = lineMatcher(domain.LogFilter{})
+	require.NoError(t, err)
+	assert.True(t, match("anything"))
+
+	_, err = lineMatcher(domain.LogFilter{Stream: domain.LogStreamStderr})
+	assert.ErrorIs(t, err, domain.ErrUnsupportedLogFilter)
+}
+
+func TestDockerTimestamp(t *testing.T) {
+	assert.Equal(t, "1704164645.000000012", dockerTimestamp(time.Date(2024, 1, 2, 3, 4, 5, 12, time.UTC)))
+}
@@ -0,0 +1,29 @@
+package loki
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// filterQuery builds LogQL pipeline stages appended to the stream selector.
+// User inputs are always quoted as string literals, so that they cannot modify the stream selector.
+func (l *lokiStreamer) filterQuery(filter domain.LogFilter) (string, error) {
+	var stages []string
+	if filter.Stream != domain.LogStreamAll {
+		if l.config.StreamLabel == "" {
+			return "", oops.Wrapf(domain.ErrUnsupportedLogFilter, "stream label is not configured")
+		}
+		stages = append(stages, "| "+l.config.StreamLabel+"="+strconv.Quote(filter.Stream.String()))
+	}
+	if filter.Contains != "" {
+		stages = append(stages, "|= "+strconv.Quote(filter.Contains))
+	}
+	if filter.Regexp != "" {
+		stages = append(stages, "|~ "+strconv.Quote(filter.Regexp))
+	}
+	return strings.Join(stages, " "), nil
+}
@@ -0,0 +1,48 @@
+package loki
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+func TestLokiStreamer_filterQuery(t *testing.T) {
+	l := &lokiStreamer{config: Config{StreamLabel: "log_stream"}}
+
+	tests := []struct {
+		name   string
+		filter domain.LogFilter
+		want   string
+	}{
+		{"empty", domain.LogFilter{}, ""},
+		{"substring", domain.LogFilter{Contains: "panic"}, `|= "panic"`},
+		{"regexp", domain.LogFilter{Regexp: `err(or)?\s`}, `|~ "err(or)?\\s"`},
+		{"stream", domain.LogFilter{Stream: domain.LogStreamStderr}, `| log_stream="stderr"`},
+		{
+			"escape",
+			domain.LogFilter{Contains: `"} or {app="other`, Regexp: "a\"\n`"},
+			`|= "\"} or {app=\"other" |~ "a\"\n` + "`" + `"`,
+		},
+		{
+			"all",
+			domain.LogFilter{Contains: "a", Regexp: "b", Stream: domain.LogStreamStdout},
+			`| log_stream="stdout" |= "a" |~ "b"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.filterQuery(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("stream unsupported", func(t *testing.T) {
+		l := &lokiStreamer{config: Config{}}
+		_, err := l.filterQuery(domain.LogFilter{Stream: domain.LogStreamStdout})
+		assert.ErrorIs(t, err, domain.ErrUnsupportedLogFilter)
+	})
+}
@@ -23,6 +23,9 @@ type Config struct {
 	Endpoint      string `mapstructure:"endpoint" yaml:"endpoint"`
 	QueryTemplate string `mapstructure:"queryTemplate" yaml:"queryTemplate"`
 	LogLimit      int    `mapstructure:"logLimit" yaml:"logLimit"`
+	// StreamLabel is the label name holding the output stream (stdout/stderr) of the log line.
+	// Filtering by stream is disabled if empty.
+	StreamLabel string `mapstructure:"streamLabel" yaml:"streamLabel"`
 }
 
 func DefaultQueryTemplate() string {
@@ -56,7 +59,7 @@ func NewLokiStreamer(
 
 	// check template validity
 	var dummy domain.Application
-	_, err = l.logQL(&dummy)
+	_, err = l.logQL(&dummy, domain.LogFilter{})
 	if err != nil {
 		return nil, oops.Wrapf(err, "executing logQL template")
 	}
@@ -75,20 +78,31 @@ func templateStr(tmpl *template.Template, data any) (string, error) {
 	return buf.String(), nil
 }
 
-func (l *lokiStreamer) logQL(app *domain.Application) (string, error) {
-	return templateStr(l.tmpl, m{"App": app})
+func (l *lokiStreamer) logQL(app *domain.Application, filter domain.LogFilter) (string, error) {
+	selector, err := templateStr(l.tmpl, m{"App": app})
+	if err != nil {
+		return "", err
+	}
+	stages, err := l.filterQuery(filter)
+	if err != nil {
+		return "", err
+	}
+	if stages == "" {
+		return selector, nil
+	}
+	return selector + " " + stages, nil
 }
 
 func (l *lokiStreamer) LogLimit() int {
 	return l.config.LogLimit
 }
 
-func (l *lokiStreamer) Get(ctx context.Context, app *domain.Application, before time.Time, limit int) ([]*domain.ContainerLog, error) {
+func (l *lokiStreamer) Get(ctx context.Context, app *domain.Application, before time.Time, limit int, filter domain.LogFilter) ([]*domain.ContainerLog, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", l.queryRangeEndpoint(), nil)
 	if err != nil {
 		return nil, oops.Wrapf(err, "creating http request")
 	}
-	logQL, err := l.logQL(app)
+	logQL, err := l.logQL(app, filter)
 	if err != nil {
 		return nil, oops.Wrapf(err, "templating logQL")
 	}
@@ -119,8 +133,8 @@ func (l *lokiStreamer) Get(ctx context.Context, app *domain.Application, before
 	return res.Data.Result.toSortedResponse(true)
 }
 
-func (l *lokiStreamer) Stream(ctx context.Context, app *domain.Application, begin time.Time) (<-chan *domain.ContainerLog, error) {
-	logQL, err := l.logQL(app)
+func (l *lokiStreamer) Stream(ctx context.Context, app *domain.Application, begin time.Time, filter domain.LogFilter) (<-chan *domain.ContainerLog, error) {
+	logQL, err := l.logQL(app, filter)
 	if err != nil {
 		return nil, oops.Wrapf(err, "templating logQL")
 	}
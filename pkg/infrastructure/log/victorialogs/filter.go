@@ -0,0 +1,31 @@
+package victorialogs
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// filterQuery builds LogsQL filters to be combined with the per-app query.
+// User inputs are always quoted as string literals, so that they cannot modify the per-app query.
+func (l *victoriaLogsStreamer) filterQuery(filter domain.LogFilter) (string, error) {
+	var filters []string
+	if filter.Stream != domain.LogStreamAll {
+		if l.config.StreamField == "" {
+			return "", oops.Wrapf(domain.ErrUnsupportedLogFilter, "stream field is not configured")
+		}
+		filters = append(filters, strconv.Quote(l.config.StreamField)+":="+strconv.Quote(filter.Stream.String()))
+	}
+	if filter.Contains != "" {
+		// Phrase filters in LogsQL match whole words only, so use a regexp filter to match any substring
+		filters = append(filters, "_msg:~"+strconv.Quote(regexp.QuoteMeta(filter.Contains)))
+	}
+	if filter.Regexp != "" {
+		filters = append(filters, "_msg:~"+strconv.Quote(filter.Regexp))
+	}
+	return strings.Join(filters, " "), nil
+}
@@ -0,0 +1,48 @@
+package victorialogs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+func TestVictoriaLogsStreamer_filterQuery(t *testing.T) {
+	l := &victoriaLogsStreamer{config: Config{StreamField: "stream"}}
+
+	tests := []struct {
+		name   string
+		filter domain.LogFilter
+		want   string
+	}{
+		{"empty", domain.LogFilter{}, ""},
+		{"substring", domain.LogFilter{Contains: "a.b"}, `_msg:~"a\\.b"`},
+		{"regexp", domain.LogFilter{Regexp: `err(or)?\s`}, `_msg:~"err(or)?\\s"`},
+		{"stream", domain.LogFilter{Stream: domain.LogStreamStderr}, `"stream":="stderr"`},
+		{
+			"escape",
+			domain.LogFilter{Regexp: `") or ("`},
+			`_msg:~"\") or (\""`,
+		},
+		{
+			"all",
+			domain.LogFilter{Contains: "a", Regexp: "b", Stream: domain.LogStreamStdout},
+			`"stream":="stdout" _msg:~"a" _msg:~"b"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.filterQuery(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("stream unsupported", func(t *testing.T) {
+		l := &victoriaLogsStreamer{config: Config{}}
+		_, err := l.filterQuery(domain.LogFilter{Stream: domain.LogStreamStdout})
+		assert.ErrorIs(t, err, domain.ErrUnsupportedLogFilter)
+	})
+}
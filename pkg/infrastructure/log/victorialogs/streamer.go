@@ -20,6 +20,9 @@ type Config struct {
 	Endpoint      string `mapstructure:"endpoint" yaml:"endpoint"`
 	QueryTemplate string `mapstructure:"queryTemplate" yaml:"queryTemplate"`
 	LogLimit      int    `mapstructure:"logLimit" yaml:"logLimit"`
+	// StreamField is the field name holding the output stream (stdout/stderr) of the log line.
+	// Filtering by stream is disabled if empty.
+	StreamField string `mapstructure:"streamField" yaml:"streamField"`
 }
 
 func DefaultQueryTemplate() string {
@@ -51,7 +54,7 @@ func NewVictoriaLogsStreamer(config Config) (domain.ContainerLogger, error) {
 
 	// check template validity
 	var dummy domain.Application
-	_, err = l.logsQL(&dummy)
+	_, err = l.logsQL(&dummy, domain.LogFilter{})
 	if err != nil {
 		return nil, oops.Wrapf(err, "executing logsQL template")
 	}
@@ -70,20 +73,32 @@ func templateStr(tmpl *template.Template, data any) (string, error) {
 	return buf.String(), nil
 }
 
-func (l *victoriaLogsStreamer) logsQL(app *domain.Application) (string, error) {
-	return templateStr(l.tmpl, m{"App": app})
+func (l *victoriaLogsStreamer) logsQL(app *domain.Application, filter domain.LogFilter) (string, error) {
+	query, err := templateStr(l.tmpl, m{"App": app})
+	if err != nil {
+		return "", err
+	}
+	filters, err := l.filterQuery(filter)
+	if err != nil {
+		return "", err
+	}
+	if filters == "" {
+		return query, nil
+	}
+	// Parenthesize the per-app query, so that filters are always combined by AND
+	return "(" + query + ") " + filters, nil
 }
 
 func (l *victoriaLogsStreamer) LogLimit() int {
 	return l.config.LogLimit
 }
 
-func (l *victoriaLogsStreamer) Get(ctx context.Context, app *domain.Application, before time.Time, limit int) ([]*domain.ContainerLog, error) {
+func (l *victoriaLogsStreamer) Get(ctx context.Context, app *domain.Application, before time.Time, limit int, filter domain.LogFilter) ([]*domain.ContainerLog, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", l.queryEndpoint(), nil)
 	if err != nil {
 		return nil, oops.Wrapf(err, "creating http request")
 	}
-	logsQL, err := l.logsQL(app)
+	logsQL, err := l.logsQL(app, filter)
 	if err != nil {
 		return nil, oops.Wrapf(err, "templating logsQL")
 	}
@@ -115,12 +130,12 @@ func (l *victoriaLogsStreamer) Get(ctx context.Context, app *domain.Application,
 	return lines, nil
 }
 
-func (l *victoriaLogsStreamer) Stream(ctx context.Context, app *domain.Application, begin time.Time) (<-chan *domain.ContainerLog, error) {
+func (l *victoriaLogsStreamer) Stream(ctx context.Context, app *domain.Application, begin time.Time, filter domain.LogFilter) (<-chan *domain.ContainerLog, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", l.tailEndpoint(), nil)
 	if err != nil {
 		return nil, oops.Wrapf(err, "creating http request")
 	}
-	logsQL, err := l.logsQL(app)
+	logsQL, err := l.logsQL(app, filter)
 	if err != nil {
 		return nil, oops.Wrapf(err, "templating logsQL")
 	}
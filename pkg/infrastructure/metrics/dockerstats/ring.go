@@ -0,0 +1,53 @@
+package dockerstats
+
+import "time"
+
+type sample struct {
+	time   time.Time
+	cpu    float64
+	memory float64
+	// cpuLimit and memoryLimit are the limits of the container at the time of the sample.
+	cpuLimit    float64
+	memoryLimit float64
+}
+
+// ring is a fixed-size ring buffer of samples, overwriting the oldest sample when full.
+type ring struct {
+	buf  []sample
+	next int
+	full bool
+}
+
+func newRing(size int) *ring {
+	return &ring{buf: make([]sample, size)}
+}
+
+func (r *ring) add(s sample) {
+	r.buf[r.next] = s
+	r.next = (r.next + 1) % len(r.buf)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// samples returns the samples from the oldest to the newest.
+func (r *ring) samples() []sample {
+	if !r.full {
+		return append([]sample(nil), r.buf[:r.next]...)
+	}
+	return append(append([]sample(nil), r.buf[r.next:]...), r.buf[:r.next]...)
+}
+
+// last returns the newest sample.
+func (r *ring) last() (sample, bool) {
+	if r.next == 0 && !r.full {
+		return sample{}, false
+	}
+	return r.buf[(r.next-1+len(r.buf))%len(r.buf)], true
+}
+
+// latest returns the time of the newest sample.
+func (r *ring) latest() time.Time {
+	s, _ := r.last()
+	return s.time
+}
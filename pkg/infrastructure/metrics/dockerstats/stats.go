@@ -0,0 +1,295 @@
+package dockerstats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const (
+	// appLabel and appIDLabel are the labels set to app containers by the docker backend.
+	appLabel   = "ns.trap.jp/app"
+	appIDLabel = "ns.trap.jp/app-id"
+
+	metricsCPU    = "CPU"
+	metricsMemory = "Memory"
+
+	// sampleConcurrency is the number of containers sampled at once. Each sample takes about a second.
+	sampleConcurrency = 8
+)
+
+type Config struct {
+	// Interval is the interval of sampling, e.g. "30s".
+	Interval string `mapstructure:"interval" yaml:"interval"`
+	// Retention is how long samples are kept in memory, e.g. "6h".
+	Retention string `mapstructure:"retention" yaml:"retention"`
+}
+
+type dockerStats struct {
+	c         *client.Client
+	interval  time.Duration
+	retention time.Duration
+
+	lock  sync.RWMutex
+	rings map[string]*ring
+	// cpuLimits caches the CPU limits of the containers by container ID, 0 if not limited.
+	cpuLimits map[string]float64
+}
+
+// NewDockerStats returns a MetricsService which periodically samples resource usages of app containers
+// from the Docker Engine, and keeps them in memory, for single-host installations without Prometheus.
+func NewDockerStats(config Config, c *client.Client) (domain.MetricsService, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid interval")
+	}
+	retention, err := time.ParseDuration(config.Retention)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid retention")
+	}
+	if interval <= 0 || retention < interval {
+		return nil, oops.Errorf("interval must be positive and retention must be at least interval")
+	}
+
+	s := &dockerStats{
+		c:         c,
+		interval:  interval,
+		retention: retention,
+		rings:     make(map[string]*ring),
+		cpuLimits: make(map[string]float64),
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *dockerStats) run() {
+	ctx := context.Background()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.sampleAll(ctx); err != nil {
+			slog.WarnContext(ctx, "failed to sample container stats", "error", err)
+		}
+		<-ticker.C
+	}
+}
+
+func (s *dockerStats) sampleAll(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	containers, err := s.c.ContainerList(ctx, client.ContainerListOptions{
+		Filters: make(client.Filters).Add("label", fmt.Sprintf("%s=true", appLabel)),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "fetching containers")
+	}
+
+	var eg errgroup.Group
+	eg.SetLimit(sampleConcurrency)
+	for _, c := range containers.Items {
+		appID := c.Labels[appIDLabel]
+		eg.Go(func() error {
+			smp, err := s.sample(ctx, c.ID)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to sample container stats", "app_id", appID, "error", err)
+				return nil
+			}
+			s.add(appID, smp)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	s.prune(time.Now())
+	s.pruneCPULimits(lo.Map(containers.Items, func(c container.Summary, _ int) string { return c.ID }))
+	return nil
+}
+
+func (s *dockerStats) sample(ctx context.Context, containerID string) (sample, error) {
+	res, err := s.c.ContainerStats(ctx, containerID, client.ContainerStatsOptions{IncludePreviousSample: true})
+	if err != nil {
+		return sample{}, oops.Wrapf(err, "getting container stats")
+	}
+	defer res.Body.Close()
+
+	var stats container.StatsResponse
+	if err = json.NewDecoder(res.Body).Decode(&stats); err != nil {
+		return sample{}, oops.Wrapf(err, "decoding container stats")
+	}
+	cpuLimit, err := s.cpuLimit(ctx, containerID)
+	if err != nil {
+		return sample{}, err
+	}
+	if cpuLimit == 0 {
+		// Not limited, the container can use all CPUs as in "docker stats"
+		cpuLimit = float64(onlineCPUs(&stats))
+	}
+	return sample{
+		time:        stats.Read,
+		cpu:         cpuUsage(&stats),
+		memory:      memoryUsage(&stats),
+		cpuLimit:    cpuLimit,
+		memoryLimit: float64(stats.MemoryStats.Limit),
+	}, nil
+}
+
+// cpuLimit returns the CPU limit of the container in cores, or 0 if not limited.
+func (s *dockerStats) cpuLimit(ctx context.Context, containerID string) (float64, error) {
+	s.lock.RLock()
+	limit, ok := s.cpuLimits[containerID]
+	s.lock.RUnlock()
+	if ok {
+		return limit, nil
+	}
+
+	res, err := s.c.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return 0, oops.Wrapf(err, "inspecting container")
+	}
+	resources := res.Container.HostConfig.Resources
+	switch {
+	case resources.NanoCPUs > 0:
+		limit = float64(resources.NanoCPUs) / 1e9
+	case resources.CPUQuota > 0 && resources.CPUPeriod > 0:
+		limit = float64(resources.CPUQuota) / float64(resources.CPUPeriod)
+	}
+
+	s.lock.Lock()
+	s.cpuLimits[containerID] = limit
+	s.lock.Unlock()
+	return limit, nil
+}
+
+// pruneCPULimits removes the cached CPU limits of containers which no longer exist.
+func (s *dockerStats) pruneCPULimits(containerIDs []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for id := range s.cpuLimits {
+		if !slices.Contains(containerIDs, id) {
+			delete(s.cpuLimits, id)
+		}
+	}
+}
+
+func onlineCPUs(stats *container.StatsResponse) int {
+	if stats.CPUStats.OnlineCPUs != 0 {
+		return int(stats.CPUStats.OnlineCPUs)
+	}
+	return len(stats.CPUStats.CPUUsage.PercpuUsage)
+}
+
+// cpuUsage calculates the CPU usage in cores, in the same way as "docker stats" does.
+func cpuUsage(stats *container.StatsResponse) float64 {
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+	return cpuDelta / systemDelta * float64(onlineCPUs(stats))
+}
+
+// memoryUsage calculates the memory usage in bytes excluding the page cache, in the same way as "docker stats" does.
+func memoryUsage(stats *container.StatsResponse) float64 {
+	usage := stats.MemoryStats.Usage
+	// cgroup v1 reports total_inactive_file, cgroup v2 reports inactive_file
+	cache, ok := stats.MemoryStats.Stats["total_inactive_file"]
+	if !ok {
+		cache = stats.MemoryStats.Stats["inactive_file"]
+	}
+	if cache < usage {
+		usage -= cache
+	}
+	return float64(usage)
+}
+
+func (s *dockerStats) GetLimit(_ context.Context, name string, app *domain.Application) (optional.Of[float64], error) {
+	var limit func(smp sample) float64
+	switch name {
+	case metricsCPU:
+		limit = func(smp sample) float64 { return smp.cpuLimit }
+	case metricsMemory:
+		limit = func(smp sample) float64 { return smp.memoryLimit }
+	default:
+		return optional.None[float64](), oops.Errorf("no such metrics: %v", name)
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	r, ok := s.rings[app.ID]
+	if !ok {
+		return optional.None[float64](), nil
+	}
+	smp, ok := r.last()
+	if !ok || limit(smp) <= 0 {
+		return optional.None[float64](), nil
+	}
+	return optional.From(limit(smp)), nil
+}
+
+func (s *dockerStats) add(appID string, smp sample) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	r, ok := s.rings[appID]
+	if !ok {
+		r = newRing(int(s.retention / s.interval))
+		s.rings[appID] = r
+	}
+	r.add(smp)
+}
+
+// prune removes samples of apps with no samples within the retention, e.g. stopped or deleted apps.
+func (s *dockerStats) prune(now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for appID, r := range s.rings {
+		if now.Sub(r.latest()) > s.retention {
+			delete(s.rings, appID)
+		}
+	}
+}
+
+func (s *dockerStats) AvailableNames() []string {
+	return []string{metricsCPU, metricsMemory}
+}
+
+func (s *dockerStats) Get(_ context.Context, name string, app *domain.Application, before time.Time, limit time.Duration) ([]*domain.AppMetric, error) {
+	var value func(smp sample) float64
+	switch name {
+	case metricsCPU:
+		value = func(smp sample) float64 { return smp.cpu }
+	case metricsMemory:
+		value = func(smp sample) float64 { return smp.memory }
+	default:
+		return nil, oops.Errorf("no such metrics: %v", name)
+	}
+
+	s.lock.RLock()
+	r, ok := s.rings[app.ID]
+	var samples []sample
+	if ok {
+		samples = r.samples()
+	}
+	s.lock.RUnlock()
+
+	after := before.Add(-limit)
+	samples = lo.Filter(samples, func(smp sample, _ int) bool {
+		return smp.time.After(after) && !smp.time.After(before)
+	})
+	return lo.Map(samples, func(smp sample, _ int) *domain.AppMetric {
+		return &domain.AppMetric{Time: smp.time, Value: value(smp)}
+	}), nil
+}
@@ -0,0 +1,107 @@
+package dockerstats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestRing(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(i int) sample { return sample{time: base.Add(time.Duration(i) * time.Minute), cpu: float64(i)} }
+
+	r := newRing(3)
+	assert.Empty(t, r.samples())
+	assert.True(t, r.latest().IsZero())
+
+	r.add(at(0))
+	r.add(at(1))
+	assert.Equal(t, []sample{at(0), at(1)}, r.samples())
+	assert.Equal(t, at(1).time, r.latest())
+
+	r.add(at(2))
+	r.add(at(3))
+	assert.Equal(t, []sample{at(1), at(2), at(3)}, r.samples())
+	assert.Equal(t, at(3).time, r.latest())
+}
+
+func TestCPUUsage(t *testing.T) {
+	stats := &container.StatsResponse{
+		CPUStats: container.CPUStats{
+			CPUUsage:    container.CPUUsage{TotalUsage: 3_000},
+			SystemUsage: 20_000,
+			OnlineCPUs:  4,
+		},
+		PreCPUStats: container.CPUStats{
+			CPUUsage:    container.CPUUsage{TotalUsage: 1_000},
+			SystemUsage: 10_000,
+		},
+	}
+	assert.InDelta(t, 0.8, cpuUsage(stats), 1e-9)
+	assert.Zero(t, cpuUsage(&container.StatsResponse{}))
+}
+
+func TestMemoryUsage(t *testing.T) {
+	assert.Equal(t, float64(700), memoryUsage(&container.StatsResponse{
+		MemoryStats: container.MemoryStats{Usage: 1000, Stats: map[string]uint64{"inactive_file": 300}},
+	}))
+	assert.Equal(t, float64(600), memoryUsage(&container.StatsResponse{
+		MemoryStats: container.MemoryStats{Usage: 1000, Stats: map[string]uint64{"total_inactive_file": 400}},
+	}))
+}
+
+func TestDockerStats_Get(t *testing.T) {
+	s := &dockerStats{interval: time.Minute, retention: 10 * time.Minute, rings: make(map[string]*ring)}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		s.add("app", sample{time: base.Add(time.Duration(i) * time.Minute), cpu: float64(i), memory: float64(i * 100)})
+	}
+	app := &domain.Application{ID: "app"}
+
+	metrics, err := s.Get(context.Background(), metricsMemory, app, base.Add(3*time.Minute), 2*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.AppMetric{
+		{Time: base.Add(2 * time.Minute), Value: 200},
+		{Time: base.Add(3 * time.Minute), Value: 300},
+	}, metrics)
+
+	_, err = s.Get(context.Background(), "unknown", app, base, time.Minute)
+	assert.Error(t, err)
+
+	s.prune(base.Add(15 * time.Minute))
+	metrics, err = s.Get(context.Background(), metricsCPU, app, base.Add(5*time.Minute), time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, metrics)
+}
+
+func TestDockerStats_GetLimit(t *testing.T) {
+	s := &dockerStats{interval: time.Minute, retention: 10 * time.Minute, rings: make(map[string]*ring)}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.add("app", sample{time: base, cpuLimit: 1, memoryLimit: 1000})
+	s.add("app", sample{time: base.Add(time.Minute), cpuLimit: 2, memoryLimit: 2000})
+	s.add("unlimited", sample{time: base})
+
+	limit, err := s.GetLimit(context.Background(), metricsCPU, &domain.Application{ID: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, optional.From(2.0), limit)
+	limit, err = s.GetLimit(context.Background(), metricsMemory, &domain.Application{ID: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, optional.From(2000.0), limit)
+
+	limit, err = s.GetLimit(context.Background(), metricsMemory, &domain.Application{ID: "unlimited"})
+	require.NoError(t, err)
+	assert.False(t, limit.Valid)
+	limit, err = s.GetLimit(context.Background(), metricsMemory, &domain.Application{ID: "unknown"})
+	require.NoError(t, err)
+	assert.False(t, limit.Valid)
+
+	_, err = s.GetLimit(context.Background(), "unknown", &domain.Application{ID: "app"})
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"slices"
 	"text/template"
 	"time"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 const defaultStep = 1 * time.Minute
@@ -22,6 +24,9 @@ const defaultStep = 1 * time.Minute
 type QueryConfig struct {
 	Name     string `mapstructure:"name" yaml:"name"`
 	Template string `mapstructure:"template" yaml:"template"`
+	// LimitTemplate is the query of the upper bound of the metric, used for alert rules in percent of the limit.
+	// The metric has no limit if empty.
+	LimitTemplate string `mapstructure:"limitTemplate" yaml:"limitTemplate"`
 }
 
 func DefaultQueriesConfig() []*QueryConfig {
@@ -29,12 +34,14 @@ func DefaultQueriesConfig() []*QueryConfig {
 	selector := `namespace="ns-apps", pod="nsapp-{{ .App.ID }}-0", container="app"`
 	return []*QueryConfig{
 		{
-			Name:     "CPU",
-			Template: fmt.Sprintf(`rate(container_cpu_user_seconds_total{%s}[5m]) + rate(container_cpu_system_seconds_total{%s}[5m])`, selector, selector),
+			Name:          "CPU",
+			Template:      fmt.Sprintf(`rate(container_cpu_user_seconds_total{%s}[5m]) + rate(container_cpu_system_seconds_total{%s}[5m])`, selector, selector),
+			LimitTemplate: fmt.Sprintf(`container_spec_cpu_quota{%s} / container_spec_cpu_period{%s}`, selector, selector),
 		},
 		{
-			Name:     "Memory",
-			Template: fmt.Sprintf(`container_memory_usage_bytes{%s} + container_memory_swap{%s}`, selector, selector),
+			Name:          "Memory",
+			Template:      fmt.Sprintf(`container_memory_usage_bytes{%s} + container_memory_swap{%s}`, selector, selector),
+			LimitTemplate: fmt.Sprintf(`container_spec_memory_limit_bytes{%s}`, selector),
 		},
 	}
 }
@@ -45,21 +52,30 @@ type Config struct {
 }
 
 type promClient struct {
-	config    Config
-	templates map[string]*template.Template
-	client    promv1.API
+	config         Config
+	templates      map[string]*template.Template
+	limitTemplates map[string]*template.Template
+	client         promv1.API
 }
 
 func NewPromClient(
 	config Config,
 ) (domain.MetricsService, error) {
 	templates := make(map[string]*template.Template, len(config.Queries))
+	limitTemplates := make(map[string]*template.Template)
 	for _, qc := range config.Queries {
 		tmpl, err := template.New(fmt.Sprintf("promQL templater %v", qc.Name)).Parse(qc.Template)
 		if err != nil {
 			return nil, oops.With("query_name", qc.Name).Wrapf(err, "parsing promQL template")
 		}
 		templates[qc.Name] = tmpl
+		if qc.LimitTemplate != "" {
+			tmpl, err = template.New(fmt.Sprintf("promQL limit templater %v", qc.Name)).Parse(qc.LimitTemplate)
+			if err != nil {
+				return nil, oops.With("query_name", qc.Name).Wrapf(err, "parsing promQL limit template")
+			}
+			limitTemplates[qc.Name] = tmpl
+		}
 	}
 
 	client, err := api.NewClient(api.Config{Address: config.Endpoint})
@@ -67,9 +83,10 @@ func NewPromClient(
 		return nil, oops.Wrapf(err, "creating prom cleint")
 	}
 	p := &promClient{
-		config:    config,
-		templates: templates,
-		client:    promv1.NewAPI(client),
+		config:         config,
+		templates:      templates,
+		limitTemplates: limitTemplates,
+		client:         promv1.NewAPI(client),
 	}
 
 	// check templates validity
@@ -79,6 +96,12 @@ func NewPromClient(
 		if err != nil {
 			return nil, oops.With("query_name", qc.Name).Wrapf(err, "executing logQL template")
 		}
+		if tmpl, ok := limitTemplates[qc.Name]; ok {
+			_, err = templateStr(tmpl, m{"App": &dummy})
+			if err != nil {
+				return nil, oops.With("query_name", qc.Name).Wrapf(err, "executing limit template")
+			}
+		}
 	}
 
 	return p, nil
@@ -131,6 +154,31 @@ func (p *promClient) Get(ctx context.Context, name string, app *domain.Applicati
 	return toSortedResponse(mv), nil
 }
 
+func (p *promClient) GetLimit(ctx context.Context, name string, app *domain.Application) (optional.Of[float64], error) {
+	tmpl, ok := p.limitTemplates[name]
+	if !ok {
+		return optional.None[float64](), nil
+	}
+	promQL, err := templateStr(tmpl, m{"App": app})
+	if err != nil {
+		return optional.None[float64](), oops.Wrapf(err, "templating promQL")
+	}
+	v, _, err := p.client.Query(ctx, promQL, time.Now())
+	if err != nil {
+		return optional.None[float64](), oops.Wrapf(err, "executing query")
+	}
+
+	vec, ok := v.(model.Vector)
+	if !ok {
+		return optional.None[float64](), oops.Errorf("expected result type to be vector, but got %v", v.Type().String())
+	}
+	// cadvisor reports 0 or -1 (resulting in NaN or a negative value) if the container is not limited
+	if len(vec) == 0 || !(vec[0].Value > 0) || math.IsInf(float64(vec[0].Value), 0) {
+		return optional.None[float64](), nil
+	}
+	return optional.From(float64(vec[0].Value)), nil
+}
+
 func toSortedResponse(mv model.Matrix) []*domain.AppMetric {
 	var items []*domain.AppMetric
 	for _, item := range mv {
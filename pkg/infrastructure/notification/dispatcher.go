@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/netutil"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+type Config struct {
+	// Timeout is the timeout of sending a notification to each sink, e.g. "10s".
+	Timeout string     `mapstructure:"timeout" yaml:"timeout"`
+	SMTP    SMTPConfig `mapstructure:"smtp" yaml:"smtp"`
+}
+
+// sink delivers notifications to the target of a subscription.
+type sink interface {
+	send(ctx context.Context, sub *domain.NotificationSubscription, n *domain.Notification) error
+}
+
+type dispatcher struct {
+	subRepo domain.NotificationSubscriptionRepository
+	sinks   map[domain.NotificationSinkType]sink
+	timeout time.Duration
+}
+
+// NewDispatcher returns a notifier which delivers notifications to the sinks subscribed by the recipients.
+//
+// Notify returns once the subscriptions are looked up, and notifications are delivered in background,
+// so that slow or unavailable sinks do not block the callers.
+// Webhook targets are resolved by resolver, and only public addresses are connected to.
+func NewDispatcher(subRepo domain.NotificationSubscriptionRepository, resolver domain.DNSResolver, c Config) (domain.Notifier, error) {
+	timeout, err := time.ParseDuration(c.Timeout)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid timeout")
+	}
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext: (&netutil.PublicDialer{Resolver: resolver}).DialContext,
+		},
+	}
+	return &dispatcher{
+		subRepo: subRepo,
+		sinks: map[domain.NotificationSinkType]sink{
+			domain.NotificationSinkWebhook: &webhookSink{client: client},
+			domain.NotificationSinkSlack:   &slackSink{client: client},
+			domain.NotificationSinkTraQ:    &traQSink{client: client},
+			domain.NotificationSinkEmail:   &emailSink{config: c.SMTP},
+		},
+		timeout: timeout,
+	}, nil
+}
+
+func (d *dispatcher) Notify(ctx context.Context, n *domain.Notification) error {
+	slog.InfoContext(ctx, "Notification", "app_id", n.ApplicationID, "event", n.Event.String(), "user_ids", n.UserIDs, "title", n.Title, "message", n.Message)
+
+	subs, err := d.subRepo.GetSubscriptions(ctx, domain.GetNotificationSubscriptionCondition{
+		ApplicationID: optional.From(n.ApplicationID),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "getting notification subscriptions")
+	}
+	subs = lo.Filter(subs, func(sub *domain.NotificationSubscription, _ int) bool { return sub.Subscribes(n) })
+
+	for _, sub := range subs {
+		go d.send(sub, n)
+	}
+	return nil
+}
+
+func (d *dispatcher) send(sub *domain.NotificationSubscription, n *domain.Notification) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	s, ok := d.sinks[sub.Sink]
+	if !ok {
+		slog.ErrorContext(ctx, "unknown notification sink", "sink", sub.Sink, "subscription_id", sub.ID)
+		return
+	}
+	err := s.send(ctx, sub, n)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to send notification", "app_id", n.ApplicationID, "subscription_id", sub.ID, "error", err)
+	}
+}
@@ -0,0 +1,206 @@
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+type fakeSubscriptionRepository struct {
+	domain.NotificationSubscriptionRepository
+	subs []*domain.NotificationSubscription
+}
+
+func (r *fakeSubscriptionRepository) GetSubscriptions(_ context.Context, cond domain.GetNotificationSubscriptionCondition) ([]*domain.NotificationSubscription, error) {
+	var ret []*domain.NotificationSubscription
+	for _, sub := range r.subs {
+		if !cond.ApplicationID.Valid || sub.ApplicationID == cond.ApplicationID.V {
+			ret = append(ret, sub)
+		}
+	}
+	return ret, nil
+}
+
+type received struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T) (*httptest.Server, <-chan received) {
+	ch := make(chan received, 10)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ch <- received{path: r.URL.Path, header: r.Header, body: body}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, ch
+}
+
+func receive(t *testing.T, ch <-chan received) received {
+	select {
+	case r := <-ch:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not received")
+		return received{}
+	}
+}
+
+func hmacHex(secret string, body []byte, sha256Hash bool) string {
+	newHash := sha1.New
+	if sha256Hash {
+		newHash = sha256.New
+	}
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestDispatcher_Notify(t *testing.T) {
+	srv, ch := newReceiver(t)
+	sub := func(id string, userID string, sink domain.NotificationSinkType, secret string, events ...domain.NotificationEvent) *domain.NotificationSubscription {
+		return &domain.NotificationSubscription{
+			ID:            id,
+			ApplicationID: "app",
+			UserID:        userID,
+			Sink:          sink,
+			Target:        srv.URL + "/" + id,
+			Secret:        secret,
+			Events:        events,
+		}
+	}
+	repo := &fakeSubscriptionRepository{subs: []*domain.NotificationSubscription{
+		sub("webhook", "owner", domain.NotificationSinkWebhook, "secret", domain.NotificationEventBuildFailed),
+		sub("slack", "owner", domain.NotificationSinkSlack, "", domain.NotificationEventBuildFailed, domain.NotificationEventDeployed),
+		sub("traq", "owner", domain.NotificationSinkTraQ, "secret", domain.NotificationEventDeployed),
+		sub("other-user", "former-owner", domain.NotificationSinkWebhook, "", domain.NotificationEventBuildFailed),
+	}}
+	n, err := NewDispatcher(repo, net.DefaultResolver, Config{Timeout: "5s"})
+	require.NoError(t, err)
+	d := n.(*dispatcher)
+	d.sinks[domain.NotificationSinkWebhook].(*webhookSink).client = srv.Client()
+	d.sinks[domain.NotificationSinkSlack].(*slackSink).client = srv.Client()
+	d.sinks[domain.NotificationSinkTraQ].(*traQSink).client = srv.Client()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = d.Notify(context.Background(), &domain.Notification{
+		ApplicationID: "app",
+		Event:         domain.NotificationEventBuildFailed,
+		UserIDs:       []string{"owner"},
+		Title:         "Build failed: app",
+		Message:       "details",
+		Time:          now,
+	})
+	require.NoError(t, err)
+
+	got := map[string]received{}
+	for range 2 {
+		r := receive(t, ch)
+		got[r.path] = r
+	}
+	if assert.Contains(t, got, "/webhook") {
+		r := got["/webhook"]
+		var payload webhookPayload
+		require.NoError(t, json.Unmarshal(r.body, &payload))
+		assert.Equal(t, webhookPayload{Event: "build_failed", ApplicationID: "app", Title: "Build failed: app", Message: "details", Time: now}, payload)
+		assert.Equal(t, "sha256="+hmacHex("secret", r.body, true), r.header.Get(webhookSignatureHeader))
+	}
+	if assert.Contains(t, got, "/slack") {
+		assert.JSONEq(t, `{"text":"*Build failed: app*\ndetails"}`, string(got["/slack"].body))
+	}
+
+	err = d.Notify(context.Background(), &domain.Notification{
+		ApplicationID: "app",
+		Event:         domain.NotificationEventDeployed,
+		UserIDs:       []string{"owner"},
+		Title:         "Deploy completed: app",
+		Message:       "running",
+		Time:          now,
+	})
+	require.NoError(t, err)
+	got = map[string]received{}
+	for range 2 {
+		r := receive(t, ch)
+		got[r.path] = r
+	}
+	if assert.Contains(t, got, "/traq") {
+		r := got["/traq"]
+		assert.Equal(t, "**Deploy completed: app**\nrunning", string(r.body))
+		assert.Equal(t, hmacHex("secret", r.body, false), r.header.Get(traQSignatureHeader))
+	}
+	assert.Contains(t, got, "/slack")
+
+	select {
+	case r := <-ch:
+		t.Errorf("unexpected notification to %s", r.path)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+type fakeResolver struct {
+	hosts map[string][]string
+}
+
+func (r *fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	// IP addresses are returned as is, like net.Resolver
+	if _, err := netip.ParseAddr(host); err == nil {
+		return []string{host}, nil
+	}
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func TestDispatcher_NonPublicTarget(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	resolver := &fakeResolver{hosts: map[string][]string{
+		"loopback.example.com": {"127.0.0.1"},
+		"mixed.example.com":    {"10.0.0.1", "127.0.0.1"},
+		"metadata.example.com": {"169.254.169.254"},
+	}}
+	n, err := NewDispatcher(&fakeSubscriptionRepository{}, resolver, Config{Timeout: "5s"})
+	require.NoError(t, err)
+	webhook := n.(*dispatcher).sinks[domain.NotificationSinkWebhook]
+
+	for _, host := range []string{"loopback.example.com", "mixed.example.com", "metadata.example.com", "127.0.0.1", "unknown.example.com"} {
+		t.Run(host, func(t *testing.T) {
+			sub := &domain.NotificationSubscription{
+				Sink:   domain.NotificationSinkWebhook,
+				Target: "http://" + net.JoinHostPort(host, u.Port()) + "/",
+			}
+			err := webhook.send(context.Background(), sub, &domain.Notification{Event: domain.NotificationEventDeployed})
+			assert.Error(t, err)
+		})
+	}
+	assert.False(t, called)
+}
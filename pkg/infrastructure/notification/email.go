@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+type SMTPConfig struct {
+	// Host is the SMTP server host. Email notifications are disabled if empty.
+	Host     string `mapstructure:"host" yaml:"host"`
+	Port     int    `mapstructure:"port" yaml:"port"`
+	Username string `mapstructure:"username" yaml:"username"`
+	Password string `mapstructure:"password" yaml:"password"`
+	// From is the sender address of the emails.
+	From string `mapstructure:"from" yaml:"from"`
+}
+
+type emailSink struct {
+	config SMTPConfig
+}
+
+func (s *emailSink) send(ctx context.Context, sub *domain.NotificationSubscription, n *domain.Notification) error {
+	if s.config.Host == "" {
+		return oops.New("email notifications are not configured")
+	}
+
+	var msg bytes.Buffer
+	msg.WriteString("From: " + s.config.From + "\r\n")
+	msg.WriteString("To: " + sub.Target + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", n.Title) + "\r\n")
+	msg.WriteString("Date: " + n.Time.Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(n.Message + "\r\n")
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	// smtp.SendMail does not take a context
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(addr, auth, s.config.From, []string{sub.Target}, msg.Bytes())
+	}()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return oops.Wrapf(err, "sending email")
+		}
+		return nil
+	case <-ctx.Done():
+		return oops.Wrapf(ctx.Err(), "sending email")
+	}
+}
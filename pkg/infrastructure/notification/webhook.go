@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+const (
+	// webhookSignatureHeader is the header of the HMAC-SHA256 signature of the body, sent if the secret is set.
+	webhookSignatureHeader = "X-NeoShowcase-Signature"
+	// traQSignatureHeader is the header of the HMAC-SHA1 signature of the body, verified by traQ if the secret is set.
+	traQSignatureHeader = "X-TRAQ-Signature"
+)
+
+func post(ctx context.Context, client *http.Client, url string, contentType string, body []byte, header http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return oops.Wrapf(err, "creating request")
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return oops.Wrapf(err, "sending request")
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return oops.Errorf("unexpected status code: %v", res.StatusCode)
+	}
+	return nil
+}
+
+func sign(secret string, body []byte, newHash func() hash.Hash) string {
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookPayload is the JSON body posted by webhookSink.
+type webhookPayload struct {
+	Event         string    `json:"event"`
+	ApplicationID string    `json:"applicationId"`
+	Title         string    `json:"title"`
+	Message       string    `json:"message"`
+	Time          time.Time `json:"time"`
+}
+
+type webhookSink struct {
+	client *http.Client
+}
+
+func (s *webhookSink) send(ctx context.Context, sub *domain.NotificationSubscription, n *domain.Notification) error {
+	body, err := json.Marshal(&webhookPayload{
+		Event:         n.Event.String(),
+		ApplicationID: n.ApplicationID,
+		Title:         n.Title,
+		Message:       n.Message,
+		Time:          n.Time,
+	})
+	if err != nil {
+		return oops.Wrapf(err, "marshaling payload")
+	}
+	header := http.Header{}
+	if sub.Secret != "" {
+		header.Set(webhookSignatureHeader, "sha256="+sign(sub.Secret, body, sha256.New))
+	}
+	return post(ctx, s.client, sub.Target, "application/json", body, header)
+}
+
+type slackSink struct {
+	client *http.Client
+}
+
+func (s *slackSink) send(ctx context.Context, sub *domain.NotificationSubscription, n *domain.Notification) error {
+	body, err := json.Marshal(map[string]string{
+		"text": "*" + n.Title + "*\n" + n.Message,
+	})
+	if err != nil {
+		return oops.Wrapf(err, "marshaling payload")
+	}
+	return post(ctx, s.client, sub.Target, "application/json", body, nil)
+}
+
+type traQSink struct {
+	client *http.Client
+}
+
+func (s *traQSink) send(ctx context.Context, sub *domain.NotificationSubscription, n *domain.Notification) error {
+	body := []byte("**" + n.Title + "**\n" + n.Message)
+	header := http.Header{}
+	if sub.Secret != "" {
+		header.Set(traQSignatureHeader, sign(sub.Secret, body, sha1.New))
+	}
+	return post(ctx, s.client, sub.Target, "text/plain; charset=utf-8", body, header)
+}
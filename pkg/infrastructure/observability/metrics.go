@@ -44,8 +44,16 @@ func (s *MetricsServer) Shutdown(ctx context.Context) error {
 }
 
 type ControllerMetrics struct {
-	buildCounter   *prometheus.CounterVec
-	deployDuration *prometheus.HistogramVec
+	buildCounter    *prometheus.CounterVec
+	deployDuration  *prometheus.HistogramVec
+	queueWait       *prometheus.HistogramVec
+	buildDuration   *prometheus.HistogramVec
+	buildStep       *prometheus.HistogramVec
+	builders        *prometheus.GaugeVec
+	artifactSize    *prometheus.HistogramVec
+	imageSize       *prometheus.HistogramVec
+	repositoryFetch *prometheus.HistogramVec
+	webhookCounter  *prometheus.CounterVec
 }
 
 func NewControllerMetrics() *ControllerMetrics {
@@ -61,6 +69,60 @@ func NewControllerMetrics() *ControllerMetrics {
 			Name:      "deploy_duration_seconds",
 			Buckets:   prometheus.ExponentialBuckets(1, 2, 8), // 1s ~ 128s
 		}, []string{}),
+		queueWait: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "build_queue_wait_seconds",
+			Help:      "Time from a build being queued to being started by a builder.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12), // 1s ~ 2048s
+		}, []string{"build_type"}),
+		buildDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "build_duration_seconds",
+			Help:      "Time from a build being started to being finished.",
+			Buckets:   prometheus.ExponentialBuckets(4, 2, 10), // 4s ~ 2048s
+		}, []string{"result", "build_type"}),
+		buildStep: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "build_step_duration_seconds",
+			Help:      "Duration of each build step reported by builders.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12), // 1s ~ 2048s
+		}, []string{"build_type", "step"}),
+		builders: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "builders",
+			Help:      "Number of builders connected to this controller, by state.",
+		}, []string{"state"}),
+		artifactSize: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "artifact_size_bytes",
+			Help:      "Size of static site artifacts saved by builders.",
+			Buckets:   prometheus.ExponentialBuckets(1<<20, 2, 12), // 1MiB ~ 2GiB
+		}, []string{}),
+		imageSize: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "runtime_image_size_bytes",
+			Help:      "Size of runtime images pushed by builders.",
+			Buckets:   prometheus.ExponentialBuckets(16<<20, 2, 10), // 16MiB ~ 8GiB
+		}, []string{}),
+		repositoryFetch: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "repository_fetch_duration_seconds",
+			Help:      "Latency of resolving refs of git repositories.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 10), // 0.1s ~ 51.2s
+		}, []string{"result"}),
+		webhookCounter: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "neoshowcase",
+			Subsystem: "controller",
+			Name:      "webhooks_total",
+			Help:      "Number of received webhooks.",
+		}, []string{"provider", "event"}),
 	}
 }
 
@@ -71,3 +133,41 @@ func (s *ControllerMetrics) IncrementBuild(status domain.BuildStatus, buildType
 func (s *ControllerMetrics) ObserveDeployDuration(d time.Duration) {
 	s.deployDuration.WithLabelValues().Observe(d.Seconds())
 }
+
+func (s *ControllerMetrics) ObserveQueueWait(buildType domain.BuildType, d time.Duration) {
+	s.queueWait.WithLabelValues(buildType.String()).Observe(d.Seconds())
+}
+
+func (s *ControllerMetrics) ObserveBuildDuration(status domain.BuildStatus, buildType domain.BuildType, d time.Duration) {
+	s.buildDuration.WithLabelValues(status.String(), buildType.String()).Observe(d.Seconds())
+}
+
+func (s *ControllerMetrics) ObserveBuildStep(buildType domain.BuildType, step string, d time.Duration) {
+	s.buildStep.WithLabelValues(buildType.String(), step).Observe(d.Seconds())
+}
+
+// SetBuilders sets the number of connected builders, and those of which are running a build.
+func (s *ControllerMetrics) SetBuilders(connected, busy int) {
+	s.builders.WithLabelValues("idle").Set(float64(connected - busy))
+	s.builders.WithLabelValues("busy").Set(float64(busy))
+}
+
+func (s *ControllerMetrics) ObserveArtifactSize(size int64) {
+	s.artifactSize.WithLabelValues().Observe(float64(size))
+}
+
+func (s *ControllerMetrics) ObserveRuntimeImageSize(size int64) {
+	s.imageSize.WithLabelValues().Observe(float64(size))
+}
+
+func (s *ControllerMetrics) ObserveRepositoryFetch(d time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	s.repositoryFetch.WithLabelValues(result).Observe(d.Seconds())
+}
+
+func (s *ControllerMetrics) IncrementWebhook(provider string, event string) {
+	s.webhookCounter.WithLabelValues(provider, event).Inc()
+}
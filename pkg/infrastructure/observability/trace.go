@@ -3,31 +3,91 @@ package observability
 import (
 	"context"
 
+	"github.com/samber/oops"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.28.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// InitTracerProvider initializes OpenTelemetry tracer provider for trace ID generation
-// This does NOT export traces, only generates trace IDs for logging purposes
-func InitTracerProvider(serviceName string) error {
+const instrumentationName = "github.com/traPtitech/neoshowcase"
+
+type TracingConfig struct {
+	// Exporter is the span exporter, one of "" (disabled), "otlp-grpc" or "otlp-http".
+	// Trace IDs are generated for logs even if disabled.
+	Exporter string `mapstructure:"exporter" yaml:"exporter"`
+	// Endpoint is the OTLP endpoint such as "tempo:4317".
+	// If empty, OTEL_EXPORTER_OTLP_ENDPOINT environment variable or the exporter's default is used.
+	Endpoint string `mapstructure:"endpoint" yaml:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	Insecure bool `mapstructure:"insecure" yaml:"insecure"`
+	// SampleRatio is the ratio of sampled traces started by this component, from 0 to 1.
+	// Traces started by other components follow the sampling decision of the parent.
+	SampleRatio float64 `mapstructure:"sampleRatio" yaml:"sampleRatio"`
+}
+
+func newExporter(ctx context.Context, c TracingConfig) (sdktrace.SpanExporter, error) {
+	switch c.Exporter {
+	case "otlp-grpc":
+		var opts []otlptracegrpc.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case "otlp-http":
+		var opts []otlptracehttp.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, oops.Errorf("invalid tracing exporter: %v (supported values: otlp-grpc, otlp-http)", c.Exporter)
+	}
+}
+
+// InitTracerProvider initializes OpenTelemetry tracer provider.
+// If no exporter is configured, spans are not exported, and only trace IDs are generated for logging purposes.
+//
+// The returned function flushes and stops exporting spans.
+func InitTracerProvider(serviceName string, c TracingConfig) (shutdown func(ctx context.Context) error, err error) {
+	ctx := context.Background()
 	res, err := resource.New(
-		context.Background(),
+		ctx,
 		resource.WithAttributes(
 			semconv.ServiceNameKey.String(serviceName),
 		),
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Create a tracer provider with a no-op exporter (just generates IDs)
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithResource(res),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
-	)
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	if c.Exporter == "" {
+		// No exporter, just generates IDs
+		opts = append(opts, sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	} else {
+		exporter, err := newExporter(ctx, c)
+		if err != nil {
+			return nil, oops.Wrapf(err, "creating span exporter")
+		}
+		opts = append(opts,
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))),
+		)
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
 
 	otel.SetTracerProvider(tp)
 
@@ -36,5 +96,34 @@ func InitTracerProvider(serviceName string) error {
 		propagation.Baggage{},
 	))
 
-	return nil
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the tracer to start spans of NeoShowcase components with.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Traced runs fn in a new span, and records the error returned by fn.
+func Traced(ctx context.Context, name string, fn func(ctx context.Context) error, attrs ...attribute.KeyValue) error {
+	ctx, span := Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+	defer span.End()
+	err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// InjectTraceContext returns the trace context of ctx, to be propagated through messages.
+func InjectTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
+
+// ExtractTraceContext returns ctx with the trace context propagated by InjectTraceContext.
+func ExtractTraceContext(ctx context.Context, traceContext map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(traceContext))
 }
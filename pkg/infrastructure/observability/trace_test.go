@@ -0,0 +1,41 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContext(t *testing.T) {
+	shutdown, err := InitTracerProvider("test", TracingConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = shutdown(context.Background()) })
+
+	ctx, span := Tracer().Start(context.Background(), "parent")
+	defer span.End()
+	parent := span.SpanContext()
+	require.True(t, parent.IsValid())
+
+	// e.g. propagated from the controller to the builder through StartBuildRequest
+	carrier := InjectTraceContext(ctx)
+	assert.NotEmpty(t, carrier)
+
+	extracted := ExtractTraceContext(context.Background(), carrier)
+	remote := trace.SpanContextFromContext(extracted)
+	assert.True(t, remote.IsRemote())
+	assert.Equal(t, parent.TraceID(), remote.TraceID())
+	assert.Equal(t, parent.SpanID(), remote.SpanID())
+	assert.Equal(t, parent.TraceFlags(), remote.TraceFlags())
+
+	_, child := Tracer().Start(extracted, "child")
+	defer child.End()
+	assert.Equal(t, parent.TraceID(), child.SpanContext().TraceID())
+	assert.NotEqual(t, parent.SpanID(), child.SpanContext().SpanID())
+
+	// Without trace context
+	empty := ExtractTraceContext(context.Background(), nil)
+	assert.False(t, trace.SpanContextFromContext(empty).IsValid())
+}
@@ -0,0 +1,83 @@
+package oidc
+
+import (
+	"slices"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+type Config struct {
+	Enabled      bool     `mapstructure:"enabled" yaml:"enabled"`
+	Issuer       string   `mapstructure:"issuer" yaml:"issuer"`
+	ClientID     string   `mapstructure:"clientID" yaml:"clientID"`
+	ClientSecret string   `mapstructure:"clientSecret" yaml:"clientSecret"`
+	RedirectURL  string   `mapstructure:"redirectURL" yaml:"redirectURL"`
+	Scopes       []string `mapstructure:"scopes" yaml:"scopes"`
+	// BasePath is the path the login, callback and logout endpoints are served under.
+	BasePath string `mapstructure:"basePath" yaml:"basePath"`
+	// PostLoginRedirect is the path to redirect to after login and logout.
+	PostLoginRedirect string `mapstructure:"postLoginRedirect" yaml:"postLoginRedirect"`
+
+	Claims struct {
+		// Username is the claim used as the NeoShowcase user name.
+		Username string `mapstructure:"username" yaml:"username"`
+		// Groups is the claim containing the groups of the user.
+		Groups string `mapstructure:"groups" yaml:"groups"`
+		// Admin is the boolean claim marking the user as an admin. Empty to disable.
+		Admin string `mapstructure:"admin" yaml:"admin"`
+	} `mapstructure:"claims" yaml:"claims"`
+	// AllowedGroups restricts login to users in any of the groups. Empty to allow all users.
+	AllowedGroups []string `mapstructure:"allowedGroups" yaml:"allowedGroups"`
+	// AdminGroups grants admin to users in any of the groups.
+	AdminGroups []string `mapstructure:"adminGroups" yaml:"adminGroups"`
+
+	Session struct {
+		CookieName string `mapstructure:"cookieName" yaml:"cookieName"`
+		// Secret is the key used to sign session cookies. Must be at least 32 bytes.
+		Secret string `mapstructure:"secret" yaml:"secret"`
+		MaxAge string `mapstructure:"maxAge" yaml:"maxAge"`
+		// Secure sets the Secure attribute of cookies; disable only for local development over plain HTTP.
+		Secure bool `mapstructure:"secure" yaml:"secure"`
+	} `mapstructure:"session" yaml:"session"`
+}
+
+func (c *Config) Validate() error {
+	if c.Issuer == "" {
+		return oops.New("issuer is required")
+	}
+	if c.ClientID == "" {
+		return oops.New("clientID is required")
+	}
+	if c.RedirectURL == "" {
+		return oops.New("redirectURL is required")
+	}
+	if c.Claims.Username == "" {
+		return oops.New("claims.username is required")
+	}
+	if len(c.Session.Secret) < 32 {
+		return oops.New("session.secret must be at least 32 bytes")
+	}
+	if _, err := c.sessionMaxAge(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Config) sessionMaxAge() (time.Duration, error) {
+	d, err := time.ParseDuration(c.Session.MaxAge)
+	if err != nil {
+		return 0, oops.Wrapf(err, "invalid session.maxAge")
+	}
+	if d <= 0 {
+		return 0, oops.New("session.maxAge must be positive")
+	}
+	return d, nil
+}
+
+func (c *Config) scopes() []string {
+	if slices.Contains(c.Scopes, "openid") {
+		return c.Scopes
+	}
+	return append([]string{"openid"}, c.Scopes...)
+}
@@ -0,0 +1,171 @@
+package oidc
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"path"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/traPtitech/neoshowcase/pkg/util/random"
+)
+
+const loginStateMaxAge = 10 * time.Minute
+
+func (p *Provider) loginStateCookieName() string {
+	return p.config.Session.CookieName + "_login"
+}
+
+// Handler serves the login, callback and logout endpoints under the configured base path.
+func (p *Provider) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+path.Join(p.config.BasePath, "login"), p.login)
+	mux.HandleFunc("GET "+path.Join(p.config.BasePath, "callback"), p.callback)
+	mux.HandleFunc("GET "+path.Join(p.config.BasePath, "logout"), p.logout)
+	mux.HandleFunc("POST "+path.Join(p.config.BasePath, "logout"), p.logout)
+	return mux
+}
+
+// Authenticate returns the session of the request, if the request has a valid session cookie.
+func (p *Provider) Authenticate(headers http.Header) (*Session, bool) {
+	cookie, err := (&http.Request{Header: headers}).Cookie(p.config.Session.CookieName)
+	if err != nil {
+		return nil, false
+	}
+	var s Session
+	if err = p.signer.decode(signPurposeSession, cookie.Value, &s); err != nil {
+		return nil, false
+	}
+	if s.Name == "" || time.Now().After(s.ExpiresAt) {
+		return nil, false
+	}
+	return &s, true
+}
+
+func (p *Provider) setCookie(w http.ResponseWriter, name, value, cookiePath string, maxAge time.Duration) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     cookiePath,
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   p.config.Session.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (p *Provider) deleteCookie(w http.ResponseWriter, name, cookiePath string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Path:     cookiePath,
+		MaxAge:   -1,
+		Secure:   p.config.Session.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (p *Provider) login(w http.ResponseWriter, r *http.Request) {
+	md, err := p.discover(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to discover oidc provider", "error", err)
+		http.Error(w, "identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+
+	state := loginState{
+		State:     random.SecureGeneratePassword(32),
+		Nonce:     random.SecureGeneratePassword(32),
+		Verifier:  oauth2.GenerateVerifier(),
+		ExpiresAt: time.Now().Add(loginStateMaxAge),
+	}
+	value, err := p.signer.encode(signPurposeLoginState, &state)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to encode login state", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	p.setCookie(w, p.loginStateCookieName(), value, p.config.BasePath, loginStateMaxAge)
+
+	authURL := p.oauth2Config(md).AuthCodeURL(
+		state.State,
+		oauth2.SetAuthURLParam("nonce", state.Nonce),
+		oauth2.S256ChallengeOption(state.Verifier),
+	)
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+func (p *Provider) callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cookie, err := r.Cookie(p.loginStateCookieName())
+	if err != nil {
+		http.Error(w, "login session not found, please try again", http.StatusBadRequest)
+		return
+	}
+	var state loginState
+	if err = p.signer.decode(signPurposeLoginState, cookie.Value, &state); err != nil || time.Now().After(state.ExpiresAt) {
+		http.Error(w, "login session expired, please try again", http.StatusBadRequest)
+		return
+	}
+	p.deleteCookie(w, p.loginStateCookieName(), p.config.BasePath)
+
+	query := r.URL.Query()
+	if errCode := query.Get("error"); errCode != "" {
+		slog.InfoContext(ctx, "oidc login failed", "error", errCode, "description", query.Get("error_description"))
+		http.Error(w, "login failed: "+errCode, http.StatusUnauthorized)
+		return
+	}
+	if query.Get("state") != state.State {
+		http.Error(w, "state mismatch", http.StatusBadRequest)
+		return
+	}
+
+	md, err := p.discover(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to discover oidc provider", "error", err)
+		http.Error(w, "identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	token, err := p.oauth2Config(md).Exchange(ctx, query.Get("code"), oauth2.VerifierOption(state.Verifier))
+	if err != nil {
+		slog.InfoContext(ctx, "failed to exchange oidc code", "error", err)
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		http.Error(w, "login failed: no id token", http.StatusUnauthorized)
+		return
+	}
+	claims, err := p.verifyIDToken(ctx, md, rawIDToken, state.Nonce)
+	if err != nil {
+		slog.InfoContext(ctx, "failed to verify id token", "error", err)
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+	session, err := p.sessionFromClaims(claims)
+	if err != nil {
+		slog.InfoContext(ctx, "oidc user not allowed", "error", err)
+		http.Error(w, "you are not allowed to log in", http.StatusForbidden)
+		return
+	}
+
+	value, err := p.signer.encode(signPurposeSession, session)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to encode session", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	p.setCookie(w, p.config.Session.CookieName, value, "/", p.maxAge)
+	http.Redirect(w, r, p.config.PostLoginRedirect, http.StatusFound)
+}
+
+// logout clears the session cookie. The session at the identity provider is left as is.
+func (p *Provider) logout(w http.ResponseWriter, r *http.Request) {
+	p.deleteCookie(w, p.config.Session.CookieName, "/")
+	http.Redirect(w, r, p.config.PostLoginRedirect, http.StatusFound)
+}
@@ -0,0 +1,249 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider is a minimal OpenID provider issuing ID tokens for a single authorization code.
+type mockProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]any
+	nonce  string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	m := &mockProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.URL,
+			"authorization_endpoint": m.URL + "/authorize",
+			"token_endpoint":         m.URL + "/token",
+			"jwks_uri":               m.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &m.key.PublicKey, KeyID: "test-key", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "test-code" || r.FormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     m.idToken(t),
+		})
+	})
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockProvider) idToken(t *testing.T) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: m.key, KeyID: "test-key"}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+	now := time.Now()
+	claims := map[string]any{"nonce": m.nonce}
+	for k, v := range m.claims {
+		claims[k] = v
+	}
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer:   m.URL,
+		Subject:  "subject",
+		Audience: jwt.Audience{"neoshowcase"},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func testConfig(issuer string) Config {
+	var c Config
+	c.Enabled = true
+	c.Issuer = issuer
+	c.ClientID = "neoshowcase"
+	c.ClientSecret = "secret"
+	c.RedirectURL = "http://ns.example.com/auth/callback"
+	c.BasePath = "/auth"
+	c.PostLoginRedirect = "/"
+	c.Claims.Username = "preferred_username"
+	c.Claims.Groups = "groups"
+	c.Claims.Admin = "ns_admin"
+	c.AllowedGroups = []string{"members"}
+	c.AdminGroups = []string{"admins"}
+	c.Session.CookieName = "ns_session"
+	c.Session.Secret = "0123456789abcdef0123456789abcdef"
+	c.Session.MaxAge = "1h"
+	return c
+}
+
+// login runs the authorization code flow and returns the callback response.
+func login(t *testing.T, p *Provider, m *mockProvider, tamperNonce bool) *http.Response {
+	h := p.Handler()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/login", nil))
+	res := rec.Result()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	authURL, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, m.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	assert.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+	m.nonce = authURL.Query().Get("nonce")
+	if tamperNonce {
+		m.nonce = "other-nonce"
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/callback?"+url.Values{
+		"code":  {"test-code"},
+		"state": {authURL.Query().Get("state")},
+	}.Encode(), nil)
+	for _, c := range res.Cookies() {
+		req.AddCookie(c)
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func sessionHeader(res *http.Response, name string) http.Header {
+	headers := make(http.Header)
+	for _, c := range res.Cookies() {
+		if c.Name == name {
+			headers.Add("Cookie", c.String())
+		}
+	}
+	return headers
+}
+
+func TestProvider_Login(t *testing.T) {
+	m := newMockProvider(t)
+	p, err := NewProvider(testConfig(m.URL))
+	require.NoError(t, err)
+
+	t.Run("member", func(t *testing.T) {
+		m.claims = map[string]any{"preferred_username": "alice", "groups": []string{"members"}}
+		res := login(t, p, m, false)
+		require.Equal(t, http.StatusFound, res.StatusCode)
+		assert.Equal(t, "/", res.Header.Get("Location"))
+
+		session, ok := p.Authenticate(sessionHeader(res, "ns_session"))
+		require.True(t, ok)
+		assert.Equal(t, "alice", session.Name)
+		assert.False(t, session.Admin)
+	})
+	t.Run("admin by group", func(t *testing.T) {
+		m.claims = map[string]any{"preferred_username": "bob", "groups": []string{"members", "admins"}}
+		res := login(t, p, m, false)
+		session, ok := p.Authenticate(sessionHeader(res, "ns_session"))
+		require.True(t, ok)
+		assert.True(t, session.Admin)
+	})
+	t.Run("admin by claim", func(t *testing.T) {
+		m.claims = map[string]any{"preferred_username": "carol", "groups": []string{"members"}, "ns_admin": true}
+		res := login(t, p, m, false)
+		session, ok := p.Authenticate(sessionHeader(res, "ns_session"))
+		require.True(t, ok)
+		assert.True(t, session.Admin)
+	})
+	t.Run("not in allowed groups", func(t *testing.T) {
+		m.claims = map[string]any{"preferred_username": "mallory", "groups": []string{"guests"}}
+		res := login(t, p, m, false)
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+	t.Run("nonce mismatch", func(t *testing.T) {
+		m.claims = map[string]any{"preferred_username": "alice", "groups": []string{"members"}}
+		res := login(t, p, m, true)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
+
+func TestProvider_Authenticate(t *testing.T) {
+	p, err := NewProvider(testConfig("http://issuer.example.com"))
+	require.NoError(t, err)
+
+	cookie := func(s *Session) http.Header {
+		value, err := p.signer.encode(signPurposeSession, s)
+		require.NoError(t, err)
+		headers := make(http.Header)
+		headers.Add("Cookie", (&http.Cookie{Name: "ns_session", Value: value}).String())
+		return headers
+	}
+
+	_, ok := p.Authenticate(cookie(&Session{Name: "alice", ExpiresAt: time.Now().Add(time.Hour)}))
+	assert.True(t, ok)
+	_, ok = p.Authenticate(cookie(&Session{Name: "alice", ExpiresAt: time.Now().Add(-time.Hour)}))
+	assert.False(t, ok, "expired session")
+	_, ok = p.Authenticate(cookie(&Session{ExpiresAt: time.Now().Add(time.Hour)}))
+	assert.False(t, ok, "empty name")
+
+	other := &signer{key: []byte("another-secret-another-secret-00")}
+	value, err := other.encode(signPurposeSession, &Session{Name: "alice", Admin: true, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	headers := make(http.Header)
+	headers.Add("Cookie", (&http.Cookie{Name: "ns_session", Value: value}).String())
+	_, ok = p.Authenticate(headers)
+	assert.False(t, ok, "forged session")
+
+	_, ok = p.Authenticate(make(http.Header))
+	assert.False(t, ok, "no session")
+}
+
+func TestProvider_Replay(t *testing.T) {
+	m := newMockProvider(t)
+	p, err := NewProvider(testConfig(m.URL))
+	require.NoError(t, err)
+
+	t.Run("login state as session", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		p.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/login", nil))
+		loginCookies := rec.Result().Cookies()
+		require.NotEmpty(t, loginCookies)
+
+		headers := make(http.Header)
+		headers.Add("Cookie", (&http.Cookie{Name: "ns_session", Value: loginCookies[0].Value}).String())
+		_, ok := p.Authenticate(headers)
+		assert.False(t, ok)
+	})
+	t.Run("session as login state", func(t *testing.T) {
+		m.claims = map[string]any{"preferred_username": "alice", "groups": []string{"members"}}
+		res := login(t, p, m, false)
+		require.Equal(t, http.StatusFound, res.StatusCode)
+		sessionCookies := lo.Filter(res.Cookies(), func(c *http.Cookie, _ int) bool { return c.Name == "ns_session" })
+		require.Len(t, sessionCookies, 1)
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/callback?code=test-code&state=", nil)
+		req.AddCookie(&http.Cookie{Name: "ns_session_login", Value: sessionCookies[0].Value})
+		rec := httptest.NewRecorder()
+		p.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Result().StatusCode)
+	})
+}
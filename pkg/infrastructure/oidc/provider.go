@@ -0,0 +1,226 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"golang.org/x/oauth2"
+)
+
+const jwksRefreshInterval = 1 * time.Minute
+
+var supportedAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.EdDSA,
+}
+
+// providerMetadata is the subset of the OpenID Provider Metadata used for login.
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	EndSessionEndpoint    string `json:"end_session_endpoint"`
+}
+
+// Provider performs the OpenID Connect authorization code flow against the configured issuer,
+// and issues signed session cookies for the logged-in users.
+type Provider struct {
+	config Config
+	maxAge time.Duration
+	signer *signer
+	client *http.Client
+
+	mu            sync.Mutex
+	metadata      *providerMetadata
+	jwks          *jose.JSONWebKeySet
+	jwksFetchedAt time.Time
+}
+
+func NewProvider(c Config) (*Provider, error) {
+	if err := c.Validate(); err != nil {
+		return nil, oops.Wrapf(err, "invalid oidc config")
+	}
+	maxAge, _ := c.sessionMaxAge()
+	return &Provider{
+		config: c,
+		maxAge: maxAge,
+		signer: &signer{key: []byte(c.Session.Secret)},
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// discover fetches the provider metadata, caching it after the first success
+// so that the gateway can start while the issuer is unavailable.
+func (p *Provider) discover(ctx context.Context) (*providerMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	var md providerMetadata
+	err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+"/.well-known/openid-configuration", &md)
+	if err != nil {
+		return nil, oops.Wrapf(err, "fetching provider metadata")
+	}
+	if md.Issuer != p.config.Issuer {
+		return nil, oops.Errorf("issuer mismatch: expected %v, got %v", p.config.Issuer, md.Issuer)
+	}
+	p.metadata = &md
+	return p.metadata, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return oops.Wrapf(err, "creating request")
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return oops.Wrapf(err, "sending request")
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return oops.Errorf("unexpected status code: %v", res.StatusCode)
+	}
+	if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+		return oops.Wrapf(err, "decoding response")
+	}
+	return nil
+}
+
+// keys returns the signing keys with the given key ID, refreshing the key set if not found (key rotation).
+func (p *Provider) keys(ctx context.Context, md *providerMetadata, kid string) ([]jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.jwks != nil {
+		if keys := p.jwks.Key(kid); len(keys) > 0 {
+			return keys, nil
+		}
+		if time.Since(p.jwksFetchedAt) < jwksRefreshInterval {
+			return nil, oops.Errorf("unknown key id: %v", kid)
+		}
+	}
+
+	var jwks jose.JSONWebKeySet
+	if err := p.getJSON(ctx, md.JWKSURI, &jwks); err != nil {
+		return nil, oops.Wrapf(err, "fetching jwks")
+	}
+	p.jwks = &jwks
+	p.jwksFetchedAt = time.Now()
+	keys := jwks.Key(kid)
+	if len(keys) == 0 {
+		return nil, oops.Errorf("unknown key id: %v", kid)
+	}
+	return keys, nil
+}
+
+func (p *Provider) oauth2Config(md *providerMetadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  md.AuthorizationEndpoint,
+			TokenURL: md.TokenEndpoint,
+		},
+		RedirectURL: p.config.RedirectURL,
+		Scopes:      p.config.scopes(),
+	}
+}
+
+// verifyIDToken verifies the signature and the standard claims of the ID token, and returns its claims.
+func (p *Provider) verifyIDToken(ctx context.Context, md *providerMetadata, rawIDToken string, nonce string) (map[string]any, error) {
+	token, err := jwt.ParseSigned(rawIDToken, supportedAlgorithms)
+	if err != nil {
+		return nil, oops.Wrapf(err, "parsing id token")
+	}
+	if len(token.Headers) != 1 {
+		return nil, oops.New("id token must have exactly one signature")
+	}
+	keys, err := p.keys(ctx, md, token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var standard jwt.Claims
+	var claims map[string]any
+	var verifyErr error
+	for _, key := range keys {
+		verifyErr = token.Claims(key.Key, &standard, &claims)
+		if verifyErr == nil {
+			break
+		}
+	}
+	if verifyErr != nil {
+		return nil, oops.Wrapf(verifyErr, "verifying id token signature")
+	}
+
+	err = standard.ValidateWithLeeway(jwt.Expected{
+		Issuer:      md.Issuer,
+		AnyAudience: jwt.Audience{p.config.ClientID},
+		Time:        time.Now(),
+	}, 30*time.Second)
+	if err != nil {
+		return nil, oops.Wrapf(err, "validating id token claims")
+	}
+	if standard.Expiry == nil {
+		return nil, oops.New("id token has no expiry")
+	}
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, oops.New("id token nonce mismatch")
+	}
+	return claims, nil
+}
+
+// sessionFromClaims maps the ID token claims to the session of a NeoShowcase user.
+func (p *Provider) sessionFromClaims(claims map[string]any) (*Session, error) {
+	name, _ := claims[p.config.Claims.Username].(string)
+	if name == "" {
+		return nil, oops.Errorf("claim %v is missing", p.config.Claims.Username)
+	}
+
+	var groups []string
+	switch v := claims[p.config.Claims.Groups].(type) {
+	case []any:
+		groups = lo.FilterMap(v, func(g any, _ int) (string, bool) {
+			s, ok := g.(string)
+			return s, ok
+		})
+	case string:
+		groups = []string{v}
+	}
+	inAnyGroup := func(targets []string) bool {
+		return lo.ContainsBy(groups, func(g string) bool { return slices.Contains(targets, g) })
+	}
+	if len(p.config.AllowedGroups) > 0 && !inAnyGroup(p.config.AllowedGroups) {
+		return nil, oops.Errorf("user %v is not in the allowed groups", name)
+	}
+
+	admin := inAnyGroup(p.config.AdminGroups)
+	if p.config.Claims.Admin != "" {
+		switch v := claims[p.config.Claims.Admin].(type) {
+		case bool:
+			admin = admin || v
+		case string:
+			admin = admin || v == "true"
+		}
+	}
+
+	return &Session{
+		Name:      name,
+		Admin:     admin,
+		ExpiresAt: time.Now().Add(p.maxAge),
+	}, nil
+}
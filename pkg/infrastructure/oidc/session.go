@@ -0,0 +1,76 @@
+package oidc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// Session is the logged-in user, stored in a signed cookie.
+type Session struct {
+	Name      string    `json:"name"`
+	Admin     bool      `json:"admin"`
+	ExpiresAt time.Time `json:"exp"`
+}
+
+// loginState is the state of an ongoing login, stored in a signed cookie until the callback.
+type loginState struct {
+	State     string    `json:"state"`
+	Nonce     string    `json:"nonce"`
+	Verifier  string    `json:"verifier"`
+	ExpiresAt time.Time `json:"exp"`
+}
+
+// signPurpose distinguishes the kinds of signed values, so that a value signed for one purpose
+// cannot be replayed as another, e.g. a login state cookie as a session cookie.
+type signPurpose string
+
+const (
+	signPurposeSession    signPurpose = "session"
+	signPurposeLoginState signPurpose = "login_state"
+)
+
+// signer encodes values into tamper-proof strings with HMAC-SHA256.
+type signer struct {
+	key []byte
+}
+
+func (s *signer) sign(purpose signPurpose, payload string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *signer) encode(purpose signPurpose, v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", oops.Wrapf(err, "marshaling cookie value")
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + s.sign(purpose, payload), nil
+}
+
+func (s *signer) decode(purpose signPurpose, value string, v any) error {
+	payload, sig, ok := strings.Cut(value, ".")
+	if !ok {
+		return oops.New("malformed cookie value")
+	}
+	if !hmac.Equal([]byte(sig), []byte(s.sign(purpose, payload))) {
+		return oops.New("invalid cookie signature")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return oops.Wrapf(err, "decoding cookie value")
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		return oops.Wrapf(err, "unmarshaling cookie value")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/repoconvert"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+type alertRepository struct {
+	db *sql.DB
+}
+
+func NewAlertRepository(db *sql.DB) domain.AlertRepository {
+	return &alertRepository{db: db}
+}
+
+func (r *alertRepository) GetAlertRules(ctx context.Context, cond domain.GetAlertRuleCondition) ([]*domain.AlertRule, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.AlertRuleColumns.CreatedAt),
+	}
+	if cond.ID.Valid {
+		mods = append(mods, models.AlertRuleWhere.ID.EQ(cond.ID.V))
+	}
+	if cond.ApplicationID.Valid {
+		mods = append(mods, models.AlertRuleWhere.ApplicationID.EQ(cond.ApplicationID.V))
+	}
+
+	rules, err := models.AlertRules(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting alert rules")
+	}
+	return ds.Map(rules, repoconvert.ToDomainAlertRule), nil
+}
+
+func (r *alertRepository) CreateAlertRule(ctx context.Context, rule *domain.AlertRule) error {
+	mr := repoconvert.FromDomainAlertRule(rule)
+	err := mr.Insert(ctx, r.db, boil.Blacklist())
+	if err != nil {
+		return oops.With("app_id", rule.ApplicationID).Wrapf(err, "inserting alert rule")
+	}
+	return nil
+}
+
+func (r *alertRepository) DeleteAlertRule(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return oops.Wrapf(err, "starting transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = models.Alerts(models.AlertWhere.RuleID.EQ(id)).DeleteAll(ctx, tx)
+	if err != nil {
+		return oops.Wrapf(err, "deleting alerts")
+	}
+	_, err = models.AlertRules(models.AlertRuleWhere.ID.EQ(id)).DeleteAll(ctx, tx)
+	if err != nil {
+		return oops.Wrapf(err, "deleting alert rule")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return oops.Wrapf(err, "committing")
+	}
+	return nil
+}
+
+func (r *alertRepository) GetAlerts(ctx context.Context, cond domain.GetAlertCondition) ([]*domain.Alert, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.AlertColumns.FiredAt + " DESC, " + models.AlertColumns.ID + " DESC"),
+	}
+	if cond.ApplicationID.Valid {
+		mods = append(mods, models.AlertWhere.ApplicationID.EQ(cond.ApplicationID.V))
+	}
+	if cond.RuleID.Valid {
+		mods = append(mods, models.AlertWhere.RuleID.EQ(cond.RuleID.V))
+	}
+	if cond.Firing.Valid {
+		if cond.Firing.V {
+			mods = append(mods, models.AlertWhere.ResolvedAt.IsNull())
+		} else {
+			mods = append(mods, models.AlertWhere.ResolvedAt.IsNotNull())
+		}
+	}
+	if cond.Limit.Valid {
+		mods = append(mods, qm.Limit(cond.Limit.V))
+	}
+
+	alerts, err := models.Alerts(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting alerts")
+	}
+	return ds.Map(alerts, repoconvert.ToDomainAlert), nil
+}
+
+func (r *alertRepository) CreateAlert(ctx context.Context, alert *domain.Alert) error {
+	ma := repoconvert.FromDomainAlert(alert)
+	err := ma.Insert(ctx, r.db, boil.Blacklist())
+	if err != nil {
+		return oops.With("app_id", alert.ApplicationID).Wrapf(err, "inserting alert")
+	}
+	return nil
+}
+
+func (r *alertRepository) ResolveAlert(ctx context.Context, id string, resolvedAt time.Time) error {
+	_, err := models.Alerts(models.AlertWhere.ID.EQ(id)).UpdateAll(ctx, r.db, models.M{
+		models.AlertColumns.ResolvedAt: null.TimeFrom(resolvedAt),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "resolving alert")
+	}
+	return nil
+}
+
+func (r *alertRepository) DeleteApplicationAlerts(ctx context.Context, applicationID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return oops.Wrapf(err, "starting transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = models.Alerts(models.AlertWhere.ApplicationID.EQ(applicationID)).DeleteAll(ctx, tx)
+	if err != nil {
+		return oops.Wrapf(err, "deleting alerts")
+	}
+	_, err = models.AlertRules(models.AlertRuleWhere.ApplicationID.EQ(applicationID)).DeleteAll(ctx, tx)
+	if err != nil {
+		return oops.Wrapf(err, "deleting alert rules")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return oops.Wrapf(err, "committing")
+	}
+	return nil
+}
@@ -30,8 +30,16 @@ func (r *applicationRepository) GetApplications(ctx context.Context, cond domain
 	mods := []qm.QueryMod{
 		qm.Load(models.ApplicationRels.ApplicationConfig),
 		qm.Load(models.ApplicationRels.Repository),
-		qm.Load(models.ApplicationRels.Websites),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteRules)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteHeaders)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteCorsPolicy)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteIPAllowlistEntries)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteBasicAuthUsers)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteRateLimit)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteBackends)),
 		qm.Load(models.ApplicationRels.PortPublications),
+		qm.Load(models.ApplicationRels.ApplicationInternalService),
+		qm.Load(models.ApplicationRels.ApplicationInternalServiceAllowedApps),
 		qm.Load(models.ApplicationRels.Users),
 	}
 
@@ -71,8 +79,16 @@ func (r *applicationRepository) getApplication(ctx context.Context, id string, f
 		models.ApplicationWhere.ID.EQ(id),
 		qm.Load(models.ApplicationRels.ApplicationConfig),
 		qm.Load(models.ApplicationRels.Repository),
-		qm.Load(models.ApplicationRels.Websites),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteRules)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteHeaders)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteCorsPolicy)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteIPAllowlistEntries)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteBasicAuthUsers)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteRateLimit)),
+		qm.Load(qm.Rels(models.ApplicationRels.Websites, models.WebsiteRels.WebsiteBackends)),
 		qm.Load(models.ApplicationRels.PortPublications),
+		qm.Load(models.ApplicationRels.ApplicationInternalService),
+		qm.Load(models.ApplicationRels.ApplicationInternalServiceAllowedApps),
 		qm.Load(models.ApplicationRels.Users),
 	}
 	if forUpdate {
@@ -103,13 +119,25 @@ func (r *applicationRepository) CreateApplication(ctx context.Context, app *doma
 	}
 	defer tx.Rollback()
 
+	if err = r.createApplication(ctx, tx, app); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return oops.Wrapf(err, "committing transaction")
+	}
+
+	return nil
+}
+
+func (r *applicationRepository) createApplication(ctx context.Context, tx boil.ContextExecutor, app *domain.Application) error {
 	ma := repoconvert.FromDomainApplication(app)
-	if err = ma.Insert(ctx, tx, boil.Blacklist()); err != nil {
+	if err := ma.Insert(ctx, tx, boil.Blacklist()); err != nil {
 		return oops.Wrapf(err, "creating application")
 	}
 
 	mc := repoconvert.FromDomainApplicationConfig(app.ID, &app.Config)
-	err = mc.Insert(ctx, tx, boil.Blacklist())
+	err := mc.Insert(ctx, tx, boil.Blacklist())
 	if err != nil {
 		return oops.Wrapf(err, "creating application config")
 	}
@@ -124,16 +152,12 @@ func (r *applicationRepository) CreateApplication(ctx context.Context, app *doma
 		return err
 	}
 
-	err = r.setOwners(ctx, tx, ma, app.OwnerIDs)
+	err = r.setInternalService(ctx, tx, ma, &app.InternalService)
 	if err != nil {
 		return err
 	}
 
-	if err = tx.Commit(); err != nil {
-		return oops.Wrapf(err, "committing transaction")
-	}
-
-	return nil
+	return r.setOwners(ctx, tx, ma, app.OwnerIDs)
 }
 
 func (r *applicationRepository) UpdateApplication(ctx context.Context, id string, args *domain.UpdateApplicationArgs) error {
@@ -143,6 +167,19 @@ func (r *applicationRepository) UpdateApplication(ctx context.Context, id string
 	}
 	defer tx.Rollback()
 
+	if err = r.updateApplication(ctx, tx, id, args); err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return oops.Wrapf(err, "committing transaction")
+	}
+
+	return err
+}
+
+func (r *applicationRepository) updateApplication(ctx context.Context, tx boil.ContextExecutor, id string, args *domain.UpdateApplicationArgs) error {
 	app, err := r.getApplication(ctx, id, true, tx)
 	if err != nil {
 		return err
@@ -187,6 +224,14 @@ func (r *applicationRepository) UpdateApplication(ctx context.Context, id string
 		app.UpdatedAt = args.UpdatedAt.V
 		cols = append(cols, models.ApplicationColumns.UpdatedAt)
 	}
+	if args.ConfigFilePath.Valid {
+		app.ConfigFilePath = args.ConfigFilePath.V
+		cols = append(cols, models.ApplicationColumns.ConfigFilePath)
+	}
+	if args.ConfigFileError.Valid {
+		app.ConfigFileError = args.ConfigFileError.V
+		cols = append(cols, models.ApplicationColumns.ConfigFileError)
+	}
 
 	if len(cols) > 0 {
 		_, err = app.Update(ctx, tx, boil.Whitelist(cols...))
@@ -214,6 +259,12 @@ func (r *applicationRepository) UpdateApplication(ctx context.Context, id string
 			return err
 		}
 	}
+	if args.InternalService.Valid {
+		err = r.setInternalService(ctx, tx, app, &args.InternalService.V)
+		if err != nil {
+			return err
+		}
+	}
 	if args.OwnerIDs.Valid {
 		err = r.setOwners(ctx, tx, app, args.OwnerIDs.V)
 		if err != nil {
@@ -221,12 +272,37 @@ func (r *applicationRepository) UpdateApplication(ctx context.Context, id string
 		}
 	}
 
-	err = tx.Commit()
+	return nil
+}
+
+func (r *applicationRepository) ApplyChanges(ctx context.Context, changes []*domain.ApplicationChange) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
+		return oops.Wrapf(err, "starting transaction")
+	}
+	defer tx.Rollback()
+
+	for _, c := range changes {
+		if c.Create != nil {
+			err = r.createApplication(ctx, tx, c.Create)
+		} else {
+			err = r.updateApplication(ctx, tx, c.ID, c.Args)
+		}
+		if err != nil {
+			return err
+		}
+		for _, env := range c.Envs {
+			if err = setEnv(ctx, tx, env); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
 		return oops.Wrapf(err, "committing transaction")
 	}
 
-	return err
+	return nil
 }
 
 func (r *applicationRepository) BulkUpdateState(ctx context.Context, states []*domain.Container) error {
@@ -261,6 +337,22 @@ func (r *applicationRepository) DeleteApplication(ctx context.Context, id string
 	if err != nil {
 		return oops.Wrapf(err, "deleting port publications")
 	}
+	err = r.deleteInternalService(ctx, r.db, app)
+	if err != nil {
+		return err
+	}
+	_, err = models.ApplicationInternalServiceAllowedApps(models.ApplicationInternalServiceAllowedAppWhere.AllowedApplicationID.EQ(app.ID)).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting internal service allowances to the application")
+	}
+	_, err = models.WebsiteBackends(models.WebsiteBackendWhere.ApplicationID.EQ(app.ID)).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website backends to the application")
+	}
+	err = r.deleteWebsiteSettings(ctx, r.db, app.R.Websites)
+	if err != nil {
+		return err
+	}
 	_, err = app.R.Websites.DeleteAll(ctx, r.db)
 	if err != nil {
 		return oops.Wrapf(err, "deleting websites")
@@ -278,7 +370,11 @@ func (r *applicationRepository) DeleteApplication(ctx context.Context, id string
 
 func (r *applicationRepository) setWebsites(ctx context.Context, ex boil.ContextExecutor, app *models.Application, websites []*domain.Website) error {
 	if app.R != nil && app.R.Websites != nil {
-		_, err := app.R.Websites.DeleteAll(ctx, ex)
+		err := r.deleteWebsiteSettings(ctx, ex, app.R.Websites)
+		if err != nil {
+			return err
+		}
+		_, err = app.R.Websites.DeleteAll(ctx, ex)
 		if err != nil {
 			return oops.Wrapf(err, "deleting existing app websites")
 		}
@@ -289,6 +385,92 @@ func (r *applicationRepository) setWebsites(ctx context.Context, ex boil.Context
 		if err != nil {
 			return oops.Wrapf(err, "inserting website")
 		}
+		for i, rule := range w.Rules {
+			mr := repoconvert.FromDomainWebsiteRule(w.ID, i, rule)
+			err = mr.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website rule")
+			}
+		}
+		for _, header := range w.HeaderPolicy.ResponseHeaders {
+			mh := repoconvert.FromDomainWebsiteHeader(w.ID, header)
+			err = mh.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website header")
+			}
+		}
+		if w.HeaderPolicy.CORS.Enabled() {
+			mc := repoconvert.FromDomainWebsiteCORSPolicy(w.ID, &w.HeaderPolicy.CORS)
+			err = mc.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website cors policy")
+			}
+		}
+		for _, cidr := range w.AccessControl.IPAllowList {
+			me := &models.WebsiteIPAllowlistEntry{WebsiteID: w.ID, Cidr: cidr}
+			err = me.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website ip allowlist entry")
+			}
+		}
+		for _, user := range w.AccessControl.BasicAuthUsers {
+			mu := repoconvert.FromDomainWebsiteBasicAuthUser(w.ID, user)
+			err = mu.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website basic auth user")
+			}
+		}
+		if w.RateLimit.Enabled {
+			mr := repoconvert.FromDomainWebsiteRateLimit(w.ID, &w.RateLimit)
+			err = mr.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website rate limit")
+			}
+		}
+		for _, b := range w.Backends {
+			mb := repoconvert.FromDomainWebsiteBackend(w.ID, b)
+			err = mb.Insert(ctx, ex, boil.Blacklist())
+			if err != nil {
+				return oops.Wrapf(err, "inserting website backend")
+			}
+		}
+	}
+	return nil
+}
+
+// deleteWebsiteSettings deletes the rows referencing the websites, such as rules and headers.
+func (r *applicationRepository) deleteWebsiteSettings(ctx context.Context, ex boil.ContextExecutor, websites models.WebsiteSlice) error {
+	if len(websites) == 0 {
+		return nil
+	}
+	websiteIDs := ds.Map(websites, func(w *models.Website) string { return w.ID })
+	_, err := models.WebsiteRules(models.WebsiteRuleWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website rules")
+	}
+	_, err = models.WebsiteHeaders(models.WebsiteHeaderWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website headers")
+	}
+	_, err = models.WebsiteCorsPolicies(models.WebsiteCorsPolicyWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website cors policies")
+	}
+	_, err = models.WebsiteIPAllowlistEntries(models.WebsiteIPAllowlistEntryWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website ip allowlist entries")
+	}
+	_, err = models.WebsiteBasicAuthUsers(models.WebsiteBasicAuthUserWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website basic auth users")
+	}
+	_, err = models.WebsiteRateLimits(models.WebsiteRateLimitWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website rate limits")
+	}
+	_, err = models.WebsiteBackends(models.WebsiteBackendWhere.WebsiteID.IN(websiteIDs)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website backends")
 	}
 	return nil
 }
@@ -310,6 +492,39 @@ func (r *applicationRepository) setPortPublications(ctx context.Context, ex boil
 	return nil
 }
 
+func (r *applicationRepository) deleteInternalService(ctx context.Context, ex boil.ContextExecutor, app *models.Application) error {
+	_, err := models.ApplicationInternalServiceAllowedApps(models.ApplicationInternalServiceAllowedAppWhere.ApplicationID.EQ(app.ID)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting internal service allowed apps")
+	}
+	_, err = models.ApplicationInternalServices(models.ApplicationInternalServiceWhere.ApplicationID.EQ(app.ID)).DeleteAll(ctx, ex)
+	if err != nil {
+		return oops.Wrapf(err, "deleting internal service")
+	}
+	return nil
+}
+
+func (r *applicationRepository) setInternalService(ctx context.Context, ex boil.ContextExecutor, app *models.Application, s *domain.InternalService) error {
+	err := r.deleteInternalService(ctx, ex, app)
+	if err != nil {
+		return err
+	}
+	if !s.Enabled() {
+		return nil
+	}
+	err = repoconvert.FromDomainInternalService(app.ID, s).Insert(ctx, ex, boil.Blacklist())
+	if err != nil {
+		return oops.Wrapf(err, "inserting internal service")
+	}
+	for _, allowedAppID := range lo.Uniq(s.AllowedAppIDs) {
+		err = repoconvert.FromDomainInternalServiceAllowedApp(app.ID, allowedAppID).Insert(ctx, ex, boil.Blacklist())
+		if err != nil {
+			return oops.Wrapf(err, "inserting internal service allowed app")
+		}
+	}
+	return nil
+}
+
 func (r *applicationRepository) setOwners(ctx context.Context, ex boil.ContextExecutor, app *models.Application, ownerIDs []string) error {
 	ownerIDs = lo.Uniq(ownerIDs)
 	users, err := models.Users(models.UserWhere.ID.IN(ownerIDs)).All(ctx, ex)
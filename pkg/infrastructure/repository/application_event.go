@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/repoconvert"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+type applicationEventRepository struct {
+	db *sql.DB
+}
+
+func NewApplicationEventRepository(db *sql.DB) domain.ApplicationEventRepository {
+	return &applicationEventRepository{db: db}
+}
+
+func (r *applicationEventRepository) GetEvents(ctx context.Context, cond domain.GetApplicationEventCondition) ([]*domain.ApplicationEvent, error) {
+	mods := []qm.QueryMod{
+		models.ApplicationEventWhere.ApplicationID.EQ(cond.ApplicationID),
+	}
+	if cond.Before.Valid {
+		mods = append(mods, models.ApplicationEventWhere.CreatedAt.LT(cond.Before.V))
+	}
+	if cond.After.Valid {
+		mods = append(mods,
+			models.ApplicationEventWhere.CreatedAt.GT(cond.After.V),
+			qm.OrderBy(models.ApplicationEventColumns.CreatedAt+" ASC, "+models.ApplicationEventColumns.ID+" ASC"),
+		)
+	} else {
+		mods = append(mods, qm.OrderBy(models.ApplicationEventColumns.CreatedAt+" DESC, "+models.ApplicationEventColumns.ID+" DESC"))
+	}
+	if cond.Limit.Valid {
+		mods = append(mods, qm.Limit(cond.Limit.V))
+	}
+
+	events, err := models.ApplicationEvents(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting application events")
+	}
+	return ds.Map(events, repoconvert.ToDomainApplicationEvent), nil
+}
+
+func (r *applicationEventRepository) CreateEvents(ctx context.Context, events []*domain.ApplicationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return oops.Wrapf(err, "starting transaction")
+	}
+	defer tx.Rollback()
+
+	for _, e := range events {
+		me := repoconvert.FromDomainApplicationEvent(e)
+		err = me.Insert(ctx, tx, boil.Blacklist())
+		if err != nil {
+			return oops.With("app_id", e.ApplicationID).Wrapf(err, "inserting application event")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return oops.Wrapf(err, "committing")
+	}
+	return nil
+}
+
+func (r *applicationEventRepository) DeleteEvents(ctx context.Context, applicationID string) error {
+	_, err := models.ApplicationEvents(
+		models.ApplicationEventWhere.ApplicationID.EQ(applicationID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting application events")
+	}
+	return nil
+}
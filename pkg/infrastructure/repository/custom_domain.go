@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/repoconvert"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+type customDomainRepository struct {
+	db *sql.DB
+}
+
+func NewCustomDomainRepository(db *sql.DB) domain.CustomDomainRepository {
+	return &customDomainRepository{db: db}
+}
+
+func (r *customDomainRepository) GetCustomDomains(ctx context.Context, cond domain.GetCustomDomainCondition) ([]*domain.CustomDomain, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.CustomDomainColumns.Domain),
+	}
+	if cond.ID.Valid {
+		mods = append(mods, models.CustomDomainWhere.ID.EQ(cond.ID.V))
+	}
+	if cond.UserID.Valid {
+		mods = append(mods, models.CustomDomainWhere.UserID.EQ(cond.UserID.V))
+	}
+	if cond.UserIDIn.Valid {
+		mods = append(mods, models.CustomDomainWhere.UserID.IN(cond.UserIDIn.V))
+	}
+	if cond.Verified.Valid {
+		mods = append(mods, models.CustomDomainWhere.Verified.EQ(cond.Verified.V))
+	}
+
+	domains, err := models.CustomDomains(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting custom domains")
+	}
+	return ds.Map(domains, repoconvert.ToDomainCustomDomain), nil
+}
+
+func (r *customDomainRepository) CreateCustomDomain(ctx context.Context, d *domain.CustomDomain) error {
+	md := repoconvert.FromDomainCustomDomain(d)
+	err := md.Insert(ctx, r.db, boil.Blacklist())
+	if err != nil {
+		return oops.With("user_id", d.UserID).Wrapf(err, "inserting custom domain")
+	}
+	return nil
+}
+
+func (r *customDomainRepository) UpdateCustomDomain(ctx context.Context, id string, args *domain.UpdateCustomDomainArgs) error {
+	cols := make(models.M)
+	if args.Verified.Valid {
+		cols[models.CustomDomainColumns.Verified] = args.Verified.V
+	}
+	if args.VerifiedAt.Valid {
+		cols[models.CustomDomainColumns.VerifiedAt] = optional.IntoTime(args.VerifiedAt)
+	}
+	if args.CheckedAt.Valid {
+		cols[models.CustomDomainColumns.CheckedAt] = optional.IntoTime(args.CheckedAt)
+	}
+	if args.Error.Valid {
+		cols[models.CustomDomainColumns.Error] = args.Error.V
+	}
+	if len(cols) == 0 {
+		return nil
+	}
+
+	_, err := models.CustomDomains(models.CustomDomainWhere.ID.EQ(id)).UpdateAll(ctx, r.db, cols)
+	if err != nil {
+		return oops.With("id", id).Wrapf(err, "updating custom domain")
+	}
+	return nil
+}
+
+func (r *customDomainRepository) DeleteCustomDomain(ctx context.Context, id string) error {
+	_, err := models.CustomDomains(models.CustomDomainWhere.ID.EQ(id)).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.With("id", id).Wrapf(err, "deleting custom domain")
+	}
+	return nil
+}
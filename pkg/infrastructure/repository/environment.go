@@ -52,7 +52,20 @@ func (r *environmentRepository) SetEnv(ctx context.Context, env *domain.Environm
 	}
 	defer tx.Rollback()
 
-	_, err = models.Applications(
+	if err = setEnv(ctx, tx, env); err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return oops.Wrapf(err, "committing")
+	}
+
+	return nil
+}
+
+func setEnv(ctx context.Context, tx boil.ContextExecutor, env *domain.Environment) error {
+	_, err := models.Applications(
 		qm.Select(models.ApplicationColumns.ID),
 		models.ApplicationWhere.ID.EQ(env.ApplicationID),
 		qm.For("UPDATE"),
@@ -79,12 +92,6 @@ func (r *environmentRepository) SetEnv(ctx context.Context, env *domain.Environm
 	if err != nil {
 		return oops.Wrapf(err, "upserting environment")
 	}
-
-	err = tx.Commit()
-	if err != nil {
-		return oops.Wrapf(err, "committing")
-	}
-
 	return nil
 }
 
@@ -0,0 +1,1536 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AlertRule is an object representing the database table.
+type AlertRule struct {
+	// アラートルールID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// ルール名
+	Name string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	// ルールの種類
+	Kind string `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	// メトリクス名 (kindがmetricの場合)
+	Metric string `boil:"metric" json:"metric" toml:"metric" yaml:"metric"`
+	// 閾値との比較方法
+	Comparison string `boil:"comparison" json:"comparison" toml:"comparison" yaml:"comparison"`
+	// 閾値
+	Threshold float64 `boil:"threshold" json:"threshold" toml:"threshold" yaml:"threshold"`
+	// 閾値の単位
+	ThresholdUnit string `boil:"threshold_unit" json:"threshold_unit" toml:"threshold_unit" yaml:"threshold_unit"`
+	// 条件が継続する時間 (秒)
+	DurationSeconds int `boil:"duration_seconds" json:"duration_seconds" toml:"duration_seconds" yaml:"duration_seconds"`
+	// 作成日時
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *alertRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L alertRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AlertRuleColumns = struct {
+	ID              string
+	ApplicationID   string
+	Name            string
+	Kind            string
+	Metric          string
+	Comparison      string
+	Threshold       string
+	ThresholdUnit   string
+	DurationSeconds string
+	CreatedAt       string
+}{
+	ID:              "id",
+	ApplicationID:   "application_id",
+	Name:            "name",
+	Kind:            "kind",
+	Metric:          "metric",
+	Comparison:      "comparison",
+	Threshold:       "threshold",
+	ThresholdUnit:   "threshold_unit",
+	DurationSeconds: "duration_seconds",
+	CreatedAt:       "created_at",
+}
+
+var AlertRuleTableColumns = struct {
+	ID              string
+	ApplicationID   string
+	Name            string
+	Kind            string
+	Metric          string
+	Comparison      string
+	Threshold       string
+	ThresholdUnit   string
+	DurationSeconds string
+	CreatedAt       string
+}{
+	ID:              "alert_rules.id",
+	ApplicationID:   "alert_rules.application_id",
+	Name:            "alert_rules.name",
+	Kind:            "alert_rules.kind",
+	Metric:          "alert_rules.metric",
+	Comparison:      "alert_rules.comparison",
+	Threshold:       "alert_rules.threshold",
+	ThresholdUnit:   "alert_rules.threshold_unit",
+	DurationSeconds: "alert_rules.duration_seconds",
+	CreatedAt:       "alert_rules.created_at",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AlertRuleWhere = struct {
+	ID              whereHelperstring
+	ApplicationID   whereHelperstring
+	Name            whereHelperstring
+	Kind            whereHelperstring
+	Metric          whereHelperstring
+	Comparison      whereHelperstring
+	Threshold       whereHelperfloat64
+	ThresholdUnit   whereHelperstring
+	DurationSeconds whereHelperint
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "`alert_rules`.`id`"},
+	ApplicationID:   whereHelperstring{field: "`alert_rules`.`application_id`"},
+	Name:            whereHelperstring{field: "`alert_rules`.`name`"},
+	Kind:            whereHelperstring{field: "`alert_rules`.`kind`"},
+	Metric:          whereHelperstring{field: "`alert_rules`.`metric`"},
+	Comparison:      whereHelperstring{field: "`alert_rules`.`comparison`"},
+	Threshold:       whereHelperfloat64{field: "`alert_rules`.`threshold`"},
+	ThresholdUnit:   whereHelperstring{field: "`alert_rules`.`threshold_unit`"},
+	DurationSeconds: whereHelperint{field: "`alert_rules`.`duration_seconds`"},
+	CreatedAt:       whereHelpertime_Time{field: "`alert_rules`.`created_at`"},
+}
+
+// AlertRuleRels is where relationship names are stored.
+var AlertRuleRels = struct {
+	Application string
+	RuleAlerts  string
+}{
+	Application: "Application",
+	RuleAlerts:  "RuleAlerts",
+}
+
+// alertRuleR is where relationships are stored.
+type alertRuleR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	RuleAlerts  AlertSlice   `boil:"RuleAlerts" json:"RuleAlerts" toml:"RuleAlerts" yaml:"RuleAlerts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*alertRuleR) NewStruct() *alertRuleR {
+	return &alertRuleR{}
+}
+
+func (o *AlertRule) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *alertRuleR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+func (o *AlertRule) GetRuleAlerts() AlertSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRuleAlerts()
+}
+
+func (r *alertRuleR) GetRuleAlerts() AlertSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RuleAlerts
+}
+
+// alertRuleL is where Load methods for each relationship are stored.
+type alertRuleL struct{}
+
+var (
+	alertRuleAllColumns            = []string{"id", "application_id", "name", "kind", "metric", "comparison", "threshold", "threshold_unit", "duration_seconds", "created_at"}
+	alertRuleColumnsWithoutDefault = []string{"id", "application_id", "name", "kind", "metric", "comparison", "threshold", "duration_seconds", "created_at"}
+	alertRuleColumnsWithDefault    = []string{"threshold_unit"}
+	alertRulePrimaryKeyColumns     = []string{"id"}
+	alertRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// AlertRuleSlice is an alias for a slice of pointers to AlertRule.
+	// This should almost always be used instead of []AlertRule.
+	AlertRuleSlice []*AlertRule
+	// AlertRuleHook is the signature for custom AlertRule hook methods
+	AlertRuleHook func(context.Context, boil.ContextExecutor, *AlertRule) error
+
+	alertRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	alertRuleType                 = reflect.TypeOf(&AlertRule{})
+	alertRuleMapping              = queries.MakeStructMapping(alertRuleType)
+	alertRulePrimaryKeyMapping, _ = queries.BindMapping(alertRuleType, alertRuleMapping, alertRulePrimaryKeyColumns)
+	alertRuleInsertCacheMut       sync.RWMutex
+	alertRuleInsertCache          = make(map[string]insertCache)
+	alertRuleUpdateCacheMut       sync.RWMutex
+	alertRuleUpdateCache          = make(map[string]updateCache)
+	alertRuleUpsertCacheMut       sync.RWMutex
+	alertRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var alertRuleAfterSelectMu sync.Mutex
+var alertRuleAfterSelectHooks []AlertRuleHook
+
+var alertRuleBeforeInsertMu sync.Mutex
+var alertRuleBeforeInsertHooks []AlertRuleHook
+var alertRuleAfterInsertMu sync.Mutex
+var alertRuleAfterInsertHooks []AlertRuleHook
+
+var alertRuleBeforeUpdateMu sync.Mutex
+var alertRuleBeforeUpdateHooks []AlertRuleHook
+var alertRuleAfterUpdateMu sync.Mutex
+var alertRuleAfterUpdateHooks []AlertRuleHook
+
+var alertRuleBeforeDeleteMu sync.Mutex
+var alertRuleBeforeDeleteHooks []AlertRuleHook
+var alertRuleAfterDeleteMu sync.Mutex
+var alertRuleAfterDeleteHooks []AlertRuleHook
+
+var alertRuleBeforeUpsertMu sync.Mutex
+var alertRuleBeforeUpsertHooks []AlertRuleHook
+var alertRuleAfterUpsertMu sync.Mutex
+var alertRuleAfterUpsertHooks []AlertRuleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AlertRule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AlertRule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AlertRule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AlertRule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AlertRule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AlertRule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AlertRule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AlertRule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AlertRule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAlertRuleHook registers your hook function for all future operations.
+func AddAlertRuleHook(hookPoint boil.HookPoint, alertRuleHook AlertRuleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		alertRuleAfterSelectMu.Lock()
+		alertRuleAfterSelectHooks = append(alertRuleAfterSelectHooks, alertRuleHook)
+		alertRuleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		alertRuleBeforeInsertMu.Lock()
+		alertRuleBeforeInsertHooks = append(alertRuleBeforeInsertHooks, alertRuleHook)
+		alertRuleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		alertRuleAfterInsertMu.Lock()
+		alertRuleAfterInsertHooks = append(alertRuleAfterInsertHooks, alertRuleHook)
+		alertRuleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		alertRuleBeforeUpdateMu.Lock()
+		alertRuleBeforeUpdateHooks = append(alertRuleBeforeUpdateHooks, alertRuleHook)
+		alertRuleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		alertRuleAfterUpdateMu.Lock()
+		alertRuleAfterUpdateHooks = append(alertRuleAfterUpdateHooks, alertRuleHook)
+		alertRuleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		alertRuleBeforeDeleteMu.Lock()
+		alertRuleBeforeDeleteHooks = append(alertRuleBeforeDeleteHooks, alertRuleHook)
+		alertRuleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		alertRuleAfterDeleteMu.Lock()
+		alertRuleAfterDeleteHooks = append(alertRuleAfterDeleteHooks, alertRuleHook)
+		alertRuleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		alertRuleBeforeUpsertMu.Lock()
+		alertRuleBeforeUpsertHooks = append(alertRuleBeforeUpsertHooks, alertRuleHook)
+		alertRuleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		alertRuleAfterUpsertMu.Lock()
+		alertRuleAfterUpsertHooks = append(alertRuleAfterUpsertHooks, alertRuleHook)
+		alertRuleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single alertRule record from the query.
+func (q alertRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AlertRule, error) {
+	o := &AlertRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for alert_rules")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AlertRule records from the query.
+func (q alertRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (AlertRuleSlice, error) {
+	var o []*AlertRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AlertRule slice")
+	}
+
+	if len(alertRuleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AlertRule records in the query.
+func (q alertRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count alert_rules rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q alertRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if alert_rules exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *AlertRule) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// RuleAlerts retrieves all the alert's Alerts with an executor via rule_id column.
+func (o *AlertRule) RuleAlerts(mods ...qm.QueryMod) alertQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`alerts`.`rule_id`=?", o.ID),
+	)
+
+	return Alerts(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (alertRuleL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAlertRule any, mods queries.Applicator) error {
+	var slice []*AlertRule
+	var object *AlertRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeAlertRule.(*AlertRule)
+		if !ok {
+			object = new(AlertRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAlertRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAlertRule))
+			}
+		}
+	} else {
+		s, ok := maybeAlertRule.(*[]*AlertRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAlertRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAlertRule))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &alertRuleR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &alertRuleR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.AlertRules = append(foreign.R.AlertRules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.AlertRules = append(foreign.R.AlertRules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRuleAlerts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (alertRuleL) LoadRuleAlerts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAlertRule any, mods queries.Applicator) error {
+	var slice []*AlertRule
+	var object *AlertRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeAlertRule.(*AlertRule)
+		if !ok {
+			object = new(AlertRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAlertRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAlertRule))
+			}
+		}
+	} else {
+		s, ok := maybeAlertRule.(*[]*AlertRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAlertRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAlertRule))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &alertRuleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &alertRuleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`alerts`),
+		qm.WhereIn(`alerts.rule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load alerts")
+	}
+
+	var resultSlice []*Alert
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice alerts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on alerts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for alerts")
+	}
+
+	if len(alertAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RuleAlerts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &alertR{}
+			}
+			foreign.R.Rule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RuleID {
+				local.R.RuleAlerts = append(local.R.RuleAlerts, foreign)
+				if foreign.R == nil {
+					foreign.R = &alertR{}
+				}
+				foreign.R.Rule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the alertRule to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.AlertRules.
+func (o *AlertRule) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `alert_rules` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, alertRulePrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &alertRuleR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			AlertRules: AlertRuleSlice{o},
+		}
+	} else {
+		related.R.AlertRules = append(related.R.AlertRules, o)
+	}
+
+	return nil
+}
+
+// AddRuleAlerts adds the given related objects to the existing relationships
+// of the alert_rule, optionally inserting them as new records.
+// Appends related to o.R.RuleAlerts.
+// Sets related.R.Rule appropriately.
+func (o *AlertRule) AddRuleAlerts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Alert) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RuleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `alerts` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"rule_id"}),
+				strmangle.WhereClause("`", "`", 0, alertPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RuleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &alertRuleR{
+			RuleAlerts: related,
+		}
+	} else {
+		o.R.RuleAlerts = append(o.R.RuleAlerts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &alertR{
+				Rule: o,
+			}
+		} else {
+			rel.R.Rule = o
+		}
+	}
+	return nil
+}
+
+// AlertRules retrieves all the records using an executor.
+func AlertRules(mods ...qm.QueryMod) alertRuleQuery {
+	mods = append(mods, qm.From("`alert_rules`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`alert_rules`.*"})
+	}
+
+	return alertRuleQuery{q}
+}
+
+// FindAlertRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAlertRule(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AlertRule, error) {
+	alertRuleObj := &AlertRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `alert_rules` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, alertRuleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from alert_rules")
+	}
+
+	if err = alertRuleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return alertRuleObj, err
+	}
+
+	return alertRuleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AlertRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no alert_rules provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	alertRuleInsertCacheMut.RLock()
+	cache, cached := alertRuleInsertCache[key]
+	alertRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			alertRuleAllColumns,
+			alertRuleColumnsWithDefault,
+			alertRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `alert_rules` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `alert_rules` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `alert_rules` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, alertRulePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into alert_rules")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for alert_rules")
+	}
+
+CacheNoHooks:
+	if !cached {
+		alertRuleInsertCacheMut.Lock()
+		alertRuleInsertCache[key] = cache
+		alertRuleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AlertRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AlertRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	alertRuleUpdateCacheMut.RLock()
+	cache, cached := alertRuleUpdateCache[key]
+	alertRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			alertRuleAllColumns,
+			alertRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update alert_rules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `alert_rules` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, alertRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, append(wl, alertRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update alert_rules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for alert_rules")
+	}
+
+	if !cached {
+		alertRuleUpdateCacheMut.Lock()
+		alertRuleUpdateCache[key] = cache
+		alertRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q alertRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for alert_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for alert_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AlertRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `alert_rules` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, alertRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in alertRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all alertRule")
+	}
+	return rowsAff, nil
+}
+
+var mySQLAlertRuleUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AlertRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no alert_rules provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertRuleColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAlertRuleUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	alertRuleUpsertCacheMut.RLock()
+	cache, cached := alertRuleUpsertCache[key]
+	alertRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			alertRuleAllColumns,
+			alertRuleColumnsWithDefault,
+			alertRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			alertRuleAllColumns,
+			alertRulePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert alert_rules, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(alertRuleAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`alert_rules`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `alert_rules` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for alert_rules")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(alertRuleType, alertRuleMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for alert_rules")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for alert_rules")
+	}
+
+CacheNoHooks:
+	if !cached {
+		alertRuleUpsertCacheMut.Lock()
+		alertRuleUpsertCache[key] = cache
+		alertRuleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AlertRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AlertRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AlertRule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), alertRulePrimaryKeyMapping)
+	sql := "DELETE FROM `alert_rules` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from alert_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for alert_rules")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q alertRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no alertRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alert_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alert_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AlertRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(alertRuleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `alert_rules` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, alertRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alertRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alert_rules")
+	}
+
+	if len(alertRuleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AlertRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAlertRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AlertRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AlertRuleSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `alert_rules`.* FROM `alert_rules` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, alertRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AlertRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AlertRuleExists checks if the AlertRule row exists.
+func AlertRuleExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `alert_rules` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if alert_rules exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AlertRule row exists.
+func (o *AlertRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AlertRuleExists(ctx, exec, o.ID)
+}
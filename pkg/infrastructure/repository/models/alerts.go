@@ -0,0 +1,1415 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Alert is an object representing the database table.
+type Alert struct {
+	// アラートID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// アラートルールID
+	RuleID string `boil:"rule_id" json:"rule_id" toml:"rule_id" yaml:"rule_id"`
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// アラートの詳細
+	Message string `boil:"message" json:"message" toml:"message" yaml:"message"`
+	// 発火日時
+	FiredAt time.Time `boil:"fired_at" json:"fired_at" toml:"fired_at" yaml:"fired_at"`
+	// 解決日時 (発火中の場合はNULL)
+	ResolvedAt null.Time `boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+
+	R *alertR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L alertL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AlertColumns = struct {
+	ID            string
+	RuleID        string
+	ApplicationID string
+	Message       string
+	FiredAt       string
+	ResolvedAt    string
+}{
+	ID:            "id",
+	RuleID:        "rule_id",
+	ApplicationID: "application_id",
+	Message:       "message",
+	FiredAt:       "fired_at",
+	ResolvedAt:    "resolved_at",
+}
+
+var AlertTableColumns = struct {
+	ID            string
+	RuleID        string
+	ApplicationID string
+	Message       string
+	FiredAt       string
+	ResolvedAt    string
+}{
+	ID:            "alerts.id",
+	RuleID:        "alerts.rule_id",
+	ApplicationID: "alerts.application_id",
+	Message:       "alerts.message",
+	FiredAt:       "alerts.fired_at",
+	ResolvedAt:    "alerts.resolved_at",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var AlertWhere = struct {
+	ID            whereHelperstring
+	RuleID        whereHelperstring
+	ApplicationID whereHelperstring
+	Message       whereHelperstring
+	FiredAt       whereHelpertime_Time
+	ResolvedAt    whereHelpernull_Time
+}{
+	ID:            whereHelperstring{field: "`alerts`.`id`"},
+	RuleID:        whereHelperstring{field: "`alerts`.`rule_id`"},
+	ApplicationID: whereHelperstring{field: "`alerts`.`application_id`"},
+	Message:       whereHelperstring{field: "`alerts`.`message`"},
+	FiredAt:       whereHelpertime_Time{field: "`alerts`.`fired_at`"},
+	ResolvedAt:    whereHelpernull_Time{field: "`alerts`.`resolved_at`"},
+}
+
+// AlertRels is where relationship names are stored.
+var AlertRels = struct {
+	Application string
+	Rule        string
+}{
+	Application: "Application",
+	Rule:        "Rule",
+}
+
+// alertR is where relationships are stored.
+type alertR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	Rule        *AlertRule   `boil:"Rule" json:"Rule" toml:"Rule" yaml:"Rule"`
+}
+
+// NewStruct creates a new relationship struct
+func (*alertR) NewStruct() *alertR {
+	return &alertR{}
+}
+
+func (o *Alert) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *alertR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+func (o *Alert) GetRule() *AlertRule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRule()
+}
+
+func (r *alertR) GetRule() *AlertRule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Rule
+}
+
+// alertL is where Load methods for each relationship are stored.
+type alertL struct{}
+
+var (
+	alertAllColumns            = []string{"id", "rule_id", "application_id", "message", "fired_at", "resolved_at"}
+	alertColumnsWithoutDefault = []string{"id", "rule_id", "application_id", "message", "fired_at", "resolved_at"}
+	alertColumnsWithDefault    = []string{}
+	alertPrimaryKeyColumns     = []string{"id"}
+	alertGeneratedColumns      = []string{}
+)
+
+type (
+	// AlertSlice is an alias for a slice of pointers to Alert.
+	// This should almost always be used instead of []Alert.
+	AlertSlice []*Alert
+	// AlertHook is the signature for custom Alert hook methods
+	AlertHook func(context.Context, boil.ContextExecutor, *Alert) error
+
+	alertQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	alertType                 = reflect.TypeOf(&Alert{})
+	alertMapping              = queries.MakeStructMapping(alertType)
+	alertPrimaryKeyMapping, _ = queries.BindMapping(alertType, alertMapping, alertPrimaryKeyColumns)
+	alertInsertCacheMut       sync.RWMutex
+	alertInsertCache          = make(map[string]insertCache)
+	alertUpdateCacheMut       sync.RWMutex
+	alertUpdateCache          = make(map[string]updateCache)
+	alertUpsertCacheMut       sync.RWMutex
+	alertUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var alertAfterSelectMu sync.Mutex
+var alertAfterSelectHooks []AlertHook
+
+var alertBeforeInsertMu sync.Mutex
+var alertBeforeInsertHooks []AlertHook
+var alertAfterInsertMu sync.Mutex
+var alertAfterInsertHooks []AlertHook
+
+var alertBeforeUpdateMu sync.Mutex
+var alertBeforeUpdateHooks []AlertHook
+var alertAfterUpdateMu sync.Mutex
+var alertAfterUpdateHooks []AlertHook
+
+var alertBeforeDeleteMu sync.Mutex
+var alertBeforeDeleteHooks []AlertHook
+var alertAfterDeleteMu sync.Mutex
+var alertAfterDeleteHooks []AlertHook
+
+var alertBeforeUpsertMu sync.Mutex
+var alertBeforeUpsertHooks []AlertHook
+var alertAfterUpsertMu sync.Mutex
+var alertAfterUpsertHooks []AlertHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Alert) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Alert) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Alert) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Alert) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Alert) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Alert) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Alert) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Alert) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Alert) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAlertHook registers your hook function for all future operations.
+func AddAlertHook(hookPoint boil.HookPoint, alertHook AlertHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		alertAfterSelectMu.Lock()
+		alertAfterSelectHooks = append(alertAfterSelectHooks, alertHook)
+		alertAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		alertBeforeInsertMu.Lock()
+		alertBeforeInsertHooks = append(alertBeforeInsertHooks, alertHook)
+		alertBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		alertAfterInsertMu.Lock()
+		alertAfterInsertHooks = append(alertAfterInsertHooks, alertHook)
+		alertAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		alertBeforeUpdateMu.Lock()
+		alertBeforeUpdateHooks = append(alertBeforeUpdateHooks, alertHook)
+		alertBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		alertAfterUpdateMu.Lock()
+		alertAfterUpdateHooks = append(alertAfterUpdateHooks, alertHook)
+		alertAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		alertBeforeDeleteMu.Lock()
+		alertBeforeDeleteHooks = append(alertBeforeDeleteHooks, alertHook)
+		alertBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		alertAfterDeleteMu.Lock()
+		alertAfterDeleteHooks = append(alertAfterDeleteHooks, alertHook)
+		alertAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		alertBeforeUpsertMu.Lock()
+		alertBeforeUpsertHooks = append(alertBeforeUpsertHooks, alertHook)
+		alertBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		alertAfterUpsertMu.Lock()
+		alertAfterUpsertHooks = append(alertAfterUpsertHooks, alertHook)
+		alertAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single alert record from the query.
+func (q alertQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Alert, error) {
+	o := &Alert{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for alerts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Alert records from the query.
+func (q alertQuery) All(ctx context.Context, exec boil.ContextExecutor) (AlertSlice, error) {
+	var o []*Alert
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Alert slice")
+	}
+
+	if len(alertAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Alert records in the query.
+func (q alertQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count alerts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q alertQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if alerts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *Alert) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// Rule pointed to by the foreign key.
+func (o *Alert) Rule(mods ...qm.QueryMod) alertRuleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.RuleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AlertRules(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (alertL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAlert any, mods queries.Applicator) error {
+	var slice []*Alert
+	var object *Alert
+
+	if singular {
+		var ok bool
+		object, ok = maybeAlert.(*Alert)
+		if !ok {
+			object = new(Alert)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAlert)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAlert))
+			}
+		}
+	} else {
+		s, ok := maybeAlert.(*[]*Alert)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAlert)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAlert))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &alertR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &alertR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.Alerts = append(foreign.R.Alerts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.Alerts = append(foreign.R.Alerts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (alertL) LoadRule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAlert any, mods queries.Applicator) error {
+	var slice []*Alert
+	var object *Alert
+
+	if singular {
+		var ok bool
+		object, ok = maybeAlert.(*Alert)
+		if !ok {
+			object = new(Alert)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAlert)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAlert))
+			}
+		}
+	} else {
+		s, ok := maybeAlert.(*[]*Alert)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAlert)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAlert))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &alertR{}
+		}
+		args[object.RuleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &alertR{}
+			}
+
+			args[obj.RuleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`alert_rules`),
+		qm.WhereIn(`alert_rules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AlertRule")
+	}
+
+	var resultSlice []*AlertRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AlertRule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for alert_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for alert_rules")
+	}
+
+	if len(alertRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Rule = foreign
+		if foreign.R == nil {
+			foreign.R = &alertRuleR{}
+		}
+		foreign.R.RuleAlerts = append(foreign.R.RuleAlerts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RuleID == foreign.ID {
+				local.R.Rule = foreign
+				if foreign.R == nil {
+					foreign.R = &alertRuleR{}
+				}
+				foreign.R.RuleAlerts = append(foreign.R.RuleAlerts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the alert to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.Alerts.
+func (o *Alert) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `alerts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, alertPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &alertR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			Alerts: AlertSlice{o},
+		}
+	} else {
+		related.R.Alerts = append(related.R.Alerts, o)
+	}
+
+	return nil
+}
+
+// SetRule of the alert to the related item.
+// Sets o.R.Rule to related.
+// Adds o to related.R.RuleAlerts.
+func (o *Alert) SetRule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AlertRule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `alerts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"rule_id"}),
+		strmangle.WhereClause("`", "`", 0, alertPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RuleID = related.ID
+	if o.R == nil {
+		o.R = &alertR{
+			Rule: related,
+		}
+	} else {
+		o.R.Rule = related
+	}
+
+	if related.R == nil {
+		related.R = &alertRuleR{
+			RuleAlerts: AlertSlice{o},
+		}
+	} else {
+		related.R.RuleAlerts = append(related.R.RuleAlerts, o)
+	}
+
+	return nil
+}
+
+// Alerts retrieves all the records using an executor.
+func Alerts(mods ...qm.QueryMod) alertQuery {
+	mods = append(mods, qm.From("`alerts`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`alerts`.*"})
+	}
+
+	return alertQuery{q}
+}
+
+// FindAlert retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAlert(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Alert, error) {
+	alertObj := &Alert{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `alerts` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, alertObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from alerts")
+	}
+
+	if err = alertObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return alertObj, err
+	}
+
+	return alertObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Alert) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no alerts provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	alertInsertCacheMut.RLock()
+	cache, cached := alertInsertCache[key]
+	alertInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			alertAllColumns,
+			alertColumnsWithDefault,
+			alertColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(alertType, alertMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(alertType, alertMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `alerts` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `alerts` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `alerts` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, alertPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into alerts")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for alerts")
+	}
+
+CacheNoHooks:
+	if !cached {
+		alertInsertCacheMut.Lock()
+		alertInsertCache[key] = cache
+		alertInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Alert.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Alert) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	alertUpdateCacheMut.RLock()
+	cache, cached := alertUpdateCache[key]
+	alertUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			alertAllColumns,
+			alertPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update alerts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `alerts` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, alertPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(alertType, alertMapping, append(wl, alertPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update alerts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for alerts")
+	}
+
+	if !cached {
+		alertUpdateCacheMut.Lock()
+		alertUpdateCache[key] = cache
+		alertUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q alertQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for alerts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for alerts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AlertSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `alerts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, alertPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in alert slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all alert")
+	}
+	return rowsAff, nil
+}
+
+var mySQLAlertUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Alert) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no alerts provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAlertUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	alertUpsertCacheMut.RLock()
+	cache, cached := alertUpsertCache[key]
+	alertUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			alertAllColumns,
+			alertColumnsWithDefault,
+			alertColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			alertAllColumns,
+			alertPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert alerts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(alertAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`alerts`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `alerts` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(alertType, alertMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(alertType, alertMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for alerts")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(alertType, alertMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for alerts")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for alerts")
+	}
+
+CacheNoHooks:
+	if !cached {
+		alertUpsertCacheMut.Lock()
+		alertUpsertCache[key] = cache
+		alertUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Alert record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Alert) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Alert provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), alertPrimaryKeyMapping)
+	sql := "DELETE FROM `alerts` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from alerts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for alerts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q alertQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no alertQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alerts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alerts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AlertSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(alertBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `alerts` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, alertPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alert slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alerts")
+	}
+
+	if len(alertAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Alert) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAlert(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AlertSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AlertSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `alerts`.* FROM `alerts` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, alertPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AlertSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AlertExists checks if the Alert row exists.
+func AlertExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `alerts` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if alerts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Alert row exists.
+func (o *Alert) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AlertExists(ctx, exec, o.ID)
+}
@@ -43,6 +43,14 @@ type ApplicationConfig struct {
 	ArtifactPath string `boil:"artifact_path" json:"artifact_path" toml:"artifact_path" yaml:"artifact_path"`
 	// 静的成果物をSPAとして配信するか
 	Spa bool `boil:"spa" json:"spa" toml:"spa" yaml:"spa"`
+	// (static only)404ページとして配信する静的成果物内のパス
+	NotFoundPath string `boil:"not_found_path" json:"not_found_path" toml:"not_found_path" yaml:"not_found_path"`
+	// (static only)ハッシュ付きファイル名のアセットのCache-Control
+	AssetCacheControl string `boil:"asset_cache_control" json:"asset_cache_control" toml:"asset_cache_control" yaml:"asset_cache_control"`
+	// (static only)HTMLのCache-Control
+	HTMLCacheControl string `boil:"html_cache_control" json:"html_cache_control" toml:"html_cache_control" yaml:"html_cache_control"`
+	// (static only)圧縮済みファイル(.br, .gz)があれば配信するか
+	Precompressed bool `boil:"precompressed" json:"precompressed" toml:"precompressed" yaml:"precompressed"`
 	// Dockerfile名
 	DockerfileName string `boil:"dockerfile_name" json:"dockerfile_name" toml:"dockerfile_name" yaml:"dockerfile_name"`
 	// ビルド時のcontext
@@ -57,96 +65,87 @@ type ApplicationConfig struct {
 }
 
 var ApplicationConfigColumns = struct {
-	ApplicationID   string
-	UseMariadb      string
-	UseMongodb      string
-	AutoShutdown    string
-	StartupBehavior string
-	BuildType       string
-	BaseImage       string
-	BuildCMD        string
-	ArtifactPath    string
-	Spa             string
-	DockerfileName  string
-	Context         string
-	Entrypoint      string
-	Command         string
+	ApplicationID     string
+	UseMariadb        string
+	UseMongodb        string
+	AutoShutdown      string
+	StartupBehavior   string
+	BuildType         string
+	BaseImage         string
+	BuildCMD          string
+	ArtifactPath      string
+	Spa               string
+	NotFoundPath      string
+	AssetCacheControl string
+	HTMLCacheControl  string
+	Precompressed     string
+	DockerfileName    string
+	Context           string
+	Entrypoint        string
+	Command           string
 }{
-	ApplicationID:   "application_id",
-	UseMariadb:      "use_mariadb",
-	UseMongodb:      "use_mongodb",
-	AutoShutdown:    "auto_shutdown",
-	StartupBehavior: "startup_behavior",
-	BuildType:       "build_type",
-	BaseImage:       "base_image",
-	BuildCMD:        "build_cmd",
-	ArtifactPath:    "artifact_path",
-	Spa:             "spa",
-	DockerfileName:  "dockerfile_name",
-	Context:         "context",
-	Entrypoint:      "entrypoint",
-	Command:         "command",
+	ApplicationID:     "application_id",
+	UseMariadb:        "use_mariadb",
+	UseMongodb:        "use_mongodb",
+	AutoShutdown:      "auto_shutdown",
+	StartupBehavior:   "startup_behavior",
+	BuildType:         "build_type",
+	BaseImage:         "base_image",
+	BuildCMD:          "build_cmd",
+	ArtifactPath:      "artifact_path",
+	Spa:               "spa",
+	NotFoundPath:      "not_found_path",
+	AssetCacheControl: "asset_cache_control",
+	HTMLCacheControl:  "html_cache_control",
+	Precompressed:     "precompressed",
+	DockerfileName:    "dockerfile_name",
+	Context:           "context",
+	Entrypoint:        "entrypoint",
+	Command:           "command",
 }
 
 var ApplicationConfigTableColumns = struct {
-	ApplicationID   string
-	UseMariadb      string
-	UseMongodb      string
-	AutoShutdown    string
-	StartupBehavior string
-	BuildType       string
-	BaseImage       string
-	BuildCMD        string
-	ArtifactPath    string
-	Spa             string
-	DockerfileName  string
-	Context         string
-	Entrypoint      string
-	Command         string
+	ApplicationID     string
+	UseMariadb        string
+	UseMongodb        string
+	AutoShutdown      string
+	StartupBehavior   string
+	BuildType         string
+	BaseImage         string
+	BuildCMD          string
+	ArtifactPath      string
+	Spa               string
+	NotFoundPath      string
+	AssetCacheControl string
+	HTMLCacheControl  string
+	Precompressed     string
+	DockerfileName    string
+	Context           string
+	Entrypoint        string
+	Command           string
 }{
-	ApplicationID:   "application_config.application_id",
-	UseMariadb:      "application_config.use_mariadb",
-	UseMongodb:      "application_config.use_mongodb",
-	AutoShutdown:    "application_config.auto_shutdown",
-	StartupBehavior: "application_config.startup_behavior",
-	BuildType:       "application_config.build_type",
-	BaseImage:       "application_config.base_image",
-	BuildCMD:        "application_config.build_cmd",
-	ArtifactPath:    "application_config.artifact_path",
-	Spa:             "application_config.spa",
-	DockerfileName:  "application_config.dockerfile_name",
-	Context:         "application_config.context",
-	Entrypoint:      "application_config.entrypoint",
-	Command:         "application_config.command",
+	ApplicationID:     "application_config.application_id",
+	UseMariadb:        "application_config.use_mariadb",
+	UseMongodb:        "application_config.use_mongodb",
+	AutoShutdown:      "application_config.auto_shutdown",
+	StartupBehavior:   "application_config.startup_behavior",
+	BuildType:         "application_config.build_type",
+	BaseImage:         "application_config.base_image",
+	BuildCMD:          "application_config.build_cmd",
+	ArtifactPath:      "application_config.artifact_path",
+	Spa:               "application_config.spa",
+	NotFoundPath:      "application_config.not_found_path",
+	AssetCacheControl: "application_config.asset_cache_control",
+	HTMLCacheControl:  "application_config.html_cache_control",
+	Precompressed:     "application_config.precompressed",
+	DockerfileName:    "application_config.dockerfile_name",
+	Context:           "application_config.context",
+	Entrypoint:        "application_config.entrypoint",
+	Command:           "application_config.command",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]any, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]any, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperbool struct{ field string }
 
 func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -157,35 +156,43 @@ func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var ApplicationConfigWhere = struct {
-	ApplicationID   whereHelperstring
-	UseMariadb      whereHelperbool
-	UseMongodb      whereHelperbool
-	AutoShutdown    whereHelperbool
-	StartupBehavior whereHelperstring
-	BuildType       whereHelperstring
-	BaseImage       whereHelperstring
-	BuildCMD        whereHelperstring
-	ArtifactPath    whereHelperstring
-	Spa             whereHelperbool
-	DockerfileName  whereHelperstring
-	Context         whereHelperstring
-	Entrypoint      whereHelperstring
-	Command         whereHelperstring
+	ApplicationID     whereHelperstring
+	UseMariadb        whereHelperbool
+	UseMongodb        whereHelperbool
+	AutoShutdown      whereHelperbool
+	StartupBehavior   whereHelperstring
+	BuildType         whereHelperstring
+	BaseImage         whereHelperstring
+	BuildCMD          whereHelperstring
+	ArtifactPath      whereHelperstring
+	Spa               whereHelperbool
+	NotFoundPath      whereHelperstring
+	AssetCacheControl whereHelperstring
+	HTMLCacheControl  whereHelperstring
+	Precompressed     whereHelperbool
+	DockerfileName    whereHelperstring
+	Context           whereHelperstring
+	Entrypoint        whereHelperstring
+	Command           whereHelperstring
 }{
-	ApplicationID:   whereHelperstring{field: "`application_config`.`application_id`"},
-	UseMariadb:      whereHelperbool{field: "`application_config`.`use_mariadb`"},
-	UseMongodb:      whereHelperbool{field: "`application_config`.`use_mongodb`"},
-	AutoShutdown:    whereHelperbool{field: "`application_config`.`auto_shutdown`"},
-	StartupBehavior: whereHelperstring{field: "`application_config`.`startup_behavior`"},
-	BuildType:       whereHelperstring{field: "`application_config`.`build_type`"},
-	BaseImage:       whereHelperstring{field: "`application_config`.`base_image`"},
-	BuildCMD:        whereHelperstring{field: "`application_config`.`build_cmd`"},
-	ArtifactPath:    whereHelperstring{field: "`application_config`.`artifact_path`"},
-	Spa:             whereHelperbool{field: "`application_config`.`spa`"},
-	DockerfileName:  whereHelperstring{field: "`application_config`.`dockerfile_name`"},
-	Context:         whereHelperstring{field: "`application_config`.`context`"},
-	Entrypoint:      whereHelperstring{field: "`application_config`.`entrypoint`"},
-	Command:         whereHelperstring{field: "`application_config`.`command`"},
+	ApplicationID:     whereHelperstring{field: "`application_config`.`application_id`"},
+	UseMariadb:        whereHelperbool{field: "`application_config`.`use_mariadb`"},
+	UseMongodb:        whereHelperbool{field: "`application_config`.`use_mongodb`"},
+	AutoShutdown:      whereHelperbool{field: "`application_config`.`auto_shutdown`"},
+	StartupBehavior:   whereHelperstring{field: "`application_config`.`startup_behavior`"},
+	BuildType:         whereHelperstring{field: "`application_config`.`build_type`"},
+	BaseImage:         whereHelperstring{field: "`application_config`.`base_image`"},
+	BuildCMD:          whereHelperstring{field: "`application_config`.`build_cmd`"},
+	ArtifactPath:      whereHelperstring{field: "`application_config`.`artifact_path`"},
+	Spa:               whereHelperbool{field: "`application_config`.`spa`"},
+	NotFoundPath:      whereHelperstring{field: "`application_config`.`not_found_path`"},
+	AssetCacheControl: whereHelperstring{field: "`application_config`.`asset_cache_control`"},
+	HTMLCacheControl:  whereHelperstring{field: "`application_config`.`html_cache_control`"},
+	Precompressed:     whereHelperbool{field: "`application_config`.`precompressed`"},
+	DockerfileName:    whereHelperstring{field: "`application_config`.`dockerfile_name`"},
+	Context:           whereHelperstring{field: "`application_config`.`context`"},
+	Entrypoint:        whereHelperstring{field: "`application_config`.`entrypoint`"},
+	Command:           whereHelperstring{field: "`application_config`.`command`"},
 }
 
 // ApplicationConfigRels is where relationship names are stored.
@@ -225,9 +232,9 @@ func (r *applicationConfigR) GetApplication() *Application {
 type applicationConfigL struct{}
 
 var (
-	applicationConfigAllColumns            = []string{"application_id", "use_mariadb", "use_mongodb", "auto_shutdown", "startup_behavior", "build_type", "base_image", "build_cmd", "artifact_path", "spa", "dockerfile_name", "context", "entrypoint", "command"}
-	applicationConfigColumnsWithoutDefault = []string{"application_id", "use_mariadb", "use_mongodb", "build_type", "base_image", "build_cmd", "artifact_path", "spa", "dockerfile_name", "context", "entrypoint", "command"}
-	applicationConfigColumnsWithDefault    = []string{"auto_shutdown", "startup_behavior"}
+	applicationConfigAllColumns            = []string{"application_id", "use_mariadb", "use_mongodb", "auto_shutdown", "startup_behavior", "build_type", "base_image", "build_cmd", "artifact_path", "spa", "not_found_path", "asset_cache_control", "html_cache_control", "precompressed", "dockerfile_name", "context", "entrypoint", "command"}
+	applicationConfigColumnsWithoutDefault = []string{"application_id", "use_mariadb", "use_mongodb", "build_type", "base_image", "build_cmd", "artifact_path", "spa", "not_found_path", "asset_cache_control", "html_cache_control", "dockerfile_name", "context", "entrypoint", "command"}
+	applicationConfigColumnsWithDefault    = []string{"auto_shutdown", "startup_behavior", "precompressed"}
 	applicationConfigPrimaryKeyColumns     = []string{"application_id"}
 	applicationConfigGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1215 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ApplicationEvent is an object representing the database table.
+type ApplicationEvent struct {
+	// イベントID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// イベントの種類
+	Type string `boil:"type" json:"type" toml:"type" yaml:"type"`
+	// 関連するエンティティのID
+	Reference string `boil:"reference" json:"reference" toml:"reference" yaml:"reference"`
+	// イベントの詳細
+	Message string `boil:"message" json:"message" toml:"message" yaml:"message"`
+	// 操作したユーザーのID (システムによるイベントの場合は空)
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// 発生日時
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *applicationEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L applicationEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ApplicationEventColumns = struct {
+	ID            string
+	ApplicationID string
+	Type          string
+	Reference     string
+	Message       string
+	UserID        string
+	CreatedAt     string
+}{
+	ID:            "id",
+	ApplicationID: "application_id",
+	Type:          "type",
+	Reference:     "reference",
+	Message:       "message",
+	UserID:        "user_id",
+	CreatedAt:     "created_at",
+}
+
+var ApplicationEventTableColumns = struct {
+	ID            string
+	ApplicationID string
+	Type          string
+	Reference     string
+	Message       string
+	UserID        string
+	CreatedAt     string
+}{
+	ID:            "application_events.id",
+	ApplicationID: "application_events.application_id",
+	Type:          "application_events.type",
+	Reference:     "application_events.reference",
+	Message:       "application_events.message",
+	UserID:        "application_events.user_id",
+	CreatedAt:     "application_events.created_at",
+}
+
+// Generated where
+
+var ApplicationEventWhere = struct {
+	ID            whereHelperstring
+	ApplicationID whereHelperstring
+	Type          whereHelperstring
+	Reference     whereHelperstring
+	Message       whereHelperstring
+	UserID        whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "`application_events`.`id`"},
+	ApplicationID: whereHelperstring{field: "`application_events`.`application_id`"},
+	Type:          whereHelperstring{field: "`application_events`.`type`"},
+	Reference:     whereHelperstring{field: "`application_events`.`reference`"},
+	Message:       whereHelperstring{field: "`application_events`.`message`"},
+	UserID:        whereHelperstring{field: "`application_events`.`user_id`"},
+	CreatedAt:     whereHelpertime_Time{field: "`application_events`.`created_at`"},
+}
+
+// ApplicationEventRels is where relationship names are stored.
+var ApplicationEventRels = struct {
+	Application string
+}{
+	Application: "Application",
+}
+
+// applicationEventR is where relationships are stored.
+type applicationEventR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+}
+
+// NewStruct creates a new relationship struct
+func (*applicationEventR) NewStruct() *applicationEventR {
+	return &applicationEventR{}
+}
+
+func (o *ApplicationEvent) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *applicationEventR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+// applicationEventL is where Load methods for each relationship are stored.
+type applicationEventL struct{}
+
+var (
+	applicationEventAllColumns            = []string{"id", "application_id", "type", "reference", "message", "user_id", "created_at"}
+	applicationEventColumnsWithoutDefault = []string{"id", "application_id", "type", "reference", "message", "user_id", "created_at"}
+	applicationEventColumnsWithDefault    = []string{}
+	applicationEventPrimaryKeyColumns     = []string{"id"}
+	applicationEventGeneratedColumns      = []string{}
+)
+
+type (
+	// ApplicationEventSlice is an alias for a slice of pointers to ApplicationEvent.
+	// This should almost always be used instead of []ApplicationEvent.
+	ApplicationEventSlice []*ApplicationEvent
+	// ApplicationEventHook is the signature for custom ApplicationEvent hook methods
+	ApplicationEventHook func(context.Context, boil.ContextExecutor, *ApplicationEvent) error
+
+	applicationEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	applicationEventType                 = reflect.TypeOf(&ApplicationEvent{})
+	applicationEventMapping              = queries.MakeStructMapping(applicationEventType)
+	applicationEventPrimaryKeyMapping, _ = queries.BindMapping(applicationEventType, applicationEventMapping, applicationEventPrimaryKeyColumns)
+	applicationEventInsertCacheMut       sync.RWMutex
+	applicationEventInsertCache          = make(map[string]insertCache)
+	applicationEventUpdateCacheMut       sync.RWMutex
+	applicationEventUpdateCache          = make(map[string]updateCache)
+	applicationEventUpsertCacheMut       sync.RWMutex
+	applicationEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var applicationEventAfterSelectMu sync.Mutex
+var applicationEventAfterSelectHooks []ApplicationEventHook
+
+var applicationEventBeforeInsertMu sync.Mutex
+var applicationEventBeforeInsertHooks []ApplicationEventHook
+var applicationEventAfterInsertMu sync.Mutex
+var applicationEventAfterInsertHooks []ApplicationEventHook
+
+var applicationEventBeforeUpdateMu sync.Mutex
+var applicationEventBeforeUpdateHooks []ApplicationEventHook
+var applicationEventAfterUpdateMu sync.Mutex
+var applicationEventAfterUpdateHooks []ApplicationEventHook
+
+var applicationEventBeforeDeleteMu sync.Mutex
+var applicationEventBeforeDeleteHooks []ApplicationEventHook
+var applicationEventAfterDeleteMu sync.Mutex
+var applicationEventAfterDeleteHooks []ApplicationEventHook
+
+var applicationEventBeforeUpsertMu sync.Mutex
+var applicationEventBeforeUpsertHooks []ApplicationEventHook
+var applicationEventAfterUpsertMu sync.Mutex
+var applicationEventAfterUpsertHooks []ApplicationEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ApplicationEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ApplicationEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ApplicationEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ApplicationEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ApplicationEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ApplicationEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ApplicationEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ApplicationEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ApplicationEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddApplicationEventHook registers your hook function for all future operations.
+func AddApplicationEventHook(hookPoint boil.HookPoint, applicationEventHook ApplicationEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		applicationEventAfterSelectMu.Lock()
+		applicationEventAfterSelectHooks = append(applicationEventAfterSelectHooks, applicationEventHook)
+		applicationEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		applicationEventBeforeInsertMu.Lock()
+		applicationEventBeforeInsertHooks = append(applicationEventBeforeInsertHooks, applicationEventHook)
+		applicationEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		applicationEventAfterInsertMu.Lock()
+		applicationEventAfterInsertHooks = append(applicationEventAfterInsertHooks, applicationEventHook)
+		applicationEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		applicationEventBeforeUpdateMu.Lock()
+		applicationEventBeforeUpdateHooks = append(applicationEventBeforeUpdateHooks, applicationEventHook)
+		applicationEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		applicationEventAfterUpdateMu.Lock()
+		applicationEventAfterUpdateHooks = append(applicationEventAfterUpdateHooks, applicationEventHook)
+		applicationEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		applicationEventBeforeDeleteMu.Lock()
+		applicationEventBeforeDeleteHooks = append(applicationEventBeforeDeleteHooks, applicationEventHook)
+		applicationEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		applicationEventAfterDeleteMu.Lock()
+		applicationEventAfterDeleteHooks = append(applicationEventAfterDeleteHooks, applicationEventHook)
+		applicationEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		applicationEventBeforeUpsertMu.Lock()
+		applicationEventBeforeUpsertHooks = append(applicationEventBeforeUpsertHooks, applicationEventHook)
+		applicationEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		applicationEventAfterUpsertMu.Lock()
+		applicationEventAfterUpsertHooks = append(applicationEventAfterUpsertHooks, applicationEventHook)
+		applicationEventAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single applicationEvent record from the query.
+func (q applicationEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ApplicationEvent, error) {
+	o := &ApplicationEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for application_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ApplicationEvent records from the query.
+func (q applicationEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (ApplicationEventSlice, error) {
+	var o []*ApplicationEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ApplicationEvent slice")
+	}
+
+	if len(applicationEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ApplicationEvent records in the query.
+func (q applicationEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count application_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q applicationEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if application_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *ApplicationEvent) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (applicationEventL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplicationEvent any, mods queries.Applicator) error {
+	var slice []*ApplicationEvent
+	var object *ApplicationEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplicationEvent.(*ApplicationEvent)
+		if !ok {
+			object = new(ApplicationEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplicationEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplicationEvent))
+			}
+		}
+	} else {
+		s, ok := maybeApplicationEvent.(*[]*ApplicationEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplicationEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplicationEvent))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationEventR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationEventR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.ApplicationEvents = append(foreign.R.ApplicationEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.ApplicationEvents = append(foreign.R.ApplicationEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the applicationEvent to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.ApplicationEvents.
+func (o *ApplicationEvent) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `application_events` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, applicationEventPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &applicationEventR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			ApplicationEvents: ApplicationEventSlice{o},
+		}
+	} else {
+		related.R.ApplicationEvents = append(related.R.ApplicationEvents, o)
+	}
+
+	return nil
+}
+
+// ApplicationEvents retrieves all the records using an executor.
+func ApplicationEvents(mods ...qm.QueryMod) applicationEventQuery {
+	mods = append(mods, qm.From("`application_events`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`application_events`.*"})
+	}
+
+	return applicationEventQuery{q}
+}
+
+// FindApplicationEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindApplicationEvent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ApplicationEvent, error) {
+	applicationEventObj := &ApplicationEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `application_events` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, applicationEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from application_events")
+	}
+
+	if err = applicationEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return applicationEventObj, err
+	}
+
+	return applicationEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ApplicationEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no application_events provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(applicationEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	applicationEventInsertCacheMut.RLock()
+	cache, cached := applicationEventInsertCache[key]
+	applicationEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			applicationEventAllColumns,
+			applicationEventColumnsWithDefault,
+			applicationEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(applicationEventType, applicationEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(applicationEventType, applicationEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `application_events` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `application_events` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `application_events` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, applicationEventPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into application_events")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for application_events")
+	}
+
+CacheNoHooks:
+	if !cached {
+		applicationEventInsertCacheMut.Lock()
+		applicationEventInsertCache[key] = cache
+		applicationEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ApplicationEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ApplicationEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	applicationEventUpdateCacheMut.RLock()
+	cache, cached := applicationEventUpdateCache[key]
+	applicationEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			applicationEventAllColumns,
+			applicationEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update application_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `application_events` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, applicationEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(applicationEventType, applicationEventMapping, append(wl, applicationEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update application_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for application_events")
+	}
+
+	if !cached {
+		applicationEventUpdateCacheMut.Lock()
+		applicationEventUpdateCache[key] = cache
+		applicationEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q applicationEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for application_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for application_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ApplicationEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `application_events` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in applicationEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all applicationEvent")
+	}
+	return rowsAff, nil
+}
+
+var mySQLApplicationEventUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ApplicationEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no application_events provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(applicationEventColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLApplicationEventUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	applicationEventUpsertCacheMut.RLock()
+	cache, cached := applicationEventUpsertCache[key]
+	applicationEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			applicationEventAllColumns,
+			applicationEventColumnsWithDefault,
+			applicationEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			applicationEventAllColumns,
+			applicationEventPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert application_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(applicationEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`application_events`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `application_events` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(applicationEventType, applicationEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(applicationEventType, applicationEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for application_events")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(applicationEventType, applicationEventMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for application_events")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for application_events")
+	}
+
+CacheNoHooks:
+	if !cached {
+		applicationEventUpsertCacheMut.Lock()
+		applicationEventUpsertCache[key] = cache
+		applicationEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ApplicationEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ApplicationEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ApplicationEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), applicationEventPrimaryKeyMapping)
+	sql := "DELETE FROM `application_events` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from application_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for application_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q applicationEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no applicationEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from application_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for application_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ApplicationEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(applicationEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `application_events` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from applicationEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for application_events")
+	}
+
+	if len(applicationEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ApplicationEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindApplicationEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ApplicationEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ApplicationEventSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `application_events`.* FROM `application_events` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ApplicationEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ApplicationEventExists checks if the ApplicationEvent row exists.
+func ApplicationEventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `application_events` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if application_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ApplicationEvent row exists.
+func (o *ApplicationEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ApplicationEventExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1160 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ApplicationInternalServiceAllowedApp is an object representing the database table.
+type ApplicationInternalServiceAllowedApp struct {
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// アクセスを許可するアプリケーションID
+	AllowedApplicationID string `boil:"allowed_application_id" json:"allowed_application_id" toml:"allowed_application_id" yaml:"allowed_application_id"`
+
+	R *applicationInternalServiceAllowedAppR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L applicationInternalServiceAllowedAppL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ApplicationInternalServiceAllowedAppColumns = struct {
+	ApplicationID        string
+	AllowedApplicationID string
+}{
+	ApplicationID:        "application_id",
+	AllowedApplicationID: "allowed_application_id",
+}
+
+var ApplicationInternalServiceAllowedAppTableColumns = struct {
+	ApplicationID        string
+	AllowedApplicationID string
+}{
+	ApplicationID:        "application_internal_service_allowed_apps.application_id",
+	AllowedApplicationID: "application_internal_service_allowed_apps.allowed_application_id",
+}
+
+// Generated where
+
+var ApplicationInternalServiceAllowedAppWhere = struct {
+	ApplicationID        whereHelperstring
+	AllowedApplicationID whereHelperstring
+}{
+	ApplicationID:        whereHelperstring{field: "`application_internal_service_allowed_apps`.`application_id`"},
+	AllowedApplicationID: whereHelperstring{field: "`application_internal_service_allowed_apps`.`allowed_application_id`"},
+}
+
+// ApplicationInternalServiceAllowedAppRels is where relationship names are stored.
+var ApplicationInternalServiceAllowedAppRels = struct {
+	Application string
+}{
+	Application: "Application",
+}
+
+// applicationInternalServiceAllowedAppR is where relationships are stored.
+type applicationInternalServiceAllowedAppR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+}
+
+// NewStruct creates a new relationship struct
+func (*applicationInternalServiceAllowedAppR) NewStruct() *applicationInternalServiceAllowedAppR {
+	return &applicationInternalServiceAllowedAppR{}
+}
+
+func (o *ApplicationInternalServiceAllowedApp) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *applicationInternalServiceAllowedAppR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+// applicationInternalServiceAllowedAppL is where Load methods for each relationship are stored.
+type applicationInternalServiceAllowedAppL struct{}
+
+var (
+	applicationInternalServiceAllowedAppAllColumns            = []string{"application_id", "allowed_application_id"}
+	applicationInternalServiceAllowedAppColumnsWithoutDefault = []string{"application_id", "allowed_application_id"}
+	applicationInternalServiceAllowedAppColumnsWithDefault    = []string{}
+	applicationInternalServiceAllowedAppPrimaryKeyColumns     = []string{"application_id", "allowed_application_id"}
+	applicationInternalServiceAllowedAppGeneratedColumns      = []string{}
+)
+
+type (
+	// ApplicationInternalServiceAllowedAppSlice is an alias for a slice of pointers to ApplicationInternalServiceAllowedApp.
+	// This should almost always be used instead of []ApplicationInternalServiceAllowedApp.
+	ApplicationInternalServiceAllowedAppSlice []*ApplicationInternalServiceAllowedApp
+	// ApplicationInternalServiceAllowedAppHook is the signature for custom ApplicationInternalServiceAllowedApp hook methods
+	ApplicationInternalServiceAllowedAppHook func(context.Context, boil.ContextExecutor, *ApplicationInternalServiceAllowedApp) error
+
+	applicationInternalServiceAllowedAppQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	applicationInternalServiceAllowedAppType                 = reflect.TypeOf(&ApplicationInternalServiceAllowedApp{})
+	applicationInternalServiceAllowedAppMapping              = queries.MakeStructMapping(applicationInternalServiceAllowedAppType)
+	applicationInternalServiceAllowedAppPrimaryKeyMapping, _ = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, applicationInternalServiceAllowedAppPrimaryKeyColumns)
+	applicationInternalServiceAllowedAppInsertCacheMut       sync.RWMutex
+	applicationInternalServiceAllowedAppInsertCache          = make(map[string]insertCache)
+	applicationInternalServiceAllowedAppUpdateCacheMut       sync.RWMutex
+	applicationInternalServiceAllowedAppUpdateCache          = make(map[string]updateCache)
+	applicationInternalServiceAllowedAppUpsertCacheMut       sync.RWMutex
+	applicationInternalServiceAllowedAppUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var applicationInternalServiceAllowedAppAfterSelectMu sync.Mutex
+var applicationInternalServiceAllowedAppAfterSelectHooks []ApplicationInternalServiceAllowedAppHook
+
+var applicationInternalServiceAllowedAppBeforeInsertMu sync.Mutex
+var applicationInternalServiceAllowedAppBeforeInsertHooks []ApplicationInternalServiceAllowedAppHook
+var applicationInternalServiceAllowedAppAfterInsertMu sync.Mutex
+var applicationInternalServiceAllowedAppAfterInsertHooks []ApplicationInternalServiceAllowedAppHook
+
+var applicationInternalServiceAllowedAppBeforeUpdateMu sync.Mutex
+var applicationInternalServiceAllowedAppBeforeUpdateHooks []ApplicationInternalServiceAllowedAppHook
+var applicationInternalServiceAllowedAppAfterUpdateMu sync.Mutex
+var applicationInternalServiceAllowedAppAfterUpdateHooks []ApplicationInternalServiceAllowedAppHook
+
+var applicationInternalServiceAllowedAppBeforeDeleteMu sync.Mutex
+var applicationInternalServiceAllowedAppBeforeDeleteHooks []ApplicationInternalServiceAllowedAppHook
+var applicationInternalServiceAllowedAppAfterDeleteMu sync.Mutex
+var applicationInternalServiceAllowedAppAfterDeleteHooks []ApplicationInternalServiceAllowedAppHook
+
+var applicationInternalServiceAllowedAppBeforeUpsertMu sync.Mutex
+var applicationInternalServiceAllowedAppBeforeUpsertHooks []ApplicationInternalServiceAllowedAppHook
+var applicationInternalServiceAllowedAppAfterUpsertMu sync.Mutex
+var applicationInternalServiceAllowedAppAfterUpsertHooks []ApplicationInternalServiceAllowedAppHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ApplicationInternalServiceAllowedApp) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAllowedAppAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddApplicationInternalServiceAllowedAppHook registers your hook function for all future operations.
+func AddApplicationInternalServiceAllowedAppHook(hookPoint boil.HookPoint, applicationInternalServiceAllowedAppHook ApplicationInternalServiceAllowedAppHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		applicationInternalServiceAllowedAppAfterSelectMu.Lock()
+		applicationInternalServiceAllowedAppAfterSelectHooks = append(applicationInternalServiceAllowedAppAfterSelectHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		applicationInternalServiceAllowedAppBeforeInsertMu.Lock()
+		applicationInternalServiceAllowedAppBeforeInsertHooks = append(applicationInternalServiceAllowedAppBeforeInsertHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		applicationInternalServiceAllowedAppAfterInsertMu.Lock()
+		applicationInternalServiceAllowedAppAfterInsertHooks = append(applicationInternalServiceAllowedAppAfterInsertHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		applicationInternalServiceAllowedAppBeforeUpdateMu.Lock()
+		applicationInternalServiceAllowedAppBeforeUpdateHooks = append(applicationInternalServiceAllowedAppBeforeUpdateHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		applicationInternalServiceAllowedAppAfterUpdateMu.Lock()
+		applicationInternalServiceAllowedAppAfterUpdateHooks = append(applicationInternalServiceAllowedAppAfterUpdateHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		applicationInternalServiceAllowedAppBeforeDeleteMu.Lock()
+		applicationInternalServiceAllowedAppBeforeDeleteHooks = append(applicationInternalServiceAllowedAppBeforeDeleteHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		applicationInternalServiceAllowedAppAfterDeleteMu.Lock()
+		applicationInternalServiceAllowedAppAfterDeleteHooks = append(applicationInternalServiceAllowedAppAfterDeleteHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		applicationInternalServiceAllowedAppBeforeUpsertMu.Lock()
+		applicationInternalServiceAllowedAppBeforeUpsertHooks = append(applicationInternalServiceAllowedAppBeforeUpsertHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		applicationInternalServiceAllowedAppAfterUpsertMu.Lock()
+		applicationInternalServiceAllowedAppAfterUpsertHooks = append(applicationInternalServiceAllowedAppAfterUpsertHooks, applicationInternalServiceAllowedAppHook)
+		applicationInternalServiceAllowedAppAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single applicationInternalServiceAllowedApp record from the query.
+func (q applicationInternalServiceAllowedAppQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ApplicationInternalServiceAllowedApp, error) {
+	o := &ApplicationInternalServiceAllowedApp{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for application_internal_service_allowed_apps")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ApplicationInternalServiceAllowedApp records from the query.
+func (q applicationInternalServiceAllowedAppQuery) All(ctx context.Context, exec boil.ContextExecutor) (ApplicationInternalServiceAllowedAppSlice, error) {
+	var o []*ApplicationInternalServiceAllowedApp
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ApplicationInternalServiceAllowedApp slice")
+	}
+
+	if len(applicationInternalServiceAllowedAppAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ApplicationInternalServiceAllowedApp records in the query.
+func (q applicationInternalServiceAllowedAppQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count application_internal_service_allowed_apps rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q applicationInternalServiceAllowedAppQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if application_internal_service_allowed_apps exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *ApplicationInternalServiceAllowedApp) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (applicationInternalServiceAllowedAppL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplicationInternalServiceAllowedApp any, mods queries.Applicator) error {
+	var slice []*ApplicationInternalServiceAllowedApp
+	var object *ApplicationInternalServiceAllowedApp
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplicationInternalServiceAllowedApp.(*ApplicationInternalServiceAllowedApp)
+		if !ok {
+			object = new(ApplicationInternalServiceAllowedApp)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplicationInternalServiceAllowedApp)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplicationInternalServiceAllowedApp))
+			}
+		}
+	} else {
+		s, ok := maybeApplicationInternalServiceAllowedApp.(*[]*ApplicationInternalServiceAllowedApp)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplicationInternalServiceAllowedApp)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplicationInternalServiceAllowedApp))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationInternalServiceAllowedAppR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationInternalServiceAllowedAppR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.ApplicationInternalServiceAllowedApps = append(foreign.R.ApplicationInternalServiceAllowedApps, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.ApplicationInternalServiceAllowedApps = append(foreign.R.ApplicationInternalServiceAllowedApps, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the applicationInternalServiceAllowedApp to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.ApplicationInternalServiceAllowedApps.
+func (o *ApplicationInternalServiceAllowedApp) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `application_internal_service_allowed_apps` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, applicationInternalServiceAllowedAppPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ApplicationID, o.AllowedApplicationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &applicationInternalServiceAllowedAppR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			ApplicationInternalServiceAllowedApps: ApplicationInternalServiceAllowedAppSlice{o},
+		}
+	} else {
+		related.R.ApplicationInternalServiceAllowedApps = append(related.R.ApplicationInternalServiceAllowedApps, o)
+	}
+
+	return nil
+}
+
+// ApplicationInternalServiceAllowedApps retrieves all the records using an executor.
+func ApplicationInternalServiceAllowedApps(mods ...qm.QueryMod) applicationInternalServiceAllowedAppQuery {
+	mods = append(mods, qm.From("`application_internal_service_allowed_apps`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`application_internal_service_allowed_apps`.*"})
+	}
+
+	return applicationInternalServiceAllowedAppQuery{q}
+}
+
+// FindApplicationInternalServiceAllowedApp retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindApplicationInternalServiceAllowedApp(ctx context.Context, exec boil.ContextExecutor, applicationID string, allowedApplicationID string, selectCols ...string) (*ApplicationInternalServiceAllowedApp, error) {
+	applicationInternalServiceAllowedAppObj := &ApplicationInternalServiceAllowedApp{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `application_internal_service_allowed_apps` where `application_id`=? AND `allowed_application_id`=?", sel,
+	)
+
+	q := queries.Raw(query, applicationID, allowedApplicationID)
+
+	err := q.Bind(ctx, exec, applicationInternalServiceAllowedAppObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from application_internal_service_allowed_apps")
+	}
+
+	if err = applicationInternalServiceAllowedAppObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return applicationInternalServiceAllowedAppObj, err
+	}
+
+	return applicationInternalServiceAllowedAppObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ApplicationInternalServiceAllowedApp) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no application_internal_service_allowed_apps provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(applicationInternalServiceAllowedAppColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	applicationInternalServiceAllowedAppInsertCacheMut.RLock()
+	cache, cached := applicationInternalServiceAllowedAppInsertCache[key]
+	applicationInternalServiceAllowedAppInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			applicationInternalServiceAllowedAppAllColumns,
+			applicationInternalServiceAllowedAppColumnsWithDefault,
+			applicationInternalServiceAllowedAppColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `application_internal_service_allowed_apps` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `application_internal_service_allowed_apps` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `application_internal_service_allowed_apps` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, applicationInternalServiceAllowedAppPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into application_internal_service_allowed_apps")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ApplicationID,
+		o.AllowedApplicationID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for application_internal_service_allowed_apps")
+	}
+
+CacheNoHooks:
+	if !cached {
+		applicationInternalServiceAllowedAppInsertCacheMut.Lock()
+		applicationInternalServiceAllowedAppInsertCache[key] = cache
+		applicationInternalServiceAllowedAppInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ApplicationInternalServiceAllowedApp.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ApplicationInternalServiceAllowedApp) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	applicationInternalServiceAllowedAppUpdateCacheMut.RLock()
+	cache, cached := applicationInternalServiceAllowedAppUpdateCache[key]
+	applicationInternalServiceAllowedAppUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			applicationInternalServiceAllowedAppAllColumns,
+			applicationInternalServiceAllowedAppPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update application_internal_service_allowed_apps, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `application_internal_service_allowed_apps` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, applicationInternalServiceAllowedAppPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, append(wl, applicationInternalServiceAllowedAppPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update application_internal_service_allowed_apps row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for application_internal_service_allowed_apps")
+	}
+
+	if !cached {
+		applicationInternalServiceAllowedAppUpdateCacheMut.Lock()
+		applicationInternalServiceAllowedAppUpdateCache[key] = cache
+		applicationInternalServiceAllowedAppUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q applicationInternalServiceAllowedAppQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for application_internal_service_allowed_apps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for application_internal_service_allowed_apps")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ApplicationInternalServiceAllowedAppSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationInternalServiceAllowedAppPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `application_internal_service_allowed_apps` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationInternalServiceAllowedAppPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in applicationInternalServiceAllowedApp slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all applicationInternalServiceAllowedApp")
+	}
+	return rowsAff, nil
+}
+
+var mySQLApplicationInternalServiceAllowedAppUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ApplicationInternalServiceAllowedApp) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no application_internal_service_allowed_apps provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(applicationInternalServiceAllowedAppColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLApplicationInternalServiceAllowedAppUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	applicationInternalServiceAllowedAppUpsertCacheMut.RLock()
+	cache, cached := applicationInternalServiceAllowedAppUpsertCache[key]
+	applicationInternalServiceAllowedAppUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			applicationInternalServiceAllowedAppAllColumns,
+			applicationInternalServiceAllowedAppColumnsWithDefault,
+			applicationInternalServiceAllowedAppColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			applicationInternalServiceAllowedAppAllColumns,
+			applicationInternalServiceAllowedAppPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert application_internal_service_allowed_apps, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(applicationInternalServiceAllowedAppAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`application_internal_service_allowed_apps`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `application_internal_service_allowed_apps` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for application_internal_service_allowed_apps")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(applicationInternalServiceAllowedAppType, applicationInternalServiceAllowedAppMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for application_internal_service_allowed_apps")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for application_internal_service_allowed_apps")
+	}
+
+CacheNoHooks:
+	if !cached {
+		applicationInternalServiceAllowedAppUpsertCacheMut.Lock()
+		applicationInternalServiceAllowedAppUpsertCache[key] = cache
+		applicationInternalServiceAllowedAppUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ApplicationInternalServiceAllowedApp record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ApplicationInternalServiceAllowedApp) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ApplicationInternalServiceAllowedApp provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), applicationInternalServiceAllowedAppPrimaryKeyMapping)
+	sql := "DELETE FROM `application_internal_service_allowed_apps` WHERE `application_id`=? AND `allowed_application_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from application_internal_service_allowed_apps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for application_internal_service_allowed_apps")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q applicationInternalServiceAllowedAppQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no applicationInternalServiceAllowedAppQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from application_internal_service_allowed_apps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for application_internal_service_allowed_apps")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ApplicationInternalServiceAllowedAppSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(applicationInternalServiceAllowedAppBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationInternalServiceAllowedAppPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `application_internal_service_allowed_apps` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationInternalServiceAllowedAppPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from applicationInternalServiceAllowedApp slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for application_internal_service_allowed_apps")
+	}
+
+	if len(applicationInternalServiceAllowedAppAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ApplicationInternalServiceAllowedApp) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindApplicationInternalServiceAllowedApp(ctx, exec, o.ApplicationID, o.AllowedApplicationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ApplicationInternalServiceAllowedAppSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ApplicationInternalServiceAllowedAppSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationInternalServiceAllowedAppPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `application_internal_service_allowed_apps`.* FROM `application_internal_service_allowed_apps` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationInternalServiceAllowedAppPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ApplicationInternalServiceAllowedAppSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ApplicationInternalServiceAllowedAppExists checks if the ApplicationInternalServiceAllowedApp row exists.
+func ApplicationInternalServiceAllowedAppExists(ctx context.Context, exec boil.ContextExecutor, applicationID string, allowedApplicationID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `application_internal_service_allowed_apps` where `application_id`=? AND `allowed_application_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, applicationID, allowedApplicationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, applicationID, allowedApplicationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if application_internal_service_allowed_apps exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ApplicationInternalServiceAllowedApp row exists.
+func (o *ApplicationInternalServiceAllowedApp) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ApplicationInternalServiceAllowedAppExists(ctx, exec, o.ApplicationID, o.AllowedApplicationID)
+}
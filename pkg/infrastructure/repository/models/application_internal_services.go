@@ -0,0 +1,1161 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ApplicationInternalService is an object representing the database table.
+type ApplicationInternalService struct {
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// 他のアプリに公開するアプリケーション側ポート
+	Port int `boil:"port" json:"port" toml:"port" yaml:"port"`
+
+	R *applicationInternalServiceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L applicationInternalServiceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ApplicationInternalServiceColumns = struct {
+	ApplicationID string
+	Port          string
+}{
+	ApplicationID: "application_id",
+	Port:          "port",
+}
+
+var ApplicationInternalServiceTableColumns = struct {
+	ApplicationID string
+	Port          string
+}{
+	ApplicationID: "application_internal_services.application_id",
+	Port:          "application_internal_services.port",
+}
+
+// Generated where
+
+var ApplicationInternalServiceWhere = struct {
+	ApplicationID whereHelperstring
+	Port          whereHelperint
+}{
+	ApplicationID: whereHelperstring{field: "`application_internal_services`.`application_id`"},
+	Port:          whereHelperint{field: "`application_internal_services`.`port`"},
+}
+
+// ApplicationInternalServiceRels is where relationship names are stored.
+var ApplicationInternalServiceRels = struct {
+	Application string
+}{
+	Application: "Application",
+}
+
+// applicationInternalServiceR is where relationships are stored.
+type applicationInternalServiceR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+}
+
+// NewStruct creates a new relationship struct
+func (*applicationInternalServiceR) NewStruct() *applicationInternalServiceR {
+	return &applicationInternalServiceR{}
+}
+
+func (o *ApplicationInternalService) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *applicationInternalServiceR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+// applicationInternalServiceL is where Load methods for each relationship are stored.
+type applicationInternalServiceL struct{}
+
+var (
+	applicationInternalServiceAllColumns            = []string{"application_id", "port"}
+	applicationInternalServiceColumnsWithoutDefault = []string{"application_id", "port"}
+	applicationInternalServiceColumnsWithDefault    = []string{}
+	applicationInternalServicePrimaryKeyColumns     = []string{"application_id"}
+	applicationInternalServiceGeneratedColumns      = []string{}
+)
+
+type (
+	// ApplicationInternalServiceSlice is an alias for a slice of pointers to ApplicationInternalService.
+	// This should almost always be used instead of []ApplicationInternalService.
+	ApplicationInternalServiceSlice []*ApplicationInternalService
+	// ApplicationInternalServiceHook is the signature for custom ApplicationInternalService hook methods
+	ApplicationInternalServiceHook func(context.Context, boil.ContextExecutor, *ApplicationInternalService) error
+
+	applicationInternalServiceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	applicationInternalServiceType                 = reflect.TypeOf(&ApplicationInternalService{})
+	applicationInternalServiceMapping              = queries.MakeStructMapping(applicationInternalServiceType)
+	applicationInternalServicePrimaryKeyMapping, _ = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, applicationInternalServicePrimaryKeyColumns)
+	applicationInternalServiceInsertCacheMut       sync.RWMutex
+	applicationInternalServiceInsertCache          = make(map[string]insertCache)
+	applicationInternalServiceUpdateCacheMut       sync.RWMutex
+	applicationInternalServiceUpdateCache          = make(map[string]updateCache)
+	applicationInternalServiceUpsertCacheMut       sync.RWMutex
+	applicationInternalServiceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var applicationInternalServiceAfterSelectMu sync.Mutex
+var applicationInternalServiceAfterSelectHooks []ApplicationInternalServiceHook
+
+var applicationInternalServiceBeforeInsertMu sync.Mutex
+var applicationInternalServiceBeforeInsertHooks []ApplicationInternalServiceHook
+var applicationInternalServiceAfterInsertMu sync.Mutex
+var applicationInternalServiceAfterInsertHooks []ApplicationInternalServiceHook
+
+var applicationInternalServiceBeforeUpdateMu sync.Mutex
+var applicationInternalServiceBeforeUpdateHooks []ApplicationInternalServiceHook
+var applicationInternalServiceAfterUpdateMu sync.Mutex
+var applicationInternalServiceAfterUpdateHooks []ApplicationInternalServiceHook
+
+var applicationInternalServiceBeforeDeleteMu sync.Mutex
+var applicationInternalServiceBeforeDeleteHooks []ApplicationInternalServiceHook
+var applicationInternalServiceAfterDeleteMu sync.Mutex
+var applicationInternalServiceAfterDeleteHooks []ApplicationInternalServiceHook
+
+var applicationInternalServiceBeforeUpsertMu sync.Mutex
+var applicationInternalServiceBeforeUpsertHooks []ApplicationInternalServiceHook
+var applicationInternalServiceAfterUpsertMu sync.Mutex
+var applicationInternalServiceAfterUpsertHooks []ApplicationInternalServiceHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ApplicationInternalService) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ApplicationInternalService) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ApplicationInternalService) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ApplicationInternalService) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ApplicationInternalService) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ApplicationInternalService) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ApplicationInternalService) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ApplicationInternalService) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ApplicationInternalService) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range applicationInternalServiceAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddApplicationInternalServiceHook registers your hook function for all future operations.
+func AddApplicationInternalServiceHook(hookPoint boil.HookPoint, applicationInternalServiceHook ApplicationInternalServiceHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		applicationInternalServiceAfterSelectMu.Lock()
+		applicationInternalServiceAfterSelectHooks = append(applicationInternalServiceAfterSelectHooks, applicationInternalServiceHook)
+		applicationInternalServiceAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		applicationInternalServiceBeforeInsertMu.Lock()
+		applicationInternalServiceBeforeInsertHooks = append(applicationInternalServiceBeforeInsertHooks, applicationInternalServiceHook)
+		applicationInternalServiceBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		applicationInternalServiceAfterInsertMu.Lock()
+		applicationInternalServiceAfterInsertHooks = append(applicationInternalServiceAfterInsertHooks, applicationInternalServiceHook)
+		applicationInternalServiceAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		applicationInternalServiceBeforeUpdateMu.Lock()
+		applicationInternalServiceBeforeUpdateHooks = append(applicationInternalServiceBeforeUpdateHooks, applicationInternalServiceHook)
+		applicationInternalServiceBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		applicationInternalServiceAfterUpdateMu.Lock()
+		applicationInternalServiceAfterUpdateHooks = append(applicationInternalServiceAfterUpdateHooks, applicationInternalServiceHook)
+		applicationInternalServiceAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		applicationInternalServiceBeforeDeleteMu.Lock()
+		applicationInternalServiceBeforeDeleteHooks = append(applicationInternalServiceBeforeDeleteHooks, applicationInternalServiceHook)
+		applicationInternalServiceBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		applicationInternalServiceAfterDeleteMu.Lock()
+		applicationInternalServiceAfterDeleteHooks = append(applicationInternalServiceAfterDeleteHooks, applicationInternalServiceHook)
+		applicationInternalServiceAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		applicationInternalServiceBeforeUpsertMu.Lock()
+		applicationInternalServiceBeforeUpsertHooks = append(applicationInternalServiceBeforeUpsertHooks, applicationInternalServiceHook)
+		applicationInternalServiceBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		applicationInternalServiceAfterUpsertMu.Lock()
+		applicationInternalServiceAfterUpsertHooks = append(applicationInternalServiceAfterUpsertHooks, applicationInternalServiceHook)
+		applicationInternalServiceAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single applicationInternalService record from the query.
+func (q applicationInternalServiceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ApplicationInternalService, error) {
+	o := &ApplicationInternalService{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for application_internal_services")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ApplicationInternalService records from the query.
+func (q applicationInternalServiceQuery) All(ctx context.Context, exec boil.ContextExecutor) (ApplicationInternalServiceSlice, error) {
+	var o []*ApplicationInternalService
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ApplicationInternalService slice")
+	}
+
+	if len(applicationInternalServiceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ApplicationInternalService records in the query.
+func (q applicationInternalServiceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count application_internal_services rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q applicationInternalServiceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if application_internal_services exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *ApplicationInternalService) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (applicationInternalServiceL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplicationInternalService any, mods queries.Applicator) error {
+	var slice []*ApplicationInternalService
+	var object *ApplicationInternalService
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplicationInternalService.(*ApplicationInternalService)
+		if !ok {
+			object = new(ApplicationInternalService)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplicationInternalService)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplicationInternalService))
+			}
+		}
+	} else {
+		s, ok := maybeApplicationInternalService.(*[]*ApplicationInternalService)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplicationInternalService)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplicationInternalService))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationInternalServiceR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationInternalServiceR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.ApplicationInternalService = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.ApplicationInternalService = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the applicationInternalService to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.ApplicationInternalService.
+func (o *ApplicationInternalService) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `application_internal_services` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, applicationInternalServicePrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ApplicationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &applicationInternalServiceR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			ApplicationInternalService: o,
+		}
+	} else {
+		related.R.ApplicationInternalService = o
+	}
+
+	return nil
+}
+
+// ApplicationInternalServices retrieves all the records using an executor.
+func ApplicationInternalServices(mods ...qm.QueryMod) applicationInternalServiceQuery {
+	mods = append(mods, qm.From("`application_internal_services`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`application_internal_services`.*"})
+	}
+
+	return applicationInternalServiceQuery{q}
+}
+
+// FindApplicationInternalService retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindApplicationInternalService(ctx context.Context, exec boil.ContextExecutor, applicationID string, selectCols ...string) (*ApplicationInternalService, error) {
+	applicationInternalServiceObj := &ApplicationInternalService{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `application_internal_services` where `application_id`=?", sel,
+	)
+
+	q := queries.Raw(query, applicationID)
+
+	err := q.Bind(ctx, exec, applicationInternalServiceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from application_internal_services")
+	}
+
+	if err = applicationInternalServiceObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return applicationInternalServiceObj, err
+	}
+
+	return applicationInternalServiceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ApplicationInternalService) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no application_internal_services provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(applicationInternalServiceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	applicationInternalServiceInsertCacheMut.RLock()
+	cache, cached := applicationInternalServiceInsertCache[key]
+	applicationInternalServiceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			applicationInternalServiceAllColumns,
+			applicationInternalServiceColumnsWithDefault,
+			applicationInternalServiceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `application_internal_services` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `application_internal_services` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `application_internal_services` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, applicationInternalServicePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into application_internal_services")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ApplicationID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for application_internal_services")
+	}
+
+CacheNoHooks:
+	if !cached {
+		applicationInternalServiceInsertCacheMut.Lock()
+		applicationInternalServiceInsertCache[key] = cache
+		applicationInternalServiceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ApplicationInternalService.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ApplicationInternalService) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	applicationInternalServiceUpdateCacheMut.RLock()
+	cache, cached := applicationInternalServiceUpdateCache[key]
+	applicationInternalServiceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			applicationInternalServiceAllColumns,
+			applicationInternalServicePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update application_internal_services, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `application_internal_services` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, applicationInternalServicePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, append(wl, applicationInternalServicePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update application_internal_services row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for application_internal_services")
+	}
+
+	if !cached {
+		applicationInternalServiceUpdateCacheMut.Lock()
+		applicationInternalServiceUpdateCache[key] = cache
+		applicationInternalServiceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q applicationInternalServiceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for application_internal_services")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for application_internal_services")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ApplicationInternalServiceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationInternalServicePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `application_internal_services` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationInternalServicePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in applicationInternalService slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all applicationInternalService")
+	}
+	return rowsAff, nil
+}
+
+var mySQLApplicationInternalServiceUniqueColumns = []string{
+	"application_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ApplicationInternalService) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no application_internal_services provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(applicationInternalServiceColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLApplicationInternalServiceUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	applicationInternalServiceUpsertCacheMut.RLock()
+	cache, cached := applicationInternalServiceUpsertCache[key]
+	applicationInternalServiceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			applicationInternalServiceAllColumns,
+			applicationInternalServiceColumnsWithDefault,
+			applicationInternalServiceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			applicationInternalServiceAllColumns,
+			applicationInternalServicePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert application_internal_services, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(applicationInternalServiceAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`application_internal_services`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `application_internal_services` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for application_internal_services")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(applicationInternalServiceType, applicationInternalServiceMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for application_internal_services")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for application_internal_services")
+	}
+
+CacheNoHooks:
+	if !cached {
+		applicationInternalServiceUpsertCacheMut.Lock()
+		applicationInternalServiceUpsertCache[key] = cache
+		applicationInternalServiceUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ApplicationInternalService record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ApplicationInternalService) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ApplicationInternalService provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), applicationInternalServicePrimaryKeyMapping)
+	sql := "DELETE FROM `application_internal_services` WHERE `application_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from application_internal_services")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for application_internal_services")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q applicationInternalServiceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no applicationInternalServiceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from application_internal_services")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for application_internal_services")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ApplicationInternalServiceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(applicationInternalServiceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationInternalServicePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `application_internal_services` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationInternalServicePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from applicationInternalService slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for application_internal_services")
+	}
+
+	if len(applicationInternalServiceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ApplicationInternalService) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindApplicationInternalService(ctx, exec, o.ApplicationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ApplicationInternalServiceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ApplicationInternalServiceSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), applicationInternalServicePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `application_internal_services`.* FROM `application_internal_services` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, applicationInternalServicePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ApplicationInternalServiceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ApplicationInternalServiceExists checks if the ApplicationInternalService row exists.
+func ApplicationInternalServiceExists(ctx context.Context, exec boil.ContextExecutor, applicationID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `application_internal_services` where `application_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, applicationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, applicationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if application_internal_services exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ApplicationInternalService row exists.
+func (o *ApplicationInternalService) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ApplicationInternalServiceExists(ctx, exec, o.ApplicationID)
+}
@@ -47,6 +47,10 @@ type Application struct {
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	// 更新日時
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	// リポジトリ内の設定ファイルのパス(空の場合はneoshowcase.yaml)
+	ConfigFilePath string `boil:"config_file_path" json:"config_file_path" toml:"config_file_path" yaml:"config_file_path"`
+	// 設定ファイルの適用エラー
+	ConfigFileError string `boil:"config_file_error" json:"config_file_error" toml:"config_file_error" yaml:"config_file_error"`
 
 	R *applicationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L applicationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -65,6 +69,8 @@ var ApplicationColumns = struct {
 	CurrentBuild     string
 	CreatedAt        string
 	UpdatedAt        string
+	ConfigFilePath   string
+	ConfigFileError  string
 }{
 	ID:               "id",
 	Name:             "name",
@@ -78,6 +84,8 @@ var ApplicationColumns = struct {
 	CurrentBuild:     "current_build",
 	CreatedAt:        "created_at",
 	UpdatedAt:        "updated_at",
+	ConfigFilePath:   "config_file_path",
+	ConfigFileError:  "config_file_error",
 }
 
 var ApplicationTableColumns = struct {
@@ -93,6 +101,8 @@ var ApplicationTableColumns = struct {
 	CurrentBuild     string
 	CreatedAt        string
 	UpdatedAt        string
+	ConfigFilePath   string
+	ConfigFileError  string
 }{
 	ID:               "applications.id",
 	Name:             "applications.name",
@@ -106,31 +116,12 @@ var ApplicationTableColumns = struct {
 	CurrentBuild:     "applications.current_build",
 	CreatedAt:        "applications.created_at",
 	UpdatedAt:        "applications.updated_at",
+	ConfigFilePath:   "applications.config_file_path",
+	ConfigFileError:  "applications.config_file_error",
 }
 
 // Generated where
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var ApplicationWhere = struct {
 	ID               whereHelperstring
 	Name             whereHelperstring
@@ -144,6 +135,8 @@ var ApplicationWhere = struct {
 	CurrentBuild     whereHelperstring
 	CreatedAt        whereHelpertime_Time
 	UpdatedAt        whereHelpertime_Time
+	ConfigFilePath   whereHelperstring
+	ConfigFileError  whereHelperstring
 }{
 	ID:               whereHelperstring{field: "`applications`.`id`"},
 	Name:             whereHelperstring{field: "`applications`.`name`"},
@@ -157,36 +150,59 @@ var ApplicationWhere = struct {
 	CurrentBuild:     whereHelperstring{field: "`applications`.`current_build`"},
 	CreatedAt:        whereHelpertime_Time{field: "`applications`.`created_at`"},
 	UpdatedAt:        whereHelpertime_Time{field: "`applications`.`updated_at`"},
+	ConfigFilePath:   whereHelperstring{field: "`applications`.`config_file_path`"},
+	ConfigFileError:  whereHelperstring{field: "`applications`.`config_file_error`"},
 }
 
 // ApplicationRels is where relationship names are stored.
 var ApplicationRels = struct {
-	Repository        string
-	ApplicationConfig string
-	Users             string
-	Builds            string
-	Environments      string
-	PortPublications  string
-	Websites          string
+	Repository                            string
+	ApplicationConfig                     string
+	ApplicationInternalService            string
+	AlertRules                            string
+	Alerts                                string
+	ApplicationEvents                     string
+	ApplicationInternalServiceAllowedApps string
+	Users                                 string
+	Builds                                string
+	Environments                          string
+	NotificationSubscriptions             string
+	PortPublications                      string
+	WebsiteProbes                         string
+	Websites                              string
 }{
-	Repository:        "Repository",
-	ApplicationConfig: "ApplicationConfig",
-	Users:             "Users",
-	Builds:            "Builds",
-	Environments:      "Environments",
-	PortPublications:  "PortPublications",
-	Websites:          "Websites",
+	Repository:                            "Repository",
+	ApplicationConfig:                     "ApplicationConfig",
+	ApplicationInternalService:            "ApplicationInternalService",
+	AlertRules:                            "AlertRules",
+	Alerts:                                "Alerts",
+	ApplicationEvents:                     "ApplicationEvents",
+	ApplicationInternalServiceAllowedApps: "ApplicationInternalServiceAllowedApps",
+	Users:                                 "Users",
+	Builds:                                "Builds",
+	Environments:                          "Environments",
+	NotificationSubscriptions:             "NotificationSubscriptions",
+	PortPublications:                      "PortPublications",
+	WebsiteProbes:                         "WebsiteProbes",
+	Websites:                              "Websites",
 }
 
 // applicationR is where relationships are stored.
 type applicationR struct {
-	Repository        *Repository          `boil:"Repository" json:"Repository" toml:"Repository" yaml:"Repository"`
-	ApplicationConfig *ApplicationConfig   `boil:"ApplicationConfig" json:"ApplicationConfig" toml:"ApplicationConfig" yaml:"ApplicationConfig"`
-	Users             UserSlice            `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
-	Builds            BuildSlice           `boil:"Builds" json:"Builds" toml:"Builds" yaml:"Builds"`
-	Environments      EnvironmentSlice     `boil:"Environments" json:"Environments" toml:"Environments" yaml:"Environments"`
-	PortPublications  PortPublicationSlice `boil:"PortPublications" json:"PortPublications" toml:"PortPublications" yaml:"PortPublications"`
-	Websites          WebsiteSlice         `boil:"Websites" json:"Websites" toml:"Websites" yaml:"Websites"`
+	Repository                            *Repository                               `boil:"Repository" json:"Repository" toml:"Repository" yaml:"Repository"`
+	ApplicationConfig                     *ApplicationConfig                        `boil:"ApplicationConfig" json:"ApplicationConfig" toml:"ApplicationConfig" yaml:"ApplicationConfig"`
+	ApplicationInternalService            *ApplicationInternalService               `boil:"ApplicationInternalService" json:"ApplicationInternalService" toml:"ApplicationInternalService" yaml:"ApplicationInternalService"`
+	AlertRules                            AlertRuleSlice                            `boil:"AlertRules" json:"AlertRules" toml:"AlertRules" yaml:"AlertRules"`
+	Alerts                                AlertSlice                                `boil:"Alerts" json:"Alerts" toml:"Alerts" yaml:"Alerts"`
+	ApplicationEvents                     ApplicationEventSlice                     `boil:"ApplicationEvents" json:"ApplicationEvents" toml:"ApplicationEvents" yaml:"ApplicationEvents"`
+	ApplicationInternalServiceAllowedApps ApplicationInternalServiceAllowedAppSlice `boil:"ApplicationInternalServiceAllowedApps" json:"ApplicationInternalServiceAllowedApps" toml:"ApplicationInternalServiceAllowedApps" yaml:"ApplicationInternalServiceAllowedApps"`
+	Users                                 UserSlice                                 `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	Builds                                BuildSlice                                `boil:"Builds" json:"Builds" toml:"Builds" yaml:"Builds"`
+	Environments                          EnvironmentSlice                          `boil:"Environments" json:"Environments" toml:"Environments" yaml:"Environments"`
+	NotificationSubscriptions             NotificationSubscriptionSlice             `boil:"NotificationSubscriptions" json:"NotificationSubscriptions" toml:"NotificationSubscriptions" yaml:"NotificationSubscriptions"`
+	PortPublications                      PortPublicationSlice                      `boil:"PortPublications" json:"PortPublications" toml:"PortPublications" yaml:"PortPublications"`
+	WebsiteProbes                         WebsiteProbeSlice                         `boil:"WebsiteProbes" json:"WebsiteProbes" toml:"WebsiteProbes" yaml:"WebsiteProbes"`
+	Websites                              WebsiteSlice                              `boil:"Websites" json:"Websites" toml:"Websites" yaml:"Websites"`
 }
 
 // NewStruct creates a new relationship struct
@@ -226,6 +242,86 @@ func (r *applicationR) GetApplicationConfig() *ApplicationConfig {
 	return r.ApplicationConfig
 }
 
+func (o *Application) GetApplicationInternalService() *ApplicationInternalService {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplicationInternalService()
+}
+
+func (r *applicationR) GetApplicationInternalService() *ApplicationInternalService {
+	if r == nil {
+		return nil
+	}
+
+	return r.ApplicationInternalService
+}
+
+func (o *Application) GetAlertRules() AlertRuleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAlertRules()
+}
+
+func (r *applicationR) GetAlertRules() AlertRuleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AlertRules
+}
+
+func (o *Application) GetAlerts() AlertSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAlerts()
+}
+
+func (r *applicationR) GetAlerts() AlertSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Alerts
+}
+
+func (o *Application) GetApplicationEvents() ApplicationEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplicationEvents()
+}
+
+func (r *applicationR) GetApplicationEvents() ApplicationEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ApplicationEvents
+}
+
+func (o *Application) GetApplicationInternalServiceAllowedApps() ApplicationInternalServiceAllowedAppSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplicationInternalServiceAllowedApps()
+}
+
+func (r *applicationR) GetApplicationInternalServiceAllowedApps() ApplicationInternalServiceAllowedAppSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ApplicationInternalServiceAllowedApps
+}
+
 func (o *Application) GetUsers() UserSlice {
 	if o == nil {
 		return nil
@@ -274,6 +370,22 @@ func (r *applicationR) GetEnvironments() EnvironmentSlice {
 	return r.Environments
 }
 
+func (o *Application) GetNotificationSubscriptions() NotificationSubscriptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotificationSubscriptions()
+}
+
+func (r *applicationR) GetNotificationSubscriptions() NotificationSubscriptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.NotificationSubscriptions
+}
+
 func (o *Application) GetPortPublications() PortPublicationSlice {
 	if o == nil {
 		return nil
@@ -290,6 +402,22 @@ func (r *applicationR) GetPortPublications() PortPublicationSlice {
 	return r.PortPublications
 }
 
+func (o *Application) GetWebsiteProbes() WebsiteProbeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteProbes()
+}
+
+func (r *applicationR) GetWebsiteProbes() WebsiteProbeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteProbes
+}
+
 func (o *Application) GetWebsites() WebsiteSlice {
 	if o == nil {
 		return nil
@@ -310,8 +438,8 @@ func (r *applicationR) GetWebsites() WebsiteSlice {
 type applicationL struct{}
 
 var (
-	applicationAllColumns            = []string{"id", "name", "repository_id", "ref_name", "commit", "deploy_type", "running", "container", "container_message", "current_build", "created_at", "updated_at"}
-	applicationColumnsWithoutDefault = []string{"id", "name", "repository_id", "ref_name", "commit", "deploy_type", "running", "container", "container_message", "current_build", "created_at", "updated_at"}
+	applicationAllColumns            = []string{"id", "name", "repository_id", "ref_name", "commit", "deploy_type", "running", "container", "container_message", "current_build", "created_at", "updated_at", "config_file_path", "config_file_error"}
+	applicationColumnsWithoutDefault = []string{"id", "name", "repository_id", "ref_name", "commit", "deploy_type", "running", "container", "container_message", "current_build", "created_at", "updated_at", "config_file_path", "config_file_error"}
 	applicationColumnsWithDefault    = []string{}
 	applicationPrimaryKeyColumns     = []string{"id"}
 	applicationGeneratedColumns      = []string{}
@@ -644,6 +772,73 @@ func (o *Application) ApplicationConfig(mods ...qm.QueryMod) applicationConfigQu
 	return ApplicationConfigs(queryMods...)
 }
 
+// ApplicationInternalService pointed to by the foreign key.
+func (o *Application) ApplicationInternalService(mods ...qm.QueryMod) applicationInternalServiceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`application_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ApplicationInternalServices(queryMods...)
+}
+
+// AlertRules retrieves all the alert_rule's AlertRules with an executor.
+func (o *Application) AlertRules(mods ...qm.QueryMod) alertRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`alert_rules`.`application_id`=?", o.ID),
+	)
+
+	return AlertRules(queryMods...)
+}
+
+// Alerts retrieves all the alert's Alerts with an executor.
+func (o *Application) Alerts(mods ...qm.QueryMod) alertQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`alerts`.`application_id`=?", o.ID),
+	)
+
+	return Alerts(queryMods...)
+}
+
+// ApplicationEvents retrieves all the application_event's ApplicationEvents with an executor.
+func (o *Application) ApplicationEvents(mods ...qm.QueryMod) applicationEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`application_events`.`application_id`=?", o.ID),
+	)
+
+	return ApplicationEvents(queryMods...)
+}
+
+// ApplicationInternalServiceAllowedApps retrieves all the application_internal_service_allowed_app's ApplicationInternalServiceAllowedApps with an executor.
+func (o *Application) ApplicationInternalServiceAllowedApps(mods ...qm.QueryMod) applicationInternalServiceAllowedAppQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`application_internal_service_allowed_apps`.`application_id`=?", o.ID),
+	)
+
+	return ApplicationInternalServiceAllowedApps(queryMods...)
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Application) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -687,6 +882,20 @@ func (o *Application) Environments(mods ...qm.QueryMod) environmentQuery {
 	return Environments(queryMods...)
 }
 
+// NotificationSubscriptions retrieves all the notification_subscription's NotificationSubscriptions with an executor.
+func (o *Application) NotificationSubscriptions(mods ...qm.QueryMod) notificationSubscriptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notification_subscriptions`.`application_id`=?", o.ID),
+	)
+
+	return NotificationSubscriptions(queryMods...)
+}
+
 // PortPublications retrieves all the port_publication's PortPublications with an executor.
 func (o *Application) PortPublications(mods ...qm.QueryMod) portPublicationQuery {
 	var queryMods []qm.QueryMod
@@ -701,6 +910,20 @@ func (o *Application) PortPublications(mods ...qm.QueryMod) portPublicationQuery
 	return PortPublications(queryMods...)
 }
 
+// WebsiteProbes retrieves all the website_probe's WebsiteProbes with an executor.
+func (o *Application) WebsiteProbes(mods ...qm.QueryMod) websiteProbeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`website_probes`.`application_id`=?", o.ID),
+	)
+
+	return WebsiteProbes(queryMods...)
+}
+
 // Websites retrieves all the website's Websites with an executor.
 func (o *Application) Websites(mods ...qm.QueryMod) websiteQuery {
 	var queryMods []qm.QueryMod
@@ -952,9 +1175,9 @@ func (applicationL) LoadApplicationConfig(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// LoadUsers allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (applicationL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+// LoadApplicationInternalService allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (applicationL) LoadApplicationInternalService(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
 	var slice []*Application
 	var object *Application
 
@@ -991,6 +1214,7 @@ func (applicationL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singu
 			if obj.R == nil {
 				obj.R = &applicationR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1007,10 +1231,8 @@ func (applicationL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.Select("`users`.`id`, `users`.`name`, `users`.`admin`, `a`.`application_id`"),
-		qm.From("`users`"),
-		qm.InnerJoin("`application_owners` as `a` on `users`.`id` = `a`.`user_id`"),
-		qm.WhereIn("`a`.`application_id` in ?", argsSlice...),
+		qm.From(`application_internal_services`),
+		qm.WhereIn(`application_internal_services.application_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1018,62 +1240,50 @@ func (applicationL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
+		return errors.Wrap(err, "failed to eager load ApplicationInternalService")
 	}
 
-	var resultSlice []*User
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(User)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.Name, &one.Admin, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for users")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice users")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*ApplicationInternalService
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ApplicationInternalService")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
+		return errors.Wrap(err, "failed to close results of eager load for application_internal_services")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for application_internal_services")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(applicationInternalServiceAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Users = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &userR{}
-			}
-			foreign.R.Applications = append(foreign.R.Applications, object)
+		foreign := resultSlice[0]
+		object.R.ApplicationInternalService = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationInternalServiceR{}
 		}
-		return nil
+		foreign.R.Application = object
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
-		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Users = append(local.R.Users, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ApplicationID {
+				local.R.ApplicationInternalService = foreign
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &applicationInternalServiceR{}
 				}
-				foreign.R.Applications = append(foreign.R.Applications, local)
+				foreign.R.Application = local
 				break
 			}
 		}
@@ -1082,9 +1292,9 @@ func (applicationL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadBuilds allows an eager lookup of values, cached into the
+// LoadAlertRules allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+func (applicationL) LoadAlertRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
 	var slice []*Application
 	var object *Application
 
@@ -1137,8 +1347,8 @@ func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`builds`),
-		qm.WhereIn(`builds.application_id in ?`, argsSlice...),
+		qm.From(`alert_rules`),
+		qm.WhereIn(`alert_rules.application_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1146,22 +1356,22 @@ func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load builds")
+		return errors.Wrap(err, "failed to eager load alert_rules")
 	}
 
-	var resultSlice []*Build
+	var resultSlice []*AlertRule
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice builds")
+		return errors.Wrap(err, "failed to bind eager loaded slice alert_rules")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on builds")
+		return errors.Wrap(err, "failed to close results in eager load on alert_rules")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for builds")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for alert_rules")
 	}
 
-	if len(buildAfterSelectHooks) != 0 {
+	if len(alertRuleAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1169,10 +1379,10 @@ func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.Builds = resultSlice
+		object.R.AlertRules = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &buildR{}
+				foreign.R = &alertRuleR{}
 			}
 			foreign.R.Application = object
 		}
@@ -1182,9 +1392,9 @@ func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ApplicationID {
-				local.R.Builds = append(local.R.Builds, foreign)
+				local.R.AlertRules = append(local.R.AlertRules, foreign)
 				if foreign.R == nil {
-					foreign.R = &buildR{}
+					foreign.R = &alertRuleR{}
 				}
 				foreign.R.Application = local
 				break
@@ -1195,9 +1405,9 @@ func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadEnvironments allows an eager lookup of values, cached into the
+// LoadAlerts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+func (applicationL) LoadAlerts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
 	var slice []*Application
 	var object *Application
 
@@ -1250,8 +1460,8 @@ func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`environments`),
-		qm.WhereIn(`environments.application_id in ?`, argsSlice...),
+		qm.From(`alerts`),
+		qm.WhereIn(`alerts.application_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1259,22 +1469,22 @@ func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load environments")
+		return errors.Wrap(err, "failed to eager load alerts")
 	}
 
-	var resultSlice []*Environment
+	var resultSlice []*Alert
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice environments")
+		return errors.Wrap(err, "failed to bind eager loaded slice alerts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on environments")
+		return errors.Wrap(err, "failed to close results in eager load on alerts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for environments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for alerts")
 	}
 
-	if len(environmentAfterSelectHooks) != 0 {
+	if len(alertAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1282,10 +1492,10 @@ func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor
 		}
 	}
 	if singular {
-		object.R.Environments = resultSlice
+		object.R.Alerts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &environmentR{}
+				foreign.R = &alertR{}
 			}
 			foreign.R.Application = object
 		}
@@ -1295,9 +1505,9 @@ func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ApplicationID {
-				local.R.Environments = append(local.R.Environments, foreign)
+				local.R.Alerts = append(local.R.Alerts, foreign)
 				if foreign.R == nil {
-					foreign.R = &environmentR{}
+					foreign.R = &alertR{}
 				}
 				foreign.R.Application = local
 				break
@@ -1308,9 +1518,9 @@ func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadPortPublications allows an eager lookup of values, cached into the
+// LoadApplicationEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (applicationL) LoadPortPublications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+func (applicationL) LoadApplicationEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
 	var slice []*Application
 	var object *Application
 
@@ -1363,8 +1573,8 @@ func (applicationL) LoadPortPublications(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`port_publications`),
-		qm.WhereIn(`port_publications.application_id in ?`, argsSlice...),
+		qm.From(`application_events`),
+		qm.WhereIn(`application_events.application_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1372,22 +1582,22 @@ func (applicationL) LoadPortPublications(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load port_publications")
+		return errors.Wrap(err, "failed to eager load application_events")
 	}
 
-	var resultSlice []*PortPublication
+	var resultSlice []*ApplicationEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice port_publications")
+		return errors.Wrap(err, "failed to bind eager loaded slice application_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on port_publications")
+		return errors.Wrap(err, "failed to close results in eager load on application_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for port_publications")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for application_events")
 	}
 
-	if len(portPublicationAfterSelectHooks) != 0 {
+	if len(applicationEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1395,7 +1605,702 @@ func (applicationL) LoadPortPublications(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.PortPublications = resultSlice
+		object.R.ApplicationEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &applicationEventR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.ApplicationEvents = append(local.R.ApplicationEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &applicationEventR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApplicationInternalServiceAllowedApps allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadApplicationInternalServiceAllowedApps(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`application_internal_service_allowed_apps`),
+		qm.WhereIn(`application_internal_service_allowed_apps.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load application_internal_service_allowed_apps")
+	}
+
+	var resultSlice []*ApplicationInternalServiceAllowedApp
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice application_internal_service_allowed_apps")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on application_internal_service_allowed_apps")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for application_internal_service_allowed_apps")
+	}
+
+	if len(applicationInternalServiceAllowedAppAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ApplicationInternalServiceAllowedApps = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &applicationInternalServiceAllowedAppR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.ApplicationInternalServiceAllowedApps = append(local.R.ApplicationInternalServiceAllowedApps, foreign)
+				if foreign.R == nil {
+					foreign.R = &applicationInternalServiceAllowedAppR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("`users`.`id`, `users`.`name`, `users`.`admin`, `a`.`application_id`"),
+		qm.From("`users`"),
+		qm.InnerJoin("`application_owners` as `a` on `users`.`id` = `a`.`user_id`"),
+		qm.WhereIn("`a`.`application_id` in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(User)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.Admin, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for users")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice users")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Users = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.Applications = append(foreign.R.Applications, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Users = append(local.R.Users, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Applications = append(foreign.R.Applications, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBuilds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadBuilds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`builds`),
+		qm.WhereIn(`builds.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load builds")
+	}
+
+	var resultSlice []*Build
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice builds")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on builds")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for builds")
+	}
+
+	if len(buildAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Builds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &buildR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.Builds = append(local.R.Builds, foreign)
+				if foreign.R == nil {
+					foreign.R = &buildR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEnvironments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadEnvironments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`environments`),
+		qm.WhereIn(`environments.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load environments")
+	}
+
+	var resultSlice []*Environment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice environments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on environments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for environments")
+	}
+
+	if len(environmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Environments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &environmentR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.Environments = append(local.R.Environments, foreign)
+				if foreign.R == nil {
+					foreign.R = &environmentR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationSubscriptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadNotificationSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_subscriptions`),
+		qm.WhereIn(`notification_subscriptions.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_subscriptions")
+	}
+
+	var resultSlice []*NotificationSubscription
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_subscriptions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_subscriptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_subscriptions")
+	}
+
+	if len(notificationSubscriptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationSubscriptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationSubscriptionR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.NotificationSubscriptions = append(local.R.NotificationSubscriptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationSubscriptionR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPortPublications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadPortPublications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`port_publications`),
+		qm.WhereIn(`port_publications.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load port_publications")
+	}
+
+	var resultSlice []*PortPublication
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice port_publications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on port_publications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for port_publications")
+	}
+
+	if len(portPublicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PortPublications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
 				foreign.R = &portPublicationR{}
@@ -1408,9 +2313,122 @@ func (applicationL) LoadPortPublications(ctx context.Context, e boil.ContextExec
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ApplicationID {
-				local.R.PortPublications = append(local.R.PortPublications, foreign)
+				local.R.PortPublications = append(local.R.PortPublications, foreign)
+				if foreign.R == nil {
+					foreign.R = &portPublicationR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteProbes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadWebsiteProbes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication any, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_probes`),
+		qm.WhereIn(`website_probes.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_probes")
+	}
+
+	var resultSlice []*WebsiteProbe
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_probes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_probes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_probes")
+	}
+
+	if len(websiteProbeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteProbes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteProbeR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.WebsiteProbes = append(local.R.WebsiteProbes, foreign)
 				if foreign.R == nil {
-					foreign.R = &portPublicationR{}
+					foreign.R = &websiteProbeR{}
 				}
 				foreign.R.Application = local
 				break
@@ -1500,133 +2518,395 @@ func (applicationL) LoadWebsites(ctx context.Context, e boil.ContextExecutor, si
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
 	}
 
-	if len(websiteAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Websites = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ApplicationID {
+				local.R.Websites = append(local.R.Websites, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRepository of the application to the related item.
+// Sets o.R.Repository to related.
+// Adds o to related.R.Applications.
+func (o *Application) SetRepository(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Repository) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `applications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"repository_id"}),
+		strmangle.WhereClause("`", "`", 0, applicationPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RepositoryID = related.ID
+	if o.R == nil {
+		o.R = &applicationR{
+			Repository: related,
+		}
+	} else {
+		o.R.Repository = related
+	}
+
+	if related.R == nil {
+		related.R = &repositoryR{
+			Applications: ApplicationSlice{o},
+		}
+	} else {
+		related.R.Applications = append(related.R.Applications, o)
+	}
+
+	return nil
+}
+
+// SetApplicationConfig of the application to the related item.
+// Sets o.R.ApplicationConfig to related.
+// Adds o to related.R.Application.
+func (o *Application) SetApplicationConfig(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ApplicationConfig) error {
+	var err error
+
+	if insert {
+		related.ApplicationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `application_config` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+			strmangle.WhereClause("`", "`", 0, applicationConfigPrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.ApplicationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ApplicationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &applicationR{
+			ApplicationConfig: related,
+		}
+	} else {
+		o.R.ApplicationConfig = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationConfigR{
+			Application: o,
+		}
+	} else {
+		related.R.Application = o
+	}
+	return nil
+}
+
+// SetApplicationInternalService of the application to the related item.
+// Sets o.R.ApplicationInternalService to related.
+// Adds o to related.R.Application.
+func (o *Application) SetApplicationInternalService(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ApplicationInternalService) error {
+	var err error
+
+	if insert {
+		related.ApplicationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `application_internal_services` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+			strmangle.WhereClause("`", "`", 0, applicationInternalServicePrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.ApplicationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ApplicationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &applicationR{
+			ApplicationInternalService: related,
+		}
+	} else {
+		o.R.ApplicationInternalService = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationInternalServiceR{
+			Application: o,
+		}
+	} else {
+		related.R.Application = o
+	}
+	return nil
+}
+
+// AddAlertRules adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.AlertRules.
+// Sets related.R.Application appropriately.
+func (o *Application) AddAlertRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AlertRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ApplicationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `alert_rules` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+				strmangle.WhereClause("`", "`", 0, alertRulePrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ApplicationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &applicationR{
+			AlertRules: related,
+		}
+	} else {
+		o.R.AlertRules = append(o.R.AlertRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &alertRuleR{
+				Application: o,
+			}
+		} else {
+			rel.R.Application = o
+		}
+	}
+	return nil
+}
+
+// AddAlerts adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.Alerts.
+// Sets related.R.Application appropriately.
+func (o *Application) AddAlerts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Alert) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ApplicationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `alerts` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+				strmangle.WhereClause("`", "`", 0, alertPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			rel.ApplicationID = o.ID
 		}
 	}
-	if singular {
-		object.R.Websites = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &websiteR{}
-			}
-			foreign.R.Application = object
+
+	if o.R == nil {
+		o.R = &applicationR{
+			Alerts: related,
 		}
-		return nil
+	} else {
+		o.R.Alerts = append(o.R.Alerts, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.ApplicationID {
-				local.R.Websites = append(local.R.Websites, foreign)
-				if foreign.R == nil {
-					foreign.R = &websiteR{}
-				}
-				foreign.R.Application = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &alertR{
+				Application: o,
 			}
+		} else {
+			rel.R.Application = o
 		}
 	}
-
 	return nil
 }
 
-// SetRepository of the application to the related item.
-// Sets o.R.Repository to related.
-// Adds o to related.R.Applications.
-func (o *Application) SetRepository(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Repository) error {
+// AddApplicationEvents adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.ApplicationEvents.
+// Sets related.R.Application appropriately.
+func (o *Application) AddApplicationEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ApplicationEvent) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.ApplicationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `application_events` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+				strmangle.WhereClause("`", "`", 0, applicationEventPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `applications` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"repository_id"}),
-		strmangle.WhereClause("`", "`", 0, applicationPrimaryKeyColumns),
-	)
-	values := []any{related.ID, o.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.ApplicationID = o.ID
+		}
 	}
 
-	o.RepositoryID = related.ID
 	if o.R == nil {
 		o.R = &applicationR{
-			Repository: related,
+			ApplicationEvents: related,
 		}
 	} else {
-		o.R.Repository = related
+		o.R.ApplicationEvents = append(o.R.ApplicationEvents, related...)
 	}
 
-	if related.R == nil {
-		related.R = &repositoryR{
-			Applications: ApplicationSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &applicationEventR{
+				Application: o,
+			}
+		} else {
+			rel.R.Application = o
 		}
-	} else {
-		related.R.Applications = append(related.R.Applications, o)
 	}
-
 	return nil
 }
 
-// SetApplicationConfig of the application to the related item.
-// Sets o.R.ApplicationConfig to related.
-// Adds o to related.R.Application.
-func (o *Application) SetApplicationConfig(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ApplicationConfig) error {
+// AddApplicationInternalServiceAllowedApps adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.ApplicationInternalServiceAllowedApps.
+// Sets related.R.Application appropriately.
+func (o *Application) AddApplicationInternalServiceAllowedApps(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ApplicationInternalServiceAllowedApp) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ApplicationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `application_internal_service_allowed_apps` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+				strmangle.WhereClause("`", "`", 0, applicationInternalServiceAllowedAppPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ApplicationID, rel.AllowedApplicationID}
 
-	if insert {
-		related.ApplicationID = o.ID
-
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE `application_config` SET %s WHERE %s",
-			strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
-			strmangle.WhereClause("`", "`", 0, applicationConfigPrimaryKeyColumns),
-		)
-		values := []any{o.ID, related.ApplicationID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
-		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+			rel.ApplicationID = o.ID
 		}
-
-		related.ApplicationID = o.ID
 	}
 
 	if o.R == nil {
 		o.R = &applicationR{
-			ApplicationConfig: related,
+			ApplicationInternalServiceAllowedApps: related,
 		}
 	} else {
-		o.R.ApplicationConfig = related
+		o.R.ApplicationInternalServiceAllowedApps = append(o.R.ApplicationInternalServiceAllowedApps, related...)
 	}
 
-	if related.R == nil {
-		related.R = &applicationConfigR{
-			Application: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &applicationInternalServiceAllowedAppR{
+				Application: o,
+			}
+		} else {
+			rel.R.Application = o
 		}
-	} else {
-		related.R.Application = o
 	}
 	return nil
 }
@@ -1882,6 +3162,59 @@ func (o *Application) AddEnvironments(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
+// AddNotificationSubscriptions adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.NotificationSubscriptions.
+// Sets related.R.Application appropriately.
+func (o *Application) AddNotificationSubscriptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationSubscription) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ApplicationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notification_subscriptions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationSubscriptionPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ApplicationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &applicationR{
+			NotificationSubscriptions: related,
+		}
+	} else {
+		o.R.NotificationSubscriptions = append(o.R.NotificationSubscriptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationSubscriptionR{
+				Application: o,
+			}
+		} else {
+			rel.R.Application = o
+		}
+	}
+	return nil
+}
+
 // AddPortPublications adds the given related objects to the existing relationships
 // of the application, optionally inserting them as new records.
 // Appends related to o.R.PortPublications.
@@ -1935,6 +3268,59 @@ func (o *Application) AddPortPublications(ctx context.Context, exec boil.Context
 	return nil
 }
 
+// AddWebsiteProbes adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.WebsiteProbes.
+// Sets related.R.Application appropriately.
+func (o *Application) AddWebsiteProbes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteProbe) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ApplicationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `website_probes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+				strmangle.WhereClause("`", "`", 0, websiteProbePrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ApplicationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &applicationR{
+			WebsiteProbes: related,
+		}
+	} else {
+		o.R.WebsiteProbes = append(o.R.WebsiteProbes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteProbeR{
+				Application: o,
+			}
+		} else {
+			rel.R.Application = o
+		}
+	}
+	return nil
+}
+
 // AddWebsites adds the given related objects to the existing relationships
 // of the application, optionally inserting them as new records.
 // Appends related to o.R.Websites.
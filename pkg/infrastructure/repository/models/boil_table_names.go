@@ -4,35 +4,69 @@
 package models
 
 var TableNames = struct {
-	ApplicationConfig string
-	ApplicationOwners string
-	Applications      string
-	Artifacts         string
-	Builds            string
-	Environments      string
-	PortPublications  string
-	Repositories      string
-	RepositoryAuth    string
-	RepositoryCommits string
-	RepositoryOwners  string
-	RuntimeImages     string
-	UserKeys          string
-	Users             string
-	Websites          string
+	AlertRules                            string
+	Alerts                                string
+	ApplicationConfig                     string
+	ApplicationEvents                     string
+	ApplicationInternalServiceAllowedApps string
+	ApplicationInternalServices           string
+	ApplicationOwners                     string
+	Applications                          string
+	Artifacts                             string
+	Builds                                string
+	CustomDomains                         string
+	Environments                          string
+	NotificationSubscriptions             string
+	PortPublications                      string
+	Repositories                          string
+	RepositoryAuth                        string
+	RepositoryCommits                     string
+	RepositoryOwners                      string
+	RuntimeImages                         string
+	TLSCertificates                       string
+	UserKeys                              string
+	UserResourceLimits                    string
+	Users                                 string
+	WebsiteBackends                       string
+	WebsiteBasicAuthUsers                 string
+	WebsiteCorsPolicies                   string
+	WebsiteHeaders                        string
+	WebsiteIPAllowlistEntries             string
+	WebsiteProbes                         string
+	WebsiteRateLimits                     string
+	WebsiteRules                          string
+	Websites                              string
 }{
-	ApplicationConfig: "application_config",
-	ApplicationOwners: "application_owners",
-	Applications:      "applications",
-	Artifacts:         "artifacts",
-	Builds:            "builds",
-	Environments:      "environments",
-	PortPublications:  "port_publications",
-	Repositories:      "repositories",
-	RepositoryAuth:    "repository_auth",
-	RepositoryCommits: "repository_commits",
-	RepositoryOwners:  "repository_owners",
-	RuntimeImages:     "runtime_images",
-	UserKeys:          "user_keys",
-	Users:             "users",
-	Websites:          "websites",
+	AlertRules:                            "alert_rules",
+	Alerts:                                "alerts",
+	ApplicationConfig:                     "application_config",
+	ApplicationEvents:                     "application_events",
+	ApplicationInternalServiceAllowedApps: "application_internal_service_allowed_apps",
+	ApplicationInternalServices:           "application_internal_services",
+	ApplicationOwners:                     "application_owners",
+	Applications:                          "applications",
+	Artifacts:                             "artifacts",
+	Builds:                                "builds",
+	CustomDomains:                         "custom_domains",
+	Environments:                          "environments",
+	NotificationSubscriptions:             "notification_subscriptions",
+	PortPublications:                      "port_publications",
+	Repositories:                          "repositories",
+	RepositoryAuth:                        "repository_auth",
+	RepositoryCommits:                     "repository_commits",
+	RepositoryOwners:                      "repository_owners",
+	RuntimeImages:                         "runtime_images",
+	TLSCertificates:                       "tls_certificates",
+	UserKeys:                              "user_keys",
+	UserResourceLimits:                    "user_resource_limits",
+	Users:                                 "users",
+	WebsiteBackends:                       "website_backends",
+	WebsiteBasicAuthUsers:                 "website_basic_auth_users",
+	WebsiteCorsPolicies:                   "website_cors_policies",
+	WebsiteHeaders:                        "website_headers",
+	WebsiteIPAllowlistEntries:             "website_ip_allowlist_entries",
+	WebsiteProbes:                         "website_probes",
+	WebsiteRateLimits:                     "website_rate_limits",
+	WebsiteRules:                          "website_rules",
+	Websites:                              "websites",
 }
@@ -51,6 +51,45 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for AlertRulesKind
+const (
+	AlertRulesKindMetric string = "metric"
+	AlertRulesKindNoLog  string = "no_log"
+)
+
+func AllAlertRulesKind() []string {
+	return []string{
+		AlertRulesKindMetric,
+		AlertRulesKindNoLog,
+	}
+}
+
+// Enum values for AlertRulesComparison
+const (
+	AlertRulesComparisonAbove string = "above"
+	AlertRulesComparisonBelow string = "below"
+)
+
+func AllAlertRulesComparison() []string {
+	return []string{
+		AlertRulesComparisonAbove,
+		AlertRulesComparisonBelow,
+	}
+}
+
+// Enum values for AlertRulesThresholdUnit
+const (
+	AlertRulesThresholdUnitValue        string = "value"
+	AlertRulesThresholdUnitLimitPercent string = "limit_percent"
+)
+
+func AllAlertRulesThresholdUnit() []string {
+	return []string{
+		AlertRulesThresholdUnitValue,
+		AlertRulesThresholdUnitLimitPercent,
+	}
+}
+
 // Enum values for ApplicationConfigStartupBehavior
 const (
 	ApplicationConfigStartupBehaviorLoadingPage string = "loading-page"
@@ -85,6 +124,29 @@ func AllApplicationConfigBuildType() []string {
 	}
 }
 
+// Enum values for ApplicationEventsType
+const (
+	ApplicationEventsTypeContainerState string = "container_state"
+	ApplicationEventsTypeBuildStarted   string = "build_started"
+	ApplicationEventsTypeBuildFinished  string = "build_finished"
+	ApplicationEventsTypeDeployed       string = "deployed"
+	ApplicationEventsTypeConfigChanged  string = "config_changed"
+	ApplicationEventsTypeStarted        string = "started"
+	ApplicationEventsTypeStopped        string = "stopped"
+)
+
+func AllApplicationEventsType() []string {
+	return []string{
+		ApplicationEventsTypeContainerState,
+		ApplicationEventsTypeBuildStarted,
+		ApplicationEventsTypeBuildFinished,
+		ApplicationEventsTypeDeployed,
+		ApplicationEventsTypeConfigChanged,
+		ApplicationEventsTypeStarted,
+		ApplicationEventsTypeStopped,
+	}
+}
+
 // Enum values for ApplicationsDeployType
 const (
 	ApplicationsDeployTypeRuntime string = "runtime"
@@ -142,6 +204,36 @@ func AllBuildsStatus() []string {
 	}
 }
 
+// Enum values for CustomDomainsMethod
+const (
+	CustomDomainsMethodDNS  string = "dns"
+	CustomDomainsMethodHTTP string = "http"
+)
+
+func AllCustomDomainsMethod() []string {
+	return []string{
+		CustomDomainsMethodDNS,
+		CustomDomainsMethodHTTP,
+	}
+}
+
+// Enum values for NotificationSubscriptionsSink
+const (
+	NotificationSubscriptionsSinkWebhook string = "webhook"
+	NotificationSubscriptionsSinkSlack   string = "slack"
+	NotificationSubscriptionsSinkTraq    string = "traq"
+	NotificationSubscriptionsSinkEmail   string = "email"
+)
+
+func AllNotificationSubscriptionsSink() []string {
+	return []string{
+		NotificationSubscriptionsSinkWebhook,
+		NotificationSubscriptionsSinkSlack,
+		NotificationSubscriptionsSinkTraq,
+		NotificationSubscriptionsSinkEmail,
+	}
+}
+
 // Enum values for PortPublicationsProtocol
 const (
 	PortPublicationsProtocolTCP string = "tcp"
@@ -168,6 +260,19 @@ func AllRepositoryAuthMethod() []string {
 	}
 }
 
+// Enum values for WebsiteRulesType
+const (
+	WebsiteRulesTypeRedirect string = "redirect"
+	WebsiteRulesTypeRewrite  string = "rewrite"
+)
+
+func AllWebsiteRulesType() []string {
+	return []string{
+		WebsiteRulesTypeRedirect,
+		WebsiteRulesTypeRewrite,
+	}
+}
+
 // Enum values for WebsitesAuthentication
 const (
 	WebsitesAuthenticationOff  string = "off"
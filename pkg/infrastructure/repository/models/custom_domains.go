@@ -0,0 +1,1240 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// CustomDomain is an object representing the database table.
+type CustomDomain struct {
+	// カスタムドメインID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// 所有ユーザーID
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// ドメイン
+	Domain string `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	// 所有確認の方法
+	Method string `boil:"method" json:"method" toml:"method" yaml:"method"`
+	// 所有確認用トークン
+	Token string `boil:"token" json:"token" toml:"token" yaml:"token"`
+	// 所有が確認されているか
+	Verified bool `boil:"verified" json:"verified" toml:"verified" yaml:"verified"`
+	// 最後に所有が確認された日時
+	VerifiedAt null.Time `boil:"verified_at" json:"verified_at,omitempty" toml:"verified_at" yaml:"verified_at,omitempty"`
+	// 最後に所有確認を行った日時
+	CheckedAt null.Time `boil:"checked_at" json:"checked_at,omitempty" toml:"checked_at" yaml:"checked_at,omitempty"`
+	// 最後の所有確認のエラー
+	Error string `boil:"error" json:"error" toml:"error" yaml:"error"`
+	// 作成日時
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *customDomainR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L customDomainL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CustomDomainColumns = struct {
+	ID         string
+	UserID     string
+	Domain     string
+	Method     string
+	Token      string
+	Verified   string
+	VerifiedAt string
+	CheckedAt  string
+	Error      string
+	CreatedAt  string
+}{
+	ID:         "id",
+	UserID:     "user_id",
+	Domain:     "domain",
+	Method:     "method",
+	Token:      "token",
+	Verified:   "verified",
+	VerifiedAt: "verified_at",
+	CheckedAt:  "checked_at",
+	Error:      "error",
+	CreatedAt:  "created_at",
+}
+
+var CustomDomainTableColumns = struct {
+	ID         string
+	UserID     string
+	Domain     string
+	Method     string
+	Token      string
+	Verified   string
+	VerifiedAt string
+	CheckedAt  string
+	Error      string
+	CreatedAt  string
+}{
+	ID:         "custom_domains.id",
+	UserID:     "custom_domains.user_id",
+	Domain:     "custom_domains.domain",
+	Method:     "custom_domains.method",
+	Token:      "custom_domains.token",
+	Verified:   "custom_domains.verified",
+	VerifiedAt: "custom_domains.verified_at",
+	CheckedAt:  "custom_domains.checked_at",
+	Error:      "custom_domains.error",
+	CreatedAt:  "custom_domains.created_at",
+}
+
+// Generated where
+
+var CustomDomainWhere = struct {
+	ID         whereHelperstring
+	UserID     whereHelperstring
+	Domain     whereHelperstring
+	Method     whereHelperstring
+	Token      whereHelperstring
+	Verified   whereHelperbool
+	VerifiedAt whereHelpernull_Time
+	CheckedAt  whereHelpernull_Time
+	Error      whereHelperstring
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "`custom_domains`.`id`"},
+	UserID:     whereHelperstring{field: "`custom_domains`.`user_id`"},
+	Domain:     whereHelperstring{field: "`custom_domains`.`domain`"},
+	Method:     whereHelperstring{field: "`custom_domains`.`method`"},
+	Token:      whereHelperstring{field: "`custom_domains`.`token`"},
+	Verified:   whereHelperbool{field: "`custom_domains`.`verified`"},
+	VerifiedAt: whereHelpernull_Time{field: "`custom_domains`.`verified_at`"},
+	CheckedAt:  whereHelpernull_Time{field: "`custom_domains`.`checked_at`"},
+	Error:      whereHelperstring{field: "`custom_domains`.`error`"},
+	CreatedAt:  whereHelpertime_Time{field: "`custom_domains`.`created_at`"},
+}
+
+// CustomDomainRels is where relationship names are stored.
+var CustomDomainRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// customDomainR is where relationships are stored.
+type customDomainR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*customDomainR) NewStruct() *customDomainR {
+	return &customDomainR{}
+}
+
+func (o *CustomDomain) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *customDomainR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// customDomainL is where Load methods for each relationship are stored.
+type customDomainL struct{}
+
+var (
+	customDomainAllColumns            = []string{"id", "user_id", "domain", "method", "token", "verified", "verified_at", "checked_at", "error", "created_at"}
+	customDomainColumnsWithoutDefault = []string{"id", "user_id", "domain", "method", "token", "verified", "verified_at", "checked_at", "error", "created_at"}
+	customDomainColumnsWithDefault    = []string{}
+	customDomainPrimaryKeyColumns     = []string{"id"}
+	customDomainGeneratedColumns      = []string{}
+)
+
+type (
+	// CustomDomainSlice is an alias for a slice of pointers to CustomDomain.
+	// This should almost always be used instead of []CustomDomain.
+	CustomDomainSlice []*CustomDomain
+	// CustomDomainHook is the signature for custom CustomDomain hook methods
+	CustomDomainHook func(context.Context, boil.ContextExecutor, *CustomDomain) error
+
+	customDomainQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	customDomainType                 = reflect.TypeOf(&CustomDomain{})
+	customDomainMapping              = queries.MakeStructMapping(customDomainType)
+	customDomainPrimaryKeyMapping, _ = queries.BindMapping(customDomainType, customDomainMapping, customDomainPrimaryKeyColumns)
+	customDomainInsertCacheMut       sync.RWMutex
+	customDomainInsertCache          = make(map[string]insertCache)
+	customDomainUpdateCacheMut       sync.RWMutex
+	customDomainUpdateCache          = make(map[string]updateCache)
+	customDomainUpsertCacheMut       sync.RWMutex
+	customDomainUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var customDomainAfterSelectMu sync.Mutex
+var customDomainAfterSelectHooks []CustomDomainHook
+
+var customDomainBeforeInsertMu sync.Mutex
+var customDomainBeforeInsertHooks []CustomDomainHook
+var customDomainAfterInsertMu sync.Mutex
+var customDomainAfterInsertHooks []CustomDomainHook
+
+var customDomainBeforeUpdateMu sync.Mutex
+var customDomainBeforeUpdateHooks []CustomDomainHook
+var customDomainAfterUpdateMu sync.Mutex
+var customDomainAfterUpdateHooks []CustomDomainHook
+
+var customDomainBeforeDeleteMu sync.Mutex
+var customDomainBeforeDeleteHooks []CustomDomainHook
+var customDomainAfterDeleteMu sync.Mutex
+var customDomainAfterDeleteHooks []CustomDomainHook
+
+var customDomainBeforeUpsertMu sync.Mutex
+var customDomainBeforeUpsertHooks []CustomDomainHook
+var customDomainAfterUpsertMu sync.Mutex
+var customDomainAfterUpsertHooks []CustomDomainHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CustomDomain) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CustomDomain) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CustomDomain) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CustomDomain) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CustomDomain) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CustomDomain) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CustomDomain) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CustomDomain) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CustomDomain) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range customDomainAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCustomDomainHook registers your hook function for all future operations.
+func AddCustomDomainHook(hookPoint boil.HookPoint, customDomainHook CustomDomainHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		customDomainAfterSelectMu.Lock()
+		customDomainAfterSelectHooks = append(customDomainAfterSelectHooks, customDomainHook)
+		customDomainAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		customDomainBeforeInsertMu.Lock()
+		customDomainBeforeInsertHooks = append(customDomainBeforeInsertHooks, customDomainHook)
+		customDomainBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		customDomainAfterInsertMu.Lock()
+		customDomainAfterInsertHooks = append(customDomainAfterInsertHooks, customDomainHook)
+		customDomainAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		customDomainBeforeUpdateMu.Lock()
+		customDomainBeforeUpdateHooks = append(customDomainBeforeUpdateHooks, customDomainHook)
+		customDomainBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		customDomainAfterUpdateMu.Lock()
+		customDomainAfterUpdateHooks = append(customDomainAfterUpdateHooks, customDomainHook)
+		customDomainAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		customDomainBeforeDeleteMu.Lock()
+		customDomainBeforeDeleteHooks = append(customDomainBeforeDeleteHooks, customDomainHook)
+		customDomainBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		customDomainAfterDeleteMu.Lock()
+		customDomainAfterDeleteHooks = append(customDomainAfterDeleteHooks, customDomainHook)
+		customDomainAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		customDomainBeforeUpsertMu.Lock()
+		customDomainBeforeUpsertHooks = append(customDomainBeforeUpsertHooks, customDomainHook)
+		customDomainBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		customDomainAfterUpsertMu.Lock()
+		customDomainAfterUpsertHooks = append(customDomainAfterUpsertHooks, customDomainHook)
+		customDomainAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single customDomain record from the query.
+func (q customDomainQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CustomDomain, error) {
+	o := &CustomDomain{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for custom_domains")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CustomDomain records from the query.
+func (q customDomainQuery) All(ctx context.Context, exec boil.ContextExecutor) (CustomDomainSlice, error) {
+	var o []*CustomDomain
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CustomDomain slice")
+	}
+
+	if len(customDomainAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CustomDomain records in the query.
+func (q customDomainQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count custom_domains rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q customDomainQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if custom_domains exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *CustomDomain) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (customDomainL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCustomDomain any, mods queries.Applicator) error {
+	var slice []*CustomDomain
+	var object *CustomDomain
+
+	if singular {
+		var ok bool
+		object, ok = maybeCustomDomain.(*CustomDomain)
+		if !ok {
+			object = new(CustomDomain)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCustomDomain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCustomDomain))
+			}
+		}
+	} else {
+		s, ok := maybeCustomDomain.(*[]*CustomDomain)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCustomDomain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCustomDomain))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &customDomainR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &customDomainR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CustomDomains = append(foreign.R.CustomDomains, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CustomDomains = append(foreign.R.CustomDomains, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the customDomain to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.CustomDomains.
+func (o *CustomDomain) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `custom_domains` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, customDomainPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &customDomainR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CustomDomains: CustomDomainSlice{o},
+		}
+	} else {
+		related.R.CustomDomains = append(related.R.CustomDomains, o)
+	}
+
+	return nil
+}
+
+// CustomDomains retrieves all the records using an executor.
+func CustomDomains(mods ...qm.QueryMod) customDomainQuery {
+	mods = append(mods, qm.From("`custom_domains`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`custom_domains`.*"})
+	}
+
+	return customDomainQuery{q}
+}
+
+// FindCustomDomain retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCustomDomain(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*CustomDomain, error) {
+	customDomainObj := &CustomDomain{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `custom_domains` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, customDomainObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from custom_domains")
+	}
+
+	if err = customDomainObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return customDomainObj, err
+	}
+
+	return customDomainObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CustomDomain) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no custom_domains provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(customDomainColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	customDomainInsertCacheMut.RLock()
+	cache, cached := customDomainInsertCache[key]
+	customDomainInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			customDomainAllColumns,
+			customDomainColumnsWithDefault,
+			customDomainColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(customDomainType, customDomainMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(customDomainType, customDomainMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `custom_domains` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `custom_domains` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `custom_domains` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, customDomainPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into custom_domains")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for custom_domains")
+	}
+
+CacheNoHooks:
+	if !cached {
+		customDomainInsertCacheMut.Lock()
+		customDomainInsertCache[key] = cache
+		customDomainInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CustomDomain.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CustomDomain) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	customDomainUpdateCacheMut.RLock()
+	cache, cached := customDomainUpdateCache[key]
+	customDomainUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			customDomainAllColumns,
+			customDomainPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update custom_domains, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `custom_domains` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, customDomainPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(customDomainType, customDomainMapping, append(wl, customDomainPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update custom_domains row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for custom_domains")
+	}
+
+	if !cached {
+		customDomainUpdateCacheMut.Lock()
+		customDomainUpdateCache[key] = cache
+		customDomainUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q customDomainQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for custom_domains")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for custom_domains")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CustomDomainSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), customDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `custom_domains` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, customDomainPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in customDomain slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all customDomain")
+	}
+	return rowsAff, nil
+}
+
+var mySQLCustomDomainUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CustomDomain) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no custom_domains provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(customDomainColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLCustomDomainUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	customDomainUpsertCacheMut.RLock()
+	cache, cached := customDomainUpsertCache[key]
+	customDomainUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			customDomainAllColumns,
+			customDomainColumnsWithDefault,
+			customDomainColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			customDomainAllColumns,
+			customDomainPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert custom_domains, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(customDomainAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`custom_domains`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `custom_domains` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(customDomainType, customDomainMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(customDomainType, customDomainMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for custom_domains")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(customDomainType, customDomainMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for custom_domains")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for custom_domains")
+	}
+
+CacheNoHooks:
+	if !cached {
+		customDomainUpsertCacheMut.Lock()
+		customDomainUpsertCache[key] = cache
+		customDomainUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CustomDomain record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CustomDomain) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CustomDomain provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), customDomainPrimaryKeyMapping)
+	sql := "DELETE FROM `custom_domains` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from custom_domains")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for custom_domains")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q customDomainQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no customDomainQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from custom_domains")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for custom_domains")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CustomDomainSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(customDomainBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), customDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `custom_domains` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, customDomainPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from customDomain slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for custom_domains")
+	}
+
+	if len(customDomainAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CustomDomain) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCustomDomain(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CustomDomainSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CustomDomainSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), customDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `custom_domains`.* FROM `custom_domains` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, customDomainPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CustomDomainSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CustomDomainExists checks if the CustomDomain row exists.
+func CustomDomainExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `custom_domains` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if custom_domains exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CustomDomain row exists.
+func (o *CustomDomain) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CustomDomainExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1460 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// NotificationSubscription is an object representing the database table.
+type NotificationSubscription struct {
+	// 通知設定ID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// ユーザーID
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// 通知先の種類
+	Sink string `boil:"sink" json:"sink" toml:"sink" yaml:"sink"`
+	// 通知先 (Webhook URLまたはメールアドレス)
+	Target string `boil:"target" json:"target" toml:"target" yaml:"target"`
+	// Webhookの署名用シークレット
+	Secret string `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	// ビルド失敗時に通知するか
+	OnBuildFailed bool `boil:"on_build_failed" json:"on_build_failed" toml:"on_build_failed" yaml:"on_build_failed"`
+	// ビルド成功時に通知するか
+	OnBuildSucceeded bool `boil:"on_build_succeeded" json:"on_build_succeeded" toml:"on_build_succeeded" yaml:"on_build_succeeded"`
+	// デプロイ完了時に通知するか
+	OnDeployed bool `boil:"on_deployed" json:"on_deployed" toml:"on_deployed" yaml:"on_deployed"`
+	// コンテナ異常時に通知するか
+	OnContainerErrored bool `boil:"on_container_errored" json:"on_container_errored" toml:"on_container_errored" yaml:"on_container_errored"`
+	// TLS証明書の問題発生時に通知するか
+	OnCertificateError bool `boil:"on_certificate_error" json:"on_certificate_error" toml:"on_certificate_error" yaml:"on_certificate_error"`
+	// アラートの発火・解決時に通知するか
+	OnAlert bool `boil:"on_alert" json:"on_alert" toml:"on_alert" yaml:"on_alert"`
+	// 作成日時
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *notificationSubscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationSubscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationSubscriptionColumns = struct {
+	ID                 string
+	ApplicationID      string
+	UserID             string
+	Sink               string
+	Target             string
+	Secret             string
+	OnBuildFailed      string
+	OnBuildSucceeded   string
+	OnDeployed         string
+	OnContainerErrored string
+	OnCertificateError string
+	OnAlert            string
+	CreatedAt          string
+}{
+	ID:                 "id",
+	ApplicationID:      "application_id",
+	UserID:             "user_id",
+	Sink:               "sink",
+	Target:             "target",
+	Secret:             "secret",
+	OnBuildFailed:      "on_build_failed",
+	OnBuildSucceeded:   "on_build_succeeded",
+	OnDeployed:         "on_deployed",
+	OnContainerErrored: "on_container_errored",
+	OnCertificateError: "on_certificate_error",
+	OnAlert:            "on_alert",
+	CreatedAt:          "created_at",
+}
+
+var NotificationSubscriptionTableColumns = struct {
+	ID                 string
+	ApplicationID      string
+	UserID             string
+	Sink               string
+	Target             string
+	Secret             string
+	OnBuildFailed      string
+	OnBuildSucceeded   string
+	OnDeployed         string
+	OnContainerErrored string
+	OnCertificateError string
+	OnAlert            string
+	CreatedAt          string
+}{
+	ID:                 "notification_subscriptions.id",
+	ApplicationID:      "notification_subscriptions.application_id",
+	UserID:             "notification_subscriptions.user_id",
+	Sink:               "notification_subscriptions.sink",
+	Target:             "notification_subscriptions.target",
+	Secret:             "notification_subscriptions.secret",
+	OnBuildFailed:      "notification_subscriptions.on_build_failed",
+	OnBuildSucceeded:   "notification_subscriptions.on_build_succeeded",
+	OnDeployed:         "notification_subscriptions.on_deployed",
+	OnContainerErrored: "notification_subscriptions.on_container_errored",
+	OnCertificateError: "notification_subscriptions.on_certificate_error",
+	OnAlert:            "notification_subscriptions.on_alert",
+	CreatedAt:          "notification_subscriptions.created_at",
+}
+
+// Generated where
+
+var NotificationSubscriptionWhere = struct {
+	ID                 whereHelperstring
+	ApplicationID      whereHelperstring
+	UserID             whereHelperstring
+	Sink               whereHelperstring
+	Target             whereHelperstring
+	Secret             whereHelperstring
+	OnBuildFailed      whereHelperbool
+	OnBuildSucceeded   whereHelperbool
+	OnDeployed         whereHelperbool
+	OnContainerErrored whereHelperbool
+	OnCertificateError whereHelperbool
+	OnAlert            whereHelperbool
+	CreatedAt          whereHelpertime_Time
+}{
+	ID:                 whereHelperstring{field: "`notification_subscriptions`.`id`"},
+	ApplicationID:      whereHelperstring{field: "`notification_subscriptions`.`application_id`"},
+	UserID:             whereHelperstring{field: "`notification_subscriptions`.`user_id`"},
+	Sink:               whereHelperstring{field: "`notification_subscriptions`.`sink`"},
+	Target:             whereHelperstring{field: "`notification_subscriptions`.`target`"},
+	Secret:             whereHelperstring{field: "`notification_subscriptions`.`secret`"},
+	OnBuildFailed:      whereHelperbool{field: "`notification_subscriptions`.`on_build_failed`"},
+	OnBuildSucceeded:   whereHelperbool{field: "`notification_subscriptions`.`on_build_succeeded`"},
+	OnDeployed:         whereHelperbool{field: "`notification_subscriptions`.`on_deployed`"},
+	OnContainerErrored: whereHelperbool{field: "`notification_subscriptions`.`on_container_errored`"},
+	OnCertificateError: whereHelperbool{field: "`notification_subscriptions`.`on_certificate_error`"},
+	OnAlert:            whereHelperbool{field: "`notification_subscriptions`.`on_alert`"},
+	CreatedAt:          whereHelpertime_Time{field: "`notification_subscriptions`.`created_at`"},
+}
+
+// NotificationSubscriptionRels is where relationship names are stored.
+var NotificationSubscriptionRels = struct {
+	Application string
+	User        string
+}{
+	Application: "Application",
+	User:        "User",
+}
+
+// notificationSubscriptionR is where relationships are stored.
+type notificationSubscriptionR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	User        *User        `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationSubscriptionR) NewStruct() *notificationSubscriptionR {
+	return &notificationSubscriptionR{}
+}
+
+func (o *NotificationSubscription) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *notificationSubscriptionR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+func (o *NotificationSubscription) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *notificationSubscriptionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// notificationSubscriptionL is where Load methods for each relationship are stored.
+type notificationSubscriptionL struct{}
+
+var (
+	notificationSubscriptionAllColumns            = []string{"id", "application_id", "user_id", "sink", "target", "secret", "on_build_failed", "on_build_succeeded", "on_deployed", "on_container_errored", "on_certificate_error", "on_alert", "created_at"}
+	notificationSubscriptionColumnsWithoutDefault = []string{"id", "application_id", "user_id", "sink", "target", "secret", "on_build_failed", "on_build_succeeded", "on_deployed", "on_container_errored", "on_certificate_error", "on_alert", "created_at"}
+	notificationSubscriptionColumnsWithDefault    = []string{}
+	notificationSubscriptionPrimaryKeyColumns     = []string{"id"}
+	notificationSubscriptionGeneratedColumns      = []string{}
+)
+
+type (
+	// NotificationSubscriptionSlice is an alias for a slice of pointers to NotificationSubscription.
+	// This should almost always be used instead of []NotificationSubscription.
+	NotificationSubscriptionSlice []*NotificationSubscription
+	// NotificationSubscriptionHook is the signature for custom NotificationSubscription hook methods
+	NotificationSubscriptionHook func(context.Context, boil.ContextExecutor, *NotificationSubscription) error
+
+	notificationSubscriptionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationSubscriptionType                 = reflect.TypeOf(&NotificationSubscription{})
+	notificationSubscriptionMapping              = queries.MakeStructMapping(notificationSubscriptionType)
+	notificationSubscriptionPrimaryKeyMapping, _ = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, notificationSubscriptionPrimaryKeyColumns)
+	notificationSubscriptionInsertCacheMut       sync.RWMutex
+	notificationSubscriptionInsertCache          = make(map[string]insertCache)
+	notificationSubscriptionUpdateCacheMut       sync.RWMutex
+	notificationSubscriptionUpdateCache          = make(map[string]updateCache)
+	notificationSubscriptionUpsertCacheMut       sync.RWMutex
+	notificationSubscriptionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var notificationSubscriptionAfterSelectMu sync.Mutex
+var notificationSubscriptionAfterSelectHooks []NotificationSubscriptionHook
+
+var notificationSubscriptionBeforeInsertMu sync.Mutex
+var notificationSubscriptionBeforeInsertHooks []NotificationSubscriptionHook
+var notificationSubscriptionAfterInsertMu sync.Mutex
+var notificationSubscriptionAfterInsertHooks []NotificationSubscriptionHook
+
+var notificationSubscriptionBeforeUpdateMu sync.Mutex
+var notificationSubscriptionBeforeUpdateHooks []NotificationSubscriptionHook
+var notificationSubscriptionAfterUpdateMu sync.Mutex
+var notificationSubscriptionAfterUpdateHooks []NotificationSubscriptionHook
+
+var notificationSubscriptionBeforeDeleteMu sync.Mutex
+var notificationSubscriptionBeforeDeleteHooks []NotificationSubscriptionHook
+var notificationSubscriptionAfterDeleteMu sync.Mutex
+var notificationSubscriptionAfterDeleteHooks []NotificationSubscriptionHook
+
+var notificationSubscriptionBeforeUpsertMu sync.Mutex
+var notificationSubscriptionBeforeUpsertHooks []NotificationSubscriptionHook
+var notificationSubscriptionAfterUpsertMu sync.Mutex
+var notificationSubscriptionAfterUpsertHooks []NotificationSubscriptionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *NotificationSubscription) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *NotificationSubscription) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *NotificationSubscription) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *NotificationSubscription) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *NotificationSubscription) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *NotificationSubscription) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *NotificationSubscription) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *NotificationSubscription) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *NotificationSubscription) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationSubscriptionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationSubscriptionHook registers your hook function for all future operations.
+func AddNotificationSubscriptionHook(hookPoint boil.HookPoint, notificationSubscriptionHook NotificationSubscriptionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		notificationSubscriptionAfterSelectMu.Lock()
+		notificationSubscriptionAfterSelectHooks = append(notificationSubscriptionAfterSelectHooks, notificationSubscriptionHook)
+		notificationSubscriptionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		notificationSubscriptionBeforeInsertMu.Lock()
+		notificationSubscriptionBeforeInsertHooks = append(notificationSubscriptionBeforeInsertHooks, notificationSubscriptionHook)
+		notificationSubscriptionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		notificationSubscriptionAfterInsertMu.Lock()
+		notificationSubscriptionAfterInsertHooks = append(notificationSubscriptionAfterInsertHooks, notificationSubscriptionHook)
+		notificationSubscriptionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		notificationSubscriptionBeforeUpdateMu.Lock()
+		notificationSubscriptionBeforeUpdateHooks = append(notificationSubscriptionBeforeUpdateHooks, notificationSubscriptionHook)
+		notificationSubscriptionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		notificationSubscriptionAfterUpdateMu.Lock()
+		notificationSubscriptionAfterUpdateHooks = append(notificationSubscriptionAfterUpdateHooks, notificationSubscriptionHook)
+		notificationSubscriptionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		notificationSubscriptionBeforeDeleteMu.Lock()
+		notificationSubscriptionBeforeDeleteHooks = append(notificationSubscriptionBeforeDeleteHooks, notificationSubscriptionHook)
+		notificationSubscriptionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		notificationSubscriptionAfterDeleteMu.Lock()
+		notificationSubscriptionAfterDeleteHooks = append(notificationSubscriptionAfterDeleteHooks, notificationSubscriptionHook)
+		notificationSubscriptionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		notificationSubscriptionBeforeUpsertMu.Lock()
+		notificationSubscriptionBeforeUpsertHooks = append(notificationSubscriptionBeforeUpsertHooks, notificationSubscriptionHook)
+		notificationSubscriptionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		notificationSubscriptionAfterUpsertMu.Lock()
+		notificationSubscriptionAfterUpsertHooks = append(notificationSubscriptionAfterUpsertHooks, notificationSubscriptionHook)
+		notificationSubscriptionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single notificationSubscription record from the query.
+func (q notificationSubscriptionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*NotificationSubscription, error) {
+	o := &NotificationSubscription{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for notification_subscriptions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all NotificationSubscription records from the query.
+func (q notificationSubscriptionQuery) All(ctx context.Context, exec boil.ContextExecutor) (NotificationSubscriptionSlice, error) {
+	var o []*NotificationSubscription
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to NotificationSubscription slice")
+	}
+
+	if len(notificationSubscriptionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all NotificationSubscription records in the query.
+func (q notificationSubscriptionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count notification_subscriptions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationSubscriptionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if notification_subscriptions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *NotificationSubscription) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *NotificationSubscription) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationSubscriptionL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationSubscription any, mods queries.Applicator) error {
+	var slice []*NotificationSubscription
+	var object *NotificationSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationSubscription.(*NotificationSubscription)
+		if !ok {
+			object = new(NotificationSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationSubscription.(*[]*NotificationSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationSubscription))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationSubscriptionR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationSubscriptionR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.NotificationSubscriptions = append(foreign.R.NotificationSubscriptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.NotificationSubscriptions = append(foreign.R.NotificationSubscriptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (notificationSubscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationSubscription any, mods queries.Applicator) error {
+	var slice []*NotificationSubscription
+	var object *NotificationSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationSubscription.(*NotificationSubscription)
+		if !ok {
+			object = new(NotificationSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationSubscription.(*[]*NotificationSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationSubscription))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationSubscriptionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationSubscriptionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.NotificationSubscriptions = append(foreign.R.NotificationSubscriptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.NotificationSubscriptions = append(foreign.R.NotificationSubscriptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the notificationSubscription to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.NotificationSubscriptions.
+func (o *NotificationSubscription) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notification_subscriptions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, notificationSubscriptionPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &notificationSubscriptionR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			NotificationSubscriptions: NotificationSubscriptionSlice{o},
+		}
+	} else {
+		related.R.NotificationSubscriptions = append(related.R.NotificationSubscriptions, o)
+	}
+
+	return nil
+}
+
+// SetUser of the notificationSubscription to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.NotificationSubscriptions.
+func (o *NotificationSubscription) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `notification_subscriptions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, notificationSubscriptionPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &notificationSubscriptionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			NotificationSubscriptions: NotificationSubscriptionSlice{o},
+		}
+	} else {
+		related.R.NotificationSubscriptions = append(related.R.NotificationSubscriptions, o)
+	}
+
+	return nil
+}
+
+// NotificationSubscriptions retrieves all the records using an executor.
+func NotificationSubscriptions(mods ...qm.QueryMod) notificationSubscriptionQuery {
+	mods = append(mods, qm.From("`notification_subscriptions`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`notification_subscriptions`.*"})
+	}
+
+	return notificationSubscriptionQuery{q}
+}
+
+// FindNotificationSubscription retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationSubscription(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*NotificationSubscription, error) {
+	notificationSubscriptionObj := &NotificationSubscription{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `notification_subscriptions` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, notificationSubscriptionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from notification_subscriptions")
+	}
+
+	if err = notificationSubscriptionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return notificationSubscriptionObj, err
+	}
+
+	return notificationSubscriptionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationSubscription) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no notification_subscriptions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationSubscriptionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationSubscriptionInsertCacheMut.RLock()
+	cache, cached := notificationSubscriptionInsertCache[key]
+	notificationSubscriptionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationSubscriptionAllColumns,
+			notificationSubscriptionColumnsWithDefault,
+			notificationSubscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `notification_subscriptions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `notification_subscriptions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `notification_subscriptions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, notificationSubscriptionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into notification_subscriptions")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for notification_subscriptions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationSubscriptionInsertCacheMut.Lock()
+		notificationSubscriptionInsertCache[key] = cache
+		notificationSubscriptionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the NotificationSubscription.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationSubscription) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	notificationSubscriptionUpdateCacheMut.RLock()
+	cache, cached := notificationSubscriptionUpdateCache[key]
+	notificationSubscriptionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationSubscriptionAllColumns,
+			notificationSubscriptionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update notification_subscriptions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `notification_subscriptions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, notificationSubscriptionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, append(wl, notificationSubscriptionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update notification_subscriptions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for notification_subscriptions")
+	}
+
+	if !cached {
+		notificationSubscriptionUpdateCacheMut.Lock()
+		notificationSubscriptionUpdateCache[key] = cache
+		notificationSubscriptionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationSubscriptionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for notification_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for notification_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationSubscriptionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `notification_subscriptions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationSubscriptionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in notificationSubscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all notificationSubscription")
+	}
+	return rowsAff, nil
+}
+
+var mySQLNotificationSubscriptionUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationSubscription) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no notification_subscriptions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationSubscriptionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLNotificationSubscriptionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationSubscriptionUpsertCacheMut.RLock()
+	cache, cached := notificationSubscriptionUpsertCache[key]
+	notificationSubscriptionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationSubscriptionAllColumns,
+			notificationSubscriptionColumnsWithDefault,
+			notificationSubscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			notificationSubscriptionAllColumns,
+			notificationSubscriptionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert notification_subscriptions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationSubscriptionAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`notification_subscriptions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `notification_subscriptions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for notification_subscriptions")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(notificationSubscriptionType, notificationSubscriptionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for notification_subscriptions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for notification_subscriptions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		notificationSubscriptionUpsertCacheMut.Lock()
+		notificationSubscriptionUpsertCache[key] = cache
+		notificationSubscriptionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single NotificationSubscription record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationSubscription) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no NotificationSubscription provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationSubscriptionPrimaryKeyMapping)
+	sql := "DELETE FROM `notification_subscriptions` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from notification_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for notification_subscriptions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationSubscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no notificationSubscriptionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from notification_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for notification_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationSubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(notificationSubscriptionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `notification_subscriptions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationSubscriptionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from notificationSubscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for notification_subscriptions")
+	}
+
+	if len(notificationSubscriptionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationSubscription) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindNotificationSubscription(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationSubscriptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationSubscriptionSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `notification_subscriptions`.* FROM `notification_subscriptions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationSubscriptionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in NotificationSubscriptionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationSubscriptionExists checks if the NotificationSubscription row exists.
+func NotificationSubscriptionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `notification_subscriptions` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if notification_subscriptions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the NotificationSubscription row exists.
+func (o *NotificationSubscription) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return NotificationSubscriptionExists(ctx, exec, o.ID)
+}
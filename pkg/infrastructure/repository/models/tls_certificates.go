@@ -0,0 +1,1233 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TLSCertificate is an object representing the database table.
+type TLSCertificate struct {
+	// 証明書ID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// アップロードしたユーザーID
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// 証明書を使用するドメイン
+	FQDN string `boil:"fqdn" json:"fqdn" toml:"fqdn" yaml:"fqdn"`
+	// PEM形式の証明書チェーン
+	Certificate string `boil:"certificate" json:"certificate" toml:"certificate" yaml:"certificate"`
+	// 暗号化されたPEM形式の秘密鍵
+	PrivateKey []byte `boil:"private_key" json:"private_key" toml:"private_key" yaml:"private_key"`
+	// 証明書の有効期間の開始日時
+	NotBefore time.Time `boil:"not_before" json:"not_before" toml:"not_before" yaml:"not_before"`
+	// 証明書の有効期限
+	NotAfter time.Time `boil:"not_after" json:"not_after" toml:"not_after" yaml:"not_after"`
+	// 作成日時
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *tlsCertificateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tlsCertificateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TLSCertificateColumns = struct {
+	ID          string
+	UserID      string
+	FQDN        string
+	Certificate string
+	PrivateKey  string
+	NotBefore   string
+	NotAfter    string
+	CreatedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	FQDN:        "fqdn",
+	Certificate: "certificate",
+	PrivateKey:  "private_key",
+	NotBefore:   "not_before",
+	NotAfter:    "not_after",
+	CreatedAt:   "created_at",
+}
+
+var TLSCertificateTableColumns = struct {
+	ID          string
+	UserID      string
+	FQDN        string
+	Certificate string
+	PrivateKey  string
+	NotBefore   string
+	NotAfter    string
+	CreatedAt   string
+}{
+	ID:          "tls_certificates.id",
+	UserID:      "tls_certificates.user_id",
+	FQDN:        "tls_certificates.fqdn",
+	Certificate: "tls_certificates.certificate",
+	PrivateKey:  "tls_certificates.private_key",
+	NotBefore:   "tls_certificates.not_before",
+	NotAfter:    "tls_certificates.not_after",
+	CreatedAt:   "tls_certificates.created_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var TLSCertificateWhere = struct {
+	ID          whereHelperstring
+	UserID      whereHelperstring
+	FQDN        whereHelperstring
+	Certificate whereHelperstring
+	PrivateKey  whereHelper__byte
+	NotBefore   whereHelpertime_Time
+	NotAfter    whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "`tls_certificates`.`id`"},
+	UserID:      whereHelperstring{field: "`tls_certificates`.`user_id`"},
+	FQDN:        whereHelperstring{field: "`tls_certificates`.`fqdn`"},
+	Certificate: whereHelperstring{field: "`tls_certificates`.`certificate`"},
+	PrivateKey:  whereHelper__byte{field: "`tls_certificates`.`private_key`"},
+	NotBefore:   whereHelpertime_Time{field: "`tls_certificates`.`not_before`"},
+	NotAfter:    whereHelpertime_Time{field: "`tls_certificates`.`not_after`"},
+	CreatedAt:   whereHelpertime_Time{field: "`tls_certificates`.`created_at`"},
+}
+
+// TLSCertificateRels is where relationship names are stored.
+var TLSCertificateRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tlsCertificateR is where relationships are stored.
+type tlsCertificateR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tlsCertificateR) NewStruct() *tlsCertificateR {
+	return &tlsCertificateR{}
+}
+
+func (o *TLSCertificate) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tlsCertificateR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tlsCertificateL is where Load methods for each relationship are stored.
+type tlsCertificateL struct{}
+
+var (
+	tlsCertificateAllColumns            = []string{"id", "user_id", "fqdn", "certificate", "private_key", "not_before", "not_after", "created_at"}
+	tlsCertificateColumnsWithoutDefault = []string{"id", "user_id", "fqdn", "certificate", "private_key", "not_before", "not_after", "created_at"}
+	tlsCertificateColumnsWithDefault    = []string{}
+	tlsCertificatePrimaryKeyColumns     = []string{"id"}
+	tlsCertificateGeneratedColumns      = []string{}
+)
+
+type (
+	// TLSCertificateSlice is an alias for a slice of pointers to TLSCertificate.
+	// This should almost always be used instead of []TLSCertificate.
+	TLSCertificateSlice []*TLSCertificate
+	// TLSCertificateHook is the signature for custom TLSCertificate hook methods
+	TLSCertificateHook func(context.Context, boil.ContextExecutor, *TLSCertificate) error
+
+	tlsCertificateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tlsCertificateType                 = reflect.TypeOf(&TLSCertificate{})
+	tlsCertificateMapping              = queries.MakeStructMapping(tlsCertificateType)
+	tlsCertificatePrimaryKeyMapping, _ = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, tlsCertificatePrimaryKeyColumns)
+	tlsCertificateInsertCacheMut       sync.RWMutex
+	tlsCertificateInsertCache          = make(map[string]insertCache)
+	tlsCertificateUpdateCacheMut       sync.RWMutex
+	tlsCertificateUpdateCache          = make(map[string]updateCache)
+	tlsCertificateUpsertCacheMut       sync.RWMutex
+	tlsCertificateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tlsCertificateAfterSelectMu sync.Mutex
+var tlsCertificateAfterSelectHooks []TLSCertificateHook
+
+var tlsCertificateBeforeInsertMu sync.Mutex
+var tlsCertificateBeforeInsertHooks []TLSCertificateHook
+var tlsCertificateAfterInsertMu sync.Mutex
+var tlsCertificateAfterInsertHooks []TLSCertificateHook
+
+var tlsCertificateBeforeUpdateMu sync.Mutex
+var tlsCertificateBeforeUpdateHooks []TLSCertificateHook
+var tlsCertificateAfterUpdateMu sync.Mutex
+var tlsCertificateAfterUpdateHooks []TLSCertificateHook
+
+var tlsCertificateBeforeDeleteMu sync.Mutex
+var tlsCertificateBeforeDeleteHooks []TLSCertificateHook
+var tlsCertificateAfterDeleteMu sync.Mutex
+var tlsCertificateAfterDeleteHooks []TLSCertificateHook
+
+var tlsCertificateBeforeUpsertMu sync.Mutex
+var tlsCertificateBeforeUpsertHooks []TLSCertificateHook
+var tlsCertificateAfterUpsertMu sync.Mutex
+var tlsCertificateAfterUpsertHooks []TLSCertificateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TLSCertificate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TLSCertificate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TLSCertificate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TLSCertificate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TLSCertificate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TLSCertificate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TLSCertificate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TLSCertificate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TLSCertificate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tlsCertificateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTLSCertificateHook registers your hook function for all future operations.
+func AddTLSCertificateHook(hookPoint boil.HookPoint, tlsCertificateHook TLSCertificateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tlsCertificateAfterSelectMu.Lock()
+		tlsCertificateAfterSelectHooks = append(tlsCertificateAfterSelectHooks, tlsCertificateHook)
+		tlsCertificateAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tlsCertificateBeforeInsertMu.Lock()
+		tlsCertificateBeforeInsertHooks = append(tlsCertificateBeforeInsertHooks, tlsCertificateHook)
+		tlsCertificateBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tlsCertificateAfterInsertMu.Lock()
+		tlsCertificateAfterInsertHooks = append(tlsCertificateAfterInsertHooks, tlsCertificateHook)
+		tlsCertificateAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tlsCertificateBeforeUpdateMu.Lock()
+		tlsCertificateBeforeUpdateHooks = append(tlsCertificateBeforeUpdateHooks, tlsCertificateHook)
+		tlsCertificateBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tlsCertificateAfterUpdateMu.Lock()
+		tlsCertificateAfterUpdateHooks = append(tlsCertificateAfterUpdateHooks, tlsCertificateHook)
+		tlsCertificateAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tlsCertificateBeforeDeleteMu.Lock()
+		tlsCertificateBeforeDeleteHooks = append(tlsCertificateBeforeDeleteHooks, tlsCertificateHook)
+		tlsCertificateBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tlsCertificateAfterDeleteMu.Lock()
+		tlsCertificateAfterDeleteHooks = append(tlsCertificateAfterDeleteHooks, tlsCertificateHook)
+		tlsCertificateAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tlsCertificateBeforeUpsertMu.Lock()
+		tlsCertificateBeforeUpsertHooks = append(tlsCertificateBeforeUpsertHooks, tlsCertificateHook)
+		tlsCertificateBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tlsCertificateAfterUpsertMu.Lock()
+		tlsCertificateAfterUpsertHooks = append(tlsCertificateAfterUpsertHooks, tlsCertificateHook)
+		tlsCertificateAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tlsCertificate record from the query.
+func (q tlsCertificateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TLSCertificate, error) {
+	o := &TLSCertificate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for tls_certificates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TLSCertificate records from the query.
+func (q tlsCertificateQuery) All(ctx context.Context, exec boil.ContextExecutor) (TLSCertificateSlice, error) {
+	var o []*TLSCertificate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TLSCertificate slice")
+	}
+
+	if len(tlsCertificateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TLSCertificate records in the query.
+func (q tlsCertificateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count tls_certificates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tlsCertificateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if tls_certificates exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TLSCertificate) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tlsCertificateL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTLSCertificate any, mods queries.Applicator) error {
+	var slice []*TLSCertificate
+	var object *TLSCertificate
+
+	if singular {
+		var ok bool
+		object, ok = maybeTLSCertificate.(*TLSCertificate)
+		if !ok {
+			object = new(TLSCertificate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTLSCertificate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTLSCertificate))
+			}
+		}
+	} else {
+		s, ok := maybeTLSCertificate.(*[]*TLSCertificate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTLSCertificate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTLSCertificate))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tlsCertificateR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tlsCertificateR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TLSCertificates = append(foreign.R.TLSCertificates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TLSCertificates = append(foreign.R.TLSCertificates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tlsCertificate to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TLSCertificates.
+func (o *TLSCertificate) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tls_certificates` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tlsCertificatePrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tlsCertificateR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TLSCertificates: TLSCertificateSlice{o},
+		}
+	} else {
+		related.R.TLSCertificates = append(related.R.TLSCertificates, o)
+	}
+
+	return nil
+}
+
+// TLSCertificates retrieves all the records using an executor.
+func TLSCertificates(mods ...qm.QueryMod) tlsCertificateQuery {
+	mods = append(mods, qm.From("`tls_certificates`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tls_certificates`.*"})
+	}
+
+	return tlsCertificateQuery{q}
+}
+
+// FindTLSCertificate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTLSCertificate(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*TLSCertificate, error) {
+	tlsCertificateObj := &TLSCertificate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tls_certificates` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tlsCertificateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from tls_certificates")
+	}
+
+	if err = tlsCertificateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tlsCertificateObj, err
+	}
+
+	return tlsCertificateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TLSCertificate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no tls_certificates provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tlsCertificateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tlsCertificateInsertCacheMut.RLock()
+	cache, cached := tlsCertificateInsertCache[key]
+	tlsCertificateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tlsCertificateAllColumns,
+			tlsCertificateColumnsWithDefault,
+			tlsCertificateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tls_certificates` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tls_certificates` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tls_certificates` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tlsCertificatePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into tls_certificates")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for tls_certificates")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tlsCertificateInsertCacheMut.Lock()
+		tlsCertificateInsertCache[key] = cache
+		tlsCertificateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TLSCertificate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TLSCertificate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tlsCertificateUpdateCacheMut.RLock()
+	cache, cached := tlsCertificateUpdateCache[key]
+	tlsCertificateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tlsCertificateAllColumns,
+			tlsCertificatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update tls_certificates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tls_certificates` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tlsCertificatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, append(wl, tlsCertificatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update tls_certificates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for tls_certificates")
+	}
+
+	if !cached {
+		tlsCertificateUpdateCacheMut.Lock()
+		tlsCertificateUpdateCache[key] = cache
+		tlsCertificateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tlsCertificateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for tls_certificates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for tls_certificates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TLSCertificateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tlsCertificatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tls_certificates` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tlsCertificatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in tlsCertificate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all tlsCertificate")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTLSCertificateUniqueColumns = []string{
+	"id",
+	"fqdn",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TLSCertificate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no tls_certificates provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tlsCertificateColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTLSCertificateUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tlsCertificateUpsertCacheMut.RLock()
+	cache, cached := tlsCertificateUpsertCache[key]
+	tlsCertificateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tlsCertificateAllColumns,
+			tlsCertificateColumnsWithDefault,
+			tlsCertificateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tlsCertificateAllColumns,
+			tlsCertificatePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert tls_certificates, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tlsCertificateAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tls_certificates`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tls_certificates` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for tls_certificates")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tlsCertificateType, tlsCertificateMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for tls_certificates")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for tls_certificates")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tlsCertificateUpsertCacheMut.Lock()
+		tlsCertificateUpsertCache[key] = cache
+		tlsCertificateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TLSCertificate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TLSCertificate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TLSCertificate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tlsCertificatePrimaryKeyMapping)
+	sql := "DELETE FROM `tls_certificates` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from tls_certificates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for tls_certificates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tlsCertificateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no tlsCertificateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tls_certificates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for tls_certificates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TLSCertificateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tlsCertificateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tlsCertificatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tls_certificates` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tlsCertificatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tlsCertificate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for tls_certificates")
+	}
+
+	if len(tlsCertificateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TLSCertificate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTLSCertificate(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TLSCertificateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TLSCertificateSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tlsCertificatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tls_certificates`.* FROM `tls_certificates` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tlsCertificatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TLSCertificateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TLSCertificateExists checks if the TLSCertificate row exists.
+func TLSCertificateExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tls_certificates` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if tls_certificates exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TLSCertificate row exists.
+func (o *TLSCertificate) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TLSCertificateExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1185 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// UserResourceLimit is an object representing the database table.
+type UserResourceLimit struct {
+	// ユーザーID
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// アプリケーション数上限
+	MaxApplications int `boil:"max_applications" json:"max_applications" toml:"max_applications" yaml:"max_applications"`
+	// 起動中アプリケーション数上限
+	MaxRunningApplications int `boil:"max_running_applications" json:"max_running_applications" toml:"max_running_applications" yaml:"max_running_applications"`
+	// 公開ポート数上限
+	MaxPortPublications int `boil:"max_port_publications" json:"max_port_publications" toml:"max_port_publications" yaml:"max_port_publications"`
+	// データベース数上限
+	MaxDatabases int `boil:"max_databases" json:"max_databases" toml:"max_databases" yaml:"max_databases"`
+
+	R *userResourceLimitR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userResourceLimitL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserResourceLimitColumns = struct {
+	UserID                 string
+	MaxApplications        string
+	MaxRunningApplications string
+	MaxPortPublications    string
+	MaxDatabases           string
+}{
+	UserID:                 "user_id",
+	MaxApplications:        "max_applications",
+	MaxRunningApplications: "max_running_applications",
+	MaxPortPublications:    "max_port_publications",
+	MaxDatabases:           "max_databases",
+}
+
+var UserResourceLimitTableColumns = struct {
+	UserID                 string
+	MaxApplications        string
+	MaxRunningApplications string
+	MaxPortPublications    string
+	MaxDatabases           string
+}{
+	UserID:                 "user_resource_limits.user_id",
+	MaxApplications:        "user_resource_limits.max_applications",
+	MaxRunningApplications: "user_resource_limits.max_running_applications",
+	MaxPortPublications:    "user_resource_limits.max_port_publications",
+	MaxDatabases:           "user_resource_limits.max_databases",
+}
+
+// Generated where
+
+var UserResourceLimitWhere = struct {
+	UserID                 whereHelperstring
+	MaxApplications        whereHelperint
+	MaxRunningApplications whereHelperint
+	MaxPortPublications    whereHelperint
+	MaxDatabases           whereHelperint
+}{
+	UserID:                 whereHelperstring{field: "`user_resource_limits`.`user_id`"},
+	MaxApplications:        whereHelperint{field: "`user_resource_limits`.`max_applications`"},
+	MaxRunningApplications: whereHelperint{field: "`user_resource_limits`.`max_running_applications`"},
+	MaxPortPublications:    whereHelperint{field: "`user_resource_limits`.`max_port_publications`"},
+	MaxDatabases:           whereHelperint{field: "`user_resource_limits`.`max_databases`"},
+}
+
+// UserResourceLimitRels is where relationship names are stored.
+var UserResourceLimitRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// userResourceLimitR is where relationships are stored.
+type userResourceLimitR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userResourceLimitR) NewStruct() *userResourceLimitR {
+	return &userResourceLimitR{}
+}
+
+func (o *UserResourceLimit) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *userResourceLimitR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// userResourceLimitL is where Load methods for each relationship are stored.
+type userResourceLimitL struct{}
+
+var (
+	userResourceLimitAllColumns            = []string{"user_id", "max_applications", "max_running_applications", "max_port_publications", "max_databases"}
+	userResourceLimitColumnsWithoutDefault = []string{"user_id", "max_applications", "max_running_applications", "max_port_publications", "max_databases"}
+	userResourceLimitColumnsWithDefault    = []string{}
+	userResourceLimitPrimaryKeyColumns     = []string{"user_id"}
+	userResourceLimitGeneratedColumns      = []string{}
+)
+
+type (
+	// UserResourceLimitSlice is an alias for a slice of pointers to UserResourceLimit.
+	// This should almost always be used instead of []UserResourceLimit.
+	UserResourceLimitSlice []*UserResourceLimit
+	// UserResourceLimitHook is the signature for custom UserResourceLimit hook methods
+	UserResourceLimitHook func(context.Context, boil.ContextExecutor, *UserResourceLimit) error
+
+	userResourceLimitQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userResourceLimitType                 = reflect.TypeOf(&UserResourceLimit{})
+	userResourceLimitMapping              = queries.MakeStructMapping(userResourceLimitType)
+	userResourceLimitPrimaryKeyMapping, _ = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, userResourceLimitPrimaryKeyColumns)
+	userResourceLimitInsertCacheMut       sync.RWMutex
+	userResourceLimitInsertCache          = make(map[string]insertCache)
+	userResourceLimitUpdateCacheMut       sync.RWMutex
+	userResourceLimitUpdateCache          = make(map[string]updateCache)
+	userResourceLimitUpsertCacheMut       sync.RWMutex
+	userResourceLimitUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userResourceLimitAfterSelectMu sync.Mutex
+var userResourceLimitAfterSelectHooks []UserResourceLimitHook
+
+var userResourceLimitBeforeInsertMu sync.Mutex
+var userResourceLimitBeforeInsertHooks []UserResourceLimitHook
+var userResourceLimitAfterInsertMu sync.Mutex
+var userResourceLimitAfterInsertHooks []UserResourceLimitHook
+
+var userResourceLimitBeforeUpdateMu sync.Mutex
+var userResourceLimitBeforeUpdateHooks []UserResourceLimitHook
+var userResourceLimitAfterUpdateMu sync.Mutex
+var userResourceLimitAfterUpdateHooks []UserResourceLimitHook
+
+var userResourceLimitBeforeDeleteMu sync.Mutex
+var userResourceLimitBeforeDeleteHooks []UserResourceLimitHook
+var userResourceLimitAfterDeleteMu sync.Mutex
+var userResourceLimitAfterDeleteHooks []UserResourceLimitHook
+
+var userResourceLimitBeforeUpsertMu sync.Mutex
+var userResourceLimitBeforeUpsertHooks []UserResourceLimitHook
+var userResourceLimitAfterUpsertMu sync.Mutex
+var userResourceLimitAfterUpsertHooks []UserResourceLimitHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserResourceLimit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserResourceLimit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserResourceLimit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserResourceLimit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserResourceLimit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserResourceLimit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserResourceLimit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserResourceLimit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserResourceLimit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userResourceLimitAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserResourceLimitHook registers your hook function for all future operations.
+func AddUserResourceLimitHook(hookPoint boil.HookPoint, userResourceLimitHook UserResourceLimitHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userResourceLimitAfterSelectMu.Lock()
+		userResourceLimitAfterSelectHooks = append(userResourceLimitAfterSelectHooks, userResourceLimitHook)
+		userResourceLimitAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userResourceLimitBeforeInsertMu.Lock()
+		userResourceLimitBeforeInsertHooks = append(userResourceLimitBeforeInsertHooks, userResourceLimitHook)
+		userResourceLimitBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userResourceLimitAfterInsertMu.Lock()
+		userResourceLimitAfterInsertHooks = append(userResourceLimitAfterInsertHooks, userResourceLimitHook)
+		userResourceLimitAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userResourceLimitBeforeUpdateMu.Lock()
+		userResourceLimitBeforeUpdateHooks = append(userResourceLimitBeforeUpdateHooks, userResourceLimitHook)
+		userResourceLimitBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userResourceLimitAfterUpdateMu.Lock()
+		userResourceLimitAfterUpdateHooks = append(userResourceLimitAfterUpdateHooks, userResourceLimitHook)
+		userResourceLimitAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userResourceLimitBeforeDeleteMu.Lock()
+		userResourceLimitBeforeDeleteHooks = append(userResourceLimitBeforeDeleteHooks, userResourceLimitHook)
+		userResourceLimitBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userResourceLimitAfterDeleteMu.Lock()
+		userResourceLimitAfterDeleteHooks = append(userResourceLimitAfterDeleteHooks, userResourceLimitHook)
+		userResourceLimitAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userResourceLimitBeforeUpsertMu.Lock()
+		userResourceLimitBeforeUpsertHooks = append(userResourceLimitBeforeUpsertHooks, userResourceLimitHook)
+		userResourceLimitBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userResourceLimitAfterUpsertMu.Lock()
+		userResourceLimitAfterUpsertHooks = append(userResourceLimitAfterUpsertHooks, userResourceLimitHook)
+		userResourceLimitAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single userResourceLimit record from the query.
+func (q userResourceLimitQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserResourceLimit, error) {
+	o := &UserResourceLimit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for user_resource_limits")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserResourceLimit records from the query.
+func (q userResourceLimitQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserResourceLimitSlice, error) {
+	var o []*UserResourceLimit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UserResourceLimit slice")
+	}
+
+	if len(userResourceLimitAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserResourceLimit records in the query.
+func (q userResourceLimitQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count user_resource_limits rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userResourceLimitQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if user_resource_limits exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *UserResourceLimit) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userResourceLimitL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserResourceLimit any, mods queries.Applicator) error {
+	var slice []*UserResourceLimit
+	var object *UserResourceLimit
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserResourceLimit.(*UserResourceLimit)
+		if !ok {
+			object = new(UserResourceLimit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserResourceLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserResourceLimit))
+			}
+		}
+	} else {
+		s, ok := maybeUserResourceLimit.(*[]*UserResourceLimit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserResourceLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserResourceLimit))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userResourceLimitR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userResourceLimitR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserResourceLimit = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserResourceLimit = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the userResourceLimit to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserResourceLimit.
+func (o *UserResourceLimit) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user_resource_limits` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, userResourceLimitPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &userResourceLimitR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserResourceLimit: o,
+		}
+	} else {
+		related.R.UserResourceLimit = o
+	}
+
+	return nil
+}
+
+// UserResourceLimits retrieves all the records using an executor.
+func UserResourceLimits(mods ...qm.QueryMod) userResourceLimitQuery {
+	mods = append(mods, qm.From("`user_resource_limits`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`user_resource_limits`.*"})
+	}
+
+	return userResourceLimitQuery{q}
+}
+
+// FindUserResourceLimit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserResourceLimit(ctx context.Context, exec boil.ContextExecutor, userID string, selectCols ...string) (*UserResourceLimit, error) {
+	userResourceLimitObj := &UserResourceLimit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `user_resource_limits` where `user_id`=?", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, userResourceLimitObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from user_resource_limits")
+	}
+
+	if err = userResourceLimitObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userResourceLimitObj, err
+	}
+
+	return userResourceLimitObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserResourceLimit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_resource_limits provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userResourceLimitColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userResourceLimitInsertCacheMut.RLock()
+	cache, cached := userResourceLimitInsertCache[key]
+	userResourceLimitInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userResourceLimitAllColumns,
+			userResourceLimitColumnsWithDefault,
+			userResourceLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `user_resource_limits` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `user_resource_limits` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `user_resource_limits` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, userResourceLimitPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into user_resource_limits")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.UserID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for user_resource_limits")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userResourceLimitInsertCacheMut.Lock()
+		userResourceLimitInsertCache[key] = cache
+		userResourceLimitInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserResourceLimit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserResourceLimit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userResourceLimitUpdateCacheMut.RLock()
+	cache, cached := userResourceLimitUpdateCache[key]
+	userResourceLimitUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userResourceLimitAllColumns,
+			userResourceLimitPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update user_resource_limits, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `user_resource_limits` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, userResourceLimitPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, append(wl, userResourceLimitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update user_resource_limits row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for user_resource_limits")
+	}
+
+	if !cached {
+		userResourceLimitUpdateCacheMut.Lock()
+		userResourceLimitUpdateCache[key] = cache
+		userResourceLimitUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userResourceLimitQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for user_resource_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for user_resource_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserResourceLimitSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userResourceLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `user_resource_limits` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userResourceLimitPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in userResourceLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all userResourceLimit")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUserResourceLimitUniqueColumns = []string{
+	"user_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserResourceLimit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_resource_limits provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userResourceLimitColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUserResourceLimitUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userResourceLimitUpsertCacheMut.RLock()
+	cache, cached := userResourceLimitUpsertCache[key]
+	userResourceLimitUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userResourceLimitAllColumns,
+			userResourceLimitColumnsWithDefault,
+			userResourceLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userResourceLimitAllColumns,
+			userResourceLimitPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert user_resource_limits, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userResourceLimitAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`user_resource_limits`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `user_resource_limits` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for user_resource_limits")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(userResourceLimitType, userResourceLimitMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for user_resource_limits")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for user_resource_limits")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userResourceLimitUpsertCacheMut.Lock()
+		userResourceLimitUpsertCache[key] = cache
+		userResourceLimitUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserResourceLimit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserResourceLimit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UserResourceLimit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userResourceLimitPrimaryKeyMapping)
+	sql := "DELETE FROM `user_resource_limits` WHERE `user_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from user_resource_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for user_resource_limits")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userResourceLimitQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userResourceLimitQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user_resource_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_resource_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserResourceLimitSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userResourceLimitBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userResourceLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `user_resource_limits` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userResourceLimitPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from userResourceLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_resource_limits")
+	}
+
+	if len(userResourceLimitAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserResourceLimit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserResourceLimit(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserResourceLimitSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserResourceLimitSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userResourceLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `user_resource_limits`.* FROM `user_resource_limits` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userResourceLimitPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserResourceLimitSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserResourceLimitExists checks if the UserResourceLimit row exists.
+func UserResourceLimitExists(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `user_resource_limits` where `user_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if user_resource_limits exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserResourceLimit row exists.
+func (o *UserResourceLimit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserResourceLimitExists(ctx, exec, o.UserID)
+}
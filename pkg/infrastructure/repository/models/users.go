@@ -68,20 +68,32 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Applications string
-	Repositories string
-	UserKeys     string
+	UserResourceLimit         string
+	Applications              string
+	CustomDomains             string
+	NotificationSubscriptions string
+	Repositories              string
+	TLSCertificates           string
+	UserKeys                  string
 }{
-	Applications: "Applications",
-	Repositories: "Repositories",
-	UserKeys:     "UserKeys",
+	UserResourceLimit:         "UserResourceLimit",
+	Applications:              "Applications",
+	CustomDomains:             "CustomDomains",
+	NotificationSubscriptions: "NotificationSubscriptions",
+	Repositories:              "Repositories",
+	TLSCertificates:           "TLSCertificates",
+	UserKeys:                  "UserKeys",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Applications ApplicationSlice `boil:"Applications" json:"Applications" toml:"Applications" yaml:"Applications"`
-	Repositories RepositorySlice  `boil:"Repositories" json:"Repositories" toml:"Repositories" yaml:"Repositories"`
-	UserKeys     UserKeySlice     `boil:"UserKeys" json:"UserKeys" toml:"UserKeys" yaml:"UserKeys"`
+	UserResourceLimit         *UserResourceLimit            `boil:"UserResourceLimit" json:"UserResourceLimit" toml:"UserResourceLimit" yaml:"UserResourceLimit"`
+	Applications              ApplicationSlice              `boil:"Applications" json:"Applications" toml:"Applications" yaml:"Applications"`
+	CustomDomains             CustomDomainSlice             `boil:"CustomDomains" json:"CustomDomains" toml:"CustomDomains" yaml:"CustomDomains"`
+	NotificationSubscriptions NotificationSubscriptionSlice `boil:"NotificationSubscriptions" json:"NotificationSubscriptions" toml:"NotificationSubscriptions" yaml:"NotificationSubscriptions"`
+	Repositories              RepositorySlice               `boil:"Repositories" json:"Repositories" toml:"Repositories" yaml:"Repositories"`
+	TLSCertificates           TLSCertificateSlice           `boil:"TLSCertificates" json:"TLSCertificates" toml:"TLSCertificates" yaml:"TLSCertificates"`
+	UserKeys                  UserKeySlice                  `boil:"UserKeys" json:"UserKeys" toml:"UserKeys" yaml:"UserKeys"`
 }
 
 // NewStruct creates a new relationship struct
@@ -89,6 +101,22 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (o *User) GetUserResourceLimit() *UserResourceLimit {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserResourceLimit()
+}
+
+func (r *userR) GetUserResourceLimit() *UserResourceLimit {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserResourceLimit
+}
+
 func (o *User) GetApplications() ApplicationSlice {
 	if o == nil {
 		return nil
@@ -105,6 +133,38 @@ func (r *userR) GetApplications() ApplicationSlice {
 	return r.Applications
 }
 
+func (o *User) GetCustomDomains() CustomDomainSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCustomDomains()
+}
+
+func (r *userR) GetCustomDomains() CustomDomainSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CustomDomains
+}
+
+func (o *User) GetNotificationSubscriptions() NotificationSubscriptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotificationSubscriptions()
+}
+
+func (r *userR) GetNotificationSubscriptions() NotificationSubscriptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.NotificationSubscriptions
+}
+
 func (o *User) GetRepositories() RepositorySlice {
 	if o == nil {
 		return nil
@@ -121,6 +181,22 @@ func (r *userR) GetRepositories() RepositorySlice {
 	return r.Repositories
 }
 
+func (o *User) GetTLSCertificates() TLSCertificateSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTLSCertificates()
+}
+
+func (r *userR) GetTLSCertificates() TLSCertificateSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TLSCertificates
+}
+
 func (o *User) GetUserKeys() UserKeySlice {
 	if o == nil {
 		return nil
@@ -453,6 +529,17 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// UserResourceLimit pointed to by the foreign key.
+func (o *User) UserResourceLimit(mods ...qm.QueryMod) userResourceLimitQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`user_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserResourceLimits(queryMods...)
+}
+
 // Applications retrieves all the application's Applications with an executor.
 func (o *User) Applications(mods ...qm.QueryMod) applicationQuery {
 	var queryMods []qm.QueryMod
@@ -468,6 +555,34 @@ func (o *User) Applications(mods ...qm.QueryMod) applicationQuery {
 	return Applications(queryMods...)
 }
 
+// CustomDomains retrieves all the custom_domain's CustomDomains with an executor.
+func (o *User) CustomDomains(mods ...qm.QueryMod) customDomainQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`custom_domains`.`user_id`=?", o.ID),
+	)
+
+	return CustomDomains(queryMods...)
+}
+
+// NotificationSubscriptions retrieves all the notification_subscription's NotificationSubscriptions with an executor.
+func (o *User) NotificationSubscriptions(mods ...qm.QueryMod) notificationSubscriptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notification_subscriptions`.`user_id`=?", o.ID),
+	)
+
+	return NotificationSubscriptions(queryMods...)
+}
+
 // Repositories retrieves all the repository's Repositories with an executor.
 func (o *User) Repositories(mods ...qm.QueryMod) repositoryQuery {
 	var queryMods []qm.QueryMod
@@ -483,6 +598,20 @@ func (o *User) Repositories(mods ...qm.QueryMod) repositoryQuery {
 	return Repositories(queryMods...)
 }
 
+// TLSCertificates retrieves all the tls_certificate's TLSCertificates with an executor.
+func (o *User) TLSCertificates(mods ...qm.QueryMod) tlsCertificateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tls_certificates`.`user_id`=?", o.ID),
+	)
+
+	return TLSCertificates(queryMods...)
+}
+
 // UserKeys retrieves all the user_key's UserKeys with an executor.
 func (o *User) UserKeys(mods ...qm.QueryMod) userKeyQuery {
 	var queryMods []qm.QueryMod
@@ -497,6 +626,123 @@ func (o *User) UserKeys(mods ...qm.QueryMod) userKeyQuery {
 	return UserKeys(queryMods...)
 }
 
+// LoadUserResourceLimit allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadUserResourceLimit(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_resource_limits`),
+		qm.WhereIn(`user_resource_limits.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserResourceLimit")
+	}
+
+	var resultSlice []*UserResourceLimit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserResourceLimit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_resource_limits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_resource_limits")
+	}
+
+	if len(userResourceLimitAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserResourceLimit = foreign
+		if foreign.R == nil {
+			foreign.R = &userResourceLimitR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.UserResourceLimit = foreign
+				if foreign.R == nil {
+					foreign.R = &userResourceLimitR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadApplications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadApplications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
@@ -552,7 +798,7 @@ func (userL) LoadApplications(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.Select("`applications`.`id`, `applications`.`name`, `applications`.`repository_id`, `applications`.`ref_name`, `applications`.`commit`, `applications`.`deploy_type`, `applications`.`running`, `applications`.`container`, `applications`.`container_message`, `applications`.`current_build`, `applications`.`created_at`, `applications`.`updated_at`, `a`.`user_id`"),
+		qm.Select("`applications`.`id`, `applications`.`name`, `applications`.`repository_id`, `applications`.`ref_name`, `applications`.`commit`, `applications`.`deploy_type`, `applications`.`running`, `applications`.`container`, `applications`.`container_message`, `applications`.`current_build`, `applications`.`created_at`, `applications`.`updated_at`, `applications`.`config_file_path`, `applications`.`config_file_error`, `a`.`user_id`"),
 		qm.From("`applications`"),
 		qm.InnerJoin("`application_owners` as `a` on `applications`.`id` = `a`.`application_id`"),
 		qm.WhereIn("`a`.`user_id` in ?", argsSlice...),
@@ -573,7 +819,7 @@ func (userL) LoadApplications(ctx context.Context, e boil.ContextExecutor, singu
 		one := new(Application)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Name, &one.RepositoryID, &one.RefName, &one.Commit, &one.DeployType, &one.Running, &one.Container, &one.ContainerMessage, &one.CurrentBuild, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.RepositoryID, &one.RefName, &one.Commit, &one.DeployType, &one.Running, &one.Container, &one.ContainerMessage, &one.CurrentBuild, &one.CreatedAt, &one.UpdatedAt, &one.ConfigFilePath, &one.ConfigFileError, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for applications")
 		}
@@ -627,9 +873,9 @@ func (userL) LoadApplications(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadRepositories allows an eager lookup of values, cached into the
+// LoadCustomDomains allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRepositories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+func (userL) LoadCustomDomains(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -682,10 +928,8 @@ func (userL) LoadRepositories(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.Select("`repositories`.`id`, `repositories`.`name`, `repositories`.`url`, `a`.`user_id`"),
-		qm.From("`repositories`"),
-		qm.InnerJoin("`repository_owners` as `a` on `repositories`.`id` = `a`.`repository_id`"),
-		qm.WhereIn("`a`.`user_id` in ?", argsSlice...),
+		qm.From(`custom_domains`),
+		qm.WhereIn(`custom_domains.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -693,36 +937,22 @@ func (userL) LoadRepositories(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load repositories")
+		return errors.Wrap(err, "failed to eager load custom_domains")
 	}
 
-	var resultSlice []*Repository
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(Repository)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.Name, &one.URL, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for repositories")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice repositories")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*CustomDomain
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice custom_domains")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on repositories")
+		return errors.Wrap(err, "failed to close results in eager load on custom_domains")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for custom_domains")
 	}
 
-	if len(repositoryAfterSelectHooks) != 0 {
+	if len(customDomainAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -730,25 +960,24 @@ func (userL) LoadRepositories(ctx context.Context, e boil.ContextExecutor, singu
 		}
 	}
 	if singular {
-		object.R.Repositories = resultSlice
+		object.R.CustomDomains = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &repositoryR{}
+				foreign.R = &customDomainR{}
 			}
-			foreign.R.Users = append(foreign.R.Users, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Repositories = append(local.R.Repositories, foreign)
+			if local.ID == foreign.UserID {
+				local.R.CustomDomains = append(local.R.CustomDomains, foreign)
 				if foreign.R == nil {
-					foreign.R = &repositoryR{}
+					foreign.R = &customDomainR{}
 				}
-				foreign.R.Users = append(foreign.R.Users, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -757,9 +986,9 @@ func (userL) LoadRepositories(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadUserKeys allows an eager lookup of values, cached into the
+// LoadNotificationSubscriptions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+func (userL) LoadNotificationSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -812,8 +1041,8 @@ func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`user_keys`),
-		qm.WhereIn(`user_keys.user_id in ?`, argsSlice...),
+		qm.From(`notification_subscriptions`),
+		qm.WhereIn(`notification_subscriptions.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -821,22 +1050,22 @@ func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_keys")
+		return errors.Wrap(err, "failed to eager load notification_subscriptions")
 	}
 
-	var resultSlice []*UserKey
+	var resultSlice []*NotificationSubscription
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_keys")
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_subscriptions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_keys")
+		return errors.Wrap(err, "failed to close results in eager load on notification_subscriptions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_keys")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_subscriptions")
 	}
 
-	if len(userKeyAfterSelectHooks) != 0 {
+	if len(notificationSubscriptionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -844,10 +1073,10 @@ func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.UserKeys = resultSlice
+		object.R.NotificationSubscriptions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userKeyR{}
+				foreign.R = &notificationSubscriptionR{}
 			}
 			foreign.R.User = object
 		}
@@ -857,9 +1086,9 @@ func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UserKeys = append(local.R.UserKeys, foreign)
+				local.R.NotificationSubscriptions = append(local.R.NotificationSubscriptions, foreign)
 				if foreign.R == nil {
-					foreign.R = &userKeyR{}
+					foreign.R = &notificationSubscriptionR{}
 				}
 				foreign.R.User = local
 				break
@@ -870,23 +1099,429 @@ func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// AddApplications adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Applications.
-// Sets related.R.Users appropriately.
-func (o *User) AddApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		}
-	}
+// LoadRepositories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRepositories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-	for _, rel := range related {
-		query := "insert into `application_owners` (`user_id`, `application_id`) values (?, ?)"
-		values := []any{o.ID, rel.ID}
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("`repositories`.`id`, `repositories`.`name`, `repositories`.`url`, `a`.`user_id`"),
+		qm.From("`repositories`"),
+		qm.InnerJoin("`repository_owners` as `a` on `repositories`.`id` = `a`.`repository_id`"),
+		qm.WhereIn("`a`.`user_id` in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load repositories")
+	}
+
+	var resultSlice []*Repository
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Repository)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.URL, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for repositories")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice repositories")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on repositories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+	}
+
+	if len(repositoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Repositories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &repositoryR{}
+			}
+			foreign.R.Users = append(foreign.R.Users, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Repositories = append(local.R.Repositories, foreign)
+				if foreign.R == nil {
+					foreign.R = &repositoryR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTLSCertificates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTLSCertificates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tls_certificates`),
+		qm.WhereIn(`tls_certificates.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tls_certificates")
+	}
+
+	var resultSlice []*TLSCertificate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tls_certificates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tls_certificates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tls_certificates")
+	}
+
+	if len(tlsCertificateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TLSCertificates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tlsCertificateR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TLSCertificates = append(local.R.TLSCertificates, foreign)
+				if foreign.R == nil {
+					foreign.R = &tlsCertificateR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_keys`),
+		qm.WhereIn(`user_keys.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_keys")
+	}
+
+	var resultSlice []*UserKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_keys")
+	}
+
+	if len(userKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userKeyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserKeys = append(local.R.UserKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &userKeyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserResourceLimit of the user to the related item.
+// Sets o.R.UserResourceLimit to related.
+// Adds o to related.R.User.
+func (o *User) SetUserResourceLimit(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserResourceLimit) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `user_resource_limits` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+			strmangle.WhereClause("`", "`", 0, userResourceLimitPrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserResourceLimit: related,
+		}
+	} else {
+		o.R.UserResourceLimit = related
+	}
+
+	if related.R == nil {
+		related.R = &userResourceLimitR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddApplications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Applications.
+// Sets related.R.Users appropriately.
+func (o *User) AddApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into `application_owners` (`user_id`, `application_id`) values (?, ?)"
+		values := []any{o.ID, rel.ID}
 
 		if boil.IsDebug(ctx) {
 			writer := boil.DebugWriterFrom(ctx)
@@ -1015,6 +1650,112 @@ func removeApplicationsFromUsersSlice(o *User, related []*Application) {
 	}
 }
 
+// AddCustomDomains adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CustomDomains.
+// Sets related.R.User appropriately.
+func (o *User) AddCustomDomains(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CustomDomain) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `custom_domains` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, customDomainPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CustomDomains: related,
+		}
+	} else {
+		o.R.CustomDomains = append(o.R.CustomDomains, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &customDomainR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationSubscriptions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationSubscriptions.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationSubscriptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationSubscription) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notification_subscriptions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationSubscriptionPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationSubscriptions: related,
+		}
+	} else {
+		o.R.NotificationSubscriptions = append(o.R.NotificationSubscriptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationSubscriptionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddRepositories adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Repositories.
@@ -1160,6 +1901,59 @@ func removeRepositoriesFromUsersSlice(o *User, related []*Repository) {
 	}
 }
 
+// AddTLSCertificates adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TLSCertificates.
+// Sets related.R.User appropriately.
+func (o *User) AddTLSCertificates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TLSCertificate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tls_certificates` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tlsCertificatePrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TLSCertificates: related,
+		}
+	} else {
+		o.R.TLSCertificates = append(o.R.TLSCertificates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tlsCertificateR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddUserKeys adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.UserKeys.
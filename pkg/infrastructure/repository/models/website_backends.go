@@ -0,0 +1,1168 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteBackend is an object representing the database table.
+type WebsiteBackend struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// トラフィックを受けるアプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// トラフィックの重み
+	Weight int `boil:"weight" json:"weight" toml:"weight" yaml:"weight"`
+
+	R *websiteBackendR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteBackendL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteBackendColumns = struct {
+	WebsiteID     string
+	ApplicationID string
+	Weight        string
+}{
+	WebsiteID:     "website_id",
+	ApplicationID: "application_id",
+	Weight:        "weight",
+}
+
+var WebsiteBackendTableColumns = struct {
+	WebsiteID     string
+	ApplicationID string
+	Weight        string
+}{
+	WebsiteID:     "website_backends.website_id",
+	ApplicationID: "website_backends.application_id",
+	Weight:        "website_backends.weight",
+}
+
+// Generated where
+
+var WebsiteBackendWhere = struct {
+	WebsiteID     whereHelperstring
+	ApplicationID whereHelperstring
+	Weight        whereHelperint
+}{
+	WebsiteID:     whereHelperstring{field: "`website_backends`.`website_id`"},
+	ApplicationID: whereHelperstring{field: "`website_backends`.`application_id`"},
+	Weight:        whereHelperint{field: "`website_backends`.`weight`"},
+}
+
+// WebsiteBackendRels is where relationship names are stored.
+var WebsiteBackendRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteBackendR is where relationships are stored.
+type websiteBackendR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteBackendR) NewStruct() *websiteBackendR {
+	return &websiteBackendR{}
+}
+
+func (o *WebsiteBackend) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteBackendR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteBackendL is where Load methods for each relationship are stored.
+type websiteBackendL struct{}
+
+var (
+	websiteBackendAllColumns            = []string{"website_id", "application_id", "weight"}
+	websiteBackendColumnsWithoutDefault = []string{"website_id", "application_id", "weight"}
+	websiteBackendColumnsWithDefault    = []string{}
+	websiteBackendPrimaryKeyColumns     = []string{"website_id", "application_id"}
+	websiteBackendGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteBackendSlice is an alias for a slice of pointers to WebsiteBackend.
+	// This should almost always be used instead of []WebsiteBackend.
+	WebsiteBackendSlice []*WebsiteBackend
+	// WebsiteBackendHook is the signature for custom WebsiteBackend hook methods
+	WebsiteBackendHook func(context.Context, boil.ContextExecutor, *WebsiteBackend) error
+
+	websiteBackendQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteBackendType                 = reflect.TypeOf(&WebsiteBackend{})
+	websiteBackendMapping              = queries.MakeStructMapping(websiteBackendType)
+	websiteBackendPrimaryKeyMapping, _ = queries.BindMapping(websiteBackendType, websiteBackendMapping, websiteBackendPrimaryKeyColumns)
+	websiteBackendInsertCacheMut       sync.RWMutex
+	websiteBackendInsertCache          = make(map[string]insertCache)
+	websiteBackendUpdateCacheMut       sync.RWMutex
+	websiteBackendUpdateCache          = make(map[string]updateCache)
+	websiteBackendUpsertCacheMut       sync.RWMutex
+	websiteBackendUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteBackendAfterSelectMu sync.Mutex
+var websiteBackendAfterSelectHooks []WebsiteBackendHook
+
+var websiteBackendBeforeInsertMu sync.Mutex
+var websiteBackendBeforeInsertHooks []WebsiteBackendHook
+var websiteBackendAfterInsertMu sync.Mutex
+var websiteBackendAfterInsertHooks []WebsiteBackendHook
+
+var websiteBackendBeforeUpdateMu sync.Mutex
+var websiteBackendBeforeUpdateHooks []WebsiteBackendHook
+var websiteBackendAfterUpdateMu sync.Mutex
+var websiteBackendAfterUpdateHooks []WebsiteBackendHook
+
+var websiteBackendBeforeDeleteMu sync.Mutex
+var websiteBackendBeforeDeleteHooks []WebsiteBackendHook
+var websiteBackendAfterDeleteMu sync.Mutex
+var websiteBackendAfterDeleteHooks []WebsiteBackendHook
+
+var websiteBackendBeforeUpsertMu sync.Mutex
+var websiteBackendBeforeUpsertHooks []WebsiteBackendHook
+var websiteBackendAfterUpsertMu sync.Mutex
+var websiteBackendAfterUpsertHooks []WebsiteBackendHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteBackend) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteBackend) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteBackend) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteBackend) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteBackend) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteBackend) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteBackend) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteBackend) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteBackend) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBackendAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteBackendHook registers your hook function for all future operations.
+func AddWebsiteBackendHook(hookPoint boil.HookPoint, websiteBackendHook WebsiteBackendHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteBackendAfterSelectMu.Lock()
+		websiteBackendAfterSelectHooks = append(websiteBackendAfterSelectHooks, websiteBackendHook)
+		websiteBackendAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteBackendBeforeInsertMu.Lock()
+		websiteBackendBeforeInsertHooks = append(websiteBackendBeforeInsertHooks, websiteBackendHook)
+		websiteBackendBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteBackendAfterInsertMu.Lock()
+		websiteBackendAfterInsertHooks = append(websiteBackendAfterInsertHooks, websiteBackendHook)
+		websiteBackendAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteBackendBeforeUpdateMu.Lock()
+		websiteBackendBeforeUpdateHooks = append(websiteBackendBeforeUpdateHooks, websiteBackendHook)
+		websiteBackendBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteBackendAfterUpdateMu.Lock()
+		websiteBackendAfterUpdateHooks = append(websiteBackendAfterUpdateHooks, websiteBackendHook)
+		websiteBackendAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteBackendBeforeDeleteMu.Lock()
+		websiteBackendBeforeDeleteHooks = append(websiteBackendBeforeDeleteHooks, websiteBackendHook)
+		websiteBackendBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteBackendAfterDeleteMu.Lock()
+		websiteBackendAfterDeleteHooks = append(websiteBackendAfterDeleteHooks, websiteBackendHook)
+		websiteBackendAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteBackendBeforeUpsertMu.Lock()
+		websiteBackendBeforeUpsertHooks = append(websiteBackendBeforeUpsertHooks, websiteBackendHook)
+		websiteBackendBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteBackendAfterUpsertMu.Lock()
+		websiteBackendAfterUpsertHooks = append(websiteBackendAfterUpsertHooks, websiteBackendHook)
+		websiteBackendAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteBackend record from the query.
+func (q websiteBackendQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteBackend, error) {
+	o := &WebsiteBackend{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_backends")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteBackend records from the query.
+func (q websiteBackendQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteBackendSlice, error) {
+	var o []*WebsiteBackend
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteBackend slice")
+	}
+
+	if len(websiteBackendAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteBackend records in the query.
+func (q websiteBackendQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_backends rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteBackendQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_backends exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteBackend) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteBackendL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteBackend any, mods queries.Applicator) error {
+	var slice []*WebsiteBackend
+	var object *WebsiteBackend
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteBackend.(*WebsiteBackend)
+		if !ok {
+			object = new(WebsiteBackend)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteBackend)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteBackend))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteBackend.(*[]*WebsiteBackend)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteBackend)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteBackend))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteBackendR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteBackendR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteBackends = append(foreign.R.WebsiteBackends, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteBackends = append(foreign.R.WebsiteBackends, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteBackend to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteBackends.
+func (o *WebsiteBackend) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_backends` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteBackendPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID, o.ApplicationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteBackendR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteBackends: WebsiteBackendSlice{o},
+		}
+	} else {
+		related.R.WebsiteBackends = append(related.R.WebsiteBackends, o)
+	}
+
+	return nil
+}
+
+// WebsiteBackends retrieves all the records using an executor.
+func WebsiteBackends(mods ...qm.QueryMod) websiteBackendQuery {
+	mods = append(mods, qm.From("`website_backends`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_backends`.*"})
+	}
+
+	return websiteBackendQuery{q}
+}
+
+// FindWebsiteBackend retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteBackend(ctx context.Context, exec boil.ContextExecutor, websiteID string, applicationID string, selectCols ...string) (*WebsiteBackend, error) {
+	websiteBackendObj := &WebsiteBackend{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_backends` where `website_id`=? AND `application_id`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID, applicationID)
+
+	err := q.Bind(ctx, exec, websiteBackendObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_backends")
+	}
+
+	if err = websiteBackendObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteBackendObj, err
+	}
+
+	return websiteBackendObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteBackend) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_backends provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteBackendColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteBackendInsertCacheMut.RLock()
+	cache, cached := websiteBackendInsertCache[key]
+	websiteBackendInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteBackendAllColumns,
+			websiteBackendColumnsWithDefault,
+			websiteBackendColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteBackendType, websiteBackendMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteBackendType, websiteBackendMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_backends` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_backends` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_backends` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteBackendPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_backends")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+		o.ApplicationID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_backends")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteBackendInsertCacheMut.Lock()
+		websiteBackendInsertCache[key] = cache
+		websiteBackendInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteBackend.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteBackend) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteBackendUpdateCacheMut.RLock()
+	cache, cached := websiteBackendUpdateCache[key]
+	websiteBackendUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteBackendAllColumns,
+			websiteBackendPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_backends, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_backends` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteBackendPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteBackendType, websiteBackendMapping, append(wl, websiteBackendPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_backends row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_backends")
+	}
+
+	if !cached {
+		websiteBackendUpdateCacheMut.Lock()
+		websiteBackendUpdateCache[key] = cache
+		websiteBackendUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteBackendQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_backends")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_backends")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteBackendSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteBackendPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_backends` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteBackendPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteBackend slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteBackend")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteBackendUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteBackend) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_backends provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteBackendColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteBackendUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteBackendUpsertCacheMut.RLock()
+	cache, cached := websiteBackendUpsertCache[key]
+	websiteBackendUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteBackendAllColumns,
+			websiteBackendColumnsWithDefault,
+			websiteBackendColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteBackendAllColumns,
+			websiteBackendPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_backends, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteBackendAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_backends`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_backends` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteBackendType, websiteBackendMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteBackendType, websiteBackendMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_backends")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteBackendType, websiteBackendMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_backends")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_backends")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteBackendUpsertCacheMut.Lock()
+		websiteBackendUpsertCache[key] = cache
+		websiteBackendUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteBackend record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteBackend) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteBackend provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteBackendPrimaryKeyMapping)
+	sql := "DELETE FROM `website_backends` WHERE `website_id`=? AND `application_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_backends")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_backends")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteBackendQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteBackendQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_backends")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_backends")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteBackendSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteBackendBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteBackendPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_backends` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteBackendPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteBackend slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_backends")
+	}
+
+	if len(websiteBackendAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteBackend) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteBackend(ctx, exec, o.WebsiteID, o.ApplicationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteBackendSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteBackendSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteBackendPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_backends`.* FROM `website_backends` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteBackendPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteBackendSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteBackendExists checks if the WebsiteBackend row exists.
+func WebsiteBackendExists(ctx context.Context, exec boil.ContextExecutor, websiteID string, applicationID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_backends` where `website_id`=? AND `application_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID, applicationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID, applicationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_backends exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteBackend row exists.
+func (o *WebsiteBackend) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteBackendExists(ctx, exec, o.WebsiteID, o.ApplicationID)
+}
@@ -0,0 +1,1168 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteBasicAuthUser is an object representing the database table.
+type WebsiteBasicAuthUser struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// ユーザー名
+	Username string `boil:"username" json:"username" toml:"username" yaml:"username"`
+	// パスワードのbcryptハッシュ
+	PasswordHash string `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
+
+	R *websiteBasicAuthUserR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteBasicAuthUserL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteBasicAuthUserColumns = struct {
+	WebsiteID    string
+	Username     string
+	PasswordHash string
+}{
+	WebsiteID:    "website_id",
+	Username:     "username",
+	PasswordHash: "password_hash",
+}
+
+var WebsiteBasicAuthUserTableColumns = struct {
+	WebsiteID    string
+	Username     string
+	PasswordHash string
+}{
+	WebsiteID:    "website_basic_auth_users.website_id",
+	Username:     "website_basic_auth_users.username",
+	PasswordHash: "website_basic_auth_users.password_hash",
+}
+
+// Generated where
+
+var WebsiteBasicAuthUserWhere = struct {
+	WebsiteID    whereHelperstring
+	Username     whereHelperstring
+	PasswordHash whereHelperstring
+}{
+	WebsiteID:    whereHelperstring{field: "`website_basic_auth_users`.`website_id`"},
+	Username:     whereHelperstring{field: "`website_basic_auth_users`.`username`"},
+	PasswordHash: whereHelperstring{field: "`website_basic_auth_users`.`password_hash`"},
+}
+
+// WebsiteBasicAuthUserRels is where relationship names are stored.
+var WebsiteBasicAuthUserRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteBasicAuthUserR is where relationships are stored.
+type websiteBasicAuthUserR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteBasicAuthUserR) NewStruct() *websiteBasicAuthUserR {
+	return &websiteBasicAuthUserR{}
+}
+
+func (o *WebsiteBasicAuthUser) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteBasicAuthUserR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteBasicAuthUserL is where Load methods for each relationship are stored.
+type websiteBasicAuthUserL struct{}
+
+var (
+	websiteBasicAuthUserAllColumns            = []string{"website_id", "username", "password_hash"}
+	websiteBasicAuthUserColumnsWithoutDefault = []string{"website_id", "username", "password_hash"}
+	websiteBasicAuthUserColumnsWithDefault    = []string{}
+	websiteBasicAuthUserPrimaryKeyColumns     = []string{"website_id", "username"}
+	websiteBasicAuthUserGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteBasicAuthUserSlice is an alias for a slice of pointers to WebsiteBasicAuthUser.
+	// This should almost always be used instead of []WebsiteBasicAuthUser.
+	WebsiteBasicAuthUserSlice []*WebsiteBasicAuthUser
+	// WebsiteBasicAuthUserHook is the signature for custom WebsiteBasicAuthUser hook methods
+	WebsiteBasicAuthUserHook func(context.Context, boil.ContextExecutor, *WebsiteBasicAuthUser) error
+
+	websiteBasicAuthUserQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteBasicAuthUserType                 = reflect.TypeOf(&WebsiteBasicAuthUser{})
+	websiteBasicAuthUserMapping              = queries.MakeStructMapping(websiteBasicAuthUserType)
+	websiteBasicAuthUserPrimaryKeyMapping, _ = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, websiteBasicAuthUserPrimaryKeyColumns)
+	websiteBasicAuthUserInsertCacheMut       sync.RWMutex
+	websiteBasicAuthUserInsertCache          = make(map[string]insertCache)
+	websiteBasicAuthUserUpdateCacheMut       sync.RWMutex
+	websiteBasicAuthUserUpdateCache          = make(map[string]updateCache)
+	websiteBasicAuthUserUpsertCacheMut       sync.RWMutex
+	websiteBasicAuthUserUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteBasicAuthUserAfterSelectMu sync.Mutex
+var websiteBasicAuthUserAfterSelectHooks []WebsiteBasicAuthUserHook
+
+var websiteBasicAuthUserBeforeInsertMu sync.Mutex
+var websiteBasicAuthUserBeforeInsertHooks []WebsiteBasicAuthUserHook
+var websiteBasicAuthUserAfterInsertMu sync.Mutex
+var websiteBasicAuthUserAfterInsertHooks []WebsiteBasicAuthUserHook
+
+var websiteBasicAuthUserBeforeUpdateMu sync.Mutex
+var websiteBasicAuthUserBeforeUpdateHooks []WebsiteBasicAuthUserHook
+var websiteBasicAuthUserAfterUpdateMu sync.Mutex
+var websiteBasicAuthUserAfterUpdateHooks []WebsiteBasicAuthUserHook
+
+var websiteBasicAuthUserBeforeDeleteMu sync.Mutex
+var websiteBasicAuthUserBeforeDeleteHooks []WebsiteBasicAuthUserHook
+var websiteBasicAuthUserAfterDeleteMu sync.Mutex
+var websiteBasicAuthUserAfterDeleteHooks []WebsiteBasicAuthUserHook
+
+var websiteBasicAuthUserBeforeUpsertMu sync.Mutex
+var websiteBasicAuthUserBeforeUpsertHooks []WebsiteBasicAuthUserHook
+var websiteBasicAuthUserAfterUpsertMu sync.Mutex
+var websiteBasicAuthUserAfterUpsertHooks []WebsiteBasicAuthUserHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteBasicAuthUser) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteBasicAuthUser) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteBasicAuthUser) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteBasicAuthUser) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteBasicAuthUser) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteBasicAuthUser) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteBasicAuthUser) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteBasicAuthUser) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteBasicAuthUser) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteBasicAuthUserAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteBasicAuthUserHook registers your hook function for all future operations.
+func AddWebsiteBasicAuthUserHook(hookPoint boil.HookPoint, websiteBasicAuthUserHook WebsiteBasicAuthUserHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteBasicAuthUserAfterSelectMu.Lock()
+		websiteBasicAuthUserAfterSelectHooks = append(websiteBasicAuthUserAfterSelectHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteBasicAuthUserBeforeInsertMu.Lock()
+		websiteBasicAuthUserBeforeInsertHooks = append(websiteBasicAuthUserBeforeInsertHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteBasicAuthUserAfterInsertMu.Lock()
+		websiteBasicAuthUserAfterInsertHooks = append(websiteBasicAuthUserAfterInsertHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteBasicAuthUserBeforeUpdateMu.Lock()
+		websiteBasicAuthUserBeforeUpdateHooks = append(websiteBasicAuthUserBeforeUpdateHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteBasicAuthUserAfterUpdateMu.Lock()
+		websiteBasicAuthUserAfterUpdateHooks = append(websiteBasicAuthUserAfterUpdateHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteBasicAuthUserBeforeDeleteMu.Lock()
+		websiteBasicAuthUserBeforeDeleteHooks = append(websiteBasicAuthUserBeforeDeleteHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteBasicAuthUserAfterDeleteMu.Lock()
+		websiteBasicAuthUserAfterDeleteHooks = append(websiteBasicAuthUserAfterDeleteHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteBasicAuthUserBeforeUpsertMu.Lock()
+		websiteBasicAuthUserBeforeUpsertHooks = append(websiteBasicAuthUserBeforeUpsertHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteBasicAuthUserAfterUpsertMu.Lock()
+		websiteBasicAuthUserAfterUpsertHooks = append(websiteBasicAuthUserAfterUpsertHooks, websiteBasicAuthUserHook)
+		websiteBasicAuthUserAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteBasicAuthUser record from the query.
+func (q websiteBasicAuthUserQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteBasicAuthUser, error) {
+	o := &WebsiteBasicAuthUser{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_basic_auth_users")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteBasicAuthUser records from the query.
+func (q websiteBasicAuthUserQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteBasicAuthUserSlice, error) {
+	var o []*WebsiteBasicAuthUser
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteBasicAuthUser slice")
+	}
+
+	if len(websiteBasicAuthUserAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteBasicAuthUser records in the query.
+func (q websiteBasicAuthUserQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_basic_auth_users rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteBasicAuthUserQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_basic_auth_users exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteBasicAuthUser) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteBasicAuthUserL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteBasicAuthUser any, mods queries.Applicator) error {
+	var slice []*WebsiteBasicAuthUser
+	var object *WebsiteBasicAuthUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteBasicAuthUser.(*WebsiteBasicAuthUser)
+		if !ok {
+			object = new(WebsiteBasicAuthUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteBasicAuthUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteBasicAuthUser))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteBasicAuthUser.(*[]*WebsiteBasicAuthUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteBasicAuthUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteBasicAuthUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteBasicAuthUserR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteBasicAuthUserR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteBasicAuthUsers = append(foreign.R.WebsiteBasicAuthUsers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteBasicAuthUsers = append(foreign.R.WebsiteBasicAuthUsers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteBasicAuthUser to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteBasicAuthUsers.
+func (o *WebsiteBasicAuthUser) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_basic_auth_users` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteBasicAuthUserPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID, o.Username}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteBasicAuthUserR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteBasicAuthUsers: WebsiteBasicAuthUserSlice{o},
+		}
+	} else {
+		related.R.WebsiteBasicAuthUsers = append(related.R.WebsiteBasicAuthUsers, o)
+	}
+
+	return nil
+}
+
+// WebsiteBasicAuthUsers retrieves all the records using an executor.
+func WebsiteBasicAuthUsers(mods ...qm.QueryMod) websiteBasicAuthUserQuery {
+	mods = append(mods, qm.From("`website_basic_auth_users`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_basic_auth_users`.*"})
+	}
+
+	return websiteBasicAuthUserQuery{q}
+}
+
+// FindWebsiteBasicAuthUser retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteBasicAuthUser(ctx context.Context, exec boil.ContextExecutor, websiteID string, username string, selectCols ...string) (*WebsiteBasicAuthUser, error) {
+	websiteBasicAuthUserObj := &WebsiteBasicAuthUser{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_basic_auth_users` where `website_id`=? AND `username`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID, username)
+
+	err := q.Bind(ctx, exec, websiteBasicAuthUserObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_basic_auth_users")
+	}
+
+	if err = websiteBasicAuthUserObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteBasicAuthUserObj, err
+	}
+
+	return websiteBasicAuthUserObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteBasicAuthUser) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_basic_auth_users provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteBasicAuthUserColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteBasicAuthUserInsertCacheMut.RLock()
+	cache, cached := websiteBasicAuthUserInsertCache[key]
+	websiteBasicAuthUserInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteBasicAuthUserAllColumns,
+			websiteBasicAuthUserColumnsWithDefault,
+			websiteBasicAuthUserColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_basic_auth_users` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_basic_auth_users` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_basic_auth_users` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteBasicAuthUserPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_basic_auth_users")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+		o.Username,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_basic_auth_users")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteBasicAuthUserInsertCacheMut.Lock()
+		websiteBasicAuthUserInsertCache[key] = cache
+		websiteBasicAuthUserInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteBasicAuthUser.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteBasicAuthUser) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteBasicAuthUserUpdateCacheMut.RLock()
+	cache, cached := websiteBasicAuthUserUpdateCache[key]
+	websiteBasicAuthUserUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteBasicAuthUserAllColumns,
+			websiteBasicAuthUserPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_basic_auth_users, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_basic_auth_users` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteBasicAuthUserPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, append(wl, websiteBasicAuthUserPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_basic_auth_users row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_basic_auth_users")
+	}
+
+	if !cached {
+		websiteBasicAuthUserUpdateCacheMut.Lock()
+		websiteBasicAuthUserUpdateCache[key] = cache
+		websiteBasicAuthUserUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteBasicAuthUserQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_basic_auth_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_basic_auth_users")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteBasicAuthUserSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteBasicAuthUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_basic_auth_users` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteBasicAuthUserPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteBasicAuthUser slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteBasicAuthUser")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteBasicAuthUserUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteBasicAuthUser) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_basic_auth_users provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteBasicAuthUserColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteBasicAuthUserUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteBasicAuthUserUpsertCacheMut.RLock()
+	cache, cached := websiteBasicAuthUserUpsertCache[key]
+	websiteBasicAuthUserUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteBasicAuthUserAllColumns,
+			websiteBasicAuthUserColumnsWithDefault,
+			websiteBasicAuthUserColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteBasicAuthUserAllColumns,
+			websiteBasicAuthUserPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_basic_auth_users, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteBasicAuthUserAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_basic_auth_users`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_basic_auth_users` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_basic_auth_users")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteBasicAuthUserType, websiteBasicAuthUserMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_basic_auth_users")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_basic_auth_users")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteBasicAuthUserUpsertCacheMut.Lock()
+		websiteBasicAuthUserUpsertCache[key] = cache
+		websiteBasicAuthUserUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteBasicAuthUser record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteBasicAuthUser) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteBasicAuthUser provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteBasicAuthUserPrimaryKeyMapping)
+	sql := "DELETE FROM `website_basic_auth_users` WHERE `website_id`=? AND `username`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_basic_auth_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_basic_auth_users")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteBasicAuthUserQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteBasicAuthUserQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_basic_auth_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_basic_auth_users")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteBasicAuthUserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteBasicAuthUserBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteBasicAuthUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_basic_auth_users` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteBasicAuthUserPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteBasicAuthUser slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_basic_auth_users")
+	}
+
+	if len(websiteBasicAuthUserAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteBasicAuthUser) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteBasicAuthUser(ctx, exec, o.WebsiteID, o.Username)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteBasicAuthUserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteBasicAuthUserSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteBasicAuthUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_basic_auth_users`.* FROM `website_basic_auth_users` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteBasicAuthUserPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteBasicAuthUserSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteBasicAuthUserExists checks if the WebsiteBasicAuthUser row exists.
+func WebsiteBasicAuthUserExists(ctx context.Context, exec boil.ContextExecutor, websiteID string, username string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_basic_auth_users` where `website_id`=? AND `username`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID, username)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID, username)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_basic_auth_users exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteBasicAuthUser row exists.
+func (o *WebsiteBasicAuthUser) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteBasicAuthUserExists(ctx, exec, o.WebsiteID, o.Username)
+}
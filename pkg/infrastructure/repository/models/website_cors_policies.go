@@ -0,0 +1,1201 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteCorsPolicy is an object representing the database table.
+type WebsiteCorsPolicy struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// 許可するオリジン (カンマ区切り)
+	AllowOrigins string `boil:"allow_origins" json:"allow_origins" toml:"allow_origins" yaml:"allow_origins"`
+	// 許可するメソッド (カンマ区切り)
+	AllowMethods string `boil:"allow_methods" json:"allow_methods" toml:"allow_methods" yaml:"allow_methods"`
+	// 許可するリクエストヘッダー (カンマ区切り)
+	AllowHeaders string `boil:"allow_headers" json:"allow_headers" toml:"allow_headers" yaml:"allow_headers"`
+	// 公開するレスポンスヘッダー (カンマ区切り)
+	ExposeHeaders string `boil:"expose_headers" json:"expose_headers" toml:"expose_headers" yaml:"expose_headers"`
+	// 認証情報を許可するかどうか
+	AllowCredentials bool `boil:"allow_credentials" json:"allow_credentials" toml:"allow_credentials" yaml:"allow_credentials"`
+	// プリフライトレスポンスのキャッシュ秒数
+	MaxAge int `boil:"max_age" json:"max_age" toml:"max_age" yaml:"max_age"`
+
+	R *websiteCorsPolicyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteCorsPolicyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteCorsPolicyColumns = struct {
+	WebsiteID        string
+	AllowOrigins     string
+	AllowMethods     string
+	AllowHeaders     string
+	ExposeHeaders    string
+	AllowCredentials string
+	MaxAge           string
+}{
+	WebsiteID:        "website_id",
+	AllowOrigins:     "allow_origins",
+	AllowMethods:     "allow_methods",
+	AllowHeaders:     "allow_headers",
+	ExposeHeaders:    "expose_headers",
+	AllowCredentials: "allow_credentials",
+	MaxAge:           "max_age",
+}
+
+var WebsiteCorsPolicyTableColumns = struct {
+	WebsiteID        string
+	AllowOrigins     string
+	AllowMethods     string
+	AllowHeaders     string
+	ExposeHeaders    string
+	AllowCredentials string
+	MaxAge           string
+}{
+	WebsiteID:        "website_cors_policies.website_id",
+	AllowOrigins:     "website_cors_policies.allow_origins",
+	AllowMethods:     "website_cors_policies.allow_methods",
+	AllowHeaders:     "website_cors_policies.allow_headers",
+	ExposeHeaders:    "website_cors_policies.expose_headers",
+	AllowCredentials: "website_cors_policies.allow_credentials",
+	MaxAge:           "website_cors_policies.max_age",
+}
+
+// Generated where
+
+var WebsiteCorsPolicyWhere = struct {
+	WebsiteID        whereHelperstring
+	AllowOrigins     whereHelperstring
+	AllowMethods     whereHelperstring
+	AllowHeaders     whereHelperstring
+	ExposeHeaders    whereHelperstring
+	AllowCredentials whereHelperbool
+	MaxAge           whereHelperint
+}{
+	WebsiteID:        whereHelperstring{field: "`website_cors_policies`.`website_id`"},
+	AllowOrigins:     whereHelperstring{field: "`website_cors_policies`.`allow_origins`"},
+	AllowMethods:     whereHelperstring{field: "`website_cors_policies`.`allow_methods`"},
+	AllowHeaders:     whereHelperstring{field: "`website_cors_policies`.`allow_headers`"},
+	ExposeHeaders:    whereHelperstring{field: "`website_cors_policies`.`expose_headers`"},
+	AllowCredentials: whereHelperbool{field: "`website_cors_policies`.`allow_credentials`"},
+	MaxAge:           whereHelperint{field: "`website_cors_policies`.`max_age`"},
+}
+
+// WebsiteCorsPolicyRels is where relationship names are stored.
+var WebsiteCorsPolicyRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteCorsPolicyR is where relationships are stored.
+type websiteCorsPolicyR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteCorsPolicyR) NewStruct() *websiteCorsPolicyR {
+	return &websiteCorsPolicyR{}
+}
+
+func (o *WebsiteCorsPolicy) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteCorsPolicyR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteCorsPolicyL is where Load methods for each relationship are stored.
+type websiteCorsPolicyL struct{}
+
+var (
+	websiteCorsPolicyAllColumns            = []string{"website_id", "allow_origins", "allow_methods", "allow_headers", "expose_headers", "allow_credentials", "max_age"}
+	websiteCorsPolicyColumnsWithoutDefault = []string{"website_id", "allow_origins", "allow_methods", "allow_headers", "expose_headers", "allow_credentials", "max_age"}
+	websiteCorsPolicyColumnsWithDefault    = []string{}
+	websiteCorsPolicyPrimaryKeyColumns     = []string{"website_id"}
+	websiteCorsPolicyGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteCorsPolicySlice is an alias for a slice of pointers to WebsiteCorsPolicy.
+	// This should almost always be used instead of []WebsiteCorsPolicy.
+	WebsiteCorsPolicySlice []*WebsiteCorsPolicy
+	// WebsiteCorsPolicyHook is the signature for custom WebsiteCorsPolicy hook methods
+	WebsiteCorsPolicyHook func(context.Context, boil.ContextExecutor, *WebsiteCorsPolicy) error
+
+	websiteCorsPolicyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteCorsPolicyType                 = reflect.TypeOf(&WebsiteCorsPolicy{})
+	websiteCorsPolicyMapping              = queries.MakeStructMapping(websiteCorsPolicyType)
+	websiteCorsPolicyPrimaryKeyMapping, _ = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, websiteCorsPolicyPrimaryKeyColumns)
+	websiteCorsPolicyInsertCacheMut       sync.RWMutex
+	websiteCorsPolicyInsertCache          = make(map[string]insertCache)
+	websiteCorsPolicyUpdateCacheMut       sync.RWMutex
+	websiteCorsPolicyUpdateCache          = make(map[string]updateCache)
+	websiteCorsPolicyUpsertCacheMut       sync.RWMutex
+	websiteCorsPolicyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteCorsPolicyAfterSelectMu sync.Mutex
+var websiteCorsPolicyAfterSelectHooks []WebsiteCorsPolicyHook
+
+var websiteCorsPolicyBeforeInsertMu sync.Mutex
+var websiteCorsPolicyBeforeInsertHooks []WebsiteCorsPolicyHook
+var websiteCorsPolicyAfterInsertMu sync.Mutex
+var websiteCorsPolicyAfterInsertHooks []WebsiteCorsPolicyHook
+
+var websiteCorsPolicyBeforeUpdateMu sync.Mutex
+var websiteCorsPolicyBeforeUpdateHooks []WebsiteCorsPolicyHook
+var websiteCorsPolicyAfterUpdateMu sync.Mutex
+var websiteCorsPolicyAfterUpdateHooks []WebsiteCorsPolicyHook
+
+var websiteCorsPolicyBeforeDeleteMu sync.Mutex
+var websiteCorsPolicyBeforeDeleteHooks []WebsiteCorsPolicyHook
+var websiteCorsPolicyAfterDeleteMu sync.Mutex
+var websiteCorsPolicyAfterDeleteHooks []WebsiteCorsPolicyHook
+
+var websiteCorsPolicyBeforeUpsertMu sync.Mutex
+var websiteCorsPolicyBeforeUpsertHooks []WebsiteCorsPolicyHook
+var websiteCorsPolicyAfterUpsertMu sync.Mutex
+var websiteCorsPolicyAfterUpsertHooks []WebsiteCorsPolicyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteCorsPolicy) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteCorsPolicy) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteCorsPolicy) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteCorsPolicy) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteCorsPolicy) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteCorsPolicy) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteCorsPolicy) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteCorsPolicy) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteCorsPolicy) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteCorsPolicyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteCorsPolicyHook registers your hook function for all future operations.
+func AddWebsiteCorsPolicyHook(hookPoint boil.HookPoint, websiteCorsPolicyHook WebsiteCorsPolicyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteCorsPolicyAfterSelectMu.Lock()
+		websiteCorsPolicyAfterSelectHooks = append(websiteCorsPolicyAfterSelectHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteCorsPolicyBeforeInsertMu.Lock()
+		websiteCorsPolicyBeforeInsertHooks = append(websiteCorsPolicyBeforeInsertHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteCorsPolicyAfterInsertMu.Lock()
+		websiteCorsPolicyAfterInsertHooks = append(websiteCorsPolicyAfterInsertHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteCorsPolicyBeforeUpdateMu.Lock()
+		websiteCorsPolicyBeforeUpdateHooks = append(websiteCorsPolicyBeforeUpdateHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteCorsPolicyAfterUpdateMu.Lock()
+		websiteCorsPolicyAfterUpdateHooks = append(websiteCorsPolicyAfterUpdateHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteCorsPolicyBeforeDeleteMu.Lock()
+		websiteCorsPolicyBeforeDeleteHooks = append(websiteCorsPolicyBeforeDeleteHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteCorsPolicyAfterDeleteMu.Lock()
+		websiteCorsPolicyAfterDeleteHooks = append(websiteCorsPolicyAfterDeleteHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteCorsPolicyBeforeUpsertMu.Lock()
+		websiteCorsPolicyBeforeUpsertHooks = append(websiteCorsPolicyBeforeUpsertHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteCorsPolicyAfterUpsertMu.Lock()
+		websiteCorsPolicyAfterUpsertHooks = append(websiteCorsPolicyAfterUpsertHooks, websiteCorsPolicyHook)
+		websiteCorsPolicyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteCorsPolicy record from the query.
+func (q websiteCorsPolicyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteCorsPolicy, error) {
+	o := &WebsiteCorsPolicy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_cors_policies")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteCorsPolicy records from the query.
+func (q websiteCorsPolicyQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteCorsPolicySlice, error) {
+	var o []*WebsiteCorsPolicy
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteCorsPolicy slice")
+	}
+
+	if len(websiteCorsPolicyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteCorsPolicy records in the query.
+func (q websiteCorsPolicyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_cors_policies rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteCorsPolicyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_cors_policies exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteCorsPolicy) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteCorsPolicyL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteCorsPolicy any, mods queries.Applicator) error {
+	var slice []*WebsiteCorsPolicy
+	var object *WebsiteCorsPolicy
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteCorsPolicy.(*WebsiteCorsPolicy)
+		if !ok {
+			object = new(WebsiteCorsPolicy)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteCorsPolicy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteCorsPolicy))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteCorsPolicy.(*[]*WebsiteCorsPolicy)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteCorsPolicy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteCorsPolicy))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteCorsPolicyR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteCorsPolicyR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteCorsPolicy = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteCorsPolicy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteCorsPolicy to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteCorsPolicy.
+func (o *WebsiteCorsPolicy) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_cors_policies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteCorsPolicyPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteCorsPolicyR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteCorsPolicy: o,
+		}
+	} else {
+		related.R.WebsiteCorsPolicy = o
+	}
+
+	return nil
+}
+
+// WebsiteCorsPolicies retrieves all the records using an executor.
+func WebsiteCorsPolicies(mods ...qm.QueryMod) websiteCorsPolicyQuery {
+	mods = append(mods, qm.From("`website_cors_policies`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_cors_policies`.*"})
+	}
+
+	return websiteCorsPolicyQuery{q}
+}
+
+// FindWebsiteCorsPolicy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteCorsPolicy(ctx context.Context, exec boil.ContextExecutor, websiteID string, selectCols ...string) (*WebsiteCorsPolicy, error) {
+	websiteCorsPolicyObj := &WebsiteCorsPolicy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_cors_policies` where `website_id`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID)
+
+	err := q.Bind(ctx, exec, websiteCorsPolicyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_cors_policies")
+	}
+
+	if err = websiteCorsPolicyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteCorsPolicyObj, err
+	}
+
+	return websiteCorsPolicyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteCorsPolicy) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_cors_policies provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteCorsPolicyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteCorsPolicyInsertCacheMut.RLock()
+	cache, cached := websiteCorsPolicyInsertCache[key]
+	websiteCorsPolicyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteCorsPolicyAllColumns,
+			websiteCorsPolicyColumnsWithDefault,
+			websiteCorsPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_cors_policies` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_cors_policies` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_cors_policies` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteCorsPolicyPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_cors_policies")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_cors_policies")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteCorsPolicyInsertCacheMut.Lock()
+		websiteCorsPolicyInsertCache[key] = cache
+		websiteCorsPolicyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteCorsPolicy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteCorsPolicy) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteCorsPolicyUpdateCacheMut.RLock()
+	cache, cached := websiteCorsPolicyUpdateCache[key]
+	websiteCorsPolicyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteCorsPolicyAllColumns,
+			websiteCorsPolicyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_cors_policies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_cors_policies` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteCorsPolicyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, append(wl, websiteCorsPolicyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_cors_policies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_cors_policies")
+	}
+
+	if !cached {
+		websiteCorsPolicyUpdateCacheMut.Lock()
+		websiteCorsPolicyUpdateCache[key] = cache
+		websiteCorsPolicyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteCorsPolicyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_cors_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_cors_policies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteCorsPolicySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteCorsPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_cors_policies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteCorsPolicyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteCorsPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteCorsPolicy")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteCorsPolicyUniqueColumns = []string{
+	"website_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteCorsPolicy) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_cors_policies provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteCorsPolicyColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteCorsPolicyUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteCorsPolicyUpsertCacheMut.RLock()
+	cache, cached := websiteCorsPolicyUpsertCache[key]
+	websiteCorsPolicyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteCorsPolicyAllColumns,
+			websiteCorsPolicyColumnsWithDefault,
+			websiteCorsPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteCorsPolicyAllColumns,
+			websiteCorsPolicyPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_cors_policies, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteCorsPolicyAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_cors_policies`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_cors_policies` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_cors_policies")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteCorsPolicyType, websiteCorsPolicyMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_cors_policies")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_cors_policies")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteCorsPolicyUpsertCacheMut.Lock()
+		websiteCorsPolicyUpsertCache[key] = cache
+		websiteCorsPolicyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteCorsPolicy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteCorsPolicy) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteCorsPolicy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteCorsPolicyPrimaryKeyMapping)
+	sql := "DELETE FROM `website_cors_policies` WHERE `website_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_cors_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_cors_policies")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteCorsPolicyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteCorsPolicyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_cors_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_cors_policies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteCorsPolicySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteCorsPolicyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteCorsPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_cors_policies` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteCorsPolicyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteCorsPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_cors_policies")
+	}
+
+	if len(websiteCorsPolicyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteCorsPolicy) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteCorsPolicy(ctx, exec, o.WebsiteID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteCorsPolicySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteCorsPolicySlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteCorsPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_cors_policies`.* FROM `website_cors_policies` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteCorsPolicyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteCorsPolicySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteCorsPolicyExists checks if the WebsiteCorsPolicy row exists.
+func WebsiteCorsPolicyExists(ctx context.Context, exec boil.ContextExecutor, websiteID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_cors_policies` where `website_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_cors_policies exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteCorsPolicy row exists.
+func (o *WebsiteCorsPolicy) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteCorsPolicyExists(ctx, exec, o.WebsiteID)
+}
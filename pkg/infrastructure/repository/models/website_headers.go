@@ -0,0 +1,1168 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteHeader is an object representing the database table.
+type WebsiteHeader struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// ヘッダー名
+	Name string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	// ヘッダーの値
+	Value string `boil:"value" json:"value" toml:"value" yaml:"value"`
+
+	R *websiteHeaderR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteHeaderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteHeaderColumns = struct {
+	WebsiteID string
+	Name      string
+	Value     string
+}{
+	WebsiteID: "website_id",
+	Name:      "name",
+	Value:     "value",
+}
+
+var WebsiteHeaderTableColumns = struct {
+	WebsiteID string
+	Name      string
+	Value     string
+}{
+	WebsiteID: "website_headers.website_id",
+	Name:      "website_headers.name",
+	Value:     "website_headers.value",
+}
+
+// Generated where
+
+var WebsiteHeaderWhere = struct {
+	WebsiteID whereHelperstring
+	Name      whereHelperstring
+	Value     whereHelperstring
+}{
+	WebsiteID: whereHelperstring{field: "`website_headers`.`website_id`"},
+	Name:      whereHelperstring{field: "`website_headers`.`name`"},
+	Value:     whereHelperstring{field: "`website_headers`.`value`"},
+}
+
+// WebsiteHeaderRels is where relationship names are stored.
+var WebsiteHeaderRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteHeaderR is where relationships are stored.
+type websiteHeaderR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteHeaderR) NewStruct() *websiteHeaderR {
+	return &websiteHeaderR{}
+}
+
+func (o *WebsiteHeader) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteHeaderR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteHeaderL is where Load methods for each relationship are stored.
+type websiteHeaderL struct{}
+
+var (
+	websiteHeaderAllColumns            = []string{"website_id", "name", "value"}
+	websiteHeaderColumnsWithoutDefault = []string{"website_id", "name", "value"}
+	websiteHeaderColumnsWithDefault    = []string{}
+	websiteHeaderPrimaryKeyColumns     = []string{"website_id", "name"}
+	websiteHeaderGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteHeaderSlice is an alias for a slice of pointers to WebsiteHeader.
+	// This should almost always be used instead of []WebsiteHeader.
+	WebsiteHeaderSlice []*WebsiteHeader
+	// WebsiteHeaderHook is the signature for custom WebsiteHeader hook methods
+	WebsiteHeaderHook func(context.Context, boil.ContextExecutor, *WebsiteHeader) error
+
+	websiteHeaderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteHeaderType                 = reflect.TypeOf(&WebsiteHeader{})
+	websiteHeaderMapping              = queries.MakeStructMapping(websiteHeaderType)
+	websiteHeaderPrimaryKeyMapping, _ = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, websiteHeaderPrimaryKeyColumns)
+	websiteHeaderInsertCacheMut       sync.RWMutex
+	websiteHeaderInsertCache          = make(map[string]insertCache)
+	websiteHeaderUpdateCacheMut       sync.RWMutex
+	websiteHeaderUpdateCache          = make(map[string]updateCache)
+	websiteHeaderUpsertCacheMut       sync.RWMutex
+	websiteHeaderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteHeaderAfterSelectMu sync.Mutex
+var websiteHeaderAfterSelectHooks []WebsiteHeaderHook
+
+var websiteHeaderBeforeInsertMu sync.Mutex
+var websiteHeaderBeforeInsertHooks []WebsiteHeaderHook
+var websiteHeaderAfterInsertMu sync.Mutex
+var websiteHeaderAfterInsertHooks []WebsiteHeaderHook
+
+var websiteHeaderBeforeUpdateMu sync.Mutex
+var websiteHeaderBeforeUpdateHooks []WebsiteHeaderHook
+var websiteHeaderAfterUpdateMu sync.Mutex
+var websiteHeaderAfterUpdateHooks []WebsiteHeaderHook
+
+var websiteHeaderBeforeDeleteMu sync.Mutex
+var websiteHeaderBeforeDeleteHooks []WebsiteHeaderHook
+var websiteHeaderAfterDeleteMu sync.Mutex
+var websiteHeaderAfterDeleteHooks []WebsiteHeaderHook
+
+var websiteHeaderBeforeUpsertMu sync.Mutex
+var websiteHeaderBeforeUpsertHooks []WebsiteHeaderHook
+var websiteHeaderAfterUpsertMu sync.Mutex
+var websiteHeaderAfterUpsertHooks []WebsiteHeaderHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteHeader) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteHeader) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteHeader) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteHeader) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteHeader) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteHeader) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteHeader) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteHeader) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteHeader) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteHeaderAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteHeaderHook registers your hook function for all future operations.
+func AddWebsiteHeaderHook(hookPoint boil.HookPoint, websiteHeaderHook WebsiteHeaderHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteHeaderAfterSelectMu.Lock()
+		websiteHeaderAfterSelectHooks = append(websiteHeaderAfterSelectHooks, websiteHeaderHook)
+		websiteHeaderAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteHeaderBeforeInsertMu.Lock()
+		websiteHeaderBeforeInsertHooks = append(websiteHeaderBeforeInsertHooks, websiteHeaderHook)
+		websiteHeaderBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteHeaderAfterInsertMu.Lock()
+		websiteHeaderAfterInsertHooks = append(websiteHeaderAfterInsertHooks, websiteHeaderHook)
+		websiteHeaderAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteHeaderBeforeUpdateMu.Lock()
+		websiteHeaderBeforeUpdateHooks = append(websiteHeaderBeforeUpdateHooks, websiteHeaderHook)
+		websiteHeaderBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteHeaderAfterUpdateMu.Lock()
+		websiteHeaderAfterUpdateHooks = append(websiteHeaderAfterUpdateHooks, websiteHeaderHook)
+		websiteHeaderAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteHeaderBeforeDeleteMu.Lock()
+		websiteHeaderBeforeDeleteHooks = append(websiteHeaderBeforeDeleteHooks, websiteHeaderHook)
+		websiteHeaderBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteHeaderAfterDeleteMu.Lock()
+		websiteHeaderAfterDeleteHooks = append(websiteHeaderAfterDeleteHooks, websiteHeaderHook)
+		websiteHeaderAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteHeaderBeforeUpsertMu.Lock()
+		websiteHeaderBeforeUpsertHooks = append(websiteHeaderBeforeUpsertHooks, websiteHeaderHook)
+		websiteHeaderBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteHeaderAfterUpsertMu.Lock()
+		websiteHeaderAfterUpsertHooks = append(websiteHeaderAfterUpsertHooks, websiteHeaderHook)
+		websiteHeaderAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteHeader record from the query.
+func (q websiteHeaderQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteHeader, error) {
+	o := &WebsiteHeader{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_headers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteHeader records from the query.
+func (q websiteHeaderQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteHeaderSlice, error) {
+	var o []*WebsiteHeader
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteHeader slice")
+	}
+
+	if len(websiteHeaderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteHeader records in the query.
+func (q websiteHeaderQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_headers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteHeaderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_headers exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteHeader) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteHeaderL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteHeader any, mods queries.Applicator) error {
+	var slice []*WebsiteHeader
+	var object *WebsiteHeader
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteHeader.(*WebsiteHeader)
+		if !ok {
+			object = new(WebsiteHeader)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteHeader)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteHeader))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteHeader.(*[]*WebsiteHeader)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteHeader)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteHeader))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteHeaderR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteHeaderR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteHeaders = append(foreign.R.WebsiteHeaders, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteHeaders = append(foreign.R.WebsiteHeaders, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteHeader to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteHeaders.
+func (o *WebsiteHeader) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_headers` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteHeaderPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID, o.Name}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteHeaderR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteHeaders: WebsiteHeaderSlice{o},
+		}
+	} else {
+		related.R.WebsiteHeaders = append(related.R.WebsiteHeaders, o)
+	}
+
+	return nil
+}
+
+// WebsiteHeaders retrieves all the records using an executor.
+func WebsiteHeaders(mods ...qm.QueryMod) websiteHeaderQuery {
+	mods = append(mods, qm.From("`website_headers`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_headers`.*"})
+	}
+
+	return websiteHeaderQuery{q}
+}
+
+// FindWebsiteHeader retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteHeader(ctx context.Context, exec boil.ContextExecutor, websiteID string, name string, selectCols ...string) (*WebsiteHeader, error) {
+	websiteHeaderObj := &WebsiteHeader{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_headers` where `website_id`=? AND `name`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID, name)
+
+	err := q.Bind(ctx, exec, websiteHeaderObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_headers")
+	}
+
+	if err = websiteHeaderObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteHeaderObj, err
+	}
+
+	return websiteHeaderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteHeader) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_headers provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteHeaderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteHeaderInsertCacheMut.RLock()
+	cache, cached := websiteHeaderInsertCache[key]
+	websiteHeaderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteHeaderAllColumns,
+			websiteHeaderColumnsWithDefault,
+			websiteHeaderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_headers` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_headers` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_headers` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteHeaderPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_headers")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+		o.Name,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_headers")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteHeaderInsertCacheMut.Lock()
+		websiteHeaderInsertCache[key] = cache
+		websiteHeaderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteHeader.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteHeader) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteHeaderUpdateCacheMut.RLock()
+	cache, cached := websiteHeaderUpdateCache[key]
+	websiteHeaderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteHeaderAllColumns,
+			websiteHeaderPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_headers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_headers` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteHeaderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, append(wl, websiteHeaderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_headers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_headers")
+	}
+
+	if !cached {
+		websiteHeaderUpdateCacheMut.Lock()
+		websiteHeaderUpdateCache[key] = cache
+		websiteHeaderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteHeaderQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_headers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_headers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteHeaderSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteHeaderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_headers` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteHeaderPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteHeader slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteHeader")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteHeaderUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteHeader) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_headers provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteHeaderColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteHeaderUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteHeaderUpsertCacheMut.RLock()
+	cache, cached := websiteHeaderUpsertCache[key]
+	websiteHeaderUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteHeaderAllColumns,
+			websiteHeaderColumnsWithDefault,
+			websiteHeaderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteHeaderAllColumns,
+			websiteHeaderPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_headers, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteHeaderAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_headers`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_headers` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_headers")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteHeaderType, websiteHeaderMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_headers")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_headers")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteHeaderUpsertCacheMut.Lock()
+		websiteHeaderUpsertCache[key] = cache
+		websiteHeaderUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteHeader record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteHeader) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteHeader provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteHeaderPrimaryKeyMapping)
+	sql := "DELETE FROM `website_headers` WHERE `website_id`=? AND `name`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_headers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_headers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteHeaderQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteHeaderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_headers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_headers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteHeaderSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteHeaderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteHeaderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_headers` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteHeaderPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteHeader slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_headers")
+	}
+
+	if len(websiteHeaderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteHeader) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteHeader(ctx, exec, o.WebsiteID, o.Name)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteHeaderSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteHeaderSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteHeaderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_headers`.* FROM `website_headers` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteHeaderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteHeaderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteHeaderExists checks if the WebsiteHeader row exists.
+func WebsiteHeaderExists(ctx context.Context, exec boil.ContextExecutor, websiteID string, name string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_headers` where `website_id`=? AND `name`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID, name)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID, name)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_headers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteHeader row exists.
+func (o *WebsiteHeader) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteHeaderExists(ctx, exec, o.WebsiteID, o.Name)
+}
@@ -0,0 +1,1160 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteIPAllowlistEntry is an object representing the database table.
+type WebsiteIPAllowlistEntry struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// アクセスを許可するアドレス範囲
+	Cidr string `boil:"cidr" json:"cidr" toml:"cidr" yaml:"cidr"`
+
+	R *websiteIPAllowlistEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteIPAllowlistEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteIPAllowlistEntryColumns = struct {
+	WebsiteID string
+	Cidr      string
+}{
+	WebsiteID: "website_id",
+	Cidr:      "cidr",
+}
+
+var WebsiteIPAllowlistEntryTableColumns = struct {
+	WebsiteID string
+	Cidr      string
+}{
+	WebsiteID: "website_ip_allowlist_entries.website_id",
+	Cidr:      "website_ip_allowlist_entries.cidr",
+}
+
+// Generated where
+
+var WebsiteIPAllowlistEntryWhere = struct {
+	WebsiteID whereHelperstring
+	Cidr      whereHelperstring
+}{
+	WebsiteID: whereHelperstring{field: "`website_ip_allowlist_entries`.`website_id`"},
+	Cidr:      whereHelperstring{field: "`website_ip_allowlist_entries`.`cidr`"},
+}
+
+// WebsiteIPAllowlistEntryRels is where relationship names are stored.
+var WebsiteIPAllowlistEntryRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteIPAllowlistEntryR is where relationships are stored.
+type websiteIPAllowlistEntryR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteIPAllowlistEntryR) NewStruct() *websiteIPAllowlistEntryR {
+	return &websiteIPAllowlistEntryR{}
+}
+
+func (o *WebsiteIPAllowlistEntry) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteIPAllowlistEntryR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteIPAllowlistEntryL is where Load methods for each relationship are stored.
+type websiteIPAllowlistEntryL struct{}
+
+var (
+	websiteIPAllowlistEntryAllColumns            = []string{"website_id", "cidr"}
+	websiteIPAllowlistEntryColumnsWithoutDefault = []string{"website_id", "cidr"}
+	websiteIPAllowlistEntryColumnsWithDefault    = []string{}
+	websiteIPAllowlistEntryPrimaryKeyColumns     = []string{"website_id", "cidr"}
+	websiteIPAllowlistEntryGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteIPAllowlistEntrySlice is an alias for a slice of pointers to WebsiteIPAllowlistEntry.
+	// This should almost always be used instead of []WebsiteIPAllowlistEntry.
+	WebsiteIPAllowlistEntrySlice []*WebsiteIPAllowlistEntry
+	// WebsiteIPAllowlistEntryHook is the signature for custom WebsiteIPAllowlistEntry hook methods
+	WebsiteIPAllowlistEntryHook func(context.Context, boil.ContextExecutor, *WebsiteIPAllowlistEntry) error
+
+	websiteIPAllowlistEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteIPAllowlistEntryType                 = reflect.TypeOf(&WebsiteIPAllowlistEntry{})
+	websiteIPAllowlistEntryMapping              = queries.MakeStructMapping(websiteIPAllowlistEntryType)
+	websiteIPAllowlistEntryPrimaryKeyMapping, _ = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, websiteIPAllowlistEntryPrimaryKeyColumns)
+	websiteIPAllowlistEntryInsertCacheMut       sync.RWMutex
+	websiteIPAllowlistEntryInsertCache          = make(map[string]insertCache)
+	websiteIPAllowlistEntryUpdateCacheMut       sync.RWMutex
+	websiteIPAllowlistEntryUpdateCache          = make(map[string]updateCache)
+	websiteIPAllowlistEntryUpsertCacheMut       sync.RWMutex
+	websiteIPAllowlistEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteIPAllowlistEntryAfterSelectMu sync.Mutex
+var websiteIPAllowlistEntryAfterSelectHooks []WebsiteIPAllowlistEntryHook
+
+var websiteIPAllowlistEntryBeforeInsertMu sync.Mutex
+var websiteIPAllowlistEntryBeforeInsertHooks []WebsiteIPAllowlistEntryHook
+var websiteIPAllowlistEntryAfterInsertMu sync.Mutex
+var websiteIPAllowlistEntryAfterInsertHooks []WebsiteIPAllowlistEntryHook
+
+var websiteIPAllowlistEntryBeforeUpdateMu sync.Mutex
+var websiteIPAllowlistEntryBeforeUpdateHooks []WebsiteIPAllowlistEntryHook
+var websiteIPAllowlistEntryAfterUpdateMu sync.Mutex
+var websiteIPAllowlistEntryAfterUpdateHooks []WebsiteIPAllowlistEntryHook
+
+var websiteIPAllowlistEntryBeforeDeleteMu sync.Mutex
+var websiteIPAllowlistEntryBeforeDeleteHooks []WebsiteIPAllowlistEntryHook
+var websiteIPAllowlistEntryAfterDeleteMu sync.Mutex
+var websiteIPAllowlistEntryAfterDeleteHooks []WebsiteIPAllowlistEntryHook
+
+var websiteIPAllowlistEntryBeforeUpsertMu sync.Mutex
+var websiteIPAllowlistEntryBeforeUpsertHooks []WebsiteIPAllowlistEntryHook
+var websiteIPAllowlistEntryAfterUpsertMu sync.Mutex
+var websiteIPAllowlistEntryAfterUpsertHooks []WebsiteIPAllowlistEntryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteIPAllowlistEntry) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteIPAllowlistEntry) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteIPAllowlistEntry) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteIPAllowlistEntry) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteIPAllowlistEntry) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteIPAllowlistEntry) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteIPAllowlistEntry) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteIPAllowlistEntry) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteIPAllowlistEntry) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteIPAllowlistEntryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteIPAllowlistEntryHook registers your hook function for all future operations.
+func AddWebsiteIPAllowlistEntryHook(hookPoint boil.HookPoint, websiteIPAllowlistEntryHook WebsiteIPAllowlistEntryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteIPAllowlistEntryAfterSelectMu.Lock()
+		websiteIPAllowlistEntryAfterSelectHooks = append(websiteIPAllowlistEntryAfterSelectHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteIPAllowlistEntryBeforeInsertMu.Lock()
+		websiteIPAllowlistEntryBeforeInsertHooks = append(websiteIPAllowlistEntryBeforeInsertHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteIPAllowlistEntryAfterInsertMu.Lock()
+		websiteIPAllowlistEntryAfterInsertHooks = append(websiteIPAllowlistEntryAfterInsertHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteIPAllowlistEntryBeforeUpdateMu.Lock()
+		websiteIPAllowlistEntryBeforeUpdateHooks = append(websiteIPAllowlistEntryBeforeUpdateHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteIPAllowlistEntryAfterUpdateMu.Lock()
+		websiteIPAllowlistEntryAfterUpdateHooks = append(websiteIPAllowlistEntryAfterUpdateHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteIPAllowlistEntryBeforeDeleteMu.Lock()
+		websiteIPAllowlistEntryBeforeDeleteHooks = append(websiteIPAllowlistEntryBeforeDeleteHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteIPAllowlistEntryAfterDeleteMu.Lock()
+		websiteIPAllowlistEntryAfterDeleteHooks = append(websiteIPAllowlistEntryAfterDeleteHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteIPAllowlistEntryBeforeUpsertMu.Lock()
+		websiteIPAllowlistEntryBeforeUpsertHooks = append(websiteIPAllowlistEntryBeforeUpsertHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteIPAllowlistEntryAfterUpsertMu.Lock()
+		websiteIPAllowlistEntryAfterUpsertHooks = append(websiteIPAllowlistEntryAfterUpsertHooks, websiteIPAllowlistEntryHook)
+		websiteIPAllowlistEntryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteIPAllowlistEntry record from the query.
+func (q websiteIPAllowlistEntryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteIPAllowlistEntry, error) {
+	o := &WebsiteIPAllowlistEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_ip_allowlist_entries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteIPAllowlistEntry records from the query.
+func (q websiteIPAllowlistEntryQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteIPAllowlistEntrySlice, error) {
+	var o []*WebsiteIPAllowlistEntry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteIPAllowlistEntry slice")
+	}
+
+	if len(websiteIPAllowlistEntryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteIPAllowlistEntry records in the query.
+func (q websiteIPAllowlistEntryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_ip_allowlist_entries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteIPAllowlistEntryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_ip_allowlist_entries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteIPAllowlistEntry) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteIPAllowlistEntryL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteIPAllowlistEntry any, mods queries.Applicator) error {
+	var slice []*WebsiteIPAllowlistEntry
+	var object *WebsiteIPAllowlistEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteIPAllowlistEntry.(*WebsiteIPAllowlistEntry)
+		if !ok {
+			object = new(WebsiteIPAllowlistEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteIPAllowlistEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteIPAllowlistEntry))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteIPAllowlistEntry.(*[]*WebsiteIPAllowlistEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteIPAllowlistEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteIPAllowlistEntry))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteIPAllowlistEntryR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteIPAllowlistEntryR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteIPAllowlistEntries = append(foreign.R.WebsiteIPAllowlistEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteIPAllowlistEntries = append(foreign.R.WebsiteIPAllowlistEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteIPAllowlistEntry to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteIPAllowlistEntries.
+func (o *WebsiteIPAllowlistEntry) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_ip_allowlist_entries` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteIPAllowlistEntryPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID, o.Cidr}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteIPAllowlistEntryR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteIPAllowlistEntries: WebsiteIPAllowlistEntrySlice{o},
+		}
+	} else {
+		related.R.WebsiteIPAllowlistEntries = append(related.R.WebsiteIPAllowlistEntries, o)
+	}
+
+	return nil
+}
+
+// WebsiteIPAllowlistEntries retrieves all the records using an executor.
+func WebsiteIPAllowlistEntries(mods ...qm.QueryMod) websiteIPAllowlistEntryQuery {
+	mods = append(mods, qm.From("`website_ip_allowlist_entries`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_ip_allowlist_entries`.*"})
+	}
+
+	return websiteIPAllowlistEntryQuery{q}
+}
+
+// FindWebsiteIPAllowlistEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteIPAllowlistEntry(ctx context.Context, exec boil.ContextExecutor, websiteID string, cidr string, selectCols ...string) (*WebsiteIPAllowlistEntry, error) {
+	websiteIPAllowlistEntryObj := &WebsiteIPAllowlistEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_ip_allowlist_entries` where `website_id`=? AND `cidr`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID, cidr)
+
+	err := q.Bind(ctx, exec, websiteIPAllowlistEntryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_ip_allowlist_entries")
+	}
+
+	if err = websiteIPAllowlistEntryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteIPAllowlistEntryObj, err
+	}
+
+	return websiteIPAllowlistEntryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteIPAllowlistEntry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_ip_allowlist_entries provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteIPAllowlistEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteIPAllowlistEntryInsertCacheMut.RLock()
+	cache, cached := websiteIPAllowlistEntryInsertCache[key]
+	websiteIPAllowlistEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteIPAllowlistEntryAllColumns,
+			websiteIPAllowlistEntryColumnsWithDefault,
+			websiteIPAllowlistEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_ip_allowlist_entries` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_ip_allowlist_entries` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_ip_allowlist_entries` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteIPAllowlistEntryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_ip_allowlist_entries")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+		o.Cidr,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_ip_allowlist_entries")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteIPAllowlistEntryInsertCacheMut.Lock()
+		websiteIPAllowlistEntryInsertCache[key] = cache
+		websiteIPAllowlistEntryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteIPAllowlistEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteIPAllowlistEntry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteIPAllowlistEntryUpdateCacheMut.RLock()
+	cache, cached := websiteIPAllowlistEntryUpdateCache[key]
+	websiteIPAllowlistEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteIPAllowlistEntryAllColumns,
+			websiteIPAllowlistEntryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_ip_allowlist_entries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_ip_allowlist_entries` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteIPAllowlistEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, append(wl, websiteIPAllowlistEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_ip_allowlist_entries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_ip_allowlist_entries")
+	}
+
+	if !cached {
+		websiteIPAllowlistEntryUpdateCacheMut.Lock()
+		websiteIPAllowlistEntryUpdateCache[key] = cache
+		websiteIPAllowlistEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteIPAllowlistEntryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_ip_allowlist_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_ip_allowlist_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteIPAllowlistEntrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteIPAllowlistEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_ip_allowlist_entries` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteIPAllowlistEntryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteIPAllowlistEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteIPAllowlistEntry")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteIPAllowlistEntryUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteIPAllowlistEntry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_ip_allowlist_entries provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteIPAllowlistEntryColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteIPAllowlistEntryUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteIPAllowlistEntryUpsertCacheMut.RLock()
+	cache, cached := websiteIPAllowlistEntryUpsertCache[key]
+	websiteIPAllowlistEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteIPAllowlistEntryAllColumns,
+			websiteIPAllowlistEntryColumnsWithDefault,
+			websiteIPAllowlistEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteIPAllowlistEntryAllColumns,
+			websiteIPAllowlistEntryPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_ip_allowlist_entries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteIPAllowlistEntryAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_ip_allowlist_entries`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_ip_allowlist_entries` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_ip_allowlist_entries")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteIPAllowlistEntryType, websiteIPAllowlistEntryMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_ip_allowlist_entries")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_ip_allowlist_entries")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteIPAllowlistEntryUpsertCacheMut.Lock()
+		websiteIPAllowlistEntryUpsertCache[key] = cache
+		websiteIPAllowlistEntryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteIPAllowlistEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteIPAllowlistEntry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteIPAllowlistEntry provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteIPAllowlistEntryPrimaryKeyMapping)
+	sql := "DELETE FROM `website_ip_allowlist_entries` WHERE `website_id`=? AND `cidr`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_ip_allowlist_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_ip_allowlist_entries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteIPAllowlistEntryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteIPAllowlistEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_ip_allowlist_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_ip_allowlist_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteIPAllowlistEntrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteIPAllowlistEntryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteIPAllowlistEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_ip_allowlist_entries` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteIPAllowlistEntryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteIPAllowlistEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_ip_allowlist_entries")
+	}
+
+	if len(websiteIPAllowlistEntryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteIPAllowlistEntry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteIPAllowlistEntry(ctx, exec, o.WebsiteID, o.Cidr)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteIPAllowlistEntrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteIPAllowlistEntrySlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteIPAllowlistEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_ip_allowlist_entries`.* FROM `website_ip_allowlist_entries` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteIPAllowlistEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteIPAllowlistEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteIPAllowlistEntryExists checks if the WebsiteIPAllowlistEntry row exists.
+func WebsiteIPAllowlistEntryExists(ctx context.Context, exec boil.ContextExecutor, websiteID string, cidr string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_ip_allowlist_entries` where `website_id`=? AND `cidr`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID, cidr)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID, cidr)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_ip_allowlist_entries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteIPAllowlistEntry row exists.
+func (o *WebsiteIPAllowlistEntry) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteIPAllowlistEntryExists(ctx, exec, o.WebsiteID, o.Cidr)
+}
@@ -0,0 +1,1218 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteProbe is an object representing the database table.
+type WebsiteProbe struct {
+	// プローブID
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// ウェブサイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// アプリケーションID
+	ApplicationID string `boil:"application_id" json:"application_id" toml:"application_id" yaml:"application_id"`
+	// 確認日時
+	CheckedAt time.Time `boil:"checked_at" json:"checked_at" toml:"checked_at" yaml:"checked_at"`
+	// 稼働しているか
+	Up bool `boil:"up" json:"up" toml:"up" yaml:"up"`
+	// HTTPステータスコード (応答がない場合は0)
+	StatusCode int `boil:"status_code" json:"status_code" toml:"status_code" yaml:"status_code"`
+	// 応答時間 (ミリ秒)
+	LatencyMS int `boil:"latency_ms" json:"latency_ms" toml:"latency_ms" yaml:"latency_ms"`
+	// TLS証明書の有効期限
+	TLSExpiresAt null.Time `boil:"tls_expires_at" json:"tls_expires_at,omitempty" toml:"tls_expires_at" yaml:"tls_expires_at,omitempty"`
+	// エラーメッセージ
+	Error string `boil:"error" json:"error" toml:"error" yaml:"error"`
+
+	R *websiteProbeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteProbeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteProbeColumns = struct {
+	ID            string
+	WebsiteID     string
+	ApplicationID string
+	CheckedAt     string
+	Up            string
+	StatusCode    string
+	LatencyMS     string
+	TLSExpiresAt  string
+	Error         string
+}{
+	ID:            "id",
+	WebsiteID:     "website_id",
+	ApplicationID: "application_id",
+	CheckedAt:     "checked_at",
+	Up:            "up",
+	StatusCode:    "status_code",
+	LatencyMS:     "latency_ms",
+	TLSExpiresAt:  "tls_expires_at",
+	Error:         "error",
+}
+
+var WebsiteProbeTableColumns = struct {
+	ID            string
+	WebsiteID     string
+	ApplicationID string
+	CheckedAt     string
+	Up            string
+	StatusCode    string
+	LatencyMS     string
+	TLSExpiresAt  string
+	Error         string
+}{
+	ID:            "website_probes.id",
+	WebsiteID:     "website_probes.website_id",
+	ApplicationID: "website_probes.application_id",
+	CheckedAt:     "website_probes.checked_at",
+	Up:            "website_probes.up",
+	StatusCode:    "website_probes.status_code",
+	LatencyMS:     "website_probes.latency_ms",
+	TLSExpiresAt:  "website_probes.tls_expires_at",
+	Error:         "website_probes.error",
+}
+
+// Generated where
+
+var WebsiteProbeWhere = struct {
+	ID            whereHelperstring
+	WebsiteID     whereHelperstring
+	ApplicationID whereHelperstring
+	CheckedAt     whereHelpertime_Time
+	Up            whereHelperbool
+	StatusCode    whereHelperint
+	LatencyMS     whereHelperint
+	TLSExpiresAt  whereHelpernull_Time
+	Error         whereHelperstring
+}{
+	ID:            whereHelperstring{field: "`website_probes`.`id`"},
+	WebsiteID:     whereHelperstring{field: "`website_probes`.`website_id`"},
+	ApplicationID: whereHelperstring{field: "`website_probes`.`application_id`"},
+	CheckedAt:     whereHelpertime_Time{field: "`website_probes`.`checked_at`"},
+	Up:            whereHelperbool{field: "`website_probes`.`up`"},
+	StatusCode:    whereHelperint{field: "`website_probes`.`status_code`"},
+	LatencyMS:     whereHelperint{field: "`website_probes`.`latency_ms`"},
+	TLSExpiresAt:  whereHelpernull_Time{field: "`website_probes`.`tls_expires_at`"},
+	Error:         whereHelperstring{field: "`website_probes`.`error`"},
+}
+
+// WebsiteProbeRels is where relationship names are stored.
+var WebsiteProbeRels = struct {
+	Application string
+}{
+	Application: "Application",
+}
+
+// websiteProbeR is where relationships are stored.
+type websiteProbeR struct {
+	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteProbeR) NewStruct() *websiteProbeR {
+	return &websiteProbeR{}
+}
+
+func (o *WebsiteProbe) GetApplication() *Application {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetApplication()
+}
+
+func (r *websiteProbeR) GetApplication() *Application {
+	if r == nil {
+		return nil
+	}
+
+	return r.Application
+}
+
+// websiteProbeL is where Load methods for each relationship are stored.
+type websiteProbeL struct{}
+
+var (
+	websiteProbeAllColumns            = []string{"id", "website_id", "application_id", "checked_at", "up", "status_code", "latency_ms", "tls_expires_at", "error"}
+	websiteProbeColumnsWithoutDefault = []string{"id", "website_id", "application_id", "checked_at", "up", "status_code", "latency_ms", "tls_expires_at", "error"}
+	websiteProbeColumnsWithDefault    = []string{}
+	websiteProbePrimaryKeyColumns     = []string{"id"}
+	websiteProbeGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteProbeSlice is an alias for a slice of pointers to WebsiteProbe.
+	// This should almost always be used instead of []WebsiteProbe.
+	WebsiteProbeSlice []*WebsiteProbe
+	// WebsiteProbeHook is the signature for custom WebsiteProbe hook methods
+	WebsiteProbeHook func(context.Context, boil.ContextExecutor, *WebsiteProbe) error
+
+	websiteProbeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteProbeType                 = reflect.TypeOf(&WebsiteProbe{})
+	websiteProbeMapping              = queries.MakeStructMapping(websiteProbeType)
+	websiteProbePrimaryKeyMapping, _ = queries.BindMapping(websiteProbeType, websiteProbeMapping, websiteProbePrimaryKeyColumns)
+	websiteProbeInsertCacheMut       sync.RWMutex
+	websiteProbeInsertCache          = make(map[string]insertCache)
+	websiteProbeUpdateCacheMut       sync.RWMutex
+	websiteProbeUpdateCache          = make(map[string]updateCache)
+	websiteProbeUpsertCacheMut       sync.RWMutex
+	websiteProbeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteProbeAfterSelectMu sync.Mutex
+var websiteProbeAfterSelectHooks []WebsiteProbeHook
+
+var websiteProbeBeforeInsertMu sync.Mutex
+var websiteProbeBeforeInsertHooks []WebsiteProbeHook
+var websiteProbeAfterInsertMu sync.Mutex
+var websiteProbeAfterInsertHooks []WebsiteProbeHook
+
+var websiteProbeBeforeUpdateMu sync.Mutex
+var websiteProbeBeforeUpdateHooks []WebsiteProbeHook
+var websiteProbeAfterUpdateMu sync.Mutex
+var websiteProbeAfterUpdateHooks []WebsiteProbeHook
+
+var websiteProbeBeforeDeleteMu sync.Mutex
+var websiteProbeBeforeDeleteHooks []WebsiteProbeHook
+var websiteProbeAfterDeleteMu sync.Mutex
+var websiteProbeAfterDeleteHooks []WebsiteProbeHook
+
+var websiteProbeBeforeUpsertMu sync.Mutex
+var websiteProbeBeforeUpsertHooks []WebsiteProbeHook
+var websiteProbeAfterUpsertMu sync.Mutex
+var websiteProbeAfterUpsertHooks []WebsiteProbeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteProbe) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteProbe) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteProbe) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteProbe) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteProbe) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteProbe) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteProbe) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteProbe) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteProbe) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteProbeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteProbeHook registers your hook function for all future operations.
+func AddWebsiteProbeHook(hookPoint boil.HookPoint, websiteProbeHook WebsiteProbeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteProbeAfterSelectMu.Lock()
+		websiteProbeAfterSelectHooks = append(websiteProbeAfterSelectHooks, websiteProbeHook)
+		websiteProbeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteProbeBeforeInsertMu.Lock()
+		websiteProbeBeforeInsertHooks = append(websiteProbeBeforeInsertHooks, websiteProbeHook)
+		websiteProbeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteProbeAfterInsertMu.Lock()
+		websiteProbeAfterInsertHooks = append(websiteProbeAfterInsertHooks, websiteProbeHook)
+		websiteProbeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteProbeBeforeUpdateMu.Lock()
+		websiteProbeBeforeUpdateHooks = append(websiteProbeBeforeUpdateHooks, websiteProbeHook)
+		websiteProbeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteProbeAfterUpdateMu.Lock()
+		websiteProbeAfterUpdateHooks = append(websiteProbeAfterUpdateHooks, websiteProbeHook)
+		websiteProbeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteProbeBeforeDeleteMu.Lock()
+		websiteProbeBeforeDeleteHooks = append(websiteProbeBeforeDeleteHooks, websiteProbeHook)
+		websiteProbeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteProbeAfterDeleteMu.Lock()
+		websiteProbeAfterDeleteHooks = append(websiteProbeAfterDeleteHooks, websiteProbeHook)
+		websiteProbeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteProbeBeforeUpsertMu.Lock()
+		websiteProbeBeforeUpsertHooks = append(websiteProbeBeforeUpsertHooks, websiteProbeHook)
+		websiteProbeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteProbeAfterUpsertMu.Lock()
+		websiteProbeAfterUpsertHooks = append(websiteProbeAfterUpsertHooks, websiteProbeHook)
+		websiteProbeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteProbe record from the query.
+func (q websiteProbeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteProbe, error) {
+	o := &WebsiteProbe{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_probes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteProbe records from the query.
+func (q websiteProbeQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteProbeSlice, error) {
+	var o []*WebsiteProbe
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteProbe slice")
+	}
+
+	if len(websiteProbeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteProbe records in the query.
+func (q websiteProbeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_probes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteProbeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_probes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Application pointed to by the foreign key.
+func (o *WebsiteProbe) Application(mods ...qm.QueryMod) applicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ApplicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Applications(queryMods...)
+}
+
+// LoadApplication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteProbeL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteProbe any, mods queries.Applicator) error {
+	var slice []*WebsiteProbe
+	var object *WebsiteProbe
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteProbe.(*WebsiteProbe)
+		if !ok {
+			object = new(WebsiteProbe)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteProbe)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteProbe))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteProbe.(*[]*WebsiteProbe)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteProbe)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteProbe))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteProbeR{}
+		}
+		args[object.ApplicationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteProbeR{}
+			}
+
+			args[obj.ApplicationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Application")
+	}
+
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Application")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for applications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Application = foreign
+		if foreign.R == nil {
+			foreign.R = &applicationR{}
+		}
+		foreign.R.WebsiteProbes = append(foreign.R.WebsiteProbes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ApplicationID == foreign.ID {
+				local.R.Application = foreign
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.WebsiteProbes = append(foreign.R.WebsiteProbes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the websiteProbe to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.WebsiteProbes.
+func (o *WebsiteProbe) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_probes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteProbePrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &websiteProbeR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			WebsiteProbes: WebsiteProbeSlice{o},
+		}
+	} else {
+		related.R.WebsiteProbes = append(related.R.WebsiteProbes, o)
+	}
+
+	return nil
+}
+
+// WebsiteProbes retrieves all the records using an executor.
+func WebsiteProbes(mods ...qm.QueryMod) websiteProbeQuery {
+	mods = append(mods, qm.From("`website_probes`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_probes`.*"})
+	}
+
+	return websiteProbeQuery{q}
+}
+
+// FindWebsiteProbe retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteProbe(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WebsiteProbe, error) {
+	websiteProbeObj := &WebsiteProbe{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_probes` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, websiteProbeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_probes")
+	}
+
+	if err = websiteProbeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteProbeObj, err
+	}
+
+	return websiteProbeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteProbe) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_probes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteProbeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteProbeInsertCacheMut.RLock()
+	cache, cached := websiteProbeInsertCache[key]
+	websiteProbeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteProbeAllColumns,
+			websiteProbeColumnsWithDefault,
+			websiteProbeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteProbeType, websiteProbeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteProbeType, websiteProbeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_probes` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_probes` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_probes` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteProbePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_probes")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_probes")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteProbeInsertCacheMut.Lock()
+		websiteProbeInsertCache[key] = cache
+		websiteProbeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteProbe.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteProbe) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteProbeUpdateCacheMut.RLock()
+	cache, cached := websiteProbeUpdateCache[key]
+	websiteProbeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteProbeAllColumns,
+			websiteProbePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_probes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_probes` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteProbePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteProbeType, websiteProbeMapping, append(wl, websiteProbePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_probes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_probes")
+	}
+
+	if !cached {
+		websiteProbeUpdateCacheMut.Lock()
+		websiteProbeUpdateCache[key] = cache
+		websiteProbeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteProbeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_probes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_probes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteProbeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteProbePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_probes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteProbePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteProbe slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteProbe")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteProbeUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteProbe) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_probes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteProbeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteProbeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteProbeUpsertCacheMut.RLock()
+	cache, cached := websiteProbeUpsertCache[key]
+	websiteProbeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteProbeAllColumns,
+			websiteProbeColumnsWithDefault,
+			websiteProbeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteProbeAllColumns,
+			websiteProbePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_probes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteProbeAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_probes`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_probes` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteProbeType, websiteProbeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteProbeType, websiteProbeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_probes")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteProbeType, websiteProbeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_probes")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_probes")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteProbeUpsertCacheMut.Lock()
+		websiteProbeUpsertCache[key] = cache
+		websiteProbeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteProbe record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteProbe) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteProbe provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteProbePrimaryKeyMapping)
+	sql := "DELETE FROM `website_probes` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_probes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_probes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteProbeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteProbeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_probes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_probes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteProbeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteProbeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteProbePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_probes` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteProbePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteProbe slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_probes")
+	}
+
+	if len(websiteProbeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteProbe) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteProbe(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteProbeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteProbeSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteProbePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_probes`.* FROM `website_probes` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteProbePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteProbeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteProbeExists checks if the WebsiteProbe row exists.
+func WebsiteProbeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_probes` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_probes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteProbe row exists.
+func (o *WebsiteProbe) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteProbeExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1177 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteRateLimit is an object representing the database table.
+type WebsiteRateLimit struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// 1秒あたりの平均リクエスト数
+	Average int `boil:"average" json:"average" toml:"average" yaml:"average"`
+	// 同時に許可する最大リクエスト数
+	Burst int `boil:"burst" json:"burst" toml:"burst" yaml:"burst"`
+	// クライアントを区別するリクエストヘッダー名 (空の場合はIPアドレス)
+	SourceHeader string `boil:"source_header" json:"source_header" toml:"source_header" yaml:"source_header"`
+
+	R *websiteRateLimitR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteRateLimitL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteRateLimitColumns = struct {
+	WebsiteID    string
+	Average      string
+	Burst        string
+	SourceHeader string
+}{
+	WebsiteID:    "website_id",
+	Average:      "average",
+	Burst:        "burst",
+	SourceHeader: "source_header",
+}
+
+var WebsiteRateLimitTableColumns = struct {
+	WebsiteID    string
+	Average      string
+	Burst        string
+	SourceHeader string
+}{
+	WebsiteID:    "website_rate_limits.website_id",
+	Average:      "website_rate_limits.average",
+	Burst:        "website_rate_limits.burst",
+	SourceHeader: "website_rate_limits.source_header",
+}
+
+// Generated where
+
+var WebsiteRateLimitWhere = struct {
+	WebsiteID    whereHelperstring
+	Average      whereHelperint
+	Burst        whereHelperint
+	SourceHeader whereHelperstring
+}{
+	WebsiteID:    whereHelperstring{field: "`website_rate_limits`.`website_id`"},
+	Average:      whereHelperint{field: "`website_rate_limits`.`average`"},
+	Burst:        whereHelperint{field: "`website_rate_limits`.`burst`"},
+	SourceHeader: whereHelperstring{field: "`website_rate_limits`.`source_header`"},
+}
+
+// WebsiteRateLimitRels is where relationship names are stored.
+var WebsiteRateLimitRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteRateLimitR is where relationships are stored.
+type websiteRateLimitR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteRateLimitR) NewStruct() *websiteRateLimitR {
+	return &websiteRateLimitR{}
+}
+
+func (o *WebsiteRateLimit) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteRateLimitR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteRateLimitL is where Load methods for each relationship are stored.
+type websiteRateLimitL struct{}
+
+var (
+	websiteRateLimitAllColumns            = []string{"website_id", "average", "burst", "source_header"}
+	websiteRateLimitColumnsWithoutDefault = []string{"website_id", "average", "burst", "source_header"}
+	websiteRateLimitColumnsWithDefault    = []string{}
+	websiteRateLimitPrimaryKeyColumns     = []string{"website_id"}
+	websiteRateLimitGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteRateLimitSlice is an alias for a slice of pointers to WebsiteRateLimit.
+	// This should almost always be used instead of []WebsiteRateLimit.
+	WebsiteRateLimitSlice []*WebsiteRateLimit
+	// WebsiteRateLimitHook is the signature for custom WebsiteRateLimit hook methods
+	WebsiteRateLimitHook func(context.Context, boil.ContextExecutor, *WebsiteRateLimit) error
+
+	websiteRateLimitQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteRateLimitType                 = reflect.TypeOf(&WebsiteRateLimit{})
+	websiteRateLimitMapping              = queries.MakeStructMapping(websiteRateLimitType)
+	websiteRateLimitPrimaryKeyMapping, _ = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, websiteRateLimitPrimaryKeyColumns)
+	websiteRateLimitInsertCacheMut       sync.RWMutex
+	websiteRateLimitInsertCache          = make(map[string]insertCache)
+	websiteRateLimitUpdateCacheMut       sync.RWMutex
+	websiteRateLimitUpdateCache          = make(map[string]updateCache)
+	websiteRateLimitUpsertCacheMut       sync.RWMutex
+	websiteRateLimitUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteRateLimitAfterSelectMu sync.Mutex
+var websiteRateLimitAfterSelectHooks []WebsiteRateLimitHook
+
+var websiteRateLimitBeforeInsertMu sync.Mutex
+var websiteRateLimitBeforeInsertHooks []WebsiteRateLimitHook
+var websiteRateLimitAfterInsertMu sync.Mutex
+var websiteRateLimitAfterInsertHooks []WebsiteRateLimitHook
+
+var websiteRateLimitBeforeUpdateMu sync.Mutex
+var websiteRateLimitBeforeUpdateHooks []WebsiteRateLimitHook
+var websiteRateLimitAfterUpdateMu sync.Mutex
+var websiteRateLimitAfterUpdateHooks []WebsiteRateLimitHook
+
+var websiteRateLimitBeforeDeleteMu sync.Mutex
+var websiteRateLimitBeforeDeleteHooks []WebsiteRateLimitHook
+var websiteRateLimitAfterDeleteMu sync.Mutex
+var websiteRateLimitAfterDeleteHooks []WebsiteRateLimitHook
+
+var websiteRateLimitBeforeUpsertMu sync.Mutex
+var websiteRateLimitBeforeUpsertHooks []WebsiteRateLimitHook
+var websiteRateLimitAfterUpsertMu sync.Mutex
+var websiteRateLimitAfterUpsertHooks []WebsiteRateLimitHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteRateLimit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteRateLimit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteRateLimit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteRateLimit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteRateLimit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteRateLimit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteRateLimit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteRateLimit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteRateLimit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRateLimitAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteRateLimitHook registers your hook function for all future operations.
+func AddWebsiteRateLimitHook(hookPoint boil.HookPoint, websiteRateLimitHook WebsiteRateLimitHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteRateLimitAfterSelectMu.Lock()
+		websiteRateLimitAfterSelectHooks = append(websiteRateLimitAfterSelectHooks, websiteRateLimitHook)
+		websiteRateLimitAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteRateLimitBeforeInsertMu.Lock()
+		websiteRateLimitBeforeInsertHooks = append(websiteRateLimitBeforeInsertHooks, websiteRateLimitHook)
+		websiteRateLimitBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteRateLimitAfterInsertMu.Lock()
+		websiteRateLimitAfterInsertHooks = append(websiteRateLimitAfterInsertHooks, websiteRateLimitHook)
+		websiteRateLimitAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteRateLimitBeforeUpdateMu.Lock()
+		websiteRateLimitBeforeUpdateHooks = append(websiteRateLimitBeforeUpdateHooks, websiteRateLimitHook)
+		websiteRateLimitBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteRateLimitAfterUpdateMu.Lock()
+		websiteRateLimitAfterUpdateHooks = append(websiteRateLimitAfterUpdateHooks, websiteRateLimitHook)
+		websiteRateLimitAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteRateLimitBeforeDeleteMu.Lock()
+		websiteRateLimitBeforeDeleteHooks = append(websiteRateLimitBeforeDeleteHooks, websiteRateLimitHook)
+		websiteRateLimitBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteRateLimitAfterDeleteMu.Lock()
+		websiteRateLimitAfterDeleteHooks = append(websiteRateLimitAfterDeleteHooks, websiteRateLimitHook)
+		websiteRateLimitAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteRateLimitBeforeUpsertMu.Lock()
+		websiteRateLimitBeforeUpsertHooks = append(websiteRateLimitBeforeUpsertHooks, websiteRateLimitHook)
+		websiteRateLimitBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteRateLimitAfterUpsertMu.Lock()
+		websiteRateLimitAfterUpsertHooks = append(websiteRateLimitAfterUpsertHooks, websiteRateLimitHook)
+		websiteRateLimitAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteRateLimit record from the query.
+func (q websiteRateLimitQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteRateLimit, error) {
+	o := &WebsiteRateLimit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_rate_limits")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteRateLimit records from the query.
+func (q websiteRateLimitQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteRateLimitSlice, error) {
+	var o []*WebsiteRateLimit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteRateLimit slice")
+	}
+
+	if len(websiteRateLimitAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteRateLimit records in the query.
+func (q websiteRateLimitQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_rate_limits rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteRateLimitQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_rate_limits exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteRateLimit) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteRateLimitL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteRateLimit any, mods queries.Applicator) error {
+	var slice []*WebsiteRateLimit
+	var object *WebsiteRateLimit
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteRateLimit.(*WebsiteRateLimit)
+		if !ok {
+			object = new(WebsiteRateLimit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteRateLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteRateLimit))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteRateLimit.(*[]*WebsiteRateLimit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteRateLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteRateLimit))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteRateLimitR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteRateLimitR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteRateLimit = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteRateLimit = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteRateLimit to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteRateLimit.
+func (o *WebsiteRateLimit) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_rate_limits` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteRateLimitPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteRateLimitR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteRateLimit: o,
+		}
+	} else {
+		related.R.WebsiteRateLimit = o
+	}
+
+	return nil
+}
+
+// WebsiteRateLimits retrieves all the records using an executor.
+func WebsiteRateLimits(mods ...qm.QueryMod) websiteRateLimitQuery {
+	mods = append(mods, qm.From("`website_rate_limits`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_rate_limits`.*"})
+	}
+
+	return websiteRateLimitQuery{q}
+}
+
+// FindWebsiteRateLimit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteRateLimit(ctx context.Context, exec boil.ContextExecutor, websiteID string, selectCols ...string) (*WebsiteRateLimit, error) {
+	websiteRateLimitObj := &WebsiteRateLimit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_rate_limits` where `website_id`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID)
+
+	err := q.Bind(ctx, exec, websiteRateLimitObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_rate_limits")
+	}
+
+	if err = websiteRateLimitObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteRateLimitObj, err
+	}
+
+	return websiteRateLimitObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteRateLimit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_rate_limits provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteRateLimitColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteRateLimitInsertCacheMut.RLock()
+	cache, cached := websiteRateLimitInsertCache[key]
+	websiteRateLimitInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteRateLimitAllColumns,
+			websiteRateLimitColumnsWithDefault,
+			websiteRateLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_rate_limits` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_rate_limits` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_rate_limits` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteRateLimitPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_rate_limits")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_rate_limits")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteRateLimitInsertCacheMut.Lock()
+		websiteRateLimitInsertCache[key] = cache
+		websiteRateLimitInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteRateLimit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteRateLimit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteRateLimitUpdateCacheMut.RLock()
+	cache, cached := websiteRateLimitUpdateCache[key]
+	websiteRateLimitUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteRateLimitAllColumns,
+			websiteRateLimitPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_rate_limits, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_rate_limits` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteRateLimitPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, append(wl, websiteRateLimitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_rate_limits row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_rate_limits")
+	}
+
+	if !cached {
+		websiteRateLimitUpdateCacheMut.Lock()
+		websiteRateLimitUpdateCache[key] = cache
+		websiteRateLimitUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteRateLimitQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_rate_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_rate_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteRateLimitSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteRateLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_rate_limits` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteRateLimitPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteRateLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteRateLimit")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteRateLimitUniqueColumns = []string{
+	"website_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteRateLimit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_rate_limits provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteRateLimitColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteRateLimitUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteRateLimitUpsertCacheMut.RLock()
+	cache, cached := websiteRateLimitUpsertCache[key]
+	websiteRateLimitUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteRateLimitAllColumns,
+			websiteRateLimitColumnsWithDefault,
+			websiteRateLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteRateLimitAllColumns,
+			websiteRateLimitPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_rate_limits, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteRateLimitAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_rate_limits`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_rate_limits` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_rate_limits")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteRateLimitType, websiteRateLimitMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_rate_limits")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_rate_limits")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteRateLimitUpsertCacheMut.Lock()
+		websiteRateLimitUpsertCache[key] = cache
+		websiteRateLimitUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteRateLimit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteRateLimit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteRateLimit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteRateLimitPrimaryKeyMapping)
+	sql := "DELETE FROM `website_rate_limits` WHERE `website_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_rate_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_rate_limits")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteRateLimitQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteRateLimitQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_rate_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_rate_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteRateLimitSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteRateLimitBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteRateLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_rate_limits` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteRateLimitPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteRateLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_rate_limits")
+	}
+
+	if len(websiteRateLimitAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteRateLimit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteRateLimit(ctx, exec, o.WebsiteID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteRateLimitSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteRateLimitSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteRateLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_rate_limits`.* FROM `website_rate_limits` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteRateLimitPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteRateLimitSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteRateLimitExists checks if the WebsiteRateLimit row exists.
+func WebsiteRateLimitExists(ctx context.Context, exec boil.ContextExecutor, websiteID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_rate_limits` where `website_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_rate_limits exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteRateLimit row exists.
+func (o *WebsiteRateLimit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteRateLimitExists(ctx, exec, o.WebsiteID)
+}
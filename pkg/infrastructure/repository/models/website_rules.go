@@ -0,0 +1,1200 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebsiteRule is an object representing the database table.
+type WebsiteRule struct {
+	// サイトID
+	WebsiteID string `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	// 評価順
+	Position int `boil:"position" json:"position" toml:"position" yaml:"position"`
+	// ルールの種類
+	Type string `boil:"type" json:"type" toml:"type" yaml:"type"`
+	// リクエストパスにマッチする正規表現
+	PathRegex string `boil:"path_regex" json:"path_regex" toml:"path_regex" yaml:"path_regex"`
+	// リダイレクト先または書き換え後のパス
+	Target string `boil:"target" json:"target" toml:"target" yaml:"target"`
+	// (redirect only)リダイレクトのステータスコード
+	StatusCode int `boil:"status_code" json:"status_code" toml:"status_code" yaml:"status_code"`
+	// (redirect only)クエリ文字列を引き継ぐかどうか
+	PreserveQuery bool `boil:"preserve_query" json:"preserve_query" toml:"preserve_query" yaml:"preserve_query"`
+
+	R *websiteRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteRuleColumns = struct {
+	WebsiteID     string
+	Position      string
+	Type          string
+	PathRegex     string
+	Target        string
+	StatusCode    string
+	PreserveQuery string
+}{
+	WebsiteID:     "website_id",
+	Position:      "position",
+	Type:          "type",
+	PathRegex:     "path_regex",
+	Target:        "target",
+	StatusCode:    "status_code",
+	PreserveQuery: "preserve_query",
+}
+
+var WebsiteRuleTableColumns = struct {
+	WebsiteID     string
+	Position      string
+	Type          string
+	PathRegex     string
+	Target        string
+	StatusCode    string
+	PreserveQuery string
+}{
+	WebsiteID:     "website_rules.website_id",
+	Position:      "website_rules.position",
+	Type:          "website_rules.type",
+	PathRegex:     "website_rules.path_regex",
+	Target:        "website_rules.target",
+	StatusCode:    "website_rules.status_code",
+	PreserveQuery: "website_rules.preserve_query",
+}
+
+// Generated where
+
+var WebsiteRuleWhere = struct {
+	WebsiteID     whereHelperstring
+	Position      whereHelperint
+	Type          whereHelperstring
+	PathRegex     whereHelperstring
+	Target        whereHelperstring
+	StatusCode    whereHelperint
+	PreserveQuery whereHelperbool
+}{
+	WebsiteID:     whereHelperstring{field: "`website_rules`.`website_id`"},
+	Position:      whereHelperint{field: "`website_rules`.`position`"},
+	Type:          whereHelperstring{field: "`website_rules`.`type`"},
+	PathRegex:     whereHelperstring{field: "`website_rules`.`path_regex`"},
+	Target:        whereHelperstring{field: "`website_rules`.`target`"},
+	StatusCode:    whereHelperint{field: "`website_rules`.`status_code`"},
+	PreserveQuery: whereHelperbool{field: "`website_rules`.`preserve_query`"},
+}
+
+// WebsiteRuleRels is where relationship names are stored.
+var WebsiteRuleRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteRuleR is where relationships are stored.
+type websiteRuleR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteRuleR) NewStruct() *websiteRuleR {
+	return &websiteRuleR{}
+}
+
+func (o *WebsiteRule) GetWebsite() *Website {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsite()
+}
+
+func (r *websiteRuleR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+
+	return r.Website
+}
+
+// websiteRuleL is where Load methods for each relationship are stored.
+type websiteRuleL struct{}
+
+var (
+	websiteRuleAllColumns            = []string{"website_id", "position", "type", "path_regex", "target", "status_code", "preserve_query"}
+	websiteRuleColumnsWithoutDefault = []string{"website_id", "position", "type", "path_regex", "target"}
+	websiteRuleColumnsWithDefault    = []string{"status_code", "preserve_query"}
+	websiteRulePrimaryKeyColumns     = []string{"website_id", "position"}
+	websiteRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// WebsiteRuleSlice is an alias for a slice of pointers to WebsiteRule.
+	// This should almost always be used instead of []WebsiteRule.
+	WebsiteRuleSlice []*WebsiteRule
+	// WebsiteRuleHook is the signature for custom WebsiteRule hook methods
+	WebsiteRuleHook func(context.Context, boil.ContextExecutor, *WebsiteRule) error
+
+	websiteRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteRuleType                 = reflect.TypeOf(&WebsiteRule{})
+	websiteRuleMapping              = queries.MakeStructMapping(websiteRuleType)
+	websiteRulePrimaryKeyMapping, _ = queries.BindMapping(websiteRuleType, websiteRuleMapping, websiteRulePrimaryKeyColumns)
+	websiteRuleInsertCacheMut       sync.RWMutex
+	websiteRuleInsertCache          = make(map[string]insertCache)
+	websiteRuleUpdateCacheMut       sync.RWMutex
+	websiteRuleUpdateCache          = make(map[string]updateCache)
+	websiteRuleUpsertCacheMut       sync.RWMutex
+	websiteRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteRuleAfterSelectMu sync.Mutex
+var websiteRuleAfterSelectHooks []WebsiteRuleHook
+
+var websiteRuleBeforeInsertMu sync.Mutex
+var websiteRuleBeforeInsertHooks []WebsiteRuleHook
+var websiteRuleAfterInsertMu sync.Mutex
+var websiteRuleAfterInsertHooks []WebsiteRuleHook
+
+var websiteRuleBeforeUpdateMu sync.Mutex
+var websiteRuleBeforeUpdateHooks []WebsiteRuleHook
+var websiteRuleAfterUpdateMu sync.Mutex
+var websiteRuleAfterUpdateHooks []WebsiteRuleHook
+
+var websiteRuleBeforeDeleteMu sync.Mutex
+var websiteRuleBeforeDeleteHooks []WebsiteRuleHook
+var websiteRuleAfterDeleteMu sync.Mutex
+var websiteRuleAfterDeleteHooks []WebsiteRuleHook
+
+var websiteRuleBeforeUpsertMu sync.Mutex
+var websiteRuleBeforeUpsertHooks []WebsiteRuleHook
+var websiteRuleAfterUpsertMu sync.Mutex
+var websiteRuleAfterUpsertHooks []WebsiteRuleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteRule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteRule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteRule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteRule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteRule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteRule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteRule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteRule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteRule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteRuleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteRuleHook registers your hook function for all future operations.
+func AddWebsiteRuleHook(hookPoint boil.HookPoint, websiteRuleHook WebsiteRuleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteRuleAfterSelectMu.Lock()
+		websiteRuleAfterSelectHooks = append(websiteRuleAfterSelectHooks, websiteRuleHook)
+		websiteRuleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteRuleBeforeInsertMu.Lock()
+		websiteRuleBeforeInsertHooks = append(websiteRuleBeforeInsertHooks, websiteRuleHook)
+		websiteRuleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteRuleAfterInsertMu.Lock()
+		websiteRuleAfterInsertHooks = append(websiteRuleAfterInsertHooks, websiteRuleHook)
+		websiteRuleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteRuleBeforeUpdateMu.Lock()
+		websiteRuleBeforeUpdateHooks = append(websiteRuleBeforeUpdateHooks, websiteRuleHook)
+		websiteRuleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteRuleAfterUpdateMu.Lock()
+		websiteRuleAfterUpdateHooks = append(websiteRuleAfterUpdateHooks, websiteRuleHook)
+		websiteRuleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteRuleBeforeDeleteMu.Lock()
+		websiteRuleBeforeDeleteHooks = append(websiteRuleBeforeDeleteHooks, websiteRuleHook)
+		websiteRuleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteRuleAfterDeleteMu.Lock()
+		websiteRuleAfterDeleteHooks = append(websiteRuleAfterDeleteHooks, websiteRuleHook)
+		websiteRuleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteRuleBeforeUpsertMu.Lock()
+		websiteRuleBeforeUpsertHooks = append(websiteRuleBeforeUpsertHooks, websiteRuleHook)
+		websiteRuleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteRuleAfterUpsertMu.Lock()
+		websiteRuleAfterUpsertHooks = append(websiteRuleAfterUpsertHooks, websiteRuleHook)
+		websiteRuleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteRule record from the query.
+func (q websiteRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteRule, error) {
+	o := &WebsiteRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_rules")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteRule records from the query.
+func (q websiteRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteRuleSlice, error) {
+	var o []*WebsiteRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteRule slice")
+	}
+
+	if len(websiteRuleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteRule records in the query.
+func (q websiteRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_rules rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_rules exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteRule) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteRuleL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteRule any, mods queries.Applicator) error {
+	var slice []*WebsiteRule
+	var object *WebsiteRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteRule.(*WebsiteRule)
+		if !ok {
+			object = new(WebsiteRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteRule))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteRule.(*[]*WebsiteRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteRule))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteRuleR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteRuleR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteRules = append(foreign.R.WebsiteRules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteRules = append(foreign.R.WebsiteRules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteRule to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteRules.
+func (o *WebsiteRule) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `website_rules` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+		strmangle.WhereClause("`", "`", 0, websiteRulePrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.WebsiteID, o.Position}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteRuleR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteRules: WebsiteRuleSlice{o},
+		}
+	} else {
+		related.R.WebsiteRules = append(related.R.WebsiteRules, o)
+	}
+
+	return nil
+}
+
+// WebsiteRules retrieves all the records using an executor.
+func WebsiteRules(mods ...qm.QueryMod) websiteRuleQuery {
+	mods = append(mods, qm.From("`website_rules`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`website_rules`.*"})
+	}
+
+	return websiteRuleQuery{q}
+}
+
+// FindWebsiteRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteRule(ctx context.Context, exec boil.ContextExecutor, websiteID string, position int, selectCols ...string) (*WebsiteRule, error) {
+	websiteRuleObj := &WebsiteRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `website_rules` where `website_id`=? AND `position`=?", sel,
+	)
+
+	q := queries.Raw(query, websiteID, position)
+
+	err := q.Bind(ctx, exec, websiteRuleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_rules")
+	}
+
+	if err = websiteRuleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteRuleObj, err
+	}
+
+	return websiteRuleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_rules provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteRuleInsertCacheMut.RLock()
+	cache, cached := websiteRuleInsertCache[key]
+	websiteRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteRuleAllColumns,
+			websiteRuleColumnsWithDefault,
+			websiteRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteRuleType, websiteRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteRuleType, websiteRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `website_rules` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `website_rules` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `website_rules` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, websiteRulePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_rules")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.WebsiteID,
+		o.Position,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_rules")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteRuleInsertCacheMut.Lock()
+		websiteRuleInsertCache[key] = cache
+		websiteRuleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteRuleUpdateCacheMut.RLock()
+	cache, cached := websiteRuleUpdateCache[key]
+	websiteRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteRuleAllColumns,
+			websiteRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_rules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `website_rules` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, websiteRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteRuleType, websiteRuleMapping, append(wl, websiteRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_rules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_rules")
+	}
+
+	if !cached {
+		websiteRuleUpdateCacheMut.Lock()
+		websiteRuleUpdateCache[key] = cache
+		websiteRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `website_rules` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteRule")
+	}
+	return rowsAff, nil
+}
+
+var mySQLWebsiteRuleUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_rules provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteRuleColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLWebsiteRuleUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteRuleUpsertCacheMut.RLock()
+	cache, cached := websiteRuleUpsertCache[key]
+	websiteRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteRuleAllColumns,
+			websiteRuleColumnsWithDefault,
+			websiteRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			websiteRuleAllColumns,
+			websiteRulePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert website_rules, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteRuleAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`website_rules`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `website_rules` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(websiteRuleType, websiteRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteRuleType, websiteRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for website_rules")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(websiteRuleType, websiteRuleMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for website_rules")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for website_rules")
+	}
+
+CacheNoHooks:
+	if !cached {
+		websiteRuleUpsertCacheMut.Lock()
+		websiteRuleUpsertCache[key] = cache
+		websiteRuleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteRule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteRulePrimaryKeyMapping)
+	sql := "DELETE FROM `website_rules` WHERE `website_id`=? AND `position`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_rules")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteRuleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `website_rules` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_rules")
+	}
+
+	if len(websiteRuleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteRule(ctx, exec, o.WebsiteID, o.Position)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteRuleSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `website_rules`.* FROM `website_rules` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteRuleExists checks if the WebsiteRule row exists.
+func WebsiteRuleExists(ctx context.Context, exec boil.ContextExecutor, websiteID string, position int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `website_rules` where `website_id`=? AND `position`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, websiteID, position)
+	}
+	row := exec.QueryRowContext(ctx, sql, websiteID, position)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_rules exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteRule row exists.
+func (o *WebsiteRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteRuleExists(ctx, exec, o.WebsiteID, o.Position)
+}
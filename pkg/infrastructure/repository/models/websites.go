@@ -116,14 +116,35 @@ var WebsiteWhere = struct {
 
 // WebsiteRels is where relationship names are stored.
 var WebsiteRels = struct {
-	Application string
+	Application               string
+	WebsiteCorsPolicy         string
+	WebsiteRateLimit          string
+	WebsiteBackends           string
+	WebsiteBasicAuthUsers     string
+	WebsiteHeaders            string
+	WebsiteIPAllowlistEntries string
+	WebsiteRules              string
 }{
-	Application: "Application",
+	Application:               "Application",
+	WebsiteCorsPolicy:         "WebsiteCorsPolicy",
+	WebsiteRateLimit:          "WebsiteRateLimit",
+	WebsiteBackends:           "WebsiteBackends",
+	WebsiteBasicAuthUsers:     "WebsiteBasicAuthUsers",
+	WebsiteHeaders:            "WebsiteHeaders",
+	WebsiteIPAllowlistEntries: "WebsiteIPAllowlistEntries",
+	WebsiteRules:              "WebsiteRules",
 }
 
 // websiteR is where relationships are stored.
 type websiteR struct {
-	Application *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	Application               *Application                 `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	WebsiteCorsPolicy         *WebsiteCorsPolicy           `boil:"WebsiteCorsPolicy" json:"WebsiteCorsPolicy" toml:"WebsiteCorsPolicy" yaml:"WebsiteCorsPolicy"`
+	WebsiteRateLimit          *WebsiteRateLimit            `boil:"WebsiteRateLimit" json:"WebsiteRateLimit" toml:"WebsiteRateLimit" yaml:"WebsiteRateLimit"`
+	WebsiteBackends           WebsiteBackendSlice          `boil:"WebsiteBackends" json:"WebsiteBackends" toml:"WebsiteBackends" yaml:"WebsiteBackends"`
+	WebsiteBasicAuthUsers     WebsiteBasicAuthUserSlice    `boil:"WebsiteBasicAuthUsers" json:"WebsiteBasicAuthUsers" toml:"WebsiteBasicAuthUsers" yaml:"WebsiteBasicAuthUsers"`
+	WebsiteHeaders            WebsiteHeaderSlice           `boil:"WebsiteHeaders" json:"WebsiteHeaders" toml:"WebsiteHeaders" yaml:"WebsiteHeaders"`
+	WebsiteIPAllowlistEntries WebsiteIPAllowlistEntrySlice `boil:"WebsiteIPAllowlistEntries" json:"WebsiteIPAllowlistEntries" toml:"WebsiteIPAllowlistEntries" yaml:"WebsiteIPAllowlistEntries"`
+	WebsiteRules              WebsiteRuleSlice             `boil:"WebsiteRules" json:"WebsiteRules" toml:"WebsiteRules" yaml:"WebsiteRules"`
 }
 
 // NewStruct creates a new relationship struct
@@ -147,6 +168,118 @@ func (r *websiteR) GetApplication() *Application {
 	return r.Application
 }
 
+func (o *Website) GetWebsiteCorsPolicy() *WebsiteCorsPolicy {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteCorsPolicy()
+}
+
+func (r *websiteR) GetWebsiteCorsPolicy() *WebsiteCorsPolicy {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteCorsPolicy
+}
+
+func (o *Website) GetWebsiteRateLimit() *WebsiteRateLimit {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteRateLimit()
+}
+
+func (r *websiteR) GetWebsiteRateLimit() *WebsiteRateLimit {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteRateLimit
+}
+
+func (o *Website) GetWebsiteBackends() WebsiteBackendSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteBackends()
+}
+
+func (r *websiteR) GetWebsiteBackends() WebsiteBackendSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteBackends
+}
+
+func (o *Website) GetWebsiteBasicAuthUsers() WebsiteBasicAuthUserSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteBasicAuthUsers()
+}
+
+func (r *websiteR) GetWebsiteBasicAuthUsers() WebsiteBasicAuthUserSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteBasicAuthUsers
+}
+
+func (o *Website) GetWebsiteHeaders() WebsiteHeaderSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteHeaders()
+}
+
+func (r *websiteR) GetWebsiteHeaders() WebsiteHeaderSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteHeaders
+}
+
+func (o *Website) GetWebsiteIPAllowlistEntries() WebsiteIPAllowlistEntrySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteIPAllowlistEntries()
+}
+
+func (r *websiteR) GetWebsiteIPAllowlistEntries() WebsiteIPAllowlistEntrySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteIPAllowlistEntries
+}
+
+func (o *Website) GetWebsiteRules() WebsiteRuleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebsiteRules()
+}
+
+func (r *websiteR) GetWebsiteRules() WebsiteRuleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebsiteRules
+}
+
 // websiteL is where Load methods for each relationship are stored.
 type websiteL struct{}
 
@@ -474,6 +607,98 @@ func (o *Website) Application(mods ...qm.QueryMod) applicationQuery {
 	return Applications(queryMods...)
 }
 
+// WebsiteCorsPolicy pointed to by the foreign key.
+func (o *Website) WebsiteCorsPolicy(mods ...qm.QueryMod) websiteCorsPolicyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`website_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return WebsiteCorsPolicies(queryMods...)
+}
+
+// WebsiteRateLimit pointed to by the foreign key.
+func (o *Website) WebsiteRateLimit(mods ...qm.QueryMod) websiteRateLimitQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`website_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return WebsiteRateLimits(queryMods...)
+}
+
+// WebsiteBackends retrieves all the website_backend's WebsiteBackends with an executor.
+func (o *Website) WebsiteBackends(mods ...qm.QueryMod) websiteBackendQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`website_backends`.`website_id`=?", o.ID),
+	)
+
+	return WebsiteBackends(queryMods...)
+}
+
+// WebsiteBasicAuthUsers retrieves all the website_basic_auth_user's WebsiteBasicAuthUsers with an executor.
+func (o *Website) WebsiteBasicAuthUsers(mods ...qm.QueryMod) websiteBasicAuthUserQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`website_basic_auth_users`.`website_id`=?", o.ID),
+	)
+
+	return WebsiteBasicAuthUsers(queryMods...)
+}
+
+// WebsiteHeaders retrieves all the website_header's WebsiteHeaders with an executor.
+func (o *Website) WebsiteHeaders(mods ...qm.QueryMod) websiteHeaderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`website_headers`.`website_id`=?", o.ID),
+	)
+
+	return WebsiteHeaders(queryMods...)
+}
+
+// WebsiteIPAllowlistEntries retrieves all the website_ip_allowlist_entry's WebsiteIPAllowlistEntries with an executor.
+func (o *Website) WebsiteIPAllowlistEntries(mods ...qm.QueryMod) websiteIPAllowlistEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`website_ip_allowlist_entries`.`website_id`=?", o.ID),
+	)
+
+	return WebsiteIPAllowlistEntries(queryMods...)
+}
+
+// WebsiteRules retrieves all the website_rule's WebsiteRules with an executor.
+func (o *Website) WebsiteRules(mods ...qm.QueryMod) websiteRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`website_rules`.`website_id`=?", o.ID),
+	)
+
+	return WebsiteRules(queryMods...)
+}
+
 // LoadApplication allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (websiteL) LoadApplication(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
@@ -594,53 +819,1217 @@ func (websiteL) LoadApplication(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// SetApplication of the website to the related item.
-// Sets o.R.Application to related.
-// Adds o to related.R.Websites.
-func (o *Website) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadWebsiteCorsPolicy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (websiteL) LoadWebsiteCorsPolicy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `websites` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
-		strmangle.WhereClause("`", "`", 0, websitePrimaryKeyColumns),
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_cors_policies`),
+		qm.WhereIn(`website_cors_policies.website_id in ?`, argsSlice...),
 	)
-	values := []any{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WebsiteCorsPolicy")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*WebsiteCorsPolicy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WebsiteCorsPolicy")
 	}
 
-	o.ApplicationID = related.ID
-	if o.R == nil {
-		o.R = &websiteR{
-			Application: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for website_cors_policies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_cors_policies")
+	}
+
+	if len(websiteCorsPolicyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.Application = related
 	}
 
-	if related.R == nil {
-		related.R = &applicationR{
-			Websites: WebsiteSlice{o},
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.WebsiteCorsPolicy = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteCorsPolicyR{}
+		}
+		foreign.R.Website = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteCorsPolicy = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteCorsPolicyR{}
+				}
+				foreign.R.Website = local
+				break
+			}
 		}
-	} else {
-		related.R.Websites = append(related.R.Websites, o)
 	}
 
 	return nil
 }
 
+// LoadWebsiteRateLimit allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (websiteL) LoadWebsiteRateLimit(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_rate_limits`),
+		qm.WhereIn(`website_rate_limits.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WebsiteRateLimit")
+	}
+
+	var resultSlice []*WebsiteRateLimit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WebsiteRateLimit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for website_rate_limits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_rate_limits")
+	}
+
+	if len(websiteRateLimitAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.WebsiteRateLimit = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteRateLimitR{}
+		}
+		foreign.R.Website = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteRateLimit = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteRateLimitR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteBackends allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteBackends(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_backends`),
+		qm.WhereIn(`website_backends.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_backends")
+	}
+
+	var resultSlice []*WebsiteBackend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_backends")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_backends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_backends")
+	}
+
+	if len(websiteBackendAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteBackends = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteBackendR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteBackends = append(local.R.WebsiteBackends, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteBackendR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteBasicAuthUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteBasicAuthUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_basic_auth_users`),
+		qm.WhereIn(`website_basic_auth_users.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_basic_auth_users")
+	}
+
+	var resultSlice []*WebsiteBasicAuthUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_basic_auth_users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_basic_auth_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_basic_auth_users")
+	}
+
+	if len(websiteBasicAuthUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteBasicAuthUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteBasicAuthUserR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteBasicAuthUsers = append(local.R.WebsiteBasicAuthUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteBasicAuthUserR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteHeaders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteHeaders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_headers`),
+		qm.WhereIn(`website_headers.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_headers")
+	}
+
+	var resultSlice []*WebsiteHeader
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_headers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_headers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_headers")
+	}
+
+	if len(websiteHeaderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteHeaders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteHeaderR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteHeaders = append(local.R.WebsiteHeaders, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteHeaderR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteIPAllowlistEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteIPAllowlistEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_ip_allowlist_entries`),
+		qm.WhereIn(`website_ip_allowlist_entries.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_ip_allowlist_entries")
+	}
+
+	var resultSlice []*WebsiteIPAllowlistEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_ip_allowlist_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_ip_allowlist_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_ip_allowlist_entries")
+	}
+
+	if len(websiteIPAllowlistEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteIPAllowlistEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteIPAllowlistEntryR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteIPAllowlistEntries = append(local.R.WebsiteIPAllowlistEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteIPAllowlistEntryR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteRules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite any, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_rules`),
+		qm.WhereIn(`website_rules.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_rules")
+	}
+
+	var resultSlice []*WebsiteRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_rules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_rules")
+	}
+
+	if len(websiteRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteRules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteRuleR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteRules = append(local.R.WebsiteRules, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteRuleR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApplication of the website to the related item.
+// Sets o.R.Application to related.
+// Adds o to related.R.Websites.
+func (o *Website) SetApplication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Application) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `websites` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"application_id"}),
+		strmangle.WhereClause("`", "`", 0, websitePrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ApplicationID = related.ID
+	if o.R == nil {
+		o.R = &websiteR{
+			Application: related,
+		}
+	} else {
+		o.R.Application = related
+	}
+
+	if related.R == nil {
+		related.R = &applicationR{
+			Websites: WebsiteSlice{o},
+		}
+	} else {
+		related.R.Websites = append(related.R.Websites, o)
+	}
+
+	return nil
+}
+
+// SetWebsiteCorsPolicy of the website to the related item.
+// Sets o.R.WebsiteCorsPolicy to related.
+// Adds o to related.R.Website.
+func (o *Website) SetWebsiteCorsPolicy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WebsiteCorsPolicy) error {
+	var err error
+
+	if insert {
+		related.WebsiteID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `website_cors_policies` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+			strmangle.WhereClause("`", "`", 0, websiteCorsPolicyPrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.WebsiteID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.WebsiteID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteCorsPolicy: related,
+		}
+	} else {
+		o.R.WebsiteCorsPolicy = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteCorsPolicyR{
+			Website: o,
+		}
+	} else {
+		related.R.Website = o
+	}
+	return nil
+}
+
+// SetWebsiteRateLimit of the website to the related item.
+// Sets o.R.WebsiteRateLimit to related.
+// Adds o to related.R.Website.
+func (o *Website) SetWebsiteRateLimit(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WebsiteRateLimit) error {
+	var err error
+
+	if insert {
+		related.WebsiteID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `website_rate_limits` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+			strmangle.WhereClause("`", "`", 0, websiteRateLimitPrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.WebsiteID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.WebsiteID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteRateLimit: related,
+		}
+	} else {
+		o.R.WebsiteRateLimit = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteRateLimitR{
+			Website: o,
+		}
+	} else {
+		related.R.Website = o
+	}
+	return nil
+}
+
+// AddWebsiteBackends adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteBackends.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteBackends(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteBackend) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `website_backends` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+				strmangle.WhereClause("`", "`", 0, websiteBackendPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.WebsiteID, rel.ApplicationID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteBackends: related,
+		}
+	} else {
+		o.R.WebsiteBackends = append(o.R.WebsiteBackends, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteBackendR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddWebsiteBasicAuthUsers adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteBasicAuthUsers.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteBasicAuthUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteBasicAuthUser) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `website_basic_auth_users` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+				strmangle.WhereClause("`", "`", 0, websiteBasicAuthUserPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.WebsiteID, rel.Username}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteBasicAuthUsers: related,
+		}
+	} else {
+		o.R.WebsiteBasicAuthUsers = append(o.R.WebsiteBasicAuthUsers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteBasicAuthUserR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddWebsiteHeaders adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteHeaders.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteHeaders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteHeader) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `website_headers` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+				strmangle.WhereClause("`", "`", 0, websiteHeaderPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.WebsiteID, rel.Name}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteHeaders: related,
+		}
+	} else {
+		o.R.WebsiteHeaders = append(o.R.WebsiteHeaders, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteHeaderR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddWebsiteIPAllowlistEntries adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteIPAllowlistEntries.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteIPAllowlistEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteIPAllowlistEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `website_ip_allowlist_entries` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+				strmangle.WhereClause("`", "`", 0, websiteIPAllowlistEntryPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.WebsiteID, rel.Cidr}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteIPAllowlistEntries: related,
+		}
+	} else {
+		o.R.WebsiteIPAllowlistEntries = append(o.R.WebsiteIPAllowlistEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteIPAllowlistEntryR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddWebsiteRules adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteRules.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `website_rules` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"website_id"}),
+				strmangle.WhereClause("`", "`", 0, websiteRulePrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.WebsiteID, rel.Position}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteRules: related,
+		}
+	} else {
+		o.R.WebsiteRules = append(o.R.WebsiteRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteRuleR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
 // Websites retrieves all the records using an executor.
 func Websites(mods ...qm.QueryMod) websiteQuery {
 	mods = append(mods, qm.From("`websites`"))
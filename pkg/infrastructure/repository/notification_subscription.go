@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/repoconvert"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+type notificationSubscriptionRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationSubscriptionRepository(db *sql.DB) domain.NotificationSubscriptionRepository {
+	return &notificationSubscriptionRepository{db: db}
+}
+
+func (r *notificationSubscriptionRepository) GetSubscriptions(ctx context.Context, cond domain.GetNotificationSubscriptionCondition) ([]*domain.NotificationSubscription, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.NotificationSubscriptionColumns.CreatedAt),
+	}
+	if cond.ID.Valid {
+		mods = append(mods, models.NotificationSubscriptionWhere.ID.EQ(cond.ID.V))
+	}
+	if cond.ApplicationID.Valid {
+		mods = append(mods, models.NotificationSubscriptionWhere.ApplicationID.EQ(cond.ApplicationID.V))
+	}
+	if cond.UserID.Valid {
+		mods = append(mods, models.NotificationSubscriptionWhere.UserID.EQ(cond.UserID.V))
+	}
+
+	subs, err := models.NotificationSubscriptions(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting notification subscriptions")
+	}
+	return ds.Map(subs, repoconvert.ToDomainNotificationSubscription), nil
+}
+
+func (r *notificationSubscriptionRepository) CreateSubscription(ctx context.Context, sub *domain.NotificationSubscription) error {
+	ms := repoconvert.FromDomainNotificationSubscription(sub)
+	err := ms.Insert(ctx, r.db, boil.Blacklist())
+	if err != nil {
+		return oops.With("app_id", sub.ApplicationID).Wrapf(err, "inserting notification subscription")
+	}
+	return nil
+}
+
+func (r *notificationSubscriptionRepository) DeleteSubscription(ctx context.Context, id string) error {
+	_, err := models.NotificationSubscriptions(
+		models.NotificationSubscriptionWhere.ID.EQ(id),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting notification subscription")
+	}
+	return nil
+}
+
+func (r *notificationSubscriptionRepository) DeleteApplicationSubscriptions(ctx context.Context, applicationID string) error {
+	_, err := models.NotificationSubscriptions(
+		models.NotificationSubscriptionWhere.ApplicationID.EQ(applicationID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting notification subscriptions")
+	}
+	return nil
+}
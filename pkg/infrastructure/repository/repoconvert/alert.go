@@ -0,0 +1,77 @@
+package repoconvert
+
+import (
+	"time"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+var AlertRuleKindMapper = mapper.MustNewValueMapper(map[string]domain.AlertRuleKind{
+	models.AlertRulesKindMetric: domain.AlertRuleKindMetric,
+	models.AlertRulesKindNoLog:  domain.AlertRuleKindNoLog,
+})
+
+var AlertComparisonMapper = mapper.MustNewValueMapper(map[string]domain.AlertComparison{
+	models.AlertRulesComparisonAbove: domain.AlertComparisonAbove,
+	models.AlertRulesComparisonBelow: domain.AlertComparisonBelow,
+})
+
+var AlertThresholdUnitMapper = mapper.MustNewValueMapper(map[string]domain.AlertThresholdUnit{
+	models.AlertRulesThresholdUnitValue:        domain.AlertThresholdUnitValue,
+	models.AlertRulesThresholdUnitLimitPercent: domain.AlertThresholdUnitLimitPercent,
+})
+
+func FromDomainAlertRule(r *domain.AlertRule) *models.AlertRule {
+	return &models.AlertRule{
+		ID:              r.ID,
+		ApplicationID:   r.ApplicationID,
+		Name:            r.Name,
+		Kind:            AlertRuleKindMapper.FromMust(r.Kind),
+		Metric:          r.Metric,
+		Comparison:      AlertComparisonMapper.FromMust(r.Comparison),
+		Threshold:       r.Threshold,
+		ThresholdUnit:   AlertThresholdUnitMapper.FromMust(r.ThresholdUnit),
+		DurationSeconds: int(r.Duration.Seconds()),
+		CreatedAt:       r.CreatedAt,
+	}
+}
+
+func ToDomainAlertRule(r *models.AlertRule) *domain.AlertRule {
+	return &domain.AlertRule{
+		ID:            r.ID,
+		ApplicationID: r.ApplicationID,
+		Name:          r.Name,
+		Kind:          AlertRuleKindMapper.IntoMust(r.Kind),
+		Metric:        r.Metric,
+		Comparison:    AlertComparisonMapper.IntoMust(r.Comparison),
+		Threshold:     r.Threshold,
+		ThresholdUnit: AlertThresholdUnitMapper.IntoMust(r.ThresholdUnit),
+		Duration:      time.Duration(r.DurationSeconds) * time.Second,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func FromDomainAlert(a *domain.Alert) *models.Alert {
+	return &models.Alert{
+		ID:            a.ID,
+		RuleID:        a.RuleID,
+		ApplicationID: a.ApplicationID,
+		Message:       a.Message,
+		FiredAt:       a.FiredAt,
+		ResolvedAt:    optional.IntoTime(a.ResolvedAt),
+	}
+}
+
+func ToDomainAlert(a *models.Alert) *domain.Alert {
+	return &domain.Alert{
+		ID:            a.ID,
+		RuleID:        a.RuleID,
+		ApplicationID: a.ApplicationID,
+		Message:       a.Message,
+		FiredAt:       a.FiredAt,
+		ResolvedAt:    optional.FromTime(a.ResolvedAt),
+	}
+}
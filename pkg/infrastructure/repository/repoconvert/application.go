@@ -36,6 +36,8 @@ func FromDomainApplication(app *domain.Application) *models.Application {
 		CurrentBuild:     app.CurrentBuild,
 		CreatedAt:        app.CreatedAt,
 		UpdatedAt:        app.UpdatedAt,
+		ConfigFilePath:   app.ConfigFilePath,
+		ConfigFileError:  app.ConfigFileError,
 	}
 }
 
@@ -53,10 +55,13 @@ func ToDomainApplication(app *models.Application) *domain.Application {
 		CurrentBuild:     app.CurrentBuild,
 		CreatedAt:        app.CreatedAt,
 		UpdatedAt:        app.UpdatedAt,
+		ConfigFilePath:   app.ConfigFilePath,
+		ConfigFileError:  app.ConfigFileError,
 
 		Config:           ToDomainApplicationConfig(app.R.ApplicationConfig),
 		Websites:         ds.Map(app.R.Websites, ToDomainWebsite),
 		PortPublications: ds.Map(app.R.PortPublications, ToDomainPortPublication),
+		InternalService:  ToDomainInternalService(app),
 		OwnerIDs:         ds.Map(app.R.Users, func(user *models.User) string { return user.ID }),
 	}
 }
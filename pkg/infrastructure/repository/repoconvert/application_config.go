@@ -67,12 +67,20 @@ func ToDomainRuntimeConfig(c *models.ApplicationConfig) domain.RuntimeConfig {
 func assignStaticConfig(mc *models.ApplicationConfig, c *domain.StaticConfig) {
 	mc.ArtifactPath = c.ArtifactPath
 	mc.Spa = c.SPA
+	mc.NotFoundPath = c.NotFoundPath
+	mc.AssetCacheControl = c.AssetCacheControl
+	mc.HTMLCacheControl = c.HTMLCacheControl
+	mc.Precompressed = c.Precompressed
 }
 
 func ToDomainStaticConfig(c *models.ApplicationConfig) domain.StaticConfig {
 	return domain.StaticConfig{
-		ArtifactPath: c.ArtifactPath,
-		SPA:          c.Spa,
+		ArtifactPath:      c.ArtifactPath,
+		SPA:               c.Spa,
+		NotFoundPath:      c.NotFoundPath,
+		AssetCacheControl: c.AssetCacheControl,
+		HTMLCacheControl:  c.HTMLCacheControl,
+		Precompressed:     c.Precompressed,
 	}
 }
 
@@ -0,0 +1,41 @@
+package repoconvert
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var ApplicationEventTypeMapper = mapper.MustNewValueMapper(map[string]domain.ApplicationEventType{
+	models.ApplicationEventsTypeContainerState: domain.ApplicationEventContainerState,
+	models.ApplicationEventsTypeBuildStarted:   domain.ApplicationEventBuildStarted,
+	models.ApplicationEventsTypeBuildFinished:  domain.ApplicationEventBuildFinished,
+	models.ApplicationEventsTypeDeployed:       domain.ApplicationEventDeployed,
+	models.ApplicationEventsTypeConfigChanged:  domain.ApplicationEventConfigChanged,
+	models.ApplicationEventsTypeStarted:        domain.ApplicationEventStarted,
+	models.ApplicationEventsTypeStopped:        domain.ApplicationEventStopped,
+})
+
+func FromDomainApplicationEvent(e *domain.ApplicationEvent) *models.ApplicationEvent {
+	return &models.ApplicationEvent{
+		ID:            e.ID,
+		ApplicationID: e.ApplicationID,
+		Type:          ApplicationEventTypeMapper.FromMust(e.Type),
+		Reference:     e.Reference,
+		Message:       e.Message,
+		UserID:        e.UserID,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+func ToDomainApplicationEvent(e *models.ApplicationEvent) *domain.ApplicationEvent {
+	return &domain.ApplicationEvent{
+		ID:            e.ID,
+		ApplicationID: e.ApplicationID,
+		Type:          ApplicationEventTypeMapper.IntoMust(e.Type),
+		Reference:     e.Reference,
+		Message:       e.Message,
+		UserID:        e.UserID,
+		CreatedAt:     e.CreatedAt,
+	}
+}
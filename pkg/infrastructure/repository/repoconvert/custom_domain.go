@@ -0,0 +1,43 @@
+package repoconvert
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+var CustomDomainVerificationMethodMapper = mapper.MustNewValueMapper(map[string]domain.CustomDomainVerificationMethod{
+	models.CustomDomainsMethodDNS:  domain.CustomDomainVerificationDNS,
+	models.CustomDomainsMethodHTTP: domain.CustomDomainVerificationHTTP,
+})
+
+func FromDomainCustomDomain(d *domain.CustomDomain) *models.CustomDomain {
+	return &models.CustomDomain{
+		ID:         d.ID,
+		UserID:     d.UserID,
+		Domain:     d.Domain,
+		Method:     CustomDomainVerificationMethodMapper.FromMust(d.Method),
+		Token:      d.Token,
+		Verified:   d.Verified,
+		VerifiedAt: optional.IntoTime(d.VerifiedAt),
+		CheckedAt:  optional.IntoTime(d.CheckedAt),
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func ToDomainCustomDomain(d *models.CustomDomain) *domain.CustomDomain {
+	return &domain.CustomDomain{
+		ID:         d.ID,
+		UserID:     d.UserID,
+		Domain:     d.Domain,
+		Method:     CustomDomainVerificationMethodMapper.IntoMust(d.Method),
+		Token:      d.Token,
+		Verified:   d.Verified,
+		VerifiedAt: optional.FromTime(d.VerifiedAt),
+		CheckedAt:  optional.FromTime(d.CheckedAt),
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+	}
+}
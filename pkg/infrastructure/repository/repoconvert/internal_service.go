@@ -0,0 +1,33 @@
+package repoconvert
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+func FromDomainInternalService(appID string, s *domain.InternalService) *models.ApplicationInternalService {
+	return &models.ApplicationInternalService{
+		ApplicationID: appID,
+		Port:          s.Port,
+	}
+}
+
+func FromDomainInternalServiceAllowedApp(appID string, allowedAppID string) *models.ApplicationInternalServiceAllowedApp {
+	return &models.ApplicationInternalServiceAllowedApp{
+		ApplicationID:        appID,
+		AllowedApplicationID: allowedAppID,
+	}
+}
+
+func ToDomainInternalService(app *models.Application) domain.InternalService {
+	if app.R.ApplicationInternalService == nil {
+		return domain.InternalService{}
+	}
+	return domain.InternalService{
+		Port: app.R.ApplicationInternalService.Port,
+		AllowedAppIDs: ds.Map(app.R.ApplicationInternalServiceAllowedApps, func(a *models.ApplicationInternalServiceAllowedApp) string {
+			return a.AllowedApplicationID
+		}),
+	}
+}
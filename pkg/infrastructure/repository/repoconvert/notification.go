@@ -0,0 +1,61 @@
+package repoconvert
+
+import (
+	"slices"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
+)
+
+var NotificationSinkMapper = mapper.MustNewValueMapper(map[string]domain.NotificationSinkType{
+	models.NotificationSubscriptionsSinkWebhook: domain.NotificationSinkWebhook,
+	models.NotificationSubscriptionsSinkSlack:   domain.NotificationSinkSlack,
+	models.NotificationSubscriptionsSinkTraq:    domain.NotificationSinkTraQ,
+	models.NotificationSubscriptionsSinkEmail:   domain.NotificationSinkEmail,
+})
+
+func FromDomainNotificationSubscription(s *domain.NotificationSubscription) *models.NotificationSubscription {
+	return &models.NotificationSubscription{
+		ID:                 s.ID,
+		ApplicationID:      s.ApplicationID,
+		UserID:             s.UserID,
+		Sink:               NotificationSinkMapper.FromMust(s.Sink),
+		Target:             s.Target,
+		Secret:             s.Secret,
+		OnBuildFailed:      slices.Contains(s.Events, domain.NotificationEventBuildFailed),
+		OnBuildSucceeded:   slices.Contains(s.Events, domain.NotificationEventBuildSucceeded),
+		OnDeployed:         slices.Contains(s.Events, domain.NotificationEventDeployed),
+		OnContainerErrored: slices.Contains(s.Events, domain.NotificationEventContainerErrored),
+		OnCertificateError: slices.Contains(s.Events, domain.NotificationEventCertificateError),
+		OnAlert:            slices.Contains(s.Events, domain.NotificationEventAlert),
+		CreatedAt:          s.CreatedAt,
+	}
+}
+
+func ToDomainNotificationSubscription(s *models.NotificationSubscription) *domain.NotificationSubscription {
+	var events []domain.NotificationEvent
+	for event, on := range map[domain.NotificationEvent]bool{
+		domain.NotificationEventBuildFailed:      s.OnBuildFailed,
+		domain.NotificationEventBuildSucceeded:   s.OnBuildSucceeded,
+		domain.NotificationEventDeployed:         s.OnDeployed,
+		domain.NotificationEventContainerErrored: s.OnContainerErrored,
+		domain.NotificationEventCertificateError: s.OnCertificateError,
+		domain.NotificationEventAlert:            s.OnAlert,
+	} {
+		if on {
+			events = append(events, event)
+		}
+	}
+	slices.Sort(events)
+	return &domain.NotificationSubscription{
+		ID:            s.ID,
+		ApplicationID: s.ApplicationID,
+		UserID:        s.UserID,
+		Sink:          NotificationSinkMapper.IntoMust(s.Sink),
+		Target:        s.Target,
+		Secret:        s.Secret,
+		Events:        events,
+		CreatedAt:     s.CreatedAt,
+	}
+}
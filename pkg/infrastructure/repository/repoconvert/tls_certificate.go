@@ -0,0 +1,31 @@
+package repoconvert
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+)
+
+func FromDomainTLSCertificate(c *domain.TLSCertificate, encryptedPrivateKey []byte) *models.TLSCertificate {
+	return &models.TLSCertificate{
+		ID:          c.ID,
+		UserID:      c.UserID,
+		FQDN:        c.FQDN,
+		Certificate: c.Certificate,
+		PrivateKey:  encryptedPrivateKey,
+		NotBefore:   c.NotBefore,
+		NotAfter:    c.NotAfter,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+func ToDomainTLSCertificate(c *models.TLSCertificate) *domain.TLSCertificate {
+	return &domain.TLSCertificate{
+		ID:          c.ID,
+		UserID:      c.UserID,
+		FQDN:        c.FQDN,
+		Certificate: c.Certificate,
+		NotBefore:   c.NotBefore,
+		NotAfter:    c.NotAfter,
+		CreatedAt:   c.CreatedAt,
+	}
+}
@@ -40,3 +40,25 @@ func ToDomainUserKey(key *models.UserKey) *domain.UserKey {
 		CreatedAt: key.CreatedAt,
 	}
 }
+
+func FromDomainUserQuota(quota *domain.UserQuota) *models.UserResourceLimit {
+	return &models.UserResourceLimit{
+		UserID:                 quota.UserID,
+		MaxApplications:        quota.MaxApplications,
+		MaxRunningApplications: quota.MaxRunningApplications,
+		MaxPortPublications:    quota.MaxPortPublications,
+		MaxDatabases:           quota.MaxDatabases,
+	}
+}
+
+func ToDomainUserQuota(quota *models.UserResourceLimit) *domain.UserQuota {
+	return &domain.UserQuota{
+		UserID: quota.UserID,
+		Quota: domain.Quota{
+			MaxApplications:        quota.MaxApplications,
+			MaxRunningApplications: quota.MaxRunningApplications,
+			MaxPortPublications:    quota.MaxPortPublications,
+			MaxDatabases:           quota.MaxDatabases,
+		},
+	}
+}
@@ -1,8 +1,13 @@
 package repoconvert
 
 import (
+	"cmp"
+	"slices"
+	"strings"
+
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
 	"github.com/traPtitech/neoshowcase/pkg/util/mapper"
 )
 
@@ -36,5 +41,190 @@ func ToDomainWebsite(website *models.Website) *domain.Website {
 		H2C:            website.H2C,
 		HTTPPort:       website.HTTPPort,
 		Authentication: AuthTypeMapper.IntoMust(website.Authentication),
+		Rules:          ds.Map(websiteRules(website), ToDomainWebsiteRule),
+		HeaderPolicy:   websiteHeaderPolicy(website),
+		AccessControl:  websiteAccessControl(website),
+		RateLimit:      websiteRateLimit(website),
+		Backends:       websiteBackends(website),
+	}
+}
+
+func websiteRules(website *models.Website) []*models.WebsiteRule {
+	if website.R == nil {
+		return nil
+	}
+	rules := slices.Clone(website.R.WebsiteRules)
+	slices.SortFunc(rules, func(a, b *models.WebsiteRule) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+	return rules
+}
+
+var WebsiteRuleTypeMapper = mapper.MustNewValueMapper(map[string]domain.WebsiteRuleType{
+	models.WebsiteRulesTypeRedirect: domain.WebsiteRuleTypeRedirect,
+	models.WebsiteRulesTypeRewrite:  domain.WebsiteRuleTypeRewrite,
+})
+
+func FromDomainWebsiteRule(websiteID string, position int, rule *domain.WebsiteRule) *models.WebsiteRule {
+	return &models.WebsiteRule{
+		WebsiteID:     websiteID,
+		Position:      position,
+		Type:          WebsiteRuleTypeMapper.FromMust(rule.Type),
+		PathRegex:     rule.PathRegex,
+		Target:        rule.Target,
+		StatusCode:    rule.StatusCode,
+		PreserveQuery: rule.PreserveQuery,
+	}
+}
+
+func ToDomainWebsiteRule(rule *models.WebsiteRule) *domain.WebsiteRule {
+	return &domain.WebsiteRule{
+		Type:          WebsiteRuleTypeMapper.IntoMust(rule.Type),
+		PathRegex:     rule.PathRegex,
+		Target:        rule.Target,
+		StatusCode:    rule.StatusCode,
+		PreserveQuery: rule.PreserveQuery,
+	}
+}
+
+func websiteHeaderPolicy(website *models.Website) domain.WebsiteHeaderPolicy {
+	var policy domain.WebsiteHeaderPolicy
+	if website.R == nil {
+		return policy
+	}
+	headers := slices.Clone(website.R.WebsiteHeaders)
+	slices.SortFunc(headers, func(a, b *models.WebsiteHeader) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	policy.ResponseHeaders = ds.Map(headers, ToDomainWebsiteHeader)
+	if website.R.WebsiteCorsPolicy != nil {
+		policy.CORS = ToDomainWebsiteCORSPolicy(website.R.WebsiteCorsPolicy)
+	}
+	return policy
+}
+
+func FromDomainWebsiteHeader(websiteID string, header *domain.WebsiteHeader) *models.WebsiteHeader {
+	return &models.WebsiteHeader{
+		WebsiteID: websiteID,
+		Name:      header.Name,
+		Value:     header.Value,
+	}
+}
+
+func ToDomainWebsiteHeader(header *models.WebsiteHeader) *domain.WebsiteHeader {
+	return &domain.WebsiteHeader{
+		Name:  header.Name,
+		Value: header.Value,
+	}
+}
+
+const corsListSeparator = ","
+
+func splitCORSList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, corsListSeparator)
+}
+
+func FromDomainWebsiteCORSPolicy(websiteID string, cors *domain.WebsiteCORSPolicy) *models.WebsiteCorsPolicy {
+	return &models.WebsiteCorsPolicy{
+		WebsiteID:        websiteID,
+		AllowOrigins:     strings.Join(cors.AllowOrigins, corsListSeparator),
+		AllowMethods:     strings.Join(cors.AllowMethods, corsListSeparator),
+		AllowHeaders:     strings.Join(cors.AllowHeaders, corsListSeparator),
+		ExposeHeaders:    strings.Join(cors.ExposeHeaders, corsListSeparator),
+		AllowCredentials: cors.AllowCredentials,
+		MaxAge:           cors.MaxAge,
+	}
+}
+
+func ToDomainWebsiteCORSPolicy(cors *models.WebsiteCorsPolicy) domain.WebsiteCORSPolicy {
+	return domain.WebsiteCORSPolicy{
+		AllowOrigins:     splitCORSList(cors.AllowOrigins),
+		AllowMethods:     splitCORSList(cors.AllowMethods),
+		AllowHeaders:     splitCORSList(cors.AllowHeaders),
+		ExposeHeaders:    splitCORSList(cors.ExposeHeaders),
+		AllowCredentials: cors.AllowCredentials,
+		MaxAge:           cors.MaxAge,
+	}
+}
+
+func websiteAccessControl(website *models.Website) domain.WebsiteAccessControl {
+	var ac domain.WebsiteAccessControl
+	if website.R == nil {
+		return ac
+	}
+	ac.IPAllowList = ds.Map(website.R.WebsiteIPAllowlistEntries, func(e *models.WebsiteIPAllowlistEntry) string { return e.Cidr })
+	slices.Sort(ac.IPAllowList)
+	users := slices.Clone(website.R.WebsiteBasicAuthUsers)
+	slices.SortFunc(users, func(a, b *models.WebsiteBasicAuthUser) int {
+		return cmp.Compare(a.Username, b.Username)
+	})
+	ac.BasicAuthUsers = ds.Map(users, ToDomainWebsiteBasicAuthUser)
+	return ac
+}
+
+func FromDomainWebsiteBasicAuthUser(websiteID string, user *domain.WebsiteBasicAuthUser) *models.WebsiteBasicAuthUser {
+	return &models.WebsiteBasicAuthUser{
+		WebsiteID:    websiteID,
+		Username:     user.Username,
+		PasswordHash: user.PasswordHash,
+	}
+}
+
+func ToDomainWebsiteBasicAuthUser(user *models.WebsiteBasicAuthUser) *domain.WebsiteBasicAuthUser {
+	return &domain.WebsiteBasicAuthUser{
+		Username:     user.Username,
+		PasswordHash: user.PasswordHash,
+	}
+}
+
+func websiteRateLimit(website *models.Website) domain.WebsiteRateLimit {
+	if website.R == nil || website.R.WebsiteRateLimit == nil {
+		return domain.WebsiteRateLimit{}
+	}
+	return ToDomainWebsiteRateLimit(website.R.WebsiteRateLimit)
+}
+
+func FromDomainWebsiteRateLimit(websiteID string, rl *domain.WebsiteRateLimit) *models.WebsiteRateLimit {
+	return &models.WebsiteRateLimit{
+		WebsiteID:    websiteID,
+		Average:      rl.Average,
+		Burst:        rl.Burst,
+		SourceHeader: rl.SourceHeader,
+	}
+}
+
+func ToDomainWebsiteRateLimit(rl *models.WebsiteRateLimit) domain.WebsiteRateLimit {
+	return domain.WebsiteRateLimit{
+		Enabled:      true,
+		Average:      rl.Average,
+		Burst:        rl.Burst,
+		SourceHeader: rl.SourceHeader,
+	}
+}
+
+func websiteBackends(website *models.Website) []*domain.WebsiteBackend {
+	if website.R == nil {
+		return nil
+	}
+	backends := ds.Map(website.R.WebsiteBackends, ToDomainWebsiteBackend)
+	slices.SortFunc(backends, (*domain.WebsiteBackend).Compare)
+	return backends
+}
+
+func FromDomainWebsiteBackend(websiteID string, b *domain.WebsiteBackend) *models.WebsiteBackend {
+	return &models.WebsiteBackend{
+		WebsiteID:     websiteID,
+		ApplicationID: b.ApplicationID,
+		Weight:        b.Weight,
+	}
+}
+
+func ToDomainWebsiteBackend(b *models.WebsiteBackend) *domain.WebsiteBackend {
+	return &domain.WebsiteBackend{
+		ApplicationID: b.ApplicationID,
+		Weight:        b.Weight,
 	}
 }
@@ -0,0 +1,37 @@
+package repoconvert
+
+import (
+	"time"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func FromDomainWebsiteProbe(p *domain.WebsiteProbe) *models.WebsiteProbe {
+	return &models.WebsiteProbe{
+		ID:            p.ID,
+		WebsiteID:     p.WebsiteID,
+		ApplicationID: p.ApplicationID,
+		CheckedAt:     p.CheckedAt,
+		Up:            p.Up,
+		StatusCode:    p.StatusCode,
+		LatencyMS:     int(p.Latency.Milliseconds()),
+		TLSExpiresAt:  optional.IntoTime(p.TLSExpiresAt),
+		Error:         p.Error,
+	}
+}
+
+func ToDomainWebsiteProbe(p *models.WebsiteProbe) *domain.WebsiteProbe {
+	return &domain.WebsiteProbe{
+		ID:            p.ID,
+		WebsiteID:     p.WebsiteID,
+		ApplicationID: p.ApplicationID,
+		CheckedAt:     p.CheckedAt,
+		Up:            p.Up,
+		StatusCode:    p.StatusCode,
+		Latency:       time.Duration(p.LatencyMS) * time.Millisecond,
+		TLSExpiresAt:  optional.FromTime(p.TLSExpiresAt),
+		Error:         p.Error,
+	}
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/repoconvert"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+type tlsCertificateRepository struct {
+	db   *sql.DB
+	aead cipher.AEAD
+}
+
+func NewTLSCertificateRepository(db *sql.DB, key domain.TLSCertificateEncryptionKey) (domain.TLSCertificateRepository, error) {
+	r := &tlsCertificateRepository{db: db}
+	if len(key) == 0 {
+		return r, nil
+	}
+	// Derive a fixed length key, so that any secret can be configured
+	derived := sha256.Sum256(key)
+	block, err := aes.NewCipher(derived[:])
+	if err != nil {
+		return nil, oops.Wrapf(err, "creating cipher")
+	}
+	r.aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, oops.Wrapf(err, "creating aead")
+	}
+	return r, nil
+}
+
+// encrypt encrypts the private key, bound to the certificate ID.
+func (r *tlsCertificateRepository) encrypt(id string, privateKey string) ([]byte, error) {
+	if r.aead == nil {
+		return nil, oops.New("encryption key for tls certificates is not configured")
+	}
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, oops.Wrapf(err, "generating nonce")
+	}
+	return r.aead.Seal(nonce, nonce, []byte(privateKey), []byte(id)), nil
+}
+
+func (r *tlsCertificateRepository) decrypt(id string, encrypted []byte) (string, error) {
+	if r.aead == nil {
+		return "", oops.New("encryption key for tls certificates is not configured")
+	}
+	nonceSize := r.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return "", oops.New("encrypted private key is too short")
+	}
+	plain, err := r.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], []byte(id))
+	if err != nil {
+		return "", oops.Wrapf(err, "decrypting private key")
+	}
+	return string(plain), nil
+}
+
+func (r *tlsCertificateRepository) GetTLSCertificates(ctx context.Context, cond domain.GetTLSCertificateCondition) ([]*domain.TLSCertificate, error) {
+	mods := []qm.QueryMod{
+		// Private keys are only loaded on demand
+		qm.Select(
+			models.TLSCertificateColumns.ID,
+			models.TLSCertificateColumns.UserID,
+			models.TLSCertificateColumns.FQDN,
+			models.TLSCertificateColumns.Certificate,
+			models.TLSCertificateColumns.NotBefore,
+			models.TLSCertificateColumns.NotAfter,
+			models.TLSCertificateColumns.CreatedAt,
+		),
+		qm.OrderBy(models.TLSCertificateColumns.FQDN),
+	}
+	if cond.ID.Valid {
+		mods = append(mods, models.TLSCertificateWhere.ID.EQ(cond.ID.V))
+	}
+	if cond.UserID.Valid {
+		mods = append(mods, models.TLSCertificateWhere.UserID.EQ(cond.UserID.V))
+	}
+	if cond.FQDN.Valid {
+		mods = append(mods, models.TLSCertificateWhere.FQDN.EQ(cond.FQDN.V))
+	}
+
+	certs, err := models.TLSCertificates(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting tls certificates")
+	}
+	return ds.Map(certs, repoconvert.ToDomainTLSCertificate), nil
+}
+
+func (r *tlsCertificateRepository) LoadTLSCertificatePrivateKey(ctx context.Context, c *domain.TLSCertificate) error {
+	mc, err := models.TLSCertificates(
+		qm.Select(models.TLSCertificateColumns.PrivateKey),
+		models.TLSCertificateWhere.ID.EQ(c.ID),
+	).One(ctx, r.db)
+	if err != nil {
+		if isNoRowsErr(err) {
+			return ErrNotFound
+		}
+		return oops.With("id", c.ID).Wrapf(err, "getting tls certificate private key")
+	}
+	privateKey, err := r.decrypt(c.ID, mc.PrivateKey)
+	if err != nil {
+		return oops.With("id", c.ID).Wrap(err)
+	}
+	c.PrivateKey = privateKey
+	return nil
+}
+
+func (r *tlsCertificateRepository) CreateTLSCertificate(ctx context.Context, c *domain.TLSCertificate) error {
+	encrypted, err := r.encrypt(c.ID, c.PrivateKey)
+	if err != nil {
+		return err
+	}
+	mc := repoconvert.FromDomainTLSCertificate(c, encrypted)
+	err = mc.Insert(ctx, r.db, boil.Blacklist())
+	if err != nil {
+		return oops.With("fqdn", c.FQDN).Wrapf(err, "inserting tls certificate")
+	}
+	return nil
+}
+
+func (r *tlsCertificateRepository) UpdateTLSCertificate(ctx context.Context, c *domain.TLSCertificate) error {
+	encrypted, err := r.encrypt(c.ID, c.PrivateKey)
+	if err != nil {
+		return err
+	}
+	_, err = models.TLSCertificates(models.TLSCertificateWhere.ID.EQ(c.ID)).UpdateAll(ctx, r.db, models.M{
+		models.TLSCertificateColumns.UserID:      c.UserID,
+		models.TLSCertificateColumns.Certificate: c.Certificate,
+		models.TLSCertificateColumns.PrivateKey:  encrypted,
+		models.TLSCertificateColumns.NotBefore:   c.NotBefore,
+		models.TLSCertificateColumns.NotAfter:    c.NotAfter,
+	})
+	if err != nil {
+		return oops.With("id", c.ID).Wrapf(err, "updating tls certificate")
+	}
+	return nil
+}
+
+func (r *tlsCertificateRepository) DeleteTLSCertificate(ctx context.Context, id string) error {
+	_, err := models.TLSCertificates(models.TLSCertificateWhere.ID.EQ(id)).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.With("id", id).Wrapf(err, "deleting tls certificate")
+	}
+	return nil
+}
@@ -143,3 +143,36 @@ func (r *userRepository) DeleteUserKey(ctx context.Context, keyID string, userID
 	}
 	return nil
 }
+
+func (r *userRepository) GetUserQuotas(ctx context.Context, cond domain.GetUserQuotaCondition) ([]*domain.UserQuota, error) {
+	var mods []qm.QueryMod
+
+	if cond.UserIDs.Valid {
+		mods = append(mods, models.UserResourceLimitWhere.UserID.IN(cond.UserIDs.V))
+	}
+
+	quotas, err := models.UserResourceLimits(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting user quotas")
+	}
+	return ds.Map(quotas, repoconvert.ToDomainUserQuota), nil
+}
+
+func (r *userRepository) SetUserQuota(ctx context.Context, quota *domain.UserQuota) error {
+	mq := repoconvert.FromDomainUserQuota(quota)
+	err := mq.Upsert(ctx, r.db, boil.Blacklist(), boil.Blacklist())
+	if err != nil {
+		return oops.Wrapf(err, "upserting user quota")
+	}
+	return nil
+}
+
+func (r *userRepository) DeleteUserQuota(ctx context.Context, userID string) error {
+	_, err := models.UserResourceLimits(
+		models.UserResourceLimitWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting user quota")
+	}
+	return nil
+}
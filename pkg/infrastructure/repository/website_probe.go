@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/models"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository/repoconvert"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+)
+
+type websiteProbeRepository struct {
+	db *sql.DB
+}
+
+func NewWebsiteProbeRepository(db *sql.DB) domain.WebsiteProbeRepository {
+	return &websiteProbeRepository{db: db}
+}
+
+func (r *websiteProbeRepository) CreateProbes(ctx context.Context, probes []*domain.WebsiteProbe) error {
+	if len(probes) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return oops.Wrapf(err, "starting transaction")
+	}
+	defer tx.Rollback()
+
+	for _, p := range probes {
+		mp := repoconvert.FromDomainWebsiteProbe(p)
+		err = mp.Insert(ctx, tx, boil.Blacklist())
+		if err != nil {
+			return oops.With("website_id", p.WebsiteID).Wrapf(err, "inserting website probe")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return oops.Wrapf(err, "committing")
+	}
+	return nil
+}
+
+func (r *websiteProbeRepository) GetLatestProbes(ctx context.Context, websiteIDs []string) ([]*domain.WebsiteProbe, error) {
+	if len(websiteIDs) == 0 {
+		return nil, nil
+	}
+	latest := `(` + models.WebsiteProbeColumns.WebsiteID + `, ` + models.WebsiteProbeColumns.CheckedAt + `) IN (` +
+		`SELECT ` + models.WebsiteProbeColumns.WebsiteID + `, MAX(` + models.WebsiteProbeColumns.CheckedAt + `) ` +
+		`FROM ` + models.TableNames.WebsiteProbes + ` ` +
+		`WHERE ` + models.WebsiteProbeColumns.WebsiteID + ` IN (` + strings.TrimSuffix(strings.Repeat("?,", len(websiteIDs)), ",") + `) ` +
+		`GROUP BY ` + models.WebsiteProbeColumns.WebsiteID + `)`
+	probes, err := models.WebsiteProbes(
+		qm.Where(latest, lo.ToAnySlice(websiteIDs)...),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting latest website probes")
+	}
+	// Deduplicate in case of probes with the same checked_at
+	probes = lo.UniqBy(probes, func(p *models.WebsiteProbe) string { return p.WebsiteID })
+	return ds.Map(probes, repoconvert.ToDomainWebsiteProbe), nil
+}
+
+type websiteProbeCountRow struct {
+	WebsiteID string `boil:"website_id"`
+	Total     int    `boil:"total"`
+	Up        int    `boil:"up"`
+}
+
+func (r *websiteProbeRepository) CountProbes(ctx context.Context, websiteIDs []string, since time.Time) ([]*domain.WebsiteProbeCount, error) {
+	if len(websiteIDs) == 0 {
+		return nil, nil
+	}
+	var rows []*websiteProbeCountRow
+	err := models.WebsiteProbes(
+		qm.Select(
+			models.WebsiteProbeColumns.WebsiteID,
+			"COUNT(*) AS total",
+			"CAST(COALESCE(SUM("+models.WebsiteProbeColumns.Up+"), 0) AS SIGNED) AS up",
+		),
+		models.WebsiteProbeWhere.WebsiteID.IN(websiteIDs),
+		models.WebsiteProbeWhere.CheckedAt.GTE(since),
+		qm.GroupBy(models.WebsiteProbeColumns.WebsiteID),
+	).Bind(ctx, r.db, &rows)
+	if err != nil {
+		return nil, oops.Wrapf(err, "counting website probes")
+	}
+	return lo.Map(rows, func(row *websiteProbeCountRow, _ int) *domain.WebsiteProbeCount {
+		return &domain.WebsiteProbeCount{WebsiteID: row.WebsiteID, Total: row.Total, Up: row.Up}
+	}), nil
+}
+
+func (r *websiteProbeRepository) DeleteProbes(ctx context.Context, before time.Time) error {
+	_, err := models.WebsiteProbes(
+		models.WebsiteProbeWhere.CheckedAt.LT(before),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website probes")
+	}
+	return nil
+}
+
+func (r *websiteProbeRepository) DeleteApplicationProbes(ctx context.Context, applicationID string) error {
+	_, err := models.WebsiteProbes(
+		models.WebsiteProbeWhere.ApplicationID.EQ(applicationID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return oops.Wrapf(err, "deleting website probes")
+	}
+	return nil
+}
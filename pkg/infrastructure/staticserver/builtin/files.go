@@ -0,0 +1,186 @@
+package builtin
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// fileServer serves the files of an artifact,
+// equivalent to the file_server and handle_errors of the caddy static server.
+type fileServer struct {
+	root   string
+	config domain.StaticConfig
+}
+
+func (s *fileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	root, err := os.OpenRoot(s.root)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	defer root.Close()
+
+	reqPath := r.URL.Path
+	if !strings.HasPrefix(reqPath, "/") {
+		reqPath = "/" + reqPath
+	}
+	name, servedPath, ok := s.resolve(root, reqPath)
+	if !ok {
+		s.notFound(w, r, root)
+		return
+	}
+	if cc := s.config.CacheControl(servedPath); cc != "" && w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", cc)
+	}
+	s.serveFile(w, r, root, name, http.StatusOK)
+}
+
+// resolve returns the file name in the artifact to serve for the request path,
+// and the request path after the SPA fallback, in the same order as try_files of the caddy static server.
+func (s *fileServer) resolve(root *os.Root, reqPath string) (name string, servedPath string, ok bool) {
+	cleaned := path.Clean(reqPath)
+	if isFile(root, cleaned) {
+		return cleaned, reqPath, true
+	}
+	if html := path.Clean(reqPath + ".html"); s.config.SPA && isFile(root, html) {
+		return html, reqPath + ".html", true
+	}
+	if index := path.Join(cleaned, "index.html"); isFile(root, index) {
+		return index, strings.TrimSuffix(reqPath, "/") + "/", true
+	}
+	if s.config.SPA && isFile(root, "/index.html") {
+		return "/index.html", "/index.html", true
+	}
+	return "", "", false
+}
+
+func (s *fileServer) notFound(w http.ResponseWriter, r *http.Request, root *os.Root) {
+	if s.config.NotFoundPath == "" || !isFile(root, s.config.NotFoundPath) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if cc := s.config.CacheControl(s.config.NotFoundPath); cc != "" {
+		w.Header().Set("Cache-Control", cc)
+	}
+	s.serveFile(w, r, root, s.config.NotFoundPath, http.StatusNotFound)
+}
+
+func (s *fileServer) serveFile(w http.ResponseWriter, r *http.Request, root *os.Root, name string, status int) {
+	h := w.Header()
+	contentType := mime.TypeByExtension(path.Ext(name))
+	var encoding string
+	if enc, ok := directEncodings[path.Ext(name)]; ok {
+		// Unity WebGL builds request the compressed files directly, see the caddy static server
+		encoding = enc
+		if ct, ok := directContentTypes[path.Ext(strings.TrimSuffix(name, path.Ext(name)))]; ok {
+			contentType = ct
+		}
+	}
+	f, precompressed, err := s.open(root, name, r.Header.Get("Accept-Encoding"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if s.config.Precompressed {
+		h.Add("Vary", "Accept-Encoding")
+	}
+	if precompressed != "" {
+		encoding = precompressed
+	}
+	if encoding != "" {
+		h.Set("Content-Encoding", encoding)
+		if contentType == "" {
+			// Do not let the compressed content be sniffed
+			contentType = "application/octet-stream"
+		}
+	}
+	if contentType != "" {
+		h.Set("Content-Type", contentType)
+	}
+
+	if status == http.StatusOK {
+		http.ServeContent(w, r, name, stat.ModTime(), f)
+		return
+	}
+	h.Set("Content-Length", strconv.FormatInt(stat.Size(), 10))
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_, _ = io.Copy(w, f)
+	}
+}
+
+// open opens the file, or its precompressed sibling accepted by the client if enabled.
+func (s *fileServer) open(root *os.Root, name string, acceptEncoding string) (f *os.File, encoding string, err error) {
+	if s.config.Precompressed {
+		for _, e := range domain.StaticPrecompressedEncodings {
+			if !acceptsEncoding(acceptEncoding, e.Encoding) || !isFile(root, name+e.Suffix) {
+				continue
+			}
+			f, err := root.Open(rootName(name + e.Suffix))
+			if err == nil {
+				return f, e.Encoding, nil
+			}
+		}
+	}
+	f, err = root.Open(rootName(name))
+	return f, "", err
+}
+
+var directEncodings = map[string]string{
+	".gz": "gzip",
+	".br": "br",
+}
+
+var directContentTypes = map[string]string{
+	".js":   "application/javascript",
+	".wasm": "application/wasm",
+}
+
+// acceptsEncoding returns true if the Accept-Encoding header value accepts the encoding.
+func acceptsEncoding(acceptEncoding string, encoding string) bool {
+	for part := range strings.SplitSeq(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(name), encoding) {
+			continue
+		}
+		q, ok := strings.CutPrefix(strings.TrimSpace(params), "q=")
+		if !ok {
+			return true
+		}
+		v, err := strconv.ParseFloat(q, 64)
+		return err == nil && v > 0
+	}
+	return false
+}
+
+func isFile(root *os.Root, name string) bool {
+	stat, err := root.Stat(rootName(name))
+	return err == nil && stat.Mode().IsRegular()
+}
+
+// rootName converts the absolute path in the artifact to the name relative to the root.
+func rootName(name string) string {
+	if name = strings.TrimPrefix(name, "/"); name == "" {
+		return "."
+	}
+	return name
+}
@@ -0,0 +1,143 @@
+package builtin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+func writeArtifact(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+	return root
+}
+
+func TestFileServer(t *testing.T) {
+	root := writeArtifact(t, map[string]string{
+		"index.html":                 "index",
+		"about.html":                 "about",
+		"docs/index.html":            "docs",
+		"404.html":                   "not found page",
+		"app.js":                     "js",
+		"app.js.br":                  "js in br",
+		"app.js.gz":                  "js in gzip",
+		"style.css":                  "css",
+		"style.css.gz":               "css in gzip",
+		"assets/index-BxK3a9_d.js":   "hashed",
+		"Build/game.wasm.gz":         "wasm in gzip",
+		"Build/game.framework.js.br": "framework in br",
+		"Build/game.data.unknown.gz": "data in gzip",
+	})
+	precompressed := domain.StaticConfig{Precompressed: true}
+	spa := domain.StaticConfig{SPA: true, NotFoundPath: "/404.html"}
+	notFoundPage := domain.StaticConfig{
+		NotFoundPath:      "/404.html",
+		HTMLCacheControl:  "no-cache",
+		AssetCacheControl: "public, max-age=31536000, immutable",
+	}
+
+	tests := []struct {
+		name           string
+		config         domain.StaticConfig
+		method         string
+		path           string
+		acceptEncoding string
+		wantStatus     int
+		wantBody       string
+		wantEncoding   string
+		wantType       string
+		wantVary       string
+		wantCache      string
+	}{
+		{name: "file", path: "/about.html", wantStatus: 200, wantBody: "about", wantType: "text/html; charset=utf-8"},
+		{name: "directory index", path: "/docs/", wantStatus: 200, wantBody: "docs"},
+		{name: "not found", path: "/missing", wantStatus: 404, wantBody: "Not Found\n"},
+		{name: "method not allowed", method: http.MethodPost, path: "/about.html", wantStatus: 405},
+
+		{name: "precompressed br preferred", config: precompressed, path: "/app.js", acceptEncoding: "gzip, deflate, br", wantStatus: 200, wantBody: "js in br", wantEncoding: "br", wantType: "text/javascript; charset=utf-8", wantVary: "Accept-Encoding"},
+		{name: "precompressed gzip", config: precompressed, path: "/app.js", acceptEncoding: "gzip", wantStatus: 200, wantBody: "js in gzip", wantEncoding: "gzip", wantVary: "Accept-Encoding"},
+		{name: "precompressed br refused with q=0", config: precompressed, path: "/app.js", acceptEncoding: "br;q=0, gzip;q=0.5", wantStatus: 200, wantBody: "js in gzip", wantEncoding: "gzip", wantVary: "Accept-Encoding"},
+		{name: "precompressed encoding names are case insensitive", config: precompressed, path: "/app.js", acceptEncoding: "BR", wantStatus: 200, wantBody: "js in br", wantEncoding: "br", wantVary: "Accept-Encoding"},
+		{name: "precompressed not accepted", config: precompressed, path: "/app.js", acceptEncoding: "identity", wantStatus: 200, wantBody: "js", wantVary: "Accept-Encoding"},
+		{name: "precompressed without accept encoding", config: precompressed, path: "/app.js", wantStatus: 200, wantBody: "js", wantVary: "Accept-Encoding"},
+		{name: "precompressed only some encodings", config: precompressed, path: "/style.css", acceptEncoding: "br, gzip", wantStatus: 200, wantBody: "css in gzip", wantEncoding: "gzip", wantType: "text/css; charset=utf-8", wantVary: "Accept-Encoding"},
+		{name: "precompressed disabled", path: "/app.js", acceptEncoding: "br, gzip", wantStatus: 200, wantBody: "js"},
+
+		{name: "unity wasm", path: "/Build/game.wasm.gz", wantStatus: 200, wantBody: "wasm in gzip", wantEncoding: "gzip", wantType: "application/wasm"},
+		{name: "unity js", path: "/Build/game.framework.js.br", wantStatus: 200, wantBody: "framework in br", wantEncoding: "br", wantType: "application/javascript"},
+		{name: "unity other type", path: "/Build/game.data.unknown.gz", wantStatus: 200, wantBody: "data in gzip", wantEncoding: "gzip", wantType: "application/gzip"},
+
+		{name: "custom 404 page", config: notFoundPage, path: "/missing", wantStatus: 404, wantBody: "not found page", wantType: "text/html; charset=utf-8", wantCache: "no-cache"},
+		{name: "custom 404 page head", config: notFoundPage, method: http.MethodHead, path: "/missing", wantStatus: 404, wantType: "text/html; charset=utf-8", wantCache: "no-cache"},
+		{name: "custom 404 page missing", config: domain.StaticConfig{NotFoundPath: "/missing.html"}, path: "/missing", wantStatus: 404, wantBody: "Not Found\n"},
+		{name: "existing file with custom 404 page", config: notFoundPage, path: "/about.html", wantStatus: 200, wantBody: "about", wantCache: "no-cache"},
+		{name: "hashed asset cache control", config: notFoundPage, path: "/assets/index-BxK3a9_d.js", wantStatus: 200, wantBody: "hashed", wantCache: "public, max-age=31536000, immutable"},
+		{name: "other file cache control", config: notFoundPage, path: "/app.js", wantStatus: 200, wantBody: "js"},
+
+		{name: "spa html", config: spa, path: "/about", wantStatus: 200, wantBody: "about"},
+		{name: "spa fallback instead of 404 page", config: spa, path: "/some/route", wantStatus: 200, wantBody: "index"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fileServer{root: root, config: tt.config}
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, tt.path, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusMethodNotAllowed {
+				assert.Equal(t, "GET, HEAD", rec.Header().Get("Allow"))
+				return
+			}
+			assert.Equal(t, tt.wantBody, rec.Body.String())
+			assert.Equal(t, tt.wantEncoding, rec.Header().Get("Content-Encoding"))
+			if tt.wantType != "" {
+				assert.Equal(t, tt.wantType, rec.Header().Get("Content-Type"))
+			}
+			assert.Equal(t, tt.wantVary, rec.Header().Get("Vary"))
+			assert.Equal(t, tt.wantCache, rec.Header().Get("Cache-Control"))
+		})
+	}
+}
+
+func TestAcceptsEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		encoding       string
+		want           bool
+	}{
+		{"", "br", false},
+		{"br", "br", true},
+		{"gzip, deflate, br", "br", true},
+		{"gzip, deflate", "br", false},
+		{"Br", "br", true},
+		{"br;q=0", "br", false},
+		{"br;q=0.0", "br", false},
+		{"br; q=0.1", "br", true},
+		{"br;q=invalid", "br", false},
+		{"*", "br", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			assert.Equal(t, tt.want, acceptsEncoding(tt.acceptEncoding, tt.encoding))
+		})
+	}
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
+	"maps"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"sync"
 	"time"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
 )
 
 type Config struct {
@@ -25,38 +27,69 @@ type server struct {
 	docsRoot string
 	server   *http.Server
 	sites    map[string]*host
+	// rules are compiled website rules keyed by website ID
+	rules map[string][]*domain.WebsiteRuleMatcher
+	// headers are header policies keyed by website ID
+	headers map[string]*domain.WebsiteHeaderPolicy
+	// rateLimiters are rate limiters keyed by website ID
+	rateLimiters map[string]*rateLimiter
 
 	sitesLock sync.RWMutex
 }
 
 type host struct {
-	Echo *echo.Echo
-	Site *domain.StaticSite
+	Files http.Handler
+	Site  *domain.StaticSite
 }
 
 func NewServer(c Config, docsRoot string) domain.StaticServer {
 	b := &server{
-		docsRoot: docsRoot,
-		sites:    make(map[string]*host),
+		docsRoot:     docsRoot,
+		sites:        make(map[string]*host),
+		rules:        make(map[string][]*domain.WebsiteRuleMatcher),
+		headers:      make(map[string]*domain.WebsiteHeaderPolicy),
+		rateLimiters: make(map[string]*rateLimiter),
 	}
 
 	e := echo.New()
+	// Requests are proxied by Traefik, which appends the client address to X-Forwarded-For
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
 	e.Use(middleware.Recover())
 	e.Any("/*", func(c *echo.Context) error {
 		req := c.Request()
 		res := c.Response()
 
 		appID := req.Header.Get(web.HeaderNameSSGenAppID)
+		websiteID := req.Header.Get(web.HeaderNameSSGenWebsiteID)
 
 		b.sitesLock.RLock()
 		host := b.sites[appID]
+		rules := b.rules[websiteID]
+		headers := b.headers[websiteID]
+		limiter := b.rateLimiters[websiteID]
 		b.sitesLock.RUnlock()
 
 		if host == nil {
 			return echo.ErrNotFound
 		}
 
-		host.Echo.ServeHTTP(res, req)
+		if limiter != nil && !limiter.allow(c) {
+			return echo.ErrTooManyRequests
+		}
+
+		if headers != nil {
+			preflight, err := applyHeaderPolicy(c, headers)
+			if err != nil || preflight {
+				return err
+			}
+		}
+
+		redirected, err := applyRules(c, rules)
+		if err != nil || redirected {
+			return err
+		}
+
+		host.Files.ServeHTTP(res, req)
 		return nil
 	})
 	b.server = &http.Server{
@@ -68,6 +101,84 @@ func NewServer(c Config, docsRoot string) domain.StaticServer {
 	return b
 }
 
+// rateLimiter limits the rate of requests with a token bucket per client,
+// equivalent to the Traefik RateLimit middleware used for runtime apps.
+type rateLimiter struct {
+	config domain.WebsiteRateLimit
+	store  *middleware.RateLimiterMemoryStore
+}
+
+func newRateLimiter(config domain.WebsiteRateLimit) *rateLimiter {
+	return &rateLimiter{
+		config: config,
+		store: middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:  float64(config.Average),
+			Burst: config.Burst,
+		}),
+	}
+}
+
+func (l *rateLimiter) allow(c *echo.Context) bool {
+	var source string
+	if l.config.SourceHeader != "" {
+		source = c.Request().Header.Get(l.config.SourceHeader)
+	} else {
+		source = c.RealIP()
+	}
+	allowed, _ := l.store.Allow(source)
+	return allowed
+}
+
+// applyRules applies the website rules in order, rewriting the request path in place.
+func applyRules(c *echo.Context, rules []*domain.WebsiteRuleMatcher) (redirected bool, err error) {
+	req := c.Request()
+	for _, rule := range rules {
+		result, ok := rule.Apply(req.URL.EscapedPath(), req.URL.RawQuery)
+		if !ok {
+			continue
+		}
+		switch rule.Rule().Type {
+		case domain.WebsiteRuleTypeRedirect:
+			return true, c.Redirect(rule.Rule().StatusCode, result)
+		case domain.WebsiteRuleTypeRewrite:
+			path, err := url.PathUnescape(result)
+			if err != nil {
+				return false, echo.ErrBadRequest
+			}
+			req.URL.Path = path
+			req.URL.RawPath = result
+		}
+	}
+	return false, nil
+}
+
+// applyHeaderPolicy sets the custom response headers and the CORS headers, and answers CORS preflight requests.
+func applyHeaderPolicy(c *echo.Context, policy *domain.WebsiteHeaderPolicy) (preflight bool, err error) {
+	req := c.Request()
+	h := c.Response().Header()
+	for _, header := range policy.ResponseHeaders {
+		h.Set(header.Name, header.Value)
+	}
+
+	cors := &policy.CORS
+	if !cors.Enabled() {
+		return false, nil
+	}
+	if !cors.AllowsAnyOrigin() {
+		h.Add("Vary", "Origin")
+	}
+	origin := req.Header.Get("Origin")
+	if !cors.AllowsOrigin(origin) {
+		return false, nil
+	}
+	maps.Copy(h, cors.ResponseHeaders(origin))
+	if domain.IsPreflightRequest(req) {
+		maps.Copy(h, cors.PreflightHeaders())
+		return true, c.NoContent(http.StatusNoContent)
+	}
+	return false, nil
+}
+
 func (b *server) Start(_ context.Context) error {
 	if err := b.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
@@ -81,24 +192,42 @@ func (b *server) Shutdown(ctx context.Context) error {
 
 func (b *server) Reconcile(sites []*domain.StaticSite) error {
 	siteMap := map[string]*host{}
+	ruleMap := map[string][]*domain.WebsiteRuleMatcher{}
+	headerMap := map[string]*domain.WebsiteHeaderPolicy{}
+	rateLimiterMap := map[string]*rateLimiter{}
+	b.sitesLock.RLock()
+	prevRateLimiters := b.rateLimiters
+	b.sitesLock.RUnlock()
 	for _, site := range sites {
-		if site.SPA {
-			slog.Warn("SPA option is not supported in built-in static server")
+		ruleMap[site.Website.ID] = ds.Map(site.Website.Rules, func(r *domain.WebsiteRule) *domain.WebsiteRuleMatcher {
+			return r.Matcher(site.Website)
+		})
+		if !site.Website.HeaderPolicy.IsEmpty() {
+			headerMap[site.Website.ID] = &site.Website.HeaderPolicy
+		}
+		if rl := site.Website.RateLimit; rl.Enabled {
+			// Keep the buckets of the clients across reloads, unless the settings have changed
+			if prev, ok := prevRateLimiters[site.Website.ID]; ok && prev.config == rl {
+				rateLimiterMap[site.Website.ID] = prev
+			} else {
+				rateLimiterMap[site.Website.ID] = newRateLimiter(rl)
+			}
 		}
-		artifactDir := filepath.Join(b.docsRoot, site.ArtifactID)
-		e := echo.New()
-		e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
-			Root:  artifactDir,
-			Index: "index.html",
-		}))
+
 		siteMap[site.Application.ID] = &host{
-			Echo: e,
+			Files: &fileServer{
+				root:   filepath.Join(b.docsRoot, site.ArtifactID),
+				config: site.Config,
+			},
 			Site: site,
 		}
 	}
 
 	b.sitesLock.Lock()
 	b.sites = siteMap
+	b.rules = ruleMap
+	b.headers = headerMap
+	b.rateLimiters = rateLimiterMap
 	b.sitesLock.Unlock()
 	return nil
 }
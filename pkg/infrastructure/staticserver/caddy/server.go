@@ -5,8 +5,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"net/http"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/samber/lo"
 	"github.com/samber/oops"
@@ -39,24 +44,35 @@ func (s *server) Shutdown(_ context.Context) error {
 const siteTemplate = `@%v {
 	header %v %v
 }
-file_server @%v {
-	root %v
-	precompressed br gzip zstd
+%vroute @%v {
+	root * %v
+%v	file_server%v
 }
 `
 
-const siteTemplateSPA = `@%v {
-	header %v %v
-}
-handle @%v {
-	root * %v
-	try_files {path} {path}.html {path}/ {path}/index.html /index.html =404
-	file_server {
-		precompressed br gzip zstd
+// notFoundTemplate serves the custom 404 page of an application, inside handle_errors
+const notFoundTemplate = `	route @%v {
+		root * %v
+		rewrite * %v
+%v		file_server%v
 	}
+`
+
+const spaTryFiles = "\ttry_files {path} {path}.html {path}/ {path}/index.html /index.html =404\n"
+
+// ruleMatcherTemplate matches the path of a website against a rule, with the capture groups as {re.<name>.N}
+const ruleMatcherTemplate = `@%v {
+	header %v %v
+	path_regexp %v %v
 }
 `
 
+// websiteMatcherTemplate matches requests to a website, optionally with additional conditions
+const websiteMatcherTemplate = `@%v {
+	header %v %v
+%v}
+`
+
 // unityWebglCompressionHeader for Unity WebGL https://docs.unity3d.com/Manual/webgl-deploying.html
 const unityWebglCompressionHeader = `@gzip-suffix {
 	path *.gz
@@ -77,31 +93,167 @@ header @wasm-comp-suffix Content-Type application/wasm
 `
 
 func (s *server) Reconcile(sites []*domain.StaticSite) error {
-	var b bytes.Buffer
+	var b, notFound bytes.Buffer
 	b.WriteString(":80 {\n")
-	sites = lo.UniqBy(sites, func(site *domain.StaticSite) string { return site.Application.ID })
-	for _, site := range sites {
+	// Websites of the same application share the artifact
+	sitesByApp := lo.GroupBy(sites, func(site *domain.StaticSite) string { return site.Application.ID })
+	for _, site := range lo.UniqBy(sites, func(site *domain.StaticSite) string { return site.Application.ID }) {
 		matcherName := fmt.Sprintf("nsapp-%v", site.Application.ID)
-		if site.SPA {
-			fmt.Fprintf(&b, siteTemplateSPA,
-				matcherName,
-				web.HeaderNameSSGenAppID, site.Application.ID,
-				matcherName,
-				filepath.Join(s.c.DocsRoot, site.ArtifactID))
-		} else {
-			fmt.Fprintf(&b, siteTemplate,
-				matcherName,
-				web.HeaderNameSSGenAppID, site.Application.ID,
+		var matchers, handlers strings.Builder
+		for _, ws := range sitesByApp[site.Application.ID] {
+			if ws.Website.RateLimit.Enabled {
+				slog.Warn("rate limit is not supported in caddy static server", "fqdn", ws.Website.FQDN)
+			}
+			writeHeaderPolicy(&matchers, &handlers, ws.Website)
+			writeRules(&matchers, &handlers, ws.Website)
+		}
+		if site.Config.SPA {
+			handlers.WriteString(spaTryFiles)
+		}
+		// Cache-Control is decided by the path after the SPA fallback
+		writeCacheControl(&matchers, &handlers, site.Application.ID, &site.Config)
+		fmt.Fprintf(&b, siteTemplate,
+			matcherName,
+			web.HeaderNameSSGenAppID, site.Application.ID,
+			matchers.String(),
+			matcherName,
+			filepath.Join(s.c.DocsRoot, site.ArtifactID),
+			handlers.String(),
+			fileServerOptions(&site.Config, "\t"),
+		)
+		if site.Config.NotFoundPath != "" {
+			var cacheControl string
+			if cc := site.Config.CacheControl(site.Config.NotFoundPath); cc != "" {
+				cacheControl = fmt.Sprintf("\t\theader Cache-Control %v\n", quote(cc))
+			}
+			fmt.Fprintf(&notFound, notFoundTemplate,
 				matcherName,
 				filepath.Join(s.c.DocsRoot, site.ArtifactID),
+				quote(site.Config.NotFoundPath),
+				cacheControl,
+				fileServerOptions(&site.Config, "\t\t"),
 			)
 		}
 	}
 	b.WriteString(unityWebglCompressionHeader)
+	if notFound.Len() > 0 {
+		b.WriteString("handle_errors 404 {\n")
+		b.WriteString(notFound.String())
+		b.WriteString("}\n")
+	}
 	b.WriteString("}\n")
 	return s.postConfig(b.Bytes())
 }
 
+// fileServerOptions returns the options block of file_server, indented by indent.
+func fileServerOptions(config *domain.StaticConfig, indent string) string {
+	if !config.Precompressed {
+		return ""
+	}
+	encodings := lo.Map(domain.StaticPrecompressedEncodings, func(e domain.StaticPrecompressedEncoding, _ int) string { return e.Encoding })
+	return fmt.Sprintf(" {\n%v\tprecompressed %v\n%v}", indent, strings.Join(encodings, " "), indent)
+}
+
+// writeCacheControl writes the matchers and the handlers setting the default Cache-Control of the files.
+// The header set by the website header policy takes precedence.
+func writeCacheControl(matchers, handlers io.Writer, appID string, config *domain.StaticConfig) {
+	if config.HTMLCacheControl != "" {
+		name := fmt.Sprintf("html_%v", appID)
+		fmt.Fprintf(matchers, "@%v path *.html */\n", name)
+		fmt.Fprintf(handlers, "\theader @%v ?Cache-Control %v\n", name, quote(config.HTMLCacheControl))
+	}
+	if config.AssetCacheControl != "" {
+		name := fmt.Sprintf("asset_%v", appID)
+		fmt.Fprintf(matchers, "@%v {\n\tnot path *.html */\n\tpath_regexp %v\n}\n", name, quote(domain.StaticHashedAssetPathPattern))
+		fmt.Fprintf(handlers, "\theader @%v ?Cache-Control %v\n", name, quote(config.AssetCacheControl))
+	}
+}
+
+// writeHeaderPolicy writes the matchers and the handlers of the website header policy.
+// The handlers answer CORS preflight requests, so they have to precede the other handlers.
+func writeHeaderPolicy(matchers, handlers io.Writer, website *domain.Website) {
+	policy := &website.HeaderPolicy
+	if policy.IsEmpty() {
+		return
+	}
+	name := fmt.Sprintf("headers_%v", website.ID)
+	fmt.Fprintf(matchers, websiteMatcherTemplate, name, web.HeaderNameSSGenWebsiteID, website.ID, "")
+	for _, h := range policy.ResponseHeaders {
+		fmt.Fprintf(handlers, "\theader @%v %v %v\n", name, h.Name, quote(h.Value))
+	}
+
+	cors := &policy.CORS
+	if !cors.Enabled() {
+		return
+	}
+	if !cors.AllowsAnyOrigin() {
+		fmt.Fprintf(handlers, "\theader @%v +Vary Origin\n", name)
+	}
+	var originMatcher strings.Builder
+	for _, origin := range cors.AllowOrigins {
+		// "*" matches any non-empty value
+		fmt.Fprintf(&originMatcher, "\theader Origin %v\n", origin)
+	}
+	corsName := fmt.Sprintf("cors_%v", website.ID)
+	fmt.Fprintf(matchers, websiteMatcherTemplate, corsName, web.HeaderNameSSGenWebsiteID, website.ID, originMatcher.String())
+	preflightName := fmt.Sprintf("preflight_%v", website.ID)
+	fmt.Fprintf(matchers, websiteMatcherTemplate, preflightName, web.HeaderNameSSGenWebsiteID, website.ID,
+		originMatcher.String()+"\tmethod OPTIONS\n\theader Access-Control-Request-Method *\n")
+
+	// The actual origin is echoed back, since the origin has already been checked by the matcher
+	writeHeaders(handlers, corsName, cors.ResponseHeaders("{http.request.header.Origin}"))
+	writeHeaders(handlers, preflightName, cors.PreflightHeaders())
+	fmt.Fprintf(handlers, "\trespond @%v 204\n", preflightName)
+}
+
+func writeHeaders(w io.Writer, matcherName string, h http.Header) {
+	for _, key := range slices.Sorted(maps.Keys(h)) {
+		fmt.Fprintf(w, "\theader @%v %v %v\n", matcherName, key, quote(h.Get(key)))
+	}
+}
+
+// writeRules writes the matchers and the handlers of the website rules, in the order of evaluation.
+func writeRules(matchers, handlers io.Writer, website *domain.Website) {
+	for i, rule := range website.Rules {
+		name := fmt.Sprintf("rule_%v_%d", website.ID, i)
+		fmt.Fprintf(matchers, ruleMatcherTemplate,
+			name,
+			web.HeaderNameSSGenWebsiteID, website.ID,
+			name, quote(rule.AnchoredPathRegex()),
+		)
+		target := placeholderTarget(rule.Target, name)
+		switch rule.Type {
+		case domain.WebsiteRuleTypeRedirect:
+			if rule.PreserveQuery {
+				target += "{?query}"
+			}
+			fmt.Fprintf(handlers, "\tredir @%v %v %d\n", name, quote(target), rule.StatusCode)
+		case domain.WebsiteRuleTypeRewrite:
+			fmt.Fprintf(handlers, "\trewrite @%v %v\n", name, quote(target))
+		}
+	}
+}
+
+var targetGroupRegexp = regexp.MustCompile(`\$(\$|\{\w+\}|\w+)`)
+
+// placeholderTarget converts references to the capture groups, such as $1 and ${1}, into caddy placeholders.
+func placeholderTarget(target string, reName string) string {
+	return targetGroupRegexp.ReplaceAllStringFunc(target, func(ref string) string {
+		group := strings.Trim(ref[1:], "{}")
+		if group == "$" {
+			return "$"
+		}
+		return fmt.Sprintf("{re.%v.%v}", reName, group)
+	})
+}
+
+// quote returns s as a quoted Caddyfile token.
+// Caddyfile unescapes only \" and a backslash before a newline, and keeps the other backslashes as is (e.g. "\d" in regexes),
+// so backslashes must not be escaped here. Values which cannot be quoted this way are rejected by the domain validations.
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
 func (s *server) postConfig(b []byte) error {
 	req, err := http.NewRequest("POST", s.c.AdminAPI+"/load", bytes.NewReader(b))
 	if err != nil {
@@ -0,0 +1,230 @@
+package caddy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// lexLine splits a line of Caddyfile into tokens, unescaping quoted tokens as Caddy does:
+// only \" is unescaped and the other backslashes are kept as is.
+func lexLine(t *testing.T, line string) []string {
+	var tokens []string
+	var token strings.Builder
+	var inToken, quoted, escaped bool
+	for _, c := range line {
+		switch {
+		case quoted && escaped:
+			if c != '"' {
+				token.WriteRune('\\')
+			}
+			token.WriteRune(c)
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case quoted && c == '"':
+			tokens = append(tokens, token.String())
+			token.Reset()
+			inToken, quoted = false, false
+		case quoted:
+			token.WriteRune(c)
+		case c == ' ' || c == '\t':
+			if inToken {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				inToken = false
+			}
+		case c == '"' && !inToken:
+			inToken, quoted = true, true
+		default:
+			inToken = true
+			token.WriteRune(c)
+		}
+	}
+	require.False(t, quoted, "unterminated quote in %q", line)
+	if inToken {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
+
+// reconcile returns the Caddyfile posted to the admin API.
+func reconcile(t *testing.T, sites []*domain.StaticSite) string {
+	var body string
+	admin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+	}))
+	t.Cleanup(admin.Close)
+
+	s := NewServer(Config{AdminAPI: admin.URL, DocsRoot: "/srv"})
+	require.NoError(t, s.Reconcile(sites))
+	return body
+}
+
+// findTokens returns the tokens of the first line starting with the given tokens.
+func findTokens(t *testing.T, caddyfile string, prefix ...string) []string {
+	for _, line := range strings.Split(caddyfile, "\n") {
+		tokens := lexLine(t, line)
+		if len(tokens) >= len(prefix) && assert.ObjectsAreEqual(prefix, tokens[:len(prefix)]) {
+			return tokens
+		}
+	}
+	t.Fatalf("line starting with %q not found in:\n%v", prefix, caddyfile)
+	return nil
+}
+
+func TestQuote(t *testing.T) {
+	values := []string{
+		``,
+		`plain`,
+		`say "hi"`,
+		`\d+\.html`,
+		`^(?:/a\\)$`,
+		`"} respond "pwned`,
+	}
+	for _, v := range values {
+		assert.Equal(t, []string{v}, lexLine(t, quote(v)), "quote(%q)", v)
+	}
+}
+
+func TestServer_Reconcile_HostileValues(t *testing.T) {
+	rules := []*domain.WebsiteRule{
+		{Type: domain.WebsiteRuleTypeRewrite, PathRegex: `/a\.b"} respond "pwned`, Target: `/"c"\d`},
+		{Type: domain.WebsiteRuleTypeRedirect, PathRegex: `/x\\`, Target: `https://example.com/"} respond "pwned`, StatusCode: 302},
+	}
+	headers := []*domain.WebsiteHeader{
+		{Name: "X-Quoted", Value: `say "hi" \o/ "} respond "pwned`},
+	}
+	for _, r := range rules {
+		require.NoError(t, r.Validate())
+	}
+	for _, h := range headers {
+		require.NoError(t, h.Validate())
+	}
+
+	app := &domain.Application{ID: "app"}
+	website := &domain.Website{
+		ID:           "website",
+		FQDN:         "app.example.com",
+		PathPrefix:   "/",
+		Rules:        rules,
+		HeaderPolicy: domain.WebsiteHeaderPolicy{ResponseHeaders: headers},
+	}
+	caddyfile := reconcile(t, []*domain.StaticSite{{Application: app, Website: website, ArtifactID: "artifact"}})
+
+	for _, line := range strings.Split(caddyfile, "\n") {
+		if tokens := lexLine(t, line); len(tokens) > 0 {
+			assert.NotEqual(t, "respond", tokens[0], "injected directive in %q", line)
+		}
+	}
+	assert.Equal(t,
+		[]string{"path_regexp", "rule_website_0", `^(?:/a\.b"} respond "pwned)$`},
+		findTokens(t, caddyfile, "path_regexp", "rule_website_0"))
+	assert.Equal(t,
+		[]string{"rewrite", "@rule_website_0", `/"c"\d`},
+		findTokens(t, caddyfile, "rewrite", "@rule_website_0"))
+	assert.Equal(t,
+		[]string{"path_regexp", "rule_website_1", `^(?:/x\\)$`},
+		findTokens(t, caddyfile, "path_regexp", "rule_website_1"))
+	assert.Equal(t,
+		[]string{"redir", "@rule_website_1", `https://example.com/"} respond "pwned`, "302"},
+		findTokens(t, caddyfile, "redir", "@rule_website_1"))
+	assert.Equal(t,
+		[]string{"header", "@headers_website", "X-Quoted", `say "hi" \o/ "} respond "pwned`},
+		findTokens(t, caddyfile, "header", "@headers_website", "X-Quoted"))
+}
+
+func TestServer_Reconcile_StaticConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config domain.StaticConfig
+		// want are the fragments which have to appear in the Caddyfile
+		want    []string
+		notWant []string
+	}{
+		{
+			name:   "default",
+			config: domain.StaticConfig{},
+			want: []string{
+				"route @nsapp-app {\n\troot * /srv/artifact\n\tfile_server\n}\n",
+			},
+			notWant: []string{"try_files", "Cache-Control", "precompressed", "handle_errors"},
+		},
+		{
+			name:   "spa",
+			config: domain.StaticConfig{SPA: true},
+			want: []string{
+				"\ttry_files {path} {path}.html {path}/ {path}/index.html /index.html =404\n\tfile_server\n",
+			},
+		},
+		{
+			name:   "precompressed",
+			config: domain.StaticConfig{Precompressed: true},
+			want: []string{
+				"\tfile_server {\n\t\tprecompressed br gzip\n\t}\n}\n",
+			},
+		},
+		{
+			name:   "cache control",
+			config: domain.StaticConfig{SPA: true, HTMLCacheControl: "no-cache", AssetCacheControl: "public, max-age=31536000, immutable"},
+			want: []string{
+				"@html_app path *.html */\n",
+				"@asset_app {\n\tnot path *.html */\n\tpath_regexp \"" + domain.StaticHashedAssetPathPattern + "\"\n}\n",
+				// after the SPA fallback, so that the served path decides Cache-Control
+				"\ttry_files {path} {path}.html {path}/ {path}/index.html /index.html =404\n" +
+					"\theader @html_app ?Cache-Control \"no-cache\"\n" +
+					"\theader @asset_app ?Cache-Control \"public, max-age=31536000, immutable\"\n" +
+					"\tfile_server\n",
+			},
+		},
+		{
+			name:   "custom 404 page",
+			config: domain.StaticConfig{NotFoundPath: "/404.html", HTMLCacheControl: "no-cache", Precompressed: true},
+			want: []string{
+				"handle_errors 404 {\n" +
+					"\troute @nsapp-app {\n" +
+					"\t\troot * /srv/artifact\n" +
+					"\t\trewrite * \"/404.html\"\n" +
+					"\t\theader Cache-Control \"no-cache\"\n" +
+					"\t\tfile_server {\n" +
+					"\t\t\tprecompressed br gzip\n" +
+					"\t\t}\n" +
+					"\t}\n" +
+					"}\n",
+			},
+		},
+		{
+			name:   "custom 404 page without cache control",
+			config: domain.StaticConfig{NotFoundPath: "/errors/404.html"},
+			want: []string{
+				"\t\trewrite * \"/errors/404.html\"\n\t\tfile_server\n\t}\n",
+			},
+			notWant: []string{"Cache-Control"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			site := &domain.StaticSite{
+				Application: &domain.Application{ID: "app"},
+				Website:     &domain.Website{ID: "website", FQDN: "app.example.com", PathPrefix: "/"},
+				ArtifactID:  "artifact",
+				Config:      tt.config,
+			}
+			caddyfile := reconcile(t, []*domain.StaticSite{site})
+			for _, want := range tt.want {
+				assert.Contains(t, caddyfile, want)
+			}
+			for _, notWant := range tt.notWant {
+				assert.NotContains(t, caddyfile, notWant)
+			}
+		})
+	}
+}
@@ -15,12 +15,14 @@ var giteaHook = lo.Must(gitea.New())
 func (r *Receiver) giteaHandler(c *echo.Context) error {
 	rawPayload, err := giteaHook.Parse(c.Request(), gitea.PushEvent, gitea.RepositoryEvent)
 	if err != nil {
+		r.metrics.IncrementWebhook("gitea", "invalid")
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	// https://docs.gitea.io/en-us/usage/webhooks/
 	switch p := rawPayload.(type) {
 	case gitea.PushPayload:
+		r.metrics.IncrementWebhook("gitea", string(gitea.PushEvent))
 		urls := []string{
 			p.Repo.HTMLURL,  // http://localhost:3000/gitea/webhooks
 			p.Repo.SSHURL,   // ssh://gitea@localhost:2222/gitea/webhooks.git
@@ -28,6 +30,7 @@ func (r *Receiver) giteaHandler(c *echo.Context) error {
 		}
 		go r.updateURLs(urls)
 	case gitea.RepositoryPayload:
+		r.metrics.IncrementWebhook("gitea", string(gitea.RepositoryEvent))
 		slog.Info("Repository event received", "action", p.Action)
 		if err := r.giteaIntegration.Sync(context.Background()); err != nil {
 			slog.Warn("failed to sync gitea repositories", "error", err)
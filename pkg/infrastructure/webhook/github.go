@@ -13,14 +13,16 @@ var githubHook = lo.Must(github.New())
 func (r *Receiver) githubHandler(c *echo.Context) error {
 	rawPayload, err := githubHook.Parse(c.Request(), github.PingEvent, github.PushEvent)
 	if err != nil {
+		r.metrics.IncrementWebhook("github", "invalid")
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	// https://docs.github.com/en/rest/repos/repos
 	switch p := rawPayload.(type) {
 	case github.PingPayload:
-		// no-op
+		r.metrics.IncrementWebhook("github", string(github.PingEvent))
 	case github.PushPayload:
+		r.metrics.IncrementWebhook("github", string(github.PushEvent))
 		urls := []string{
 			p.Repository.HTMLURL,  // https://github.com/octocat/Hello-World
 			p.Repository.GitURL,   // git:github.com/octocat/Hello-World.git
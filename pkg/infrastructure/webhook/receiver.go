@@ -12,6 +12,7 @@ import (
 	"github.com/labstack/echo/v5"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
 	"github.com/traPtitech/neoshowcase/pkg/usecase/repofetcher"
 	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
@@ -37,6 +38,7 @@ type Receiver struct {
 	gitRepo          domain.GitRepositoryRepository
 	fetcher          repofetcher.Service
 	giteaIntegration domain.GiteaIntegrationServiceClient
+	metrics          *observability.ControllerMetrics
 
 	server *http.Server
 }
@@ -46,12 +48,14 @@ func NewReceiver(
 	gitRepo domain.GitRepositoryRepository,
 	fetcher repofetcher.Service,
 	giteaIntegration domain.GiteaIntegrationServiceClient,
+	metrics *observability.ControllerMetrics,
 ) *Receiver {
 	r := &Receiver{
 		config:           config,
 		gitRepo:          gitRepo,
 		fetcher:          fetcher,
 		giteaIntegration: giteaIntegration,
+		metrics:          metrics,
 	}
 
 	e := echo.New()
@@ -0,0 +1,652 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"io"
+	"sync"
+)
+
+// Ensure, that BackendMock does implement domain.Backend.
+// If this is not the case, regenerate this file with moq.
+var _ domain.Backend = &BackendMock{}
+
+// BackendMock is a mock implementation of domain.Backend.
+//
+//	func TestSomethingThatUsesBackend(t *testing.T) {
+//
+//		// make and configure a mocked domain.Backend
+//		mockedBackend := &BackendMock{
+//			AttachContainerFunc: func(ctx context.Context, appID string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+//				panic("mock out the AttachContainer method")
+//			},
+//			AvailableDomainsFunc: func() domain.AvailableDomainSlice {
+//				panic("mock out the AvailableDomains method")
+//			},
+//			AvailablePortsFunc: func() domain.AvailablePortSlice {
+//				panic("mock out the AvailablePorts method")
+//			},
+//			DisposeFunc: func(ctx context.Context) error {
+//				panic("mock out the Dispose method")
+//			},
+//			ExecContainerFunc: func(ctx context.Context, appID string, cmd []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+//				panic("mock out the ExecContainer method")
+//			},
+//			GetContainerFunc: func(ctx context.Context, appID string) (*domain.Container, error) {
+//				panic("mock out the GetContainer method")
+//			},
+//			ListContainersFunc: func(ctx context.Context) ([]*domain.Container, error) {
+//				panic("mock out the ListContainers method")
+//			},
+//			ListenContainerEventsFunc: func() (<-chan *domain.ContainerEvent, func()) {
+//				panic("mock out the ListenContainerEvents method")
+//			},
+//			StartFunc: func(ctx context.Context) error {
+//				panic("mock out the Start method")
+//			},
+//			SynchronizeFunc: func(ctx context.Context, s *domain.DesiredState) error {
+//				panic("mock out the Synchronize method")
+//			},
+//			SynchronizeSharedFunc: func(ctx context.Context, s *domain.DesiredStateLeader) error {
+//				panic("mock out the SynchronizeShared method")
+//			},
+//			TLSTargetDomainFunc: func(website *domain.Website) (string, bool) {
+//				panic("mock out the TLSTargetDomain method")
+//			},
+//		}
+//
+//		// use mockedBackend in code that requires domain.Backend
+//		// and then make assertions.
+//
+//	}
+type BackendMock struct {
+	// AttachContainerFunc mocks the AttachContainer method.
+	AttachContainerFunc func(ctx context.Context, appID string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error
+
+	// AvailableDomainsFunc mocks the AvailableDomains method.
+	AvailableDomainsFunc func() domain.AvailableDomainSlice
+
+	// AvailablePortsFunc mocks the AvailablePorts method.
+	AvailablePortsFunc func() domain.AvailablePortSlice
+
+	// DisposeFunc mocks the Dispose method.
+	DisposeFunc func(ctx context.Context) error
+
+	// ExecContainerFunc mocks the ExecContainer method.
+	ExecContainerFunc func(ctx context.Context, appID string, cmd []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error
+
+	// GetContainerFunc mocks the GetContainer method.
+	GetContainerFunc func(ctx context.Context, appID string) (*domain.Container, error)
+
+	// ListContainersFunc mocks the ListContainers method.
+	ListContainersFunc func(ctx context.Context) ([]*domain.Container, error)
+
+	// ListenContainerEventsFunc mocks the ListenContainerEvents method.
+	ListenContainerEventsFunc func() (<-chan *domain.ContainerEvent, func())
+
+	// StartFunc mocks the Start method.
+	StartFunc func(ctx context.Context) error
+
+	// SynchronizeFunc mocks the Synchronize method.
+	SynchronizeFunc func(ctx context.Context, s *domain.DesiredState) error
+
+	// SynchronizeSharedFunc mocks the SynchronizeShared method.
+	SynchronizeSharedFunc func(ctx context.Context, s *domain.DesiredStateLeader) error
+
+	// TLSTargetDomainFunc mocks the TLSTargetDomain method.
+	TLSTargetDomainFunc func(website *domain.Website) (string, bool)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AttachContainer holds details about calls to the AttachContainer method.
+		AttachContainer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AppID is the appID argument value.
+			AppID string
+			// Stdin is the stdin argument value.
+			Stdin io.Reader
+			// Stdout is the stdout argument value.
+			Stdout io.Writer
+			// Stderr is the stderr argument value.
+			Stderr io.Writer
+		}
+		// AvailableDomains holds details about calls to the AvailableDomains method.
+		AvailableDomains []struct {
+		}
+		// AvailablePorts holds details about calls to the AvailablePorts method.
+		AvailablePorts []struct {
+		}
+		// Dispose holds details about calls to the Dispose method.
+		Dispose []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ExecContainer holds details about calls to the ExecContainer method.
+		ExecContainer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AppID is the appID argument value.
+			AppID string
+			// Cmd is the cmd argument value.
+			Cmd []string
+			// Stdin is the stdin argument value.
+			Stdin io.Reader
+			// Stdout is the stdout argument value.
+			Stdout io.Writer
+			// Stderr is the stderr argument value.
+			Stderr io.Writer
+		}
+		// GetContainer holds details about calls to the GetContainer method.
+		GetContainer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AppID is the appID argument value.
+			AppID string
+		}
+		// ListContainers holds details about calls to the ListContainers method.
+		ListContainers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListenContainerEvents holds details about calls to the ListenContainerEvents method.
+		ListenContainerEvents []struct {
+		}
+		// Start holds details about calls to the Start method.
+		Start []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Synchronize holds details about calls to the Synchronize method.
+		Synchronize []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S *domain.DesiredState
+		}
+		// SynchronizeShared holds details about calls to the SynchronizeShared method.
+		SynchronizeShared []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S *domain.DesiredStateLeader
+		}
+		// TLSTargetDomain holds details about calls to the TLSTargetDomain method.
+		TLSTargetDomain []struct {
+			// Website is the website argument value.
+			Website *domain.Website
+		}
+	}
+	lockAttachContainer       sync.RWMutex
+	lockAvailableDomains      sync.RWMutex
+	lockAvailablePorts        sync.RWMutex
+	lockDispose               sync.RWMutex
+	lockExecContainer         sync.RWMutex
+	lockGetContainer          sync.RWMutex
+	lockListContainers        sync.RWMutex
+	lockListenContainerEvents sync.RWMutex
+	lockStart                 sync.RWMutex
+	lockSynchronize           sync.RWMutex
+	lockSynchronizeShared     sync.RWMutex
+	lockTLSTargetDomain       sync.RWMutex
+}
+
+// AttachContainer calls AttachContainerFunc.
+func (mock *BackendMock) AttachContainer(ctx context.Context, appID string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	callInfo := struct {
+		Ctx    context.Context
+		AppID  string
+		Stdin  io.Reader
+		Stdout io.Writer
+		Stderr io.Writer
+	}{
+		Ctx:    ctx,
+		AppID:  appID,
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	}
+	mock.lockAttachContainer.Lock()
+	mock.calls.AttachContainer = append(mock.calls.AttachContainer, callInfo)
+	mock.lockAttachContainer.Unlock()
+	if mock.AttachContainerFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.AttachContainerFunc(ctx, appID, stdin, stdout, stderr)
+}
+
+// AttachContainerCalls gets all the calls that were made to AttachContainer.
+// Check the length with:
+//
+//	len(mockedBackend.AttachContainerCalls())
+func (mock *BackendMock) AttachContainerCalls() []struct {
+	Ctx    context.Context
+	AppID  string
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+} {
+	var calls []struct {
+		Ctx    context.Context
+		AppID  string
+		Stdin  io.Reader
+		Stdout io.Writer
+		Stderr io.Writer
+	}
+	mock.lockAttachContainer.RLock()
+	calls = mock.calls.AttachContainer
+	mock.lockAttachContainer.RUnlock()
+	return calls
+}
+
+// AvailableDomains calls AvailableDomainsFunc.
+func (mock *BackendMock) AvailableDomains() domain.AvailableDomainSlice {
+	callInfo := struct {
+	}{}
+	mock.lockAvailableDomains.Lock()
+	mock.calls.AvailableDomains = append(mock.calls.AvailableDomains, callInfo)
+	mock.lockAvailableDomains.Unlock()
+	if mock.AvailableDomainsFunc == nil {
+		var (
+			availableDomainSliceOut domain.AvailableDomainSlice
+		)
+		return availableDomainSliceOut
+	}
+	return mock.AvailableDomainsFunc()
+}
+
+// AvailableDomainsCalls gets all the calls that were made to AvailableDomains.
+// Check the length with:
+//
+//	len(mockedBackend.AvailableDomainsCalls())
+func (mock *BackendMock) AvailableDomainsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAvailableDomains.RLock()
+	calls = mock.calls.AvailableDomains
+	mock.lockAvailableDomains.RUnlock()
+	return calls
+}
+
+// AvailablePorts calls AvailablePortsFunc.
+func (mock *BackendMock) AvailablePorts() domain.AvailablePortSlice {
+	callInfo := struct {
+	}{}
+	mock.lockAvailablePorts.Lock()
+	mock.calls.AvailablePorts = append(mock.calls.AvailablePorts, callInfo)
+	mock.lockAvailablePorts.Unlock()
+	if mock.AvailablePortsFunc == nil {
+		var (
+			availablePortSliceOut domain.AvailablePortSlice
+		)
+		return availablePortSliceOut
+	}
+	return mock.AvailablePortsFunc()
+}
+
+// AvailablePortsCalls gets all the calls that were made to AvailablePorts.
+// Check the length with:
+//
+//	len(mockedBackend.AvailablePortsCalls())
+func (mock *BackendMock) AvailablePortsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAvailablePorts.RLock()
+	calls = mock.calls.AvailablePorts
+	mock.lockAvailablePorts.RUnlock()
+	return calls
+}
+
+// Dispose calls DisposeFunc.
+func (mock *BackendMock) Dispose(ctx context.Context) error {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDispose.Lock()
+	mock.calls.Dispose = append(mock.calls.Dispose, callInfo)
+	mock.lockDispose.Unlock()
+	if mock.DisposeFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DisposeFunc(ctx)
+}
+
+// DisposeCalls gets all the calls that were made to Dispose.
+// Check the length with:
+//
+//	len(mockedBackend.DisposeCalls())
+func (mock *BackendMock) DisposeCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDispose.RLock()
+	calls = mock.calls.Dispose
+	mock.lockDispose.RUnlock()
+	return calls
+}
+
+// ExecContainer calls ExecContainerFunc.
+func (mock *BackendMock) ExecContainer(ctx context.Context, appID string, cmd []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	callInfo := struct {
+		Ctx    context.Context
+		AppID  string
+		Cmd    []string
+		Stdin  io.Reader
+		Stdout io.Writer
+		Stderr io.Writer
+	}{
+		Ctx:    ctx,
+		AppID:  appID,
+		Cmd:    cmd,
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	}
+	mock.lockExecContainer.Lock()
+	mock.calls.ExecContainer = append(mock.calls.ExecContainer, callInfo)
+	mock.lockExecContainer.Unlock()
+	if mock.ExecContainerFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.ExecContainerFunc(ctx, appID, cmd, stdin, stdout, stderr)
+}
+
+// ExecContainerCalls gets all the calls that were made to ExecContainer.
+// Check the length with:
+//
+//	len(mockedBackend.ExecContainerCalls())
+func (mock *BackendMock) ExecContainerCalls() []struct {
+	Ctx    context.Context
+	AppID  string
+	Cmd    []string
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+} {
+	var calls []struct {
+		Ctx    context.Context
+		AppID  string
+		Cmd    []string
+		Stdin  io.Reader
+		Stdout io.Writer
+		Stderr io.Writer
+	}
+	mock.lockExecContainer.RLock()
+	calls = mock.calls.ExecContainer
+	mock.lockExecContainer.RUnlock()
+	return calls
+}
+
+// GetContainer calls GetContainerFunc.
+func (mock *BackendMock) GetContainer(ctx context.Context, appID string) (*domain.Container, error) {
+	callInfo := struct {
+		Ctx   context.Context
+		AppID string
+	}{
+		Ctx:   ctx,
+		AppID: appID,
+	}
+	mock.lockGetContainer.Lock()
+	mock.calls.GetContainer = append(mock.calls.GetContainer, callInfo)
+	mock.lockGetContainer.Unlock()
+	if mock.GetContainerFunc == nil {
+		var (
+			containerOut *domain.Container
+			errOut       error
+		)
+		return containerOut, errOut
+	}
+	return mock.GetContainerFunc(ctx, appID)
+}
+
+// GetContainerCalls gets all the calls that were made to GetContainer.
+// Check the length with:
+//
+//	len(mockedBackend.GetContainerCalls())
+func (mock *BackendMock) GetContainerCalls() []struct {
+	Ctx   context.Context
+	AppID string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		AppID string
+	}
+	mock.lockGetContainer.RLock()
+	calls = mock.calls.GetContainer
+	mock.lockGetContainer.RUnlock()
+	return calls
+}
+
+// ListContainers calls ListContainersFunc.
+func (mock *BackendMock) ListContainers(ctx context.Context) ([]*domain.Container, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListContainers.Lock()
+	mock.calls.ListContainers = append(mock.calls.ListContainers, callInfo)
+	mock.lockListContainers.Unlock()
+	if mock.ListContainersFunc == nil {
+		var (
+			containersOut []*domain.Container
+			errOut        error
+		)
+		return containersOut, errOut
+	}
+	return mock.ListContainersFunc(ctx)
+}
+
+// ListContainersCalls gets all the calls that were made to ListContainers.
+// Check the length with:
+//
+//	len(mockedBackend.ListContainersCalls())
+func (mock *BackendMock) ListContainersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListContainers.RLock()
+	calls = mock.calls.ListContainers
+	mock.lockListContainers.RUnlock()
+	return calls
+}
+
+// ListenContainerEvents calls ListenContainerEventsFunc.
+func (mock *BackendMock) ListenContainerEvents() (<-chan *domain.ContainerEvent, func()) {
+	callInfo := struct {
+	}{}
+	mock.lockListenContainerEvents.Lock()
+	mock.calls.ListenContainerEvents = append(mock.calls.ListenContainerEvents, callInfo)
+	mock.lockListenContainerEvents.Unlock()
+	if mock.ListenContainerEventsFunc == nil {
+		var (
+			subOut   <-chan *domain.ContainerEvent
+			unsubOut func()
+		)
+		return subOut, unsubOut
+	}
+	return mock.ListenContainerEventsFunc()
+}
+
+// ListenContainerEventsCalls gets all the calls that were made to ListenContainerEvents.
+// Check the length with:
+//
+//	len(mockedBackend.ListenContainerEventsCalls())
+func (mock *BackendMock) ListenContainerEventsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListenContainerEvents.RLock()
+	calls = mock.calls.ListenContainerEvents
+	mock.lockListenContainerEvents.RUnlock()
+	return calls
+}
+
+// Start calls StartFunc.
+func (mock *BackendMock) Start(ctx context.Context) error {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStart.Lock()
+	mock.calls.Start = append(mock.calls.Start, callInfo)
+	mock.lockStart.Unlock()
+	if mock.StartFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.StartFunc(ctx)
+}
+
+// StartCalls gets all the calls that were made to Start.
+// Check the length with:
+//
+//	len(mockedBackend.StartCalls())
+func (mock *BackendMock) StartCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStart.RLock()
+	calls = mock.calls.Start
+	mock.lockStart.RUnlock()
+	return calls
+}
+
+// Synchronize calls SynchronizeFunc.
+func (mock *BackendMock) Synchronize(ctx context.Context, s *domain.DesiredState) error {
+	callInfo := struct {
+		Ctx context.Context
+		S   *domain.DesiredState
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	mock.lockSynchronize.Lock()
+	mock.calls.Synchronize = append(mock.calls.Synchronize, callInfo)
+	mock.lockSynchronize.Unlock()
+	if mock.SynchronizeFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.SynchronizeFunc(ctx, s)
+}
+
+// SynchronizeCalls gets all the calls that were made to Synchronize.
+// Check the length with:
+//
+//	len(mockedBackend.SynchronizeCalls())
+func (mock *BackendMock) SynchronizeCalls() []struct {
+	Ctx context.Context
+	S   *domain.DesiredState
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   *domain.DesiredState
+	}
+	mock.lockSynchronize.RLock()
+	calls = mock.calls.Synchronize
+	mock.lockSynchronize.RUnlock()
+	return calls
+}
+
+// SynchronizeShared calls SynchronizeSharedFunc.
+func (mock *BackendMock) SynchronizeShared(ctx context.Context, s *domain.DesiredStateLeader) error {
+	callInfo := struct {
+		Ctx context.Context
+		S   *domain.DesiredStateLeader
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	mock.lockSynchronizeShared.Lock()
+	mock.calls.SynchronizeShared = append(mock.calls.SynchronizeShared, callInfo)
+	mock.lockSynchronizeShared.Unlock()
+	if mock.SynchronizeSharedFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.SynchronizeSharedFunc(ctx, s)
+}
+
+// SynchronizeSharedCalls gets all the calls that were made to SynchronizeShared.
+// Check the length with:
+//
+//	len(mockedBackend.SynchronizeSharedCalls())
+func (mock *BackendMock) SynchronizeSharedCalls() []struct {
+	Ctx context.Context
+	S   *domain.DesiredStateLeader
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   *domain.DesiredStateLeader
+	}
+	mock.lockSynchronizeShared.RLock()
+	calls = mock.calls.SynchronizeShared
+	mock.lockSynchronizeShared.RUnlock()
+	return calls
+}
+
+// TLSTargetDomain calls TLSTargetDomainFunc.
+func (mock *BackendMock) TLSTargetDomain(website *domain.Website) (string, bool) {
+	callInfo := struct {
+		Website *domain.Website
+	}{
+		Website: website,
+	}
+	mock.lockTLSTargetDomain.Lock()
+	mock.calls.TLSTargetDomain = append(mock.calls.TLSTargetDomain, callInfo)
+	mock.lockTLSTargetDomain.Unlock()
+	if mock.TLSTargetDomainFunc == nil {
+		var (
+			hostOut string
+			okOut   bool
+		)
+		return hostOut, okOut
+	}
+	return mock.TLSTargetDomainFunc(website)
+}
+
+// TLSTargetDomainCalls gets all the calls that were made to TLSTargetDomain.
+// Check the length with:
+//
+//	len(mockedBackend.TLSTargetDomainCalls())
+func (mock *BackendMock) TLSTargetDomainCalls() []struct {
+	Website *domain.Website
+} {
+	var calls []struct {
+		Website *domain.Website
+	}
+	mock.lockTLSTargetDomain.RLock()
+	calls = mock.calls.TLSTargetDomain
+	mock.lockTLSTargetDomain.RUnlock()
+	return calls
+}
@@ -0,0 +1,85 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"sync"
+)
+
+// Ensure, that CustomDomainVerifierMock does implement domain.CustomDomainVerifier.
+// If this is not the case, regenerate this file with moq.
+var _ domain.CustomDomainVerifier = &CustomDomainVerifierMock{}
+
+// CustomDomainVerifierMock is a mock implementation of domain.CustomDomainVerifier.
+//
+//	func TestSomethingThatUsesCustomDomainVerifier(t *testing.T) {
+//
+//		// make and configure a mocked domain.CustomDomainVerifier
+//		mockedCustomDomainVerifier := &CustomDomainVerifierMock{
+//			VerifyFunc: func(ctx context.Context, d *domain.CustomDomain) error {
+//				panic("mock out the Verify method")
+//			},
+//		}
+//
+//		// use mockedCustomDomainVerifier in code that requires domain.CustomDomainVerifier
+//		// and then make assertions.
+//
+//	}
+type CustomDomainVerifierMock struct {
+	// VerifyFunc mocks the Verify method.
+	VerifyFunc func(ctx context.Context, d *domain.CustomDomain) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Verify holds details about calls to the Verify method.
+		Verify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// D is the d argument value.
+			D *domain.CustomDomain
+		}
+	}
+	lockVerify sync.RWMutex
+}
+
+// Verify calls VerifyFunc.
+func (mock *CustomDomainVerifierMock) Verify(ctx context.Context, d *domain.CustomDomain) error {
+	callInfo := struct {
+		Ctx context.Context
+		D   *domain.CustomDomain
+	}{
+		Ctx: ctx,
+		D:   d,
+	}
+	mock.lockVerify.Lock()
+	mock.calls.Verify = append(mock.calls.Verify, callInfo)
+	mock.lockVerify.Unlock()
+	if mock.VerifyFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.VerifyFunc(ctx, d)
+}
+
+// VerifyCalls gets all the calls that were made to Verify.
+// Check the length with:
+//
+//	len(mockedCustomDomainVerifier.VerifyCalls())
+func (mock *CustomDomainVerifierMock) VerifyCalls() []struct {
+	Ctx context.Context
+	D   *domain.CustomDomain
+} {
+	var calls []struct {
+		Ctx context.Context
+		D   *domain.CustomDomain
+	}
+	mock.lockVerify.RLock()
+	calls = mock.calls.Verify
+	mock.lockVerify.RUnlock()
+	return calls
+}
@@ -4,3 +4,6 @@ package mocks
 //go:generate go tool moq -stub -pkg $GOPACKAGE -out controller_mock.go ../../domain ControllerServiceClient
 //go:generate go tool moq -stub -pkg $GOPACKAGE -out dbmanager_mock.go ../../domain MariaDBManager MongoDBManager
 //go:generate go tool moq -stub -pkg $GOPACKAGE -out git_mock.go ../../domain GitService GitRepository
+//go:generate go tool moq -stub -pkg $GOPACKAGE -out custom_domain_mock.go ../../domain CustomDomainVerifier
+//go:generate go tool moq -stub -pkg $GOPACKAGE -out repository_mock.go ../../domain ApplicationRepository UserRepository
+//go:generate go tool moq -stub -pkg $GOPACKAGE -out backend_mock.go ../../domain Backend
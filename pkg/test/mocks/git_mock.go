@@ -220,6 +220,9 @@ var _ domain.GitRepository = &GitRepositoryMock{}
 //			GetCommitFunc: func(hash string) (*domain.RepositoryCommit, error) {
 //				panic("mock out the GetCommit method")
 //			},
+//			ReadFileFunc: func(hash string, path string) ([]byte, error) {
+//				panic("mock out the ReadFile method")
+//			},
 //		}
 //
 //		// use mockedGitRepository in code that requires domain.GitRepository
@@ -233,6 +236,9 @@ type GitRepositoryMock struct {
 	// GetCommitFunc mocks the GetCommit method.
 	GetCommitFunc func(hash string) (*domain.RepositoryCommit, error)
 
+	// ReadFileFunc mocks the ReadFile method.
+	ReadFileFunc func(hash string, path string) ([]byte, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Fetch holds details about calls to the Fetch method.
@@ -247,9 +253,17 @@ type GitRepositoryMock struct {
 			// Hash is the hash argument value.
 			Hash string
 		}
+		// ReadFile holds details about calls to the ReadFile method.
+		ReadFile []struct {
+			// Hash is the hash argument value.
+			Hash string
+			// Path is the path argument value.
+			Path string
+		}
 	}
 	lockFetch     sync.RWMutex
 	lockGetCommit sync.RWMutex
+	lockReadFile  sync.RWMutex
 }
 
 // Fetch calls FetchFunc.
@@ -326,3 +340,43 @@ func (mock *GitRepositoryMock) GetCommitCalls() []struct {
 	mock.lockGetCommit.RUnlock()
 	return calls
 }
+
+// ReadFile calls ReadFileFunc.
+func (mock *GitRepositoryMock) ReadFile(hash string, path string) ([]byte, error) {
+	callInfo := struct {
+		Hash string
+		Path string
+	}{
+		Hash: hash,
+		Path: path,
+	}
+	mock.lockReadFile.Lock()
+	mock.calls.ReadFile = append(mock.calls.ReadFile, callInfo)
+	mock.lockReadFile.Unlock()
+	if mock.ReadFileFunc == nil {
+		var (
+			bytesOut []byte
+			errOut   error
+		)
+		return bytesOut, errOut
+	}
+	return mock.ReadFileFunc(hash, path)
+}
+
+// ReadFileCalls gets all the calls that were made to ReadFile.
+// Check the length with:
+//
+//	len(mockedGitRepository.ReadFileCalls())
+func (mock *GitRepositoryMock) ReadFileCalls() []struct {
+	Hash string
+	Path string
+} {
+	var calls []struct {
+		Hash string
+		Path string
+	}
+	mock.lockReadFile.RLock()
+	calls = mock.calls.ReadFile
+	mock.lockReadFile.RUnlock()
+	return calls
+}
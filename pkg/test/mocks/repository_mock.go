@@ -0,0 +1,921 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"sync"
+)
+
+// Ensure, that ApplicationRepositoryMock does implement domain.ApplicationRepository.
+// If this is not the case, regenerate this file with moq.
+var _ domain.ApplicationRepository = &ApplicationRepositoryMock{}
+
+// ApplicationRepositoryMock is a mock implementation of domain.ApplicationRepository.
+//
+//	func TestSomethingThatUsesApplicationRepository(t *testing.T) {
+//
+//		// make and configure a mocked domain.ApplicationRepository
+//		mockedApplicationRepository := &ApplicationRepositoryMock{
+//			ApplyChangesFunc: func(ctx context.Context, changes []*domain.ApplicationChange) error {
+//				panic("mock out the ApplyChanges method")
+//			},
+//			BulkUpdateStateFunc: func(ctx context.Context, states []*domain.Container) error {
+//				panic("mock out the BulkUpdateState method")
+//			},
+//			CreateApplicationFunc: func(ctx context.Context, app *domain.Application) error {
+//				panic("mock out the CreateApplication method")
+//			},
+//			DeleteApplicationFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteApplication method")
+//			},
+//			GetApplicationFunc: func(ctx context.Context, id string) (*domain.Application, error) {
+//				panic("mock out the GetApplication method")
+//			},
+//			GetApplicationsFunc: func(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error) {
+//				panic("mock out the GetApplications method")
+//			},
+//			UpdateApplicationFunc: func(ctx context.Context, id string, args *domain.UpdateApplicationArgs) error {
+//				panic("mock out the UpdateApplication method")
+//			},
+//		}
+//
+//		// use mockedApplicationRepository in code that requires domain.ApplicationRepository
+//		// and then make assertions.
+//
+//	}
+type ApplicationRepositoryMock struct {
+	// ApplyChangesFunc mocks the ApplyChanges method.
+	ApplyChangesFunc func(ctx context.Context, changes []*domain.ApplicationChange) error
+
+	// BulkUpdateStateFunc mocks the BulkUpdateState method.
+	BulkUpdateStateFunc func(ctx context.Context, states []*domain.Container) error
+
+	// CreateApplicationFunc mocks the CreateApplication method.
+	CreateApplicationFunc func(ctx context.Context, app *domain.Application) error
+
+	// DeleteApplicationFunc mocks the DeleteApplication method.
+	DeleteApplicationFunc func(ctx context.Context, id string) error
+
+	// GetApplicationFunc mocks the GetApplication method.
+	GetApplicationFunc func(ctx context.Context, id string) (*domain.Application, error)
+
+	// GetApplicationsFunc mocks the GetApplications method.
+	GetApplicationsFunc func(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error)
+
+	// UpdateApplicationFunc mocks the UpdateApplication method.
+	UpdateApplicationFunc func(ctx context.Context, id string, args *domain.UpdateApplicationArgs) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ApplyChanges holds details about calls to the ApplyChanges method.
+		ApplyChanges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Changes is the changes argument value.
+			Changes []*domain.ApplicationChange
+		}
+		// BulkUpdateState holds details about calls to the BulkUpdateState method.
+		BulkUpdateState []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// States is the states argument value.
+			States []*domain.Container
+		}
+		// CreateApplication holds details about calls to the CreateApplication method.
+		CreateApplication []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// App is the app argument value.
+			App *domain.Application
+		}
+		// DeleteApplication holds details about calls to the DeleteApplication method.
+		DeleteApplication []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetApplication holds details about calls to the GetApplication method.
+		GetApplication []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetApplications holds details about calls to the GetApplications method.
+		GetApplications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cond is the cond argument value.
+			Cond domain.GetApplicationCondition
+		}
+		// UpdateApplication holds details about calls to the UpdateApplication method.
+		UpdateApplication []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Args is the args argument value.
+			Args *domain.UpdateApplicationArgs
+		}
+	}
+	lockApplyChanges      sync.RWMutex
+	lockBulkUpdateState   sync.RWMutex
+	lockCreateApplication sync.RWMutex
+	lockDeleteApplication sync.RWMutex
+	lockGetApplication    sync.RWMutex
+	lockGetApplications   sync.RWMutex
+	lockUpdateApplication sync.RWMutex
+}
+
+// ApplyChanges calls ApplyChangesFunc.
+func (mock *ApplicationRepositoryMock) ApplyChanges(ctx context.Context, changes []*domain.ApplicationChange) error {
+	callInfo := struct {
+		Ctx     context.Context
+		Changes []*domain.ApplicationChange
+	}{
+		Ctx:     ctx,
+		Changes: changes,
+	}
+	mock.lockApplyChanges.Lock()
+	mock.calls.ApplyChanges = append(mock.calls.ApplyChanges, callInfo)
+	mock.lockApplyChanges.Unlock()
+	if mock.ApplyChangesFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.ApplyChangesFunc(ctx, changes)
+}
+
+// ApplyChangesCalls gets all the calls that were made to ApplyChanges.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.ApplyChangesCalls())
+func (mock *ApplicationRepositoryMock) ApplyChangesCalls() []struct {
+	Ctx     context.Context
+	Changes []*domain.ApplicationChange
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Changes []*domain.ApplicationChange
+	}
+	mock.lockApplyChanges.RLock()
+	calls = mock.calls.ApplyChanges
+	mock.lockApplyChanges.RUnlock()
+	return calls
+}
+
+// BulkUpdateState calls BulkUpdateStateFunc.
+func (mock *ApplicationRepositoryMock) BulkUpdateState(ctx context.Context, states []*domain.Container) error {
+	callInfo := struct {
+		Ctx    context.Context
+		States []*domain.Container
+	}{
+		Ctx:    ctx,
+		States: states,
+	}
+	mock.lockBulkUpdateState.Lock()
+	mock.calls.BulkUpdateState = append(mock.calls.BulkUpdateState, callInfo)
+	mock.lockBulkUpdateState.Unlock()
+	if mock.BulkUpdateStateFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.BulkUpdateStateFunc(ctx, states)
+}
+
+// BulkUpdateStateCalls gets all the calls that were made to BulkUpdateState.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.BulkUpdateStateCalls())
+func (mock *ApplicationRepositoryMock) BulkUpdateStateCalls() []struct {
+	Ctx    context.Context
+	States []*domain.Container
+} {
+	var calls []struct {
+		Ctx    context.Context
+		States []*domain.Container
+	}
+	mock.lockBulkUpdateState.RLock()
+	calls = mock.calls.BulkUpdateState
+	mock.lockBulkUpdateState.RUnlock()
+	return calls
+}
+
+// CreateApplication calls CreateApplicationFunc.
+func (mock *ApplicationRepositoryMock) CreateApplication(ctx context.Context, app *domain.Application) error {
+	callInfo := struct {
+		Ctx context.Context
+		App *domain.Application
+	}{
+		Ctx: ctx,
+		App: app,
+	}
+	mock.lockCreateApplication.Lock()
+	mock.calls.CreateApplication = append(mock.calls.CreateApplication, callInfo)
+	mock.lockCreateApplication.Unlock()
+	if mock.CreateApplicationFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.CreateApplicationFunc(ctx, app)
+}
+
+// CreateApplicationCalls gets all the calls that were made to CreateApplication.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.CreateApplicationCalls())
+func (mock *ApplicationRepositoryMock) CreateApplicationCalls() []struct {
+	Ctx context.Context
+	App *domain.Application
+} {
+	var calls []struct {
+		Ctx context.Context
+		App *domain.Application
+	}
+	mock.lockCreateApplication.RLock()
+	calls = mock.calls.CreateApplication
+	mock.lockCreateApplication.RUnlock()
+	return calls
+}
+
+// DeleteApplication calls DeleteApplicationFunc.
+func (mock *ApplicationRepositoryMock) DeleteApplication(ctx context.Context, id string) error {
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteApplication.Lock()
+	mock.calls.DeleteApplication = append(mock.calls.DeleteApplication, callInfo)
+	mock.lockDeleteApplication.Unlock()
+	if mock.DeleteApplicationFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DeleteApplicationFunc(ctx, id)
+}
+
+// DeleteApplicationCalls gets all the calls that were made to DeleteApplication.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.DeleteApplicationCalls())
+func (mock *ApplicationRepositoryMock) DeleteApplicationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteApplication.RLock()
+	calls = mock.calls.DeleteApplication
+	mock.lockDeleteApplication.RUnlock()
+	return calls
+}
+
+// GetApplication calls GetApplicationFunc.
+func (mock *ApplicationRepositoryMock) GetApplication(ctx context.Context, id string) (*domain.Application, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetApplication.Lock()
+	mock.calls.GetApplication = append(mock.calls.GetApplication, callInfo)
+	mock.lockGetApplication.Unlock()
+	if mock.GetApplicationFunc == nil {
+		var (
+			applicationOut *domain.Application
+			errOut         error
+		)
+		return applicationOut, errOut
+	}
+	return mock.GetApplicationFunc(ctx, id)
+}
+
+// GetApplicationCalls gets all the calls that were made to GetApplication.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.GetApplicationCalls())
+func (mock *ApplicationRepositoryMock) GetApplicationCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetApplication.RLock()
+	calls = mock.calls.GetApplication
+	mock.lockGetApplication.RUnlock()
+	return calls
+}
+
+// GetApplications calls GetApplicationsFunc.
+func (mock *ApplicationRepositoryMock) GetApplications(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Cond domain.GetApplicationCondition
+	}{
+		Ctx:  ctx,
+		Cond: cond,
+	}
+	mock.lockGetApplications.Lock()
+	mock.calls.GetApplications = append(mock.calls.GetApplications, callInfo)
+	mock.lockGetApplications.Unlock()
+	if mock.GetApplicationsFunc == nil {
+		var (
+			applicationsOut []*domain.Application
+			errOut          error
+		)
+		return applicationsOut, errOut
+	}
+	return mock.GetApplicationsFunc(ctx, cond)
+}
+
+// GetApplicationsCalls gets all the calls that were made to GetApplications.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.GetApplicationsCalls())
+func (mock *ApplicationRepositoryMock) GetApplicationsCalls() []struct {
+	Ctx  context.Context
+	Cond domain.GetApplicationCondition
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Cond domain.GetApplicationCondition
+	}
+	mock.lockGetApplications.RLock()
+	calls = mock.calls.GetApplications
+	mock.lockGetApplications.RUnlock()
+	return calls
+}
+
+// UpdateApplication calls UpdateApplicationFunc.
+func (mock *ApplicationRepositoryMock) UpdateApplication(ctx context.Context, id string, args *domain.UpdateApplicationArgs) error {
+	callInfo := struct {
+		Ctx  context.Context
+		ID   string
+		Args *domain.UpdateApplicationArgs
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Args: args,
+	}
+	mock.lockUpdateApplication.Lock()
+	mock.calls.UpdateApplication = append(mock.calls.UpdateApplication, callInfo)
+	mock.lockUpdateApplication.Unlock()
+	if mock.UpdateApplicationFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.UpdateApplicationFunc(ctx, id, args)
+}
+
+// UpdateApplicationCalls gets all the calls that were made to UpdateApplication.
+// Check the length with:
+//
+//	len(mockedApplicationRepository.UpdateApplicationCalls())
+func (mock *ApplicationRepositoryMock) UpdateApplicationCalls() []struct {
+	Ctx  context.Context
+	ID   string
+	Args *domain.UpdateApplicationArgs
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   string
+		Args *domain.UpdateApplicationArgs
+	}
+	mock.lockUpdateApplication.RLock()
+	calls = mock.calls.UpdateApplication
+	mock.lockUpdateApplication.RUnlock()
+	return calls
+}
+
+// Ensure, that UserRepositoryMock does implement domain.UserRepository.
+// If this is not the case, regenerate this file with moq.
+var _ domain.UserRepository = &UserRepositoryMock{}
+
+// UserRepositoryMock is a mock implementation of domain.UserRepository.
+//
+//	func TestSomethingThatUsesUserRepository(t *testing.T) {
+//
+//		// make and configure a mocked domain.UserRepository
+//		mockedUserRepository := &UserRepositoryMock{
+//			CreateUserKeyFunc: func(ctx context.Context, key *domain.UserKey) error {
+//				panic("mock out the CreateUserKey method")
+//			},
+//			DeleteUserKeyFunc: func(ctx context.Context, keyID string, userID string) error {
+//				panic("mock out the DeleteUserKey method")
+//			},
+//			DeleteUserQuotaFunc: func(ctx context.Context, userID string) error {
+//				panic("mock out the DeleteUserQuota method")
+//			},
+//			EnsureUserFunc: func(ctx context.Context, name string) (*domain.User, error) {
+//				panic("mock out the EnsureUser method")
+//			},
+//			EnsureUsersFunc: func(ctx context.Context, names []string) ([]*domain.User, error) {
+//				panic("mock out the EnsureUsers method")
+//			},
+//			GetUserKeysFunc: func(ctx context.Context, cond domain.GetUserKeyCondition) ([]*domain.UserKey, error) {
+//				panic("mock out the GetUserKeys method")
+//			},
+//			GetUserQuotasFunc: func(ctx context.Context, cond domain.GetUserQuotaCondition) ([]*domain.UserQuota, error) {
+//				panic("mock out the GetUserQuotas method")
+//			},
+//			GetUsersFunc: func(ctx context.Context, cond domain.GetUserCondition) ([]*domain.User, error) {
+//				panic("mock out the GetUsers method")
+//			},
+//			SetUserQuotaFunc: func(ctx context.Context, quota *domain.UserQuota) error {
+//				panic("mock out the SetUserQuota method")
+//			},
+//		}
+//
+//		// use mockedUserRepository in code that requires domain.UserRepository
+//		// and then make assertions.
+//
+//	}
+type UserRepositoryMock struct {
+	// CreateUserKeyFunc mocks the CreateUserKey method.
+	CreateUserKeyFunc func(ctx context.Context, key *domain.UserKey) error
+
+	// DeleteUserKeyFunc mocks the DeleteUserKey method.
+	DeleteUserKeyFunc func(ctx context.Context, keyID string, userID string) error
+
+	// DeleteUserQuotaFunc mocks the DeleteUserQuota method.
+	DeleteUserQuotaFunc func(ctx context.Context, userID string) error
+
+	// EnsureUserFunc mocks the EnsureUser method.
+	EnsureUserFunc func(ctx context.Context, name string) (*domain.User, error)
+
+	// EnsureUsersFunc mocks the EnsureUsers method.
+	EnsureUsersFunc func(ctx context.Context, names []string) ([]*domain.User, error)
+
+	// GetUserKeysFunc mocks the GetUserKeys method.
+	GetUserKeysFunc func(ctx context.Context, cond domain.GetUserKeyCondition) ([]*domain.UserKey, error)
+
+	// GetUserQuotasFunc mocks the GetUserQuotas method.
+	GetUserQuotasFunc func(ctx context.Context, cond domain.GetUserQuotaCondition) ([]*domain.UserQuota, error)
+
+	// GetUsersFunc mocks the GetUsers method.
+	GetUsersFunc func(ctx context.Context, cond domain.GetUserCondition) ([]*domain.User, error)
+
+	// SetUserQuotaFunc mocks the SetUserQuota method.
+	SetUserQuotaFunc func(ctx context.Context, quota *domain.UserQuota) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateUserKey holds details about calls to the CreateUserKey method.
+		CreateUserKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key *domain.UserKey
+		}
+		// DeleteUserKey holds details about calls to the DeleteUserKey method.
+		DeleteUserKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeyID is the keyID argument value.
+			KeyID string
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// DeleteUserQuota holds details about calls to the DeleteUserQuota method.
+		DeleteUserQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// EnsureUser holds details about calls to the EnsureUser method.
+		EnsureUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// EnsureUsers holds details about calls to the EnsureUsers method.
+		EnsureUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Names is the names argument value.
+			Names []string
+		}
+		// GetUserKeys holds details about calls to the GetUserKeys method.
+		GetUserKeys []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cond is the cond argument value.
+			Cond domain.GetUserKeyCondition
+		}
+		// GetUserQuotas holds details about calls to the GetUserQuotas method.
+		GetUserQuotas []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cond is the cond argument value.
+			Cond domain.GetUserQuotaCondition
+		}
+		// GetUsers holds details about calls to the GetUsers method.
+		GetUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cond is the cond argument value.
+			Cond domain.GetUserCondition
+		}
+		// SetUserQuota holds details about calls to the SetUserQuota method.
+		SetUserQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Quota is the quota argument value.
+			Quota *domain.UserQuota
+		}
+	}
+	lockCreateUserKey   sync.RWMutex
+	lockDeleteUserKey   sync.RWMutex
+	lockDeleteUserQuota sync.RWMutex
+	lockEnsureUser      sync.RWMutex
+	lockEnsureUsers     sync.RWMutex
+	lockGetUserKeys     sync.RWMutex
+	lockGetUserQuotas   sync.RWMutex
+	lockGetUsers        sync.RWMutex
+	lockSetUserQuota    sync.RWMutex
+}
+
+// CreateUserKey calls CreateUserKeyFunc.
+func (mock *UserRepositoryMock) CreateUserKey(ctx context.Context, key *domain.UserKey) error {
+	callInfo := struct {
+		Ctx context.Context
+		Key *domain.UserKey
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockCreateUserKey.Lock()
+	mock.calls.CreateUserKey = append(mock.calls.CreateUserKey, callInfo)
+	mock.lockCreateUserKey.Unlock()
+	if mock.CreateUserKeyFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.CreateUserKeyFunc(ctx, key)
+}
+
+// CreateUserKeyCalls gets all the calls that were made to CreateUserKey.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateUserKeyCalls())
+func (mock *UserRepositoryMock) CreateUserKeyCalls() []struct {
+	Ctx context.Context
+	Key *domain.UserKey
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key *domain.UserKey
+	}
+	mock.lockCreateUserKey.RLock()
+	calls = mock.calls.CreateUserKey
+	mock.lockCreateUserKey.RUnlock()
+	return calls
+}
+
+// DeleteUserKey calls DeleteUserKeyFunc.
+func (mock *UserRepositoryMock) DeleteUserKey(ctx context.Context, keyID string, userID string) error {
+	callInfo := struct {
+		Ctx    context.Context
+		KeyID  string
+		UserID string
+	}{
+		Ctx:    ctx,
+		KeyID:  keyID,
+		UserID: userID,
+	}
+	mock.lockDeleteUserKey.Lock()
+	mock.calls.DeleteUserKey = append(mock.calls.DeleteUserKey, callInfo)
+	mock.lockDeleteUserKey.Unlock()
+	if mock.DeleteUserKeyFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DeleteUserKeyFunc(ctx, keyID, userID)
+}
+
+// DeleteUserKeyCalls gets all the calls that were made to DeleteUserKey.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteUserKeyCalls())
+func (mock *UserRepositoryMock) DeleteUserKeyCalls() []struct {
+	Ctx    context.Context
+	KeyID  string
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		KeyID  string
+		UserID string
+	}
+	mock.lockDeleteUserKey.RLock()
+	calls = mock.calls.DeleteUserKey
+	mock.lockDeleteUserKey.RUnlock()
+	return calls
+}
+
+// DeleteUserQuota calls DeleteUserQuotaFunc.
+func (mock *UserRepositoryMock) DeleteUserQuota(ctx context.Context, userID string) error {
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockDeleteUserQuota.Lock()
+	mock.calls.DeleteUserQuota = append(mock.calls.DeleteUserQuota, callInfo)
+	mock.lockDeleteUserQuota.Unlock()
+	if mock.DeleteUserQuotaFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DeleteUserQuotaFunc(ctx, userID)
+}
+
+// DeleteUserQuotaCalls gets all the calls that were made to DeleteUserQuota.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteUserQuotaCalls())
+func (mock *UserRepositoryMock) DeleteUserQuotaCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockDeleteUserQuota.RLock()
+	calls = mock.calls.DeleteUserQuota
+	mock.lockDeleteUserQuota.RUnlock()
+	return calls
+}
+
+// EnsureUser calls EnsureUserFunc.
+func (mock *UserRepositoryMock) EnsureUser(ctx context.Context, name string) (*domain.User, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockEnsureUser.Lock()
+	mock.calls.EnsureUser = append(mock.calls.EnsureUser, callInfo)
+	mock.lockEnsureUser.Unlock()
+	if mock.EnsureUserFunc == nil {
+		var (
+			userOut *domain.User
+			errOut  error
+		)
+		return userOut, errOut
+	}
+	return mock.EnsureUserFunc(ctx, name)
+}
+
+// EnsureUserCalls gets all the calls that were made to EnsureUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.EnsureUserCalls())
+func (mock *UserRepositoryMock) EnsureUserCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockEnsureUser.RLock()
+	calls = mock.calls.EnsureUser
+	mock.lockEnsureUser.RUnlock()
+	return calls
+}
+
+// EnsureUsers calls EnsureUsersFunc.
+func (mock *UserRepositoryMock) EnsureUsers(ctx context.Context, names []string) ([]*domain.User, error) {
+	callInfo := struct {
+		Ctx   context.Context
+		Names []string
+	}{
+		Ctx:   ctx,
+		Names: names,
+	}
+	mock.lockEnsureUsers.Lock()
+	mock.calls.EnsureUsers = append(mock.calls.EnsureUsers, callInfo)
+	mock.lockEnsureUsers.Unlock()
+	if mock.EnsureUsersFunc == nil {
+		var (
+			usersOut []*domain.User
+			errOut   error
+		)
+		return usersOut, errOut
+	}
+	return mock.EnsureUsersFunc(ctx, names)
+}
+
+// EnsureUsersCalls gets all the calls that were made to EnsureUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.EnsureUsersCalls())
+func (mock *UserRepositoryMock) EnsureUsersCalls() []struct {
+	Ctx   context.Context
+	Names []string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Names []string
+	}
+	mock.lockEnsureUsers.RLock()
+	calls = mock.calls.EnsureUsers
+	mock.lockEnsureUsers.RUnlock()
+	return calls
+}
+
+// GetUserKeys calls GetUserKeysFunc.
+func (mock *UserRepositoryMock) GetUserKeys(ctx context.Context, cond domain.GetUserKeyCondition) ([]*domain.UserKey, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Cond domain.GetUserKeyCondition
+	}{
+		Ctx:  ctx,
+		Cond: cond,
+	}
+	mock.lockGetUserKeys.Lock()
+	mock.calls.GetUserKeys = append(mock.calls.GetUserKeys, callInfo)
+	mock.lockGetUserKeys.Unlock()
+	if mock.GetUserKeysFunc == nil {
+		var (
+			userKeysOut []*domain.UserKey
+			errOut      error
+		)
+		return userKeysOut, errOut
+	}
+	return mock.GetUserKeysFunc(ctx, cond)
+}
+
+// GetUserKeysCalls gets all the calls that were made to GetUserKeys.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUserKeysCalls())
+func (mock *UserRepositoryMock) GetUserKeysCalls() []struct {
+	Ctx  context.Context
+	Cond domain.GetUserKeyCondition
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Cond domain.GetUserKeyCondition
+	}
+	mock.lockGetUserKeys.RLock()
+	calls = mock.calls.GetUserKeys
+	mock.lockGetUserKeys.RUnlock()
+	return calls
+}
+
+// GetUserQuotas calls GetUserQuotasFunc.
+func (mock *UserRepositoryMock) GetUserQuotas(ctx context.Context, cond domain.GetUserQuotaCondition) ([]*domain.UserQuota, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Cond domain.GetUserQuotaCondition
+	}{
+		Ctx:  ctx,
+		Cond: cond,
+	}
+	mock.lockGetUserQuotas.Lock()
+	mock.calls.GetUserQuotas = append(mock.calls.GetUserQuotas, callInfo)
+	mock.lockGetUserQuotas.Unlock()
+	if mock.GetUserQuotasFunc == nil {
+		var (
+			userQuotasOut []*domain.UserQuota
+			errOut        error
+		)
+		return userQuotasOut, errOut
+	}
+	return mock.GetUserQuotasFunc(ctx, cond)
+}
+
+// GetUserQuotasCalls gets all the calls that were made to GetUserQuotas.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUserQuotasCalls())
+func (mock *UserRepositoryMock) GetUserQuotasCalls() []struct {
+	Ctx  context.Context
+	Cond domain.GetUserQuotaCondition
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Cond domain.GetUserQuotaCondition
+	}
+	mock.lockGetUserQuotas.RLock()
+	calls = mock.calls.GetUserQuotas
+	mock.lockGetUserQuotas.RUnlock()
+	return calls
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *UserRepositoryMock) GetUsers(ctx context.Context, cond domain.GetUserCondition) ([]*domain.User, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Cond domain.GetUserCondition
+	}{
+		Ctx:  ctx,
+		Cond: cond,
+	}
+	mock.lockGetUsers.Lock()
+	mock.calls.GetUsers = append(mock.calls.GetUsers, callInfo)
+	mock.lockGetUsers.Unlock()
+	if mock.GetUsersFunc == nil {
+		var (
+			usersOut []*domain.User
+			errOut   error
+		)
+		return usersOut, errOut
+	}
+	return mock.GetUsersFunc(ctx, cond)
+}
+
+// GetUsersCalls gets all the calls that were made to GetUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUsersCalls())
+func (mock *UserRepositoryMock) GetUsersCalls() []struct {
+	Ctx  context.Context
+	Cond domain.GetUserCondition
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Cond domain.GetUserCondition
+	}
+	mock.lockGetUsers.RLock()
+	calls = mock.calls.GetUsers
+	mock.lockGetUsers.RUnlock()
+	return calls
+}
+
+// SetUserQuota calls SetUserQuotaFunc.
+func (mock *UserRepositoryMock) SetUserQuota(ctx context.Context, quota *domain.UserQuota) error {
+	callInfo := struct {
+		Ctx   context.Context
+		Quota *domain.UserQuota
+	}{
+		Ctx:   ctx,
+		Quota: quota,
+	}
+	mock.lockSetUserQuota.Lock()
+	mock.calls.SetUserQuota = append(mock.calls.SetUserQuota, callInfo)
+	mock.lockSetUserQuota.Unlock()
+	if mock.SetUserQuotaFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.SetUserQuotaFunc(ctx, quota)
+}
+
+// SetUserQuotaCalls gets all the calls that were made to SetUserQuota.
+// Check the length with:
+//
+//	len(mockedUserRepository.SetUserQuotaCalls())
+func (mock *UserRepositoryMock) SetUserQuotaCalls() []struct {
+	Ctx   context.Context
+	Quota *domain.UserQuota
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Quota *domain.UserQuota
+	}
+	mock.lockSetUserQuota.RLock()
+	calls = mock.calls.SetUserQuota
+	mock.lockSetUserQuota.RUnlock()
+	return calls
+}
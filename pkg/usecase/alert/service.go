@@ -0,0 +1,213 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+	"github.com/traPtitech/neoshowcase/pkg/util/loop"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// evaluateConcurrency is the number of applications evaluated at once.
+const evaluateConcurrency = 8
+
+type Config struct {
+	// Interval is the interval of evaluating alert rules, e.g. "1m".
+	Interval string `mapstructure:"interval" yaml:"interval"`
+}
+
+type Service interface {
+	Start(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+}
+
+type alertService struct {
+	cluster   *discovery.Cluster
+	appRepo   domain.ApplicationRepository
+	alertRepo domain.AlertRepository
+	metrics   domain.MetricsService
+	logger    domain.ContainerLogger
+	notifier  domain.Notifier
+
+	start        func()
+	startOnce    sync.Once
+	shutdown     func()
+	shutdownOnce sync.Once
+}
+
+func NewService(
+	cluster *discovery.Cluster,
+	appRepo domain.ApplicationRepository,
+	alertRepo domain.AlertRepository,
+	metrics domain.MetricsService,
+	logger domain.ContainerLogger,
+	notifier domain.Notifier,
+	config Config,
+) (Service, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid interval")
+	}
+
+	s := &alertService{
+		cluster:   cluster,
+		appRepo:   appRepo,
+		alertRepo: alertRepo,
+		metrics:   metrics,
+		logger:    logger,
+		notifier:  notifier,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.start = func() {
+		go loop.Loop(ctx, func(ctx context.Context) {
+			start := time.Now()
+			err := s.evaluateAll(ctx)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to evaluate alert rules", "error", err)
+				return
+			}
+			slog.DebugContext(ctx, "Evaluated alert rules", "duration", time.Since(start))
+		}, interval, true)
+	}
+	s.shutdown = cancel
+
+	return s, nil
+}
+
+func (s *alertService) Start(_ context.Context) error {
+	s.startOnce.Do(s.start)
+	return nil
+}
+
+func (s *alertService) Shutdown(_ context.Context) error {
+	s.shutdownOnce.Do(s.shutdown)
+	return nil
+}
+
+func (s *alertService) evaluateAll(ctx context.Context) error {
+	rules, err := s.alertRepo.GetAlertRules(ctx, domain.GetAlertRuleCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting alert rules")
+	}
+	// Shard by app ID
+	rules = lo.Filter(rules, func(r *domain.AlertRule, _ int) bool {
+		return s.cluster.IsAssigned(r.ApplicationID)
+	})
+	if len(rules) == 0 {
+		return nil
+	}
+
+	rulesByApp := lo.GroupBy(rules, func(r *domain.AlertRule) string { return r.ApplicationID })
+	apps, err := s.appRepo.GetApplications(ctx, domain.GetApplicationCondition{IDIn: optional.From(lo.Keys(rulesByApp))})
+	if err != nil {
+		return oops.Wrapf(err, "getting applications")
+	}
+	firing, err := s.alertRepo.GetAlerts(ctx, domain.GetAlertCondition{Firing: optional.From(true)})
+	if err != nil {
+		return oops.Wrapf(err, "getting firing alerts")
+	}
+	firingByRule := lo.SliceToMap(firing, func(a *domain.Alert) (string, *domain.Alert) { return a.RuleID, a })
+
+	var eg errgroup.Group
+	eg.SetLimit(evaluateConcurrency)
+	for _, app := range apps {
+		eg.Go(func() error {
+			for _, rule := range rulesByApp[app.ID] {
+				s.evaluate(ctx, app, rule, firingByRule[rule.ID])
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// evaluate evaluates the rule, and records and notifies the change of the state.
+// current is the currently firing alert of the rule, or nil if the rule is not firing.
+func (s *alertService) evaluate(ctx context.Context, app *domain.Application, rule *domain.AlertRule, current *domain.Alert) {
+	now := time.Now()
+	firing, message, err := s.check(ctx, app, rule, now)
+	if err != nil {
+		// Keep the current state
+		slog.WarnContext(ctx, "failed to evaluate alert rule", "app_id", app.ID, "rule_id", rule.ID, "error", err)
+		return
+	}
+
+	switch {
+	case firing && current == nil:
+		alert := domain.NewAlert(rule, message, now)
+		err = s.alertRepo.CreateAlert(ctx, alert)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to record firing alert", "app_id", app.ID, "rule_id", rule.ID, "error", err)
+			return
+		}
+		s.notify(ctx, app, fmt.Sprintf("[FIRING] %s in %s", rule.Name, app.Name), message)
+	case !firing && current != nil:
+		err = s.alertRepo.ResolveAlert(ctx, current.ID, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to record resolved alert", "app_id", app.ID, "rule_id", rule.ID, "error", err)
+			return
+		}
+		s.notify(ctx, app, fmt.Sprintf("[RESOLVED] %s in %s", rule.Name, app.Name),
+			fmt.Sprintf("Resolved after %v: %s", now.Sub(current.FiredAt).Round(time.Second), current.Message))
+	}
+}
+
+func (s *alertService) check(ctx context.Context, app *domain.Application, rule *domain.AlertRule, now time.Time) (firing bool, message string, err error) {
+	// Stopped apps do not fire, so that alerts are resolved on stop
+	if !app.Running || app.Container != domain.ContainerStateRunning {
+		return false, "", nil
+	}
+
+	switch rule.Kind {
+	case domain.AlertRuleKindMetric:
+		values, err := s.metrics.Get(ctx, rule.Metric, app, now, rule.Duration)
+		if err != nil {
+			return false, "", oops.Wrapf(err, "getting metrics")
+		}
+		limit := optional.None[float64]()
+		if rule.ThresholdUnit == domain.AlertThresholdUnitLimitPercent {
+			limit, err = s.metrics.GetLimit(ctx, rule.Metric, app)
+			if err != nil {
+				return false, "", oops.Wrapf(err, "getting metric limit")
+			}
+		}
+		return rule.EvaluateMetric(now, values, limit)
+	case domain.AlertRuleKindNoLog:
+		logs, err := s.logger.Get(ctx, app, now, 1, domain.LogFilter{})
+		if err != nil {
+			return false, "", oops.Wrapf(err, "getting logs")
+		}
+		latest := optional.None[time.Time]()
+		if len(logs) > 0 {
+			latest = optional.From(logs[0].Time)
+		}
+		firing, message = rule.EvaluateNoLog(now, latest)
+		return firing, message, nil
+	default:
+		return false, "", oops.Errorf("unknown alert rule kind: %v", rule.Kind)
+	}
+}
+
+func (s *alertService) notify(ctx context.Context, app *domain.Application, title string, message string) {
+	err := s.notifier.Notify(ctx, &domain.Notification{
+		ApplicationID: app.ID,
+		Event:         domain.NotificationEventAlert,
+		UserIDs:       app.OwnerIDs,
+		Title:         title,
+		Message:       message,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to notify alert", "app_id", app.ID, "title", title, "error", err)
+	}
+}
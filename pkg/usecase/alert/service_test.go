@@ -0,0 +1,230 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+type fakeAlertRepository struct {
+	domain.AlertRepository
+	lock   sync.Mutex
+	rules  []*domain.AlertRule
+	alerts []*domain.Alert
+}
+
+func (r *fakeAlertRepository) GetAlertRules(context.Context, domain.GetAlertRuleCondition) ([]*domain.AlertRule, error) {
+	return r.rules, nil
+}
+
+func (r *fakeAlertRepository) GetAlerts(_ context.Context, cond domain.GetAlertCondition) ([]*domain.Alert, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return lo.Filter(r.alerts, func(a *domain.Alert, _ int) bool {
+		return !cond.Firing.Valid || a.Firing() == cond.Firing.V
+	}), nil
+}
+
+func (r *fakeAlertRepository) CreateAlert(_ context.Context, alert *domain.Alert) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *fakeAlertRepository) ResolveAlert(_ context.Context, id string, resolvedAt time.Time) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, a := range r.alerts {
+		if a.ID == id {
+			a.ResolvedAt = optional.From(resolvedAt)
+		}
+	}
+	return nil
+}
+
+func (r *fakeAlertRepository) firing() []*domain.Alert {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return lo.Filter(r.alerts, func(a *domain.Alert, _ int) bool { return a.Firing() })
+}
+
+// fakeMetrics returns the value sampled every minute over the requested duration.
+type fakeMetrics struct {
+	domain.MetricsService
+	value float64
+	err   error
+}
+
+func (m *fakeMetrics) Get(_ context.Context, _ string, _ *domain.Application, before time.Time, limit time.Duration) ([]*domain.AppMetric, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var values []*domain.AppMetric
+	for t := before.Add(-limit); !t.After(before); t = t.Add(time.Minute) {
+		values = append(values, &domain.AppMetric{Time: t, Value: m.value})
+	}
+	return values, nil
+}
+
+type fakeLogger struct {
+	domain.ContainerLogger
+	latest optional.Of[time.Time]
+}
+
+func (l *fakeLogger) Get(context.Context, *domain.Application, time.Time, int, domain.LogFilter) ([]*domain.ContainerLog, error) {
+	if !l.latest.Valid {
+		return nil, nil
+	}
+	return []*domain.ContainerLog{{Time: l.latest.V, Log: "hello"}}, nil
+}
+
+type fakeNotifier struct {
+	lock          sync.Mutex
+	notifications []*domain.Notification
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, notification *domain.Notification) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func (n *fakeNotifier) titles() []string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return lo.Map(n.notifications, func(n *domain.Notification, _ int) string { return n.Title })
+}
+
+func singleCluster(t *testing.T) *discovery.Cluster {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	c := discovery.NewCluster(discovery.NewSingleDiscoverer("127.0.0.1"))
+	go func() { _ = c.Start(ctx) }()
+	return c
+}
+
+func TestAlertService_evaluateAll(t *testing.T) {
+	app := &domain.Application{ID: "app", Name: "app", Running: true, Container: domain.ContainerStateRunning, OwnerIDs: []string{"owner"}}
+	appRepo := &mocks.ApplicationRepositoryMock{
+		GetApplicationsFunc: func(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error) {
+			app := *app
+			return []*domain.Application{&app}, nil
+		},
+	}
+	memory := &domain.AlertRule{ID: "memory", ApplicationID: app.ID, Name: "high memory", Kind: domain.AlertRuleKindMetric, Metric: "Memory", Comparison: domain.AlertComparisonAbove, Threshold: 90, Duration: 5 * time.Minute}
+	noLog := &domain.AlertRule{ID: "no-log", ApplicationID: app.ID, Name: "silent", Kind: domain.AlertRuleKindNoLog, Duration: 10 * time.Minute}
+	alertRepo := &fakeAlertRepository{rules: []*domain.AlertRule{memory, noLog}}
+	metrics := &fakeMetrics{value: 50}
+	logger := &fakeLogger{latest: optional.From(time.Now())}
+	notifier := &fakeNotifier{}
+	s := &alertService{
+		cluster:   singleCluster(t),
+		appRepo:   appRepo,
+		alertRepo: alertRepo,
+		metrics:   metrics,
+		logger:    logger,
+		notifier:  notifier,
+	}
+	evaluate := func() {
+		t.Helper()
+		require.NoError(t, s.evaluateAll(context.Background()))
+	}
+	firingRules := func() []string {
+		return lo.Map(alertRepo.firing(), func(a *domain.Alert, _ int) string { return a.RuleID })
+	}
+
+	// Not breaching
+	evaluate()
+	assert.Empty(t, alertRepo.alerts)
+	assert.Empty(t, notifier.titles())
+
+	// Fires once, and stays firing without duplicates
+	metrics.value = 95
+	evaluate()
+	evaluate()
+	assert.Equal(t, []string{"memory"}, firingRules())
+	assert.Equal(t, []string{"[FIRING] high memory in app"}, notifier.titles())
+	assert.Equal(t, "Memory > 90 for 5m0s (latest value: 95)", alertRepo.alerts[0].Message)
+	assert.Equal(t, []string{"owner"}, notifier.notifications[0].UserIDs)
+	assert.Equal(t, domain.NotificationEventAlert, notifier.notifications[0].Event)
+
+	// Failure to evaluate keeps the current state
+	metrics.err = errors.New("prometheus is down")
+	evaluate()
+	assert.Equal(t, []string{"memory"}, firingRules())
+	assert.Len(t, notifier.titles(), 1)
+	metrics.err = nil
+
+	// Resolves once
+	metrics.value = 50
+	evaluate()
+	evaluate()
+	assert.Empty(t, firingRules())
+	assert.Len(t, alertRepo.alerts, 1)
+	assert.Equal(t, []string{"[FIRING] high memory in app", "[RESOLVED] high memory in app"}, notifier.titles())
+	assert.Contains(t, notifier.notifications[1].Message, "Memory > 90 for 5m0s")
+
+	// Fires again as a new alert
+	logger.latest = optional.From(time.Now().Add(-20 * time.Minute))
+	evaluate()
+	assert.Equal(t, []string{"no-log"}, firingRules())
+	assert.Len(t, alertRepo.alerts, 2)
+	assert.Equal(t, "[FIRING] silent in app", notifier.titles()[2])
+
+	// Stopped apps do not fire, so that alerts are resolved
+	metrics.value = 95
+	app.Running = false
+	evaluate()
+	assert.Empty(t, firingRules())
+	assert.Equal(t, []string{"[RESOLVED] silent in app"}, notifier.titles()[3:])
+}
+
+func TestAlertService_evaluateAll_Sharding(t *testing.T) {
+	// The only replica is not "me", e.g. while the cluster is being rolled out
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	cluster := discovery.NewCluster(&staticTargets{targets: []discovery.Target{{IP: "10.0.0.1"}}})
+	go func() { _ = cluster.Start(ctx) }()
+
+	alertRepo := &fakeAlertRepository{rules: []*domain.AlertRule{
+		{ID: "no-log", ApplicationID: "app", Name: "silent", Kind: domain.AlertRuleKindNoLog, Duration: 10 * time.Minute},
+	}}
+	notifier := &fakeNotifier{}
+	s := &alertService{
+		cluster:   cluster,
+		appRepo:   &mocks.ApplicationRepositoryMock{},
+		alertRepo: alertRepo,
+		logger:    &fakeLogger{},
+		notifier:  notifier,
+	}
+	require.NoError(t, s.evaluateAll(context.Background()))
+	assert.Empty(t, alertRepo.alerts)
+	assert.Empty(t, notifier.titles())
+}
+
+type staticTargets struct {
+	targets []discovery.Target
+}
+
+func (d *staticTargets) Watch(ctx context.Context) (<-chan []discovery.Target, error) {
+	updates := make(chan []discovery.Target)
+	go func() {
+		updates <- d.targets
+		<-ctx.Done()
+		close(updates)
+	}()
+	return updates, nil
+}
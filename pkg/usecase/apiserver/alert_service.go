@@ -0,0 +1,90 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const (
+	maxAlertRulesPerApp = 20
+	defaultAlertsLimit  = 50
+	maxAlertsLimit      = 500
+)
+
+func (s *Service) GetAlertRules(ctx context.Context, appID string) ([]*domain.AlertRule, error) {
+	err := s.isApplicationOwner(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	return s.alertRepo.GetAlertRules(ctx, domain.GetAlertRuleCondition{ApplicationID: optional.From(appID)})
+}
+
+func (s *Service) CreateAlertRule(ctx context.Context, rule *domain.AlertRule) (*domain.AlertRule, error) {
+	err := s.isApplicationOwner(ctx, rule.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var availableMetrics []string
+	if s.metricsService != nil {
+		availableMetrics = s.metricsService.AvailableNames()
+	}
+	err = rule.Validate(availableMetrics)
+	if err != nil {
+		return nil, newError(ErrorTypeBadRequest, "invalid alert rule: "+err.Error(), err)
+	}
+
+	rules, err := s.alertRepo.GetAlertRules(ctx, domain.GetAlertRuleCondition{ApplicationID: optional.From(rule.ApplicationID)})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting alert rules")
+	}
+	if len(rules) >= maxAlertRulesPerApp {
+		return nil, newError(ErrorTypeBadRequest, "too many alert rules", nil)
+	}
+
+	err = s.alertRepo.CreateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, oops.Wrapf(err, "creating alert rule")
+	}
+	return rule, nil
+}
+
+func (s *Service) DeleteAlertRule(ctx context.Context, appID string, ruleID string) error {
+	err := s.isApplicationOwner(ctx, appID)
+	if err != nil {
+		return err
+	}
+	rules, err := s.alertRepo.GetAlertRules(ctx, domain.GetAlertRuleCondition{
+		ID:            optional.From(ruleID),
+		ApplicationID: optional.From(appID),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "getting alert rule")
+	}
+	if len(rules) == 0 {
+		return newError(ErrorTypeNotFound, "alert rule not found", nil)
+	}
+	return s.alertRepo.DeleteAlertRule(ctx, ruleID)
+}
+
+// GetAlerts returns firing and resolved alerts of the application, latest first.
+func (s *Service) GetAlerts(ctx context.Context, appID string, limit int) ([]*domain.Alert, error) {
+	err := s.isApplicationOwner(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultAlertsLimit
+	}
+	if limit < 0 || limit > maxAlertsLimit {
+		return nil, newError(ErrorTypeBadRequest, "limit must be between 1 and 500", nil)
+	}
+	return s.alertRepo.GetAlerts(ctx, domain.GetAlertCondition{
+		ApplicationID: optional.From(appID),
+		Limit:         optional.From(limit),
+	})
+}
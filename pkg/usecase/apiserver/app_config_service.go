@@ -7,6 +7,7 @@ import (
 	"github.com/samber/oops"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
 	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
@@ -53,6 +54,17 @@ func (s *Service) StartApplication(ctx context.Context, id string) error {
 		return err
 	}
 
+	appBefore, err := s.appRepo.GetApplication(ctx, id)
+	if err != nil {
+		return oops.Wrapf(err, "getting application")
+	}
+	appAfter := *appBefore
+	appAfter.Running = true
+	err = s.checkQuota(ctx, appBefore, &appAfter)
+	if err != nil {
+		return err
+	}
+
 	err = s.appRepo.UpdateApplication(ctx, id, &domain.UpdateApplicationArgs{
 		Running:   optional.From(true),
 		UpdatedAt: optional.From(time.Now()),
@@ -60,6 +72,7 @@ func (s *Service) StartApplication(ctx context.Context, id string) error {
 	if err != nil {
 		return oops.Wrapf(err, "marking application as running")
 	}
+	s.recordEvent(ctx, domain.NewStartedEvent(id, web.GetUser(ctx).ID))
 
 	app, err := s.appRepo.GetApplication(ctx, id)
 	if err != nil {
@@ -89,6 +102,7 @@ func (s *Service) StopApplication(ctx context.Context, id string) error {
 	if err != nil {
 		return oops.Wrapf(err, "marking application as not running")
 	}
+	s.recordEvent(ctx, domain.NewStoppedEvent(id, "", web.GetUser(ctx).ID))
 
 	err = s.controller.SyncDeployments(ctx)
 	if err != nil {
@@ -0,0 +1,81 @@
+package apiserver
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const (
+	defaultEventsLimit = 50
+	maxEventsLimit     = 500
+	// eventsPollInterval is the interval to check new events for streaming.
+	// Events are recorded by both gateway and controller, so the database is polled.
+	eventsPollInterval = 2 * time.Second
+)
+
+// recordEvent records the event. Errors are only logged, since the timeline is not essential to the operation.
+func (s *Service) recordEvent(ctx context.Context, event *domain.ApplicationEvent) {
+	err := s.eventRepo.CreateEvents(ctx, []*domain.ApplicationEvent{event})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record application event", "app_id", event.ApplicationID, "error", err)
+	}
+}
+
+func (s *Service) GetApplicationEvents(ctx context.Context, id string, before time.Time, limit int) ([]*domain.ApplicationEvent, error) {
+	err := s.isApplicationOwner(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultEventsLimit
+	}
+	if limit < 0 || limit > maxEventsLimit {
+		return nil, newError(ErrorTypeBadRequest, "limit must be between 1 and 500", nil)
+	}
+
+	return s.eventRepo.GetEvents(ctx, domain.GetApplicationEventCondition{
+		ApplicationID: id,
+		Before:        optional.From(before),
+		Limit:         optional.From(limit),
+	})
+}
+
+func (s *Service) GetApplicationEventsStream(ctx context.Context, id string, begin time.Time, send func(e *domain.ApplicationEvent) error) error {
+	err := s.isApplicationOwner(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(eventsPollInterval)
+	defer ticker.Stop()
+
+	after := begin
+	for {
+		events, err := s.eventRepo.GetEvents(ctx, domain.GetApplicationEventCondition{
+			ApplicationID: id,
+			After:         optional.From(after),
+		})
+		if err != nil {
+			return oops.Wrapf(err, "getting application events")
+		}
+		for _, e := range events {
+			err = send(e)
+			if err != nil {
+				return oops.Wrapf(err, "sending event")
+			}
+			after = e.CreatedAt
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
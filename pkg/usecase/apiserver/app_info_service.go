@@ -2,6 +2,7 @@ package apiserver
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/samber/oops"
@@ -25,7 +26,21 @@ func (s *Service) GetApplicationMetrics(ctx context.Context, name string, id str
 	return s.metricsService.Get(ctx, name, app, before, limit)
 }
 
-func (s *Service) GetOutput(ctx context.Context, id string, before time.Time, limit int) ([]*domain.ContainerLog, error) {
+func validateLogFilter(filter domain.LogFilter) error {
+	if err := filter.Validate(); err != nil {
+		return newError(ErrorTypeBadRequest, "invalid log filter: "+err.Error(), err)
+	}
+	return nil
+}
+
+func handleLogFilterError(err error) error {
+	if errors.Is(err, domain.ErrUnsupportedLogFilter) {
+		return newError(ErrorTypeBadRequest, "log filter not supported by the log backend", err)
+	}
+	return err
+}
+
+func (s *Service) GetOutput(ctx context.Context, id string, before time.Time, limit int, filter domain.LogFilter) ([]*domain.ContainerLog, error) {
 	// Validate
 	err := s.isApplicationOwner(ctx, id)
 	if err != nil {
@@ -34,20 +49,30 @@ func (s *Service) GetOutput(ctx context.Context, id string, before time.Time, li
 	if limit > s.containerLogger.LogLimit() {
 		return nil, newError(ErrorTypeBadRequest, "limit too large", nil)
 	}
+	if err = validateLogFilter(filter); err != nil {
+		return nil, err
+	}
 
 	// Get logs
 	app, err := s.appRepo.GetApplication(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	return s.containerLogger.Get(ctx, app, before, limit)
+	logs, err := s.containerLogger.Get(ctx, app, before, limit, filter)
+	if err != nil {
+		return nil, handleLogFilterError(err)
+	}
+	return logs, nil
 }
 
-func (s *Service) GetOutputStream(ctx context.Context, id string, begin time.Time, send func(l *domain.ContainerLog) error) error {
+func (s *Service) GetOutputStream(ctx context.Context, id string, begin time.Time, filter domain.LogFilter, send func(l *domain.ContainerLog) error) error {
 	err := s.isApplicationOwner(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err = validateLogFilter(filter); err != nil {
+		return err
+	}
 
 	app, err := s.appRepo.GetApplication(ctx, id)
 	if err != nil {
@@ -57,9 +82,9 @@ func (s *Service) GetOutputStream(ctx context.Context, id string, begin time.Tim
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	ch, err := s.containerLogger.Stream(ctx, app, begin)
+	ch, err := s.containerLogger.Stream(ctx, app, begin, filter)
 	if err != nil {
-		return oops.Wrapf(err, "connecting to stream")
+		return handleLogFilterError(oops.Wrapf(err, "connecting to stream"))
 	}
 
 	for {
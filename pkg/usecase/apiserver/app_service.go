@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/samber/oops"
@@ -26,7 +28,12 @@ func (s *Service) validateApp(ctx context.Context, app *domain.Application) erro
 	if err != nil {
 		return oops.Wrapf(err, "getting system info")
 	}
-	err = app.Validate(web.GetUser(ctx), existingApps, si.AvailableDomains, si.AvailablePorts)
+	customDomains, err := s.verifiedCustomDomains(ctx)
+	if err != nil {
+		return err
+	}
+	availableDomains := si.AvailableDomains.WithCustomDomains(customDomains, app.OwnerIDs)
+	err = app.Validate(web.GetUser(ctx), existingApps, availableDomains, si.AvailablePorts)
 	if err != nil {
 		return newError(ErrorTypeBadRequest, "invalid application", err)
 	}
@@ -47,6 +54,12 @@ func (s *Service) validateApp(ctx context.Context, app *domain.Application) erro
 }
 
 func (s *Service) CreateApplication(ctx context.Context, app *domain.Application) (*domain.Application, error) {
+	return s.createApplication(ctx, app, nil)
+}
+
+// createApplication creates the application, and sets envs in addition to the provisioned database credentials
+// before the first build is requested.
+func (s *Service) createApplication(ctx context.Context, app *domain.Application, envs []*domain.Environment) (*domain.Application, error) {
 	repo, err := s.gitRepo.GetRepository(ctx, app.RepositoryID)
 	if err != nil {
 		return nil, oops.Wrapf(err, "getting repository metadata")
@@ -54,7 +67,14 @@ func (s *Service) CreateApplication(ctx context.Context, app *domain.Application
 
 	for _, website := range app.Websites {
 		website.Normalize()
+		if err = website.HashPasswords(nil); err != nil {
+			return nil, newError(ErrorTypeBadRequest, "invalid basic auth password", err)
+		}
 	}
+	if err = s.limiter.BoundRateLimits(app.Websites); err != nil {
+		return nil, newError(ErrorTypeBadRequest, "invalid rate limit", err)
+	}
+	app.InternalService.Normalize()
 
 	// Validate
 	if !repo.CanCreateApp(web.GetUser(ctx)) {
@@ -64,6 +84,10 @@ func (s *Service) CreateApplication(ctx context.Context, app *domain.Application
 	if err != nil {
 		return nil, err
 	}
+	err = s.checkQuota(ctx, nil, app)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create
 	err = s.appRepo.CreateApplication(ctx, app)
@@ -71,10 +95,16 @@ func (s *Service) CreateApplication(ctx context.Context, app *domain.Application
 		return nil, err
 	}
 
-	err = s.createApplicationDatabase(ctx, app)
+	err = s.createApplicationDatabase(ctx, app, envs)
 	if err != nil {
 		return nil, err
 	}
+	for _, env := range envs {
+		err = s.envRepo.SetEnv(ctx, env)
+		if err != nil {
+			return nil, oops.Wrapf(err, "setting environment")
+		}
+	}
 
 	// Sync
 	s.systemInfo.Purge()
@@ -86,10 +116,15 @@ func (s *Service) CreateApplication(ctx context.Context, app *domain.Application
 	return handleRepoError(s.appRepo.GetApplication(ctx, app.ID))
 }
 
-func (s *Service) createApplicationDatabase(ctx context.Context, app *domain.Application) error {
+// createApplicationDatabase provisions the databases of the app,
+// except the ones whose credentials are already given in envs, i.e. shared with the source application of a duplicate.
+func (s *Service) createApplicationDatabase(ctx context.Context, app *domain.Application, envs []*domain.Environment) error {
 	dbName := domain.DBName(app.ID)
+	given := func(key string) bool {
+		return lo.ContainsBy(envs, func(e *domain.Environment) bool { return e.System && e.Key == key })
+	}
 
-	if app.Config.BuildConfig.MariaDB() {
+	if app.Config.BuildConfig.MariaDB() && !given(domain.EnvMariaDBDatabaseKey) {
 		host, port := s.mariaDBManager.GetHost()
 		dbPassword := random.SecureGeneratePassword(32)
 		dbSetting := domain.CreateArgs{
@@ -116,7 +151,7 @@ func (s *Service) createApplicationDatabase(ctx context.Context, app *domain.App
 		}
 	}
 
-	if app.Config.BuildConfig.MongoDB() {
+	if app.Config.BuildConfig.MongoDB() && !given(domain.EnvMongoDBDatabaseKey) {
 		host, port := s.mongoDBManager.GetHost()
 		dbPassword := random.SecureGeneratePassword(32)
 		dbSetting := domain.CreateArgs{
@@ -235,10 +270,20 @@ func (s *Service) UpdateApplication(ctx context.Context, id string, args *domain
 	if err != nil {
 		return err
 	}
+	prevWebsites := app.Websites
+	if args.InternalService.Valid {
+		args.InternalService.V.Normalize()
+	}
 	app.Apply(args)
 
 	for _, website := range app.Websites {
 		website.Normalize()
+		if err = website.HashPasswords(prevWebsites); err != nil {
+			return newError(ErrorTypeBadRequest, "invalid basic auth password", err)
+		}
+	}
+	if err = s.limiter.BoundRateLimits(app.Websites); err != nil {
+		return newError(ErrorTypeBadRequest, "invalid rate limit", err)
 	}
 	// Validate
 	if err = s.validateApp(ctx, app); err != nil {
@@ -256,6 +301,9 @@ func (s *Service) UpdateApplication(ctx context.Context, id string, args *domain
 		if appBefore.Config.BuildConfig.MongoDB() != app.Config.BuildConfig.MongoDB() {
 			return newError(ErrorTypeBadRequest, "use_mongodb is immutable", nil)
 		}
+		if err = s.checkQuota(ctx, appBefore, app); err != nil {
+			return err
+		}
 	}
 
 	// Update
@@ -263,6 +311,7 @@ func (s *Service) UpdateApplication(ctx context.Context, id string, args *domain
 	if err != nil {
 		return oops.Wrapf(err, "updating application")
 	}
+	s.recordEvent(ctx, domain.NewConfigChangedEvent(id, args.UserChangedFields(), web.GetUser(ctx).ID))
 
 	// Sync
 	s.systemInfo.Purge()
@@ -278,15 +327,19 @@ func (s *Service) UpdateApplication(ctx context.Context, id string, args *domain
 	return nil
 }
 
+// deleteApplicationDatabase deletes the databases owned by the app.
+// Databases shared with another application, i.e. the source application of a duplicate, are left as is.
 func (s *Service) deleteApplicationDatabase(ctx context.Context, app *domain.Application, envs []*domain.Environment) error {
 	if app.Config.BuildConfig.MariaDB() {
 		dbKey, ok := lo.Find(envs, func(e *domain.Environment) bool { return e.Key == domain.EnvMariaDBDatabaseKey })
 		if !ok {
 			return oops.New("mariadb name not found in env key")
 		}
-		err := s.mariaDBManager.Delete(ctx, domain.DeleteArgs{Database: dbKey.Value})
-		if err != nil {
-			return err
+		if dbKey.Value == domain.DBName(app.ID) {
+			err := s.mariaDBManager.Delete(ctx, domain.DeleteArgs{Database: dbKey.Value})
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -295,9 +348,11 @@ func (s *Service) deleteApplicationDatabase(ctx context.Context, app *domain.App
 		if !ok {
 			return oops.New("mongodb name not found in env key")
 		}
-		err := s.mongoDBManager.Delete(ctx, domain.DeleteArgs{Database: dbKey.Value})
-		if err != nil {
-			return err
+		if dbKey.Value == domain.DBName(app.ID) {
+			err := s.mongoDBManager.Delete(ctx, domain.DeleteArgs{Database: dbKey.Value})
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -398,6 +453,26 @@ func (s *Service) DeleteApplication(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
+	// delete events
+	err = s.eventRepo.DeleteEvents(ctx, app.ID)
+	if err != nil {
+		return err
+	}
+	// delete website probes
+	err = s.probeRepo.DeleteApplicationProbes(ctx, app.ID)
+	if err != nil {
+		return err
+	}
+	// delete alerts and alert rules
+	err = s.alertRepo.DeleteApplicationAlerts(ctx, app.ID)
+	if err != nil {
+		return err
+	}
+	// delete notification subscriptions
+	err = s.notificationRepo.DeleteApplicationSubscriptions(ctx, app.ID)
+	if err != nil {
+		return err
+	}
 	// delete websites, owners, application
 	err = s.appRepo.DeleteApplication(ctx, app.ID)
 	if err != nil {
@@ -409,3 +484,27 @@ func (s *Service) DeleteApplication(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *Service) DuplicateApplication(ctx context.Context, sourceID string, args *domain.DuplicateApplicationArgs) (*domain.Application, error) {
+	err := s.isApplicationOwner(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := handleRepoError(s.appRepo.GetApplication(ctx, sourceID))
+	if err != nil {
+		return nil, err
+	}
+	ownerIDs := lo.Uniq(append(slices.Clone(source.OwnerIDs), web.GetUser(ctx).ID))
+	app, err := source.Duplicate(args, ownerIDs, time.Now())
+	if err != nil {
+		return nil, newError(ErrorTypeBadRequest, err.Error(), err)
+	}
+	envs, err := s.envRepo.GetEnv(ctx, domain.GetEnvCondition{ApplicationID: optional.From(sourceID)})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting source environments")
+	}
+
+	// Validated and provisioned in the same way as a new application
+	return s.createApplication(ctx, app, domain.DuplicateEnvs(envs, app.ID, args.ExcludeSystemEnv))
+}
@@ -617,3 +617,148 @@ func TestUpdateApplication_UpdateMariaDBConfigIsNotAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestDuplicateApplication_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		excludeSystemEnv bool
+	}{
+		{name: "exclude system env", excludeSystemEnv: true},
+		{name: "share databases", excludeSystemEnv: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			gitMock := &mocks.GitServiceMock{
+				ResolveRefsFunc: func(ctx context.Context, repo *domain.Repository) (map[string]string, error) {
+					return map[string]string{
+						"main": exampleCommitHash,
+					}, nil
+				},
+			}
+			registryMock := &mocks.RegistryClientMock{
+				GetTagsFunc: func(ctx context.Context, image string) ([]string, error) {
+					return []string{"latest"}, nil
+				},
+			}
+			dbManagerMock := &mocks.MariaDBManagerMock{
+				GetHostFunc: func() (string, int) {
+					return "mariadb", 3306
+				},
+				CreateFunc: func(ctx context.Context, args domain.CreateArgs) error {
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, args domain.DeleteArgs) error {
+					return nil
+				},
+			}
+			c := testhelper.NewContainer(
+				apiserver.DefaultOption(t),
+				apiserver.WithGitMock(gitMock),
+				apiserver.WithRegistryMock(registryMock),
+				apiserver.WithMariaDBManagerMock(dbManagerMock),
+			)
+			svc := testhelper.Resolve[*apiserver.Service](c)
+			ctx := t.Context()
+
+			user := apiserver.CreateUser(c, "test-user")
+			web.SetUser(&ctx, user)
+
+			repo, err := svc.CreateRepository(ctx, "test-repo", "https://example.com/user/repo", optional.From(apiserver.CreateRepositoryAuth{
+				Method:   domain.RepositoryAuthMethodBasic,
+				Username: "test-user",
+				Password: "test-password",
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			source, err := svc.CreateApplication(ctx, &domain.Application{
+				ID:           domain.NewID(),
+				Name:         "test-app",
+				RepositoryID: repo.ID,
+				RefName:      "main",
+				Commit:       domain.EmptyCommit,
+				DeployType:   domain.DeployTypeRuntime,
+				Config: domain.ApplicationConfig{
+					BuildConfig: &domain.BuildConfigRuntimeBuildpack{
+						UseMariaDB: true,
+						Context:    ".",
+					},
+				},
+				Websites: []*domain.Website{
+					{
+						ID:             domain.NewID(),
+						FQDN:           "duplicate-application-test.example.com",
+						PathPrefix:     "/",
+						HTTPPort:       80,
+						Authentication: domain.AuthenticationTypeOff,
+					},
+				},
+				OwnerIDs: []string{user.ID},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = svc.SetEnvironmentVariable(ctx, source.ID, "FOO", "foo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			sourceEnvs, err := svc.GetEnvironmentVariables(ctx, source.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			dup, err := svc.DuplicateApplication(ctx, source.ID, &domain.DuplicateApplicationArgs{
+				Name:             "test-app-copy",
+				RefName:          "main",
+				WebsiteFQDNs:     []string{"duplicate-application-test-copy.example.com"},
+				ExcludeSystemEnv: tt.excludeSystemEnv,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			dupEnvs, err := svc.GetEnvironmentVariables(ctx, dup.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = svc.DeleteApplication(ctx, dup.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Assert
+			envValue := func(envs []*domain.Environment, key string) string {
+				env, _ := lo.Find(envs, func(e *domain.Environment) bool { return e.Key == key })
+				return lo.FromPtr(env).Value
+			}
+			assert.Equal(t, "foo", envValue(dupEnvs, "FOO"), "user env should be copied")
+			if tt.excludeSystemEnv {
+				assert.Equal(t, domain.DBName(dup.ID), envValue(dupEnvs, domain.EnvMariaDBDatabaseKey), "copy should have its own database")
+				assert.NotEqual(t, envValue(sourceEnvs, domain.EnvMariaDBPasswordKey), envValue(dupEnvs, domain.EnvMariaDBPasswordKey), "copy should have its own credentials")
+				assert.Len(t, dbManagerMock.CreateCalls(), 2, "database should be created for both applications")
+				if assert.Len(t, dbManagerMock.DeleteCalls(), 1) {
+					assert.Equal(t, domain.DBName(dup.ID), dbManagerMock.DeleteCalls()[0].Args.Database, "only the database of the copy should be deleted")
+				}
+			} else {
+				assert.Equal(t, domain.DBName(source.ID), envValue(dupEnvs, domain.EnvMariaDBDatabaseKey), "copy should use the source database")
+				assert.Equal(t, envValue(sourceEnvs, domain.EnvMariaDBPasswordKey), envValue(dupEnvs, domain.EnvMariaDBPasswordKey), "copy should use the source credentials")
+				assert.Len(t, dbManagerMock.CreateCalls(), 1, "database should not be created for the copy")
+				assert.Empty(t, dbManagerMock.DeleteCalls(), "shared database should not be deleted")
+			}
+			_, err = svc.GetApplication(ctx, source.ID)
+			assert.NoError(t, err, "source application should remain")
+			envsAfter, err := svc.GetEnvironmentVariables(ctx, source.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.ElementsMatch(t, sourceEnvs, envsAfter, "source envs should be unchanged")
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const maxCustomDomainsPerUser = 20
+
+// verifiedCustomDomains returns verified custom domains of all users,
+// to be merged into the available domains of applications by AvailableDomainSlice.WithCustomDomains.
+func (s *Service) verifiedCustomDomains(ctx context.Context) ([]*domain.CustomDomain, error) {
+	customDomains, err := s.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{Verified: optional.From(true)})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting custom domains")
+	}
+	return customDomains, nil
+}
+
+// GetCustomDomains returns the current user's custom domains.
+func (s *Service) GetCustomDomains(ctx context.Context) ([]*domain.CustomDomain, error) {
+	return s.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{
+		UserID: optional.From(web.GetUser(ctx).ID),
+	})
+}
+
+func (s *Service) CreateCustomDomain(ctx context.Context, d *domain.CustomDomain) (*domain.CustomDomain, error) {
+	si, err := s.systemInfo.Get(ctx, struct{}{})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting system info")
+	}
+	err = d.Validate(si.AvailableDomains)
+	if err != nil {
+		return nil, newError(ErrorTypeBadRequest, "invalid custom domain: "+err.Error(), err)
+	}
+
+	customDomains, err := s.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{
+		UserID: optional.From(d.UserID),
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting custom domains")
+	}
+	if len(customDomains) >= maxCustomDomainsPerUser {
+		return nil, newError(ErrorTypeBadRequest, "too many custom domains", nil)
+	}
+	for _, existing := range customDomains {
+		if existing.Domain == d.Domain {
+			return nil, newError(ErrorTypeAlreadyExists, "custom domain already registered", nil)
+		}
+	}
+
+	err = s.customDomainRepo.CreateCustomDomain(ctx, d)
+	if err != nil {
+		return nil, oops.Wrapf(err, "creating custom domain")
+	}
+	return d, nil
+}
+
+func (s *Service) getOwnCustomDomain(ctx context.Context, id string) (*domain.CustomDomain, error) {
+	customDomains, err := s.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{
+		ID:     optional.From(id),
+		UserID: optional.From(web.GetUser(ctx).ID),
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting custom domain")
+	}
+	if len(customDomains) == 0 {
+		return nil, newError(ErrorTypeNotFound, "custom domain not found", nil)
+	}
+	return customDomains[0], nil
+}
+
+// VerifyCustomDomain verifies the ownership of the current user's custom domain immediately,
+// instead of waiting for the periodic verification by the controller.
+func (s *Service) VerifyCustomDomain(ctx context.Context, id string) (*domain.CustomDomain, error) {
+	d, err := s.getOwnCustomDomain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	verifyErr := s.verifier.Verify(ctx, d)
+	args := d.VerificationResult(verifyErr, time.Now(), 0)
+	if verifyErr != nil && d.Verified {
+		// Leave revoking to the periodic verification, which respects the grace period
+		args.Verified = optional.None[bool]()
+	}
+	err = s.customDomainRepo.UpdateCustomDomain(ctx, d.ID, args)
+	if err != nil {
+		return nil, oops.Wrapf(err, "updating custom domain")
+	}
+	return s.getOwnCustomDomain(ctx, id)
+}
+
+// DeleteCustomDomain deletes the current user's custom domain.
+// Websites already using the domain are kept, but their certificates are no longer issued.
+func (s *Service) DeleteCustomDomain(ctx context.Context, id string) error {
+	d, err := s.getOwnCustomDomain(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.customDomainRepo.DeleteCustomDomain(ctx, d.ID)
+}
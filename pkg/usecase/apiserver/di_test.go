@@ -10,6 +10,7 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/storage"
 	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
 	"github.com/traPtitech/neoshowcase/pkg/test/testhelper"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/applimit"
 )
 
 func DefaultOption(t *testing.T) testhelper.ContainerOption {
@@ -20,6 +21,13 @@ func DefaultOption(t *testing.T) testhelper.ContainerOption {
 		c.Provide(repository.NewArtifactRepository)
 		c.Provide(repository.NewRuntimeImageRepository)
 		c.Provide(repository.NewApplicationRepository)
+		c.Provide(repository.NewApplicationEventRepository)
+		c.Provide(repository.NewWebsiteProbeRepository)
+		c.Provide(repository.NewAlertRepository)
+		c.Provide(repository.NewNotificationSubscriptionRepository)
+		c.Provide(repository.NewCustomDomainRepository)
+		c.Provide(wrapValue(domain.TLSCertificateEncryptionKey("test-key")))
+		c.Provide(repository.NewTLSCertificateRepository)
 		c.Provide(repository.NewBuildRepository)
 		c.Provide(repository.NewEnvironmentRepository)
 		c.Provide(repository.NewGitRepositoryRepository)
@@ -35,6 +43,7 @@ func DefaultOption(t *testing.T) testhelper.ContainerOption {
 		c.Provide(func() builder.RegistryClient { return &mocks.RegistryClientMock{} })
 		c.Provide(func() domain.MariaDBManager { return &mocks.MariaDBManagerMock{} })
 		c.Provide(func() domain.MongoDBManager { return &mocks.MongoDBManagerMock{} })
+		c.Provide(func() domain.CustomDomainVerifier { return &mocks.CustomDomainVerifierMock{} })
 
 		dir := t.TempDir()
 		c.Provide(func() (domain.Storage, error) {
@@ -55,6 +64,10 @@ func DefaultOption(t *testing.T) testhelper.ContainerOption {
 			TmpNamePrefix: "ns-apps-tmp/",
 		}))
 
+		c.Provide(wrapValue(domain.UnlimitedQuota))
+		c.Provide(wrapValue(domain.WebsiteRateLimitConfig{DefaultAverage: 100, DefaultBurst: 200, MaxAverage: -1, MaxBurst: -1}))
+		c.Provide(applimit.NewLimiter)
+
 		c.Provide(NewService)
 	}
 }
@@ -0,0 +1,164 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const (
+	// logExportMaxRange is the maximum time range of a single export.
+	logExportMaxRange = 7 * 24 * time.Hour
+	// logExportSearchWindow is how far ContainerLogger.Get searches back from before.
+	logExportSearchWindow = 24 * time.Hour
+)
+
+func validateExportRange(since, until time.Time) error {
+	if !since.Before(until) {
+		return newError(ErrorTypeBadRequest, "since must be before until", nil)
+	}
+	if until.Sub(since) > logExportMaxRange {
+		return newError(ErrorTypeBadRequest, "time range must be at most "+logExportMaxRange.String(), nil)
+	}
+	return nil
+}
+
+// ExportOutput writes all container logs of the app in [since, until) to the exporter in chronological order.
+func (s *Service) ExportOutput(ctx context.Context, id string, since, until time.Time, filter domain.LogFilter, e *domain.LogExporter) error {
+	err := s.isApplicationOwner(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = validateExportRange(since, until); err != nil {
+		return err
+	}
+	if err = validateLogFilter(filter); err != nil {
+		return err
+	}
+
+	app, err := s.appRepo.GetApplication(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.exportContainerLogs(ctx, app, since, until, filter, e.WriteContainerLog)
+}
+
+// logExportPage is the lines in [since, before), fitting in a single ContainerLogger.Get.
+type logExportPage struct {
+	since  time.Time
+	before time.Time
+}
+
+// exportContainerLogs writes the container logs in [since, until) in chronological order.
+//
+// ContainerLogger only pages backwards, so the pages are first determined from the newest,
+// then fetched again from the oldest to be written, holding a single page in memory at a time.
+func (s *Service) exportContainerLogs(
+	ctx context.Context,
+	app *domain.Application,
+	since, until time.Time,
+	filter domain.LogFilter,
+	write func(l *domain.ContainerLog) error,
+) error {
+	limit := s.containerLogger.LogLimit()
+	var pages []logExportPage
+	before := until
+	for before.After(since) {
+		logs, full, err := s.getLogPage(ctx, app, logExportPage{since: since, before: before}, limit, filter)
+		if err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			// No logs within the search window of the backend, continue searching older logs
+			before = before.Add(-logExportSearchWindow)
+			continue
+		}
+
+		oldest := logs[0].Time
+		page := logExportPage{since: oldest, before: before}
+		if full {
+			// Lines with the same timestamp as the oldest line may not have fit into the page,
+			// so leave them all to the next page
+			if !oldest.Equal(logs[len(logs)-1].Time) {
+				page.since = oldest.Add(time.Nanosecond)
+			} else {
+				// All lines in the page have the same timestamp; cannot split further
+				slog.WarnContext(ctx, "too many log lines with the same timestamp, some lines may not be exported", "app_id", app.ID, "time", oldest)
+			}
+		}
+		pages = append(pages, page)
+		before = page.since
+	}
+
+	for _, page := range slices.Backward(pages) {
+		logs, _, err := s.getLogPage(ctx, app, page, limit, filter)
+		if err != nil {
+			return err
+		}
+		for _, l := range logs {
+			if err = write(l); err != nil {
+				return oops.Wrapf(err, "writing log")
+			}
+		}
+	}
+	return nil
+}
+
+// getLogPage returns the lines in the page in chronological order,
+// and whether the lines older than the page may have been cut off at the oldest line by limit.
+func (s *Service) getLogPage(ctx context.Context, app *domain.Application, page logExportPage, limit int, filter domain.LogFilter) ([]*domain.ContainerLog, bool, error) {
+	logs, err := s.containerLogger.Get(ctx, app, page.before, limit, filter)
+	if err != nil {
+		return nil, false, handleLogFilterError(oops.Wrapf(err, "getting logs"))
+	}
+	// Lines older than the oldest returned line prove that no line with its timestamp was cut off
+	full := len(logs) >= limit && !logs[0].Time.Before(page.since)
+	logs = lo.Filter(logs, func(l *domain.ContainerLog, _ int) bool {
+		return !l.Time.Before(page.since) && l.Time.Before(page.before)
+	})
+	return logs, full, nil
+}
+
+// ExportBuildLogs writes the logs of finished builds of the app queued in [since, until) to the exporter in chronological order.
+func (s *Service) ExportBuildLogs(ctx context.Context, id string, since, until time.Time, e *domain.LogExporter) error {
+	err := s.isApplicationOwner(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = validateExportRange(since, until); err != nil {
+		return err
+	}
+
+	builds, err := s.buildRepo.GetBuilds(ctx, domain.GetBuildCondition{
+		ApplicationID: optional.From(id),
+		SortAsc:       optional.From(true),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "getting builds")
+	}
+	builds = lo.Filter(builds, func(b *domain.Build, _ int) bool {
+		return b.Status.IsFinished() && !b.QueuedAt.Before(since) && b.QueuedAt.Before(until)
+	})
+
+	for _, build := range builds {
+		log, err := domain.GetBuildLog(s.storage, build.ID)
+		if errors.Is(err, domain.ErrFileNotFound) {
+			continue // e.g. skipped builds
+		}
+		if err != nil {
+			return oops.With("build_id", build.ID).Wrapf(err, "getting build log")
+		}
+		if err = e.WriteBuildLog(build, log); err != nil {
+			return oops.Wrapf(err, "writing build log")
+		}
+	}
+	return nil
+}
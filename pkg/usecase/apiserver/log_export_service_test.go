@@ -0,0 +1,89 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// fakeContainerLogger returns the newest lines before the given time within the search window, like the real backends.
+type fakeContainerLogger struct {
+	limit int
+	logs  []*domain.ContainerLog // in chronological order
+	calls int
+}
+
+func (l *fakeContainerLogger) LogLimit() int { return l.limit }
+
+func (l *fakeContainerLogger) Get(_ context.Context, _ *domain.Application, before time.Time, limit int, _ domain.LogFilter) ([]*domain.ContainerLog, error) {
+	l.calls++
+	logs := lo.Filter(l.logs, func(log *domain.ContainerLog, _ int) bool {
+		return log.Time.Before(before) && !log.Time.Before(before.Add(-logExportSearchWindow))
+	})
+	return logs[max(0, len(logs)-limit):], nil
+}
+
+func (l *fakeContainerLogger) Stream(context.Context, *domain.Application, time.Time, domain.LogFilter) (<-chan *domain.ContainerLog, error) {
+	panic("not implemented")
+}
+
+func TestService_exportContainerLogs(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(offsets ...time.Duration) []*domain.ContainerLog {
+		return lo.Map(offsets, func(d time.Duration, i int) *domain.ContainerLog {
+			return &domain.ContainerLog{Time: base.Add(d), Log: fmt.Sprintf("line %d", i)}
+		})
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		logs  []*domain.ContainerLog
+		// dropped is the indexes of the lines not exported
+		dropped []int
+	}{
+		{
+			name:  "multiple pages",
+			limit: 2,
+			logs:  at(1*time.Second, 2*time.Second, 3*time.Second, 4*time.Second, 5*time.Second),
+		},
+		{
+			name:  "ties on page boundaries",
+			limit: 3,
+			logs:  at(1*time.Second, 2*time.Second, 2*time.Second, 3*time.Second, 3*time.Second),
+		},
+		{
+			name:  "gap longer than search window",
+			limit: 2,
+			logs:  at(1*time.Second, 2*time.Second, 50*time.Hour, 51*time.Hour),
+		},
+		{
+			name:    "more lines with the same timestamp than limit",
+			limit:   2,
+			logs:    at(1*time.Second, 2*time.Second, 2*time.Second, 2*time.Second, 3*time.Second),
+			dropped: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &fakeContainerLogger{limit: tt.limit, logs: tt.logs}
+			s := &Service{containerLogger: logger}
+
+			var got []*domain.ContainerLog
+			err := s.exportContainerLogs(context.Background(), &domain.Application{ID: "app"}, base, base.Add(7*24*time.Hour), domain.LogFilter{}, func(l *domain.ContainerLog) error {
+				got = append(got, l)
+				return nil
+			})
+			require.NoError(t, err)
+			want := lo.Reject(tt.logs, func(_ *domain.ContainerLog, i int) bool { return lo.Contains(tt.dropped, i) })
+			assert.Equal(t, want, got)
+		})
+	}
+}
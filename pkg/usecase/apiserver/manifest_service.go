@@ -0,0 +1,284 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func (s *Service) ExportApplications(ctx context.Context, ids []string, format domain.ManifestFormat) ([]byte, error) {
+	if len(ids) == 0 {
+		return nil, newError(ErrorTypeBadRequest, "no applications selected", nil)
+	}
+	for _, id := range ids {
+		if err := s.isApplicationOwner(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	apps, err := s.appRepo.GetApplications(ctx, domain.GetApplicationCondition{IDIn: optional.From(ids)})
+	if err != nil {
+		return nil, err
+	}
+	envs, err := s.envRepo.GetEnv(ctx, domain.GetEnvCondition{ApplicationIDIn: optional.From(ids)})
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.userRepo.GetUsers(ctx, domain.GetUserCondition{})
+	if err != nil {
+		return nil, err
+	}
+	b, err := domain.NewManifest(apps, envs, users).Marshal(format)
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshaling manifest")
+	}
+	return b, nil
+}
+
+// ManifestApplicationResult is the planned (or applied) change of an application in a manifest.
+type ManifestApplicationResult struct {
+	// ApplicationID is the ID of the application, or empty if the application is to be created and not yet created.
+	ApplicationID string
+	Name          string
+	Create        bool
+	Diffs         []*domain.ManifestFieldDiff
+	Errors        []string
+}
+
+type manifestPlan struct {
+	result *ManifestApplicationResult
+	app    *domain.Application
+	args   *domain.UpdateApplicationArgs
+	envs   []*domain.Environment
+}
+
+// ApplyManifest creates or updates applications to match the manifest.
+//
+// All applications are validated before anything changes, and nothing is applied if any of them is invalid
+// or dryRun is set. The changes are applied in a single transaction. The returned bool reports whether the changes were applied.
+func (s *Service) ApplyManifest(ctx context.Context, content []byte, dryRun bool) ([]*ManifestApplicationResult, bool, error) {
+	manifest, err := domain.ParseManifest(content)
+	if err != nil {
+		return nil, false, newError(ErrorTypeBadRequest, "invalid manifest", err)
+	}
+	plans, err := s.planManifest(ctx, manifest)
+	if err != nil {
+		return nil, false, err
+	}
+	results := lo.Map(plans, func(p *manifestPlan, _ int) *ManifestApplicationResult { return p.result })
+	valid := lo.EveryBy(results, func(r *ManifestApplicationResult) bool { return len(r.Errors) == 0 })
+	if dryRun || !valid {
+		return results, false, nil
+	}
+
+	changes := lo.Map(plans, func(p *manifestPlan, _ int) *domain.ApplicationChange {
+		if p.result.Create {
+			return &domain.ApplicationChange{Create: p.app, Envs: p.envs}
+		}
+		return &domain.ApplicationChange{ID: p.app.ID, Args: p.args, Envs: p.envs}
+	})
+	err = s.appRepo.ApplyChanges(ctx, changes)
+	if err != nil {
+		return nil, false, oops.Wrapf(err, "applying manifest")
+	}
+	for _, p := range plans {
+		if p.result.Create {
+			err = s.createApplicationDatabase(ctx, p.app, nil)
+			if err != nil {
+				return nil, false, oops.With("app_name", p.app.Name).Wrapf(err, "creating database")
+			}
+			p.result.ApplicationID = p.app.ID
+		} else {
+			s.recordEvent(ctx, domain.NewConfigChangedEvent(p.app.ID, p.args.UserChangedFields(), web.GetUser(ctx).ID))
+		}
+	}
+
+	// Sync
+	s.systemInfo.Purge()
+	for _, repoID := range lo.Uniq(lo.Map(plans, func(p *manifestPlan, _ int) string { return p.app.RepositoryID })) {
+		err = s.controller.FetchRepository(ctx, repoID)
+		if err != nil {
+			return nil, false, oops.Wrapf(err, "requesting fetch repository")
+		}
+	}
+	err = s.controller.SyncDeployments(ctx)
+	if err != nil {
+		return nil, false, oops.Wrapf(err, "requesting sync deployments")
+	}
+	return results, true, nil
+}
+
+func (s *Service) planManifest(ctx context.Context, manifest *domain.Manifest) ([]*manifestPlan, error) {
+	user := web.GetUser(ctx)
+	existingApps, err := s.appRepo.GetApplications(ctx, domain.GetApplicationCondition{})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting existing applications")
+	}
+	envs, err := s.envRepo.GetEnv(ctx, domain.GetEnvCondition{})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting env")
+	}
+	users, err := s.userRepo.GetUsers(ctx, domain.GetUserCondition{})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting users")
+	}
+	si, err := s.systemInfo.Get(ctx, struct{}{})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting system info")
+	}
+	customDomains, err := s.verifiedCustomDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appsByID := lo.SliceToMap(existingApps, func(app *domain.Application) (string, *domain.Application) { return app.ID, app })
+	seenIDs := make(map[string]bool)
+	refsByRepo := make(map[string]map[string]string)
+	plans := make([]*manifestPlan, 0, len(manifest.Applications))
+	for _, m := range manifest.Applications {
+		plan := &manifestPlan{result: &ManifestApplicationResult{Name: m.Name}}
+		plans = append(plans, plan)
+		addError := func(err error) { plan.result.Errors = append(plan.result.Errors, err.Error()) }
+
+		// Resolve the base application.
+		// Applications not found are created, so that manifests exported from other instances can be applied.
+		if m.ID != "" {
+			if seenIDs[m.ID] {
+				addError(oops.Errorf("application %v is declared more than once", m.ID))
+				continue
+			}
+			seenIDs[m.ID] = true
+		}
+		before, ok := appsByID[m.ID]
+		plan.result.Create = !ok
+		if ok {
+			plan.result.ApplicationID = before.ID
+			if !before.IsOwner(user) {
+				addError(oops.New("you do not have permission for this application"))
+				continue
+			}
+		} else {
+			repo, err := s.gitRepo.GetRepository(ctx, m.RepositoryID)
+			if err != nil {
+				addError(oops.Errorf("repository %v not found", m.RepositoryID))
+				continue
+			}
+			if !repo.CanCreateApp(user) {
+				addError(oops.New("you cannot create application from this repository"))
+				continue
+			}
+		}
+
+		base := before
+		if base == nil {
+			now := time.Now()
+			base = &domain.Application{
+				ID:           domain.NewID(),
+				RepositoryID: m.RepositoryID,
+				Commit:       domain.EmptyCommit,
+				Container:    domain.ContainerStateMissing,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+				OwnerIDs:     []string{user.ID},
+			}
+		}
+		args, err := m.UpdateArgs(base, users)
+		if err != nil {
+			addError(err)
+			continue
+		}
+		args.UpdatedAt = optional.From(time.Now())
+		app := *base
+		app.Apply(args)
+		app.InternalService.Normalize()
+		for _, website := range app.Websites {
+			website.Normalize()
+		}
+		if err = s.limiter.BoundRateLimits(app.Websites); err != nil {
+			addError(err)
+		}
+		plan.app = &app
+		plan.args = args
+
+		// Validate
+		availableDomains := si.AvailableDomains.WithCustomDomains(customDomains, app.OwnerIDs)
+		if err = app.Validate(user, existingApps, availableDomains, si.AvailablePorts); err != nil {
+			addError(err)
+		}
+		if before != nil {
+			if before.Config.BuildConfig.MariaDB() != app.Config.BuildConfig.MariaDB() {
+				addError(oops.New("useMariaDB is immutable"))
+			}
+			if before.Config.BuildConfig.MongoDB() != app.Config.BuildConfig.MongoDB() {
+				addError(oops.New("useMongoDB is immutable"))
+			}
+		}
+		if err = s.validateManifestRef(ctx, &app, refsByRepo); err != nil {
+			addError(err)
+		}
+		currentEnvs := lo.Filter(envs, func(e *domain.Environment, _ int) bool { return e.ApplicationID == app.ID })
+		plan.envs, err = m.Envs(app.ID, currentEnvs)
+		if err != nil {
+			addError(err)
+		}
+
+		// Diff
+		var beforeManifest *domain.ManifestApplication
+		if before != nil {
+			beforeManifest = domain.NewManifestApplication(before, envs, users)
+		}
+		afterManifest := domain.NewManifestApplication(&app, append(currentEnvs, plan.envs...), users)
+		plan.result.Diffs, err = domain.DiffManifestApplications(beforeManifest, afterManifest)
+		if err != nil {
+			return nil, err
+		}
+
+		// Later applications in the manifest are validated against this one
+		existingApps = append(lo.Filter(existingApps, func(a *domain.Application, _ int) bool { return a.ID != app.ID }), &app)
+	}
+
+	// Check quotas against all the valid changes so far, since they are applied together
+	var quotaApps []*domain.Application
+	for _, plan := range plans {
+		if len(plan.result.Errors) > 0 {
+			continue
+		}
+		err = s.limiter.CheckQuotas(ctx, append(quotaApps, plan.app))
+		var exceeded *domain.QuotaExceededError
+		if errors.As(err, &exceeded) {
+			plan.result.Errors = append(plan.result.Errors, exceeded.Error())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		quotaApps = append(quotaApps, plan.app)
+	}
+	return plans, nil
+}
+
+func (s *Service) validateManifestRef(ctx context.Context, app *domain.Application, refsByRepo map[string]map[string]string) error {
+	refs, ok := refsByRepo[app.RepositoryID]
+	if !ok {
+		repo, err := s.gitRepo.GetRepository(ctx, app.RepositoryID)
+		if err != nil {
+			return oops.Errorf("repository %v not found", app.RepositoryID)
+		}
+		refs, err = s.gitsvc.ResolveRefs(ctx, repo)
+		if err != nil {
+			return oops.New("cannot fetch repository, check auth setting")
+		}
+		refsByRepo[app.RepositoryID] = refs
+	}
+	if _, ok := refs[app.RefName]; !ok {
+		return oops.Errorf("ref %v not found", app.RefName)
+	}
+	return nil
+}
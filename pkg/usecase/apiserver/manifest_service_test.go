@@ -0,0 +1,129 @@
+package apiserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
+	"github.com/traPtitech/neoshowcase/pkg/test/testhelper"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/apiserver"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+func TestApplyManifest(t *testing.T) {
+	t.Parallel()
+
+	manifest := func(repoID string, apps ...string) []byte {
+		s := "version: v1\napplications:\n"
+		for _, app := range apps {
+			s += app
+		}
+		return []byte(fmt.Sprintf(s, repoID))
+	}
+	// Exported from another instance, so that the ID is unknown to this instance
+	foreignApp := `
+  - id: 0123456789abcdef0123456789abcdef
+    name: foreign-app
+    repositoryId: %[1]v
+    refName: main
+    configFilePath: deploy/neoshowcase.yaml
+    build:
+      type: runtime_dockerfile
+      dockerfileName: Dockerfile
+    websites:
+      - fqdn: apply-manifest-foreign.example.com
+        httpPort: 80
+    envKeys:
+      - TOKEN
+`
+	invalidApp := `
+  - name: invalid-app
+    repositoryId: %[1]v
+    refName: unknown-ref
+    build:
+      type: runtime_dockerfile
+      dockerfileName: Dockerfile
+`
+
+	tests := []struct {
+		name        string
+		apps        []string
+		wantApplied bool
+		wantApps    []string
+	}{
+		{
+			name:        "unknown ID is created",
+			apps:        []string{foreignApp},
+			wantApplied: true,
+			wantApps:    []string{"foreign-app"},
+		},
+		{
+			name:        "nothing is applied if any application is invalid",
+			apps:        []string{foreignApp, invalidApp},
+			wantApplied: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			gitMock := &mocks.GitServiceMock{
+				ResolveRefsFunc: func(ctx context.Context, repo *domain.Repository) (map[string]string, error) {
+					return map[string]string{
+						"main": exampleCommitHash,
+					}, nil
+				},
+			}
+			c := testhelper.NewContainer(
+				apiserver.DefaultOption(t),
+				apiserver.WithGitMock(gitMock),
+			)
+			svc := testhelper.Resolve[*apiserver.Service](c)
+			ctx := t.Context()
+
+			user := apiserver.CreateUser(c, "test-user")
+			web.SetUser(&ctx, user)
+
+			repo, err := svc.CreateRepository(ctx, "test-repo", "https://example.com/user/repo", optional.From(apiserver.CreateRepositoryAuth{
+				Method: domain.RepositoryAuthMethodBasic, Username: "test-user", Password: "test-password",
+			}))
+			require.NoError(t, err)
+
+			// Act
+			results, applied, err := svc.ApplyManifest(ctx, manifest(repo.ID, tt.apps...), false)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tt.wantApplied, applied)
+			apps, err := svc.GetApplications(ctx, apiserver.GetAppScope{Scope: apiserver.GetAppScopeMine})
+			require.NoError(t, err)
+			var appNames []string
+			for _, app := range apps {
+				appNames = append(appNames, app.App.Name)
+			}
+			assert.ElementsMatch(t, tt.wantApps, appNames)
+			if !tt.wantApplied {
+				return
+			}
+
+			require.Len(t, results, 1)
+			assert.True(t, results[0].Create)
+			assert.NotEqual(t, "0123456789abcdef0123456789abcdef", results[0].ApplicationID)
+			app := apps[0].App
+			assert.Equal(t, results[0].ApplicationID, app.ID)
+			assert.Equal(t, "deploy/neoshowcase.yaml", app.ConfigFilePath)
+			envs, err := svc.GetEnvironmentVariables(ctx, app.ID)
+			require.NoError(t, err)
+			require.Len(t, envs, 1)
+			assert.Equal(t, "TOKEN", envs[0].Key)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const maxNotificationSubscriptionsPerUser = 10
+
+// GetNotificationSubscriptions returns the current user's notification subscriptions of the application.
+func (s *Service) GetNotificationSubscriptions(ctx context.Context, appID string) ([]*domain.NotificationSubscription, error) {
+	err := s.isApplicationOwner(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	return s.notificationRepo.GetSubscriptions(ctx, domain.GetNotificationSubscriptionCondition{
+		ApplicationID: optional.From(appID),
+		UserID:        optional.From(web.GetUser(ctx).ID),
+	})
+}
+
+func (s *Service) CreateNotificationSubscription(ctx context.Context, sub *domain.NotificationSubscription) (*domain.NotificationSubscription, error) {
+	err := s.isApplicationOwner(ctx, sub.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	err = sub.Validate()
+	if err != nil {
+		return nil, newError(ErrorTypeBadRequest, "invalid notification subscription: "+err.Error(), err)
+	}
+
+	subs, err := s.notificationRepo.GetSubscriptions(ctx, domain.GetNotificationSubscriptionCondition{
+		ApplicationID: optional.From(sub.ApplicationID),
+		UserID:        optional.From(sub.UserID),
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting notification subscriptions")
+	}
+	if len(subs) >= maxNotificationSubscriptionsPerUser {
+		return nil, newError(ErrorTypeBadRequest, "too many notification subscriptions", nil)
+	}
+
+	err = s.notificationRepo.CreateSubscription(ctx, sub)
+	if err != nil {
+		return nil, oops.Wrapf(err, "creating notification subscription")
+	}
+	return sub, nil
+}
+
+// DeleteNotificationSubscription deletes the current user's notification subscription.
+func (s *Service) DeleteNotificationSubscription(ctx context.Context, appID string, subscriptionID string) error {
+	err := s.isApplicationOwner(ctx, appID)
+	if err != nil {
+		return err
+	}
+	subs, err := s.notificationRepo.GetSubscriptions(ctx, domain.GetNotificationSubscriptionCondition{
+		ID:            optional.From(subscriptionID),
+		ApplicationID: optional.From(appID),
+		UserID:        optional.From(web.GetUser(ctx).ID),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "getting notification subscription")
+	}
+	if len(subs) == 0 {
+		return newError(ErrorTypeNotFound, "notification subscription not found", nil)
+	}
+	return s.notificationRepo.DeleteSubscription(ctx, subscriptionID)
+}
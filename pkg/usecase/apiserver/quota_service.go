@@ -0,0 +1,54 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// checkQuota checks that the change of the application from before to after
+// does not make any of its owners exceed their quota.
+// before is nil if the application is to be created.
+func (s *Service) checkQuota(ctx context.Context, before, after *domain.Application) error {
+	err := s.limiter.CheckQuota(ctx, before, after)
+	var exceeded *domain.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		return newError(ErrorTypeBadRequest, exceeded.Error(), err)
+	}
+	return err
+}
+
+func (s *Service) GetMyUsage(ctx context.Context) (quota domain.Quota, usage domain.ResourceUsage, custom bool, err error) {
+	user := web.GetUser(ctx)
+	quota, custom, err = s.limiter.GetQuota(ctx, user.ID)
+	if err != nil {
+		return
+	}
+	usage, err = s.limiter.GetUsage(ctx, user.ID)
+	return
+}
+
+// SetUserQuota overrides the quota of the user. If quota is not valid, the quota is reset to the default.
+func (s *Service) SetUserQuota(ctx context.Context, userID string, quota optional.Of[domain.Quota]) error {
+	if err := s.isAdmin(ctx); err != nil {
+		return err
+	}
+	users, err := s.userRepo.GetUsers(ctx, domain.GetUserCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting users")
+	}
+	if !lo.ContainsBy(users, func(u *domain.User) bool { return u.ID == userID }) {
+		return newError(ErrorTypeNotFound, "user not found", nil)
+	}
+
+	if !quota.Valid {
+		return s.userRepo.DeleteUserQuota(ctx, userID)
+	}
+	return s.userRepo.SetUserQuota(ctx, &domain.UserQuota{UserID: userID, Quota: quota.V})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/traPtitech/neoshowcase/pkg/domain/builder"
 	"github.com/traPtitech/neoshowcase/pkg/domain/web"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/repository"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/applimit"
 	"github.com/traPtitech/neoshowcase/pkg/util/scutil"
 )
 
@@ -29,6 +30,12 @@ type Service struct {
 	artifactRepo     domain.ArtifactRepository
 	runtimeImageRepo domain.RuntimeImageRepository
 	appRepo          domain.ApplicationRepository
+	eventRepo        domain.ApplicationEventRepository
+	probeRepo        domain.WebsiteProbeRepository
+	alertRepo        domain.AlertRepository
+	notificationRepo domain.NotificationSubscriptionRepository
+	customDomainRepo domain.CustomDomainRepository
+	tlsCertRepo      domain.TLSCertificateRepository
 	buildRepo        domain.BuildRepository
 	envRepo          domain.EnvironmentRepository
 	gitRepo          domain.GitRepositoryRepository
@@ -39,10 +46,12 @@ type Service struct {
 	mongoDBManager   domain.MongoDBManager
 	metricsService   domain.MetricsService
 	containerLogger  domain.ContainerLogger
+	verifier         domain.CustomDomainVerifier
 	controller       domain.ControllerServiceClient
 	gitsvc           domain.GitService
 	regclient        builder.RegistryClient
 	image            builder.ImageConfig
+	limiter          *applimit.Limiter
 
 	systemInfo *sc.Cache[struct{}, *domain.SystemInfo]
 	tmpKeys    *tmpKeyPairService
@@ -52,6 +61,12 @@ func NewService(
 	artifactRepo domain.ArtifactRepository,
 	runtimeImageRepo domain.RuntimeImageRepository,
 	appRepo domain.ApplicationRepository,
+	eventRepo domain.ApplicationEventRepository,
+	probeRepo domain.WebsiteProbeRepository,
+	alertRepo domain.AlertRepository,
+	notificationRepo domain.NotificationSubscriptionRepository,
+	customDomainRepo domain.CustomDomainRepository,
+	tlsCertRepo domain.TLSCertificateRepository,
 	buildRepo domain.BuildRepository,
 	envRepo domain.EnvironmentRepository,
 	gitRepo domain.GitRepositoryRepository,
@@ -62,15 +77,23 @@ func NewService(
 	mongoDBManager domain.MongoDBManager,
 	metricsService domain.MetricsService,
 	containerLogger domain.ContainerLogger,
+	verifier domain.CustomDomainVerifier,
 	controller domain.ControllerServiceClient,
 	regclient builder.RegistryClient,
 	image builder.ImageConfig,
 	gitsvc domain.GitService,
+	limiter *applimit.Limiter,
 ) (*Service, error) {
 	return &Service{
 		artifactRepo:     artifactRepo,
 		runtimeImageRepo: runtimeImageRepo,
 		appRepo:          appRepo,
+		eventRepo:        eventRepo,
+		probeRepo:        probeRepo,
+		alertRepo:        alertRepo,
+		notificationRepo: notificationRepo,
+		customDomainRepo: customDomainRepo,
+		tlsCertRepo:      tlsCertRepo,
 		buildRepo:        buildRepo,
 		envRepo:          envRepo,
 		gitRepo:          gitRepo,
@@ -81,10 +104,12 @@ func NewService(
 		mongoDBManager:   mongoDBManager,
 		metricsService:   metricsService,
 		containerLogger:  containerLogger,
+		verifier:         verifier,
 		controller:       controller,
 		gitsvc:           gitsvc,
 		regclient:        regclient,
 		image:            image,
+		limiter:          limiter,
 
 		systemInfo: sc.NewMust(scutil.WrapFunc(controller.GetSystemInfo), 5*time.Minute, 10*time.Minute),
 		tmpKeys:    newTmpKeyPairService(),
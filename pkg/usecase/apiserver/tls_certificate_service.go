@@ -0,0 +1,84 @@
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/domain/web"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// GetTLSCertificates returns the certificates uploaded by the current user, or all certificates for admins.
+func (s *Service) GetTLSCertificates(ctx context.Context) ([]*domain.TLSCertificate, error) {
+	user := web.GetUser(ctx)
+	var cond domain.GetTLSCertificateCondition
+	if !user.Admin {
+		cond.UserID = optional.From(user.ID)
+	}
+	return s.tlsCertRepo.GetTLSCertificates(ctx, cond)
+}
+
+// UploadTLSCertificate uploads the certificate for fqdn, replacing the existing one if any.
+// Users other than admins can only upload certificates for their verified custom domains.
+func (s *Service) UploadTLSCertificate(ctx context.Context, fqdn, certPEM, keyPEM string) (*domain.TLSCertificate, error) {
+	user := web.GetUser(ctx)
+	c, err := domain.NewTLSCertificate(user.ID, fqdn, certPEM, keyPEM, time.Now())
+	if err != nil {
+		return nil, newError(ErrorTypeBadRequest, "invalid certificate: "+err.Error(), err)
+	}
+
+	if !user.Admin {
+		customDomains, err := s.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{
+			UserID:   optional.From(user.ID),
+			Verified: optional.From(true),
+		})
+		if err != nil {
+			return nil, oops.Wrapf(err, "getting custom domains")
+		}
+		if !lo.ContainsBy(customDomains, func(d *domain.CustomDomain) bool { return d.Domain == c.FQDN }) {
+			return nil, newError(ErrorTypeForbidden, "certificates can only be uploaded for your verified custom domains", nil)
+		}
+	}
+
+	existing, err := s.tlsCertRepo.GetTLSCertificates(ctx, domain.GetTLSCertificateCondition{FQDN: optional.From(c.FQDN)})
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting tls certificates")
+	}
+	if len(existing) == 0 {
+		err = s.tlsCertRepo.CreateTLSCertificate(ctx, c)
+		if err != nil {
+			return nil, oops.Wrapf(err, "creating tls certificate")
+		}
+		return c, nil
+	}
+
+	// Replace the existing certificate, e.g. when renewed
+	if !existing[0].CanManage(user) {
+		return nil, newError(ErrorTypeAlreadyExists, "certificate for the domain is already uploaded by another user", nil)
+	}
+	c.ID = existing[0].ID
+	c.UserID = existing[0].UserID
+	c.CreatedAt = existing[0].CreatedAt
+	err = s.tlsCertRepo.UpdateTLSCertificate(ctx, c)
+	if err != nil {
+		return nil, oops.Wrapf(err, "updating tls certificate")
+	}
+	return c, nil
+}
+
+// DeleteTLSCertificate deletes the certificate uploaded by the current user, or any certificate for admins.
+// Certificates for the websites are issued by ACME again, if available.
+func (s *Service) DeleteTLSCertificate(ctx context.Context, id string) error {
+	certs, err := s.tlsCertRepo.GetTLSCertificates(ctx, domain.GetTLSCertificateCondition{ID: optional.From(id)})
+	if err != nil {
+		return oops.Wrapf(err, "getting tls certificate")
+	}
+	if len(certs) == 0 || !certs[0].CanManage(web.GetUser(ctx)) {
+		return newError(ErrorTypeNotFound, "tls certificate not found", nil)
+	}
+	return s.tlsCertRepo.DeleteTLSCertificate(ctx, id)
+}
@@ -0,0 +1,75 @@
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// GetWebsiteStatus returns the latest probe and uptimes of each website of the application.
+func (s *Service) GetWebsiteStatus(ctx context.Context, appID string) ([]*domain.WebsiteStatus, error) {
+	app, err := s.appRepo.GetApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getWebsiteStatuses(ctx, []*domain.Application{app})
+}
+
+// GetRunningWebsiteStatuses returns statuses of websites of all running applications.
+func (s *Service) GetRunningWebsiteStatuses(ctx context.Context) ([]*domain.WebsiteStatus, error) {
+	apps, err := s.appRepo.GetApplications(ctx, domain.GetApplicationCondition{Running: optional.From(true)})
+	if err != nil {
+		return nil, err
+	}
+	return s.getWebsiteStatuses(ctx, apps)
+}
+
+func (s *Service) getWebsiteStatuses(ctx context.Context, apps []*domain.Application) ([]*domain.WebsiteStatus, error) {
+	var statuses []*domain.WebsiteStatus
+	for _, app := range apps {
+		for _, website := range app.Websites {
+			statuses = append(statuses, &domain.WebsiteStatus{
+				ApplicationID: app.ID,
+				Website:       website,
+			})
+		}
+	}
+	if len(statuses) == 0 {
+		return statuses, nil
+	}
+	websiteIDs := ds.Map(statuses, func(st *domain.WebsiteStatus) string { return st.Website.ID })
+
+	latest, err := s.probeRepo.GetLatestProbes(ctx, websiteIDs)
+	if err != nil {
+		return nil, oops.Wrapf(err, "getting latest probes")
+	}
+	latestMap := lo.SliceToMap(latest, func(p *domain.WebsiteProbe) (string, *domain.WebsiteProbe) { return p.WebsiteID, p })
+	for _, st := range statuses {
+		st.Latest = latestMap[st.Website.ID]
+	}
+
+	now := time.Now()
+	for _, window := range domain.WebsiteUptimeWindows {
+		counts, err := s.probeRepo.CountProbes(ctx, websiteIDs, now.Add(-window))
+		if err != nil {
+			return nil, oops.Wrapf(err, "counting probes")
+		}
+		countsMap := lo.SliceToMap(counts, func(c *domain.WebsiteProbeCount) (string, *domain.WebsiteProbeCount) { return c.WebsiteID, c })
+		for _, st := range statuses {
+			uptime := &domain.WebsiteUptime{Window: window}
+			if c, ok := countsMap[st.Website.ID]; ok {
+				uptime.Total = c.Total
+				uptime.Up = c.Up
+			}
+			st.Uptimes = append(st.Uptimes, uptime)
+		}
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,28 @@
+package applimit
+
+import (
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// Limiter bounds the applications by the limits configured by admins,
+// whether the applications are changed via API or by the configuration files in the repositories.
+type Limiter struct {
+	appRepo         domain.ApplicationRepository
+	userRepo        domain.UserRepository
+	defaultQuota    domain.Quota
+	rateLimitConfig domain.WebsiteRateLimitConfig
+}
+
+func NewLimiter(
+	appRepo domain.ApplicationRepository,
+	userRepo domain.UserRepository,
+	defaultQuota domain.Quota,
+	rateLimitConfig domain.WebsiteRateLimitConfig,
+) *Limiter {
+	return &Limiter{
+		appRepo:         appRepo,
+		userRepo:        userRepo,
+		defaultQuota:    defaultQuota,
+		rateLimitConfig: rateLimitConfig,
+	}
+}
@@ -0,0 +1,106 @@
+package applimit
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+// GetQuota returns the quota of the user, and whether the default quota is overridden for the user.
+func (l *Limiter) GetQuota(ctx context.Context, userID string) (domain.Quota, bool, error) {
+	quotas, err := l.userRepo.GetUserQuotas(ctx, domain.GetUserQuotaCondition{
+		UserIDs: optional.From([]string{userID}),
+	})
+	if err != nil {
+		return domain.Quota{}, false, oops.Wrapf(err, "getting user quota")
+	}
+	if len(quotas) == 0 {
+		return l.defaultQuota, false, nil
+	}
+	return quotas[0].Quota, true, nil
+}
+
+// GetUsage returns the resource usage of the applications owned by the user.
+func (l *Limiter) GetUsage(ctx context.Context, userID string) (domain.ResourceUsage, error) {
+	apps, err := l.appRepo.GetApplications(ctx, domain.GetApplicationCondition{UserID: optional.From(userID)})
+	if err != nil {
+		return domain.ResourceUsage{}, oops.Wrapf(err, "getting applications")
+	}
+	return domain.CalculateUsage(apps), nil
+}
+
+// CheckQuota checks that the change of the application from before to after
+// does not make any of its owners exceed their quota, returning *domain.QuotaExceededError otherwise.
+// before is nil if the application is to be created.
+// Admins are not subject to quotas.
+func (l *Limiter) CheckQuota(ctx context.Context, before, after *domain.Application) error {
+	users, err := l.userRepo.GetUsers(ctx, domain.GetUserCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting users")
+	}
+	usersMap := lo.SliceToMap(users, func(u *domain.User) (string, *domain.User) { return u.ID, u })
+
+	for _, ownerID := range after.OwnerIDs {
+		owner, ok := usersMap[ownerID]
+		if !ok || owner.Admin {
+			continue
+		}
+		quota, _, err := l.GetQuota(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		usage, err := l.GetUsage(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		ownerBefore := before
+		if before != nil && !before.IsOwner(owner) {
+			ownerBefore = nil
+		}
+		if err = quota.CheckUsage(usage, usage.Replace(ownerBefore, after)); err != nil {
+			return &domain.QuotaExceededError{UserName: owner.Name, Err: err}
+		}
+	}
+	return nil
+}
+
+// CheckQuotas is CheckQuota for creating or updating multiple applications at once.
+// apps are the applications after the changes, each replacing the stored application of the same ID if any.
+func (l *Limiter) CheckQuotas(ctx context.Context, apps []*domain.Application) error {
+	users, err := l.userRepo.GetUsers(ctx, domain.GetUserCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting users")
+	}
+	usersMap := lo.SliceToMap(users, func(u *domain.User) (string, *domain.User) { return u.ID, u })
+
+	ownerIDs := lo.Uniq(lo.FlatMap(apps, func(app *domain.Application, _ int) []string { return app.OwnerIDs }))
+	for _, ownerID := range ownerIDs {
+		owner, ok := usersMap[ownerID]
+		if !ok || owner.Admin {
+			continue
+		}
+		quota, _, err := l.GetQuota(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		current, err := l.appRepo.GetApplications(ctx, domain.GetApplicationCondition{UserID: optional.From(ownerID)})
+		if err != nil {
+			return oops.Wrapf(err, "getting applications")
+		}
+		owned := lo.SliceToMap(current, func(app *domain.Application) (string, *domain.Application) { return app.ID, app })
+		for _, app := range apps {
+			delete(owned, app.ID)
+			if lo.Contains(app.OwnerIDs, ownerID) {
+				owned[app.ID] = app
+			}
+		}
+		if err = quota.CheckUsage(domain.CalculateUsage(current), domain.CalculateUsage(lo.Values(owned))); err != nil {
+			return &domain.QuotaExceededError{UserName: owner.Name, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package applimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
+)
+
+func newTestLimiter(apps []*domain.Application, users []*domain.User, quotas []*domain.UserQuota) *Limiter {
+	appRepo := &mocks.ApplicationRepositoryMock{
+		GetApplicationsFunc: func(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error) {
+			return lo.Filter(apps, func(app *domain.Application, _ int) bool {
+				return !cond.UserID.Valid || lo.Contains(app.OwnerIDs, cond.UserID.V)
+			}), nil
+		},
+	}
+	userRepo := &mocks.UserRepositoryMock{
+		GetUsersFunc: func(ctx context.Context, cond domain.GetUserCondition) ([]*domain.User, error) {
+			return users, nil
+		},
+		GetUserQuotasFunc: func(ctx context.Context, cond domain.GetUserQuotaCondition) ([]*domain.UserQuota, error) {
+			return lo.Filter(quotas, func(q *domain.UserQuota, _ int) bool {
+				return lo.Contains(cond.UserIDs.V, q.UserID)
+			}), nil
+		},
+	}
+	defaultQuota := domain.Quota{MaxApplications: 2, MaxRunningApplications: 1, MaxPortPublications: 0, MaxDatabases: -1}
+	return NewLimiter(appRepo, userRepo, defaultQuota, domain.WebsiteRateLimitConfig{})
+}
+
+func testApp(id string, running bool, ownerIDs ...string) *domain.Application {
+	return &domain.Application{
+		ID:       id,
+		Running:  running,
+		OwnerIDs: ownerIDs,
+		Config:   domain.ApplicationConfig{BuildConfig: &domain.BuildConfigRuntimeBuildpack{}},
+	}
+}
+
+func TestLimiter_GetQuota(t *testing.T) {
+	custom := domain.Quota{MaxApplications: 10, MaxRunningApplications: 5, MaxPortPublications: 1, MaxDatabases: 1}
+	l := newTestLimiter(nil, nil, []*domain.UserQuota{{UserID: "custom", Quota: custom}})
+
+	quota, overridden, err := l.GetQuota(context.Background(), "custom")
+	require.NoError(t, err)
+	assert.True(t, overridden)
+	assert.Equal(t, custom, quota)
+
+	quota, overridden, err = l.GetQuota(context.Background(), "user")
+	require.NoError(t, err)
+	assert.False(t, overridden)
+	assert.Equal(t, l.defaultQuota, quota)
+}
+
+func TestLimiter_CheckQuota(t *testing.T) {
+	users := []*domain.User{{ID: "user", Name: "user"}, {ID: "other", Name: "other"}, {ID: "admin", Name: "admin", Admin: true}}
+	existing := testApp("existing", true, "user")
+	l := newTestLimiter([]*domain.Application{existing, testApp("other", true, "other")}, users, nil)
+
+	tests := []struct {
+		name    string
+		before  *domain.Application
+		after   *domain.Application
+		wantErr string
+	}{
+		{
+			name:  "create within quota",
+			after: testApp("new", false, "user"),
+		},
+		{
+			name:    "create exceeding running applications",
+			after:   testApp("new", true, "user"),
+			wantErr: "quota exceeded for user user: running applications would be 2, exceeding the limit of 1",
+		},
+		{
+			name:   "update without consuming more",
+			before: existing,
+			after:  testApp("existing", true, "user"),
+		},
+		{
+			// The application counts as a new one for the added owner
+			name:    "add an owner exceeding their quota",
+			before:  existing,
+			after:   testApp("existing", true, "user", "other"),
+			wantErr: "quota exceeded for user other: running applications would be 2, exceeding the limit of 1",
+		},
+		{
+			name:  "admins are not subject to quotas",
+			after: &domain.Application{ID: "new", Running: true, OwnerIDs: []string{"admin"}, PortPublications: []*domain.PortPublication{{}}, Config: existing.Config},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := l.CheckQuota(context.Background(), tt.before, tt.after)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var exceeded *domain.QuotaExceededError
+			require.ErrorAs(t, err, &exceeded)
+			assert.Equal(t, tt.wantErr, exceeded.Error())
+		})
+	}
+}
+
+func TestLimiter_CheckQuotas(t *testing.T) {
+	users := []*domain.User{{ID: "user", Name: "user"}}
+	l := newTestLimiter([]*domain.Application{testApp("a", true, "user")}, users, nil)
+
+	// Replacing the stored application does not count twice
+	assert.NoError(t, l.CheckQuotas(context.Background(), []*domain.Application{
+		testApp("a", true, "user"),
+		testApp("b", false, "user"),
+	}))
+	// Transferring the running application to another user frees the quota
+	assert.NoError(t, l.CheckQuotas(context.Background(), []*domain.Application{
+		testApp("a", true, "admin"),
+		testApp("b", true, "user"),
+	}))
+
+	err := l.CheckQuotas(context.Background(), []*domain.Application{
+		testApp("b", false, "user"),
+		testApp("c", false, "user"),
+	})
+	var exceeded *domain.QuotaExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, "quota exceeded for user user: applications would be 3, exceeding the limit of 2", exceeded.Error())
+}
@@ -0,0 +1,17 @@
+package applimit
+
+import (
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// BoundRateLimits fills in the default rate limits of the websites, and checks them against the upper bounds.
+func (l *Limiter) BoundRateLimits(websites []*domain.Website) error {
+	for _, website := range websites {
+		if err := l.rateLimitConfig.Apply(&website.RateLimit); err != nil {
+			return oops.With("fqdn", website.FQDN).Wrapf(err, "invalid rate limit")
+		}
+	}
+	return nil
+}
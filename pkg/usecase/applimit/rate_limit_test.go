@@ -0,0 +1,42 @@
+package applimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+func TestLimiter_BoundRateLimits(t *testing.T) {
+	l := NewLimiter(nil, nil, domain.Quota{}, domain.WebsiteRateLimitConfig{
+		DefaultAverage: 100,
+		DefaultBurst:   200,
+		MaxAverage:     1000,
+		MaxBurst:       2000,
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		websites := []*domain.Website{
+			{FQDN: "a.example.com", RateLimit: domain.WebsiteRateLimit{Enabled: true}},
+			{FQDN: "b.example.com", RateLimit: domain.WebsiteRateLimit{Enabled: true, Average: 10}},
+			{FQDN: "c.example.com"},
+		}
+		require.NoError(t, l.BoundRateLimits(websites))
+		assert.Equal(t, domain.WebsiteRateLimit{Enabled: true, Average: 100, Burst: 200}, websites[0].RateLimit)
+		assert.Equal(t, domain.WebsiteRateLimit{Enabled: true, Average: 10, Burst: 200}, websites[1].RateLimit)
+		// Disabled rate limits are kept as is
+		assert.Equal(t, domain.WebsiteRateLimit{}, websites[2].RateLimit)
+	})
+
+	t.Run("exceeding the upper bound", func(t *testing.T) {
+		websites := []*domain.Website{
+			{FQDN: "a.example.com", RateLimit: domain.WebsiteRateLimit{Enabled: true}},
+			{FQDN: "b.example.com", RateLimit: domain.WebsiteRateLimit{Enabled: true, Burst: 5000}},
+		}
+		err := l.BoundRateLimits(websites)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "burst has to be at most 2000 (got 5000)")
+	})
+}
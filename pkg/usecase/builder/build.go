@@ -9,10 +9,15 @@ import (
 
 	buildkit "github.com/moby/buildkit/client"
 	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pbconvert"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
 	"github.com/traPtitech/neoshowcase/pkg/util/cli"
 )
 
@@ -31,7 +36,14 @@ func (s *ServiceImpl) startBuild(req *domain.StartBuildRequest) error {
 	if err != nil {
 		return err
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	// Continue the trace from the controller
+	ctx := observability.ExtractTraceContext(context.Background(), req.TraceContext)
+	ctx, span := observability.Tracer().Start(ctx, "Build", trace.WithAttributes(
+		attribute.String("build.id", req.Build.ID),
+		attribute.String("app.id", req.App.ID),
+		attribute.String("build.type", req.App.Config.BuildConfig.BuildType().String()),
+	))
+	ctx, cancel := context.WithCancel(ctx)
 	s.state = st
 	s.stateCancel = func() {
 		cancel()
@@ -40,8 +52,13 @@ func (s *ServiceImpl) startBuild(req *domain.StartBuildRequest) error {
 
 	go func() {
 		status := s.process(ctx, st)
-		s.finalize(context.Background(), st, status) // don't want finalization tasks to be cancelled
+		s.finalize(context.WithoutCancel(ctx), st, status) // don't want finalization tasks to be cancelled
 		st.Done()
+		span.SetAttributes(attribute.String("build.status", status.String()))
+		if status == domain.BuildStatusFailed {
+			span.SetStatus(codes.Error, "build failed")
+		}
+		span.End()
 
 		cancel()
 		s.statusLock.Lock()
@@ -53,6 +70,7 @@ func (s *ServiceImpl) startBuild(req *domain.StartBuildRequest) error {
 		s.response <- &pb.BuilderResponse{Type: pb.BuilderResponse_BUILD_SETTLED, Body: &pb.BuilderResponse_Settled{Settled: &pb.BuildSettled{
 			BuildId: st.build.ID,
 			Status:  pbconvert.BuildStatusMapper.IntoMust(status),
+			Steps:   st.stepDurations,
 		}}}
 	}()
 
@@ -164,9 +182,16 @@ func (s *ServiceImpl) process(ctx context.Context, st *state) domain.BuildStatus
 		start := time.Now()
 
 		// Execute step with timeout
-		childCtx, cancel := context.WithTimeout(ctx, s.config.StepTimeout)
+		childCtx, span := observability.Tracer().Start(ctx, step.desc)
+		childCtx, cancel := context.WithTimeout(childCtx, s.config.StepTimeout)
 		err := step.fn(childCtx)
 		cancel()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		st.stepDurations = append(st.stepDurations, &pb.BuildStepDuration{Name: step.desc, Duration: durationpb.New(time.Since(start))})
 
 		// First, check if ctx was cancelled from parent
 		// - this (usually) means the user pressed 'Cancel' button to cancel the build
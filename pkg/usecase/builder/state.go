@@ -13,6 +13,7 @@ import (
 	"github.com/samber/oops"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
 )
 
 type logWriter struct {
@@ -71,6 +72,9 @@ type state struct {
 	done              chan struct{}
 
 	staticDest string
+
+	// stepDurations are the durations of executed build steps, reported to the controller for metrics.
+	stepDurations []*pb.BuildStepDuration
 }
 
 func newState(app *domain.Application, envs []*domain.Environment, build *domain.Build, repo *domain.Repository, client domain.ControllerBuilderServiceClient) (*state, error) {
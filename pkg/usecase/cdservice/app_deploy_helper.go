@@ -5,24 +5,28 @@ import (
 	"log/slog"
 
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
 	"github.com/traPtitech/neoshowcase/pkg/domain/builder"
 	"github.com/traPtitech/neoshowcase/pkg/infrastructure/grpc/pb"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
 	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
 	"github.com/traPtitech/neoshowcase/pkg/util/ds"
 	"github.com/traPtitech/neoshowcase/pkg/util/optional"
 )
 
 type AppDeployHelper struct {
-	cluster     *discovery.Cluster
-	backend     domain.Backend
-	appRepo     domain.ApplicationRepository
-	buildRepo   domain.BuildRepository
-	envRepo     domain.EnvironmentRepository
-	websiteRepo domain.WebsiteRepository
-	ssgen       domain.ControllerSSGenService
-	image       builder.ImageConfig
+	cluster          *discovery.Cluster
+	backend          domain.Backend
+	appRepo          domain.ApplicationRepository
+	buildRepo        domain.BuildRepository
+	envRepo          domain.EnvironmentRepository
+	websiteRepo      domain.WebsiteRepository
+	customDomainRepo domain.CustomDomainRepository
+	tlsCertRepo      domain.TLSCertificateRepository
+	ssgen            domain.ControllerSSGenService
+	image            builder.ImageConfig
 }
 
 func NewAppDeployHelper(
@@ -32,18 +36,22 @@ func NewAppDeployHelper(
 	buildRepo domain.BuildRepository,
 	envRepo domain.EnvironmentRepository,
 	websiteRepo domain.WebsiteRepository,
+	customDomainRepo domain.CustomDomainRepository,
+	tlsCertRepo domain.TLSCertificateRepository,
 	ssgen domain.ControllerSSGenService,
 	imageConfig builder.ImageConfig,
 ) *AppDeployHelper {
 	return &AppDeployHelper{
-		cluster:     cluster,
-		backend:     backend,
-		appRepo:     appRepo,
-		buildRepo:   buildRepo,
-		envRepo:     envRepo,
-		websiteRepo: websiteRepo,
-		ssgen:       ssgen,
-		image:       imageConfig,
+		cluster:          cluster,
+		backend:          backend,
+		appRepo:          appRepo,
+		buildRepo:        buildRepo,
+		envRepo:          envRepo,
+		websiteRepo:      websiteRepo,
+		customDomainRepo: customDomainRepo,
+		tlsCertRepo:      tlsCertRepo,
+		ssgen:            ssgen,
+		image:            imageConfig,
 	}
 }
 
@@ -72,35 +80,67 @@ func (s *AppDeployHelper) _runtimeDesiredStates(ctx context.Context) ([]*domain.
 	if err != nil {
 		return nil, err
 	}
+	// Internal services are resolved among all running apps, across shards
+	allApps := apps
 	// Shard by app ID
 	apps = lo.Filter(apps, func(app *domain.Application, _ int) bool {
 		return s.cluster.IsAssigned(app.ID)
 	})
 
 	syncableApps := lo.Filter(apps, func(app *domain.Application, _ int) bool { return app.CurrentBuild != "" })
+	// Split the traffic only among the apps actually running and serving the port of the website,
+	// since the backend apps may have changed their websites after the split was configured
+	appsByID := lo.SliceToMap(allApps, func(app *domain.Application) (string, *domain.Application) { return app.ID, app })
+	for _, app := range syncableApps {
+		for _, website := range app.Websites {
+			website.Backends = website.ActiveBackends(func(appID string) bool {
+				backendApp, ok := appsByID[appID]
+				return ok && backendApp.CurrentBuild != "" && backendApp.ServesPortOf(website)
+			})
+		}
+	}
 	envs, err := s._getEnv(ctx, syncableApps)
 	if err != nil {
 		return nil, err
 	}
 	desiredStates := ds.Map(syncableApps, func(app *domain.Application) *domain.RuntimeDesiredState {
+		// Environment variables set by users take precedence
+		appEnvs := ds.MergeMap(domain.InternalServiceEnvs(app, allApps), envs[app.ID])
 		return &domain.RuntimeDesiredState{
-			App:       app,
-			ImageName: s.image.ImageName(app.ID),
-			ImageTag:  app.CurrentBuild,
-			Envs:      envs[app.ID],
+			App:               app,
+			ImageName:         s.image.ImageName(app.ID),
+			ImageTag:          app.CurrentBuild,
+			Envs:              appEnvs,
+			InternalClientIDs: app.InternalServiceClients(allApps),
 		}
 	})
 	return desiredStates, nil
 }
 
-func (s *AppDeployHelper) _collectSharedResources(ctx context.Context) (*domain.DesiredStateLeader, error) {
-	var st domain.DesiredStateLeader
+func (s *AppDeployHelper) _collectSharedResources(ctx context.Context, certs domain.TLSCertificateSlice) (*domain.DesiredStateLeader, error) {
+	st := domain.DesiredStateLeader{TLSCertificates: certs}
 	websites, err := s.websiteRepo.GetWebsites(ctx)
 	if err != nil {
 		return nil, err
 	}
+	customDomains, err := s.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{Verified: optional.From(true)})
+	if err != nil {
+		return nil, err
+	}
+	verifiedDomains := lo.SliceToMap(customDomains, func(d *domain.CustomDomain) (string, struct{}) { return d.Domain, struct{}{} })
+	availableDomains := s.backend.AvailableDomains()
+
 	tlsTargetDomains := make(map[string]struct{})
 	for _, website := range websites {
+		// Do not issue certificates for custom domains whose ownership is not (or no longer) verified
+		_, verified := verifiedDomains[website.FQDN]
+		if !verified && !availableDomains.IsAvailable(website.FQDN) {
+			continue
+		}
+		// Uploaded certificates are served instead
+		if certs.Covers(website) {
+			continue
+		}
 		host, ok := s.backend.TLSTargetDomain(website)
 		if ok {
 			tlsTargetDomains[host] = struct{}{}
@@ -110,6 +150,23 @@ func (s *AppDeployHelper) _collectSharedResources(ctx context.Context) (*domain.
 	return &st, nil
 }
 
+// _tlsCertificates returns the uploaded certificates with their private keys.
+// Certificates which private keys cannot be decrypted are skipped, so that the others are still served.
+func (s *AppDeployHelper) _tlsCertificates(ctx context.Context) (domain.TLSCertificateSlice, error) {
+	certs, err := s.tlsCertRepo.GetTLSCertificates(ctx, domain.GetTLSCertificateCondition{})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Filter(certs, func(c *domain.TLSCertificate, _ int) bool {
+		err := s.tlsCertRepo.LoadTLSCertificatePrivateKey(ctx, c)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to load private key of tls certificate, skipping", "cert_id", c.ID, "fqdn", c.FQDN, "error", err)
+			return false
+		}
+		return true
+	}), nil
+}
+
 func (s *AppDeployHelper) synchronize(ctx context.Context) error {
 	// Synchronize sharded resource
 	var st domain.DesiredState
@@ -122,22 +179,30 @@ func (s *AppDeployHelper) synchronize(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	st.TLSCertificates, err = s._tlsCertificates(ctx)
+	if err != nil {
+		return err
+	}
 	slog.InfoContext(ctx, "shard status", "shard_index", s.cluster.MyShardIndex(), "shard_size", s.cluster.Size(), "runtime_count", len(st.Runtime), "static_sites_count", len(st.StaticSites))
 
 	s.ssgen.BroadcastSSGen(&pb.SSGenRequest{Type: pb.SSGenRequest_RELOAD})
-	err = s.backend.Synchronize(ctx, &st)
+	err = observability.Traced(ctx, "Backend.Synchronize", func(ctx context.Context) error {
+		return s.backend.Synchronize(ctx, &st)
+	}, attribute.Int("runtime.count", len(st.Runtime)), attribute.Int("static_sites.count", len(st.StaticSites)))
 	if err != nil {
 		return err
 	}
 
 	// Only let leader synchronize shared resources (certificates)
 	if s.cluster.IsLeader() {
-		st, err := s._collectSharedResources(ctx)
+		leaderSt, err := s._collectSharedResources(ctx, st.TLSCertificates)
 		if err != nil {
 			return err
 		}
-		slog.InfoContext(ctx, "shard leader status", "tls_targets_count", len(st.TLSTargetDomains))
-		err = s.backend.SynchronizeShared(ctx, st)
+		slog.InfoContext(ctx, "shard leader status", "tls_targets_count", len(leaderSt.TLSTargetDomains), "tls_certificates_count", len(leaderSt.TLSCertificates))
+		err = observability.Traced(ctx, "Backend.SynchronizeShared", func(ctx context.Context) error {
+			return s.backend.SynchronizeShared(ctx, leaderSt)
+		}, attribute.Int("tls_targets.count", len(leaderSt.TLSTargetDomains)), attribute.Int("tls_certificates.count", len(leaderSt.TLSCertificates)))
 		if err != nil {
 			return err
 		}
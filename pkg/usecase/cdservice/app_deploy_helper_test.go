@@ -0,0 +1,55 @@
+package cdservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+type fakeTLSCertificateRepository struct {
+	domain.TLSCertificateRepository
+	certs []*domain.TLSCertificate
+	keys  map[string]string
+}
+
+func (r *fakeTLSCertificateRepository) GetTLSCertificates(context.Context, domain.GetTLSCertificateCondition) ([]*domain.TLSCertificate, error) {
+	return lo.Map(r.certs, func(c *domain.TLSCertificate, _ int) *domain.TLSCertificate {
+		cc := *c
+		return &cc
+	}), nil
+}
+
+func (r *fakeTLSCertificateRepository) LoadTLSCertificatePrivateKey(_ context.Context, c *domain.TLSCertificate) error {
+	key, ok := r.keys[c.ID]
+	if !ok {
+		return errors.New("decrypting private key")
+	}
+	c.PrivateKey = key
+	return nil
+}
+
+func TestAppDeployHelper_tlsCertificates(t *testing.T) {
+	repo := &fakeTLSCertificateRepository{
+		certs: []*domain.TLSCertificate{
+			{ID: "a", FQDN: "a.example.com"},
+			{ID: "broken", FQDN: "broken.example.com"},
+			{ID: "c", FQDN: "c.example.com"},
+		},
+		keys: map[string]string{"a": "key-a", "c": "key-c"},
+	}
+	s := &AppDeployHelper{tlsCertRepo: repo}
+
+	certs, err := s._tlsCertificates(context.Background())
+	require.NoError(t, err)
+	// The certificate failing to decrypt does not stop serving the others
+	assert.Equal(t, domain.TLSCertificateSlice{
+		{ID: "a", FQDN: "a.example.com", PrivateKey: "key-a"},
+		{ID: "c", FQDN: "c.example.com", PrivateKey: "key-c"},
+	}, certs)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"sync"
+	"time"
 
 	"github.com/motoki317/sc"
 	"github.com/samber/lo"
@@ -15,9 +16,16 @@ import (
 )
 
 type ContainerStateMutator struct {
-	cluster *discovery.Cluster
-	appRepo domain.ApplicationRepository
-	backend domain.Backend
+	cluster   *discovery.Cluster
+	appRepo   domain.ApplicationRepository
+	eventRepo domain.ApplicationEventRepository
+	backend   domain.Backend
+	notifier  domain.Notifier
+
+	crashLoop         *domain.CrashLoopDetector
+	crashLoopAutoStop bool
+	// autoStopped is published when apps are stopped by crash loop detection, and deployments need to be synced.
+	autoStopped domain.PubSub[struct{}]
 
 	lock sync.Mutex
 }
@@ -25,15 +33,27 @@ type ContainerStateMutator struct {
 func NewContainerStateMutator(
 	cluster *discovery.Cluster,
 	appRepo domain.ApplicationRepository,
+	eventRepo domain.ApplicationEventRepository,
 	backend domain.Backend,
-) *ContainerStateMutator {
+	notifier domain.Notifier,
+	crashLoop domain.CrashLoopConfig,
+) (*ContainerStateMutator, error) {
+	detector, err := domain.NewCrashLoopDetector(crashLoop)
+	if err != nil {
+		return nil, err
+	}
 	m := &ContainerStateMutator{
-		cluster: cluster,
-		appRepo: appRepo,
-		backend: backend,
+		cluster:   cluster,
+		appRepo:   appRepo,
+		eventRepo: eventRepo,
+		backend:   backend,
+		notifier:  notifier,
+
+		crashLoop:         detector,
+		crashLoopAutoStop: crashLoop.AutoStop,
 	}
 	go m._subscribe(backend)
-	return m
+	return m, nil
 }
 
 func (m *ContainerStateMutator) _subscribe(backend domain.Backend) {
@@ -52,13 +72,29 @@ func (m *ContainerStateMutator) _subscribe(backend domain.Backend) {
 }
 
 func (m *ContainerStateMutator) _updateOne(ctx context.Context, appID string) (struct{}, error) {
+	// Every replica receives the container events, so only the assigned one updates the state.
+	// Otherwise, another replica may persist the new state first, and the assigned one would miss the transition.
+	if !m.cluster.IsAssigned(appID) {
+		return struct{}{}, nil
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
+	app, err := m.appRepo.GetApplication(ctx, appID)
+	if err != nil {
+		return struct{}{}, oops.With("app_id", appID).Wrapf(err, "getting application")
+	}
 	container, err := m.backend.GetContainer(ctx, appID)
 	if err != nil {
 		return struct{}{}, oops.With("app_id", appID).Wrapf(err, "getting container state")
 	}
+	var crashLooping bool
+	if app.Running {
+		crashLooping = m.crashLoop.Observe(container, time.Now())
+	} else {
+		m.crashLoop.Forget(appID)
+	}
 	err = m.appRepo.UpdateApplication(ctx, appID, &domain.UpdateApplicationArgs{
 		Container:        optional.From(container.State),
 		ContainerMessage: optional.From(container.Message),
@@ -66,6 +102,11 @@ func (m *ContainerStateMutator) _updateOne(ctx context.Context, appID string) (s
 	if err != nil {
 		return struct{}{}, oops.With("app_id", appID).Wrapf(err, "updating application")
 	}
+	m.recordEvents(ctx, []*domain.ApplicationEvent{domain.NewContainerStateEvent(app, container)})
+	m.notify(ctx, domain.NewContainerStateNotification(app, container))
+	if crashLooping {
+		m.handleCrashLoop(ctx, app, container)
+	}
 
 	return struct{}{}, nil
 }
@@ -89,6 +130,9 @@ func (m *ContainerStateMutator) updateAll(ctx context.Context) error {
 	if err != nil {
 		return oops.Wrapf(err, "listing containers")
 	}
+	containers = lo.Filter(containers, func(c *domain.Container, _ int) bool {
+		return m.cluster.IsAssigned(c.ApplicationID)
+	})
 
 	// If actual state is not found, update state as "missing"
 	stateExists := lo.SliceToMap(containers, func(c *domain.Container) (string, bool) {
@@ -103,10 +147,91 @@ func (m *ContainerStateMutator) updateAll(ctx context.Context) error {
 		}
 	}
 
+	// Detect crash loops of the assigned apps
+	now := time.Now()
+	containersMap := lo.SliceToMap(containers, func(c *domain.Container) (string, *domain.Container) {
+		return c.ApplicationID, c
+	})
+	var crashLoopingApps []*domain.Application
+	for _, app := range allRuntimeApps {
+		if !app.Running {
+			m.crashLoop.Forget(app.ID)
+			continue
+		}
+		if m.crashLoop.Observe(containersMap[app.ID], now) {
+			crashLoopingApps = append(crashLoopingApps, app)
+		}
+	}
+
 	// Update
 	err = m.appRepo.BulkUpdateState(ctx, containers)
 	if err != nil {
 		return oops.Wrapf(err, "bulk-updating container state")
 	}
+
+	// Record state transitions of the assigned apps
+	m.recordEvents(ctx, lo.Map(allRuntimeApps, func(app *domain.Application, _ int) *domain.ApplicationEvent {
+		return domain.NewContainerStateEvent(app, containersMap[app.ID])
+	}))
+	for _, app := range allRuntimeApps {
+		m.notify(ctx, domain.NewContainerStateNotification(app, containersMap[app.ID]))
+	}
+	for _, app := range crashLoopingApps {
+		m.handleCrashLoop(ctx, app, containersMap[app.ID])
+	}
 	return nil
 }
+
+// handleCrashLoop notifies the owners of the newly detected crash-looping app, and stops the app if configured to.
+// Errors are only logged, since the container state is already recorded.
+func (m *ContainerStateMutator) handleCrashLoop(ctx context.Context, app *domain.Application, container *domain.Container) {
+	slog.InfoContext(ctx, "crash loop detected", "app_id", app.ID, "message", container.Message)
+
+	message := container.Message
+	if m.crashLoopAutoStop {
+		err := m.appRepo.UpdateApplication(ctx, app.ID, &domain.UpdateApplicationArgs{
+			Running:   optional.From(false),
+			UpdatedAt: optional.From(time.Now()),
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "failed to stop crash-looping app", "app_id", app.ID, "error", err)
+		} else {
+			m.crashLoop.Forget(app.ID)
+			m.recordEvents(ctx, []*domain.ApplicationEvent{domain.NewStoppedEvent(app.ID, "crash loop detected", "")})
+			m.autoStopped.Publish(struct{}{})
+			message += "\nThe application has been stopped automatically."
+		}
+	}
+
+	err := m.notifier.Notify(ctx, &domain.Notification{
+		ApplicationID: app.ID,
+		Event:         domain.NotificationEventContainerErrored,
+		UserIDs:       app.OwnerIDs,
+		Title:         "Crash loop detected in " + app.Name,
+		Message:       message,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to notify crash loop", "app_id", app.ID, "error", err)
+	}
+}
+
+// recordEvents records non-nil events. Errors are only logged, since the timeline is not essential to the state.
+func (m *ContainerStateMutator) recordEvents(ctx context.Context, events []*domain.ApplicationEvent) {
+	events = lo.Compact(events)
+	err := m.eventRepo.CreateEvents(ctx, events)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record container state events", "error", err)
+	}
+}
+
+// notify sends the notification if not nil. Errors are only logged, since the container state is already recorded.
+func (m *ContainerStateMutator) notify(ctx context.Context, n *domain.Notification) {
+	if n == nil {
+		return
+	}
+	err := m.notifier.Notify(ctx, n)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to notify container state", "app_id", n.ApplicationID, "error", err)
+	}
+}
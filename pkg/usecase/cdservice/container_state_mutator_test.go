@@ -0,0 +1,257 @@
+package cdservice
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+	"github.com/traPtitech/neoshowcase/pkg/util/hash"
+)
+
+type fakeDiscoverer struct {
+	targets []discovery.Target
+}
+
+func (d *fakeDiscoverer) Watch(ctx context.Context) (<-chan []discovery.Target, error) {
+	updates := make(chan []discovery.Target)
+	go func() {
+		updates <- d.targets
+		<-ctx.Done()
+		close(updates)
+	}()
+	return updates, nil
+}
+
+type fakeEventRepository struct {
+	domain.ApplicationEventRepository
+	lock   sync.Mutex
+	events []*domain.ApplicationEvent
+}
+
+func (r *fakeEventRepository) CreateEvents(_ context.Context, events []*domain.ApplicationEvent) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, events...)
+	return nil
+}
+
+type fakeNotifier struct {
+	lock          sync.Mutex
+	notifications []*domain.Notification
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, notification *domain.Notification) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+// replicas returns clusters of the given number of replicas, each of which is "me" in the respective cluster.
+func replicas(t *testing.T, n int) []*discovery.Cluster {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return lo.Times(n, func(me int) *discovery.Cluster {
+		targets := lo.Times(n, func(i int) discovery.Target {
+			return discovery.Target{IP: "10.0.0." + strconv.Itoa(i+1), Me: i == me}
+		})
+		c := discovery.NewCluster(&fakeDiscoverer{targets: targets})
+		go func() { _ = c.Start(ctx) }()
+		return c
+	})
+}
+
+// appIDFor returns an app ID assigned to the given shard.
+func appIDFor(t *testing.T, shard int, shards int) string {
+	for i := range 100 {
+		id := "app-" + strconv.Itoa(i)
+		if hash.JumpHashStr(id, shards) == shard {
+			return id
+		}
+	}
+	t.Fatalf("no app ID found for shard %d", shard)
+	return ""
+}
+
+type containerStateFixture struct {
+	lock       sync.Mutex
+	apps       map[string]*domain.Application
+	containers map[string]*domain.Container
+	// bulkUpdated is the input to BulkUpdateState per replica.
+	bulkUpdated [][]*domain.Container
+	// updated is the app IDs whose state is written per replica.
+	updated [][]string
+
+	events   *fakeEventRepository
+	notifier *fakeNotifier
+	mutators []*ContainerStateMutator
+}
+
+func newContainerStateFixture(t *testing.T, apps []*domain.Application, crashLoop domain.CrashLoopConfig) *containerStateFixture {
+	f := &containerStateFixture{
+		apps:       lo.SliceToMap(apps, func(app *domain.Application) (string, *domain.Application) { return app.ID, app }),
+		containers: make(map[string]*domain.Container),
+		events:     &fakeEventRepository{},
+		notifier:   &fakeNotifier{},
+	}
+	clusters := replicas(t, 2)
+	f.bulkUpdated = make([][]*domain.Container, len(clusters))
+	f.updated = make([][]string, len(clusters))
+	for i, cluster := range clusters {
+		appRepo := &mocks.ApplicationRepositoryMock{
+			GetApplicationFunc: func(ctx context.Context, id string) (*domain.Application, error) {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				app := *f.apps[id]
+				return &app, nil
+			},
+			GetApplicationsFunc: func(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error) {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				return lo.Map(apps, func(app *domain.Application, _ int) *domain.Application {
+					a := *f.apps[app.ID]
+					return &a
+				}), nil
+			},
+			UpdateApplicationFunc: func(ctx context.Context, id string, args *domain.UpdateApplicationArgs) error {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				f.apps[id].Apply(args)
+				if args.Container.Valid {
+					f.updated[i] = append(f.updated[i], id)
+				}
+				return nil
+			},
+			BulkUpdateStateFunc: func(ctx context.Context, states []*domain.Container) error {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				f.bulkUpdated[i] = append(f.bulkUpdated[i], states...)
+				for _, c := range states {
+					f.apps[c.ApplicationID].Container = c.State
+				}
+				return nil
+			},
+		}
+		events := make(chan *domain.ContainerEvent)
+		t.Cleanup(func() { close(events) })
+		backend := &mocks.BackendMock{
+			ListenContainerEventsFunc: func() (<-chan *domain.ContainerEvent, func()) {
+				return events, func() {}
+			},
+			GetContainerFunc: func(ctx context.Context, appID string) (*domain.Container, error) {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				c := *f.containers[appID]
+				return &c, nil
+			},
+			ListContainersFunc: func(ctx context.Context) ([]*domain.Container, error) {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				return lo.Map(lo.Values(f.containers), func(c *domain.Container, _ int) *domain.Container {
+					cc := *c
+					return &cc
+				}), nil
+			},
+		}
+		m, err := NewContainerStateMutator(cluster, appRepo, f.events, backend, f.notifier, crashLoop)
+		require.NoError(t, err)
+		f.mutators = append(f.mutators, m)
+	}
+	return f
+}
+
+func (f *containerStateFixture) setContainer(c *domain.Container) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.containers[c.ApplicationID] = c
+}
+
+func (f *containerStateFixture) state(appID string) domain.ContainerState {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.apps[appID].Container
+}
+
+func TestContainerStateMutator_updateOne(t *testing.T) {
+	app0 := &domain.Application{ID: appIDFor(t, 0, 2), Running: true, Container: domain.ContainerStateStarting}
+	app1 := &domain.Application{ID: appIDFor(t, 1, 2), Running: true, Container: domain.ContainerStateStarting}
+	f := newContainerStateFixture(t, []*domain.Application{app0, app1}, domain.CrashLoopConfig{})
+	f.setContainer(&domain.Container{ApplicationID: app0.ID, State: domain.ContainerStateRunning})
+	f.setContainer(&domain.Container{ApplicationID: app1.ID, State: domain.ContainerStateRunning})
+
+	// Every replica receives the events, the non-assigned one first
+	ctx := context.Background()
+	for _, i := range []int{1, 0} {
+		_, err := f.mutators[i]._updateOne(ctx, app0.ID)
+		require.NoError(t, err)
+	}
+	for _, i := range []int{0, 1} {
+		_, err := f.mutators[i]._updateOne(ctx, app1.ID)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, [][]string{{app0.ID}, {app1.ID}}, f.updated)
+	assert.Equal(t, domain.ContainerStateRunning, f.state(app0.ID))
+	assert.Equal(t, domain.ContainerStateRunning, f.state(app1.ID))
+	// Transitions are recorded and notified exactly once
+	assert.ElementsMatch(t, []string{app0.ID, app1.ID}, lo.Map(f.events.events, func(e *domain.ApplicationEvent, _ int) string { return e.ApplicationID }))
+	assert.ElementsMatch(t, []string{app0.ID, app1.ID}, lo.Map(f.notifier.notifications, func(n *domain.Notification, _ int) string { return n.ApplicationID }))
+}
+
+func TestContainerStateMutator_updateAll(t *testing.T) {
+	app0 := &domain.Application{ID: appIDFor(t, 0, 2), DeployType: domain.DeployTypeRuntime, Running: true, Container: domain.ContainerStateStarting}
+	app1 := &domain.Application{ID: appIDFor(t, 1, 2), DeployType: domain.DeployTypeRuntime, Running: true, Container: domain.ContainerStateStarting}
+	f := newContainerStateFixture(t, []*domain.Application{app0, app1}, domain.CrashLoopConfig{})
+	// The container of app1 is missing
+	f.setContainer(&domain.Container{ApplicationID: app0.ID, State: domain.ContainerStateRunning})
+
+	ctx := context.Background()
+	for _, m := range f.mutators {
+		require.NoError(t, m.updateAll(ctx))
+	}
+
+	assert.Equal(t, [][]*domain.Container{
+		{{ApplicationID: app0.ID, State: domain.ContainerStateRunning}},
+		{{ApplicationID: app1.ID, State: domain.ContainerStateMissing}},
+	}, f.bulkUpdated)
+	assert.Equal(t, domain.ContainerStateRunning, f.state(app0.ID))
+	assert.Equal(t, domain.ContainerStateMissing, f.state(app1.ID))
+	assert.ElementsMatch(t, []string{app0.ID, app1.ID}, lo.Map(f.events.events, func(e *domain.ApplicationEvent, _ int) string { return e.ApplicationID }))
+}
+
+func TestContainerStateMutator_CrashLoop(t *testing.T) {
+	running := &domain.Application{ID: appIDFor(t, 0, 2), Running: true, Container: domain.ContainerStateRunning}
+	stopped := &domain.Application{ID: appIDFor(t, 1, 2), Running: false, Container: domain.ContainerStateExited}
+	f := newContainerStateFixture(t, []*domain.Application{running, stopped}, domain.CrashLoopConfig{Threshold: 2, Window: "10m"})
+
+	ctx := context.Background()
+	crashLoopNotifications := func() []string {
+		f.notifier.lock.Lock()
+		defer f.notifier.lock.Unlock()
+		return lo.FilterMap(f.notifier.notifications, func(n *domain.Notification, _ int) (string, bool) {
+			return n.ApplicationID, n.Title == "Crash loop detected in "+f.apps[n.ApplicationID].Name
+		})
+	}
+	for restarts := range 3 {
+		f.setContainer(&domain.Container{ApplicationID: running.ID, State: domain.ContainerStateRestarting, RestartCount: restarts})
+		f.setContainer(&domain.Container{ApplicationID: stopped.ID, State: domain.ContainerStateExited, RestartCount: restarts})
+		for _, m := range f.mutators {
+			_, err := m._updateOne(ctx, running.ID)
+			require.NoError(t, err)
+			_, err = m._updateOne(ctx, stopped.ID)
+			require.NoError(t, err)
+		}
+	}
+
+	assert.Equal(t, []string{running.ID}, crashLoopNotifications())
+	assert.Equal(t, domain.ContainerStateErrored, f.state(running.ID))
+	assert.Equal(t, domain.ContainerStateExited, f.state(stopped.ID))
+}
@@ -26,6 +26,7 @@ type service struct {
 	appRepo   domain.ApplicationRepository
 	buildRepo domain.BuildRepository
 	envRepo   domain.EnvironmentRepository
+	eventRepo domain.ApplicationEventRepository
 	backend   domain.Backend
 	builder   domain.ControllerBuilderService
 	deployer  *AppDeployHelper
@@ -49,6 +50,7 @@ func NewService(
 	appRepo domain.ApplicationRepository,
 	buildRepo domain.BuildRepository,
 	envRepo domain.EnvironmentRepository,
+	eventRepo domain.ApplicationEventRepository,
 	backend domain.Backend,
 	builder domain.ControllerBuilderService,
 	deployer *AppDeployHelper,
@@ -68,6 +70,7 @@ func NewService(
 		appRepo:   appRepo,
 		buildRepo: buildRepo,
 		envRepo:   envRepo,
+		eventRepo: eventRepo,
 		backend:   backend,
 		builder:   builder,
 		deployer:  deployer,
@@ -142,6 +145,12 @@ func NewService(
 				go cd.doClusterSyncDeploy()
 			}
 		}()
+		go func() {
+			sub, _ := mutator.autoStopped.Subscribe()
+			for range sub {
+				go cd.doClusterSyncDeploy()
+			}
+		}()
 		go loop.Loop(ctx, func(ctx context.Context) {
 			_ = doLocalSyncDeploy.Do(ctx)
 		}, 3*time.Minute, true)
@@ -319,6 +328,10 @@ func (cd *service) _syncAppFields(ctx context.Context) error {
 			if err != nil {
 				return oops.With("app_id", app.ID).Wrapf(err, "syncing application commit")
 			}
+			err = cd.eventRepo.CreateEvents(ctx, []*domain.ApplicationEvent{domain.NewDeployedEvent(app.ID, nextBuild.ID)})
+			if err != nil {
+				slog.WarnContext(ctx, "failed to record deployed event", "app_id", app.ID, "error", err) // fail-safe
+			}
 		}
 	}
 	return nil
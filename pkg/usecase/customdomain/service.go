@@ -0,0 +1,125 @@
+package customdomain
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/samber/oops"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+	"github.com/traPtitech/neoshowcase/pkg/util/loop"
+)
+
+// verifyConcurrency is the number of custom domains verified at once.
+const verifyConcurrency = 8
+
+type Config struct {
+	// Interval is the interval of re-verifying the ownership of custom domains, e.g. "1h".
+	Interval string `mapstructure:"interval" yaml:"interval"`
+	// GracePeriod is how long a verified domain stays verified while verification fails, e.g. "72h".
+	GracePeriod string `mapstructure:"gracePeriod" yaml:"gracePeriod"`
+}
+
+// Service periodically verifies the ownership of custom domains, including ones not verified yet.
+type Service interface {
+	Start(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+}
+
+type customDomainService struct {
+	cluster     *discovery.Cluster
+	repo        domain.CustomDomainRepository
+	verifier    domain.CustomDomainVerifier
+	gracePeriod time.Duration
+
+	start        func()
+	startOnce    sync.Once
+	shutdown     func()
+	shutdownOnce sync.Once
+}
+
+func NewService(
+	cluster *discovery.Cluster,
+	repo domain.CustomDomainRepository,
+	verifier domain.CustomDomainVerifier,
+	config Config,
+) (Service, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid interval")
+	}
+	gracePeriod, err := time.ParseDuration(config.GracePeriod)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid grace period")
+	}
+
+	s := &customDomainService{
+		cluster:     cluster,
+		repo:        repo,
+		verifier:    verifier,
+		gracePeriod: gracePeriod,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.start = func() {
+		go loop.Loop(ctx, func(ctx context.Context) {
+			// Custom domains are shared resources
+			if !s.cluster.IsLeader() {
+				return
+			}
+			start := time.Now()
+			err := s.verifyAll(ctx)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to verify custom domains", "error", err)
+				return
+			}
+			slog.DebugContext(ctx, "Verified custom domains", "duration", time.Since(start))
+		}, interval, true)
+	}
+	s.shutdown = cancel
+
+	return s, nil
+}
+
+func (s *customDomainService) Start(_ context.Context) error {
+	s.startOnce.Do(s.start)
+	return nil
+}
+
+func (s *customDomainService) Shutdown(_ context.Context) error {
+	s.shutdownOnce.Do(s.shutdown)
+	return nil
+}
+
+func (s *customDomainService) verifyAll(ctx context.Context) error {
+	domains, err := s.repo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting custom domains")
+	}
+
+	var eg errgroup.Group
+	eg.SetLimit(verifyConcurrency)
+	for _, d := range domains {
+		eg.Go(func() error {
+			err := Verify(ctx, s.repo, s.verifier, d, s.gracePeriod)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to record custom domain verification", "id", d.ID, "error", err)
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// Verify verifies the ownership of the custom domain and records the result.
+func Verify(ctx context.Context, repo domain.CustomDomainRepository, verifier domain.CustomDomainVerifier, d *domain.CustomDomain, gracePeriod time.Duration) error {
+	verifyErr := verifier.Verify(ctx, d)
+	if verifyErr != nil && d.Verified {
+		slog.InfoContext(ctx, "failed to verify verified custom domain", "id", d.ID, "domain", d.Domain, "error", verifyErr)
+	}
+	return repo.UpdateCustomDomain(ctx, d.ID, d.VerificationResult(verifyErr, time.Now(), gracePeriod))
+}
@@ -0,0 +1,121 @@
+package customdomain
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/netutil"
+)
+
+const (
+	verifyTimeout = 10 * time.Second
+	// maxTokenBodySize limits the body read from the verification URL.
+	maxTokenBodySize = 1024
+)
+
+type verifier struct {
+	resolver domain.DNSResolver
+	dialer   *netutil.PublicDialer
+	client   *http.Client
+	// httpPort is the port to connect to for the HTTP verification, 80 except for tests.
+	httpPort int
+}
+
+// NewVerifier returns a verifier resolving names by resolver.
+// HTTP verification also connects to the addresses resolved by resolver, so that tests can use a fake DNS.
+// Only public addresses are connected to, since the domains are set by users.
+func NewVerifier(resolver domain.DNSResolver) domain.CustomDomainVerifier {
+	v := &verifier{
+		resolver: resolver,
+		dialer:   &netutil.PublicDialer{Resolver: resolver},
+		httpPort: 80,
+	}
+	v.client = &http.Client{
+		Timeout: verifyTimeout,
+		Transport: &http.Transport{
+			DialContext:       v.dialContext,
+			DisableKeepAlives: true,
+		},
+		// The token has to be served on the domain itself
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return v
+}
+
+func (v *verifier) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	return v.dialer.DialContext(ctx, network, net.JoinHostPort(host, strconv.Itoa(v.httpPort)))
+}
+
+func (v *verifier) Verify(ctx context.Context, d *domain.CustomDomain) error {
+	ctx, cancel := context.WithTimeout(ctx, verifyTimeout)
+	defer cancel()
+
+	switch d.Method {
+	case domain.CustomDomainVerificationDNS:
+		return v.verifyDNS(ctx, d)
+	case domain.CustomDomainVerificationHTTP:
+		return v.verifyHTTP(ctx, d)
+	default:
+		return oops.Errorf("unknown verification method: %v", d.Method)
+	}
+}
+
+func (v *verifier) verifyDNS(ctx context.Context, d *domain.CustomDomain) error {
+	records, err := v.resolver.LookupTXT(ctx, d.TXTRecordName())
+	if err != nil {
+		return oops.Wrapf(err, "looking up TXT record %v", d.TXTRecordName())
+	}
+	if !slices.Contains(records, d.TXTRecordValue()) {
+		return oops.Errorf("TXT record %v does not contain %q", d.TXTRecordName(), d.TXTRecordValue())
+	}
+	return nil
+}
+
+func (v *verifier) verifyHTTP(ctx context.Context, d *domain.CustomDomain) error {
+	err := v.fetchToken(ctx, d)
+	if err != nil {
+		// The error is recorded and shown to the user, so do not expose the responses of or the errors connecting to arbitrary hosts
+		slog.DebugContext(ctx, "HTTP verification failed", "domain", d.Domain, "error", err)
+		return oops.Errorf("%v does not serve the verification token", d.HTTPURL())
+	}
+	return nil
+}
+
+func (v *verifier) fetchToken(ctx context.Context, d *domain.CustomDomain) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.HTTPURL(), nil)
+	if err != nil {
+		return oops.Wrapf(err, "creating request")
+	}
+	res, err := v.client.Do(req)
+	if err != nil {
+		return oops.Wrapf(err, "requesting %v", d.HTTPURL())
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return oops.Errorf("%v returned status %d", d.HTTPURL(), res.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxTokenBodySize))
+	if err != nil {
+		return oops.Wrapf(err, "reading response")
+	}
+	if strings.TrimSpace(string(body)) != d.Token {
+		return oops.Errorf("wrong token served")
+	}
+	return nil
+}
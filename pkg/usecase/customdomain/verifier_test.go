@@ -0,0 +1,103 @@
+package customdomain
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+type fakeResolver struct {
+	txt   map[string][]string
+	hosts map[string][]string
+}
+
+func (r *fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := r.txt[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func TestVerifier_DNS(t *testing.T) {
+	d := domain.NewCustomDomain("user", "example.dev", domain.CustomDomainVerificationDNS)
+	resolver := &fakeResolver{txt: map[string][]string{
+		"_neoshowcase-challenge.example.dev": {"some-other-record", "neoshowcase-verification=" + d.Token},
+		"_neoshowcase-challenge.wrong.dev":   {"neoshowcase-verification=wrong"},
+	}}
+	v := NewVerifier(resolver)
+
+	assert.NoError(t, v.Verify(context.Background(), d))
+
+	wrong := domain.NewCustomDomain("user", "wrong.dev", domain.CustomDomainVerificationDNS)
+	assert.Error(t, v.Verify(context.Background(), wrong))
+
+	missing := domain.NewCustomDomain("user", "missing.dev", domain.CustomDomainVerificationDNS)
+	assert.Error(t, v.Verify(context.Background(), missing))
+}
+
+func TestVerifier_HTTP(t *testing.T) {
+	d := domain.NewCustomDomain("user", "example.dev", domain.CustomDomainVerificationHTTP)
+	redirected := domain.NewCustomDomain("user", "redirect.dev", domain.CustomDomainVerificationHTTP)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/neoshowcase-verification.txt" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Host {
+		case "example.dev":
+			_, _ = w.Write([]byte(d.Token + "\n"))
+		case "redirect.dev":
+			http.Redirect(w, r, "http://example.dev/.well-known/neoshowcase-verification.txt", http.StatusFound)
+		default:
+			_, _ = w.Write([]byte("wrong token"))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	resolver := &fakeResolver{hosts: map[string][]string{
+		"example.dev":  {u.Hostname()},
+		"redirect.dev": {u.Hostname()},
+		"wrong.dev":    {u.Hostname()},
+	}}
+	v := NewVerifier(resolver).(*verifier)
+	v.httpPort = port
+
+	// The test server listens on loopback, which is not connected to in production
+	err = v.Verify(context.Background(), d)
+	assert.EqualError(t, err, "http://example.dev/.well-known/neoshowcase-verification.txt does not serve the verification token")
+	v.dialer.AllowAddr = func(netip.Addr) bool { return true }
+
+	assert.NoError(t, v.Verify(context.Background(), d))
+	assert.Error(t, v.Verify(context.Background(), redirected), "redirects should not be followed")
+
+	wrong := domain.NewCustomDomain("user", "wrong.dev", domain.CustomDomainVerificationHTTP)
+	assert.Error(t, v.Verify(context.Background(), wrong))
+
+	// Details of the failures are not exposed to the users
+	missing := domain.NewCustomDomain("user", "missing.dev", domain.CustomDomainVerificationHTTP)
+	err = v.Verify(context.Background(), missing)
+	assert.EqualError(t, err, "http://missing.dev/.well-known/neoshowcase-verification.txt does not serve the verification token")
+}
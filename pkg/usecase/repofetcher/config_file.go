@@ -0,0 +1,119 @@
+package repofetcher
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+// readConfigFiles reads the configuration files of the apps at their new commits.
+//
+// Only apps whose commit changed are read, since the configuration at the current commit has already been applied.
+// The returned map has a nil value for apps without a configuration file,
+// and errs has the error for apps whose configuration file could not be read.
+func (r *service) readConfigFiles(
+	ctx context.Context,
+	repo *domain.Repository,
+	apps []*domain.Application,
+	newCommits map[string]string,
+) (files map[string][]byte, errs map[string]error) {
+	targets := lo.Filter(apps, func(app *domain.Application, _ int) bool {
+		commit := newCommits[app.ID]
+		return commit != domain.EmptyCommit && commit != app.Commit
+	})
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	files = make(map[string][]byte, len(targets))
+	errs = make(map[string]error)
+	failAll := func(err error) (map[string][]byte, map[string]error) {
+		for _, app := range targets {
+			errs[app.ID] = err
+		}
+		return files, errs
+	}
+
+	tmpDir, err := os.MkdirTemp("", "repo-fetcher-")
+	if err != nil {
+		return failAll(oops.Wrapf(err, "creating temp dir"))
+	}
+	defer os.RemoveAll(tmpDir)
+
+	localRepo, err := r.gitsvc.CreateBareRepository(tmpDir, repo)
+	if err != nil {
+		return failAll(oops.Wrapf(err, "initializing git repo"))
+	}
+	hashes := lo.Uniq(lo.Map(targets, func(app *domain.Application, _ int) string { return newCommits[app.ID] }))
+	err = localRepo.Fetch(ctx, hashes)
+	if err != nil {
+		return failAll(oops.Wrapf(err, "fetching commits"))
+	}
+
+	for _, app := range targets {
+		b, err := localRepo.ReadFile(newCommits[app.ID], app.ConfigFilePathOrDefault())
+		if errors.Is(err, domain.ErrFileNotFound) {
+			files[app.ID] = nil
+			continue
+		}
+		if err != nil {
+			errs[app.ID] = oops.Wrapf(err, "reading config file")
+			continue
+		}
+		files[app.ID] = b
+	}
+	return files, errs
+}
+
+// configFileArgs returns the update args and env vars declared by the configuration file.
+// The returned configErr is a validation error of the configuration file, to be surfaced on the app.
+func (r *service) configFileArgs(
+	ctx context.Context,
+	app *domain.Application,
+	content []byte,
+	currentEnvs []*domain.Environment,
+	existingApps []*domain.Application,
+	customDomains []*domain.CustomDomain,
+) (args *domain.UpdateApplicationArgs, envs []*domain.Environment, configErr error, err error) {
+	f, configErr := domain.ParseConfigFile(content)
+	if configErr != nil {
+		return nil, nil, configErr, nil
+	}
+	args, configErr = f.UpdateArgs(app)
+	if configErr != nil {
+		return nil, nil, configErr, nil
+	}
+	envs, configErr = f.Envs(app.ID, currentEnvs)
+	if configErr != nil {
+		return nil, nil, configErr, nil
+	}
+	if args.Websites.Valid {
+		// Bound the same as the changes via API
+		configErr = r.limiter.BoundRateLimits(args.Websites.V)
+		if configErr != nil {
+			return nil, nil, configErr, nil
+		}
+	}
+	availableDomains := r.backend.AvailableDomains().WithCustomDomains(customDomains, app.OwnerIDs)
+	configErr = f.ValidateUpdate(app, args, existingApps, availableDomains, r.backend.AvailablePorts())
+	if configErr != nil {
+		return nil, nil, configErr, nil
+	}
+
+	next := *app
+	next.Apply(args)
+	err = r.limiter.CheckQuota(ctx, app, &next)
+	var exceeded *domain.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		return nil, nil, exceeded, nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return args, envs, nil, nil
+}
@@ -0,0 +1,197 @@
+package repofetcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/test/mocks"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/applimit"
+)
+
+func newTestService(quota domain.Quota, rateLimitConfig domain.WebsiteRateLimitConfig) *service {
+	appRepo := &mocks.ApplicationRepositoryMock{
+		GetApplicationsFunc: func(ctx context.Context, cond domain.GetApplicationCondition) ([]*domain.Application, error) {
+			return nil, nil
+		},
+	}
+	userRepo := &mocks.UserRepositoryMock{
+		GetUsersFunc: func(ctx context.Context, cond domain.GetUserCondition) ([]*domain.User, error) {
+			return []*domain.User{{ID: "user", Name: "user"}}, nil
+		},
+		GetUserQuotasFunc: func(ctx context.Context, cond domain.GetUserQuotaCondition) ([]*domain.UserQuota, error) {
+			return nil, nil
+		},
+	}
+	backend := &mocks.BackendMock{
+		AvailableDomainsFunc: func() domain.AvailableDomainSlice {
+			return domain.AvailableDomainSlice{{Domain: "*.example.com", AuthAvailable: true}}
+		},
+		AvailablePortsFunc: func() domain.AvailablePortSlice {
+			return domain.AvailablePortSlice{{StartPort: 39000, EndPort: 39999, Protocol: domain.PortPublicationProtocolTCP}}
+		},
+	}
+	return &service{
+		limiter: applimit.NewLimiter(appRepo, userRepo, quota, rateLimitConfig),
+		backend: backend,
+	}
+}
+
+func TestService_configFileArgs(t *testing.T) {
+	app := &domain.Application{
+		ID:           "app-id",
+		Name:         "app",
+		RepositoryID: "repo-id",
+		RefName:      "main",
+		DeployType:   domain.DeployTypeRuntime,
+		Config: domain.ApplicationConfig{
+			BuildConfig: &domain.BuildConfigRuntimeDockerfile{DockerfileName: "Dockerfile"},
+		},
+		OwnerIDs: []string{"user"},
+	}
+	rateLimitConfig := domain.WebsiteRateLimitConfig{DefaultAverage: 10, DefaultBurst: 20, MaxAverage: 100, MaxBurst: -1}
+
+	tests := []struct {
+		name          string
+		quota         domain.Quota
+		content       string
+		check         func(t *testing.T, args *domain.UpdateApplicationArgs)
+		wantConfigErr bool
+	}{
+		{
+			name:  "rate limit defaults",
+			quota: domain.UnlimitedQuota,
+			content: `
+build:
+  type: runtime_dockerfile
+  dockerfileName: Dockerfile
+websites:
+  - fqdn: app.example.com
+    rateLimit: {}
+`,
+			check: func(t *testing.T, args *domain.UpdateApplicationArgs) {
+				require.Len(t, args.Websites.V, 1)
+				assert.Equal(t, 10, args.Websites.V[0].RateLimit.Average)
+				assert.Equal(t, 20, args.Websites.V[0].RateLimit.Burst)
+			},
+		},
+		{
+			name:  "rate limit exceeding max",
+			quota: domain.UnlimitedQuota,
+			content: `
+build:
+  type: runtime_dockerfile
+  dockerfileName: Dockerfile
+websites:
+  - fqdn: app.example.com
+    rateLimit:
+      average: 1000
+`,
+			wantConfigErr: true,
+		},
+		{
+			name:  "within quota",
+			quota: domain.Quota{MaxApplications: -1, MaxRunningApplications: -1, MaxPortPublications: 1, MaxDatabases: -1},
+			content: `
+build:
+  type: runtime_dockerfile
+  dockerfileName: Dockerfile
+portPublications:
+  - internetPort: 39000
+    applicationPort: 22
+`,
+			check: func(t *testing.T, args *domain.UpdateApplicationArgs) {
+				require.Len(t, args.PortPublications.V, 1)
+			},
+		},
+		{
+			name:  "exceeding quota",
+			quota: domain.Quota{MaxApplications: -1, MaxRunningApplications: -1, MaxPortPublications: 0, MaxDatabases: -1},
+			content: `
+build:
+  type: runtime_dockerfile
+  dockerfileName: Dockerfile
+portPublications:
+  - internetPort: 39000
+    applicationPort: 22
+`,
+			wantConfigErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(tt.quota, rateLimitConfig)
+			args, _, configErr, err := s.configFileArgs(context.Background(), app, []byte(tt.content), nil, nil, nil)
+			require.NoError(t, err)
+			if tt.wantConfigErr {
+				assert.Error(t, configErr)
+				return
+			}
+			require.NoError(t, configErr)
+			tt.check(t, args)
+		})
+	}
+}
+
+func TestService_readConfigFiles(t *testing.T) {
+	repo := &domain.Repository{ID: "repo-id"}
+	apps := []*domain.Application{
+		{ID: "app1", Commit: "old"},
+		{ID: "app2", Commit: "old"},
+		{ID: "app3", Commit: "current"},
+	}
+	newCommits := map[string]string{
+		"app1": "new1",
+		"app2": "new2",
+		"app3": "current",
+	}
+
+	t.Run("fetch failure", func(t *testing.T) {
+		gitRepo := &mocks.GitRepositoryMock{
+			FetchFunc: func(ctx context.Context, hashes []string) error {
+				return errors.New("fetch failed")
+			},
+		}
+		s := &service{gitsvc: &mocks.GitServiceMock{
+			CreateBareRepositoryFunc: func(dir string, repo *domain.Repository) (domain.GitRepository, error) {
+				return gitRepo, nil
+			},
+		}}
+
+		files, errs := s.readConfigFiles(context.Background(), repo, apps, newCommits)
+		assert.Empty(t, files)
+		assert.Len(t, errs, 2)
+		assert.Contains(t, errs, "app1")
+		assert.Contains(t, errs, "app2")
+	})
+
+	t.Run("read failure of one app", func(t *testing.T) {
+		gitRepo := &mocks.GitRepositoryMock{
+			FetchFunc: func(ctx context.Context, hashes []string) error {
+				return nil
+			},
+			ReadFileFunc: func(hash string, path string) ([]byte, error) {
+				switch hash {
+				case "new1":
+					return []byte("build:\n  type: static_cmd\n"), nil
+				default:
+					return nil, errors.New("read failed")
+				}
+			},
+		}
+		s := &service{gitsvc: &mocks.GitServiceMock{
+			CreateBareRepositoryFunc: func(dir string, repo *domain.Repository) (domain.GitRepository, error) {
+				return gitRepo, nil
+			},
+		}}
+
+		files, errs := s.readConfigFiles(context.Background(), repo, apps, newCommits)
+		assert.Equal(t, map[string][]byte{"app1": []byte("build:\n  type: static_cmd\n")}, files)
+		assert.Len(t, errs, 1)
+		assert.Contains(t, errs, "app2")
+	})
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/samber/lo"
 	"github.com/samber/oops"
 	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/infrastructure/observability"
+	"github.com/traPtitech/neoshowcase/pkg/usecase/applimit"
 	commitfetcher "github.com/traPtitech/neoshowcase/pkg/usecase/commit-fetcher"
 	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
 	"github.com/traPtitech/neoshowcase/pkg/util/ds"
@@ -42,12 +45,18 @@ type Service interface {
 }
 
 type service struct {
-	cluster       *discovery.Cluster
-	appRepo       domain.ApplicationRepository
-	gitRepo       domain.GitRepositoryRepository
-	gitsvc        domain.GitService
-	cd            domain.CDService
-	commitFetcher commitfetcher.Service
+	cluster          *discovery.Cluster
+	appRepo          domain.ApplicationRepository
+	gitRepo          domain.GitRepositoryRepository
+	envRepo          domain.EnvironmentRepository
+	eventRepo        domain.ApplicationEventRepository
+	customDomainRepo domain.CustomDomainRepository
+	limiter          *applimit.Limiter
+	backend          domain.Backend
+	gitsvc           domain.GitService
+	cd               domain.CDService
+	commitFetcher    commitfetcher.Service
+	metrics          *observability.ControllerMetrics
 
 	fetcher   chan<- string
 	run       func()
@@ -60,17 +69,29 @@ func NewService(
 	cluster *discovery.Cluster,
 	appRepo domain.ApplicationRepository,
 	gitRepo domain.GitRepositoryRepository,
+	envRepo domain.EnvironmentRepository,
+	eventRepo domain.ApplicationEventRepository,
+	customDomainRepo domain.CustomDomainRepository,
+	limiter *applimit.Limiter,
+	backend domain.Backend,
 	cd domain.CDService,
 	commitFetcher commitfetcher.Service,
 	gitsvc domain.GitService,
+	metrics *observability.ControllerMetrics,
 ) (Service, error) {
 	r := &service{
-		cluster:       cluster,
-		appRepo:       appRepo,
-		gitRepo:       gitRepo,
-		cd:            cd,
-		commitFetcher: commitFetcher,
-		gitsvc:        gitsvc,
+		cluster:          cluster,
+		appRepo:          appRepo,
+		gitRepo:          gitRepo,
+		envRepo:          envRepo,
+		eventRepo:        eventRepo,
+		customDomainRepo: customDomainRepo,
+		limiter:          limiter,
+		backend:          backend,
+		cd:               cd,
+		commitFetcher:    commitFetcher,
+		gitsvc:           gitsvc,
+		metrics:          metrics,
 	}
 
 	fetcher := make(chan string, 100)
@@ -193,12 +214,19 @@ func (r *service) fetchOne(ctx context.Context, repositoryID string) error {
 }
 
 func (r *service) updateApps(ctx context.Context, repo *domain.Repository, apps []*domain.Application) error {
-	refToCommit, err := r.gitsvc.ResolveRefs(ctx, repo)
+	var refToCommit map[string]string
+	start := time.Now()
+	err := observability.Traced(ctx, "ResolveRefs", func(ctx context.Context) (err error) {
+		refToCommit, err = r.gitsvc.ResolveRefs(ctx, repo)
+		return err
+	}, attribute.String("repository.id", repo.ID))
+	r.metrics.ObserveRepositoryFetch(time.Since(start), err)
 	if err != nil {
 		return err
 	}
 
 	var hashes []string
+	newCommits := make(map[string]string, len(apps))
 	for _, app := range apps {
 		commit, ok := refToCommit[app.RefName]
 		if ok {
@@ -207,11 +235,76 @@ func (r *service) updateApps(ctx context.Context, repo *domain.Repository, apps
 			slog.WarnContext(ctx, "failed to resolve ref for app", "ref_name", app.RefName, "app_id", app.ID)
 			commit = domain.EmptyCommit // Mark as empty commit to signal error
 		}
+		newCommits[app.ID] = commit
+	}
 
-		err = r.appRepo.UpdateApplication(ctx, app.ID, &domain.UpdateApplicationArgs{Commit: optional.From(commit)})
+	configFiles, readErrs := r.readConfigFiles(ctx, repo, apps, newCommits)
+	var existingApps []*domain.Application
+	var customDomains []*domain.CustomDomain
+	if len(configFiles) > 0 {
+		existingApps, err = r.appRepo.GetApplications(ctx, domain.GetApplicationCondition{})
+		if err != nil {
+			return oops.Wrapf(err, "getting applications")
+		}
+		customDomains, err = r.customDomainRepo.GetCustomDomains(ctx, domain.GetCustomDomainCondition{Verified: optional.From(true)})
+		if err != nil {
+			return oops.Wrapf(err, "getting custom domains")
+		}
+	}
+
+	for _, app := range apps {
+		if readErr, ok := readErrs[app.ID]; ok {
+			// Keep the current commit until the config file can be read, not to deploy the new commit without it
+			slog.WarnContext(ctx, "failed to read config file", "app_id", app.ID, "error", readErr)
+			err = r.appRepo.UpdateApplication(ctx, app.ID, &domain.UpdateApplicationArgs{ConfigFileError: optional.From("failed to read config file")})
+			if err != nil {
+				return oops.With("app_id", app.ID).Wrapf(err, "updating application")
+			}
+			continue
+		}
+		args := &domain.UpdateApplicationArgs{Commit: optional.From(newCommits[app.ID])}
+		var envs []*domain.Environment
+		var event *domain.ApplicationEvent
+		if content, ok := configFiles[app.ID]; ok {
+			args.ConfigFileError = optional.From("")
+			if content != nil {
+				currentEnvs, err := r.envRepo.GetEnv(ctx, domain.GetEnvCondition{ApplicationID: optional.From(app.ID)})
+				if err != nil {
+					return oops.With("app_id", app.ID).Wrapf(err, "getting env")
+				}
+				configArgs, configEnvs, configErr, err := r.configFileArgs(ctx, app, content, currentEnvs, existingApps, customDomains)
+				if err != nil {
+					slog.WarnContext(ctx, "failed to apply config file", "app_id", app.ID, "error", err)
+					continue
+				}
+				if configErr != nil {
+					// Do not deploy the new commit until the config file is fixed
+					args = &domain.UpdateApplicationArgs{ConfigFileError: optional.From(configErr.Error())}
+				} else {
+					configArgs.Commit = args.Commit
+					configArgs.ConfigFileError = args.ConfigFileError
+					args, envs = configArgs, configEnvs
+					event = domain.NewConfigChangedEvent(app.ID, "config file: "+args.UserChangedFields(), "")
+				}
+			}
+		}
+
+		err = r.appRepo.UpdateApplication(ctx, app.ID, args)
 		if err != nil {
 			return oops.With("app_id", app.ID).Wrapf(err, "updating application")
 		}
+		for _, env := range envs {
+			err = r.envRepo.SetEnv(ctx, env)
+			if err != nil {
+				return oops.With("app_id", app.ID).Wrapf(err, "setting env")
+			}
+		}
+		if event != nil {
+			err = r.eventRepo.CreateEvents(ctx, []*domain.ApplicationEvent{event})
+			if err != nil {
+				slog.WarnContext(ctx, "failed to record config changed event", "app_id", app.ID, "error", err) // fail-safe
+			}
+		}
 		// Notify builds
 		r.cd.RegisterBuild(app.ID)
 	}
@@ -0,0 +1,110 @@
+package tlscert
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+	"github.com/traPtitech/neoshowcase/pkg/util/loop"
+)
+
+type Config struct {
+	// Interval is the interval of checking the expiry of uploaded certificates, e.g. "24h".
+	// Owners are notified once per interval while the certificate is expiring.
+	Interval string `mapstructure:"interval" yaml:"interval"`
+}
+
+// Service periodically reports the uploaded TLS certificates about to expire,
+// as they are not renewed automatically unlike the ones issued by ACME.
+type Service interface {
+	Start(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+}
+
+type tlsCertService struct {
+	cluster  *discovery.Cluster
+	appRepo  domain.ApplicationRepository
+	certRepo domain.TLSCertificateRepository
+	notifier domain.Notifier
+
+	start        func()
+	startOnce    sync.Once
+	shutdown     func()
+	shutdownOnce sync.Once
+}
+
+func NewService(
+	cluster *discovery.Cluster,
+	appRepo domain.ApplicationRepository,
+	certRepo domain.TLSCertificateRepository,
+	notifier domain.Notifier,
+	config Config,
+) (Service, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid interval")
+	}
+
+	s := &tlsCertService{
+		cluster:  cluster,
+		appRepo:  appRepo,
+		certRepo: certRepo,
+		notifier: notifier,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.start = func() {
+		go loop.Loop(ctx, func(ctx context.Context) {
+			// Certificates are shared resources
+			if !s.cluster.IsLeader() {
+				return
+			}
+			err := s.checkExpiry(ctx, time.Now())
+			if err != nil {
+				slog.WarnContext(ctx, "failed to check expiry of tls certificates", "error", err)
+			}
+		}, interval, true)
+	}
+	s.shutdown = cancel
+
+	return s, nil
+}
+
+func (s *tlsCertService) Start(_ context.Context) error {
+	s.startOnce.Do(s.start)
+	return nil
+}
+
+func (s *tlsCertService) Shutdown(_ context.Context) error {
+	s.shutdownOnce.Do(s.shutdown)
+	return nil
+}
+
+func (s *tlsCertService) checkExpiry(ctx context.Context, now time.Time) error {
+	certs, err := s.certRepo.GetTLSCertificates(ctx, domain.GetTLSCertificateCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting tls certificates")
+	}
+	for _, c := range certs {
+		if c.ExpiresSoon(now) {
+			slog.WarnContext(ctx, "uploaded tls certificate is expiring", "id", c.ID, "fqdn", c.FQDN, "user_id", c.UserID, "not_after", c.NotAfter)
+		}
+	}
+
+	apps, err := s.appRepo.GetApplications(ctx, domain.GetApplicationCondition{})
+	if err != nil {
+		return oops.Wrapf(err, "getting applications")
+	}
+	for _, n := range domain.TLSCertificateSlice(certs).ExpiryNotifications(apps, now) {
+		err := s.notifier.Notify(ctx, n)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to notify expiring tls certificate", "app_id", n.ApplicationID, "error", err)
+		}
+	}
+	return nil
+}
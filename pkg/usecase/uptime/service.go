@@ -0,0 +1,241 @@
+package uptime
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+	"github.com/traPtitech/neoshowcase/pkg/util/discovery"
+	"github.com/traPtitech/neoshowcase/pkg/util/ds"
+	"github.com/traPtitech/neoshowcase/pkg/util/loop"
+	"github.com/traPtitech/neoshowcase/pkg/util/optional"
+)
+
+const (
+	// probeConcurrency is the number of websites probed at once.
+	probeConcurrency = 16
+	// maxBodySize is the maximum size of the response body read by a probe.
+	maxBodySize = 64 * 1024
+)
+
+type Config struct {
+	// Interval is the interval of probing each website, e.g. "1m".
+	Interval string `mapstructure:"interval" yaml:"interval"`
+	// Timeout is the timeout of a probe, e.g. "10s".
+	Timeout string `mapstructure:"timeout" yaml:"timeout"`
+	// Retention is how long probe results are kept, e.g. "720h".
+	Retention string `mapstructure:"retention" yaml:"retention"`
+}
+
+type Service interface {
+	Start(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+}
+
+type uptimeService struct {
+	cluster   *discovery.Cluster
+	appRepo   domain.ApplicationRepository
+	probeRepo domain.WebsiteProbeRepository
+	notifier  domain.Notifier
+	client    *http.Client
+
+	start        func()
+	startOnce    sync.Once
+	shutdown     func()
+	shutdownOnce sync.Once
+}
+
+func NewService(
+	cluster *discovery.Cluster,
+	appRepo domain.ApplicationRepository,
+	probeRepo domain.WebsiteProbeRepository,
+	notifier domain.Notifier,
+	config Config,
+) (Service, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid interval")
+	}
+	timeout, err := time.ParseDuration(config.Timeout)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid timeout")
+	}
+	retention, err := time.ParseDuration(config.Retention)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid retention")
+	}
+
+	s := &uptimeService{
+		cluster:   cluster,
+		appRepo:   appRepo,
+		probeRepo: probeRepo,
+		notifier:  notifier,
+		client:    newProbeClient(timeout),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.start = func() {
+		go loop.Loop(ctx, func(ctx context.Context) {
+			start := time.Now()
+			err := s.probeAll(ctx)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to probe websites", "error", err)
+				return
+			}
+			slog.DebugContext(ctx, "Probed websites", "duration", time.Since(start))
+		}, interval, true)
+		go loop.Loop(ctx, func(ctx context.Context) {
+			err := s.probeRepo.DeleteProbes(ctx, time.Now().Add(-retention))
+			if err != nil {
+				slog.WarnContext(ctx, "failed to prune website probes", "error", err)
+			}
+		}, 1*time.Hour, true)
+	}
+	s.shutdown = cancel
+
+	return s, nil
+}
+
+func newProbeClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			DisableKeepAlives: true,
+		},
+		// Redirects (e.g. to the login page) are regarded as successful responses
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (s *uptimeService) Start(_ context.Context) error {
+	s.startOnce.Do(s.start)
+	return nil
+}
+
+func (s *uptimeService) Shutdown(_ context.Context) error {
+	s.shutdownOnce.Do(s.shutdown)
+	return nil
+}
+
+func (s *uptimeService) probeAll(ctx context.Context) error {
+	apps, err := s.appRepo.GetApplications(ctx, domain.GetApplicationCondition{Running: optional.From(true)})
+	if err != nil {
+		return oops.Wrapf(err, "getting running applications")
+	}
+	// Shard by app ID
+	apps = lo.Filter(apps, func(app *domain.Application, _ int) bool {
+		return s.cluster.IsAssigned(app.ID)
+	})
+
+	var (
+		eg     errgroup.Group
+		lock   sync.Mutex
+		probes []*domain.WebsiteProbe
+	)
+	eg.SetLimit(probeConcurrency)
+	for _, app := range apps {
+		for _, website := range app.Websites {
+			eg.Go(func() error {
+				p := s.probe(ctx, app.ID, website)
+				lock.Lock()
+				probes = append(probes, p)
+				lock.Unlock()
+				return nil
+			})
+		}
+	}
+	_ = eg.Wait()
+
+	websiteIDs := ds.Map(probes, func(p *domain.WebsiteProbe) string { return p.WebsiteID })
+	previous, err := s.probeRepo.GetLatestProbes(ctx, websiteIDs)
+	if err != nil {
+		return oops.Wrapf(err, "getting previous probes")
+	}
+	err = s.probeRepo.CreateProbes(ctx, probes)
+	if err != nil {
+		return err
+	}
+
+	appsMap := lo.SliceToMap(apps, func(app *domain.Application) (string, *domain.Application) { return app.ID, app })
+	previousMap := lo.SliceToMap(previous, func(p *domain.WebsiteProbe) (string, *domain.WebsiteProbe) { return p.WebsiteID, p })
+	for _, p := range probes {
+		s.notifyCertificateProblem(ctx, appsMap[p.ApplicationID], previousMap[p.WebsiteID], p)
+	}
+	return nil
+}
+
+// notifyCertificateProblem notifies the owners when a certificate problem is newly found.
+func (s *uptimeService) notifyCertificateProblem(ctx context.Context, app *domain.Application, previous, current *domain.WebsiteProbe) {
+	problem := current.CertificateProblem()
+	if problem == "" || (previous != nil && previous.CertificateProblem() != "") {
+		return
+	}
+	website, ok := lo.Find(app.Websites, func(w *domain.Website) bool { return w.ID == current.WebsiteID })
+	if !ok {
+		return
+	}
+	err := s.notifier.Notify(ctx, &domain.Notification{
+		ApplicationID: app.ID,
+		Event:         domain.NotificationEventCertificateError,
+		UserIDs:       app.OwnerIDs,
+		Title:         "Certificate problem: " + website.FQDN,
+		Message:       website.URL() + ": " + problem,
+		Time:          current.CheckedAt,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to notify certificate problem", "app_id", app.ID, "website_id", website.ID, "error", err)
+	}
+}
+
+func (s *uptimeService) probe(ctx context.Context, appID string, website *domain.Website) *domain.WebsiteProbe {
+	p := &domain.WebsiteProbe{
+		ID:            domain.NewID(),
+		WebsiteID:     website.ID,
+		ApplicationID: appID,
+		CheckedAt:     time.Now(),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, website.URL(), nil)
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	req.Header.Set("User-Agent", "NeoShowcase-Uptime/1.0")
+
+	res, err := s.client.Do(req)
+	p.Latency = time.Since(p.CheckedAt)
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxBodySize))
+
+	p.StatusCode = res.StatusCode
+	p.Up = domain.IsWebsiteUp(res.StatusCode)
+	if !p.Up {
+		p.Error = res.Status
+	}
+	p.TLSExpiresAt = tlsExpiry(res.TLS)
+	return p
+}
+
+// tlsExpiry returns the expiry of the leaf certificate.
+func tlsExpiry(state *tls.ConnectionState) optional.Of[time.Time] {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return optional.None[time.Time]()
+	}
+	return optional.From(state.PeerCertificates[0].NotAfter)
+}
@@ -0,0 +1,58 @@
+package uptime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/traPtitech/neoshowcase/pkg/domain"
+)
+
+func TestUptimeService_probe(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/auth", http.StatusFound) })
+	mux.HandleFunc("/down", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusBadGateway) })
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+	u, _ := url.Parse(srv.URL)
+
+	s := &uptimeService{client: newProbeClient(5 * time.Second)}
+	s.client.Transport = srv.Client().Transport
+
+	website := func(path string) *domain.Website {
+		return &domain.Website{ID: "website", FQDN: u.Host, PathPrefix: path, HTTPS: true}
+	}
+
+	t.Run("up", func(t *testing.T) {
+		p := s.probe(context.Background(), "app", website("/ok"))
+		assert.True(t, p.Up)
+		assert.Equal(t, http.StatusOK, p.StatusCode)
+		assert.Equal(t, "app", p.ApplicationID)
+		assert.Equal(t, "website", p.WebsiteID)
+		assert.True(t, p.TLSExpiresAt.Valid)
+		assert.Empty(t, p.Error)
+	})
+	t.Run("redirect", func(t *testing.T) {
+		p := s.probe(context.Background(), "app", website("/login"))
+		assert.True(t, p.Up)
+		assert.Equal(t, http.StatusFound, p.StatusCode)
+	})
+	t.Run("server error", func(t *testing.T) {
+		p := s.probe(context.Background(), "app", website("/down"))
+		assert.False(t, p.Up)
+		assert.Equal(t, http.StatusBadGateway, p.StatusCode)
+		assert.Equal(t, "502 Bad Gateway", p.Error)
+	})
+	t.Run("connection error", func(t *testing.T) {
+		p := s.probe(context.Background(), "app", &domain.Website{FQDN: "127.0.0.1:1", PathPrefix: "/"})
+		assert.False(t, p.Up)
+		assert.Zero(t, p.StatusCode)
+		assert.NotEmpty(t, p.Error)
+	})
+}
@@ -0,0 +1,80 @@
+package netutil
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+
+	"github.com/samber/oops"
+)
+
+// nonPublicPrefixes are the ranges not covered by the netip.Addr methods, which are not reachable from the internet.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// IsPublicAddr reports whether ip is a public unicast address.
+func IsPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// PublicDialer connects only to public addresses,
+// so that the hosts set by users cannot reach the internal network, the metadata servers or the host itself.
+// The host is resolved here and the checked addresses are dialed directly, so that the check cannot be bypassed by DNS rebinding.
+type PublicDialer struct {
+	Resolver Resolver
+	// AllowAddr overrides IsPublicAddr, e.g. to connect to local servers in tests.
+	AllowAddr func(ip netip.Addr) bool
+}
+
+func (d *PublicDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := d.Resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	allowAddr := d.AllowAddr
+	if allowAddr == nil {
+		allowAddr = IsPublicAddr
+	}
+	var dialer net.Dialer
+	var errs []error
+	for _, a := range addrs {
+		ip, err := netip.ParseAddr(a)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !allowAddr(ip) {
+			errs = append(errs, oops.Errorf("%v resolves to non-public address %v", host, a))
+			continue
+		}
+		conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(a, port))
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, oops.Errorf("no addresses found for %v", host)
+	}
+	return nil, errors.Join(errs...)
+}